@@ -78,6 +78,51 @@ const docTemplate = `{
                 }
             }
         },
+        "/events/stream": {
+            "get": {
+                "description": "Server-Sent Events by default; requests asking for a WebSocket upgrade get one instead.\nReconnecting with the Last-Event-ID header, or last_event_id for WebSockets, replays missed events that are still kept.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Stream Events",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Last event ID seen",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Last event ID seen, for clients that cannot set headers",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token, for clients that cannot set headers",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LiveEventResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
         "/follows": {
             "post": {
                 "consumes": [
@@ -336,53 +381,15 @@ const docTemplate = `{
                 }
             }
         },
-        "/notification-channels": {
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "NotificationChannel"
-                ],
-                "summary": "Create Notification Channel",
-                "parameters": [
-                    {
-                        "description": "Channel data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateNotificationChannelRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/dto.NotificationChannelResponse"
-                        }
-                    }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
-            }
-        },
-        "/notification-channels/users/{user_id}": {
+        "/inbox/users/{user_id}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "NotificationChannel"
+                    "Inbox"
                 ],
-                "summary": "List Notification Channels By User",
+                "summary": "List Inbox Messages By User",
                 "parameters": [
                     {
                         "type": "integer",
@@ -391,6 +398,12 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "boolean",
+                        "description": "Only unread messages",
+                        "name": "unread",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -410,7 +423,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PaginationResponse-dto_NotificationChannelResponse"
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_InboxMessageResponse"
                         }
                     }
                 },
@@ -421,20 +434,20 @@ const docTemplate = `{
                 ]
             }
         },
-        "/notification-channels/{id}": {
-            "get": {
+        "/inbox/users/{user_id}/read-all": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "NotificationChannel"
+                    "Inbox"
                 ],
-                "summary": "Get Notification Channel",
+                "summary": "Mark All Inbox Messages Read",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Channel ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
@@ -443,7 +456,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.NotificationChannelResponse"
+                            "$ref": "#/definitions/dto.InboxMarkAllReadResponse"
                         }
                     }
                 },
@@ -452,41 +465,31 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/inbox/users/{user_id}/unread-count": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "NotificationChannel"
+                    "Inbox"
                 ],
-                "summary": "Update Notification Channel",
+                "summary": "Count Unread Inbox Messages",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Channel ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Channel data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateNotificationChannelRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.NotificationChannelResponse"
+                            "$ref": "#/definitions/dto.InboxUnreadCountResponse"
                         }
                     }
                 },
@@ -495,19 +498,21 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
+            }
+        },
+        "/inbox/{id}/read": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "NotificationChannel"
+                    "Inbox"
                 ],
-                "summary": "Delete Notification Channel",
+                "summary": "Mark Inbox Message Read",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Channel ID",
+                        "description": "Message ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -515,7 +520,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InboxMessageResponse"
+                        }
                     }
                 },
                 "security": [
@@ -525,105 +533,92 @@ const docTemplate = `{
                 ]
             }
         },
-        "/platforms": {
+        "/notification-actions/{token}": {
             "get": {
+                "description": "Target of the links in notifications to snooze, mute or unfollow a streamer, or to acknowledge the notification.\nThe signed token authorizes the request and expires; acting on a notification also acknowledges it, which stops escalation.\nOpening the link with GET only acknowledges or snoozes; mute and unfollow have to be confirmed with POST.\nBrowsers asking for HTML get a page instead, which asks to confirm mute and unfollow and posts back.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/html"
                 ],
                 "tags": [
-                    "StreamingPlatform"
+                    "NotificationAction"
                 ],
-                "summary": "List Streaming Platforms",
+                "summary": "Perform Notification Action",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Signed action token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PaginationResponse-dto_StreamingPlatformResponse"
+                            "$ref": "#/definitions/dto.NotificationActionResponse"
                         }
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             },
             "post": {
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Target of the links in notifications to snooze, mute or unfollow a streamer, or to acknowledge the notification.\nThe signed token authorizes the request and expires; acting on a notification also acknowledges it, which stops escalation.\nOpening the link with GET only acknowledges or snoozes; mute and unfollow have to be confirmed with POST.\nBrowsers asking for HTML get a page instead, which asks to confirm mute and unfollow and posts back.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/html"
                 ],
                 "tags": [
-                    "StreamingPlatform"
+                    "NotificationAction"
                 ],
-                "summary": "Create Streaming Platform",
+                "summary": "Perform Notification Action",
                 "parameters": [
                     {
-                        "description": "Platform data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateStreamingPlatformRequest"
-                        }
+                        "type": "string",
+                        "description": "Signed action token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StreamingPlatformResponse"
+                            "$ref": "#/definitions/dto.NotificationActionResponse"
                         }
                     }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
+                }
             }
         },
-        "/platforms/{id}": {
-            "get": {
+        "/notification-channels": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "StreamingPlatform"
+                    "NotificationChannel"
                 ],
-                "summary": "Get Streaming Platform",
+                "summary": "Create Notification Channel",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Platform ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Channel data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateNotificationChannelRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.StreamingPlatformResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelResponse"
                         }
                     }
                 },
@@ -632,8 +627,10 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
+            }
+        },
+        "/notification-channels/test": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -641,24 +638,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "StreamingPlatform"
+                    "NotificationChannel"
                 ],
-                "summary": "Update Streaming Platform",
+                "summary": "Test Notification Channel Config",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Platform ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Platform data",
+                        "description": "Channel type and config",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateStreamingPlatformRequest"
+                            "$ref": "#/definitions/dto.TestNotificationChannelConfigRequest"
                         }
                     }
                 ],
@@ -666,7 +656,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StreamingPlatformResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelTestResponse"
                         }
                     }
                 },
@@ -675,27 +665,26 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
+            }
+        },
+        "/notification-channels/types": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "StreamingPlatform"
-                ],
-                "summary": "Delete Streaming Platform",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Platform ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "NotificationChannel"
                 ],
+                "summary": "List Notification Channel Types",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.NotificationChannelTypeResponse"
+                            }
+                        }
                     }
                 },
                 "security": [
@@ -705,20 +694,27 @@ const docTemplate = `{
                 ]
             }
         },
-        "/streamers": {
+        "/notification-channels/users/{user_id}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Streamer"
+                    "NotificationChannel"
                 ],
-                "summary": "List Streamers",
+                "summary": "List Notification Channels By User",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
                         "name": "page",
                         "in": "query"
                     },
@@ -734,43 +730,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PaginationResponse-dto_StreamerResponse"
-                        }
-                    }
-                },
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ]
-            },
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Streamer"
-                ],
-                "summary": "Create Streamer",
-                "parameters": [
-                    {
-                        "description": "Streamer data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateStreamerRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/dto.StreamerResponse"
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_NotificationChannelResponse"
                         }
                     }
                 },
@@ -781,19 +741,19 @@ const docTemplate = `{
                 ]
             }
         },
-        "/streamers/{id}": {
+        "/notification-channels/{id}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Streamer"
+                    "NotificationChannel"
                 ],
-                "summary": "Get Streamer",
+                "summary": "Get Notification Channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Streamer ID",
+                        "description": "Channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -803,7 +763,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StreamerResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelResponse"
                         }
                     }
                 },
@@ -821,24 +781,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Streamer"
+                    "NotificationChannel"
                 ],
-                "summary": "Update Streamer",
+                "summary": "Update Notification Channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Streamer ID",
+                        "description": "Channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Streamer data",
+                        "description": "Channel data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateStreamerRequest"
+                            "$ref": "#/definitions/dto.UpdateNotificationChannelRequest"
                         }
                     }
                 ],
@@ -846,7 +806,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StreamerResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelResponse"
                         }
                     }
                 },
@@ -861,13 +821,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Streamer"
+                    "NotificationChannel"
                 ],
-                "summary": "Delete Streamer",
+                "summary": "Delete Notification Channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Streamer ID",
+                        "description": "Channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -885,47 +845,29 @@ const docTemplate = `{
                 ]
             }
         },
-        "/streamers/{platform_type}/{platform_streamer_id}": {
-            "get": {
+        "/notification-channels/{id}/test": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Streamer"
+                    "NotificationChannel"
                 ],
-                "summary": "Get Streamer By Platform",
+                "summary": "Test Notification Channel",
                 "parameters": [
                     {
-                        "enum": [
-                            "douyu",
-                            "huya",
-                            "bilibili"
-                        ],
-                        "type": "string",
-                        "description": "Platform type",
-                        "name": "platform_type",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform streamer ID",
-                        "name": "platform_streamer_id",
+                        "type": "integer",
+                        "description": "Channel ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Refresh from platform and update database",
-                        "name": "refresh",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StreamerResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelTestResponse"
                         }
                     }
                 },
@@ -936,34 +878,29 @@ const docTemplate = `{
                 ]
             }
         },
-        "/user": {
+        "/notification-channels/{id}/verification": {
             "post": {
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "NotificationChannel"
                 ],
-                "summary": "Create User",
+                "summary": "Resend Notification Channel Verification",
                 "parameters": [
                     {
-                        "description": "User info",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateUserRequest"
-                        }
+                        "type": "integer",
+                        "description": "Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelResponse"
                         }
                     }
                 },
@@ -974,36 +911,41 @@ const docTemplate = `{
                 ]
             }
         },
-        "/user/list": {
-            "get": {
+        "/notification-channels/{id}/verify": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "NotificationChannel"
                 ],
-                "summary": "List Users",
+                "summary": "Verify Notification Channel",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Verification code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.VerifyNotificationChannelRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PaginationResponse-dto_UserResponse"
+                            "$ref": "#/definitions/dto.NotificationChannelResponse"
                         }
                     }
                 },
@@ -1014,29 +956,54 @@ const docTemplate = `{
                 ]
             }
         },
-        "/user/{id}": {
+        "/notification-deliveries/dead-letters": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "NotificationDelivery"
                 ],
-                "summary": "Get User",
+                "summary": "List Dead-Lettered Notification Deliveries",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Channel ID",
+                        "name": "channel_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_NotificationDeliveryResponse"
                         }
                     }
                 },
@@ -1045,8 +1012,10 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "put": {
+            }
+        },
+        "/notification-deliveries/dead-letters/replay": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -1054,24 +1023,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "NotificationDelivery"
                 ],
-                "summary": "Update User",
+                "summary": "Replay Dead-Lettered Notification Deliveries",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "User info",
+                        "description": "Optional scope; empty replays everything",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                            "$ref": "#/definitions/dto.ReplayDeadLettersRequest"
                         }
                     }
                 ],
@@ -1079,7 +1041,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserResponse"
+                            "$ref": "#/definitions/dto.ReplayDeadLettersResponse"
                         }
                     }
                 },
@@ -1088,111 +1050,1606 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ]
-            },
-            "delete": {
+            }
+        },
+        "/notification-deliveries/users/{user_id}": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "NotificationDelivery"
                 ],
-                "summary": "Delete User",
+                "summary": "List Notification Deliveries By User",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "User ID",
-                        "name": "id",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                },
-                "security": [
+                    },
                     {
-                        "Bearer": []
-                    }
-                ]
-            }
-        }
-    },
-    "definitions": {
-        "dto.CreateNotificationChannelRequest": {
-            "type": "object",
-            "properties": {
-                "channel_type": {
-                    "type": "string"
-                },
-                "config": {
-                    "type": "object",
-                    "additionalProperties": {}
-                },
-                "enable": {
-                    "type": "boolean"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "priority": {
-                    "type": "integer"
-                },
-                "user_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.CreateStreamerRequest": {
-            "type": "object",
+                        "enum": [
+                            "pending",
+                            "processing",
+                            "retrying",
+                            "sent",
+                            "dead",
+                            "held",
+                            "skipped",
+                            "batched",
+                            "digested",
+                            "throttled"
+                        ],
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Channel ID",
+                        "name": "channel_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Streamer ID",
+                        "name": "streamer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Follow ID",
+                        "name": "follow_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_NotificationDeliveryResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-deliveries/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationDelivery"
+                ],
+                "summary": "Get Notification Delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationDeliveryResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-deliveries/{id}/ack": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationDelivery"
+                ],
+                "summary": "Acknowledge Notification Delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationDeliveryResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-deliveries/{id}/resend": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationDelivery"
+                ],
+                "summary": "Resend Notification Delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationDeliveryResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-preferences/users/{user_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationPreference"
+                ],
+                "summary": "Get Notification Preference",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationPreference"
+                ],
+                "summary": "Update Notification Preference",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preference data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateNotificationPreferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-preferences/users/{user_id}/dnd": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationPreference"
+                ],
+                "summary": "Enable Do Not Disturb",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Duration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetDoNotDisturbRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationPreference"
+                ],
+                "summary": "Disable Do Not Disturb",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-templates/preview": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationTemplate"
+                ],
+                "summary": "Preview Notification Template",
+                "parameters": [
+                    {
+                        "description": "Template and optional streamer",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PreviewNotificationTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationTemplatePreviewResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/notification-templates/variables": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NotificationTemplate"
+                ],
+                "summary": "List Notification Template Variables",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.NotificationTemplateVariableResponse"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/platforms": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StreamingPlatform"
+                ],
+                "summary": "List Streaming Platforms",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_StreamingPlatformResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StreamingPlatform"
+                ],
+                "summary": "Create Streaming Platform",
+                "parameters": [
+                    {
+                        "description": "Platform data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateStreamingPlatformRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamingPlatformResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/platforms/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StreamingPlatform"
+                ],
+                "summary": "Get Streaming Platform",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamingPlatformResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StreamingPlatform"
+                ],
+                "summary": "Update Streaming Platform",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Platform data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStreamingPlatformRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamingPlatformResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StreamingPlatform"
+                ],
+                "summary": "Delete Streaming Platform",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/streamers": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streamer"
+                ],
+                "summary": "List Streamers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_StreamerResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streamer"
+                ],
+                "summary": "Create Streamer",
+                "parameters": [
+                    {
+                        "description": "Streamer data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateStreamerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamerResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/streamers/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streamer"
+                ],
+                "summary": "Get Streamer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Streamer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamerResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streamer"
+                ],
+                "summary": "Update Streamer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Streamer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Streamer data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStreamerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamerResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streamer"
+                ],
+                "summary": "Delete Streamer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Streamer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/streamers/{platform_type}/{platform_streamer_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Streamer"
+                ],
+                "summary": "Get Streamer By Platform",
+                "parameters": [
+                    {
+                        "enum": [
+                            "douyu",
+                            "huya",
+                            "bilibili"
+                        ],
+                        "type": "string",
+                        "description": "Platform type",
+                        "name": "platform_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform streamer ID",
+                        "name": "platform_streamer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Refresh from platform and update database",
+                        "name": "refresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamerResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/user": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create User",
+                "parameters": [
+                    {
+                        "description": "User info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/user/list": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "List Users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_UserResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/webpush/subscriptions": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebPush"
+                ],
+                "summary": "Subscribe Web Push",
+                "parameters": [
+                    {
+                        "description": "Push subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubscribeWebPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebPushSubscriptionResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/webpush/subscriptions/users/{user_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebPush"
+                ],
+                "summary": "List Web Push Subscriptions By User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaginationResponse-dto_WebPushSubscriptionResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/webpush/subscriptions/{id}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebPush"
+                ],
+                "summary": "Unsubscribe Web Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                },
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ]
+            }
+        },
+        "/webpush/vapid-public-key": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebPush"
+                ],
+                "summary": "Get VAPID Public Key",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VAPIDPublicKeyResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.CreateNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "body_template": {
+                    "type": "string"
+                },
+                "channel_type": {
+                    "type": "string"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "daily_summary_at": {
+                    "description": "DailySummaryAt is the local HH:MM time of the daily summary of who streamed; empty turns it off.",
+                    "type": "string"
+                },
+                "daily_summary_timezone": {
+                    "type": "string"
+                },
+                "digest_window_seconds": {
+                    "description": "DigestWindowSeconds batches go-live notifications into one message per window; 0 sends each right away.",
+                    "type": "integer",
+                    "maximum": 21600,
+                    "minimum": 60
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "title_template": {
+                    "description": "TitleTemplate and BodyTemplate word the notification from the stream variables, e.g. .Streamer.\nWeCom and DingTalk robots then arrange the result with config.title_layout and config.body_layout.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreateStreamerRequest": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "platform_streamer_id": {
+                    "type": "string"
+                },
+                "platform_type": {
+                    "type": "string"
+                },
+                "room_url": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateStreamingPlatformRequest": {
+            "type": "object",
+            "properties": {
+                "base_url": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "logo_url": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateUserFollowedStreamerRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "always_notify": {
+                    "type": "boolean"
+                },
+                "body_template": {
+                    "type": "string"
+                },
+                "channel_overrides": {
+                    "description": "ChannelOverrides lays config fields over the config of the channel with the given ID for this\nfollow's notifications. Only fields the channel type schema marks x-overridable are accepted.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
+                },
+                "escalate_after_seconds": {
+                    "type": "integer"
+                },
+                "filter": {
+                    "description": "Filter limits which go-live events notify; null lets every broadcast through.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.NotificationFilterDTO"
+                        }
+                    ]
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "notification_channel_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "notifications_enabled": {
+                    "type": "boolean"
+                },
+                "notify_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notify_stream_end": {
+                    "type": "boolean"
+                },
+                "notify_title_change": {
+                    "type": "boolean"
+                },
+                "routing_mode": {
+                    "description": "RoutingMode overrides the user's routing for this follow; empty inherits it.",
+                    "type": "string",
+                    "enum": [
+                        "broadcast",
+                        "first_success",
+                        "escalate"
+                    ]
+                },
+                "streamer_id": {
+                    "type": "integer"
+                },
+                "title_template": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "email",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "zh-CN"
+                    ]
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.InboxMarkAllReadResponse": {
+            "type": "object",
+            "properties": {
+                "marked": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.InboxMessageResponse": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "icon_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "streamer_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.InboxUnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LiveEventResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1748808000000000
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "stream.online",
+                        "stream.offline",
+                        "stream.title_changed",
+                        "stream.category_changed",
+                        "notification.delivered",
+                        "notification.failed"
+                    ]
+                }
+            }
+        },
+        "dto.LiveStatusResponse": {
+            "type": "object",
+            "properties": {
+                "cover_image": {
+                    "type": "string"
+                },
+                "game_name": {
+                    "type": "string"
+                },
+                "is_live": {
+                    "type": "boolean"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "viewers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
             "properties": {
-                "avatar_url": {
+                "password": {
                     "type": "string"
                 },
-                "bio": {
+                "username": {
                     "type": "string"
-                },
-                "display_name": {
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expire_at": {
                     "type": "string"
                 },
-                "platform_streamer_id": {
+                "token": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.NotificationActionResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged": {
+                    "type": "boolean"
                 },
-                "platform_type": {
-                    "type": "string"
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "ack",
+                        "snooze",
+                        "mute",
+                        "unfollow"
+                    ]
+                },
+                "follow_id": {
+                    "type": "integer"
                 },
-                "room_url": {
+                "muted_session_id": {
+                    "type": "integer"
+                },
+                "snoozed_until": {
+                    "description": "SnoozedUntil and MutedSessionID are the follow's state after the action.",
                     "type": "string"
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "unfollowed": {
+                    "type": "boolean"
                 }
             }
         },
-        "dto.CreateStreamingPlatformRequest": {
+        "dto.NotificationChannelResponse": {
             "type": "object",
             "properties": {
-                "base_url": {
+                "body_template": {
                     "type": "string"
                 },
-                "description": {
+                "channel_type": {
                     "type": "string"
                 },
-                "enabled": {
+                "config": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "consecutive_failures": {
+                    "type": "integer"
+                },
+                "daily_summary_at": {
+                    "type": "string"
+                },
+                "daily_summary_sent_at": {
+                    "type": "string"
+                },
+                "daily_summary_timezone": {
+                    "type": "string"
+                },
+                "digest_window_seconds": {
+                    "type": "integer"
+                },
+                "disabled_at": {
+                    "type": "string"
+                },
+                "enable": {
                     "type": "boolean"
                 },
-                "logo_url": {
+                "health": {
+                    "description": "Health is healthy, failing while recent sends fail, or unhealthy once the channel was disabled\nfor failing too often in a row; enabling it again resets it.",
+                    "type": "string",
+                    "enum": [
+                        "healthy",
+                        "failing",
+                        "unhealthy"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
                     "type": "string"
                 },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "last_failure_at": {
+                    "type": "string"
+                },
+                "last_success_at": {
+                    "type": "string"
+                },
+                "last_test_error": {
+                    "type": "string"
+                },
+                "last_test_success": {
+                    "type": "boolean"
+                },
+                "last_tested_at": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
@@ -1200,124 +2657,283 @@ const docTemplate = `{
                 "priority": {
                     "type": "integer"
                 },
-                "type": {
+                "title_template": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "verification_expires_at": {
                     "type": "string"
+                },
+                "verification_sent_at": {
+                    "description": "VerificationSentAt is empty when sending the code failed; request a new one.",
+                    "type": "string"
+                },
+                "verified": {
+                    "description": "Verified is false while the channel waits for the code sent through it; it stays disabled until then.",
+                    "type": "boolean"
                 }
             }
         },
-        "dto.CreateUserFollowedStreamerRequest": {
+        "dto.NotificationChannelTestResponse": {
             "type": "object",
             "properties": {
-                "alias": {
+                "error": {
                     "type": "string"
                 },
-                "notes": {
-                    "type": "string"
+                "latency_ms": {
+                    "type": "integer"
                 },
-                "notification_channel_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "response": {
+                    "type": "object",
+                    "additionalProperties": {}
                 },
-                "notifications_enabled": {
+                "success": {
                     "type": "boolean"
                 },
+                "tested_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationChannelTypeResponse": {
+            "type": "object",
+            "properties": {
+                "channel_type": {
+                    "type": "string"
+                },
+                "schema": {
+                    "description": "Schema is a JSON Schema describing the config object of this channel type.",
+                    "type": "object"
+                }
+            }
+        },
+        "dto.NotificationDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "channel_id": {
+                    "type": "integer"
+                },
+                "channel_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "digest_id": {
+                    "description": "DigestID is the digest delivery a batched or throttled notification went out with.",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "escalate_after_seconds": {
+                    "type": "integer"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "follow_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "response": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "route_id": {
+                    "description": "Route fields are set for notifications sent with a sequential routing mode.",
+                    "type": "string"
+                },
+                "route_mode": {
+                    "type": "string"
+                },
+                "route_step": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
                 "streamer_id": {
                     "type": "integer"
                 },
+                "updated_at": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "integer"
                 }
             }
         },
-        "dto.CreateUserRequest": {
+        "dto.NotificationFilterDTO": {
             "type": "object",
-            "required": [
-                "confirm_password",
-                "email",
-                "password",
-                "username"
-            ],
             "properties": {
-                "confirm_password": {
-                    "type": "string"
+                "blocked_categories": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "email": {
-                    "type": "string"
+                "categories": {
+                    "description": "Categories only lets these categories through; empty allows any.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 32,
-                    "minLength": 8
+                "min_viewers": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "username": {
+                "title_exclude": {
+                    "description": "TitleExclude rejects titles containing any of the keywords.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title_include": {
+                    "description": "TitleInclude requires the title to contain at least one of the keywords.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title_pattern": {
+                    "description": "TitlePattern is a regular expression the title has to match.",
                     "type": "string",
-                    "maxLength": 32,
-                    "minLength": 3
+                    "maxLength": 200,
+                    "example": "(?i)elden ring"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.NotificationPreferenceResponse": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "do_not_disturb_until": {
                     "type": "string"
                 },
-                "username": {
+                "escalate_after_seconds": {
+                    "type": "integer"
+                },
+                "quiet_hours": {
+                    "$ref": "#/definitions/dto.QuietHoursDTO"
+                },
+                "routing_mode": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.LoginResponse": {
+        "dto.NotificationTemplatePreviewResponse": {
             "type": "object",
             "properties": {
-                "expire_at": {
+                "body": {
                     "type": "string"
                 },
-                "token": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "dto.NotificationChannelResponse": {
+        "dto.NotificationTemplateVariableResponse": {
             "type": "object",
             "properties": {
-                "channel_type": {
+                "description": {
                     "type": "string"
                 },
-                "config": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "example": {
+                    "type": "string"
                 },
-                "enable": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PaginationResponse-dto_InboxMessageResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InboxMessageResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PaginationResponse-dto_NotificationChannelResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.NotificationChannelResponse"
+                    }
                 },
-                "id": {
+                "page": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "page_size": {
+                    "type": "integer"
                 },
-                "priority": {
+                "total": {
                     "type": "integer"
                 },
-                "user_id": {
+                "total_pages": {
                     "type": "integer"
                 }
             }
         },
-        "dto.PaginationResponse-dto_NotificationChannelResponse": {
+        "dto.PaginationResponse-dto_NotificationDeliveryResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.NotificationChannelResponse"
+                        "$ref": "#/definitions/dto.NotificationDeliveryResponse"
                     }
                 },
                 "page": {
@@ -1426,6 +3042,89 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.PaginationResponse-dto_WebPushSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebPushSubscriptionResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PreviewNotificationTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "body_template": {
+                    "type": "string"
+                },
+                "streamer_id": {
+                    "type": "integer"
+                },
+                "title_template": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.QuietHoursDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "drop",
+                        "delay",
+                        "passive"
+                    ]
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.QuietHoursRuleDTO"
+                    }
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA name such as \"Asia/Shanghai\"; empty means UTC.",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.QuietHoursRuleDTO": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "07:00"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "23:00"
+                },
+                "weekdays": {
+                    "description": "Weekdays the period starts on, 0 (Sunday) to 6; empty means every day.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "dto.RegisterRequest": {
             "type": "object",
             "required": [
@@ -1453,6 +3152,40 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.ReplayDeadLettersRequest": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "integer"
+                },
+                "streamer_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ReplayDeadLettersResponse": {
+            "type": "object",
+            "properties": {
+                "replayed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SetDoNotDisturbRequest": {
+            "type": "object",
+            "required": [
+                "minutes"
+            ],
+            "properties": {
+                "minutes": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
         "dto.StreamerResponse": {
             "type": "object",
             "properties": {
@@ -1468,6 +3201,15 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
+                "last_live_synced_at": {
+                    "type": "string"
+                },
+                "last_profile_synced_at": {
+                    "type": "string"
+                },
+                "live_status": {
+                    "$ref": "#/definitions/dto.LiveStatusResponse"
+                },
                 "platform_streamer_id": {
                     "type": "string"
                 },
@@ -1520,9 +3262,45 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.SubscribeWebPushRequest": {
+            "type": "object",
+            "required": [
+                "endpoint",
+                "user_id"
+            ],
+            "properties": {
+                "endpoint": {
+                    "type": "string"
+                },
+                "keys": {
+                    "$ref": "#/definitions/dto.WebPushSubscriptionKeys"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.TestNotificationChannelConfigRequest": {
+            "type": "object",
+            "required": [
+                "channel_type"
+            ],
+            "properties": {
+                "channel_type": {
+                    "type": "string"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
         "dto.UpdateNotificationChannelRequest": {
             "type": "object",
             "properties": {
+                "body_template": {
+                    "type": "string"
+                },
                 "channel_type": {
                     "type": "string"
                 },
@@ -1530,6 +3308,19 @@ const docTemplate = `{
                     "type": "object",
                     "additionalProperties": {}
                 },
+                "daily_summary_at": {
+                    "description": "DailySummaryAt is the local HH:MM time of the daily summary of who streamed; empty turns it off.",
+                    "type": "string"
+                },
+                "daily_summary_timezone": {
+                    "type": "string"
+                },
+                "digest_window_seconds": {
+                    "description": "DigestWindowSeconds batches go-live notifications into one message per window; 0 sends each right away.",
+                    "type": "integer",
+                    "maximum": 21600,
+                    "minimum": 60
+                },
                 "enable": {
                     "type": "boolean"
                 },
@@ -1542,11 +3333,39 @@ const docTemplate = `{
                 "priority": {
                     "type": "integer"
                 },
+                "title_template": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "integer"
                 }
             }
         },
+        "dto.UpdateNotificationPreferenceRequest": {
+            "type": "object",
+            "properties": {
+                "escalate_after_seconds": {
+                    "description": "EscalateAfterSeconds is used by the escalate mode; 0 means the default of 300.",
+                    "type": "integer"
+                },
+                "quiet_hours": {
+                    "description": "QuietHours null turns quiet hours off.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.QuietHoursDTO"
+                        }
+                    ]
+                },
+                "routing_mode": {
+                    "type": "string",
+                    "enum": [
+                        "broadcast",
+                        "first_success",
+                        "escalate"
+                    ]
+                }
+            }
+        },
         "dto.UpdateStreamerRequest": {
             "type": "object",
             "properties": {
@@ -1620,6 +3439,31 @@ const docTemplate = `{
                 "alias": {
                     "type": "string"
                 },
+                "always_notify": {
+                    "type": "boolean"
+                },
+                "body_template": {
+                    "type": "string"
+                },
+                "channel_overrides": {
+                    "description": "ChannelOverrides lays config fields over the config of the channel with the given ID for this\nfollow's notifications. Only fields the channel type schema marks x-overridable are accepted.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
+                },
+                "escalate_after_seconds": {
+                    "type": "integer"
+                },
+                "filter": {
+                    "description": "Filter limits which go-live events notify; null lets every broadcast through.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.NotificationFilterDTO"
+                        }
+                    ]
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -1634,6 +3478,30 @@ const docTemplate = `{
                 },
                 "notifications_enabled": {
                     "type": "boolean"
+                },
+                "notify_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notify_stream_end": {
+                    "type": "boolean"
+                },
+                "notify_title_change": {
+                    "type": "boolean"
+                },
+                "routing_mode": {
+                    "description": "RoutingMode overrides the user's routing for this follow; empty inherits it.",
+                    "type": "string",
+                    "enum": [
+                        "broadcast",
+                        "first_success",
+                        "escalate"
+                    ]
+                },
+                "title_template": {
+                    "type": "string"
                 }
             }
         },
@@ -1657,6 +3525,13 @@ const docTemplate = `{
                     "type": "integer",
                     "minimum": 1
                 },
+                "locale": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "zh-CN"
+                    ]
+                },
                 "password": {
                     "type": "string",
                     "maxLength": 32,
@@ -1675,9 +3550,37 @@ const docTemplate = `{
                 "alias": {
                     "type": "string"
                 },
+                "always_notify": {
+                    "type": "boolean"
+                },
+                "body_template": {
+                    "type": "string"
+                },
+                "channel_overrides": {
+                    "description": "ChannelOverrides lays config fields over the config of the channel with the given ID for this\nfollow's notifications. Only fields the channel type schema marks x-overridable are accepted.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
+                },
+                "escalate_after_seconds": {
+                    "type": "integer"
+                },
+                "filter": {
+                    "description": "Filter limits which go-live events notify; null lets every broadcast through.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.NotificationFilterDTO"
+                        }
+                    ]
+                },
                 "id": {
                     "type": "integer"
                 },
+                "muted_session_id": {
+                    "type": "integer"
+                },
                 "notes": {
                     "type": "string"
                 },
@@ -1690,9 +3593,37 @@ const docTemplate = `{
                 "notifications_enabled": {
                     "type": "boolean"
                 },
+                "notify_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notify_stream_end": {
+                    "type": "boolean"
+                },
+                "notify_title_change": {
+                    "type": "boolean"
+                },
+                "routing_mode": {
+                    "description": "RoutingMode overrides the user's routing for this follow; empty inherits it.",
+                    "type": "string",
+                    "enum": [
+                        "broadcast",
+                        "first_success",
+                        "escalate"
+                    ]
+                },
+                "snoozed_until": {
+                    "description": "SnoozedUntil and MutedSessionID are set from the action links in notifications and hold back\nnotifications until then, or about that one broadcast.",
+                    "type": "string"
+                },
                 "streamer_id": {
                     "type": "integer"
                 },
+                "title_template": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "integer"
                 }
@@ -1710,6 +3641,9 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
+                "locale": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -1717,6 +3651,63 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dto.VAPIDPublicKeyResponse": {
+            "type": "object",
+            "properties": {
+                "public_key": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.VerifyNotificationChannelRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WebPushSubscriptionKeys": {
+            "type": "object",
+            "required": [
+                "auth",
+                "p256dh"
+            ],
+            "properties": {
+                "auth": {
+                    "type": "string"
+                },
+                "p256dh": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.WebPushSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
         }
     },
     "securityDefinitions": {
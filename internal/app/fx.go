@@ -7,6 +7,7 @@ import (
 	"github.com/ryuyb/fusion/internal/infrastructure/http"
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/jwt"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/keyring"
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/logger"
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/validator"
 	"github.com/ryuyb/fusion/internal/infrastructure/scheduler"
@@ -18,6 +19,7 @@ var AppModule = fx.Module("app",
 	logger.Module,
 	validator.Module,
 	jwt.Module,
+	keyring.Module,
 	scheduler.Module,
 	external.Module,
 
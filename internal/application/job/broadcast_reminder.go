@@ -1,17 +1,18 @@
 package job
 
 import (
+	"cmp"
 	"context"
-	"fmt"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/ryuyb/fusion/internal/core/domain"
 	coreExternal "github.com/ryuyb/fusion/internal/core/port/external"
 	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
 	coreService "github.com/ryuyb/fusion/internal/core/port/service"
-	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
 	appErrors "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"go.uber.org/zap"
 )
 
@@ -24,29 +25,47 @@ const (
 )
 
 type BroadcastReminder struct {
-	logger                *zap.Logger
-	streamerRepo          coreRepo.StreamerRepository
-	followRepo            coreRepo.UserFollowedStreamerRepository
-	channelRepo           coreRepo.NotificationChannelRepository
-	streamerService       coreService.StreamerService
-	notificationProviders *notificationInfra.NotificationProviderManager
+	logger            *zap.Logger
+	reminder          config.ReminderConfig
+	streamerRepo      coreRepo.StreamerRepository
+	followRepo        coreRepo.UserFollowedStreamerRepository
+	channelRepo       coreRepo.NotificationChannelRepository
+	sessionRepo       coreRepo.StreamSessionRepository
+	userRepo          coreRepo.UserRepository
+	streamerService   coreService.StreamerService
+	deliveryService   coreService.NotificationDeliveryService
+	preferenceService coreService.NotificationPreferenceService
+	inboxService      coreService.InboxService
+	events            coreService.LiveEventService
 }
 
 func NewBroadcastReminder(
+	cfg *config.Config,
 	logger *zap.Logger,
 	streamerRepo coreRepo.StreamerRepository,
 	followRepo coreRepo.UserFollowedStreamerRepository,
 	channelRepo coreRepo.NotificationChannelRepository,
+	sessionRepo coreRepo.StreamSessionRepository,
+	userRepo coreRepo.UserRepository,
 	streamerService coreService.StreamerService,
-	notificationProviders *notificationInfra.NotificationProviderManager,
+	deliveryService coreService.NotificationDeliveryService,
+	preferenceService coreService.NotificationPreferenceService,
+	inboxService coreService.InboxService,
+	events coreService.LiveEventService,
 ) *BroadcastReminder {
 	return &BroadcastReminder{
-		logger:                logger,
-		streamerRepo:          streamerRepo,
-		followRepo:            followRepo,
-		channelRepo:           channelRepo,
-		streamerService:       streamerService,
-		notificationProviders: notificationProviders,
+		logger:            logger,
+		reminder:          cfg.Notification.Reminder,
+		streamerRepo:      streamerRepo,
+		followRepo:        followRepo,
+		channelRepo:       channelRepo,
+		sessionRepo:       sessionRepo,
+		userRepo:          userRepo,
+		streamerService:   streamerService,
+		deliveryService:   deliveryService,
+		preferenceService: preferenceService,
+		inboxService:      inboxService,
+		events:            events,
 	}
 }
 
@@ -55,7 +74,9 @@ func (j *BroadcastReminder) Name() string {
 }
 
 func (j *BroadcastReminder) Execute(ctx context.Context) error {
-	resolver := newChannelResolver(j.channelRepo)
+	resolver := newChannelResolver(j.channelRepo, j.inboxService)
+	preferences := newPreferenceResolver(j.preferenceService)
+	locales := newLocaleResolver(j.userRepo)
 
 	offset := 0
 	for {
@@ -72,7 +93,7 @@ func (j *BroadcastReminder) Execute(ctx context.Context) error {
 		}
 
 		for _, streamer := range streamers {
-			if err := j.processStreamer(ctx, streamer, resolver); err != nil {
+			if err := j.processStreamer(ctx, streamer, resolver, preferences, locales); err != nil {
 				j.logger.Warn("failed to process streamer for reminders",
 					zap.Int64("streamer_id", streamer.ID),
 					zap.Error(err))
@@ -88,7 +109,7 @@ func (j *BroadcastReminder) Execute(ctx context.Context) error {
 	return nil
 }
 
-func (j *BroadcastReminder) processStreamer(ctx context.Context, streamer *domain.Streamer, resolver *channelResolver) error {
+func (j *BroadcastReminder) processStreamer(ctx context.Context, streamer *domain.Streamer, resolver *channelResolver, preferences *preferenceResolver, locales *localeResolver) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -97,7 +118,17 @@ func (j *BroadcastReminder) processStreamer(ctx context.Context, streamer *domai
 	if err != nil {
 		return err
 	}
-	if refreshed == nil || !refreshed.LiveStatus.IsLive {
+	if refreshed == nil {
+		return nil
+	}
+	now := time.Now()
+	session, err := j.trackSession(ctx, refreshed, now)
+	if err != nil {
+		j.logger.Warn("failed to track stream session",
+			zap.Int64("streamer_id", refreshed.ID),
+			zap.Error(err))
+	}
+	if session.ended == nil && !refreshed.LiveStatus.IsLive {
 		return nil
 	}
 
@@ -110,7 +141,30 @@ func (j *BroadcastReminder) processStreamer(ctx context.Context, streamer *domai
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if err := j.processFollower(ctx, follow, refreshed, resolver); err != nil {
+		j.publishEvents(ctx, follow, refreshed, session)
+		if !follow.NotificationsEnabled {
+			continue
+		}
+		if session.ended != nil && follow.NotifyStreamEnd && !follow.IsSilenced(session.ended.ID, now) {
+			if err := j.processStreamEnd(ctx, follow, refreshed, session.ended, resolver, preferences, locales); err != nil {
+				j.logger.Warn("failed to process stream end notification",
+					zap.Int64("follow_id", follow.ID),
+					zap.Int64("streamer_id", refreshed.ID),
+					zap.Error(err))
+			}
+		}
+		if !refreshed.LiveStatus.IsLive || follow.IsSilenced(session.currentID(), now) {
+			continue
+		}
+		if picked := follow.StreamChanges(session.changes); len(picked) > 0 {
+			if err := j.processStreamChange(ctx, follow, refreshed, picked, resolver, preferences, locales); err != nil {
+				j.logger.Warn("failed to process stream change notification",
+					zap.Int64("follow_id", follow.ID),
+					zap.Int64("streamer_id", refreshed.ID),
+					zap.Error(err))
+			}
+		}
+		if err := j.processFollower(ctx, follow, refreshed, session.current, resolver, preferences, locales); err != nil {
 			j.logger.Warn("failed to process follower notification",
 				zap.Int64("follow_id", follow.ID),
 				zap.Int64("streamer_id", refreshed.ID),
@@ -120,6 +174,70 @@ func (j *BroadcastReminder) processStreamer(ctx context.Context, streamer *domai
 	return nil
 }
 
+// sessionUpdate is what trackSession made of a live status check.
+type sessionUpdate struct {
+	// current is the ongoing session; nil while the streamer is offline or when tracking failed.
+	current *domain.StreamSession
+	// started reports whether current was opened by this check, i.e. the streamer just went live.
+	started bool
+	// ended is the session that ended with this check, if any.
+	ended *domain.StreamSession
+	// changes are the title and category changes of the current session that settled since the last check.
+	changes []domain.StreamChange
+}
+
+// currentID is the ID of the ongoing session, zero when it is not known.
+func (u sessionUpdate) currentID() int64 {
+	if u.current == nil {
+		return 0
+	}
+	return u.current.ID
+}
+
+// trackSession keeps the streamer's StreamSession in step with the live status just fetched: it is
+// opened when the streamer goes live, extended while they stay live and ended once they are seen
+// offline or have started a new broadcast. The persisted session is what detects the live to offline
+// transition, so it survives restarts. Dropping offline for less than the reconnect grace keeps the
+// session going, so a crashed and restarted stream is still one broadcast.
+func (j *BroadcastReminder) trackSession(ctx context.Context, streamer *domain.Streamer, now time.Time) (sessionUpdate, error) {
+	status := streamer.LiveStatus
+	grace := j.reminder.ReconnectGrace
+	open, err := j.sessionRepo.FindOpenByStreamerId(ctx, streamer.ID)
+	if err != nil && !appErrors.IsNotFoundError(err) {
+		return sessionUpdate{}, err
+	}
+
+	var update sessionUpdate
+	if open != nil {
+		if status.IsLive && open.IsSameBroadcast(status, grace) {
+			open.Observe(status, now)
+			changes := open.SettledChanges(now)
+			if _, err := j.sessionRepo.Update(ctx, open); err != nil {
+				return sessionUpdate{}, err
+			}
+			return sessionUpdate{current: open, changes: changes}, nil
+		}
+		if !status.IsLive && open.OfflineWithin(now, grace) {
+			return sessionUpdate{}, nil
+		}
+		// The broadcast ended somewhere after it was last seen live; taking that time keeps a gap in the
+		// checks, e.g. downtime, out of the session.
+		open.End(open.LastSeenAt)
+		if update.ended, err = j.sessionRepo.Update(ctx, open); err != nil {
+			return sessionUpdate{}, err
+		}
+	}
+
+	if !status.IsLive {
+		return update, nil
+	}
+	if update.current, err = j.sessionRepo.Create(ctx, domain.NewStreamSession(streamer, now)); err != nil {
+		return update, err
+	}
+	update.started = true
+	return update, nil
+}
+
 func (j *BroadcastReminder) listFollowers(ctx context.Context, streamerID int64) ([]*domain.UserFollowedStreamer, error) {
 	var (
 		results []*domain.UserFollowedStreamer
@@ -137,11 +255,7 @@ func (j *BroadcastReminder) listFollowers(ctx context.Context, streamerID int64)
 		if len(follows) == 0 {
 			break
 		}
-		for _, follow := range follows {
-			if follow.NotificationsEnabled {
-				results = append(results, follow)
-			}
-		}
+		results = append(results, follows...)
 		offset += len(follows)
 		if offset >= total {
 			break
@@ -150,107 +264,340 @@ func (j *BroadcastReminder) listFollowers(ctx context.Context, streamerID int64)
 	return results, nil
 }
 
-func (j *BroadcastReminder) processFollower(ctx context.Context, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, resolver *channelResolver) error {
-	if !j.shouldSend(follow, streamer) {
+// publishEvents tells the follower's open event streams how the streamer changed with this check. It
+// does so whether or not the follow has notifications enabled.
+func (j *BroadcastReminder) publishEvents(ctx context.Context, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, session sessionUpdate) {
+	if session.ended != nil {
+		data := domain.NewStreamLiveEventData(follow, streamer)
+		data["is_live"] = false
+		data["title"] = session.ended.Title
+		data["category"] = session.ended.GameName
+		j.events.Publish(ctx, follow.UserID, domain.LiveEventStreamOffline, data)
+	}
+	if !streamer.LiveStatus.IsLive {
+		return
+	}
+	if session.started {
+		j.events.Publish(ctx, follow.UserID, domain.LiveEventStreamOnline, domain.NewStreamLiveEventData(follow, streamer))
+	}
+	for _, change := range session.changes {
+		eventType, data := domain.NewStreamChangeLiveEvent(follow, streamer, change)
+		j.events.Publish(ctx, follow.UserID, eventType, data)
+	}
+}
+
+func (j *BroadcastReminder) processFollower(ctx context.Context, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, session *domain.StreamSession, resolver *channelResolver, preferences *preferenceResolver, locales *localeResolver) error {
+	if !j.shouldSend(follow, streamer, session, time.Now()) {
 		return nil
 	}
 
-	channels, err := resolver.Resolve(ctx, follow)
+	held, dispatched, err := j.dispatch(ctx, follow, resolver, preferences, locales, func(channel *domain.NotificationChannel, locale i18n.Locale) *coreExternal.NotificationData {
+		return j.buildNotificationData(locale, follow, channel, streamer)
+	})
 	if err != nil {
 		return err
 	}
-	if len(channels) == 0 {
+	switch {
+	case held == domain.QuietHoursDelay:
+		// Leaving the follow unmarked makes a later run send it once the quiet period is over,
+		// provided the stream is still live by then.
+		return nil
+	case held == domain.QuietHoursDrop, dispatched:
+		// Once dispatched the outbox owns retries and failover, so the follow counts as notified for
+		// this broadcast. Which of the routed channels actually delivered is recorded per delivery.
+		return j.markNotified(ctx, follow)
+	default:
 		return nil
 	}
+}
+
+// processStreamEnd sends follows that opted in a summary of the session that just ended. The summary is
+// only worth sending right away, so quiet hours that delay notifications drop it.
+func (j *BroadcastReminder) processStreamEnd(ctx context.Context, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, session *domain.StreamSession, resolver *channelResolver, preferences *preferenceResolver, locales *localeResolver) error {
+	_, _, err := j.dispatch(ctx, follow, resolver, preferences, locales, func(_ *domain.NotificationChannel, locale i18n.Locale) *coreExternal.NotificationData {
+		return j.buildStreamEndedData(locale, follow, streamer, session)
+	})
+	return err
+}
 
-	data := buildNotificationData(follow, streamer)
-	sent := false
+// processStreamChange tells a follow about the title or category changes it opted in to. Like the
+// stream end summary it is dropped rather than delayed by quiet hours.
+func (j *BroadcastReminder) processStreamChange(ctx context.Context, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, changes []domain.StreamChange, resolver *channelResolver, preferences *preferenceResolver, locales *localeResolver) error {
+	_, _, err := j.dispatch(ctx, follow, resolver, preferences, locales, func(_ *domain.NotificationChannel, locale i18n.Locale) *coreExternal.NotificationData {
+		return j.buildStreamChangeData(locale, follow, streamer, changes)
+	})
+	return err
+}
+
+// dispatch queues the notification build renders for each of the follow's enabled channels, in the
+// user's locale and honouring their quiet hours and routing. held is the quiet hours action that kept the notification back,
+// empty if it went out; dispatched reports whether any delivery was queued.
+func (j *BroadcastReminder) dispatch(
+	ctx context.Context,
+	follow *domain.UserFollowedStreamer,
+	resolver *channelResolver,
+	preferences *preferenceResolver,
+	locales *localeResolver,
+	build func(channel *domain.NotificationChannel, locale i18n.Locale) *coreExternal.NotificationData,
+) (held domain.QuietHoursAction, dispatched bool, err error) {
+	channels, err := resolver.Resolve(ctx, follow)
+	if err != nil {
+		return "", false, err
+	}
+	if len(channels) == 0 {
+		return "", false, nil
+	}
+
+	preference, err := preferences.Resolve(ctx, follow.UserID)
+	if err != nil {
+		return "", false, err
+	}
+	severity := domain.NotificationSeverityNormal
+	if action, quiet := preference.QuietAction(time.Now(), follow.AlwaysNotify); quiet {
+		if action != domain.QuietHoursPassive {
+			return action, false, nil
+		}
+		severity = domain.NotificationSeverityLow
+	}
+	locale, err := locales.Resolve(ctx, follow.UserID)
+	if err != nil {
+		return "", false, err
+	}
+	routing := preference.Routing
+	if follow.Routing != nil {
+		routing = *follow.Routing
+	}
+
+	targets := make([]coreService.DeliveryTarget, 0, len(channels))
 	for _, channel := range channels {
 		if !channel.Enable {
 			continue
 		}
-		provider, err := j.notificationProviders.GetProvider(channel.ChannelType)
-		if err != nil {
-			j.logger.Warn("notification provider unavailable",
-				zap.String("channel_type", string(channel.ChannelType)),
-				zap.Error(err))
-			continue
-		}
-		if err := provider.Send(ctx, channel, data); err != nil {
-			j.logger.Warn("failed to send notification",
-				zap.Int64("channel_id", channel.ID),
-				zap.Int64("follow_id", follow.ID),
-				zap.Error(err))
-			continue
-		}
-		sent = true
+		data := build(channel, locale)
+		data.Severity = severity
+		targets = append(targets, coreService.DeliveryTarget{Channel: channel, Data: data})
 	}
-
-	if !sent {
-		return nil
+	deliveries, err := j.deliveryService.Dispatch(ctx, routing, follow, targets)
+	if err != nil {
+		return "", false, err
 	}
+	return "", len(deliveries) > 0, nil
+}
 
+func (j *BroadcastReminder) markNotified(ctx context.Context, follow *domain.UserFollowedStreamer) error {
 	now := time.Now()
 	follow.LastNotificationSentAt = &now
-	_, err = j.followRepo.Update(ctx, follow)
+	_, err := j.followRepo.Update(ctx, follow)
 	return err
 }
 
-func (j *BroadcastReminder) shouldSend(follow *domain.UserFollowedStreamer, streamer *domain.Streamer) bool {
+// shouldSend reports whether the follow still has to hear about the current broadcast, which session
+// identifies when it is known. A broadcast the follow's filter rejects stays unnotified, so it notifies
+// once it passes later on, e.g. after switching to an allowed category or reaching the minimum viewers.
+// The re-notify cooldown holds back a new broadcast shortly after the previous notification.
+func (j *BroadcastReminder) shouldSend(follow *domain.UserFollowedStreamer, streamer *domain.Streamer, session *domain.StreamSession, now time.Time) bool {
 	if !streamer.LiveStatus.IsLive {
 		return false
 	}
+	if follow.Filter != nil && !follow.Filter.Allows(streamer.LiveStatus) {
+		return false
+	}
 	if follow.LastNotificationSentAt == nil {
 		return true
 	}
+	lastSent := *follow.LastNotificationSentAt
+	if now.Sub(lastSent) < j.reminder.RenotifyCooldown {
+		return false
+	}
+	if session != nil {
+		return session.StartedAt.After(lastSent)
+	}
 	if !streamer.LiveStatus.StartTime.IsZero() {
-		return streamer.LiveStatus.StartTime.After(*follow.LastNotificationSentAt)
+		return streamer.LiveStatus.StartTime.After(lastSent)
 	}
-	return streamer.LastLiveSyncedAt.After(*follow.LastNotificationSentAt)
+	return streamer.LastLiveSyncedAt.After(lastSent)
 }
 
-func buildNotificationData(follow *domain.UserFollowedStreamer, streamer *domain.Streamer) *coreExternal.NotificationData {
-	displayName := streamer.DisplayName
-	if strings.TrimSpace(follow.Alias) != "" {
-		displayName = follow.Alias
+// buildNotificationData renders the message with the follow template, then the channel template,
+// then the default one. A template that fails at send time falls back to the default message.
+func (j *BroadcastReminder) buildNotificationData(locale i18n.Locale, follow *domain.UserFollowedStreamer, channel *domain.NotificationChannel, streamer *domain.Streamer) *coreExternal.NotificationData {
+	vars := domain.NewNotificationTemplateVariables(follow, streamer)
+	rendered, err := channel.Template.Merge(follow.Template).Render(locale, vars)
+	if err != nil {
+		j.logger.Warn("failed to render notification template, using default",
+			zap.Int64("channel_id", channel.ID),
+			zap.Int64("follow_id", follow.ID),
+			zap.Error(err))
+		rendered, _ = domain.DefaultNotificationTemplateFor(locale).Render(locale, vars)
 	}
+	return &coreExternal.NotificationData{
+		Title:              rendered.Title,
+		Content:            rendered.Body,
+		URL:                streamer.RoomURL,
+		ImageURL:           streamer.LiveStatus.CoverImage,
+		IconURL:            streamer.AvatarURL,
+		EventType:          domain.NotificationEventStreamOnline,
+		Severity:           domain.NotificationSeverityNormal,
+		StreamerID:         streamer.ID,
+		StreamerName:       cmp.Or(follow.Alias, streamer.DisplayName),
+		StreamTitle:        streamer.LiveStatus.Title,
+		PlatformType:       streamer.PlatformType,
+		PlatformStreamerID: streamer.PlatformStreamerID,
+	}
+}
 
-	title := fmt.Sprintf("%s is live now!", displayName)
-	body := streamer.LiveStatus.Title
-	if streamer.RoomURL != "" {
-		if body != "" {
-			body = body + "\n"
-		}
-		body += streamer.RoomURL
+// buildStreamEndedData summarizes an ended session; it is the same for every channel.
+func (j *BroadcastReminder) buildStreamEndedData(locale i18n.Locale, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, session *domain.StreamSession) *coreExternal.NotificationData {
+	name := cmp.Or(follow.Alias, streamer.DisplayName)
+	rendered := domain.RenderStreamEnded(locale, name, session)
+	return &coreExternal.NotificationData{
+		Title:              rendered.Title,
+		Content:            rendered.Body,
+		URL:                streamer.RoomURL,
+		IconURL:            streamer.AvatarURL,
+		EventType:          domain.NotificationEventStreamOffline,
+		Severity:           domain.NotificationSeverityNormal,
+		StreamerID:         streamer.ID,
+		StreamerName:       name,
+		StreamTitle:        session.Title,
+		PlatformType:       streamer.PlatformType,
+		PlatformStreamerID: streamer.PlatformStreamerID,
 	}
-	if body == "" {
-		body = "Tune in now."
+}
+
+// buildStreamChangeData announces settled title or category changes; it is the same for every channel.
+func (j *BroadcastReminder) buildStreamChangeData(locale i18n.Locale, follow *domain.UserFollowedStreamer, streamer *domain.Streamer, changes []domain.StreamChange) *coreExternal.NotificationData {
+	name := cmp.Or(follow.Alias, streamer.DisplayName)
+	rendered := domain.RenderStreamChange(locale, name, streamer.LiveStatus.Title, changes)
+	eventType := domain.NotificationEventTitleChange
+	for _, change := range changes {
+		if change.EventType == domain.NotificationEventCategoryChange {
+			eventType = domain.NotificationEventCategoryChange
+		}
 	}
 	return &coreExternal.NotificationData{
-		Title:   title,
-		Content: body,
+		Title:              rendered.Title,
+		Content:            rendered.Body,
+		URL:                streamer.RoomURL,
+		ImageURL:           streamer.LiveStatus.CoverImage,
+		IconURL:            streamer.AvatarURL,
+		EventType:          eventType,
+		Severity:           domain.NotificationSeverityNormal,
+		StreamerID:         streamer.ID,
+		StreamerName:       name,
+		StreamTitle:        streamer.LiveStatus.Title,
+		PlatformType:       streamer.PlatformType,
+		PlatformStreamerID: streamer.PlatformStreamerID,
+	}
+}
+
+// preferenceResolver caches each user's notification preference for one run.
+type preferenceResolver struct {
+	service coreService.NotificationPreferenceService
+	byUser  map[int64]*domain.NotificationPreference
+}
+
+func newPreferenceResolver(service coreService.NotificationPreferenceService) *preferenceResolver {
+	return &preferenceResolver{
+		service: service,
+		byUser:  make(map[int64]*domain.NotificationPreference),
+	}
+}
+
+func (r *preferenceResolver) Resolve(ctx context.Context, userID int64) (*domain.NotificationPreference, error) {
+	if preference, ok := r.byUser[userID]; ok {
+		return preference, nil
+	}
+	preference, err := r.service.FindByUserId(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	r.byUser[userID] = preference
+	return preference, nil
+}
+
+// localeResolver caches the locale each user's notifications are written in for one run.
+type localeResolver struct {
+	repo   coreRepo.UserRepository
+	byUser map[int64]i18n.Locale
+}
+
+func newLocaleResolver(repo coreRepo.UserRepository) *localeResolver {
+	return &localeResolver{
+		repo:   repo,
+		byUser: make(map[int64]i18n.Locale),
 	}
 }
 
+func (r *localeResolver) Resolve(ctx context.Context, userID int64) (i18n.Locale, error) {
+	if locale, ok := r.byUser[userID]; ok {
+		return locale, nil
+	}
+	user, err := r.repo.FindById(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	locale := i18n.Resolve(user.Locale)
+	r.byUser[userID] = locale
+	return locale, nil
+}
+
 type channelResolver struct {
-	repo   coreRepo.NotificationChannelRepository
-	byID   map[int64]*domain.NotificationChannel
-	byUser map[int64][]*domain.NotificationChannel
+	repo    coreRepo.NotificationChannelRepository
+	inbox   coreService.InboxService
+	byID    map[int64]*domain.NotificationChannel
+	byUser  map[int64][]*domain.NotificationChannel
+	inboxes map[int64]*domain.NotificationChannel
 }
 
-func newChannelResolver(repo coreRepo.NotificationChannelRepository) *channelResolver {
+func newChannelResolver(repo coreRepo.NotificationChannelRepository, inbox coreService.InboxService) *channelResolver {
 	return &channelResolver{
-		repo:   repo,
-		byID:   make(map[int64]*domain.NotificationChannel),
-		byUser: make(map[int64][]*domain.NotificationChannel),
+		repo:    repo,
+		inbox:   inbox,
+		byID:    make(map[int64]*domain.NotificationChannel),
+		byUser:  make(map[int64][]*domain.NotificationChannel),
+		inboxes: make(map[int64]*domain.NotificationChannel),
 	}
 }
 
+// Resolve returns the channels the follow notifies through. The user's inbox is always among them
+// while it is enabled, even when the follow picks its channels, so the inbox records every notification.
 func (r *channelResolver) Resolve(ctx context.Context, follow *domain.UserFollowedStreamer) ([]*domain.NotificationChannel, error) {
+	var (
+		channels []*domain.NotificationChannel
+		err      error
+	)
 	if len(follow.NotificationChannelIDs) > 0 {
-		return r.channelsByIDs(ctx, follow.UserID, follow.NotificationChannelIDs)
+		channels, err = r.channelsByIDs(ctx, follow.UserID, follow.NotificationChannelIDs)
+	} else {
+		channels, err = r.channelsByUser(ctx, follow.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	inbox, err := r.inboxOf(ctx, follow.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if inbox.Enable && !slices.ContainsFunc(channels, func(channel *domain.NotificationChannel) bool {
+		return channel.ID == inbox.ID
+	}) {
+		channels = append(slices.Clip(channels), inbox)
+	}
+	return channels, nil
+}
+
+func (r *channelResolver) inboxOf(ctx context.Context, userID int64) (*domain.NotificationChannel, error) {
+	if inbox, ok := r.inboxes[userID]; ok {
+		return inbox, nil
+	}
+	inbox, err := r.inbox.EnsureChannel(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
-	return r.channelsByUser(ctx, follow.UserID)
+	r.inboxes[userID] = inbox
+	return inbox, nil
 }
 
 func (r *channelResolver) channelsByIDs(ctx context.Context, userID int64, ids []int64) ([]*domain.NotificationChannel, error) {
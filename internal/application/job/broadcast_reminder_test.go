@@ -6,10 +6,11 @@ import (
 	"time"
 
 	"github.com/ryuyb/fusion/internal/core/domain"
-	coreExternal "github.com/ryuyb/fusion/internal/core/port/external"
 	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
 	serviceMocks "github.com/ryuyb/fusion/internal/core/port/service"
-	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	appErrors "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -76,24 +77,32 @@ func TestBroadcastReminder_SendNotificationForLiveStreamer(t *testing.T) {
 		FindByPlatformStreamerId(mock.Anything, streamer.PlatformType, streamer.PlatformStreamerID, true).
 		Return(&live, nil).Once()
 
-	provider := coreExternal.NewMockNotificationProvider(t)
-	provider.EXPECT().GetChannelType().Return(domain.ChannelTypeBark).Twice()
-	provider.EXPECT().
-		Send(mock.Anything, channel, mock.AnythingOfType("*external.NotificationData")).
-		Return(nil).Once()
+	routing := domain.NotificationRouting{Mode: domain.RoutingModeEscalate, EscalateAfter: time.Minute}
+	preferenceService := serviceMocks.NewMockNotificationPreferenceService(t)
+	preferenceService.EXPECT().
+		FindByUserId(mock.Anything, follow.UserID).
+		Return(&domain.NotificationPreference{UserID: follow.UserID, Routing: routing}, nil).Once()
 
-	manager := notificationInfra.NewNotificationProviderManager(
-		[]coreExternal.NotificationProvider{provider},
-		zap.NewNop(),
-	)
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	deliveryService.EXPECT().
+		Dispatch(mock.Anything, routing, follow, mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+			return len(targets) == 1 && targets[0].Channel == channel && targets[0].Data.Title == "Streamer is live now!"
+		})).
+		Return([]*domain.NotificationDelivery{{Status: domain.DeliveryStatusPending}}, nil).Once()
 
 	job := NewBroadcastReminder(
+		&config.Config{},
 		zap.NewNop(),
 		streamerRepo,
 		followRepo,
 		channelRepo,
+		expectNewSession(t, live.ID),
+		newTestUserRepo(t),
 		streamerService,
-		manager,
+		deliveryService,
+		preferenceService,
+		newTestInbox(t),
+		newTestLiveEvents(t),
 	)
 
 	err := job.Execute(ctx)
@@ -129,6 +138,7 @@ func TestBroadcastReminder_FallbackToAllChannels(t *testing.T) {
 		StreamerID:             streamer.ID,
 		NotificationsEnabled:   true,
 		NotificationChannelIDs: nil,
+		Routing:                &domain.NotificationRouting{Mode: domain.RoutingModeFirstSuccess},
 	}
 	followRepo := repoMocks.NewMockUserFollowedStreamerRepository(t)
 	followRepo.EXPECT().
@@ -164,27 +174,497 @@ func TestBroadcastReminder_FallbackToAllChannels(t *testing.T) {
 		FindByPlatformStreamerId(mock.Anything, streamer.PlatformType, streamer.PlatformStreamerID, true).
 		Return(&live, nil).Once()
 
-	provider := coreExternal.NewMockNotificationProvider(t)
-	provider.EXPECT().GetChannelType().Return(domain.ChannelTypeBark).Twice()
-	provider.EXPECT().
-		Send(mock.Anything, channels[0], mock.AnythingOfType("*external.NotificationData")).
-		Return(nil).Once()
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	deliveryService.EXPECT().
+		Dispatch(mock.Anything, *follow.Routing, follow, mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+			return len(targets) == 1 && targets[0].Channel == channels[0]
+		})).
+		Return([]*domain.NotificationDelivery{{Status: domain.DeliveryStatusPending}}, nil).Once()
 
-	manager := notificationInfra.NewNotificationProviderManager(
-		[]coreExternal.NotificationProvider{provider},
-		zap.NewNop(),
-	)
+	// The follow's own routing wins over the user's.
+	preferenceService := serviceMocks.NewMockNotificationPreferenceService(t)
+	preferenceService.EXPECT().
+		FindByUserId(mock.Anything, follow.UserID).
+		Return(&domain.NotificationPreference{UserID: follow.UserID, Routing: domain.NotificationRouting{Mode: domain.RoutingModeEscalate}}, nil).Once()
 
 	job := NewBroadcastReminder(
+		&config.Config{},
 		zap.NewNop(),
 		streamerRepo,
 		followRepo,
 		channelRepo,
+		expectNewSession(t, live.ID),
+		newTestUserRepo(t),
 		streamerService,
-		manager,
+		deliveryService,
+		preferenceService,
+		newTestInbox(t),
+		newTestLiveEvents(t),
 	)
 
 	err := job.Execute(ctx)
 	require.NoError(t, err)
-	provider.AssertNumberOfCalls(t, "Send", 1)
+}
+
+func TestBroadcastReminder_QuietHours(t *testing.T) {
+	allDay := []domain.QuietHoursRule{{Start: "00:00", End: "00:00"}}
+	tests := []struct {
+		name         string
+		action       domain.QuietHoursAction
+		alwaysNotify bool
+		wantDispatch bool
+		wantSeverity domain.NotificationSeverity
+		wantMarked   bool
+	}{
+		{name: "delay leaves the follow for a later run", action: domain.QuietHoursDelay},
+		{name: "drop marks the broadcast as handled", action: domain.QuietHoursDrop, wantMarked: true},
+		{name: "passive sends with low severity", action: domain.QuietHoursPassive, wantDispatch: true, wantSeverity: domain.NotificationSeverityLow, wantMarked: true},
+		{name: "always notify bypasses quiet hours", action: domain.QuietHoursDrop, alwaysNotify: true, wantDispatch: true, wantSeverity: domain.NotificationSeverityNormal, wantMarked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			streamer := &domain.Streamer{ID: 3, PlatformType: domain.StreamingPlatformTypeBilibili, PlatformStreamerID: "3003", DisplayName: "Night Owl"}
+			live := *streamer
+			live.LiveStatus = domain.LiveStatusInfo{IsLive: true, StartTime: time.Now()}
+
+			streamerRepo := repoMocks.NewMockStreamerRepository(t)
+			streamerRepo.EXPECT().List(mock.Anything, 0, streamerBatchSize).Return([]*domain.Streamer{streamer}, 1, nil).Once()
+			streamerService := serviceMocks.NewMockStreamerService(t)
+			streamerService.EXPECT().
+				FindByPlatformStreamerId(mock.Anything, streamer.PlatformType, streamer.PlatformStreamerID, true).
+				Return(&live, nil).Once()
+
+			follow := &domain.UserFollowedStreamer{ID: 30, UserID: 5, StreamerID: streamer.ID, NotificationsEnabled: true, AlwaysNotify: tt.alwaysNotify}
+			followRepo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+			followRepo.EXPECT().ListByStreamerId(mock.Anything, streamer.ID, 0, followBatchSize).
+				Return([]*domain.UserFollowedStreamer{follow}, 1, nil).Once()
+			if tt.wantMarked {
+				followRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(f *domain.UserFollowedStreamer) bool {
+					return f.LastNotificationSentAt != nil
+				})).Return(follow, nil).Once()
+			}
+
+			channel := &domain.NotificationChannel{ID: 11, UserID: follow.UserID, ChannelType: domain.ChannelTypeBark, Enable: true}
+			channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+			channelRepo.EXPECT().ListByUserId(mock.Anything, follow.UserID, 0, channelBatchSize).
+				Return([]*domain.NotificationChannel{channel}, 1, nil).Once()
+
+			preference := &domain.NotificationPreference{
+				UserID:     follow.UserID,
+				Routing:    domain.DefaultNotificationRouting,
+				QuietHours: &domain.QuietHours{Action: tt.action, Rules: allDay},
+			}
+			preferenceService := serviceMocks.NewMockNotificationPreferenceService(t)
+			preferenceService.EXPECT().FindByUserId(mock.Anything, follow.UserID).Return(preference, nil).Once()
+
+			deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+			if tt.wantDispatch {
+				deliveryService.EXPECT().
+					Dispatch(mock.Anything, domain.DefaultNotificationRouting, follow, mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+						return len(targets) == 1 && targets[0].Data.Severity == tt.wantSeverity
+					})).
+					Return([]*domain.NotificationDelivery{{Status: domain.DeliveryStatusPending}}, nil).Once()
+			}
+
+			job := NewBroadcastReminder(&config.Config{}, zap.NewNop(), streamerRepo, followRepo, channelRepo, expectNewSession(t, live.ID), newTestUserRepo(t), streamerService, deliveryService, preferenceService, newTestInbox(t), newTestLiveEvents(t))
+			require.NoError(t, job.Execute(ctx))
+		})
+	}
+}
+
+func TestBroadcastReminder_BuildNotificationDataTemplates(t *testing.T) {
+	job := &BroadcastReminder{logger: zap.NewNop()}
+	streamer := &domain.Streamer{
+		DisplayName:  "Streamer",
+		PlatformType: domain.StreamingPlatformTypeDouyu,
+		RoomURL:      "https://live.example/1",
+		LiveStatus: domain.LiveStatusInfo{
+			IsLive:   true,
+			Title:    "Ranked",
+			GameName: "Chess",
+		},
+	}
+	follow := &domain.UserFollowedStreamer{Alias: "Buddy"}
+	channel := &domain.NotificationChannel{}
+
+	data := job.buildNotificationData(i18n.English, follow, channel, streamer)
+	require.Equal(t, "Buddy is live now!", data.Title)
+	require.Equal(t, "Ranked\nhttps://live.example/1", data.Content)
+
+	data = job.buildNotificationData(i18n.SimplifiedChinese, follow, channel, streamer)
+	require.Equal(t, "Buddy 开播了！", data.Title)
+	require.Equal(t, "Ranked\nhttps://live.example/1", data.Content)
+
+	channel.Template = domain.NotificationTemplate{
+		Title: "[{{.Platform}}] {{.Streamer}}",
+		Body:  "{{.Category}}",
+	}
+	data = job.buildNotificationData(i18n.English, follow, channel, streamer)
+	require.Equal(t, "[douyu] Streamer", data.Title)
+	require.Equal(t, "Chess", data.Content)
+
+	follow.Template = domain.NotificationTemplate{Title: "{{.Alias}} started {{.Title}}"}
+	data = job.buildNotificationData(i18n.English, follow, channel, streamer)
+	require.Equal(t, "Buddy started Ranked", data.Title)
+	require.Equal(t, "Chess", data.Content)
+}
+
+// expectNewSession expects the job to open a session for a streamer without one.
+func expectNewSession(t *testing.T, streamerID int64) *repoMocks.MockStreamSessionRepository {
+	sessionRepo := repoMocks.NewMockStreamSessionRepository(t)
+	sessionRepo.EXPECT().FindOpenByStreamerId(mock.Anything, streamerID).
+		Return(nil, appErrors.NotFound("StreamSession")).Once()
+	sessionRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(s *domain.StreamSession) bool {
+		return s.StreamerID == streamerID && s.EndedAt == nil
+	})).RunAndReturn(func(_ context.Context, s *domain.StreamSession) (*domain.StreamSession, error) {
+		return s, nil
+	}).Once()
+	return sessionRepo
+}
+
+// newTestUserRepo serves users without a saved locale, so notifications are rendered in English.
+func newTestUserRepo(t *testing.T) *repoMocks.MockUserRepository {
+	userRepo := repoMocks.NewMockUserRepository(t)
+	userRepo.EXPECT().FindById(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, id int64) (*domain.User, error) {
+			return &domain.User{ID: id}, nil
+		}).Maybe()
+	return userRepo
+}
+
+// newTestInbox gives every user a disabled inbox, which keeps notifications to the channels under test.
+func newTestInbox(t *testing.T) *serviceMocks.MockInboxService {
+	inbox := serviceMocks.NewMockInboxService(t)
+	inbox.EXPECT().EnsureChannel(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, userID int64) (*domain.NotificationChannel, error) {
+			channel := domain.NewInboxChannel(userID, domain.InboxChannelName)
+			channel.ID = 1000 + userID
+			channel.Enable = false
+			return channel, nil
+		}).Maybe()
+	return inbox
+}
+
+func newTestLiveEvents(t *testing.T) *serviceMocks.MockLiveEventService {
+	events := serviceMocks.NewMockLiveEventService(t)
+	events.EXPECT().Publish(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&domain.LiveEvent{}).Maybe()
+	return events
+}
+
+func TestBroadcastReminder_TrackSession(t *testing.T) {
+	now := time.Now()
+	startedAt := now.Add(-time.Hour)
+	lastSeenAt := now.Add(-time.Minute)
+	restartedAt := now.Add(-30 * time.Second)
+
+	tests := []struct {
+		name       string
+		open       *domain.StreamSession
+		status     domain.LiveStatusInfo
+		grace      time.Duration
+		wantEnd    *time.Time
+		wantCreate bool
+		// keepOpen expects the session to be left as it is.
+		keepOpen bool
+	}{
+		{
+			name:       "going live opens a session",
+			status:     domain.LiveStatusInfo{IsLive: true, StartTime: startedAt},
+			wantCreate: true,
+		},
+		{
+			name:   "staying live extends the session",
+			open:   &domain.StreamSession{ID: 1, StartedAt: startedAt, LastSeenAt: lastSeenAt, PeakViewers: 50},
+			status: domain.LiveStatusInfo{IsLive: true, StartTime: startedAt, Viewers: 80, Title: "Still here"},
+		},
+		{
+			name:    "going offline ends the session when it was last seen live",
+			open:    &domain.StreamSession{ID: 1, StartedAt: startedAt, LastSeenAt: lastSeenAt},
+			status:  domain.LiveStatusInfo{},
+			wantEnd: &lastSeenAt,
+		},
+		{
+			name:       "a new broadcast ends the previous session and opens another",
+			open:       &domain.StreamSession{ID: 1, StartedAt: startedAt, LastSeenAt: lastSeenAt},
+			status:     domain.LiveStatusInfo{IsLive: true, StartTime: restartedAt},
+			wantEnd:    &lastSeenAt,
+			wantCreate: true,
+		},
+		{
+			name:   "reconnecting within the grace continues the session",
+			open:   &domain.StreamSession{ID: 1, StartedAt: startedAt, LastSeenAt: lastSeenAt, PeakViewers: 50},
+			status: domain.LiveStatusInfo{IsLive: true, StartTime: restartedAt, Viewers: 80, Title: "Still here"},
+			grace:  10 * time.Minute,
+		},
+		{
+			name:     "briefly offline keeps the session open",
+			open:     &domain.StreamSession{ID: 1, StartedAt: startedAt, LastSeenAt: lastSeenAt},
+			status:   domain.LiveStatusInfo{},
+			grace:    10 * time.Minute,
+			keepOpen: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			streamer := &domain.Streamer{ID: 4, LiveStatus: tt.status}
+			sessionRepo := repoMocks.NewMockStreamSessionRepository(t)
+			if tt.open == nil {
+				sessionRepo.EXPECT().FindOpenByStreamerId(ctx, streamer.ID).Return(nil, appErrors.NotFound("StreamSession")).Once()
+			} else {
+				sessionRepo.EXPECT().FindOpenByStreamerId(ctx, streamer.ID).Return(tt.open, nil).Once()
+			}
+			if tt.open != nil && !tt.keepOpen {
+				sessionRepo.EXPECT().Update(ctx, mock.MatchedBy(func(s *domain.StreamSession) bool {
+					if tt.wantEnd == nil {
+						return s.EndedAt == nil && s.LastSeenAt.Equal(now) && s.PeakViewers == 80 && s.Title == "Still here"
+					}
+					return s.EndedAt != nil && s.EndedAt.Equal(*tt.wantEnd)
+				})).Return(tt.open, nil).Once()
+			}
+			if tt.wantCreate {
+				sessionRepo.EXPECT().Create(ctx, mock.MatchedBy(func(s *domain.StreamSession) bool {
+					return s.StreamerID == streamer.ID && s.StartedAt.Equal(tt.status.StartTime) && s.EndedAt == nil
+				})).Return(&domain.StreamSession{}, nil).Once()
+			}
+
+			job := &BroadcastReminder{logger: zap.NewNop(), sessionRepo: sessionRepo, reminder: config.ReminderConfig{ReconnectGrace: tt.grace}}
+			update, err := job.trackSession(ctx, streamer, now)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEnd != nil, update.ended != nil)
+			require.Equal(t, tt.status.IsLive, update.current != nil)
+		})
+	}
+}
+
+func TestBroadcastReminder_StreamEndedNotification(t *testing.T) {
+	ctx := context.Background()
+	streamer := &domain.Streamer{ID: 6, PlatformType: domain.StreamingPlatformTypeBilibili, PlatformStreamerID: "6006", DisplayName: "Marathoner"}
+	offline := *streamer
+
+	startedAt := time.Now().Add(-3 * time.Hour)
+	session := &domain.StreamSession{
+		ID:          1,
+		StreamerID:  streamer.ID,
+		StartedAt:   startedAt,
+		LastSeenAt:  startedAt.Add(2*time.Hour + 30*time.Minute),
+		Title:       "Part 2",
+		Titles:      []string{"Part 1", "Part 2"},
+		Categories:  []string{"Elden Ring"},
+		PeakViewers: 4200,
+	}
+
+	streamerRepo := repoMocks.NewMockStreamerRepository(t)
+	streamerRepo.EXPECT().List(mock.Anything, 0, streamerBatchSize).Return([]*domain.Streamer{streamer}, 1, nil).Once()
+	streamerService := serviceMocks.NewMockStreamerService(t)
+	streamerService.EXPECT().
+		FindByPlatformStreamerId(mock.Anything, streamer.PlatformType, streamer.PlatformStreamerID, true).
+		Return(&offline, nil).Once()
+
+	sessionRepo := repoMocks.NewMockStreamSessionRepository(t)
+	sessionRepo.EXPECT().FindOpenByStreamerId(mock.Anything, streamer.ID).Return(session, nil).Once()
+	sessionRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(s *domain.StreamSession) bool {
+		return s.EndedAt != nil
+	})).RunAndReturn(func(_ context.Context, s *domain.StreamSession) (*domain.StreamSession, error) {
+		return s, nil
+	}).Once()
+
+	optedIn := &domain.UserFollowedStreamer{ID: 60, UserID: 6, StreamerID: streamer.ID, NotificationsEnabled: true, NotifyStreamEnd: true}
+	optedOut := &domain.UserFollowedStreamer{ID: 61, UserID: 7, StreamerID: streamer.ID, NotificationsEnabled: true}
+	followRepo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+	followRepo.EXPECT().ListByStreamerId(mock.Anything, streamer.ID, 0, followBatchSize).
+		Return([]*domain.UserFollowedStreamer{optedIn, optedOut}, 2, nil).Once()
+
+	channel := &domain.NotificationChannel{ID: 12, UserID: optedIn.UserID, ChannelType: domain.ChannelTypeBark, Enable: true}
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	channelRepo.EXPECT().ListByUserId(mock.Anything, optedIn.UserID, 0, channelBatchSize).
+		Return([]*domain.NotificationChannel{channel}, 1, nil).Once()
+
+	preferenceService := serviceMocks.NewMockNotificationPreferenceService(t)
+	preferenceService.EXPECT().FindByUserId(mock.Anything, optedIn.UserID).
+		Return(&domain.NotificationPreference{UserID: optedIn.UserID, Routing: domain.DefaultNotificationRouting}, nil).Once()
+
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	deliveryService.EXPECT().
+		Dispatch(mock.Anything, domain.DefaultNotificationRouting, optedIn, mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+			data := targets[0].Data
+			return len(targets) == 1 &&
+				data.EventType == domain.NotificationEventStreamOffline &&
+				data.Title == "Marathoner finished streaming" &&
+				data.Content == "Streamed for 2h 30m, peak 4200 viewers\nTitles: Part 1 · Part 2\nCategories: Elden Ring"
+		})).
+		Return([]*domain.NotificationDelivery{{Status: domain.DeliveryStatusPending}}, nil).Once()
+
+	events := serviceMocks.NewMockLiveEventService(t)
+	for _, follow := range []*domain.UserFollowedStreamer{optedIn, optedOut} {
+		events.EXPECT().Publish(mock.Anything, follow.UserID, domain.LiveEventStreamOffline, mock.MatchedBy(func(data map[string]any) bool {
+			return data["streamer_id"] == streamer.ID && data["is_live"] == false && data["title"] == "Part 2"
+		})).Return(&domain.LiveEvent{}).Once()
+	}
+
+	job := NewBroadcastReminder(&config.Config{}, zap.NewNop(), streamerRepo, followRepo, channelRepo, sessionRepo, newTestUserRepo(t), streamerService, deliveryService, preferenceService, newTestInbox(t), events)
+	require.NoError(t, job.Execute(ctx))
+}
+
+func TestBroadcastReminder_StreamChangeNotification(t *testing.T) {
+	ctx := context.Background()
+	startedAt := time.Now().Add(-time.Hour)
+	streamer := &domain.Streamer{ID: 8, PlatformType: domain.StreamingPlatformTypeBilibili, PlatformStreamerID: "8008", DisplayName: "Switcher"}
+	live := *streamer
+	live.LiveStatus = domain.LiveStatusInfo{IsLive: true, Title: "Boss fights", GameName: "Elden Ring", StartTime: startedAt}
+
+	// The title and category changed three minutes ago and have not been announced yet.
+	changedAt := time.Now().Add(-3 * time.Minute)
+	session := &domain.StreamSession{
+		ID:                1,
+		StreamerID:        streamer.ID,
+		Title:             "Boss fights",
+		GameName:          "Elden Ring",
+		AnnouncedTitle:    "Hanging out",
+		AnnouncedGameName: "Just Chatting",
+		ChangedAt:         &changedAt,
+		StartedAt:         startedAt,
+		LastSeenAt:        changedAt,
+	}
+
+	streamerRepo := repoMocks.NewMockStreamerRepository(t)
+	streamerRepo.EXPECT().List(mock.Anything, 0, streamerBatchSize).Return([]*domain.Streamer{streamer}, 1, nil).Once()
+	streamerService := serviceMocks.NewMockStreamerService(t)
+	streamerService.EXPECT().
+		FindByPlatformStreamerId(mock.Anything, streamer.PlatformType, streamer.PlatformStreamerID, true).
+		Return(&live, nil).Once()
+
+	sessionRepo := repoMocks.NewMockStreamSessionRepository(t)
+	sessionRepo.EXPECT().FindOpenByStreamerId(mock.Anything, streamer.ID).Return(session, nil).Once()
+	sessionRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(s *domain.StreamSession) bool {
+		return s.ChangedAt == nil && s.AnnouncedTitle == "Boss fights" && s.AnnouncedGameName == "Elden Ring"
+	})).Return(session, nil).Once()
+
+	notified := time.Now().Add(-30 * time.Minute)
+	category := &domain.UserFollowedStreamer{ID: 80, UserID: 8, StreamerID: streamer.ID, NotificationsEnabled: true, NotifyCategories: []string{"elden ring"}, LastNotificationSentAt: &notified}
+	title := &domain.UserFollowedStreamer{ID: 81, UserID: 9, StreamerID: streamer.ID, NotificationsEnabled: true, NotifyTitleChange: true, LastNotificationSentAt: &notified}
+	none := &domain.UserFollowedStreamer{ID: 82, UserID: 10, StreamerID: streamer.ID, NotificationsEnabled: true, LastNotificationSentAt: &notified}
+	muted := &domain.UserFollowedStreamer{ID: 83, UserID: 11, StreamerID: streamer.ID, NotifyTitleChange: true}
+	// Snoozing the streamer or muting this broadcast from a notification holds these back.
+	snoozedUntil := time.Now().Add(time.Hour)
+	snoozed := &domain.UserFollowedStreamer{ID: 84, UserID: 12, StreamerID: streamer.ID, NotificationsEnabled: true, NotifyTitleChange: true, LastNotificationSentAt: &notified, SnoozedUntil: &snoozedUntil}
+	sessionMuted := &domain.UserFollowedStreamer{ID: 85, UserID: 13, StreamerID: streamer.ID, NotificationsEnabled: true, NotifyTitleChange: true, LastNotificationSentAt: &notified, MutedSessionID: &session.ID}
+	follows := []*domain.UserFollowedStreamer{category, title, none, muted, snoozed, sessionMuted}
+	followRepo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+	followRepo.EXPECT().ListByStreamerId(mock.Anything, streamer.ID, 0, followBatchSize).
+		Return(follows, len(follows), nil).Once()
+
+	// Event streams hear about every change, whatever the follow opted in to be notified of.
+	events := serviceMocks.NewMockLiveEventService(t)
+	for _, follow := range follows {
+		events.EXPECT().Publish(mock.Anything, follow.UserID, domain.LiveEventStreamTitleChanged, mock.MatchedBy(func(data map[string]any) bool {
+			return data["follow_id"] == follow.ID && data["from"] == "Hanging out" && data["to"] == "Boss fights"
+		})).Return(&domain.LiveEvent{}).Once()
+		events.EXPECT().Publish(mock.Anything, follow.UserID, domain.LiveEventStreamCategoryChanged, mock.MatchedBy(func(data map[string]any) bool {
+			return data["follow_id"] == follow.ID && data["from"] == "Just Chatting" && data["to"] == "Elden Ring"
+		})).Return(&domain.LiveEvent{}).Once()
+	}
+
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	preferenceService := serviceMocks.NewMockNotificationPreferenceService(t)
+	for _, follow := range []*domain.UserFollowedStreamer{category, title} {
+		channel := &domain.NotificationChannel{ID: follow.ID, UserID: follow.UserID, ChannelType: domain.ChannelTypeBark, Enable: true}
+		channelRepo.EXPECT().ListByUserId(mock.Anything, follow.UserID, 0, channelBatchSize).
+			Return([]*domain.NotificationChannel{channel}, 1, nil).Once()
+		preferenceService.EXPECT().FindByUserId(mock.Anything, follow.UserID).
+			Return(&domain.NotificationPreference{UserID: follow.UserID, Routing: domain.DefaultNotificationRouting}, nil).Once()
+	}
+
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	deliveryService.EXPECT().
+		Dispatch(mock.Anything, domain.DefaultNotificationRouting, category, mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+			data := targets[0].Data
+			return data.EventType == domain.NotificationEventCategoryChange &&
+				data.Title == "Switcher switched to Elden Ring" &&
+				data.Content == "From Just Chatting\nBoss fights"
+		})).
+		Return([]*domain.NotificationDelivery{{Status: domain.DeliveryStatusPending}}, nil).Once()
+	deliveryService.EXPECT().
+		Dispatch(mock.Anything, domain.DefaultNotificationRouting, title, mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+			data := targets[0].Data
+			return data.EventType == domain.NotificationEventTitleChange &&
+				data.Title == "Switcher changed the stream title" &&
+				data.Content == "Boss fights"
+		})).
+		Return([]*domain.NotificationDelivery{{Status: domain.DeliveryStatusPending}}, nil).Once()
+
+	job := NewBroadcastReminder(&config.Config{}, zap.NewNop(), streamerRepo, followRepo, channelRepo, sessionRepo, newTestUserRepo(t), streamerService, deliveryService, preferenceService, newTestInbox(t), events)
+	require.NoError(t, job.Execute(ctx))
+}
+
+func TestBroadcastReminder_ShouldSendFilter(t *testing.T) {
+	job := &BroadcastReminder{logger: zap.NewNop()}
+	streamer := &domain.Streamer{ID: 9, LiveStatus: domain.LiveStatusInfo{IsLive: true, Title: "Chatting", GameName: "Just Chatting", StartTime: time.Now()}}
+	follow := &domain.UserFollowedStreamer{ID: 90, Filter: &domain.NotificationFilter{Categories: []string{"Elden Ring"}}}
+	require.False(t, job.shouldSend(follow, streamer, nil, time.Now()))
+
+	// Switching to the wanted game later in the broadcast sends the go-live notification then.
+	streamer.LiveStatus.GameName = "Elden Ring"
+	require.True(t, job.shouldSend(follow, streamer, nil, time.Now()))
+}
+
+func TestBroadcastReminder_ShouldSendAntiFlapping(t *testing.T) {
+	now := time.Now()
+	notified := now.Add(-time.Hour)
+	follow := &domain.UserFollowedStreamer{ID: 91, LastNotificationSentAt: &notified}
+	// The stream crashed and restarted, so the platform reports a new start time.
+	streamer := &domain.Streamer{ID: 9, LiveStatus: domain.LiveStatusInfo{IsLive: true, StartTime: now.Add(-time.Minute)}}
+	job := &BroadcastReminder{logger: zap.NewNop()}
+
+	require.True(t, job.shouldSend(follow, streamer, nil, now))
+	// The session survived the reconnect and was already notified.
+	session := &domain.StreamSession{StartedAt: now.Add(-2 * time.Hour)}
+	require.False(t, job.shouldSend(follow, streamer, session, now))
+
+	session.StartedAt = streamer.LiveStatus.StartTime
+	require.True(t, job.shouldSend(follow, streamer, session, now))
+	job.reminder.RenotifyCooldown = 2 * time.Hour
+	require.False(t, job.shouldSend(follow, streamer, session, now))
+}
+
+func TestChannelResolver_Inbox(t *testing.T) {
+	ctx := context.Background()
+	picked := &domain.NotificationChannel{ID: 7, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+	inbox := domain.NewInboxChannel(1, domain.InboxChannelName)
+	inbox.ID = 8
+	lonelyInbox := domain.NewInboxChannel(2, domain.InboxChannelName)
+	lonelyInbox.ID = 9
+
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	channelRepo.EXPECT().FindById(mock.Anything, picked.ID).Return(picked, nil).Once()
+	channelRepo.EXPECT().ListByUserId(mock.Anything, int64(1), 0, channelBatchSize).
+		Return([]*domain.NotificationChannel{picked, inbox}, 2, nil).Once()
+	channelRepo.EXPECT().ListByUserId(mock.Anything, int64(2), 0, channelBatchSize).
+		Return(nil, 0, nil).Once()
+	inboxService := serviceMocks.NewMockInboxService(t)
+	inboxService.EXPECT().EnsureChannel(mock.Anything, int64(1)).Return(inbox, nil).Once()
+	inboxService.EXPECT().EnsureChannel(mock.Anything, int64(2)).Return(lonelyInbox, nil).Once()
+	resolver := newChannelResolver(channelRepo, inboxService)
+
+	// Picking channels does not keep notifications out of the inbox.
+	channels, err := resolver.Resolve(ctx, &domain.UserFollowedStreamer{UserID: 1, NotificationChannelIDs: []int64{picked.ID}})
+	require.NoError(t, err)
+	require.Equal(t, []*domain.NotificationChannel{picked, inbox}, channels)
+
+	channels, err = resolver.Resolve(ctx, &domain.UserFollowedStreamer{UserID: 1})
+	require.NoError(t, err)
+	require.Equal(t, []*domain.NotificationChannel{picked, inbox}, channels)
+
+	// Users without channels of their own still get the inbox.
+	channels, err = resolver.Resolve(ctx, &domain.UserFollowedStreamer{UserID: 2})
+	require.NoError(t, err)
+	require.Equal(t, []*domain.NotificationChannel{lonelyInbox}, channels)
+
+	lonelyInbox.Enable = false
+	channels, err = resolver.Resolve(ctx, &domain.UserFollowedStreamer{UserID: 2})
+	require.NoError(t, err)
+	require.Empty(t, channels)
 }
@@ -0,0 +1,39 @@
+package job
+
+import (
+	"context"
+
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"go.uber.org/zap"
+)
+
+const NotificationChannelSecretRotationJob = "notification_channel_secret_rotation"
+
+// NotificationChannelSecretRotation re-encrypts channel secrets that are still plaintext
+// or sealed with a retired master key, so old keys can be removed after a rotation.
+type NotificationChannelSecretRotation struct {
+	logger         *zap.Logger
+	channelService coreService.NotificationChannelService
+}
+
+func NewNotificationChannelSecretRotation(logger *zap.Logger, channelService coreService.NotificationChannelService) *NotificationChannelSecretRotation {
+	return &NotificationChannelSecretRotation{
+		logger:         logger,
+		channelService: channelService,
+	}
+}
+
+func (j *NotificationChannelSecretRotation) Name() string {
+	return NotificationChannelSecretRotationJob
+}
+
+func (j *NotificationChannelSecretRotation) Execute(ctx context.Context) error {
+	rotated, err := j.channelService.RotateSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	if rotated > 0 {
+		j.logger.Info("rotated notification channel secrets", zap.Int("channels", rotated))
+	}
+	return nil
+}
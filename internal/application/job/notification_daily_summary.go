@@ -0,0 +1,195 @@
+package job
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreExternal "github.com/ryuyb/fusion/internal/core/port/external"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"go.uber.org/zap"
+)
+
+const NotificationDailySummaryJob = "notification_daily_summary"
+
+// NotificationDailySummary sends channels with a daily summary schedule a summary of who, among the
+// streamers notified through the channel, streamed during the past day and for how long.
+type NotificationDailySummary struct {
+	logger          *zap.Logger
+	channelRepo     coreRepo.NotificationChannelRepository
+	followRepo      coreRepo.UserFollowedStreamerRepository
+	streamerRepo    coreRepo.StreamerRepository
+	sessionRepo     coreRepo.StreamSessionRepository
+	userRepo        coreRepo.UserRepository
+	deliveryService coreService.NotificationDeliveryService
+}
+
+func NewNotificationDailySummary(
+	logger *zap.Logger,
+	channelRepo coreRepo.NotificationChannelRepository,
+	followRepo coreRepo.UserFollowedStreamerRepository,
+	streamerRepo coreRepo.StreamerRepository,
+	sessionRepo coreRepo.StreamSessionRepository,
+	userRepo coreRepo.UserRepository,
+	deliveryService coreService.NotificationDeliveryService,
+) *NotificationDailySummary {
+	return &NotificationDailySummary{
+		logger:          logger,
+		channelRepo:     channelRepo,
+		followRepo:      followRepo,
+		streamerRepo:    streamerRepo,
+		sessionRepo:     sessionRepo,
+		userRepo:        userRepo,
+		deliveryService: deliveryService,
+	}
+}
+
+func (j *NotificationDailySummary) Name() string {
+	return NotificationDailySummaryJob
+}
+
+func (j *NotificationDailySummary) Execute(ctx context.Context) error {
+	now := time.Now()
+	locales := newLocaleResolver(j.userRepo)
+	offset := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		channels, total, err := j.channelRepo.ListWithDailySummary(ctx, offset, channelBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(channels) == 0 {
+			break
+		}
+
+		for _, channel := range channels {
+			scheduled, due := channel.DailySummary.Due(now)
+			if !due {
+				continue
+			}
+			if err := j.send(ctx, channel, locales, scheduled, now); err != nil {
+				j.logger.Warn("failed to send daily summary",
+					zap.Int64("channel_id", channel.ID),
+					zap.Error(err))
+			}
+		}
+
+		offset += len(channels)
+		if offset >= total {
+			break
+		}
+	}
+	return nil
+}
+
+// send queues the summary of the 24 hours before scheduled, in the locale of the channel owner. Days
+// without any stream are marked as sent without a message.
+func (j *NotificationDailySummary) send(ctx context.Context, channel *domain.NotificationChannel, locales *localeResolver, scheduled, now time.Time) error {
+	follows, err := j.listFollows(ctx, channel)
+	if err != nil {
+		return err
+	}
+	streamerIDs := make([]int64, len(follows))
+	for i, follow := range follows {
+		streamerIDs[i] = follow.StreamerID
+	}
+	from := scheduled.Add(-24 * time.Hour)
+	sessions, err := j.sessionRepo.ListByStreamerIds(ctx, streamerIDs, from, scheduled)
+	if err != nil {
+		return err
+	}
+
+	if len(sessions) > 0 {
+		entries, err := j.summarize(ctx, follows, sessions, from, scheduled)
+		if err != nil {
+			return err
+		}
+		locale, err := locales.Resolve(ctx, channel.UserID)
+		if err != nil {
+			return err
+		}
+		rendered := domain.RenderDailySummary(locale, entries)
+		target := coreService.DeliveryTarget{
+			Channel: channel,
+			Data: &coreExternal.NotificationData{
+				Title:     rendered.Title,
+				Content:   rendered.Body,
+				EventType: domain.NotificationEventDailySummary,
+				Severity:  domain.NotificationSeverityNormal,
+			},
+		}
+		if _, err := j.deliveryService.Dispatch(ctx, domain.DefaultNotificationRouting, nil, []coreService.DeliveryTarget{target}); err != nil {
+			return err
+		}
+	}
+	return j.channelRepo.MarkDailySummarySent(ctx, channel.ID, now)
+}
+
+// summarize adds up the sessions of each streamer, in the order they first went live.
+func (j *NotificationDailySummary) summarize(ctx context.Context, follows []*domain.UserFollowedStreamer, sessions []*domain.StreamSession, from, to time.Time) ([]domain.DailySummaryEntry, error) {
+	aliases := make(map[int64]string, len(follows))
+	for _, follow := range follows {
+		aliases[follow.StreamerID] = follow.Alias
+	}
+
+	var entries []domain.DailySummaryEntry
+	index := make(map[int64]int)
+	for _, session := range sessions {
+		i, ok := index[session.StreamerID]
+		if !ok {
+			name := aliases[session.StreamerID]
+			if name == "" {
+				streamer, err := j.streamerRepo.FindById(ctx, session.StreamerID)
+				if err != nil {
+					return nil, err
+				}
+				name = streamer.DisplayName
+			}
+			i = len(entries)
+			index[session.StreamerID] = i
+			entries = append(entries, domain.DailySummaryEntry{Streamer: name})
+		}
+		entries[i].Sessions++
+		entries[i].Duration += session.DurationWithin(from, to)
+		if session.Title != "" {
+			entries[i].Title = session.Title
+		}
+	}
+	return entries, nil
+}
+
+// listFollows returns the user's follows with notifications enabled that are routed to channel.
+func (j *NotificationDailySummary) listFollows(ctx context.Context, channel *domain.NotificationChannel) ([]*domain.UserFollowedStreamer, error) {
+	var (
+		results []*domain.UserFollowedStreamer
+		offset  int
+	)
+	for {
+		follows, total, err := j.followRepo.ListByUserId(ctx, channel.UserID, offset, followBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(follows) == 0 {
+			break
+		}
+		for _, follow := range follows {
+			if !follow.NotificationsEnabled {
+				continue
+			}
+			if len(follow.NotificationChannelIDs) > 0 && !slices.Contains(follow.NotificationChannelIDs, channel.ID) {
+				continue
+			}
+			results = append(results, follow)
+		}
+		offset += len(follows)
+		if offset >= total {
+			break
+		}
+	}
+	return results, nil
+}
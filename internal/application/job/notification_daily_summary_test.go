@@ -0,0 +1,65 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	serviceMocks "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotificationDailySummary_SendsDueSummaries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	at := now.Add(-time.Minute).Format("15:04")
+
+	due := &domain.NotificationChannel{ID: 1, UserID: 9, ChannelType: domain.ChannelTypeBark, Enable: true,
+		DailySummary: &domain.DailySummarySchedule{At: at}}
+	sent := now.Add(-30 * time.Second)
+	alreadySent := &domain.NotificationChannel{ID: 2, UserID: 9, ChannelType: domain.ChannelTypeBark, Enable: true,
+		DailySummary: &domain.DailySummarySchedule{At: at, LastSentAt: &sent}}
+
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	channelRepo.EXPECT().ListWithDailySummary(mock.Anything, 0, channelBatchSize).
+		Return([]*domain.NotificationChannel{due, alreadySent}, 2, nil).Once()
+	channelRepo.EXPECT().MarkDailySummarySent(mock.Anything, due.ID, mock.Anything).Return(nil).Once()
+
+	followRepo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+	followRepo.EXPECT().ListByUserId(mock.Anything, due.UserID, 0, followBatchSize).Return([]*domain.UserFollowedStreamer{
+		{StreamerID: 100, Alias: "Buddy", NotificationsEnabled: true},
+		{StreamerID: 200, NotificationsEnabled: true},
+		{StreamerID: 300, NotificationsEnabled: true, NotificationChannelIDs: []int64{5}},
+		{StreamerID: 400, NotificationsEnabled: false},
+	}, 4, nil).Once()
+
+	sessionRepo := repoMocks.NewMockStreamSessionRepository(t)
+	sessionRepo.EXPECT().ListByStreamerIds(mock.Anything, []int64{100, 200}, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ []int64, from, to time.Time) ([]*domain.StreamSession, error) {
+			end := from.Add(2 * time.Hour)
+			return []*domain.StreamSession{
+				{StreamerID: 200, StartedAt: from, EndedAt: &end, Title: "Speedrun"},
+				{StreamerID: 100, StartedAt: to.Add(-30 * time.Minute), Title: "Late show"},
+			}, nil
+		}).Once()
+
+	streamerRepo := repoMocks.NewMockStreamerRepository(t)
+	streamerRepo.EXPECT().FindById(mock.Anything, int64(200)).Return(&domain.Streamer{ID: 200, DisplayName: "Runner"}, nil).Once()
+
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	deliveryService.EXPECT().
+		Dispatch(mock.Anything, domain.DefaultNotificationRouting, (*domain.UserFollowedStreamer)(nil), mock.MatchedBy(func(targets []serviceMocks.DeliveryTarget) bool {
+			return len(targets) == 1 && targets[0].Channel == due &&
+				targets[0].Data.EventType == domain.NotificationEventDailySummary &&
+				targets[0].Data.Title == "Daily summary: 2 streamers streamed" &&
+				targets[0].Data.Content == "• Runner: 2h 00m (Speedrun)\n• Buddy: 30m (Late show)"
+		})).
+		Return([]*domain.NotificationDelivery{{}}, nil).Once()
+
+	job := NewNotificationDailySummary(zap.NewNop(), channelRepo, followRepo, streamerRepo, sessionRepo, newTestUserRepo(t), deliveryService)
+	require.NoError(t, job.Execute(ctx))
+}
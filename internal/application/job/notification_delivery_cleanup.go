@@ -0,0 +1,45 @@
+package job
+
+import (
+	"context"
+
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"go.uber.org/zap"
+)
+
+const NotificationDeliveryCleanupJob = "notification_delivery_cleanup"
+
+// NotificationDeliveryCleanup prunes delivery history and inbox messages past their configured
+// retention.
+type NotificationDeliveryCleanup struct {
+	logger          *zap.Logger
+	deliveryService coreService.NotificationDeliveryService
+	inboxService    coreService.InboxService
+}
+
+func NewNotificationDeliveryCleanup(logger *zap.Logger, deliveryService coreService.NotificationDeliveryService, inboxService coreService.InboxService) *NotificationDeliveryCleanup {
+	return &NotificationDeliveryCleanup{
+		logger:          logger,
+		deliveryService: deliveryService,
+		inboxService:    inboxService,
+	}
+}
+
+func (j *NotificationDeliveryCleanup) Name() string {
+	return NotificationDeliveryCleanupJob
+}
+
+func (j *NotificationDeliveryCleanup) Execute(ctx context.Context) error {
+	deleted, err := j.deliveryService.PurgeExpired(ctx)
+	if err != nil {
+		return err
+	}
+	j.logger.Info("purged expired notification deliveries", zap.Int("deleted", deleted))
+
+	deleted, err = j.inboxService.PurgeExpired(ctx)
+	if err != nil {
+		return err
+	}
+	j.logger.Info("purged expired inbox messages", zap.Int("deleted", deleted))
+	return nil
+}
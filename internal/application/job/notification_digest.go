@@ -0,0 +1,46 @@
+package job
+
+import (
+	"context"
+
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"go.uber.org/zap"
+)
+
+const NotificationDigestJob = "notification_digest"
+
+// NotificationDigest queues the digests of channels whose digest window has closed, and the collapsed
+// go-live notifications of channels the send rate limit allows again.
+type NotificationDigest struct {
+	logger          *zap.Logger
+	deliveryService coreService.NotificationDeliveryService
+}
+
+func NewNotificationDigest(logger *zap.Logger, deliveryService coreService.NotificationDeliveryService) *NotificationDigest {
+	return &NotificationDigest{
+		logger:          logger,
+		deliveryService: deliveryService,
+	}
+}
+
+func (j *NotificationDigest) Name() string {
+	return NotificationDigestJob
+}
+
+func (j *NotificationDigest) Execute(ctx context.Context) error {
+	flushed, err := j.deliveryService.FlushDigests(ctx)
+	if err != nil {
+		return err
+	}
+	if flushed > 0 {
+		j.logger.Info("queued notification digests", zap.Int("digests", flushed))
+	}
+	collapsed, err := j.deliveryService.FlushThrottled(ctx)
+	if err != nil {
+		return err
+	}
+	if collapsed > 0 {
+		j.logger.Info("queued throttled notifications", zap.Int("channels", collapsed))
+	}
+	return nil
+}
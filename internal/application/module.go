@@ -3,6 +3,7 @@ package application
 import (
 	"github.com/ryuyb/fusion/internal/application/job"
 	"github.com/ryuyb/fusion/internal/application/service"
+	"github.com/ryuyb/fusion/internal/application/worker"
 	"go.uber.org/fx"
 )
 
@@ -14,11 +15,27 @@ var Module = fx.Module("application",
 		service.NewStreamerService,
 		service.NewNotificationChannelService,
 		service.NewUserFollowedStreamerService,
+		service.NewWebPushService,
+		service.NewNotificationTemplateService,
+		service.NewNotificationDeliveryService,
+		service.NewNotificationPreferenceService,
+		service.NewLiveEventService,
+		service.NewInboxService,
+		service.NewNotificationActionService,
 	),
 
 	fx.Provide(
 		asJob(job.NewBroadcastReminder),
+		asJob(job.NewNotificationDeliveryCleanup),
+		asJob(job.NewNotificationChannelSecretRotation),
+		asJob(job.NewNotificationDigest),
+		asJob(job.NewNotificationDailySummary),
 	),
+
+	fx.Provide(worker.NewNotificationOutbox),
+	fx.Invoke(func(lc fx.Lifecycle, outbox *worker.NotificationOutbox) {
+		lc.Append(fx.StartStopHook(outbox.Start, outbox.Stop))
+	}),
 )
 
 func asJob(f any) any {
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+	"go.uber.org/zap"
+)
+
+// maxInboxChannelNameAttempts bounds the search for a free name when the user already has a channel
+// called InboxChannelName.
+const maxInboxChannelNameAttempts = 10
+
+type inboxService struct {
+	repo        coreRepo.InboxMessageRepository
+	channelRepo coreRepo.NotificationChannelRepository
+	retention   time.Duration
+	now         func() time.Time
+	logger      *zap.Logger
+}
+
+func NewInboxService(cfg *config.Config, repo coreRepo.InboxMessageRepository, channelRepo coreRepo.NotificationChannelRepository, logger *zap.Logger) coreService.InboxService {
+	return &inboxService{
+		repo:        repo,
+		channelRepo: channelRepo,
+		retention:   cfg.Notification.Inbox.Retention,
+		now:         time.Now,
+		logger:      logger,
+	}
+}
+
+func (s *inboxService) EnsureChannel(ctx context.Context, userID int64) (*domain.NotificationChannel, error) {
+	channel, err := s.channelRepo.FindInboxByUserId(ctx, userID)
+	if err == nil || !errors.IsNotFoundError(err) {
+		return channel, err
+	}
+
+	for attempt := 1; attempt <= maxInboxChannelNameAttempts; attempt++ {
+		name := domain.InboxChannelName
+		if attempt > 1 {
+			name = fmt.Sprintf("%s %d", domain.InboxChannelName, attempt)
+		}
+		exist, err := s.channelRepo.ExistByName(ctx, userID, name)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return s.channelRepo.Create(ctx, domain.NewInboxChannel(userID, name))
+		}
+	}
+	return nil, errors.Conflict("notification channel already exists").WithDetail("name", domain.InboxChannelName)
+}
+
+func (s *inboxService) ListByUserId(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*domain.InboxMessage, int, error) {
+	if err := util.ValidatePagination(page, pageSize); err != nil {
+		s.logger.Warn("invalid pagination parameters for inbox messages",
+			zap.Int("page", page),
+			zap.Int("page_size", pageSize),
+			zap.Error(err),
+		)
+		return nil, 0, err
+	}
+	offset := (page - 1) * pageSize
+	return s.repo.ListByUserId(ctx, userID, unreadOnly, offset, pageSize)
+}
+
+func (s *inboxService) CountUnread(ctx context.Context, userID int64) (int, error) {
+	return s.repo.CountUnread(ctx, userID)
+}
+
+func (s *inboxService) MarkRead(ctx context.Context, id int64) (*domain.InboxMessage, error) {
+	return s.repo.MarkRead(ctx, id, s.now())
+}
+
+func (s *inboxService) MarkAllRead(ctx context.Context, userID int64) (int, error) {
+	return s.repo.MarkAllRead(ctx, userID, s.now())
+}
+
+func (s *inboxService) PurgeExpired(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.repo.DeleteCreatedBefore(ctx, s.now().Add(-s.retention))
+}
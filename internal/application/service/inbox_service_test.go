@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestInboxService(t *testing.T, retention time.Duration) (*inboxService, *repoMocks.MockInboxMessageRepository, *repoMocks.MockNotificationChannelRepository) {
+	repo := repoMocks.NewMockInboxMessageRepository(t)
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	cfg := &config.Config{Notification: config.NotificationConfig{Inbox: config.InboxConfig{Retention: retention}}}
+	svc := NewInboxService(cfg, repo, channelRepo, zap.NewNop()).(*inboxService)
+	return svc, repo, channelRepo
+}
+
+func TestInboxService_EnsureChannel(t *testing.T) {
+	ctx := context.Background()
+	svc, _, channelRepo := newTestInboxService(t, 0)
+
+	existing := domain.NewInboxChannel(1, domain.InboxChannelName)
+	channelRepo.EXPECT().FindInboxByUserId(ctx, int64(1)).Return(existing, nil).Once()
+	channel, err := svc.EnsureChannel(ctx, 1)
+	require.NoError(t, err)
+	require.Same(t, existing, channel)
+
+	// A channel the user named "Inbox" themselves pushes the inbox to the next free name.
+	channelRepo.EXPECT().FindInboxByUserId(ctx, int64(2)).Return(nil, errors.NotFound("notification channel")).Once()
+	channelRepo.EXPECT().ExistByName(ctx, int64(2), domain.InboxChannelName).Return(true, nil).Once()
+	channelRepo.EXPECT().ExistByName(ctx, int64(2), domain.InboxChannelName+" 2").Return(false, nil).Once()
+	channelRepo.EXPECT().Create(ctx, mock.MatchedBy(func(c *domain.NotificationChannel) bool {
+		return c.UserID == 2 && c.ChannelType == domain.ChannelTypeInbox && c.Name == "Inbox 2" && c.Enable
+	})).RunAndReturn(func(_ context.Context, c *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+		c.ID = 7
+		return c, nil
+	}).Once()
+	channel, err = svc.EnsureChannel(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), channel.ID)
+}
+
+func TestInboxService_PurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+
+	svc, repo, _ := newTestInboxService(t, 24*time.Hour)
+	svc.now = func() time.Time { return now }
+	repo.EXPECT().DeleteCreatedBefore(ctx, now.Add(-24*time.Hour)).Return(3, nil).Once()
+	deleted, err := svc.PurgeExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, deleted)
+
+	// Without a retention messages are kept for good.
+	svc, _, _ = newTestInboxService(t, 0)
+	deleted, err = svc.PurgeExpired(ctx)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"go.uber.org/zap"
+)
+
+// liveEventSubscriberBuffer is how many events a subscriber may fall behind before it is dropped. A
+// dropped client reconnects with Last-Event-ID and catches up from the replay.
+const liveEventSubscriberBuffer = 64
+
+// liveEventService keeps events and subscriptions in memory, so it serves the streams opened against
+// this instance only.
+type liveEventService struct {
+	replaySize   int
+	replayWindow time.Duration
+	now          func() time.Time
+	logger       *zap.Logger
+
+	mu          sync.Mutex
+	lastID      int64
+	closed      bool
+	replay      map[int64][]*domain.LiveEvent
+	subscribers map[int64]map[*liveEventSubscriber]struct{}
+}
+
+type liveEventSubscriber struct {
+	events chan *domain.LiveEvent
+}
+
+func NewLiveEventService(cfg *config.Config, logger *zap.Logger) coreService.LiveEventService {
+	return &liveEventService{
+		replaySize:   cfg.Events.ReplaySize,
+		replayWindow: cfg.Events.ReplayWindow,
+		now:          time.Now,
+		logger:       logger,
+		replay:       make(map[int64][]*domain.LiveEvent),
+		subscribers:  make(map[int64]map[*liveEventSubscriber]struct{}),
+	}
+}
+
+func (s *liveEventService) Publish(_ context.Context, userID int64, eventType domain.LiveEventType, data map[string]any) *domain.LiveEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	// Seeding IDs from the clock keeps them growing across restarts, so a Last-Event-ID from before a
+	// restart does not hide newer events.
+	s.lastID = max(s.lastID+1, now.UnixMicro())
+	event := &domain.LiveEvent{
+		ID:        s.lastID,
+		UserID:    userID,
+		Type:      eventType,
+		Data:      data,
+		CreatedAt: now,
+	}
+	if s.replaySize > 0 {
+		kept := append(s.kept(userID, now), event)
+		if len(kept) > s.replaySize {
+			kept = kept[len(kept)-s.replaySize:]
+		}
+		s.replay[userID] = kept
+	}
+
+	for subscriber := range s.subscribers[userID] {
+		select {
+		case subscriber.events <- event:
+		default:
+			s.logger.Debug("dropping live event subscriber that fell behind", zap.Int64("user_id", userID))
+			s.unsubscribeLocked(userID, subscriber)
+		}
+	}
+	return event
+}
+
+func (s *liveEventService) Subscribe(ctx context.Context, userID, lastEventID int64) ([]*domain.LiveEvent, <-chan *domain.LiveEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscriber := &liveEventSubscriber{events: make(chan *domain.LiveEvent, liveEventSubscriberBuffer)}
+	if s.closed {
+		close(subscriber.events)
+		return nil, subscriber.events
+	}
+
+	var replay []*domain.LiveEvent
+	if lastEventID > 0 {
+		for _, event := range s.kept(userID, s.now()) {
+			if event.ID > lastEventID {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	if s.subscribers[userID] == nil {
+		s.subscribers[userID] = make(map[*liveEventSubscriber]struct{})
+	}
+	s.subscribers[userID][subscriber] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.unsubscribeLocked(userID, subscriber)
+	}()
+	return replay, subscriber.events
+}
+
+func (s *liveEventService) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for userID, subscribers := range s.subscribers {
+		for subscriber := range subscribers {
+			s.unsubscribeLocked(userID, subscriber)
+		}
+	}
+}
+
+// kept returns the user's events that are still within the replay window.
+func (s *liveEventService) kept(userID int64, now time.Time) []*domain.LiveEvent {
+	events := s.replay[userID]
+	if s.replayWindow <= 0 {
+		return events
+	}
+	for len(events) > 0 && now.Sub(events[0].CreatedAt) > s.replayWindow {
+		events = events[1:]
+	}
+	if len(events) == 0 {
+		delete(s.replay, userID)
+	}
+	return events
+}
+
+// unsubscribeLocked closes the subscriber's channel unless it is gone already; s.mu must be held.
+func (s *liveEventService) unsubscribeLocked(userID int64, subscriber *liveEventSubscriber) {
+	subscribers := s.subscribers[userID]
+	if _, ok := subscribers[subscriber]; !ok {
+		return
+	}
+	delete(subscribers, subscriber)
+	if len(subscribers) == 0 {
+		delete(s.subscribers, userID)
+	}
+	close(subscriber.events)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestLiveEventService(size int, window time.Duration) (*liveEventService, *time.Time) {
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	cfg := &config.Config{Events: config.EventsConfig{ReplaySize: size, ReplayWindow: window}}
+	svc := NewLiveEventService(cfg, zap.NewNop()).(*liveEventService)
+	svc.now = func() time.Time { return now }
+	return svc, &now
+}
+
+func TestLiveEventService_Replay(t *testing.T) {
+	ctx := context.Background()
+	svc, now := newTestLiveEventService(3, time.Hour)
+
+	var published []*domain.LiveEvent
+	for range 4 {
+		published = append(published, svc.Publish(ctx, 1, domain.LiveEventStreamOnline, nil))
+	}
+	svc.Publish(ctx, 2, domain.LiveEventStreamOnline, nil)
+	for i := 1; i < len(published); i++ {
+		require.Greater(t, published[i].ID, published[i-1].ID)
+	}
+
+	// Only the last three events of the user are kept, and only those after Last-Event-ID come back.
+	replay, _ := svc.Subscribe(ctx, 1, published[0].ID)
+	require.Equal(t, published[1:], replay)
+	replay, _ = svc.Subscribe(ctx, 1, published[2].ID)
+	require.Equal(t, published[3:], replay)
+	replay, _ = svc.Subscribe(ctx, 1, 0)
+	require.Empty(t, replay)
+
+	*now = now.Add(2 * time.Hour)
+	replay, _ = svc.Subscribe(ctx, 1, published[0].ID)
+	require.Empty(t, replay)
+}
+
+func TestLiveEventService_Subscribe(t *testing.T) {
+	svc, _ := newTestLiveEventService(0, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, events := svc.Subscribe(ctx, 1, 0)
+	_, others := svc.Subscribe(context.Background(), 2, 0)
+	event := svc.Publish(ctx, 1, domain.LiveEventNotificationDelivered, map[string]any{"delivery_id": int64(9)})
+	require.Equal(t, event, <-events)
+	require.Empty(t, others)
+
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+
+	svc.Close()
+	_, ok = <-others
+	require.False(t, ok)
+	_, events = svc.Subscribe(context.Background(), 1, 0)
+	_, ok = <-events
+	require.False(t, ok)
+}
+
+func TestLiveEventService_DropsSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestLiveEventService(0, 0)
+
+	_, events := svc.Subscribe(ctx, 1, 0)
+	for range liveEventSubscriberBuffer + 1 {
+		svc.Publish(ctx, 1, domain.LiveEventStreamOnline, nil)
+	}
+	for range liveEventSubscriberBuffer {
+		<-events
+	}
+	_, ok := <-events
+	require.False(t, ok)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"strings"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNotificationActionTTL    = 72 * time.Hour
+	defaultNotificationActionSnooze = 24 * time.Hour
+
+	// NotificationActionPath is where action links point, followed by the signed token.
+	NotificationActionPath = "/api/v1/notification-actions/"
+
+	// notificationActionKeyInfo labels the key derived from the JWT secret, so action links and access
+	// tokens are never signed with the same key.
+	notificationActionKeyInfo = "fusion notification actions"
+)
+
+// notificationActionLinks signs the action links put in notifications.
+type notificationActionLinks struct {
+	baseURL string
+	secret  []byte
+	ttl     time.Duration
+	snooze  time.Duration
+}
+
+func newNotificationActionLinks(cfg *config.Config) notificationActionLinks {
+	actions := cfg.Notification.Actions
+	links := notificationActionLinks{
+		baseURL: strings.TrimRight(strings.TrimSpace(actions.BaseURL), "/"),
+		secret:  []byte(actions.Secret),
+		ttl:     actions.TTL,
+		snooze:  actions.Snooze,
+	}
+	if len(links.secret) == 0 && cfg.JWT.Secret != "" {
+		links.secret, _ = hkdf.Key(sha256.New, []byte(cfg.JWT.Secret), nil, notificationActionKeyInfo, sha256.Size)
+	}
+	if links.ttl <= 0 {
+		links.ttl = defaultNotificationActionTTL
+	}
+	if links.snooze <= 0 {
+		links.snooze = defaultNotificationActionSnooze
+	}
+	return links
+}
+
+// enabled reports whether notifications carry action links; they need somewhere to point and a key to
+// sign them with.
+func (l notificationActionLinks) enabled() bool {
+	return l.baseURL != "" && len(l.secret) > 0
+}
+
+// build makes the links offered with delivery, labeled in locale.
+func (l notificationActionLinks) build(locale i18n.Locale, delivery *domain.NotificationDelivery, now time.Time) []external.NotificationAction {
+	if !l.enabled() {
+		return nil
+	}
+	types := domain.NotificationActionsFor(delivery)
+	if len(types) == 0 {
+		return nil
+	}
+	actions := make([]external.NotificationAction, len(types))
+	for i, actionType := range types {
+		token := domain.NotificationActionToken{
+			Action:     actionType,
+			FollowID:   *delivery.FollowID,
+			DeliveryID: delivery.ID,
+			ExpiresAt:  now.Add(l.ttl),
+		}
+		actions[i] = external.NotificationAction{
+			Type:  actionType,
+			Label: l.label(locale, actionType),
+			URL:   l.baseURL + NotificationActionPath + token.Sign(l.secret),
+		}
+	}
+	return actions
+}
+
+func (l notificationActionLinks) label(locale i18n.Locale, actionType domain.NotificationActionType) string {
+	if actionType == domain.NotificationActionSnooze {
+		return i18n.T(locale, "notification.action.snooze", max(int(l.snooze.Round(time.Hour)/time.Hour), 1))
+	}
+	return i18n.T(locale, "notification.action."+string(actionType))
+}
+
+type notificationActionService struct {
+	followRepo      coreRepo.UserFollowedStreamerRepository
+	sessionRepo     coreRepo.StreamSessionRepository
+	deliveryService coreService.NotificationDeliveryService
+	links           notificationActionLinks
+	now             func() time.Time
+	logger          *zap.Logger
+}
+
+func NewNotificationActionService(
+	cfg *config.Config,
+	followRepo coreRepo.UserFollowedStreamerRepository,
+	sessionRepo coreRepo.StreamSessionRepository,
+	deliveryService coreService.NotificationDeliveryService,
+	logger *zap.Logger,
+) coreService.NotificationActionService {
+	return &notificationActionService{
+		followRepo:      followRepo,
+		sessionRepo:     sessionRepo,
+		deliveryService: deliveryService,
+		links:           newNotificationActionLinks(cfg),
+		now:             time.Now,
+		logger:          logger,
+	}
+}
+
+func (s *notificationActionService) Inspect(_ context.Context, signed string) (*domain.NotificationActionToken, error) {
+	if len(s.links.secret) == 0 {
+		return nil, errors.Forbidden("action link is invalid")
+	}
+	return domain.ParseNotificationActionToken(s.links.secret, signed, s.now())
+}
+
+func (s *notificationActionService) Perform(ctx context.Context, signed string, confirmed bool) (*domain.NotificationActionResult, error) {
+	now := s.now()
+	token, err := s.Inspect(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+	if token.Action.NeedsConfirmation() && !confirmed {
+		return nil, errors.BadRequest("this action has to be confirmed").WithDetail("action", string(token.Action))
+	}
+	follow, err := s.followRepo.FindById(ctx, token.FollowID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.NotificationActionResult{Action: token.Action, FollowID: follow.ID, Follow: follow}
+	// The acknowledgement comes first, while the delivery still refers to a follow that exists.
+	if token.DeliveryID > 0 {
+		if _, err := s.deliveryService.Acknowledge(ctx, token.DeliveryID); err == nil {
+			result.Acknowledged = true
+		} else if token.Action == domain.NotificationActionAcknowledge {
+			return nil, err
+		} else {
+			s.logger.Warn("failed to acknowledge notification delivery from action link",
+				zap.Int64("delivery_id", token.DeliveryID),
+				zap.String("action", string(token.Action)),
+				zap.Error(err))
+		}
+	}
+
+	switch token.Action {
+	case domain.NotificationActionSnooze:
+		follow.Snooze(now.Add(s.links.snooze))
+		result.Follow, err = s.followRepo.Update(ctx, follow)
+	case domain.NotificationActionMute:
+		session, findErr := s.sessionRepo.FindOpenByStreamerId(ctx, follow.StreamerID)
+		if findErr != nil {
+			if errors.IsNotFoundError(findErr) {
+				return nil, errors.BadRequest("the stream has already ended").WithDetail("streamer_id", follow.StreamerID)
+			}
+			return nil, findErr
+		}
+		follow.MuteSession(session.ID)
+		result.Follow, err = s.followRepo.Update(ctx, follow)
+	case domain.NotificationActionUnfollow:
+		err = s.followRepo.Delete(ctx, follow.ID)
+		result.Follow = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
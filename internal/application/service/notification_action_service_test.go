@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testActionService struct {
+	*notificationActionService
+	followRepo  *repoMocks.MockUserFollowedStreamerRepository
+	sessionRepo *repoMocks.MockStreamSessionRepository
+	deliveries  *coreService.MockNotificationDeliveryService
+}
+
+func newTestActionService(t *testing.T, now time.Time) *testActionService {
+	followRepo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+	sessionRepo := repoMocks.NewMockStreamSessionRepository(t)
+	deliveries := coreService.NewMockNotificationDeliveryService(t)
+	cfg := &config.Config{JWT: config.JWTConfig{Secret: "secret"}}
+	svc := NewNotificationActionService(cfg, followRepo, sessionRepo, deliveries, zap.NewNop()).(*notificationActionService)
+	svc.now = func() time.Time { return now }
+	return &testActionService{notificationActionService: svc, followRepo: followRepo, sessionRepo: sessionRepo, deliveries: deliveries}
+}
+
+func (s *testActionService) sign(action domain.NotificationActionType, deliveryID int64) string {
+	return domain.NotificationActionToken{
+		Action:     action,
+		FollowID:   4,
+		DeliveryID: deliveryID,
+		ExpiresAt:  s.now().Add(time.Hour),
+	}.Sign(s.links.secret)
+}
+
+func TestNotificationActionService_Snooze(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	svc := newTestActionService(t, now)
+
+	svc.followRepo.EXPECT().FindById(ctx, int64(4)).Return(&domain.UserFollowedStreamer{ID: 4, StreamerID: 5}, nil).Once()
+	svc.deliveries.EXPECT().Acknowledge(ctx, int64(9)).Return(&domain.NotificationDelivery{ID: 9}, nil).Once()
+	svc.followRepo.EXPECT().Update(ctx, mock.MatchedBy(func(f *domain.UserFollowedStreamer) bool {
+		return f.SnoozedUntil != nil && f.SnoozedUntil.Equal(now.Add(defaultNotificationActionSnooze))
+	})).RunAndReturn(func(_ context.Context, f *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error) {
+		return f, nil
+	}).Once()
+
+	result, err := svc.Perform(ctx, svc.sign(domain.NotificationActionSnooze, 9), false)
+	require.NoError(t, err)
+	require.True(t, result.Acknowledged)
+	require.NotNil(t, result.Follow)
+}
+
+func TestNotificationActionService_Mute(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestActionService(t, time.Now())
+	follow := &domain.UserFollowedStreamer{ID: 4, StreamerID: 5}
+
+	// A failed acknowledgement does not keep the follow from being muted.
+	svc.followRepo.EXPECT().FindById(ctx, int64(4)).Return(follow, nil).Twice()
+	svc.deliveries.EXPECT().Acknowledge(ctx, int64(9)).Return(nil, errors.NotFound("NotificationDelivery")).Once()
+	svc.sessionRepo.EXPECT().FindOpenByStreamerId(ctx, int64(5)).Return(&domain.StreamSession{ID: 7}, nil).Once()
+	svc.followRepo.EXPECT().Update(ctx, mock.MatchedBy(func(f *domain.UserFollowedStreamer) bool {
+		return f.MutedSessionID != nil && *f.MutedSessionID == 7
+	})).Return(follow, nil).Once()
+	result, err := svc.Perform(ctx, svc.sign(domain.NotificationActionMute, 9), true)
+	require.NoError(t, err)
+	require.False(t, result.Acknowledged)
+
+	svc.sessionRepo.EXPECT().FindOpenByStreamerId(ctx, int64(5)).Return(nil, errors.NotFound("StreamSession")).Once()
+	_, err = svc.Perform(ctx, svc.sign(domain.NotificationActionMute, 0), true)
+	require.Equal(t, errors.ErrCodeBadRequest, errors.GetAppError(err).Code)
+}
+
+func TestNotificationActionService_Unfollow(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestActionService(t, time.Now())
+
+	svc.followRepo.EXPECT().FindById(ctx, int64(4)).Return(&domain.UserFollowedStreamer{ID: 4, StreamerID: 5}, nil).Once()
+	svc.followRepo.EXPECT().Delete(ctx, int64(4)).Return(nil).Once()
+	result, err := svc.Perform(ctx, svc.sign(domain.NotificationActionUnfollow, 0), true)
+	require.NoError(t, err)
+	require.Nil(t, result.Follow)
+	require.False(t, result.Acknowledged)
+
+	// Merely opening the link neither unfollows nor acknowledges.
+	_, err = svc.Perform(ctx, svc.sign(domain.NotificationActionUnfollow, 9), false)
+	require.Equal(t, errors.ErrCodeBadRequest, errors.GetAppError(err).Code)
+}
+
+func TestNotificationActionService_Acknowledge(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestActionService(t, time.Now())
+
+	svc.followRepo.EXPECT().FindById(ctx, int64(4)).Return(&domain.UserFollowedStreamer{ID: 4}, nil).Twice()
+	svc.deliveries.EXPECT().Acknowledge(ctx, int64(9)).Return(&domain.NotificationDelivery{ID: 9}, nil).Once()
+	result, err := svc.Perform(ctx, svc.sign(domain.NotificationActionAcknowledge, 9), false)
+	require.NoError(t, err)
+	require.True(t, result.Acknowledged)
+	require.NotNil(t, result.Follow)
+
+	// Acknowledging is the whole point of the action, so its failure is reported.
+	svc.deliveries.EXPECT().Acknowledge(ctx, int64(10)).Return(nil, errors.NotFound("NotificationDelivery")).Once()
+	_, err = svc.Perform(ctx, svc.sign(domain.NotificationActionAcknowledge, 10), false)
+	require.True(t, errors.IsNotFoundError(err))
+}
+
+func TestNewNotificationActionLinks(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWTConfig{Secret: "secret"}}
+	derived := newNotificationActionLinks(cfg)
+	require.Len(t, derived.secret, 32)
+	require.NotEqual(t, []byte("secret"), derived.secret)
+	require.Equal(t, derived.secret, newNotificationActionLinks(cfg).secret)
+
+	cfg.Notification.Actions.Secret = "actions"
+	require.Equal(t, []byte("actions"), newNotificationActionLinks(cfg).secret)
+
+	require.Empty(t, newNotificationActionLinks(&config.Config{}).secret)
+}
+
+func TestNotificationActionService_RejectsInvalidLinks(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestActionService(t, time.Now())
+
+	_, err := svc.Perform(ctx, "not-a-token", true)
+	require.Equal(t, errors.ErrCodeForbidden, errors.GetAppError(err).Code)
+
+	// Without a key nothing can be verified, not even tokens signed with an empty one.
+	svc.links.secret = nil
+	_, err = svc.Perform(ctx, svc.sign(domain.NotificationActionUnfollow, 0), true)
+	require.Equal(t, errors.ErrCodeForbidden, errors.GetAppError(err).Code)
+}
@@ -2,25 +2,38 @@ package service
 
 import (
 	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
 	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
 	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
 	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"github.com/ryuyb/fusion/internal/pkg/util"
 	"go.uber.org/zap"
 )
 
 type notificationChannelService struct {
-	repo   coreRepo.NotificationChannelRepository
-	logger *zap.Logger
+	repo      coreRepo.NotificationChannelRepository
+	providers *notificationInfra.NotificationProviderManager
+	logger    *zap.Logger
 }
 
-func NewNotificationChannelService(repo coreRepo.NotificationChannelRepository, logger *zap.Logger) coreService.NotificationChannelService {
+func NewNotificationChannelService(
+	repo coreRepo.NotificationChannelRepository,
+	providers *notificationInfra.NotificationProviderManager,
+	logger *zap.Logger,
+) coreService.NotificationChannelService {
 	return &notificationChannelService{
-		repo:   repo,
-		logger: logger,
+		repo:      repo,
+		providers: providers,
+		logger:    logger,
 	}
 }
 
@@ -32,11 +45,24 @@ func (s *notificationChannelService) Create(ctx context.Context, cmd *command.Cr
 	if exist {
 		return nil, errors.Conflict("notification channel already exists")
 	}
-	channel, err := buildNotificationChannelFromCommand(cmd)
+	channel, err := s.buildChannel(cmd, nil)
+	if err != nil {
+		return nil, err
+	}
+	var code string
+	if channel.ChannelType.RequiresVerification() {
+		if code, err = channel.StartVerification(time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	created, err := s.repo.Create(ctx, channel)
 	if err != nil {
 		return nil, err
 	}
-	return s.repo.Create(ctx, channel)
+	if code != "" {
+		s.deliverVerification(ctx, created, code, i18n.Resolve(cmd.Locale))
+	}
+	return s.redact(created), nil
 }
 
 func (s *notificationChannelService) Update(ctx context.Context, cmd *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error) {
@@ -53,20 +79,149 @@ func (s *notificationChannelService) Update(ctx context.Context, cmd *command.Up
 			return nil, errors.Conflict("notification channel already exists")
 		}
 	}
-	channel, err := buildNotificationChannelFromCommand(cmd.CreateNotificationChannelCommand)
+	channel, err := s.buildChannel(cmd.CreateNotificationChannelCommand, current)
 	if err != nil {
 		return nil, err
 	}
 	channel.ID = cmd.ID
-	return s.repo.Update(ctx, channel)
+	code, err := s.carryVerification(channel, current)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.Update(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	if code != "" {
+		s.deliverVerification(ctx, updated, code, i18n.Resolve(cmd.Locale))
+	}
+	return s.redact(updated), nil
+}
+
+// carryVerification decides whether an updated channel has to be verified again. A new type or config
+// may point somewhere else, so it restarts the handshake and returns the code to send; otherwise a
+// pending verification carries over and keeps the channel disabled.
+func (s *notificationChannelService) carryVerification(channel, current *domain.NotificationChannel) (string, error) {
+	if !channel.ChannelType.RequiresVerification() {
+		return "", nil
+	}
+	if channel.ChannelType != current.ChannelType || !sameChannelConfig(channel.Config, current.Config) {
+		return channel.StartVerification(time.Now())
+	}
+	if current.Verification != nil {
+		channel.Verification = current.Verification
+		channel.Enable = false
+	}
+	return "", nil
+}
+
+func sameChannelConfig(a, b map[string]any) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// ResendVerification issues a new code for a channel awaiting verification and sends it through the
+// channel. Unlike on create, a failed send is reported to the caller.
+func (s *notificationChannelService) ResendVerification(ctx context.Context, id int64, locale i18n.Locale) (*domain.NotificationChannel, error) {
+	channel, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if channel.IsVerified() {
+		return nil, errors.Conflict("notification channel is already verified")
+	}
+	now := time.Now()
+	if !channel.CanResendVerification(now) {
+		return nil, errors.BadRequest("verification code was sent recently, try again later").
+			WithDetail("retry_after_seconds", int64(domain.ChannelVerificationResendInterval/time.Second))
+	}
+	code, err := channel.StartVerification(now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateVerification(ctx, id, channel.Enable, channel.Verification); err != nil {
+		return nil, err
+	}
+	if err := s.sendVerification(ctx, channel, code, locale); err != nil {
+		return nil, errors.BadRequest("failed to send verification code").
+			WithDetail("reason", err.Error()).
+			Wrap(err)
+	}
+	return s.redact(channel), nil
+}
+
+// Verify confirms a channel with the code it received and enables it.
+func (s *notificationChannelService) Verify(ctx context.Context, id int64, code string) (*domain.NotificationChannel, error) {
+	channel, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	verifyErr := channel.Verify(strings.TrimSpace(code), time.Now())
+	if verifyErr != nil && channel.IsVerified() {
+		return nil, verifyErr
+	}
+	// A wrong code used up an attempt, so the handshake is saved either way.
+	if err := s.repo.UpdateVerification(ctx, id, channel.Enable, channel.Verification); err != nil {
+		return nil, err
+	}
+	if verifyErr != nil {
+		return nil, verifyErr
+	}
+	return s.redact(channel), nil
 }
 
+// deliverVerification sends the code of a channel that was just saved. The channel is kept when
+// sending fails; its verification then has no SentAt and a new code can be requested right away.
+func (s *notificationChannelService) deliverVerification(ctx context.Context, channel *domain.NotificationChannel, code string, locale i18n.Locale) {
+	if err := s.sendVerification(ctx, channel, code, locale); err != nil {
+		s.logger.Warn("failed to send notification channel verification code",
+			zap.Int64("id", channel.ID),
+			zap.String("channel_type", string(channel.ChannelType)),
+			zap.Error(err))
+	}
+}
+
+// sendVerification sends code through the channel and records when it went out.
+func (s *notificationChannelService) sendVerification(ctx context.Context, channel *domain.NotificationChannel, code string, locale i18n.Locale) error {
+	provider, err := s.providers.GetProvider(channel.ChannelType)
+	if err != nil {
+		return err
+	}
+	data := &external.NotificationData{
+		Title:     i18n.T(locale, "notification.verification.title"),
+		Content:   i18n.T(locale, "notification.verification.body", channel.Name, code, int(domain.ChannelVerificationTTL/time.Minute)),
+		EventType: domain.NotificationEventVerification,
+		Severity:  domain.NotificationSeverityNormal,
+	}
+	if err := provider.Send(ctx, channel, data); err != nil {
+		return err
+	}
+	now := time.Now()
+	channel.Verification.SentAt = &now
+	return s.repo.UpdateVerification(ctx, channel.ID, channel.Enable, channel.Verification)
+}
+
+// Delete removes a channel. The built-in inbox would only come back with the next notification, so it
+// can be disabled but not deleted.
 func (s *notificationChannelService) Delete(ctx context.Context, id int64) error {
+	channel, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if channel.ChannelType == domain.ChannelTypeInbox {
+		return errors.BadRequest("the inbox channel cannot be deleted").WithDetail("id", id)
+	}
 	return s.repo.Delete(ctx, id)
 }
 
 func (s *notificationChannelService) FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error) {
-	return s.repo.FindById(ctx, id)
+	channel, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.redact(channel), nil
 }
 
 func (s *notificationChannelService) ListByUserId(ctx context.Context, userID int64, page, pageSize int) ([]*domain.NotificationChannel, int, error) {
@@ -79,7 +234,132 @@ func (s *notificationChannelService) ListByUserId(ctx context.Context, userID in
 		return nil, 0, err
 	}
 	offset := (page - 1) * pageSize
-	return s.repo.ListByUserId(ctx, userID, offset, pageSize)
+	channels, total, err := s.repo.ListByUserId(ctx, userID, offset, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, channel := range channels {
+		channels[i] = s.redact(channel)
+	}
+	return channels, total, nil
+}
+
+func (s *notificationChannelService) RotateSecrets(ctx context.Context) (int, error) {
+	return s.repo.RotateSecrets(ctx)
+}
+
+// ListChannelTypes returns every channel type with a registered provider, sorted by type.
+func (s *notificationChannelService) ListChannelTypes(ctx context.Context) []*domain.NotificationChannelTypeSchema {
+	channelTypes := s.providers.GetSupportedChannels()
+	slices.Sort(channelTypes)
+
+	result := make([]*domain.NotificationChannelTypeSchema, 0, len(channelTypes))
+	for _, channelType := range channelTypes {
+		provider, err := s.providers.GetProvider(channelType)
+		if err != nil {
+			continue
+		}
+		result = append(result, &domain.NotificationChannelTypeSchema{
+			ChannelType: channelType,
+			Schema:      provider.ConfigSchema(),
+		})
+	}
+	return result
+}
+
+func (s *notificationChannelService) Test(ctx context.Context, id int64) (*domain.NotificationChannelTestResult, error) {
+	channel, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.providers.GetProvider(channel.ChannelType)
+	if err != nil {
+		return nil, errors.BadRequest("notification channel type is not supported").
+			WithDetail("channel_type", channel.ChannelType)
+	}
+
+	// Send through the saved channel rather than TestConnection so providers that
+	// deliver to the owner, like web push, reach their real targets.
+	result := s.runTest(func() error {
+		return provider.Send(ctx, channel, newTestNotificationData())
+	})
+	if err := s.repo.UpdateTestResult(ctx, id, result); err != nil {
+		s.logger.Warn("failed to record notification channel test result", zap.Int64("id", id), zap.Error(err))
+	}
+	// A test that gets through shows the channel works again, e.g. after its key was fixed.
+	if result.Success {
+		if err := s.repo.RecordSendSuccess(ctx, id, result.TestedAt); err != nil {
+			s.logger.Warn("failed to record notification channel health", zap.Int64("id", id), zap.Error(err))
+		}
+	}
+	return result, nil
+}
+
+func (s *notificationChannelService) TestConfig(ctx context.Context, channelType domain.NotificationChannelType, config map[string]any) (*domain.NotificationChannelTestResult, error) {
+	if err := s.providers.ValidateConfig(channelType, config); err != nil {
+		return nil, err
+	}
+	provider, err := s.providers.GetProvider(channelType)
+	if err != nil {
+		return nil, err
+	}
+	return s.runTest(func() error {
+		return provider.TestConnection(ctx, config)
+	}), nil
+}
+
+// runTest times send and turns a provider error into a failed result instead of a request error.
+func (s *notificationChannelService) runTest(send func() error) *domain.NotificationChannelTestResult {
+	started := time.Now()
+	err := send()
+	result := &domain.NotificationChannelTestResult{
+		Success:  err == nil,
+		Latency:  time.Since(started),
+		TestedAt: time.Now(),
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.Response = providerResponse(err)
+	}
+	return result
+}
+
+// redact masks secret config fields before a channel leaves the service.
+func (s *notificationChannelService) redact(channel *domain.NotificationChannel) *domain.NotificationChannel {
+	return channel.Redacted(s.providers.SecretFields(channel.ChannelType))
+}
+
+func newTestNotificationData() *external.NotificationData {
+	return &external.NotificationData{
+		Title:     "Test Notification",
+		Content:   "This is a test notification from Fusion",
+		EventType: domain.NotificationEventTest,
+		Severity:  domain.NotificationSeverityNormal,
+	}
+}
+
+// buildChannel converts the command and validates the config against the provider schema.
+// On update, secrets the client omitted or sent back redacted are taken from current. The built-in
+// inbox is created by Fusion itself, so no channel can be created as or turned into one, nor can the
+// inbox change type.
+func (s *notificationChannelService) buildChannel(cmd *command.CreateNotificationChannelCommand, current *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+	channel, err := buildNotificationChannelFromCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+	wasInbox := current != nil && current.ChannelType == domain.ChannelTypeInbox
+	if wasInbox != (channel.ChannelType == domain.ChannelTypeInbox) {
+		return nil, errors.BadRequest("the inbox channel is built in").WithDetail("channel_type", channel.ChannelType)
+	}
+	var stored map[string]any
+	if current != nil && current.ChannelType == channel.ChannelType {
+		stored = current.Config
+	}
+	channel.Config = domain.KeepSecrets(channel.Config, stored, s.providers.SecretFields(channel.ChannelType))
+	if err := s.providers.ValidateConfig(channel.ChannelType, channel.Config); err != nil {
+		return nil, err
+	}
+	return channel, nil
 }
 
 func buildNotificationChannelFromCommand(cmd *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error) {
@@ -95,12 +375,32 @@ func buildNotificationChannelFromCommand(cmd *command.CreateNotificationChannelC
 		}
 	}
 
+	template := domain.NotificationTemplate{Title: cmd.TitleTemplate, Body: cmd.BodyTemplate}.Normalize()
+	err := template.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	digestWindow := time.Duration(cmd.DigestWindowSeconds) * time.Second
+	if err := domain.ValidateDigestWindow(digestWindow); err != nil {
+		return nil, err
+	}
+	var dailySummary *domain.DailySummarySchedule
+	if strings.TrimSpace(cmd.DailySummaryAt) != "" {
+		if dailySummary, err = domain.NewDailySummarySchedule(cmd.DailySummaryAt, cmd.DailySummaryTimezone); err != nil {
+			return nil, err
+		}
+	}
+
 	return &domain.NotificationChannel{
-		UserID:      cmd.UserID,
-		ChannelType: domain.NotificationChannelType(cmd.ChannelType),
-		Name:        cmd.Name,
-		Config:      config,
-		Enable:      cmd.Enable,
-		Priority:    cmd.Priority,
+		UserID:       cmd.UserID,
+		ChannelType:  domain.NotificationChannelType(cmd.ChannelType),
+		Name:         cmd.Name,
+		Config:       config,
+		Enable:       cmd.Enable,
+		Priority:     cmd.Priority,
+		Template:     template,
+		DigestWindow: digestWindow,
+		DailySummary: dailySummary,
 	}, nil
 }
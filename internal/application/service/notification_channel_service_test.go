@@ -3,49 +3,119 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
 	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
+var testBarkSchema = &domain.ChannelConfigSchema{
+	Type:     domain.SchemaTypeObject,
+	Required: []string{"device_key"},
+	Properties: map[string]*domain.ChannelConfigSchema{
+		"device_key": {Type: domain.SchemaTypeString, Secret: true},
+		"volume":     {Type: domain.SchemaTypeInteger, Minimum: domain.SchemaBound(0), Maximum: domain.SchemaBound(10), Overridable: true},
+	},
+}
+
+func newTestChannelProviders(t *testing.T) *notificationInfra.NotificationProviderManager {
+	t.Helper()
+	manager, _ := newTestChannelProvidersWithBark(t)
+	return manager
+}
+
+func newTestChannelProvidersWithBark(t *testing.T) (*notificationInfra.NotificationProviderManager, *external.MockNotificationProvider) {
+	t.Helper()
+	bark := external.NewMockNotificationProvider(t)
+	bark.EXPECT().GetChannelType().Return(domain.ChannelTypeBark).Maybe()
+	bark.EXPECT().ConfigSchema().Return(testBarkSchema).Maybe()
+	ntfy := external.NewMockNotificationProvider(t)
+	ntfy.EXPECT().GetChannelType().Return(domain.ChannelTypeNtfy).Maybe()
+	ntfy.EXPECT().ConfigSchema().Return(&domain.ChannelConfigSchema{Type: domain.SchemaTypeObject}).Maybe()
+	return notificationInfra.NewNotificationProviderManager([]external.NotificationProvider{ntfy, bark}, zap.NewNop()), bark
+}
+
 func TestNotificationChannelService_Create(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockNotificationChannelRepository(t)
-	svc := NewNotificationChannelService(repo, zap.NewNop())
+	providers, bark := newTestChannelProvidersWithBark(t)
+	svc := NewNotificationChannelService(repo, providers, zap.NewNop())
 
 	cmd := &command.CreateNotificationChannelCommand{
 		UserID:      10,
-		ChannelType: string(domain.ChannelTypeEmail),
-		Name:        "email",
+		ChannelType: string(domain.ChannelTypeBark),
+		Name:        "bark",
 		Config: map[string]any{
-			"address": "user@example.com",
+			"device_key": "abc",
 		},
 		Enable:   true,
 		Priority: 1,
 	}
-	expected := &domain.NotificationChannel{ID: 1, UserID: cmd.UserID, Name: cmd.Name}
 
+	// The channel stays disabled until the code sent through it is confirmed.
 	repo.EXPECT().ExistByName(ctx, cmd.UserID, cmd.Name).Return(false, nil)
 	repo.EXPECT().Create(ctx, mock.MatchedBy(func(channel *domain.NotificationChannel) bool {
 		return channel.UserID == cmd.UserID &&
 			channel.Name == cmd.Name &&
 			channel.ChannelType == domain.NotificationChannelType(cmd.ChannelType) &&
-			channel.Config["address"] == cmd.Config["address"]
-	})).Return(expected, nil)
+			channel.Config["device_key"] == cmd.Config["device_key"] &&
+			!channel.Enable && channel.Verification != nil
+	})).RunAndReturn(func(_ context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+		channel.ID = 1
+		return channel, nil
+	}).Once()
+	bark.EXPECT().Send(ctx, mock.Anything, mock.MatchedBy(func(data *external.NotificationData) bool {
+		return data.EventType == domain.NotificationEventVerification
+	})).Return(nil).Once()
+	repo.EXPECT().UpdateVerification(ctx, int64(1), false, mock.MatchedBy(func(v *domain.ChannelVerification) bool {
+		return v != nil && v.SentAt != nil
+	})).Return(nil).Once()
 
 	created, err := svc.Create(ctx, cmd)
 	require.NoError(t, err)
-	require.Equal(t, expected, created)
+	require.False(t, created.Enable)
+	require.False(t, created.IsVerified())
+	require.Equal(t, domain.RedactedSecret, created.Config["device_key"])
+}
+
+func TestNotificationChannelService_Verify(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
+
+	channel := &domain.NotificationChannel{ID: 1, UserID: 10, ChannelType: domain.ChannelTypeBark, Name: "bark"}
+	code, err := channel.StartVerification(time.Now())
+	require.NoError(t, err)
+	repo.EXPECT().FindById(ctx, int64(1)).Return(channel, nil)
+
+	// A wrong code is rejected but still uses up an attempt.
+	repo.EXPECT().UpdateVerification(ctx, int64(1), false, mock.MatchedBy(func(v *domain.ChannelVerification) bool {
+		return v != nil && v.Attempts == 1
+	})).Return(nil).Once()
+	_, err = svc.Verify(ctx, 1, "not-it")
+	require.Error(t, err)
+
+	repo.EXPECT().UpdateVerification(ctx, int64(1), true, (*domain.ChannelVerification)(nil)).Return(nil).Once()
+	verified, err := svc.Verify(ctx, 1, code)
+	require.NoError(t, err)
+	require.True(t, verified.Enable)
+	require.True(t, verified.IsVerified())
+
+	_, err = svc.Verify(ctx, 1, code)
+	require.Error(t, err)
 }
 
 func TestNotificationChannelService_CreateConflict(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockNotificationChannelRepository(t)
-	svc := NewNotificationChannelService(repo, zap.NewNop())
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
 
 	cmd := &command.CreateNotificationChannelCommand{UserID: 10, Name: "email"}
 
@@ -58,14 +128,16 @@ func TestNotificationChannelService_CreateConflict(t *testing.T) {
 func TestNotificationChannelService_Update(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockNotificationChannelRepository(t)
-	svc := NewNotificationChannelService(repo, zap.NewNop())
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
 
 	current := &domain.NotificationChannel{ID: 1, UserID: 10, Name: "email"}
 	cmd := &command.UpdateNotificationChannelCommand{
 		ID: current.ID,
 		CreateNotificationChannelCommand: &command.CreateNotificationChannelCommand{
-			UserID: 10,
-			Name:   "email",
+			UserID:      10,
+			ChannelType: string(domain.ChannelTypeBark),
+			Name:        "email",
+			Config:      map[string]any{"device_key": "abc"},
 		},
 	}
 	expected := &domain.NotificationChannel{ID: current.ID, UserID: current.UserID, Name: current.Name}
@@ -83,7 +155,7 @@ func TestNotificationChannelService_Update(t *testing.T) {
 func TestNotificationChannelService_UpdateConflict(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockNotificationChannelRepository(t)
-	svc := NewNotificationChannelService(repo, zap.NewNop())
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
 
 	current := &domain.NotificationChannel{ID: 1, UserID: 10, Name: "email"}
 	cmd := &command.UpdateNotificationChannelCommand{
@@ -104,8 +176,156 @@ func TestNotificationChannelService_UpdateConflict(t *testing.T) {
 func TestNotificationChannelService_ListInvalidPagination(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockNotificationChannelRepository(t)
-	svc := NewNotificationChannelService(repo, zap.NewNop())
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
 
 	_, _, err := svc.ListByUserId(ctx, 1, 0, 10)
 	require.Error(t, err)
 }
+
+func TestNotificationChannelService_CreateInvalidConfig(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
+
+	cmd := &command.CreateNotificationChannelCommand{
+		UserID:      10,
+		ChannelType: string(domain.ChannelTypeBark),
+		Name:        "bark",
+		Config:      map[string]any{"volume": float64(11)},
+	}
+	repo.EXPECT().ExistByName(ctx, cmd.UserID, cmd.Name).Return(false, nil)
+
+	_, err := svc.Create(ctx, cmd)
+	appErr := errors.GetAppError(err)
+	require.NotNil(t, appErr)
+	require.Equal(t, errors.ErrCodeValidation, appErr.Code)
+
+	fieldErrors, ok := appErr.Details["errors"].([]domain.ChannelConfigFieldError)
+	require.True(t, ok)
+	require.ElementsMatch(t, []string{"config.device_key", "config.volume"}, []string{fieldErrors[0].Field, fieldErrors[1].Field})
+}
+
+func TestNotificationChannelService_CreateUnsupportedType(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
+
+	cmd := &command.CreateNotificationChannelCommand{UserID: 10, ChannelType: "pager", Name: "pager"}
+	repo.EXPECT().ExistByName(ctx, cmd.UserID, cmd.Name).Return(false, nil)
+
+	_, err := svc.Create(ctx, cmd)
+	require.Error(t, err)
+}
+
+func TestNotificationChannelService_InboxIsBuiltIn(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
+
+	cmd := &command.CreateNotificationChannelCommand{UserID: 10, ChannelType: string(domain.ChannelTypeInbox), Name: "mine"}
+	repo.EXPECT().ExistByName(ctx, cmd.UserID, cmd.Name).Return(false, nil).Once()
+	_, err := svc.Create(ctx, cmd)
+	require.Equal(t, errors.ErrCodeBadRequest, errors.GetAppError(err).Code)
+
+	inbox := domain.NewInboxChannel(10, domain.InboxChannelName)
+	inbox.ID = 4
+	repo.EXPECT().FindById(ctx, inbox.ID).Return(inbox, nil).Twice()
+	_, err = svc.Update(ctx, &command.UpdateNotificationChannelCommand{ID: inbox.ID, CreateNotificationChannelCommand: &command.CreateNotificationChannelCommand{
+		UserID: 10, ChannelType: string(domain.ChannelTypeBark), Name: domain.InboxChannelName, Config: map[string]any{"device_key": "abc"},
+	}})
+	require.Equal(t, errors.ErrCodeBadRequest, errors.GetAppError(err).Code)
+	require.Equal(t, errors.ErrCodeBadRequest, errors.GetAppError(svc.Delete(ctx, inbox.ID)).Code)
+}
+
+func TestNotificationChannelService_ListChannelTypes(t *testing.T) {
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
+
+	types := svc.ListChannelTypes(context.Background())
+	require.Len(t, types, 2)
+	require.Equal(t, domain.ChannelTypeBark, types[0].ChannelType)
+	require.Same(t, testBarkSchema, types[0].Schema)
+	require.Equal(t, domain.ChannelTypeNtfy, types[1].ChannelType)
+}
+
+func TestNotificationChannelService_TestRecordsResult(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	providers, bark := newTestChannelProvidersWithBark(t)
+	svc := NewNotificationChannelService(repo, providers, zap.NewNop())
+
+	channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Config: map[string]any{"device_key": "abc"}}
+	repo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Twice()
+	bark.EXPECT().Send(ctx, channel, mock.MatchedBy(func(data *external.NotificationData) bool {
+		return data.EventType == domain.NotificationEventTest
+	})).Return(nil).Once()
+	repo.EXPECT().UpdateTestResult(ctx, int64(3), mock.MatchedBy(func(r *domain.NotificationChannelTestResult) bool {
+		return r.Success && r.Error == "" && !r.TestedAt.IsZero()
+	})).Return(nil).Once()
+	repo.EXPECT().RecordSendSuccess(ctx, int64(3), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	result, err := svc.Test(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	sendErr := errors.BadRequest("bark returned non-success status").WithDetail("status", 400)
+	bark.EXPECT().Send(ctx, channel, mock.Anything).Return(sendErr).Once()
+	repo.EXPECT().UpdateTestResult(ctx, int64(3), mock.MatchedBy(func(r *domain.NotificationChannelTestResult) bool {
+		return !r.Success && r.Error != ""
+	})).Return(nil).Once()
+
+	result, err = svc.Test(ctx, 3)
+	require.NoError(t, err)
+	require.False(t, result.Success)
+	require.Equal(t, 400, result.Response["status"])
+}
+
+func TestNotificationChannelService_TestConfig(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	providers, bark := newTestChannelProvidersWithBark(t)
+	svc := NewNotificationChannelService(repo, providers, zap.NewNop())
+
+	_, err := svc.TestConfig(ctx, domain.ChannelTypeBark, map[string]any{})
+	require.Equal(t, errors.ErrCodeValidation, errors.GetAppError(err).Code)
+
+	config := map[string]any{"device_key": "abc"}
+	bark.EXPECT().TestConnection(ctx, config).Return(nil).Once()
+
+	result, err := svc.TestConfig(ctx, domain.ChannelTypeBark, config)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
+func TestNotificationChannelService_RedactsAndKeepsSecrets(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewNotificationChannelService(repo, newTestChannelProviders(t), zap.NewNop())
+
+	current := &domain.NotificationChannel{ID: 1, UserID: 10, ChannelType: domain.ChannelTypeBark, Name: "bark", Config: map[string]any{"device_key": "abc", "volume": float64(3)}}
+	repo.EXPECT().FindById(ctx, int64(1)).Return(current, nil)
+
+	found, err := svc.FindById(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, domain.RedactedSecret, found.Config["device_key"])
+	require.Equal(t, "abc", current.Config["device_key"])
+
+	cmd := &command.UpdateNotificationChannelCommand{
+		ID: 1,
+		CreateNotificationChannelCommand: &command.CreateNotificationChannelCommand{
+			UserID:      10,
+			ChannelType: string(domain.ChannelTypeBark),
+			Name:        "bark",
+			Config:      map[string]any{"device_key": domain.RedactedSecret, "volume": float64(3)},
+		},
+	}
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(channel *domain.NotificationChannel) bool {
+		return channel.Config["device_key"] == "abc" && channel.Config["volume"] == float64(3)
+	})).RunAndReturn(func(_ context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+		return channel, nil
+	}).Once()
+
+	updated, err := svc.Update(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, domain.RedactedSecret, updated.Config["device_key"])
+}
@@ -0,0 +1,684 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultOutboxBatchSize   = 20
+	defaultOutboxLease       = 2 * time.Minute
+	defaultOutboxMaxAttempts = 8
+	defaultOutboxBaseBackoff = 10 * time.Second
+	defaultOutboxMaxBackoff  = 30 * time.Minute
+
+	alertChannelBatchSize = 100
+
+	// payloadConfigOverrides keeps the follow's overrides for the channel next to the message, so
+	// they are laid over the channel config as it is when the delivery is sent.
+	payloadConfigOverrides = "config_overrides"
+)
+
+type notificationDeliveryService struct {
+	repo        coreRepo.NotificationDeliveryRepository
+	channelRepo coreRepo.NotificationChannelRepository
+	userRepo    coreRepo.UserRepository
+	providers   *notificationInfra.NotificationProviderManager
+	retention   time.Duration
+	outbox      config.OutboxConfig
+	rateLimit   config.RateLimitConfig
+	health      config.HealthConfig
+	actions     notificationActionLinks
+	events      coreService.LiveEventService
+	now         func() time.Time
+	logger      *zap.Logger
+}
+
+func NewNotificationDeliveryService(
+	cfg *config.Config,
+	repo coreRepo.NotificationDeliveryRepository,
+	channelRepo coreRepo.NotificationChannelRepository,
+	userRepo coreRepo.UserRepository,
+	providers *notificationInfra.NotificationProviderManager,
+	events coreService.LiveEventService,
+	logger *zap.Logger,
+) coreService.NotificationDeliveryService {
+	outbox := cfg.Notification.Outbox
+	if outbox.BatchSize <= 0 {
+		outbox.BatchSize = defaultOutboxBatchSize
+	}
+	if outbox.Lease <= 0 {
+		outbox.Lease = defaultOutboxLease
+	}
+	if outbox.MaxAttempts <= 0 {
+		outbox.MaxAttempts = defaultOutboxMaxAttempts
+	}
+	if outbox.BaseBackoff <= 0 {
+		outbox.BaseBackoff = defaultOutboxBaseBackoff
+	}
+	if outbox.MaxBackoff <= 0 {
+		outbox.MaxBackoff = defaultOutboxMaxBackoff
+	}
+	return &notificationDeliveryService{
+		repo:        repo,
+		channelRepo: channelRepo,
+		userRepo:    userRepo,
+		providers:   providers,
+		retention:   cfg.Notification.Delivery.Retention,
+		outbox:      outbox,
+		rateLimit:   cfg.Notification.RateLimit,
+		health:      cfg.Notification.Health,
+		actions:     newNotificationActionLinks(cfg),
+		events:      events,
+		now:         time.Now,
+		logger:      logger,
+	}
+}
+
+func (s *notificationDeliveryService) Dispatch(ctx context.Context, routing domain.NotificationRouting, follow *domain.UserFollowedStreamer, targets []coreService.DeliveryTarget) ([]*domain.NotificationDelivery, error) {
+	targets = slices.Clone(targets)
+	slices.SortStableFunc(targets, func(a, b coreService.DeliveryTarget) int {
+		return cmp.Compare(b.Channel.Priority, a.Channel.Priority)
+	})
+
+	now := s.now()
+	sends := s.newSendCounter(now)
+	deliveries := make([]*domain.NotificationDelivery, 0, len(targets))
+	routed := make([]*domain.NotificationDelivery, 0, len(targets))
+	for _, target := range targets {
+		if !s.providers.HasProvider(target.Channel.ChannelType) {
+			s.logger.Warn("skipping notification channel without provider",
+				zap.Int64("channel_id", target.Channel.ID),
+				zap.String("channel_type", string(target.Channel.ChannelType)))
+			continue
+		}
+		payload, err := notificationPayload(target.Data)
+		if err != nil {
+			return nil, err
+		}
+		if follow != nil && len(follow.ChannelOverrides[target.Channel.ID]) > 0 {
+			payload[payloadConfigOverrides] = follow.ChannelOverrides[target.Channel.ID]
+		}
+		delivery := domain.NewNotificationDelivery(target.Channel, follow, target.Data.StreamerID, target.Data.EventType, payload, now)
+		switch {
+		case target.Channel.ChannelType == domain.ChannelTypeInbox:
+			// The inbox keeps a record of every notification, so it is never held back by a route, the
+			// rate limits or a digest, and is queued right away.
+		case target.Channel.Digests(target.Data.EventType):
+			delivery.Batch()
+		case target.Data.EventType.IsDigestible():
+			allowed, err := sends.Allow(ctx, target.Channel.UserID, target.Channel.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				delivery.Throttle()
+				break
+			}
+			routed = append(routed, delivery)
+		default:
+			routed = append(routed, delivery)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if routing.IsSequential() && len(routed) > 0 {
+		domain.RouteDeliveries(uuid.NewString(), routing, routed, now)
+	}
+	return s.repo.CreateBatch(ctx, deliveries)
+}
+
+func (s *notificationDeliveryService) ClaimDue(ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	return s.repo.ClaimDue(ctx, s.now(), s.outbox.BatchSize, s.outbox.Lease)
+}
+
+func (s *notificationDeliveryService) Process(ctx context.Context, delivery *domain.NotificationDelivery) error {
+	channel, err := s.channelRepo.FindById(ctx, delivery.ChannelID)
+	switch {
+	case err == nil && channel.UserID == delivery.UserID && channel.Enable:
+		s.send(ctx, channel, delivery)
+	case err == nil && channel.UserID == delivery.UserID:
+		delivery.MarkDead(0, nil, errors.BadRequest("notification channel is disabled").WithDetail("id", channel.ID))
+	case err == nil || errors.IsNotFoundError(err):
+		delivery.MarkDead(0, nil, errors.NotFound("NotificationChannel").WithDetail("id", delivery.ChannelID))
+	default:
+		s.fail(delivery, 0, err)
+	}
+
+	if _, err := s.repo.Update(ctx, delivery); err != nil {
+		s.logger.Error("failed to record notification delivery outcome",
+			zap.Int64("delivery_id", delivery.ID),
+			zap.String("status", string(delivery.Status)),
+			zap.Error(err))
+		return err
+	}
+	if eventType, data, ok := domain.NewDeliveryLiveEvent(delivery); ok {
+		s.events.Publish(ctx, delivery.UserID, eventType, data)
+	}
+	s.advanceRoute(ctx, delivery)
+	return nil
+}
+
+func (s *notificationDeliveryService) Acknowledge(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	delivery, err := s.repo.Acknowledge(ctx, id, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if route := delivery.Route; route != nil {
+		if _, err := s.repo.SkipRoute(ctx, route.ID, route.Step); err != nil {
+			return nil, err
+		}
+	}
+	return delivery, nil
+}
+
+func (s *notificationDeliveryService) FlushDigests(ctx context.Context) (int, error) {
+	batched, err := s.repo.ListBatched(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now()
+	flushed := 0
+	for _, batch := range groupByChannel(batched) {
+		if err := ctx.Err(); err != nil {
+			return flushed, err
+		}
+		due, err := s.digestDue(ctx, batch, now)
+		if err == nil && due {
+			err = s.queueCollapsed(ctx, batch, now, domain.RenderDigest)
+			if err == nil {
+				flushed++
+			}
+		}
+		if err != nil {
+			s.logger.Warn("failed to flush notification digest",
+				zap.Int64("channel_id", batch[0].ChannelID),
+				zap.Int("batched", len(batch)),
+				zap.Error(err))
+		}
+	}
+	return flushed, nil
+}
+
+func (s *notificationDeliveryService) FlushThrottled(ctx context.Context) (int, error) {
+	throttled, err := s.repo.ListThrottled(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now()
+	sends := s.newSendCounter(now)
+	flushed := 0
+	for _, batch := range groupByChannel(throttled) {
+		if err := ctx.Err(); err != nil {
+			return flushed, err
+		}
+		allowed, err := sends.Allow(ctx, batch[0].UserID, batch[0].ChannelID)
+		if err == nil && allowed {
+			err = s.queueCollapsed(ctx, batch, now, domain.RenderOverflow)
+			if err == nil {
+				flushed++
+			}
+		}
+		if err != nil {
+			s.logger.Warn("failed to flush throttled notifications",
+				zap.Int64("channel_id", batch[0].ChannelID),
+				zap.Int("throttled", len(batch)),
+				zap.Error(err))
+		}
+	}
+	return flushed, nil
+}
+
+func (s *notificationDeliveryService) Resend(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	delivery, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status == domain.DeliveryStatusProcessing {
+		return nil, errors.Conflict("notification delivery is being processed").WithDetail("id", id)
+	}
+	delivery.Requeue(s.now())
+	return s.repo.Update(ctx, delivery)
+}
+
+func (s *notificationDeliveryService) FindById(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+func (s *notificationDeliveryService) ListByUserId(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, page, pageSize int) ([]*domain.NotificationDelivery, int, error) {
+	if err := s.validateListParams(filter, page, pageSize); err != nil {
+		return nil, 0, err
+	}
+	offset := (page - 1) * pageSize
+	return s.repo.ListByUserId(ctx, userID, filter, offset, pageSize)
+}
+
+func (s *notificationDeliveryService) ListDeadLetters(ctx context.Context, filter *domain.NotificationDeliveryFilter, page, pageSize int) ([]*domain.NotificationDelivery, int, error) {
+	scoped := domain.NotificationDeliveryFilter{}
+	if filter != nil {
+		scoped = *filter
+	}
+	scoped.Status = domain.DeliveryStatusDead
+	if err := s.validateListParams(&scoped, page, pageSize); err != nil {
+		return nil, 0, err
+	}
+	offset := (page - 1) * pageSize
+	return s.repo.List(ctx, &scoped, offset, pageSize)
+}
+
+func (s *notificationDeliveryService) ReplayDeadLetters(ctx context.Context, filter *domain.NotificationDeliveryFilter) (int, error) {
+	return s.repo.RequeueDead(ctx, filter, s.now())
+}
+
+func (s *notificationDeliveryService) PurgeExpired(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.repo.DeleteCreatedBefore(ctx, s.now().Add(-s.retention))
+}
+
+func (s *notificationDeliveryService) validateListParams(filter *domain.NotificationDeliveryFilter, page, pageSize int) error {
+	if err := util.ValidatePagination(page, pageSize); err != nil {
+		s.logger.Warn("invalid pagination parameters for notification delivery",
+			zap.Int("page", page),
+			zap.Int("page_size", pageSize),
+			zap.Error(err),
+		)
+		return err
+	}
+	if filter != nil && filter.Status != "" && !filter.Status.IsValid() {
+		return errors.BadRequest("notification delivery status is invalid").WithDetail("status", filter.Status)
+	}
+	return nil
+}
+
+// advanceRoute moves a sequential route on once one of its steps is final. A delivered first-success
+// step makes the remaining steps unnecessary; a dead step hands over to the next channel right away
+// instead of waiting for its turn.
+func (s *notificationDeliveryService) advanceRoute(ctx context.Context, delivery *domain.NotificationDelivery) {
+	route := delivery.Route
+	if route == nil {
+		return
+	}
+	var err error
+	switch {
+	case delivery.Status == domain.DeliveryStatusSent && route.Mode == domain.RoutingModeFirstSuccess:
+		_, err = s.repo.SkipRoute(ctx, route.ID, route.Step)
+	case delivery.Status == domain.DeliveryStatusDead:
+		_, err = s.repo.PromoteRouteStep(ctx, route.ID, route.Step+1, s.now())
+	}
+	if err != nil {
+		s.logger.Error("failed to advance notification route",
+			zap.Int64("delivery_id", delivery.ID),
+			zap.String("route_id", route.ID),
+			zap.Int("step", route.Step),
+			zap.Error(err))
+	}
+}
+
+// digestDue reports whether the digest window opened by the oldest batched delivery has closed. Batches
+// of deleted channels, or of channels no longer in digest mode, are due right away.
+func (s *notificationDeliveryService) digestDue(ctx context.Context, batch []*domain.NotificationDelivery, now time.Time) (bool, error) {
+	var window time.Duration
+	channel, err := s.channelRepo.FindById(ctx, batch[0].ChannelID)
+	switch {
+	case err == nil:
+		window = channel.DigestWindow
+	case !errors.IsNotFoundError(err):
+		return false, err
+	}
+	return !now.Before(batch[0].CreatedAt.Add(window)), nil
+}
+
+// queueCollapsed replaces a channel's batched or throttled deliveries with one message rendered by
+// render in the locale of the channel owner. A lone notification is sent as it was rendered for its
+// follow.
+func (s *notificationDeliveryService) queueCollapsed(ctx context.Context, batch []*domain.NotificationDelivery, now time.Time, render func(i18n.Locale, []domain.DigestEntry) *domain.RenderedNotification) error {
+	payload := batch[0].Payload
+	if len(batch) > 1 {
+		user, err := s.userRepo.FindById(ctx, batch[0].UserID)
+		if err != nil {
+			return err
+		}
+		if payload, err = digestPayload(i18n.Resolve(user.Locale), batch, render); err != nil {
+			return err
+		}
+	}
+	ids := make([]int64, len(batch))
+	for i, delivery := range batch {
+		ids[i] = delivery.ID
+	}
+	_, err := s.repo.CreateDigest(ctx, domain.NewDigestDelivery(batch, payload, now), ids)
+	return err
+}
+
+// groupByChannel splits deliveries ordered by channel into one slice per channel.
+func groupByChannel(deliveries []*domain.NotificationDelivery) [][]*domain.NotificationDelivery {
+	var groups [][]*domain.NotificationDelivery
+	for start := 0; start < len(deliveries); {
+		end := start + 1
+		for end < len(deliveries) && deliveries[end].ChannelID == deliveries[start].ChannelID {
+			end++
+		}
+		groups = append(groups, deliveries[start:end])
+		start = end
+	}
+	return groups
+}
+
+// digestPayload lists every notification of batch in one message. The digest is only low severity
+// when every notification in it was, e.g. all were batched during passive quiet hours.
+func digestPayload(locale i18n.Locale, batch []*domain.NotificationDelivery, render func(i18n.Locale, []domain.DigestEntry) *domain.RenderedNotification) (map[string]any, error) {
+	entries := make([]domain.DigestEntry, 0, len(batch))
+	severity := domain.NotificationSeverityLow
+	for _, delivery := range batch {
+		data, err := notificationDataFromPayload(delivery.Payload)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, domain.DigestEntry{
+			Streamer: cmp.Or(data.StreamerName, data.Title),
+			Title:    data.StreamTitle,
+			URL:      data.URL,
+		})
+		if data.Severity != domain.NotificationSeverityLow {
+			severity = domain.NotificationSeverityNormal
+		}
+	}
+	rendered := render(locale, entries)
+	return notificationPayload(&external.NotificationData{
+		Title:     rendered.Title,
+		Content:   rendered.Body,
+		EventType: domain.NotificationEventDigest,
+		Severity:  severity,
+	})
+}
+
+// sendCounter checks sends against the per-channel and per-user rate limits. It counts what was stored
+// within the window once per channel and user and then adds the sends it allowed itself.
+type sendCounter struct {
+	repo      coreRepo.NotificationDeliveryRepository
+	limit     config.RateLimitConfig
+	since     time.Time
+	byChannel map[int64]int
+	byUser    map[int64]int
+}
+
+func (s *notificationDeliveryService) newSendCounter(now time.Time) *sendCounter {
+	return &sendCounter{
+		repo:      s.repo,
+		limit:     s.rateLimit,
+		since:     now.Add(-s.rateLimit.Window),
+		byChannel: make(map[int64]int),
+		byUser:    make(map[int64]int),
+	}
+}
+
+// Allow reports whether one more notification may go to the channel and counts it if so.
+func (c *sendCounter) Allow(ctx context.Context, userID, channelID int64) (bool, error) {
+	if c.limit.Window <= 0 {
+		return true, nil
+	}
+	if c.limit.PerChannel > 0 {
+		count, err := c.count(ctx, c.byChannel, channelID, c.repo.CountSentByChannelSince)
+		if err != nil || count >= c.limit.PerChannel {
+			return false, err
+		}
+	}
+	if c.limit.PerUser > 0 {
+		count, err := c.count(ctx, c.byUser, userID, c.repo.CountSentByUserSince)
+		if err != nil || count >= c.limit.PerUser {
+			return false, err
+		}
+	}
+	c.byChannel[channelID]++
+	c.byUser[userID]++
+	return true, nil
+}
+
+func (c *sendCounter) count(ctx context.Context, counts map[int64]int, id int64, load func(context.Context, int64, time.Time) (int, error)) (int, error) {
+	if count, ok := counts[id]; ok {
+		return count, nil
+	}
+	count, err := load(ctx, id, c.since)
+	if err != nil {
+		return 0, err
+	}
+	counts[id] = count
+	return count, nil
+}
+
+// send calls the channel provider and stamps the outcome on delivery.
+func (s *notificationDeliveryService) send(ctx context.Context, channel *domain.NotificationChannel, delivery *domain.NotificationDelivery) {
+	data, err := notificationDataFromPayload(delivery.Payload)
+	if err != nil {
+		delivery.MarkDead(0, nil, err)
+		return
+	}
+	provider, err := s.providers.GetProvider(channel.ChannelType)
+	if err != nil {
+		delivery.MarkDead(0, nil, err)
+		return
+	}
+
+	if overrides, ok := delivery.Payload[payloadConfigOverrides].(map[string]any); ok {
+		overridden := *channel
+		overridden.Config = provider.ConfigSchema().ApplyOverrides(channel.Config, overrides)
+		channel = &overridden
+	}
+	data.Actions = s.actionLinks(ctx, delivery)
+
+	started := time.Now()
+	err = provider.Send(ctx, channel, data)
+	latency := time.Since(started)
+	if err != nil {
+		s.fail(delivery, latency, err)
+		// A retry may still go through, so only a delivery that was given up on counts against the channel.
+		if delivery.Status == domain.DeliveryStatusDead {
+			s.recordFailure(ctx, channel, err)
+		}
+		return
+	}
+	delivery.MarkSent(latency, nil, s.now())
+	if err := s.channelRepo.RecordSendSuccess(ctx, channel.ID, s.now()); err != nil {
+		s.logger.Warn("failed to record notification channel health",
+			zap.Int64("channel_id", channel.ID),
+			zap.Error(err))
+	}
+}
+
+// actionLinks makes the signed links sent with delivery, labeled in the language of its owner.
+func (s *notificationDeliveryService) actionLinks(ctx context.Context, delivery *domain.NotificationDelivery) []external.NotificationAction {
+	if !s.actions.enabled() || len(domain.NotificationActionsFor(delivery)) == 0 {
+		return nil
+	}
+	locale := i18n.Default
+	if user, err := s.userRepo.FindById(ctx, delivery.UserID); err == nil {
+		locale = i18n.Resolve(user.Locale)
+	} else {
+		s.logger.Warn("failed to look up notification delivery owner, labeling action links in the default language",
+			zap.Int64("delivery_id", delivery.ID),
+			zap.Error(err))
+	}
+	return s.actions.build(locale, delivery, s.now())
+}
+
+// recordFailure counts a dead-lettered send against the channel's health. The send that completes the
+// failure streak disables the channel and alerts the owner through their other channels.
+func (s *notificationDeliveryService) recordFailure(ctx context.Context, channel *domain.NotificationChannel, sendErr error) {
+	now := s.now()
+	updated, err := s.channelRepo.RecordSendFailure(ctx, channel.ID, sendErr.Error(), now)
+	if err == nil && updated.ExceedsFailureThreshold(s.health.FailureThreshold) {
+		var disabled bool
+		disabled, err = s.channelRepo.DisableUnhealthy(ctx, channel.ID, now)
+		if err == nil && disabled {
+			s.logger.Warn("notification channel disabled after repeated failures",
+				zap.Int64("channel_id", channel.ID),
+				zap.Int("consecutive_failures", updated.Health.ConsecutiveFailures),
+				zap.Error(sendErr))
+			err = s.alertDisabled(ctx, updated)
+		}
+	}
+	if err != nil {
+		s.logger.Warn("failed to record notification channel health",
+			zap.Int64("channel_id", channel.ID),
+			zap.Error(err))
+	}
+}
+
+// alertDisabled tells the owner of disabled through every other channel that can still reach them.
+// Nothing is sent when they have none left.
+func (s *notificationDeliveryService) alertDisabled(ctx context.Context, disabled *domain.NotificationChannel) error {
+	var targets []coreService.DeliveryTarget
+	var data *external.NotificationData
+	for offset := 0; ; {
+		channels, total, err := s.channelRepo.ListByUserId(ctx, disabled.UserID, offset, alertChannelBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, channel := range channels {
+			if channel.ID == disabled.ID || !channel.CanReceiveAlerts() {
+				continue
+			}
+			if data == nil {
+				if data, err = s.channelDisabledData(ctx, disabled); err != nil {
+					return err
+				}
+			}
+			targets = append(targets, coreService.DeliveryTarget{Channel: channel, Data: data})
+		}
+		offset += len(channels)
+		if len(channels) == 0 || offset >= total {
+			break
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	_, err := s.Dispatch(ctx, domain.DefaultNotificationRouting, nil, targets)
+	return err
+}
+
+func (s *notificationDeliveryService) channelDisabledData(ctx context.Context, disabled *domain.NotificationChannel) (*external.NotificationData, error) {
+	user, err := s.userRepo.FindById(ctx, disabled.UserID)
+	if err != nil {
+		return nil, err
+	}
+	locale := i18n.Resolve(user.Locale)
+	return &external.NotificationData{
+		Title: i18n.T(locale, "notification.channel_disabled.title", disabled.Name),
+		Content: i18n.T(locale, "notification.channel_disabled.body",
+			disabled.Name, disabled.Health.ConsecutiveFailures, disabled.Health.LastError),
+		EventType: domain.NotificationEventChannelDisabled,
+		Severity:  domain.NotificationSeverityHigh,
+	}, nil
+}
+
+// fail schedules a retry for transient errors while the retry budget lasts, otherwise dead-letters the delivery.
+func (s *notificationDeliveryService) fail(delivery *domain.NotificationDelivery, latency time.Duration, err error) {
+	response := providerResponse(err)
+	if !isTransientDeliveryError(err) || delivery.Attempts+1 >= s.outbox.MaxAttempts {
+		s.logger.Warn("notification delivery dead-lettered",
+			zap.Int64("delivery_id", delivery.ID),
+			zap.Int64("channel_id", delivery.ChannelID),
+			zap.Int("attempts", delivery.Attempts+1),
+			zap.Error(err))
+		delivery.MarkDead(latency, response, err)
+		return
+	}
+	delivery.MarkRetry(latency, response, err, s.now().Add(s.backoff(delivery.Attempts+1)))
+}
+
+// backoff doubles the delay for every attempt up to the maximum and keeps a random half of it as jitter.
+func (s *notificationDeliveryService) backoff(attempt int) time.Duration {
+	delay := s.outbox.MaxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if d := s.outbox.BaseBackoff << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// isTransientDeliveryError treats network failures, throttling and upstream 5xx as retryable.
+// Configuration and other 4xx errors will not succeed by retrying.
+func isTransientDeliveryError(err error) bool {
+	appErr := errors.GetAppError(err)
+	if appErr == nil {
+		return true
+	}
+	switch appErr.Code {
+	case errors.ErrCodeInternal, errors.ErrCodeDatabaseError:
+		return true
+	case errors.ErrCodeBadRequest:
+		status, ok := appErr.Details["status"].(int)
+		if !ok {
+			return false
+		}
+		return status == http.StatusRequestTimeout || status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
+
+// providerResponse keeps what the provider reported, e.g. the upstream status and body carried in AppError details.
+func providerResponse(err error) map[string]any {
+	appErr := errors.GetAppError(err)
+	if appErr == nil {
+		return nil
+	}
+	response := map[string]any{
+		"code":    string(appErr.Code),
+		"message": appErr.Message,
+	}
+	for k, v := range appErr.Details {
+		response[k] = v
+	}
+	return response
+}
+
+func notificationPayload(data *external.NotificationData) (map[string]any, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, errors.Internal(err)
+	}
+	return payload, nil
+}
+
+func notificationDataFromPayload(payload map[string]any) (*external.NotificationData, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	var data external.NotificationData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, errors.BadRequest("notification delivery payload is invalid").Wrap(err)
+	}
+	return &data, nil
+}
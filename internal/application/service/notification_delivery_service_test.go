@@ -0,0 +1,722 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/dingtalk"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestDeliveryService(t *testing.T, retention time.Duration) (*repoMocks.MockNotificationDeliveryRepository, *repoMocks.MockNotificationChannelRepository, *external.MockNotificationProvider, *notificationDeliveryService) {
+	t.Helper()
+	repo := repoMocks.NewMockNotificationDeliveryRepository(t)
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	userRepo := repoMocks.NewMockUserRepository(t)
+	userRepo.EXPECT().FindById(mock.Anything, mock.Anything).Return(&domain.User{}, nil).Maybe()
+	provider := external.NewMockNotificationProvider(t)
+	provider.EXPECT().GetChannelType().Return(domain.ChannelTypeBark).Maybe()
+	manager := notificationInfra.NewNotificationProviderManager([]external.NotificationProvider{provider}, zap.NewNop())
+
+	cfg := &config.Config{Notification: config.NotificationConfig{Delivery: config.DeliveryConfig{Retention: retention}}}
+	events := NewLiveEventService(cfg, zap.NewNop())
+	svc := NewNotificationDeliveryService(cfg, repo, channelRepo, userRepo, manager, events, zap.NewNop()).(*notificationDeliveryService)
+	return repo, channelRepo, provider, svc
+}
+
+func passthroughDelivery(_ context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	return delivery, nil
+}
+
+func newTestDeliveryTargets() []coreService.DeliveryTarget {
+	data := &external.NotificationData{
+		Title:      "title",
+		Content:    "content",
+		EventType:  domain.NotificationEventStreamOnline,
+		StreamerID: 5,
+	}
+	return []coreService.DeliveryTarget{
+		{Channel: &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Priority: 1}, Data: data},
+		{Channel: &domain.NotificationChannel{ID: 4, UserID: 1, ChannelType: domain.ChannelTypeEmail, Priority: 9}, Data: data},
+		{Channel: &domain.NotificationChannel{ID: 6, UserID: 1, ChannelType: domain.ChannelTypeBark, Priority: 5}, Data: data},
+	}
+}
+
+func TestNotificationDeliveryService_DispatchBroadcast(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+	follow := &domain.UserFollowedStreamer{ID: 4, UserID: 1}
+
+	repo.EXPECT().CreateBatch(ctx, mock.MatchedBy(func(deliveries []*domain.NotificationDelivery) bool {
+		if len(deliveries) != 2 || deliveries[0].ChannelID != 6 || deliveries[1].ChannelID != 3 {
+			return false
+		}
+		for _, d := range deliveries {
+			if d.Status != domain.DeliveryStatusPending || d.Route != nil || d.NextAttemptAt == nil ||
+				*d.FollowID != 4 || *d.StreamerID != 5 || d.Payload["title"] != "title" {
+				return false
+			}
+		}
+		return true
+	})).RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+		return deliveries, nil
+	}).Once()
+
+	deliveries, err := svc.Dispatch(ctx, domain.DefaultNotificationRouting, follow, newTestDeliveryTargets())
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+}
+
+func TestNotificationDeliveryService_DispatchSequential(t *testing.T) {
+	now := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		routing     domain.NotificationRouting
+		wantStatus  domain.NotificationDeliveryStatus
+		wantNextDue *time.Time
+	}{
+		{
+			name:       "first success holds later steps",
+			routing:    domain.NotificationRouting{Mode: domain.RoutingModeFirstSuccess},
+			wantStatus: domain.DeliveryStatusHeld,
+		},
+		{
+			name:        "escalation schedules later steps",
+			routing:     domain.NotificationRouting{Mode: domain.RoutingModeEscalate, EscalateAfter: time.Minute},
+			wantStatus:  domain.DeliveryStatusPending,
+			wantNextDue: lo.ToPtr(now.Add(time.Minute)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo, _, _, svc := newTestDeliveryService(t, 0)
+			svc.now = func() time.Time { return now }
+
+			var created []*domain.NotificationDelivery
+			repo.EXPECT().CreateBatch(ctx, mock.Anything).
+				RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+					created = deliveries
+					return deliveries, nil
+				}).Once()
+
+			_, err := svc.Dispatch(ctx, tt.routing, nil, newTestDeliveryTargets())
+			require.NoError(t, err)
+			require.Len(t, created, 2)
+
+			primary, next := created[0], created[1]
+			require.Equal(t, int64(6), primary.ChannelID)
+			require.Equal(t, domain.DeliveryStatusPending, primary.Status)
+			require.Equal(t, now, *primary.NextAttemptAt)
+			require.Equal(t, 0, primary.Route.Step)
+			require.NotEmpty(t, primary.Route.ID)
+
+			require.Equal(t, int64(3), next.ChannelID)
+			require.Equal(t, tt.wantStatus, next.Status)
+			require.Equal(t, tt.wantNextDue, next.NextAttemptAt)
+			require.Equal(t, primary.Route.ID, next.Route.ID)
+			require.Equal(t, 1, next.Route.Step)
+			require.Equal(t, tt.routing.Mode, next.Route.Mode)
+		})
+	}
+}
+
+func TestNotificationDeliveryService_DispatchQueuesInboxOutsideRoute(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+	now := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	svc.rateLimit = config.RateLimitConfig{Window: 10 * time.Minute, PerUser: 1}
+	inboxProvider := external.NewMockNotificationProvider(t)
+	inboxProvider.EXPECT().GetChannelType().Return(domain.ChannelTypeInbox).Maybe()
+	svc.providers = notificationInfra.NewNotificationProviderManager([]external.NotificationProvider{provider, inboxProvider}, zap.NewNop())
+
+	// The user's budget fits only the first external channel; the inbox neither uses it up nor waits for it.
+	inbox := &domain.NotificationChannel{ID: 9, UserID: 1, ChannelType: domain.ChannelTypeInbox, Enable: true, DigestWindow: time.Hour}
+	targets := append(newTestDeliveryTargets(), coreService.DeliveryTarget{Channel: inbox, Data: newTestDeliveryTargets()[0].Data})
+	repo.EXPECT().CountSentByUserSince(ctx, int64(1), now.Add(-10*time.Minute)).Return(0, nil).Once()
+	var created []*domain.NotificationDelivery
+	repo.EXPECT().CreateBatch(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+			created = deliveries
+			return deliveries, nil
+		}).Once()
+
+	_, err := svc.Dispatch(ctx, domain.NotificationRouting{Mode: domain.RoutingModeFirstSuccess}, nil, targets)
+	require.NoError(t, err)
+	require.Len(t, created, 3)
+	primary, throttled, queued := created[0], created[1], created[2]
+	require.Equal(t, int64(6), primary.ChannelID)
+	require.Equal(t, domain.DeliveryStatusThrottled, throttled.Status)
+	require.Equal(t, int64(9), queued.ChannelID)
+	require.Equal(t, domain.DeliveryStatusPending, queued.Status)
+	require.Equal(t, now, *queued.NextAttemptAt)
+	require.Nil(t, queued.Route)
+
+	// The external channel succeeding ends its route, and the inbox still gets the message.
+	for _, delivery := range []*domain.NotificationDelivery{primary, queued} {
+		delivery.Status = domain.DeliveryStatusProcessing
+	}
+	external6 := &domain.NotificationChannel{ID: 6, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+	channelRepo.EXPECT().FindById(ctx, int64(6)).Return(external6, nil).Once()
+	channelRepo.EXPECT().FindById(ctx, int64(9)).Return(inbox, nil).Once()
+	provider.EXPECT().Send(ctx, external6, mock.Anything).Return(nil).Once()
+	inboxProvider.EXPECT().Send(ctx, inbox, mock.Anything).Return(nil).Once()
+	channelRepo.EXPECT().RecordSendSuccess(ctx, mock.Anything, now).Return(nil).Twice()
+	repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Twice()
+	repo.EXPECT().SkipRoute(ctx, primary.Route.ID, 0).Return(0, nil).Once()
+
+	require.NoError(t, svc.Process(ctx, primary))
+	require.NoError(t, svc.Process(ctx, queued))
+	require.Equal(t, domain.DeliveryStatusSent, primary.Status)
+	require.Equal(t, domain.DeliveryStatusSent, queued.Status)
+}
+
+func TestNotificationDeliveryService_DispatchWithoutSupportedChannels(t *testing.T) {
+	_, _, _, svc := newTestDeliveryService(t, 0)
+
+	targets := newTestDeliveryTargets()[1:2]
+	deliveries, err := svc.Dispatch(context.Background(), domain.DefaultNotificationRouting, nil, targets)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func newProcessingDelivery() *domain.NotificationDelivery {
+	return &domain.NotificationDelivery{
+		ID:        9,
+		UserID:    1,
+		ChannelID: 3,
+		Status:    domain.DeliveryStatusProcessing,
+		Payload:   map[string]any{"title": "again", "content": "body", "streamer_id": float64(5)},
+	}
+}
+
+func TestNotificationDeliveryService_ProcessSuccess(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+
+	channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+	provider.EXPECT().Send(ctx, channel, mock.MatchedBy(func(data *external.NotificationData) bool {
+		return data.Title == "again" && data.Content == "body" && data.StreamerID == 5
+	})).Return(nil).Once()
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(d *domain.NotificationDelivery) bool {
+		return d.Status == domain.DeliveryStatusSent && d.Attempts == 1 && d.DeliveredAt != nil && d.LockedUntil == nil
+	})).RunAndReturn(passthroughDelivery).Once()
+	channelRepo.EXPECT().RecordSendSuccess(ctx, int64(3), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	_, events := svc.events.Subscribe(ctx, 1, 0)
+	require.NoError(t, svc.Process(ctx, newProcessingDelivery()))
+	require.Len(t, events, 1)
+	event := <-events
+	require.Equal(t, domain.LiveEventNotificationDelivered, event.Type)
+	require.Equal(t, int64(9), event.Data["delivery_id"])
+}
+
+func TestNotificationDeliveryService_ProcessAddsActionLinks(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+	svc.actions = newNotificationActionLinks(&config.Config{Notification: config.NotificationConfig{
+		Actions: config.ActionsConfig{BaseURL: "https://fusion.example/", Secret: "secret"},
+	}})
+
+	channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+	var sent *external.NotificationData
+	provider.EXPECT().Send(ctx, channel, mock.Anything).
+		Run(func(_ context.Context, _ *domain.NotificationChannel, data *external.NotificationData) { sent = data }).
+		Return(nil).Once()
+	repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+	channelRepo.EXPECT().RecordSendSuccess(ctx, int64(3), mock.Anything).Return(nil).Once()
+
+	delivery := newProcessingDelivery()
+	delivery.FollowID = lo.ToPtr(int64(4))
+	delivery.EventType = domain.NotificationEventStreamOnline
+	delivery.Route = &domain.NotificationDeliveryRoute{ID: "route", Mode: domain.RoutingModeEscalate}
+	require.NoError(t, svc.Process(ctx, delivery))
+
+	require.Len(t, sent.Actions, 4)
+	ack := sent.FindAction(domain.NotificationActionAcknowledge)
+	require.NotNil(t, ack)
+	require.Equal(t, "Got it", ack.Label)
+	require.Equal(t, "Snooze 24h", sent.FindAction(domain.NotificationActionSnooze).Label)
+	require.True(t, strings.HasPrefix(ack.URL, "https://fusion.example"+NotificationActionPath))
+	token, err := domain.ParseNotificationActionToken([]byte("secret"), strings.TrimPrefix(ack.URL, "https://fusion.example"+NotificationActionPath), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, domain.NotificationActionToken{
+		Action: domain.NotificationActionAcknowledge, FollowID: 4, DeliveryID: 9, ExpiresAt: token.ExpiresAt,
+	}, *token)
+}
+
+func TestNotificationDeliveryService_FollowChannelOverrides(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+	follow := &domain.UserFollowedStreamer{ID: 4, UserID: 1, ChannelOverrides: map[int64]map[string]any{
+		3: {"level": "critical", "device_key": "other"},
+	}}
+
+	var created []*domain.NotificationDelivery
+	repo.EXPECT().CreateBatch(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+			created = deliveries
+			return deliveries, nil
+		}).Once()
+	_, err := svc.Dispatch(ctx, domain.DefaultNotificationRouting, follow, newTestDeliveryTargets())
+	require.NoError(t, err)
+	require.Len(t, created, 2)
+	require.NotContains(t, created[0].Payload, payloadConfigOverrides)
+	require.Equal(t, follow.ChannelOverrides[3], created[1].Payload[payloadConfigOverrides])
+
+	// Overrides are laid over the channel config as it is at send time; fields the schema does not
+	// mark overridable are ignored.
+	channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true,
+		Config: map[string]any{"device_key": "mine", "level": "passive", "sound": "bell"}}
+	provider.EXPECT().ConfigSchema().Return(&domain.ChannelConfigSchema{Properties: map[string]*domain.ChannelConfigSchema{
+		"device_key": {Type: domain.SchemaTypeString, Secret: true},
+		"level":      {Type: domain.SchemaTypeString, Overridable: true},
+	}}).Once()
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+	provider.EXPECT().Send(ctx, mock.MatchedBy(func(c *domain.NotificationChannel) bool {
+		return c.Config["device_key"] == "mine" && c.Config["level"] == "critical" && c.Config["sound"] == "bell"
+	}), mock.Anything).Return(nil).Once()
+	channelRepo.EXPECT().RecordSendSuccess(ctx, int64(3), mock.Anything).Return(nil).Once()
+	repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+
+	delivery := newProcessingDelivery()
+	delivery.Payload[payloadConfigOverrides] = map[string]any{"level": "critical", "device_key": "other"}
+	require.NoError(t, svc.Process(ctx, delivery))
+	require.Equal(t, "passive", channel.Config["level"])
+}
+
+func TestNotificationDeliveryService_ProcessFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		err      error
+		want     domain.NotificationDeliveryStatus
+	}{
+		{name: "upstream 5xx is retried", err: errors.BadRequest("bark returned non-success status").WithDetail("status", 503), want: domain.DeliveryStatusRetrying},
+		{name: "network error is retried", err: errors.Internal(context.DeadlineExceeded), want: domain.DeliveryStatusRetrying},
+		{name: "upstream 4xx is dead", err: errors.BadRequest("bark returned non-success status").WithDetail("status", 400), want: domain.DeliveryStatusDead},
+		{name: "retry budget exhausted", attempts: defaultOutboxMaxAttempts - 1, err: errors.Internal(context.DeadlineExceeded), want: domain.DeliveryStatusDead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+
+			channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+			delivery := newProcessingDelivery()
+			delivery.Attempts = tt.attempts
+
+			channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+			provider.EXPECT().Send(ctx, channel, mock.Anything).Return(tt.err).Once()
+			if tt.want == domain.DeliveryStatusDead {
+				failing := *channel
+				failing.Health.ConsecutiveFailures = 1
+				channelRepo.EXPECT().RecordSendFailure(ctx, int64(3), tt.err.Error(), mock.AnythingOfType("time.Time")).Return(&failing, nil).Once()
+			}
+			repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+
+			_, events := svc.events.Subscribe(ctx, 1, 0)
+			require.NoError(t, svc.Process(ctx, delivery))
+			require.Equal(t, tt.want, delivery.Status)
+			// Only a delivery that gave up is reported as failed; retries stay quiet.
+			if tt.want == domain.DeliveryStatusDead {
+				require.Len(t, events, 1)
+				require.Equal(t, domain.LiveEventNotificationFailed, (<-events).Type)
+			} else {
+				require.Empty(t, events)
+			}
+			require.Equal(t, tt.attempts+1, delivery.Attempts)
+			require.NotEmpty(t, delivery.Error)
+			require.Equal(t, tt.want == domain.DeliveryStatusRetrying, delivery.NextAttemptAt != nil)
+		})
+	}
+}
+
+func TestNotificationDeliveryService_ProcessDisablesUnhealthyChannel(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		disabled  bool
+		wantAlert bool
+	}{
+		{name: "below threshold keeps the channel", failures: 2},
+		{name: "threshold disables and alerts", failures: 3, disabled: true, wantAlert: true},
+		{name: "already disabled by another worker", failures: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+			svc.health.FailureThreshold = 3
+
+			channel := &domain.NotificationChannel{ID: 3, UserID: 1, Name: "phone", ChannelType: domain.ChannelTypeBark, Enable: true}
+			sendErr := errors.BadRequest("bark returned non-success status").WithDetail("status", 400)
+			channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+			provider.EXPECT().Send(ctx, channel, mock.Anything).Return(sendErr).Once()
+			failing := *channel
+			failing.Health = domain.ChannelHealth{ConsecutiveFailures: tt.failures, LastError: sendErr.Error()}
+			channelRepo.EXPECT().RecordSendFailure(ctx, int64(3), sendErr.Error(), mock.AnythingOfType("time.Time")).Return(&failing, nil).Once()
+			if tt.failures >= 3 {
+				channelRepo.EXPECT().DisableUnhealthy(ctx, int64(3), mock.AnythingOfType("time.Time")).Return(tt.disabled, nil).Once()
+			}
+			if tt.wantAlert {
+				disabledAt := time.Now()
+				channelRepo.EXPECT().ListByUserId(ctx, int64(1), 0, alertChannelBatchSize).Return([]*domain.NotificationChannel{
+					&failing,
+					{ID: 7, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true},
+					{ID: 8, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: false},
+					{ID: 10, UserID: 1, ChannelType: domain.ChannelTypeBark, Health: domain.ChannelHealth{DisabledAt: &disabledAt}},
+				}, 4, nil).Once()
+				repo.EXPECT().CreateBatch(ctx, mock.MatchedBy(func(deliveries []*domain.NotificationDelivery) bool {
+					return len(deliveries) == 1 && deliveries[0].ChannelID == 7 &&
+						deliveries[0].EventType == domain.NotificationEventChannelDisabled &&
+						deliveries[0].Payload["title"] == `Notification channel "phone" was disabled`
+				})).RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+					return deliveries, nil
+				}).Once()
+			}
+			repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+
+			delivery := newProcessingDelivery()
+			require.NoError(t, svc.Process(ctx, delivery))
+			require.Equal(t, domain.DeliveryStatusDead, delivery.Status)
+		})
+	}
+}
+
+func TestNotificationDeliveryService_ProcessKeepsWebhookSecretsOutOfErrors(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, _, svc := newTestDeliveryService(t, 0)
+	svc.providers = notificationInfra.NewNotificationProviderManager([]external.NotificationProvider{dingtalk.NewProvider(zap.NewNop())}, zap.NewNop())
+
+	// Nothing listens on the port, so the send fails in the transport, whose error quotes the URL.
+	channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeDingTalk, Enable: true, Config: map[string]any{
+		"webhook_url": "http://127.0.0.1:1/robot/send?access_token=SUPERSECRET",
+		"secret":      "SIGNINGSECRET",
+	}}
+	var lastError string
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+	channelRepo.EXPECT().RecordSendFailure(ctx, int64(3), mock.Anything, mock.AnythingOfType("time.Time")).
+		RunAndReturn(func(_ context.Context, _ int64, message string, _ time.Time) (*domain.NotificationChannel, error) {
+			lastError = message
+			return channel, nil
+		}).Once()
+	repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+
+	delivery := newProcessingDelivery()
+	delivery.Attempts = defaultOutboxMaxAttempts - 1
+	require.NoError(t, svc.Process(ctx, delivery))
+	require.Equal(t, domain.DeliveryStatusDead, delivery.Status)
+	for _, stored := range []string{delivery.Error, lastError} {
+		require.Contains(t, stored, "127.0.0.1:1")
+		require.NotContains(t, stored, "SUPERSECRET")
+		require.NotRegexp(t, `sign=[^R&]`, stored)
+	}
+}
+
+func TestNotificationDeliveryService_TransientRetriesKeepChannelHealthy(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+	svc.health.FailureThreshold = 1
+
+	// Retries neither count toward the failure streak nor disable the channel, however many there are.
+	channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+	sendErr := errors.BadRequest("bark returned non-success status").WithDetail("status", 429)
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Times(defaultOutboxMaxAttempts - 1)
+	provider.EXPECT().Send(ctx, channel, mock.Anything).Return(sendErr).Times(defaultOutboxMaxAttempts - 1)
+	repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Times(defaultOutboxMaxAttempts - 1)
+
+	delivery := newProcessingDelivery()
+	for range defaultOutboxMaxAttempts - 1 {
+		require.NoError(t, svc.Process(ctx, delivery))
+		require.Equal(t, domain.DeliveryStatusRetrying, delivery.Status)
+		delivery.Status = domain.DeliveryStatusProcessing
+	}
+	channelRepo.AssertNotCalled(t, "RecordSendFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	channelRepo.AssertNotCalled(t, "DisableUnhealthy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNotificationDeliveryService_ProcessMissingChannel(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, _, svc := newTestDeliveryService(t, 0)
+
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(nil, errors.NotFound("NotificationChannel")).Once()
+	repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+
+	delivery := newProcessingDelivery()
+	require.NoError(t, svc.Process(ctx, delivery))
+	require.Equal(t, domain.DeliveryStatusDead, delivery.Status)
+}
+
+func TestNotificationDeliveryService_ProcessAdvancesRoute(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      domain.NotificationRoutingMode
+		sendErr   error
+		expectRep func(repo *repoMocks.MockNotificationDeliveryRepository)
+	}{
+		{
+			name: "first success skips remaining steps once delivered",
+			mode: domain.RoutingModeFirstSuccess,
+			expectRep: func(repo *repoMocks.MockNotificationDeliveryRepository) {
+				repo.EXPECT().SkipRoute(mock.Anything, "route", 1).Return(1, nil).Once()
+			},
+		},
+		{
+			name:    "dead step hands over to the next channel",
+			mode:    domain.RoutingModeFirstSuccess,
+			sendErr: errors.BadRequest("bark returned non-success status").WithDetail("status", 400),
+			expectRep: func(repo *repoMocks.MockNotificationDeliveryRepository) {
+				repo.EXPECT().PromoteRouteStep(mock.Anything, "route", 2, mock.Anything).Return(1, nil).Once()
+			},
+		},
+		{
+			name:    "dead escalation step escalates right away",
+			mode:    domain.RoutingModeEscalate,
+			sendErr: errors.BadRequest("bark returned non-success status").WithDetail("status", 401),
+			expectRep: func(repo *repoMocks.MockNotificationDeliveryRepository) {
+				repo.EXPECT().PromoteRouteStep(mock.Anything, "route", 2, mock.Anything).Return(1, nil).Once()
+			},
+		},
+		{
+			name:      "delivered escalation step waits for acknowledgement",
+			mode:      domain.RoutingModeEscalate,
+			expectRep: func(*repoMocks.MockNotificationDeliveryRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo, channelRepo, provider, svc := newTestDeliveryService(t, 0)
+
+			channel := &domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark, Enable: true}
+			channelRepo.EXPECT().FindById(ctx, int64(3)).Return(channel, nil).Once()
+			provider.EXPECT().Send(ctx, channel, mock.Anything).Return(tt.sendErr).Once()
+			channelRepo.EXPECT().RecordSendSuccess(ctx, int64(3), mock.Anything).Return(nil).Maybe()
+			channelRepo.EXPECT().RecordSendFailure(ctx, int64(3), mock.Anything, mock.Anything).Return(channel, nil).Maybe()
+			repo.EXPECT().Update(ctx, mock.Anything).RunAndReturn(passthroughDelivery).Once()
+			tt.expectRep(repo)
+
+			delivery := newProcessingDelivery()
+			delivery.Route = &domain.NotificationDeliveryRoute{ID: "route", Mode: tt.mode, Step: 1}
+			require.NoError(t, svc.Process(ctx, delivery))
+		})
+	}
+}
+
+func TestNotificationDeliveryService_AcknowledgeStopsEscalation(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+
+	acknowledged := &domain.NotificationDelivery{
+		ID:    9,
+		Route: &domain.NotificationDeliveryRoute{ID: "route", Mode: domain.RoutingModeEscalate, Step: 0},
+	}
+	repo.EXPECT().Acknowledge(ctx, int64(9), mock.AnythingOfType("time.Time")).Return(acknowledged, nil).Once()
+	repo.EXPECT().SkipRoute(ctx, "route", 0).Return(2, nil).Once()
+
+	delivery, err := svc.Acknowledge(ctx, 9)
+	require.NoError(t, err)
+	require.Same(t, acknowledged, delivery)
+}
+
+func TestNotificationDeliveryService_ResendRequeues(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+
+	existing := &domain.NotificationDelivery{ID: 9, UserID: 1, ChannelID: 3, Status: domain.DeliveryStatusDead, Attempts: 8, Error: "boom"}
+	repo.EXPECT().FindById(ctx, int64(9)).Return(existing, nil).Once()
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(d *domain.NotificationDelivery) bool {
+		return d.Status == domain.DeliveryStatusPending && d.Attempts == 0 && d.Error == "" && d.NextAttemptAt != nil
+	})).RunAndReturn(passthroughDelivery).Once()
+
+	delivery, err := svc.Resend(ctx, 9)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliveryStatusPending, delivery.Status)
+
+	repo.EXPECT().FindById(ctx, int64(10)).Return(&domain.NotificationDelivery{ID: 10, Status: domain.DeliveryStatusProcessing}, nil).Once()
+	_, err = svc.Resend(ctx, 10)
+	require.Error(t, err)
+}
+
+func TestNotificationDeliveryService_ListDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+
+	repo.EXPECT().List(ctx, mock.MatchedBy(func(f *domain.NotificationDeliveryFilter) bool {
+		return f.Status == domain.DeliveryStatusDead && f.UserID == 1
+	}), 10, 10).Return([]*domain.NotificationDelivery{{ID: 1}}, 11, nil).Once()
+
+	deliveries, total, err := svc.ListDeadLetters(ctx, &domain.NotificationDeliveryFilter{UserID: 1, Status: domain.DeliveryStatusSent}, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, 11, total)
+}
+
+func TestNotificationDeliveryService_ListInvalidStatus(t *testing.T) {
+	_, _, _, svc := newTestDeliveryService(t, 0)
+
+	_, _, err := svc.ListByUserId(context.Background(), 1, &domain.NotificationDeliveryFilter{Status: "unknown"}, 1, 10)
+	require.Error(t, err)
+}
+
+func TestNotificationDeliveryService_PurgeExpired(t *testing.T) {
+	ctx := context.Background()
+
+	_, _, _, disabled := newTestDeliveryService(t, 0)
+	deleted, err := disabled.PurgeExpired(ctx)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	repo, _, _, svc := newTestDeliveryService(t, 24*time.Hour)
+	repo.EXPECT().DeleteCreatedBefore(ctx, mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= 24*time.Hour && time.Since(before) < 25*time.Hour
+	})).Return(3, nil).Once()
+
+	deleted, err = svc.PurgeExpired(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, deleted)
+}
+
+func TestNotificationDeliveryService_DispatchBatchesDigestChannels(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+	targets := newTestDeliveryTargets()
+	targets[2].Channel.DigestWindow = 10 * time.Minute
+
+	repo.EXPECT().CreateBatch(ctx, mock.MatchedBy(func(deliveries []*domain.NotificationDelivery) bool {
+		return len(deliveries) == 2 &&
+			deliveries[0].ChannelID == 6 && deliveries[0].Status == domain.DeliveryStatusBatched &&
+			deliveries[0].Route == nil && deliveries[0].NextAttemptAt == nil &&
+			deliveries[1].ChannelID == 3 && deliveries[1].Status == domain.DeliveryStatusPending &&
+			deliveries[1].Route != nil && deliveries[1].Route.Step == 0
+	})).RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+		return deliveries, nil
+	}).Once()
+
+	_, err := svc.Dispatch(ctx, domain.NotificationRouting{Mode: domain.RoutingModeFirstSuccess}, nil, targets)
+	require.NoError(t, err)
+}
+
+func TestNotificationDeliveryService_FlushDigests(t *testing.T) {
+	ctx := context.Background()
+	repo, channelRepo, _, svc := newTestDeliveryService(t, 0)
+	now := time.Date(2025, 1, 1, 20, 10, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	batched := func(id, channelID int64, createdAt time.Time, name string) *domain.NotificationDelivery {
+		return &domain.NotificationDelivery{
+			ID: id, UserID: 1, ChannelID: channelID, ChannelType: domain.ChannelTypeBark,
+			EventType: domain.NotificationEventStreamOnline, FollowID: lo.ToPtr(id), StreamerID: lo.ToPtr(id),
+			Status: domain.DeliveryStatusBatched, CreatedAt: createdAt,
+			Payload: map[string]any{
+				"title": name + " is live now!", "streamer_name": name, "stream_title": name + "'s stream",
+				"url": "https://live.example/" + name, "severity": "normal",
+			},
+		}
+	}
+	repo.EXPECT().ListBatched(ctx).Return([]*domain.NotificationDelivery{
+		// Channel 3's window opened 10 minutes ago and has closed.
+		batched(1, 3, now.Add(-10*time.Minute), "Alice"),
+		batched(2, 3, now.Add(-2*time.Minute), "Bob"),
+		// Channel 4's window is still open.
+		batched(3, 4, now.Add(-time.Minute), "Carol"),
+		// Channel 5 was deleted, so its lone notification goes out unchanged.
+		batched(4, 5, now, "Dave"),
+	}, nil).Once()
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(&domain.NotificationChannel{ID: 3, DigestWindow: 10 * time.Minute}, nil).Once()
+	channelRepo.EXPECT().FindById(ctx, int64(4)).Return(&domain.NotificationChannel{ID: 4, DigestWindow: 10 * time.Minute}, nil).Once()
+	channelRepo.EXPECT().FindById(ctx, int64(5)).Return(nil, errors.NotFound("NotificationChannel")).Once()
+
+	repo.EXPECT().CreateDigest(ctx, mock.MatchedBy(func(d *domain.NotificationDelivery) bool {
+		return d.ChannelID == 3 && d.EventType == domain.NotificationEventDigest && d.Status == domain.DeliveryStatusPending &&
+			d.FollowID == nil && d.Payload["title"] == "2 streamers went live" &&
+			d.Payload["content"] == "• Alice: Alice's stream\n  https://live.example/Alice\n• Bob: Bob's stream\n  https://live.example/Bob"
+	}), []int64{1, 2}).Return(&domain.NotificationDelivery{ID: 10}, nil).Once()
+	repo.EXPECT().CreateDigest(ctx, mock.MatchedBy(func(d *domain.NotificationDelivery) bool {
+		return d.ChannelID == 5 && d.EventType == domain.NotificationEventStreamOnline &&
+			*d.FollowID == 4 && d.Payload["title"] == "Dave is live now!"
+	}), []int64{4}).Return(&domain.NotificationDelivery{ID: 11}, nil).Once()
+
+	flushed, err := svc.FlushDigests(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, flushed)
+}
+
+func TestNotificationDeliveryService_DispatchThrottlesOverRateLimit(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+	now := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	svc.rateLimit = config.RateLimitConfig{Window: 10 * time.Minute, PerChannel: 20, PerUser: 21}
+	since := now.Add(-10 * time.Minute)
+
+	// Channel 6 already had 20 sends in the window; channel 3 fits, which uses up the user's budget.
+	repo.EXPECT().CountSentByChannelSince(ctx, int64(6), since).Return(20, nil).Once()
+	repo.EXPECT().CountSentByChannelSince(ctx, int64(3), since).Return(0, nil).Once()
+	repo.EXPECT().CountSentByUserSince(ctx, int64(1), since).Return(20, nil).Once()
+	repo.EXPECT().CreateBatch(ctx, mock.MatchedBy(func(deliveries []*domain.NotificationDelivery) bool {
+		return len(deliveries) == 2 &&
+			deliveries[0].ChannelID == 6 && deliveries[0].Status == domain.DeliveryStatusThrottled && deliveries[0].NextAttemptAt == nil &&
+			deliveries[1].ChannelID == 3 && deliveries[1].Status == domain.DeliveryStatusPending
+	})).RunAndReturn(func(_ context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+		return deliveries, nil
+	}).Once()
+
+	_, err := svc.Dispatch(ctx, domain.DefaultNotificationRouting, nil, newTestDeliveryTargets())
+	require.NoError(t, err)
+}
+
+func TestNotificationDeliveryService_FlushThrottled(t *testing.T) {
+	ctx := context.Background()
+	repo, _, _, svc := newTestDeliveryService(t, 0)
+	now := time.Date(2025, 1, 1, 20, 10, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	svc.rateLimit = config.RateLimitConfig{Window: 10 * time.Minute, PerChannel: 20}
+	since := now.Add(-10 * time.Minute)
+
+	throttled := func(id, channelID int64, name string) *domain.NotificationDelivery {
+		return &domain.NotificationDelivery{
+			ID: id, UserID: 1, ChannelID: channelID, ChannelType: domain.ChannelTypeBark,
+			EventType: domain.NotificationEventStreamOnline, Status: domain.DeliveryStatusThrottled,
+			Payload: map[string]any{"title": name + " is live now!", "streamer_name": name, "stream_title": name + "'s stream", "severity": "normal"},
+		}
+	}
+	repo.EXPECT().ListThrottled(ctx).Return([]*domain.NotificationDelivery{
+		throttled(1, 3, "Alice"),
+		throttled(2, 3, "Bob"),
+		// Channel 4 is still at its limit.
+		throttled(3, 4, "Carol"),
+	}, nil).Once()
+	repo.EXPECT().CountSentByChannelSince(ctx, int64(3), since).Return(12, nil).Once()
+	repo.EXPECT().CountSentByChannelSince(ctx, int64(4), since).Return(20, nil).Once()
+	repo.EXPECT().CreateDigest(ctx, mock.MatchedBy(func(d *domain.NotificationDelivery) bool {
+		return d.ChannelID == 3 && d.EventType == domain.NotificationEventDigest &&
+			d.Payload["title"] == "…and 2 more streamers went live" &&
+			d.Payload["content"] == "• Alice: Alice's stream\n• Bob: Bob's stream"
+	}), []int64{1, 2}).Return(&domain.NotificationDelivery{ID: 10}, nil).Once()
+
+	flushed, err := svc.FlushThrottled(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, flushed)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type notificationPreferenceService struct {
+	repo   coreRepo.NotificationPreferenceRepository
+	logger *zap.Logger
+}
+
+func NewNotificationPreferenceService(repo coreRepo.NotificationPreferenceRepository, logger *zap.Logger) coreService.NotificationPreferenceService {
+	return &notificationPreferenceService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *notificationPreferenceService) FindByUserId(ctx context.Context, userID int64) (*domain.NotificationPreference, error) {
+	preference, err := s.repo.FindByUserId(ctx, userID)
+	if errors.IsNotFoundError(err) {
+		return domain.NewNotificationPreference(userID)
+	}
+	return preference, err
+}
+
+func (s *notificationPreferenceService) Update(ctx context.Context, cmd *command.UpdateNotificationPreferenceCommand) (*domain.NotificationPreference, error) {
+	if cmd == nil {
+		return nil, errors.BadRequest("notification preference command is required")
+	}
+	routing, err := domain.NewNotificationRouting(domain.NotificationRoutingMode(cmd.RoutingMode), time.Duration(cmd.EscalateAfterSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := s.FindByUserId(ctx, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	preference.Routing = routing
+	if err := preference.UpdateQuietHours(toDomainQuietHours(cmd.QuietHours)); err != nil {
+		return nil, err
+	}
+	return s.save(ctx, preference)
+}
+
+func (s *notificationPreferenceService) SetDoNotDisturb(ctx context.Context, cmd *command.SetDoNotDisturbCommand) (*domain.NotificationPreference, error) {
+	if cmd == nil {
+		return nil, errors.BadRequest("do-not-disturb command is required")
+	}
+	preference, err := s.FindByUserId(ctx, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := preference.SetDoNotDisturb(time.Now(), time.Duration(cmd.Minutes)*time.Minute); err != nil {
+		return nil, err
+	}
+	return s.save(ctx, preference)
+}
+
+func (s *notificationPreferenceService) save(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+	if preference.ID == 0 {
+		return s.repo.Create(ctx, preference)
+	}
+	return s.repo.Update(ctx, preference)
+}
+
+func toDomainQuietHours(cmd *command.QuietHoursCommand) *domain.QuietHours {
+	if cmd == nil {
+		return nil
+	}
+	rules := make([]domain.QuietHoursRule, len(cmd.Rules))
+	for i, rule := range cmd.Rules {
+		weekdays := make([]time.Weekday, len(rule.Weekdays))
+		for j, day := range rule.Weekdays {
+			weekdays[j] = time.Weekday(day)
+		}
+		rules[i] = domain.QuietHoursRule{Weekdays: weekdays, Start: rule.Start, End: rule.End}
+	}
+	return &domain.QuietHours{
+		Timezone: cmd.Timezone,
+		Action:   domain.QuietHoursAction(cmd.Action),
+		Rules:    rules,
+	}
+}
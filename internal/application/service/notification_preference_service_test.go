@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotificationPreferenceService_FindByUserIdDefaults(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationPreferenceRepository(t)
+	svc := NewNotificationPreferenceService(repo, zap.NewNop())
+
+	repo.EXPECT().FindByUserId(ctx, int64(3)).Return(nil, errors.NotFound("NotificationPreference")).Once()
+
+	preference, err := svc.FindByUserId(ctx, 3)
+	require.NoError(t, err)
+	require.Zero(t, preference.ID)
+	require.Equal(t, int64(3), preference.UserID)
+	require.Equal(t, domain.DefaultNotificationRouting, preference.Routing)
+}
+
+func TestNotificationPreferenceService_UpdateCreatesOnFirstUse(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationPreferenceRepository(t)
+	svc := NewNotificationPreferenceService(repo, zap.NewNop())
+
+	repo.EXPECT().FindByUserId(ctx, int64(3)).Return(nil, errors.NotFound("NotificationPreference")).Once()
+	repo.EXPECT().Create(ctx, mock.MatchedBy(func(p *domain.NotificationPreference) bool {
+		return p.UserID == 3 && p.Routing.Mode == domain.RoutingModeEscalate && p.Routing.EscalateAfter == 90*time.Second
+	})).RunAndReturn(func(_ context.Context, p *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+		return p, nil
+	}).Once()
+
+	_, err := svc.Update(ctx, &command.UpdateNotificationPreferenceCommand{
+		UserID:               3,
+		RoutingMode:          string(domain.RoutingModeEscalate),
+		EscalateAfterSeconds: 90,
+	})
+	require.NoError(t, err)
+}
+
+func TestNotificationPreferenceService_UpdateExisting(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationPreferenceRepository(t)
+	svc := NewNotificationPreferenceService(repo, zap.NewNop())
+
+	existing := &domain.NotificationPreference{ID: 5, UserID: 3, Routing: domain.DefaultNotificationRouting}
+	repo.EXPECT().FindByUserId(ctx, int64(3)).Return(existing, nil).Once()
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(p *domain.NotificationPreference) bool {
+		return p.ID == 5 && p.Routing.Mode == domain.RoutingModeFirstSuccess
+	})).Return(existing, nil).Once()
+
+	_, err := svc.Update(ctx, &command.UpdateNotificationPreferenceCommand{
+		UserID:      3,
+		RoutingMode: string(domain.RoutingModeFirstSuccess),
+	})
+	require.NoError(t, err)
+}
+
+func TestNotificationPreferenceService_UpdateInvalidMode(t *testing.T) {
+	repo := repoMocks.NewMockNotificationPreferenceRepository(t)
+	svc := NewNotificationPreferenceService(repo, zap.NewNop())
+
+	_, err := svc.Update(context.Background(), &command.UpdateNotificationPreferenceCommand{UserID: 3, RoutingMode: "everywhere"})
+	require.Error(t, err)
+}
+
+func TestNotificationPreferenceService_UpdateQuietHoursKeepsDoNotDisturb(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationPreferenceRepository(t)
+	svc := NewNotificationPreferenceService(repo, zap.NewNop())
+
+	until := time.Now().Add(time.Hour)
+	existing := &domain.NotificationPreference{ID: 5, UserID: 3, Routing: domain.DefaultNotificationRouting, DoNotDisturbUntil: &until}
+	repo.EXPECT().FindByUserId(ctx, int64(3)).Return(existing, nil).Once()
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(p *domain.NotificationPreference) bool {
+		return p.DoNotDisturbUntil == &until &&
+			p.QuietHours != nil &&
+			p.QuietHours.Timezone == "Asia/Shanghai" &&
+			p.QuietHours.Action == domain.QuietHoursDelay &&
+			p.QuietHours.Rules[0].Weekdays[0] == time.Monday
+	})).Return(existing, nil).Once()
+
+	_, err := svc.Update(ctx, &command.UpdateNotificationPreferenceCommand{
+		UserID: 3,
+		QuietHours: &command.QuietHoursCommand{
+			Timezone: "Asia/Shanghai",
+			Rules:    []command.QuietHoursRuleCommand{{Weekdays: []int{5, 1}, Start: "23:00", End: "07:00"}},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestNotificationPreferenceService_SetDoNotDisturb(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockNotificationPreferenceRepository(t)
+	svc := NewNotificationPreferenceService(repo, zap.NewNop())
+
+	repo.EXPECT().FindByUserId(ctx, int64(3)).Return(nil, errors.NotFound("NotificationPreference")).Once()
+	repo.EXPECT().Create(ctx, mock.MatchedBy(func(p *domain.NotificationPreference) bool {
+		return p.DoNotDisturbUntil != nil && time.Until(*p.DoNotDisturbUntil) > 29*time.Minute
+	})).RunAndReturn(func(_ context.Context, p *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+		return p, nil
+	}).Once()
+
+	_, err := svc.SetDoNotDisturb(ctx, &command.SetDoNotDisturbCommand{UserID: 3, Minutes: 30})
+	require.NoError(t, err)
+}
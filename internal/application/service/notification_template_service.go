@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"go.uber.org/zap"
+)
+
+type notificationTemplateService struct {
+	streamerRepo coreRepo.StreamerRepository
+	logger       *zap.Logger
+}
+
+func NewNotificationTemplateService(streamerRepo coreRepo.StreamerRepository, logger *zap.Logger) coreService.NotificationTemplateService {
+	return &notificationTemplateService{
+		streamerRepo: streamerRepo,
+		logger:       logger,
+	}
+}
+
+func (s *notificationTemplateService) Preview(ctx context.Context, cmd *command.PreviewNotificationTemplateCommand) (*domain.RenderedNotification, error) {
+	if cmd == nil {
+		return nil, errors.BadRequest("notification template preview command is required")
+	}
+	template := domain.NotificationTemplate{Title: cmd.TitleTemplate, Body: cmd.BodyTemplate}.Normalize()
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	vars := domain.SampleNotificationTemplateVariables()
+	if cmd.StreamerID > 0 {
+		streamer, err := s.streamerRepo.FindById(ctx, cmd.StreamerID)
+		if err != nil {
+			return nil, err
+		}
+		vars = domain.NewNotificationTemplateVariables(nil, streamer)
+	}
+	vars.Alias = strings.TrimSpace(cmd.Alias)
+
+	return template.Render(i18n.Resolve(cmd.Locale), vars)
+}
+
+func (s *notificationTemplateService) Variables() []domain.NotificationTemplateVariable {
+	return domain.NotificationTemplateVariableDocs
+}
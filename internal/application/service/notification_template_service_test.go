@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotificationTemplateService_PreviewSample(t *testing.T) {
+	svc := NewNotificationTemplateService(repoMocks.NewMockStreamerRepository(t), zap.NewNop())
+
+	rendered, err := svc.Preview(context.Background(), &command.PreviewNotificationTemplateCommand{
+		TitleTemplate: "{{.Streamer}} on {{.Platform}}",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Sample Streamer on douyu", rendered.Title)
+	require.Equal(t, "Sample live title\nhttps://www.douyu.com/1", rendered.Body)
+}
+
+func TestNotificationTemplateService_PreviewStreamer(t *testing.T) {
+	ctx := context.Background()
+	streamerRepo := repoMocks.NewMockStreamerRepository(t)
+	svc := NewNotificationTemplateService(streamerRepo, zap.NewNop())
+
+	streamerRepo.EXPECT().FindById(ctx, int64(5)).Return(&domain.Streamer{
+		ID:          5,
+		DisplayName: "Real",
+		LiveStatus:  domain.LiveStatusInfo{Viewers: 42},
+	}, nil)
+
+	rendered, err := svc.Preview(ctx, &command.PreviewNotificationTemplateCommand{
+		TitleTemplate: "{{.Alias}}/{{.Streamer}}",
+		BodyTemplate:  "{{.Viewers}} viewers",
+		StreamerID:    5,
+		Alias:         "Nick",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Nick/Real", rendered.Title)
+	require.Equal(t, "42 viewers", rendered.Body)
+}
+
+func TestNotificationTemplateService_PreviewInvalid(t *testing.T) {
+	svc := NewNotificationTemplateService(repoMocks.NewMockStreamerRepository(t), zap.NewNop())
+
+	_, err := svc.Preview(context.Background(), &command.PreviewNotificationTemplateCommand{
+		BodyTemplate: "{{.Missing}}",
+	})
+	require.Error(t, err)
+}
@@ -2,25 +2,39 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
 	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
 	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	notificationInfra "github.com/ryuyb/fusion/internal/infrastructure/external/notification"
 	"github.com/ryuyb/fusion/internal/pkg/errors"
 	"github.com/ryuyb/fusion/internal/pkg/util"
 	"go.uber.org/zap"
 )
 
 type userFollowedStreamerService struct {
-	repo   coreRepo.UserFollowedStreamerRepository
-	logger *zap.Logger
+	repo        coreRepo.UserFollowedStreamerRepository
+	channelRepo coreRepo.NotificationChannelRepository
+	providers   *notificationInfra.NotificationProviderManager
+	logger      *zap.Logger
 }
 
-func NewUserFollowedStreamerService(repo coreRepo.UserFollowedStreamerRepository, logger *zap.Logger) coreService.UserFollowedStreamerService {
+func NewUserFollowedStreamerService(
+	repo coreRepo.UserFollowedStreamerRepository,
+	channelRepo coreRepo.NotificationChannelRepository,
+	providers *notificationInfra.NotificationProviderManager,
+	logger *zap.Logger,
+) coreService.UserFollowedStreamerService {
 	return &userFollowedStreamerService{
-		repo:   repo,
-		logger: logger,
+		repo:        repo,
+		channelRepo: channelRepo,
+		providers:   providers,
+		logger:      logger,
 	}
 }
 
@@ -37,6 +51,26 @@ func (s *userFollowedStreamerService) Create(ctx context.Context, cmd *command.C
 		return nil, err
 	}
 	follow.NotificationsEnabled = cmd.NotificationsEnabled
+	follow.AlwaysNotify = cmd.AlwaysNotify
+	follow.NotifyStreamEnd = cmd.NotifyStreamEnd
+	follow.NotifyTitleChange = cmd.NotifyTitleChange
+	if err := follow.UpdateNotifyCategories(cmd.NotifyCategories); err != nil {
+		return nil, err
+	}
+	if err := follow.UpdateTemplate(domain.NotificationTemplate{Title: cmd.TitleTemplate, Body: cmd.BodyTemplate}); err != nil {
+		return nil, err
+	}
+	if err := follow.UpdateFilter(notificationFilter(cmd.Filter)); err != nil {
+		return nil, err
+	}
+	routing, err := followRouting(cmd.RoutingMode, cmd.EscalateAfterSeconds)
+	if err != nil {
+		return nil, err
+	}
+	follow.UpdateRouting(routing)
+	if err := s.updateChannelOverrides(ctx, follow, cmd.ChannelOverrides); err != nil {
+		return nil, err
+	}
 	return s.repo.Create(ctx, follow)
 }
 
@@ -48,9 +82,77 @@ func (s *userFollowedStreamerService) Update(ctx context.Context, cmd *command.U
 	if err := current.UpdatePreferences(cmd.Alias, cmd.Notes, cmd.NotificationsEnabled, cmd.NotificationChannelIDs); err != nil {
 		return nil, err
 	}
+	current.AlwaysNotify = cmd.AlwaysNotify
+	current.NotifyStreamEnd = cmd.NotifyStreamEnd
+	current.NotifyTitleChange = cmd.NotifyTitleChange
+	if err := current.UpdateNotifyCategories(cmd.NotifyCategories); err != nil {
+		return nil, err
+	}
+	if err := current.UpdateTemplate(domain.NotificationTemplate{Title: cmd.TitleTemplate, Body: cmd.BodyTemplate}); err != nil {
+		return nil, err
+	}
+	if err := current.UpdateFilter(notificationFilter(cmd.Filter)); err != nil {
+		return nil, err
+	}
+	routing, err := followRouting(cmd.RoutingMode, cmd.EscalateAfterSeconds)
+	if err != nil {
+		return nil, err
+	}
+	current.UpdateRouting(routing)
+	if err := s.updateChannelOverrides(ctx, current, cmd.ChannelOverrides); err != nil {
+		return nil, err
+	}
 	return s.repo.Update(ctx, current)
 }
 
+// updateChannelOverrides stores the per-channel overrides after checking each against the config
+// schema of its channel's type. Only the follower's own channels can be overridden.
+func (s *userFollowedStreamerService) updateChannelOverrides(ctx context.Context, follow *domain.UserFollowedStreamer, overrides map[int64]map[string]any) error {
+	if err := follow.UpdateChannelOverrides(overrides); err != nil {
+		return err
+	}
+	for _, channelID := range slices.Sorted(maps.Keys(follow.ChannelOverrides)) {
+		channel, err := s.channelRepo.FindById(ctx, channelID)
+		if err == nil && channel.UserID != follow.UserID {
+			err = errors.NotFound("NotificationChannel").WithDetail("id", channelID)
+		}
+		if err != nil {
+			return err
+		}
+		field := fmt.Sprintf("channel_overrides.%d", channelID)
+		if err := s.providers.ValidateOverrides(channel.ChannelType, field, follow.ChannelOverrides[channelID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func notificationFilter(cmd *command.NotificationFilterCommand) *domain.NotificationFilter {
+	if cmd == nil {
+		return nil
+	}
+	return &domain.NotificationFilter{
+		TitleInclude:      cmd.TitleInclude,
+		TitleExclude:      cmd.TitleExclude,
+		TitlePattern:      cmd.TitlePattern,
+		Categories:        cmd.Categories,
+		BlockedCategories: cmd.BlockedCategories,
+		MinViewers:        cmd.MinViewers,
+	}
+}
+
+// followRouting builds the per-follow routing override; an empty mode means none.
+func followRouting(mode string, escalateAfterSeconds int64) (*domain.NotificationRouting, error) {
+	if mode == "" {
+		return nil, nil
+	}
+	routing, err := domain.NewNotificationRouting(domain.NotificationRoutingMode(mode), time.Duration(escalateAfterSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &routing, nil
+}
+
 func (s *userFollowedStreamerService) Delete(ctx context.Context, id int64) error {
 	return s.repo.Delete(ctx, id)
 }
@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
 	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -15,7 +17,7 @@ import (
 func TestUserFollowedStreamerService_Create(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockUserFollowedStreamerRepository(t)
-	svc := NewUserFollowedStreamerService(repo, zap.NewNop())
+	svc := NewUserFollowedStreamerService(repo, repoMocks.NewMockNotificationChannelRepository(t), newTestChannelProviders(t), zap.NewNop())
 
 	cmd := &command.CreateUserFollowedStreamerCommand{
 		UserID:               1,
@@ -41,7 +43,7 @@ func TestUserFollowedStreamerService_Create(t *testing.T) {
 func TestUserFollowedStreamerService_CreateConflict(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockUserFollowedStreamerRepository(t)
-	svc := NewUserFollowedStreamerService(repo, zap.NewNop())
+	svc := NewUserFollowedStreamerService(repo, repoMocks.NewMockNotificationChannelRepository(t), newTestChannelProviders(t), zap.NewNop())
 
 	cmd := &command.CreateUserFollowedStreamerCommand{UserID: 1, StreamerID: 2}
 
@@ -51,10 +53,60 @@ func TestUserFollowedStreamerService_CreateConflict(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUserFollowedStreamerService_UpdateRoutingOverride(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+	svc := NewUserFollowedStreamerService(repo, repoMocks.NewMockNotificationChannelRepository(t), newTestChannelProviders(t), zap.NewNop())
+
+	current := &domain.UserFollowedStreamer{ID: 1, UserID: 1, StreamerID: 2}
+	repo.EXPECT().FindById(ctx, int64(1)).Return(current, nil).Twice()
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(f *domain.UserFollowedStreamer) bool {
+		return f.Routing != nil && f.Routing.Mode == domain.RoutingModeEscalate && f.Routing.EscalateAfter == 2*time.Minute
+	})).Return(current, nil).Once()
+
+	_, err := svc.Update(ctx, &command.UpdateUserFollowedStreamerCommand{
+		ID:                   1,
+		RoutingMode:          string(domain.RoutingModeEscalate),
+		EscalateAfterSeconds: 120,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.Update(ctx, &command.UpdateUserFollowedStreamerCommand{ID: 1, RoutingMode: "sometimes"})
+	require.Error(t, err)
+}
+
+func TestUserFollowedStreamerService_UpdateChannelOverrides(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockUserFollowedStreamerRepository(t)
+	channelRepo := repoMocks.NewMockNotificationChannelRepository(t)
+	svc := NewUserFollowedStreamerService(repo, channelRepo, newTestChannelProviders(t), zap.NewNop())
+
+	current := &domain.UserFollowedStreamer{ID: 1, UserID: 1, StreamerID: 2}
+	repo.EXPECT().FindById(ctx, int64(1)).Return(current, nil)
+	channelRepo.EXPECT().FindById(ctx, int64(3)).Return(&domain.NotificationChannel{ID: 3, UserID: 1, ChannelType: domain.ChannelTypeBark}, nil)
+	channelRepo.EXPECT().FindById(ctx, int64(4)).Return(&domain.NotificationChannel{ID: 4, UserID: 2, ChannelType: domain.ChannelTypeBark}, nil)
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(f *domain.UserFollowedStreamer) bool {
+		return len(f.ChannelOverrides) == 1 && f.ChannelOverrides[3]["volume"] == 8
+	})).Return(current, nil).Once()
+
+	update := func(overrides map[int64]map[string]any) error {
+		_, err := svc.Update(ctx, &command.UpdateUserFollowedStreamerCommand{ID: 1, ChannelOverrides: overrides})
+		return err
+	}
+	require.NoError(t, update(map[int64]map[string]any{3: {"volume": 8}, 5: {}}))
+
+	// Secrets and unknown fields cannot be overridden, and values follow the schema.
+	require.Error(t, update(map[int64]map[string]any{3: {"device_key": "other"}}))
+	require.Error(t, update(map[int64]map[string]any{3: {"sound": "alarm"}}))
+	require.Error(t, update(map[int64]map[string]any{3: {"volume": 11}}))
+	// Channels of other users cannot be overridden.
+	require.True(t, errors.IsNotFoundError(update(map[int64]map[string]any{4: {"volume": 8}})))
+}
+
 func TestUserFollowedStreamerService_ListInvalid(t *testing.T) {
 	ctx := context.Background()
 	repo := repoMocks.NewMockUserFollowedStreamerRepository(t)
-	svc := NewUserFollowedStreamerService(repo, zap.NewNop())
+	svc := NewUserFollowedStreamerService(repo, repoMocks.NewMockNotificationChannelRepository(t), newTestChannelProviders(t), zap.NewNop())
 
 	_, _, err := svc.ListByUserId(ctx, 1, 0, 10)
 	require.Error(t, err)
@@ -39,6 +39,9 @@ func (u *userService) Create(ctx context.Context, cmd *command.CreateUserCommand
 		u.logger.Error("failed to create domain user", zap.Error(err))
 		return nil, err
 	}
+	if err := user.UpdateLocale(cmd.Locale); err != nil {
+		return nil, err
+	}
 
 	return u.repo.Create(ctx, user)
 }
@@ -71,6 +74,9 @@ func (u *userService) Update(ctx context.Context, cmd *command.UpdateUserCommand
 		u.logger.Error("failed to update domain user", zap.Error(err))
 		return nil, err
 	}
+	if err := user.UpdateLocale(cmd.Locale); err != nil {
+		return nil, err
+	}
 	return u.repo.Update(ctx, user)
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/ryuyb/fusion/internal/core/domain"
 	"github.com/ryuyb/fusion/internal/core/port/repository"
 	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"github.com/ryuyb/fusion/internal/pkg/util"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -114,3 +115,21 @@ func TestUserServiceDeleteDelegates(t *testing.T) {
 	svc := NewUserService(repo, zap.NewNop())
 	require.NoError(t, svc.Delete(context.Background(), 99))
 }
+
+func TestUserServiceCreateLocale(t *testing.T) {
+	t.Parallel()
+	repo := repository.NewMockUserRepository(t)
+	repo.EXPECT().ExistByUsername(mock.Anything, mock.Anything).Return(false, nil)
+	repo.EXPECT().ExistByEmail(mock.Anything, mock.Anything).Return(false, nil)
+	repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+		return u.Locale == "zh-CN"
+	})).Return(&domain.User{ID: 1, Locale: "zh-CN"}, nil)
+
+	svc := NewUserService(repo, zap.NewNop())
+	_, err := svc.Create(context.Background(), &command.CreateUserCommand{Username: "neo", Email: "neo@example.com", Password: "secret", Locale: "zh-Hans"})
+	require.NoError(t, err)
+
+	_, err = svc.Create(context.Background(), &command.CreateUserCommand{Username: "neo", Email: "neo@example.com", Password: "secret", Locale: "fr"})
+	require.Error(t, err)
+	require.Equal(t, "不支持该语言", errors2.GetAppError(err).Localize(i18n.SimplifiedChinese))
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/webpush"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+	"go.uber.org/zap"
+)
+
+type webPushService struct {
+	repo   coreRepo.WebPushSubscriptionRepository
+	keys   *webpush.KeyStore
+	logger *zap.Logger
+}
+
+func NewWebPushService(repo coreRepo.WebPushSubscriptionRepository, keys *webpush.KeyStore, logger *zap.Logger) coreService.WebPushService {
+	return &webPushService{
+		repo:   repo,
+		keys:   keys,
+		logger: logger,
+	}
+}
+
+func (s *webPushService) GetVAPIDPublicKey(ctx context.Context) (string, error) {
+	keys, err := s.keys.Keys(ctx)
+	if err != nil {
+		return "", err
+	}
+	return keys.PublicKey, nil
+}
+
+// Subscribe stores the browser subscription. Browsers reuse the endpoint when re-subscribing,
+// so an existing record is refreshed instead of creating a duplicate.
+func (s *webPushService) Subscribe(ctx context.Context, cmd *command.SubscribeWebPushCommand) (*domain.WebPushSubscription, error) {
+	if cmd == nil {
+		return nil, errors.BadRequest("web push subscription command is required")
+	}
+	subscription, err := domain.NewWebPushSubscription(cmd.UserID, cmd.Endpoint, cmd.P256dh, cmd.Auth, cmd.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindByEndpoint(ctx, subscription.Endpoint)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+	if existing == nil {
+		return s.repo.Create(ctx, subscription)
+	}
+
+	if err := existing.UpdateKeys(cmd.Endpoint, cmd.P256dh, cmd.Auth, cmd.UserAgent); err != nil {
+		return nil, err
+	}
+	existing.UserID = cmd.UserID
+	return s.repo.Update(ctx, existing)
+}
+
+func (s *webPushService) Unsubscribe(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *webPushService) ListByUserId(ctx context.Context, userID int64, page, pageSize int) ([]*domain.WebPushSubscription, int, error) {
+	if err := util.ValidatePagination(page, pageSize); err != nil {
+		s.logger.Warn("invalid pagination parameters for web push subscription",
+			zap.Int("page", page),
+			zap.Int("page_size", pageSize),
+			zap.Error(err),
+		)
+		return nil, 0, err
+	}
+	offset := (page - 1) * pageSize
+	return s.repo.ListByUserId(ctx, userID, offset, pageSize)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/webpush"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testP256dh = "BNcRdreALRFXTkOOUHK1EtK2wtaz5Ry4YfYCA_0QTpQtUbVlUls0VJXg7A8u-Ts1XbjhazAkj7I99e8QcYP7DkM"
+	testAuth   = "tBHItJI5svbpez7KI4CCXg"
+)
+
+func newTestWebPushService(t *testing.T, repo *repoMocks.MockWebPushSubscriptionRepository) coreService.WebPushService {
+	t.Helper()
+	keys := webpush.NewKeyStore(&config.Config{}, repoMocks.NewMockSystemSettingRepository(t), zap.NewNop())
+	return NewWebPushService(repo, keys, zap.NewNop())
+}
+
+func TestWebPushService_SubscribeCreates(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	svc := newTestWebPushService(t, repo)
+
+	cmd := &command.SubscribeWebPushCommand{
+		UserID:   3,
+		Endpoint: "https://push.example/send/abc",
+		P256dh:   testP256dh,
+		Auth:     testAuth,
+	}
+	expected := &domain.WebPushSubscription{ID: 1, UserID: 3, Endpoint: cmd.Endpoint}
+
+	repo.EXPECT().FindByEndpoint(ctx, cmd.Endpoint).Return(nil, errors.NotFound("web push subscription"))
+	repo.EXPECT().Create(ctx, mock.MatchedBy(func(subscription *domain.WebPushSubscription) bool {
+		return subscription.UserID == cmd.UserID && subscription.P256dh == cmd.P256dh && subscription.Auth == cmd.Auth
+	})).Return(expected, nil)
+
+	created, err := svc.Subscribe(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, expected, created)
+}
+
+func TestWebPushService_SubscribeRefreshesExisting(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	svc := newTestWebPushService(t, repo)
+
+	cmd := &command.SubscribeWebPushCommand{
+		UserID:    3,
+		Endpoint:  "https://push.example/send/abc",
+		P256dh:    testP256dh,
+		Auth:      testAuth,
+		UserAgent: "Firefox",
+	}
+	existing := &domain.WebPushSubscription{ID: 9, UserID: 2, Endpoint: cmd.Endpoint, P256dh: "old", Auth: "old"}
+
+	repo.EXPECT().FindByEndpoint(ctx, cmd.Endpoint).Return(existing, nil)
+	repo.EXPECT().Update(ctx, mock.MatchedBy(func(subscription *domain.WebPushSubscription) bool {
+		return subscription.ID == 9 &&
+			subscription.UserID == cmd.UserID &&
+			subscription.P256dh == cmd.P256dh &&
+			subscription.UserAgent == cmd.UserAgent
+	})).Return(existing, nil)
+
+	_, err := svc.Subscribe(ctx, cmd)
+	require.NoError(t, err)
+}
+
+func TestWebPushService_SubscribeInvalidKeys(t *testing.T) {
+	ctx := context.Background()
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	svc := newTestWebPushService(t, repo)
+
+	_, err := svc.Subscribe(ctx, &command.SubscribeWebPushCommand{
+		UserID:   3,
+		Endpoint: "http://push.example/send/abc",
+		P256dh:   testP256dh,
+		Auth:     testAuth,
+	})
+	require.Error(t, err)
+}
+
+func TestWebPushService_ListByUserIdInvalidPagination(t *testing.T) {
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	svc := newTestWebPushService(t, repo)
+
+	_, _, err := svc.ListByUserId(context.Background(), 3, 0, 10)
+	require.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultOutboxWorkers      = 4
+	defaultOutboxPollInterval = 2 * time.Second
+)
+
+// NotificationOutbox drains the notification outbox with a fixed pool of workers.
+// A single poller claims batches and hands them out, so several instances can run
+// side by side: rows claimed by one are skipped by the others.
+type NotificationOutbox struct {
+	enable          bool
+	workers         int
+	pollInterval    time.Duration
+	deliveryService coreService.NotificationDeliveryService
+	logger          *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewNotificationOutbox(cfg *config.Config, deliveryService coreService.NotificationDeliveryService, logger *zap.Logger) *NotificationOutbox {
+	outbox := cfg.Notification.Outbox
+	workers := outbox.Workers
+	if workers <= 0 {
+		workers = defaultOutboxWorkers
+	}
+	pollInterval := outbox.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultOutboxPollInterval
+	}
+	return &NotificationOutbox{
+		enable:          outbox.Enable,
+		workers:         workers,
+		pollInterval:    pollInterval,
+		deliveryService: deliveryService,
+		logger:          logger,
+	}
+}
+
+func (o *NotificationOutbox) Start() {
+	if !o.enable {
+		o.logger.Info("notification outbox disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+
+	queue := make(chan *domain.NotificationDelivery)
+	for range o.workers {
+		o.wg.Go(func() {
+			for delivery := range queue {
+				if err := o.deliveryService.Process(ctx, delivery); err != nil {
+					o.logger.Warn("failed to process notification delivery",
+						zap.Int64("delivery_id", delivery.ID),
+						zap.Error(err))
+				}
+			}
+		})
+	}
+	o.wg.Go(func() {
+		defer close(queue)
+		o.poll(ctx, queue)
+	})
+
+	o.logger.Info("notification outbox started", zap.Int("workers", o.workers))
+}
+
+func (o *NotificationOutbox) Stop(ctx context.Context) error {
+	if o.cancel == nil {
+		return nil
+	}
+	o.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		o.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		o.logger.Info("notification outbox stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (o *NotificationOutbox) poll(ctx context.Context, queue chan<- *domain.NotificationDelivery) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		claimed, err := o.deliveryService.ClaimDue(ctx)
+		if err != nil {
+			o.logger.Warn("failed to claim notification deliveries", zap.Error(err))
+		}
+		for _, delivery := range claimed {
+			select {
+			case queue <- delivery:
+			case <-ctx.Done():
+				// Unsent claims are picked up again once their lease expires.
+				return
+			}
+		}
+
+		// Keep draining while there is work and fall back to the interval once the queue is empty.
+		if len(claimed) > 0 && err == nil {
+			timer.Reset(0)
+		} else {
+			timer.Reset(o.pollInterval)
+		}
+	}
+}
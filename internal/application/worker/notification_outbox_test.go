@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	serviceMocks "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotificationOutbox_ProcessesClaimedDeliveries(t *testing.T) {
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	claimed := []*domain.NotificationDelivery{{ID: 1}, {ID: 2}}
+
+	deliveryService.EXPECT().ClaimDue(mock.Anything).Return(claimed, nil).Once()
+	deliveryService.EXPECT().ClaimDue(mock.Anything).Return(nil, nil).Maybe()
+
+	processed := make(chan int64, len(claimed))
+	deliveryService.EXPECT().Process(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, d *domain.NotificationDelivery) error {
+			processed <- d.ID
+			return nil
+		}).Times(len(claimed))
+
+	cfg := &config.Config{Notification: config.NotificationConfig{Outbox: config.OutboxConfig{
+		Enable:       true,
+		Workers:      2,
+		PollInterval: 10 * time.Millisecond,
+	}}}
+	outbox := NewNotificationOutbox(cfg, deliveryService, zap.NewNop())
+	outbox.Start()
+
+	var ids []int64
+	for range claimed {
+		select {
+		case id := <-processed:
+			ids = append(ids, id)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for deliveries to be processed")
+		}
+	}
+	require.ElementsMatch(t, []int64{1, 2}, ids)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, outbox.Stop(ctx))
+}
+
+func TestNotificationOutbox_Disabled(t *testing.T) {
+	deliveryService := serviceMocks.NewMockNotificationDeliveryService(t)
+	outbox := NewNotificationOutbox(&config.Config{}, deliveryService, zap.NewNop())
+
+	outbox.Start()
+	require.NoError(t, outbox.Stop(context.Background()))
+}
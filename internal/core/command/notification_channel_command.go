@@ -7,6 +7,16 @@ type CreateNotificationChannelCommand struct {
 	Config      map[string]any
 	Enable      bool
 	Priority    int
+
+	TitleTemplate string
+	BodyTemplate  string
+
+	DigestWindowSeconds  int64
+	DailySummaryAt       string
+	DailySummaryTimezone string
+
+	// Locale is the language of the verification message sent to a channel that needs one.
+	Locale string
 }
 
 type UpdateNotificationChannelCommand struct {
@@ -0,0 +1,27 @@
+package command
+
+type UpdateNotificationPreferenceCommand struct {
+	UserID               int64
+	RoutingMode          string
+	EscalateAfterSeconds int64
+	// QuietHours nil turns quiet hours off.
+	QuietHours *QuietHoursCommand
+}
+
+type QuietHoursCommand struct {
+	Timezone string
+	Action   string
+	Rules    []QuietHoursRuleCommand
+}
+
+type QuietHoursRuleCommand struct {
+	Weekdays []int
+	Start    string
+	End      string
+}
+
+type SetDoNotDisturbCommand struct {
+	UserID int64
+	// Minutes 0 switches do-not-disturb off.
+	Minutes int
+}
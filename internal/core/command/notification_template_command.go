@@ -0,0 +1,11 @@
+package command
+
+type PreviewNotificationTemplateCommand struct {
+	TitleTemplate string
+	BodyTemplate  string
+	// StreamerID renders against a real streamer when set, otherwise sample data is used.
+	StreamerID int64
+	Alias      string
+	// Locale picks the default template empty fields fall back to; empty means i18n.Default.
+	Locale string
+}
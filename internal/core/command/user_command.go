@@ -4,6 +4,7 @@ type CreateUserCommand struct {
 	Username string
 	Email    string
 	Password string
+	Locale   string
 }
 
 type UpdateUserCommand struct {
@@ -6,7 +6,24 @@ type CreateUserFollowedStreamerCommand struct {
 	Alias                  string
 	Notes                  string
 	NotificationsEnabled   bool
+	AlwaysNotify           bool
+	NotifyStreamEnd        bool
+	NotifyTitleChange      bool
+	NotifyCategories       []string
 	NotificationChannelIDs []int64
+
+	TitleTemplate string
+	BodyTemplate  string
+
+	// Filter nil removes the go-live filter.
+	Filter *NotificationFilterCommand
+
+	// An empty RoutingMode inherits the user's routing.
+	RoutingMode          string
+	EscalateAfterSeconds int64
+
+	// ChannelOverrides replaces the per-channel config overrides, keyed by channel ID.
+	ChannelOverrides map[int64]map[string]any
 }
 
 type UpdateUserFollowedStreamerCommand struct {
@@ -14,5 +31,31 @@ type UpdateUserFollowedStreamerCommand struct {
 	Alias                  string
 	Notes                  string
 	NotificationsEnabled   bool
+	AlwaysNotify           bool
+	NotifyStreamEnd        bool
+	NotifyTitleChange      bool
+	NotifyCategories       []string
 	NotificationChannelIDs []int64
+
+	TitleTemplate string
+	BodyTemplate  string
+
+	// Filter nil removes the go-live filter.
+	Filter *NotificationFilterCommand
+
+	// An empty RoutingMode inherits the user's routing.
+	RoutingMode          string
+	EscalateAfterSeconds int64
+
+	// ChannelOverrides replaces the per-channel config overrides, keyed by channel ID.
+	ChannelOverrides map[int64]map[string]any
+}
+
+type NotificationFilterCommand struct {
+	TitleInclude      []string
+	TitleExclude      []string
+	TitlePattern      string
+	Categories        []string
+	BlockedCategories []string
+	MinViewers        int
 }
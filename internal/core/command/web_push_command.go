@@ -0,0 +1,9 @@
+package command
+
+type SubscribeWebPushCommand struct {
+	UserID    int64
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	UserAgent string
+}
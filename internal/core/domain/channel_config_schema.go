@@ -0,0 +1,314 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+// Value types understood by ChannelConfigSchema, named after their JSON Schema counterparts.
+const (
+	SchemaTypeObject  = "object"
+	SchemaTypeString  = "string"
+	SchemaTypeInteger = "integer"
+	SchemaTypeNumber  = "number"
+	SchemaTypeBoolean = "boolean"
+	SchemaTypeArray   = "array"
+)
+
+// SchemaFormatURI requires an absolute http(s) URL.
+const SchemaFormatURI = "uri"
+
+// ChannelConfigSchema is the subset of JSON Schema providers use to describe NotificationChannel.Config.
+// It marshals to valid JSON Schema so clients can render forms from it.
+//
+// Matching how providers read their config, a nil value or a blank string counts as unset:
+// it fails Required but is otherwise not validated.
+type ChannelConfigSchema struct {
+	Type        string                          `json:"type,omitempty"`
+	Title       string                          `json:"title,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Properties  map[string]*ChannelConfigSchema `json:"properties,omitempty"`
+	Required    []string                        `json:"required,omitempty"`
+	// AnyOf passes when the value matches at least one subschema, e.g. "either key or webhook_url is required".
+	AnyOf     []*ChannelConfigSchema `json:"anyOf,omitempty"`
+	Items     *ChannelConfigSchema   `json:"items,omitempty"`
+	Enum      []any                  `json:"enum,omitempty"`
+	Format    string                 `json:"format,omitempty"`
+	Pattern   string                 `json:"pattern,omitempty"`
+	MaxLength int                    `json:"maxLength,omitempty"`
+	Minimum   *float64               `json:"minimum,omitempty"`
+	Maximum   *float64               `json:"maximum,omitempty"`
+	Default   any                    `json:"default,omitempty"`
+	// Secret fields are encrypted at rest and never returned by the API.
+	Secret bool `json:"writeOnly,omitempty"`
+	// Overridable fields only change how a message is presented, so a follow may set them for its own
+	// notifications. Fields that decide where a message goes never are.
+	Overridable bool `json:"x-overridable,omitempty"`
+}
+
+// ChannelConfigFieldError describes one rejected config field. It has the same shape as request validation errors.
+type ChannelConfigFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Tag     string `json:"tag,omitempty"`
+}
+
+// SchemaBound returns a pointer for the Minimum and Maximum keywords.
+func SchemaBound(v float64) *float64 {
+	return &v
+}
+
+// SecretFields lists the top-level properties marked Secret, sorted by name.
+func (s *ChannelConfigSchema) SecretFields() []string {
+	if s == nil {
+		return nil
+	}
+	var fields []string
+	for name, property := range s.Properties {
+		if property.Secret {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Validate checks config against the schema and returns a validation error listing every offending field.
+func (s *ChannelConfigSchema) Validate(config map[string]any) error {
+	if s == nil {
+		return nil
+	}
+	if config == nil {
+		config = map[string]any{}
+	}
+	fieldErrors := s.validate("config", config)
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	return errors.ValidationError("notification channel config is invalid").
+		WithDetail("errors", fieldErrors)
+}
+
+// ValidateOverrides checks per-follow overrides: every field has to be a top-level property marked
+// Overridable and pass its rules. Offending fields are reported under the prefix field.
+func (s *ChannelConfigSchema) ValidateOverrides(field string, overrides map[string]any) error {
+	var fieldErrors []ChannelConfigFieldError
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		field := field + "." + name
+		property := s.overridable(name)
+		if property == nil {
+			fieldErrors = append(fieldErrors, ChannelConfigFieldError{
+				Field:   field,
+				Message: fmt.Sprintf("%s cannot be overridden", field),
+				Tag:     "overridable",
+			})
+			continue
+		}
+		fieldErrors = append(fieldErrors, property.validate(field, overrides[name])...)
+	}
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	return errors.ValidationError("notification channel overrides are invalid").
+		WithDetail("errors", fieldErrors)
+}
+
+// ApplyOverrides returns config with the overridable fields of overrides laid over it. Other fields
+// are ignored, e.g. ones left behind after the channel changed its type.
+func (s *ChannelConfigSchema) ApplyOverrides(config, overrides map[string]any) map[string]any {
+	merged := maps.Clone(config)
+	for name, value := range overrides {
+		if s.overridable(name) == nil {
+			continue
+		}
+		if merged == nil {
+			merged = make(map[string]any, len(overrides))
+		}
+		merged[name] = value
+	}
+	return merged
+}
+
+func (s *ChannelConfigSchema) overridable(name string) *ChannelConfigSchema {
+	if s == nil {
+		return nil
+	}
+	if property := s.Properties[name]; property != nil && property.Overridable && !property.Secret {
+		return property
+	}
+	return nil
+}
+
+func (s *ChannelConfigSchema) validate(field string, value any) []ChannelConfigFieldError {
+	if isUnsetConfigValue(value) {
+		return nil
+	}
+
+	var fieldErrors []ChannelConfigFieldError
+	fail := func(tag, format string, args ...any) []ChannelConfigFieldError {
+		return append(fieldErrors, ChannelConfigFieldError{Field: field, Message: fmt.Sprintf(format, args...), Tag: tag})
+	}
+
+	if s.Type != "" && !matchesSchemaType(s.Type, value) {
+		return fail("type", "%s must be of type %s", field, s.Type)
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(allowed any) bool { return enumEqual(allowed, value) }) {
+		return fail("enum", "%s must be one of %v", field, s.Enum)
+	}
+
+	switch v := value.(type) {
+	case string:
+		if s.MaxLength > 0 && utf8.RuneCountInString(v) > s.MaxLength {
+			fieldErrors = fail("maxLength", "%s must be at most %d characters", field, s.MaxLength)
+		}
+		if s.Format == SchemaFormatURI && !isHTTPURL(v) {
+			fieldErrors = fail("format", "%s must be a valid http(s) URL", field)
+		}
+		if s.Pattern != "" {
+			if matched, err := regexp.MatchString(s.Pattern, v); err != nil || !matched {
+				fieldErrors = fail("pattern", "%s must match %s", field, s.Pattern)
+			}
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if isUnsetConfigValue(v[name]) {
+				fieldErrors = append(fieldErrors, ChannelConfigFieldError{
+					Field:   field + "." + name,
+					Message: fmt.Sprintf("%s.%s is required", field, name),
+					Tag:     "required",
+				})
+			}
+		}
+		names := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fieldErrors = append(fieldErrors, s.Properties[name].validate(field+"."+name, v[name])...)
+		}
+	case []any:
+		if s.Items != nil {
+			for i, item := range v {
+				fieldErrors = append(fieldErrors, s.Items.validate(fmt.Sprintf("%s[%d]", field, i), item)...)
+			}
+		}
+	default:
+		if n, ok := toSchemaNumber(value); ok {
+			if s.Minimum != nil && n < *s.Minimum {
+				fieldErrors = fail("minimum", "%s must be at least %v", field, *s.Minimum)
+			}
+			if s.Maximum != nil && n > *s.Maximum {
+				fieldErrors = fail("maximum", "%s must be at most %v", field, *s.Maximum)
+			}
+		}
+	}
+
+	if len(s.AnyOf) > 0 && !slices.ContainsFunc(s.AnyOf, func(sub *ChannelConfigSchema) bool {
+		return len(sub.validate(field, value)) == 0
+	}) {
+		fieldErrors = fail("anyOf", "%s must match one of: %s", field, describeAnyOf(s.AnyOf))
+	}
+
+	return fieldErrors
+}
+
+func describeAnyOf(schemas []*ChannelConfigSchema) string {
+	parts := make([]string, len(schemas))
+	for i, sub := range schemas {
+		switch {
+		case len(sub.Required) > 0:
+			parts[i] = "required " + strings.Join(sub.Required, ", ")
+		case sub.Type != "":
+			parts[i] = sub.Type
+		default:
+			parts[i] = fmt.Sprintf("schema %d", i+1)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+func isUnsetConfigValue(value any) bool {
+	if value == nil {
+		return true
+	}
+	s, ok := value.(string)
+	return ok && strings.TrimSpace(s) == ""
+}
+
+func matchesSchemaType(schemaType string, value any) bool {
+	switch schemaType {
+	case SchemaTypeObject:
+		_, ok := value.(map[string]any)
+		return ok
+	case SchemaTypeString:
+		_, ok := value.(string)
+		return ok
+	case SchemaTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case SchemaTypeArray:
+		switch value.(type) {
+		case []any, []string:
+			return true
+		}
+		return false
+	case SchemaTypeNumber:
+		_, ok := toSchemaNumber(value)
+		return ok
+	case SchemaTypeInteger:
+		n, ok := toSchemaNumber(value)
+		return ok && n == math.Trunc(n)
+	default:
+		return true
+	}
+}
+
+func toSchemaNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func enumEqual(allowed, value any) bool {
+	if a, ok := toSchemaNumber(allowed); ok {
+		v, ok := toSchemaNumber(value)
+		return ok && a == v
+	}
+	return reflect.DeepEqual(allowed, value)
+}
+
+func isHTTPURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// NotificationChannelTypeSchema pairs a supported channel type with the config it accepts.
+type NotificationChannelTypeSchema struct {
+	ChannelType NotificationChannelType
+	Schema      *ChannelConfigSchema
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelConfigSchema_Validate(t *testing.T) {
+	schema := &ChannelConfigSchema{
+		Type:     SchemaTypeObject,
+		Required: []string{"topic"},
+		AnyOf: []*ChannelConfigSchema{
+			{Required: []string{"key"}},
+			{Required: []string{"webhook_url"}},
+		},
+		Properties: map[string]*ChannelConfigSchema{
+			"topic":       {Type: SchemaTypeString, MaxLength: 5},
+			"key":         {Type: SchemaTypeString},
+			"webhook_url": {Type: SchemaTypeString, Format: SchemaFormatURI},
+			"level":       {Type: SchemaTypeString, Enum: []any{"active", "passive"}},
+			"volume":      {Type: SchemaTypeInteger, Minimum: SchemaBound(0), Maximum: SchemaBound(10)},
+			"markdown":    {Type: SchemaTypeBoolean},
+			"mobiles":     {Type: SchemaTypeArray, Items: &ChannelConfigSchema{Type: SchemaTypeString}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		fields map[string]string
+	}{
+		{
+			name:   "valid",
+			config: map[string]any{"topic": "live", "key": "k", "level": "passive", "volume": float64(3), "markdown": true, "mobiles": []any{"1"}},
+		},
+		{
+			name:   "blank values count as unset",
+			config: map[string]any{"topic": "live", "key": "k", "webhook_url": "  ", "level": ""},
+		},
+		{
+			name:   "nil config",
+			config: nil,
+			fields: map[string]string{"config.topic": "required", "config": "anyOf"},
+		},
+		{
+			name: "field errors",
+			config: map[string]any{
+				"topic":       "too long",
+				"webhook_url": "ftp://example.com",
+				"level":       "loud",
+				"volume":      float64(2.5),
+				"markdown":    "yes",
+				"mobiles":     []any{"1", 2},
+			},
+			fields: map[string]string{
+				"config.topic":       "maxLength",
+				"config.webhook_url": "format",
+				"config.level":       "enum",
+				"config.volume":      "type",
+				"config.markdown":    "type",
+				"config.mobiles[1]":  "type",
+			},
+		},
+		{
+			name:   "out of range",
+			config: map[string]any{"topic": "live", "key": "k", "volume": 11},
+			fields: map[string]string{"config.volume": "maximum"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.config)
+			if len(tt.fields) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			appErr := errors.GetAppError(err)
+			require.NotNil(t, appErr)
+			require.Equal(t, errors.ErrCodeValidation, appErr.Code)
+
+			got := map[string]string{}
+			for _, fieldErr := range appErr.Details["errors"].([]ChannelConfigFieldError) {
+				got[fieldErr.Field] = fieldErr.Tag
+			}
+			require.Equal(t, tt.fields, got)
+		})
+	}
+}
+
+func TestChannelConfigSchema_Overrides(t *testing.T) {
+	schema := &ChannelConfigSchema{
+		Type: SchemaTypeObject,
+		Properties: map[string]*ChannelConfigSchema{
+			"device_key": {Type: SchemaTypeString, Secret: true, Overridable: true},
+			"url":        {Type: SchemaTypeString, Format: SchemaFormatURI},
+			"level":      {Type: SchemaTypeString, Enum: []any{"critical", "passive"}, Overridable: true},
+			"sound":      {Type: SchemaTypeString, Overridable: true},
+		},
+	}
+
+	require.NoError(t, schema.ValidateOverrides("channel_overrides.3", map[string]any{"level": "critical", "sound": "alarm"}))
+
+	err := schema.ValidateOverrides("channel_overrides.3", map[string]any{"device_key": "k", "url": "https://example.com", "level": "loud", "badge": 1})
+	appErr := errors.GetAppError(err)
+	require.NotNil(t, appErr)
+	got := map[string]string{}
+	for _, fieldErr := range appErr.Details["errors"].([]ChannelConfigFieldError) {
+		got[fieldErr.Field] = fieldErr.Tag
+	}
+	require.Equal(t, map[string]string{
+		"channel_overrides.3.badge":      "overridable",
+		"channel_overrides.3.device_key": "overridable",
+		"channel_overrides.3.level":      "enum",
+		"channel_overrides.3.url":        "overridable",
+	}, got)
+
+	config := map[string]any{"device_key": "mine", "level": "passive"}
+	merged := schema.ApplyOverrides(config, map[string]any{"device_key": "other", "url": "https://example.com", "level": "critical"})
+	require.Equal(t, map[string]any{"device_key": "mine", "level": "critical"}, merged)
+	require.Equal(t, "passive", config["level"])
+}
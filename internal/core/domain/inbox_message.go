@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// InboxChannelName is what the built-in inbox channel is called, unless the user already has a
+// channel of that name.
+const InboxChannelName = "Inbox"
+
+// InboxMessage is a notification kept in a user's in-app inbox.
+type InboxMessage struct {
+	ID        int64
+	UserID    int64
+	ChannelID int64
+	// StreamerID is the streamer the notification is about, nil for notifications about the account.
+	StreamerID *int64
+	EventType  NotificationEventType
+	Severity   NotificationSeverity
+	Title      string
+	Content    string
+	URL        string
+	ImageURL   string
+	IconURL    string
+	// ReadAt is when the user read the message; nil while it is unread.
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsRead reports whether the user has read the message.
+func (m *InboxMessage) IsRead() bool {
+	return m.ReadAt != nil
+}
+
+// NewInboxChannel builds the built-in inbox channel of a user. It needs no config or verification.
+func NewInboxChannel(userID int64, name string) *NotificationChannel {
+	return &NotificationChannel{
+		UserID:      userID,
+		ChannelType: ChannelTypeInbox,
+		Name:        name,
+		Config:      map[string]any{},
+		Enable:      true,
+	}
+}
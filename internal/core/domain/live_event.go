@@ -0,0 +1,94 @@
+package domain
+
+import "time"
+
+// LiveEventType names an event pushed to a user's open event streams as it happens.
+type LiveEventType string
+
+const (
+	LiveEventStreamOnline          LiveEventType = "stream.online"
+	LiveEventStreamOffline         LiveEventType = "stream.offline"
+	LiveEventStreamTitleChanged    LiveEventType = "stream.title_changed"
+	LiveEventStreamCategoryChanged LiveEventType = "stream.category_changed"
+	LiveEventNotificationDelivered LiveEventType = "notification.delivered"
+	LiveEventNotificationFailed    LiveEventType = "notification.failed"
+)
+
+// LiveEvent is one real-time event for a user. IDs grow with every event published, so a client
+// that reconnects can ask for the events after the last one it saw.
+type LiveEvent struct {
+	ID        int64
+	UserID    int64
+	Type      LiveEventType
+	Data      map[string]any
+	CreatedAt time.Time
+}
+
+// NewStreamLiveEventData describes the followed streamer for the stream.* events.
+func NewStreamLiveEventData(follow *UserFollowedStreamer, streamer *Streamer) map[string]any {
+	data := map[string]any{
+		"follow_id":            follow.ID,
+		"streamer_id":          streamer.ID,
+		"platform_type":        streamer.PlatformType,
+		"platform_streamer_id": streamer.PlatformStreamerID,
+		"display_name":         streamer.DisplayName,
+		"alias":                follow.Alias,
+		"avatar_url":           streamer.AvatarURL,
+		"room_url":             streamer.RoomURL,
+		"is_live":              streamer.LiveStatus.IsLive,
+		"title":                streamer.LiveStatus.Title,
+		"category":             streamer.LiveStatus.GameName,
+		"viewers":              streamer.LiveStatus.Viewers,
+		"cover_image":          streamer.LiveStatus.CoverImage,
+	}
+	if !streamer.LiveStatus.StartTime.IsZero() {
+		data["started_at"] = streamer.LiveStatus.StartTime
+	}
+	return data
+}
+
+// NewStreamChangeLiveEvent picks the event type and data announcing change of the followed streamer.
+func NewStreamChangeLiveEvent(follow *UserFollowedStreamer, streamer *Streamer, change StreamChange) (LiveEventType, map[string]any) {
+	eventType := LiveEventStreamTitleChanged
+	if change.EventType == NotificationEventCategoryChange {
+		eventType = LiveEventStreamCategoryChanged
+	}
+	data := NewStreamLiveEventData(follow, streamer)
+	data["from"] = change.From
+	data["to"] = change.To
+	return eventType, data
+}
+
+// NewDeliveryLiveEvent picks the event type and data reporting the outcome of delivery. ok is false
+// while the delivery has not settled as sent or dead yet.
+func NewDeliveryLiveEvent(delivery *NotificationDelivery) (eventType LiveEventType, data map[string]any, ok bool) {
+	switch delivery.Status {
+	case DeliveryStatusSent:
+		eventType = LiveEventNotificationDelivered
+	case DeliveryStatusDead:
+		eventType = LiveEventNotificationFailed
+	default:
+		return "", nil, false
+	}
+	data = map[string]any{
+		"delivery_id":  delivery.ID,
+		"channel_id":   delivery.ChannelID,
+		"channel_type": delivery.ChannelType,
+		"event_type":   delivery.EventType,
+		"status":       delivery.Status,
+		"attempts":     delivery.Attempts,
+	}
+	if delivery.StreamerID != nil {
+		data["streamer_id"] = *delivery.StreamerID
+	}
+	if delivery.FollowID != nil {
+		data["follow_id"] = *delivery.FollowID
+	}
+	if delivery.DeliveredAt != nil {
+		data["delivered_at"] = *delivery.DeliveredAt
+	}
+	if delivery.Error != "" {
+		data["error"] = delivery.Error
+	}
+	return eventType, data, true
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+// NotificationActionType is something the user can do straight from a notification through a signed
+// link, without signing in.
+type NotificationActionType string
+
+const (
+	// NotificationActionAcknowledge confirms the notification was seen, which stops its route from escalating.
+	NotificationActionAcknowledge NotificationActionType = "ack"
+	// NotificationActionSnooze holds back the follow's notifications for a while.
+	NotificationActionSnooze NotificationActionType = "snooze"
+	// NotificationActionMute holds back the follow's notifications about the ongoing broadcast.
+	NotificationActionMute NotificationActionType = "mute"
+	// NotificationActionUnfollow deletes the follow.
+	NotificationActionUnfollow NotificationActionType = "unfollow"
+)
+
+func (t NotificationActionType) IsValid() bool {
+	switch t {
+	case NotificationActionAcknowledge, NotificationActionSnooze, NotificationActionMute, NotificationActionUnfollow:
+		return true
+	default:
+		return false
+	}
+}
+
+// NeedsConfirmation reports whether the action is only carried out on an explicit request, never just
+// because its link was opened: link previews and mail scanners open links too, and a mute or unfollow
+// cannot be undone from the notification.
+func (t NotificationActionType) NeedsConfirmation() bool {
+	return t == NotificationActionMute || t == NotificationActionUnfollow
+}
+
+// NotificationActionsFor lists the actions offered with a delivery, most useful first. Only
+// notifications about a follow offer any; acknowledging is offered when an escalating route waits for it.
+func NotificationActionsFor(delivery *NotificationDelivery) []NotificationActionType {
+	if delivery.FollowID == nil {
+		return nil
+	}
+	var actions []NotificationActionType
+	if delivery.Route != nil && delivery.Route.Mode == RoutingModeEscalate {
+		actions = append(actions, NotificationActionAcknowledge)
+	}
+	switch delivery.EventType {
+	case NotificationEventStreamOnline, NotificationEventTitleChange, NotificationEventCategoryChange:
+		actions = append(actions, NotificationActionSnooze, NotificationActionMute, NotificationActionUnfollow)
+	case NotificationEventStreamOffline:
+		actions = append(actions, NotificationActionSnooze, NotificationActionUnfollow)
+	}
+	return actions
+}
+
+// NotificationActionToken is what a signed action link carries.
+type NotificationActionToken struct {
+	Action   NotificationActionType
+	FollowID int64
+	// DeliveryID is the notification the link was sent with; acting on it acknowledges the delivery.
+	DeliveryID int64
+	ExpiresAt  time.Time
+}
+
+// Sign encodes the token and signs it with secret, for use in a URL path.
+func (t NotificationActionToken) Sign(secret []byte) string {
+	payload := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%s:%d:%d:%d",
+		t.Action, t.FollowID, t.DeliveryID, t.ExpiresAt.Unix()))
+	return payload + "." + signNotificationAction(secret, payload)
+}
+
+// ParseNotificationActionToken checks the signature and expiry of a signed token and decodes it.
+func ParseNotificationActionToken(secret []byte, signed string, now time.Time) (*NotificationActionToken, error) {
+	invalid := errors.Forbidden("action link is invalid")
+	payload, signature, ok := strings.Cut(signed, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signNotificationAction(secret, payload))) {
+		return nil, invalid
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, invalid.Wrap(err)
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 {
+		return nil, invalid
+	}
+	token := &NotificationActionToken{Action: NotificationActionType(parts[0])}
+	followID, err1 := strconv.ParseInt(parts[1], 10, 64)
+	deliveryID, err2 := strconv.ParseInt(parts[2], 10, 64)
+	expiresAt, err3 := strconv.ParseInt(parts[3], 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil || !token.Action.IsValid() || followID <= 0 {
+		return nil, invalid
+	}
+	token.FollowID = followID
+	token.DeliveryID = deliveryID
+	token.ExpiresAt = time.Unix(expiresAt, 0)
+	if !now.Before(token.ExpiresAt) {
+		return nil, errors.Forbidden("action link has expired")
+	}
+	return token, nil
+}
+
+func signNotificationAction(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// NotificationActionResult is what carrying out a notification action did.
+type NotificationActionResult struct {
+	Action   NotificationActionType
+	FollowID int64
+	// Follow is the follow as the action left it; nil once unfollowed.
+	Follow *UserFollowedStreamer
+	// Acknowledged reports whether the notification the link came with was acknowledged.
+	Acknowledged bool
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationActionToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	token := NotificationActionToken{Action: NotificationActionSnooze, FollowID: 4, DeliveryID: 9, ExpiresAt: now.Add(time.Hour)}
+	signed := token.Sign(secret)
+
+	parsed, err := ParseNotificationActionToken(secret, signed, now)
+	require.NoError(t, err)
+	require.Equal(t, token.Action, parsed.Action)
+	require.Equal(t, token.FollowID, parsed.FollowID)
+	require.Equal(t, token.DeliveryID, parsed.DeliveryID)
+	require.True(t, token.ExpiresAt.Equal(parsed.ExpiresAt))
+
+	payload, signature, _ := strings.Cut(signed, ".")
+	forged := NotificationActionToken{Action: NotificationActionUnfollow, FollowID: 4, DeliveryID: 9, ExpiresAt: token.ExpiresAt}.Sign(secret)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	for _, invalid := range []string{
+		"",
+		payload,
+		forgedPayload + "." + signature,
+		token.Sign([]byte("other")),
+	} {
+		_, err := ParseNotificationActionToken(secret, invalid, now)
+		require.Equal(t, errors.ErrCodeForbidden, errors.GetAppError(err).Code, "token %q", invalid)
+	}
+
+	_, err = ParseNotificationActionToken(secret, signed, now.Add(time.Hour))
+	require.Equal(t, "action link has expired", errors.GetAppError(err).Message)
+}
+
+func TestNotificationActionTypeNeedsConfirmation(t *testing.T) {
+	require.False(t, NotificationActionAcknowledge.NeedsConfirmation())
+	require.False(t, NotificationActionSnooze.NeedsConfirmation())
+	require.True(t, NotificationActionMute.NeedsConfirmation())
+	require.True(t, NotificationActionUnfollow.NeedsConfirmation())
+}
+
+func TestNotificationActionsFor(t *testing.T) {
+	followID := int64(4)
+	require.Empty(t, NotificationActionsFor(&NotificationDelivery{EventType: NotificationEventStreamOnline}))
+	require.Empty(t, NotificationActionsFor(&NotificationDelivery{FollowID: &followID, EventType: NotificationEventDigest}))
+
+	require.Equal(t,
+		[]NotificationActionType{NotificationActionSnooze, NotificationActionMute, NotificationActionUnfollow},
+		NotificationActionsFor(&NotificationDelivery{FollowID: &followID, EventType: NotificationEventTitleChange}))
+	require.Equal(t,
+		[]NotificationActionType{NotificationActionAcknowledge, NotificationActionSnooze, NotificationActionUnfollow},
+		NotificationActionsFor(&NotificationDelivery{
+			FollowID:  &followID,
+			EventType: NotificationEventStreamOffline,
+			Route:     &NotificationDeliveryRoute{ID: "route", Mode: RoutingModeEscalate},
+		}))
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"maps"
+	"time"
+)
 
 // NotificationChannelType defines the type of notification channel
 type NotificationChannelType string
@@ -12,6 +15,13 @@ const (
 	ChannelTypeDiscord  NotificationChannelType = "discord"
 	ChannelTypeFeishu   NotificationChannelType = "feishu"
 	ChannelTypeBark     NotificationChannelType = "bark"
+	ChannelTypeNtfy     NotificationChannelType = "ntfy"
+	ChannelTypeGotify   NotificationChannelType = "gotify"
+	ChannelTypeWeCom    NotificationChannelType = "wecom"
+	ChannelTypeDingTalk NotificationChannelType = "dingtalk"
+	ChannelTypeWebPush  NotificationChannelType = "webpush"
+	// ChannelTypeInbox keeps notifications in the user's in-app inbox. Every user gets one built in.
+	ChannelTypeInbox NotificationChannelType = "inbox"
 )
 
 type NotificationChannel struct {
@@ -22,6 +32,72 @@ type NotificationChannel struct {
 	Config      map[string]any
 	Enable      bool
 	Priority    int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Template    NotificationTemplate
+	// DigestWindow batches go-live notifications: the first one opens a window and everything that
+	// arrives before it closes goes out as a single message. Zero sends each notification right away.
+	DigestWindow time.Duration
+	// DailySummary is nil unless the channel gets a daily summary of who streamed.
+	DailySummary *DailySummarySchedule
+	// LastTest is the outcome of the most recent test send, nil when never tested.
+	LastTest *NotificationChannelTestResult
+	// Verification is the pending verification handshake; the channel stays disabled until it is
+	// confirmed. Nil once verified or for types that need none.
+	Verification *ChannelVerification
+	// Health is the outcome of the channel's recent sends.
+	Health    ChannelHealth
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotificationChannelTestResult is the outcome of sending a test message through a channel.
+type NotificationChannelTestResult struct {
+	Success bool
+	Error   string
+	// Response holds what the provider reported back on failure, e.g. HTTP status and body.
+	Response map[string]any
+	Latency  time.Duration
+	TestedAt time.Time
+}
+
+// Digests reports whether notifications of eventType sent through the channel are batched into digests.
+func (c *NotificationChannel) Digests(eventType NotificationEventType) bool {
+	return c.DigestWindow > 0 && eventType.IsDigestible()
+}
+
+// RedactedSecret replaces secret config values in API responses. Sending it back on
+// update, like omitting the field, keeps the stored secret.
+const RedactedSecret = "********"
+
+// Redacted returns a copy of the channel with the given secret config fields masked.
+func (c *NotificationChannel) Redacted(secretFields []string) *NotificationChannel {
+	redacted := *c
+	if c.Config != nil {
+		redacted.Config = maps.Clone(c.Config)
+		for _, field := range secretFields {
+			if !isUnsetConfigValue(redacted.Config[field]) {
+				redacted.Config[field] = RedactedSecret
+			}
+		}
+	}
+	return &redacted
+}
+
+// KeepSecrets fills secret fields that config omits, or sends back redacted, from the stored config.
+// Redacted placeholders without a stored value are dropped so they are never saved as secrets.
+func KeepSecrets(config, stored map[string]any, secretFields []string) map[string]any {
+	for _, field := range secretFields {
+		value, present := config[field]
+		if present && value != RedactedSecret {
+			continue
+		}
+		if storedValue, ok := stored[field]; ok && !isUnsetConfigValue(storedValue) {
+			if config == nil {
+				config = map[string]any{}
+			}
+			config[field] = storedValue
+		} else if present {
+			delete(config, field)
+		}
+	}
+	return config
 }
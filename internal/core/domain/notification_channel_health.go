@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// ChannelHealthState summarizes how a channel's recent sends went.
+type ChannelHealthState string
+
+const (
+	// ChannelHealthHealthy channels delivered their last send, or have not sent anything yet.
+	ChannelHealthHealthy ChannelHealthState = "healthy"
+	// ChannelHealthFailing channels failed their most recent sends but are still enabled.
+	ChannelHealthFailing ChannelHealthState = "failing"
+	// ChannelHealthUnhealthy channels failed too often in a row and were disabled automatically.
+	ChannelHealthUnhealthy ChannelHealthState = "unhealthy"
+)
+
+// ChannelHealth tracks the outcome of sends through a channel. Verification codes and test messages
+// are not counted, but a successful test ends a failure streak.
+type ChannelHealth struct {
+	ConsecutiveFailures int
+	LastError           string
+	LastFailureAt       *time.Time
+	LastSuccessAt       *time.Time
+	// DisabledAt is when the channel was disabled for failing; nil while it is not.
+	DisabledAt *time.Time
+}
+
+// State reports whether the channel is healthy, failing or was disabled for failing.
+func (h ChannelHealth) State() ChannelHealthState {
+	switch {
+	case h.DisabledAt != nil:
+		return ChannelHealthUnhealthy
+	case h.ConsecutiveFailures > 0:
+		return ChannelHealthFailing
+	default:
+		return ChannelHealthHealthy
+	}
+}
+
+// ExceedsFailureThreshold reports whether the channel is still enabled although its failure streak
+// reached threshold. A threshold of zero or less never disables a channel.
+func (c *NotificationChannel) ExceedsFailureThreshold(threshold int) bool {
+	return threshold > 0 && c.Enable && c.Health.ConsecutiveFailures >= threshold
+}
+
+// CanReceiveAlerts reports whether the channel may carry alerts about the user's other channels.
+func (c *NotificationChannel) CanReceiveAlerts() bool {
+	return c.Enable && c.IsVerified() && c.Health.DisabledAt == nil
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationChannel_Redacted(t *testing.T) {
+	channel := &NotificationChannel{ID: 1, Config: map[string]any{"device_key": "abc", "sound": "bell", "password": ""}}
+
+	redacted := channel.Redacted([]string{"device_key", "password"})
+	require.Equal(t, map[string]any{"device_key": RedactedSecret, "sound": "bell", "password": ""}, redacted.Config)
+	require.Equal(t, "abc", channel.Config["device_key"])
+}
+
+func TestKeepSecrets(t *testing.T) {
+	stored := map[string]any{"device_key": "abc", "password": "pw"}
+	secrets := []string{"device_key", "password", "token"}
+
+	config := KeepSecrets(map[string]any{"device_key": RedactedSecret, "sound": "bell", "token": RedactedSecret}, stored, secrets)
+	require.Equal(t, map[string]any{"device_key": "abc", "password": "pw", "sound": "bell"}, config)
+
+	config = KeepSecrets(map[string]any{"device_key": "new", "password": ""}, stored, secrets)
+	require.Equal(t, map[string]any{"device_key": "new", "password": ""}, config)
+
+	require.Equal(t, stored, KeepSecrets(nil, stored, secrets))
+}
+
+func TestNotificationChannel_Verify(t *testing.T) {
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	require.False(t, ChannelTypeWebPush.RequiresVerification())
+	require.True(t, ChannelTypeWebhook.RequiresVerification())
+
+	channel := &NotificationChannel{Enable: true}
+	require.True(t, channel.IsVerified())
+	code, err := channel.StartVerification(now)
+	require.NoError(t, err)
+	require.Len(t, code, 6)
+	require.False(t, channel.Enable)
+	require.False(t, channel.IsVerified())
+	require.NotContains(t, channel.Verification.CodeHash, code)
+
+	// Wrong codes use up attempts until the code is invalidated.
+	for range maxChannelVerificationAttempts {
+		require.Error(t, channel.Verify("000000x", now))
+	}
+	require.Error(t, channel.Verify(code, now))
+
+	code, err = channel.StartVerification(now)
+	require.NoError(t, err)
+	require.Error(t, channel.Verify(code, now.Add(ChannelVerificationTTL)))
+	require.NoError(t, channel.Verify(code, now.Add(time.Minute)))
+	require.True(t, channel.Enable)
+	require.True(t, channel.IsVerified())
+
+	sent := now
+	channel.Verification = &ChannelVerification{SentAt: &sent}
+	require.False(t, channel.CanResendVerification(now.Add(30*time.Second)))
+	require.True(t, channel.CanResendVerification(now.Add(ChannelVerificationResendInterval)))
+}
+
+func TestNotificationChannel_Health(t *testing.T) {
+	channel := &NotificationChannel{Enable: true}
+	require.Equal(t, ChannelHealthHealthy, channel.Health.State())
+	require.True(t, channel.CanReceiveAlerts())
+
+	channel.Health.ConsecutiveFailures = 2
+	require.Equal(t, ChannelHealthFailing, channel.Health.State())
+	require.False(t, channel.ExceedsFailureThreshold(3))
+	require.False(t, channel.ExceedsFailureThreshold(0))
+
+	channel.Health.ConsecutiveFailures = 3
+	require.True(t, channel.ExceedsFailureThreshold(3))
+
+	disabledAt := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	channel.Enable = false
+	channel.Health.DisabledAt = &disabledAt
+	require.Equal(t, ChannelHealthUnhealthy, channel.Health.State())
+	require.False(t, channel.ExceedsFailureThreshold(3))
+	require.False(t, channel.CanReceiveAlerts())
+}
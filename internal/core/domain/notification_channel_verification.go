@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+const (
+	// ChannelVerificationTTL is how long a verification code can be confirmed after it was issued.
+	ChannelVerificationTTL = 30 * time.Minute
+	// ChannelVerificationResendInterval is how soon a new code may be requested after the last one.
+	ChannelVerificationResendInterval = time.Minute
+	// maxChannelVerificationAttempts wrong codes invalidate the current one.
+	maxChannelVerificationAttempts = 5
+	channelVerificationCodeDigits  = 6
+)
+
+// RequiresVerification reports whether channels of this type must prove they reach the user before
+// they can be enabled. Every type sends to a destination taken from its config, which could belong to
+// someone else, except web push and the inbox: they only deliver to browsers the user subscribed
+// themselves and to the user's own inbox.
+func (t NotificationChannelType) RequiresVerification() bool {
+	return t != ChannelTypeWebPush && t != ChannelTypeInbox
+}
+
+// ChannelVerification is a pending verification handshake: a one-time code sent through the channel
+// that the user has to confirm. Only a hash of the code is kept.
+type ChannelVerification struct {
+	CodeHash  string
+	ExpiresAt time.Time
+	Attempts  int
+	// SentAt is when the code went out through the channel; nil when sending it failed.
+	SentAt *time.Time
+}
+
+// IsVerified reports whether the channel has no pending verification. Channels created before
+// verification existed count as verified.
+func (c *NotificationChannel) IsVerified() bool {
+	return c.Verification == nil
+}
+
+// StartVerification issues a new code, disables the channel until it is confirmed and returns the code
+// to send through the channel.
+func (c *NotificationChannel) StartVerification(now time.Time) (string, error) {
+	code, err := newChannelVerificationCode()
+	if err != nil {
+		return "", errors.Internal(err)
+	}
+	c.Enable = false
+	c.Verification = &ChannelVerification{
+		CodeHash:  hashChannelVerificationCode(code),
+		ExpiresAt: now.Add(ChannelVerificationTTL),
+	}
+	return code, nil
+}
+
+// CanResendVerification reports whether a new code may be issued at now.
+func (c *NotificationChannel) CanResendVerification(now time.Time) bool {
+	v := c.Verification
+	return v == nil || v.SentAt == nil || now.Sub(*v.SentAt) >= ChannelVerificationResendInterval
+}
+
+// Verify confirms the pending verification with code and enables the channel. A wrong code counts as
+// an attempt, so the caller has to save the channel whether or not Verify succeeds.
+func (c *NotificationChannel) Verify(code string, now time.Time) error {
+	v := c.Verification
+	if v == nil {
+		return errors.Conflict("notification channel is already verified")
+	}
+	if !now.Before(v.ExpiresAt) || v.Attempts >= maxChannelVerificationAttempts {
+		return errors.BadRequest("verification code has expired, request a new one")
+	}
+	hash := hashChannelVerificationCode(code)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(v.CodeHash)) != 1 {
+		v.Attempts++
+		return errors.BadRequest("verification code is invalid").
+			WithDetail("attempts_left", maxChannelVerificationAttempts-v.Attempts)
+	}
+	c.Verification = nil
+	c.Enable = true
+	return nil
+}
+
+func newChannelVerificationCode() (string, error) {
+	limit := big.NewInt(1_000_000)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", channelVerificationCodeDigits, n.Int64()), nil
+}
+
+func hashChannelVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"time"
+)
+
+// NotificationDeliveryStatus tracks where a delivery is in its lifecycle.
+//
+//	pending -> processing -> sent
+//	                      -> retrying -> processing ...
+//	                      -> dead -> (replay) pending
+//
+// Sequential routes (see NotificationRouting) add two states for the later steps of a route:
+//
+//	held -> pending         when the previous step failed
+//	held/pending -> skipped when an earlier step was delivered or acknowledged first
+//
+// Channels with a digest window batch their notifications and send them as one digest delivery:
+//
+//	batched -> digested     once the window closed and the digest was queued
+//
+// Go-live notifications over the per-channel or per-user send rate limit wait and go out collapsed
+// into one message once the limit allows:
+//
+//	throttled -> digested
+type NotificationDeliveryStatus string
+
+const (
+	DeliveryStatusPending    NotificationDeliveryStatus = "pending"
+	DeliveryStatusProcessing NotificationDeliveryStatus = "processing"
+	DeliveryStatusRetrying   NotificationDeliveryStatus = "retrying"
+	DeliveryStatusSent       NotificationDeliveryStatus = "sent"
+	DeliveryStatusDead       NotificationDeliveryStatus = "dead"
+	DeliveryStatusHeld       NotificationDeliveryStatus = "held"
+	DeliveryStatusSkipped    NotificationDeliveryStatus = "skipped"
+	DeliveryStatusBatched    NotificationDeliveryStatus = "batched"
+	DeliveryStatusDigested   NotificationDeliveryStatus = "digested"
+	DeliveryStatusThrottled  NotificationDeliveryStatus = "throttled"
+)
+
+func (s NotificationDeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryStatusPending, DeliveryStatusProcessing, DeliveryStatusRetrying, DeliveryStatusSent, DeliveryStatusDead,
+		DeliveryStatusHeld, DeliveryStatusSkipped, DeliveryStatusBatched, DeliveryStatusDigested, DeliveryStatusThrottled:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationDelivery is one notification queued for, or delivered through, one channel.
+type NotificationDelivery struct {
+	ID          int64
+	UserID      int64
+	FollowID    *int64
+	ChannelID   int64
+	ChannelType NotificationChannelType
+	StreamerID  *int64
+	EventType   NotificationEventType
+	// Payload is the rendered message exactly as handed to the provider.
+	Payload map[string]any
+	// Response holds what the provider reported back, e.g. HTTP status and body on failure.
+	Response      map[string]any
+	Status        NotificationDeliveryStatus
+	Attempts      int
+	Latency       time.Duration
+	Error         string
+	NextAttemptAt *time.Time
+	LockedUntil   *time.Time
+	DeliveredAt   *time.Time
+	// Route ties together the deliveries of one notification sent with a sequential routing mode.
+	Route *NotificationDeliveryRoute
+	// DigestID is the digest delivery a batched or throttled notification was sent with.
+	DigestID *int64
+	// AcknowledgedAt is when the user confirmed seeing the notification; it stops escalation.
+	AcknowledgedAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NotificationDeliveryRoute places a delivery within a sequential route. Step 0 goes to the
+// channel with the highest priority.
+type NotificationDeliveryRoute struct {
+	ID            string
+	Mode          NotificationRoutingMode
+	Step          int
+	EscalateAfter time.Duration
+}
+
+// NotificationDeliveryFilter narrows delivery history. Zero values are ignored.
+type NotificationDeliveryFilter struct {
+	UserID     int64
+	Status     NotificationDeliveryStatus
+	ChannelID  int64
+	StreamerID int64
+	FollowID   int64
+	EventType  NotificationEventType
+	RouteID    string
+	From       time.Time
+	To         time.Time
+}
+
+// NewNotificationDelivery queues payload for delivery through channel as soon as a worker is free.
+func NewNotificationDelivery(channel *NotificationChannel, follow *UserFollowedStreamer, streamerID int64, eventType NotificationEventType, payload map[string]any, now time.Time) *NotificationDelivery {
+	delivery := &NotificationDelivery{
+		UserID:        channel.UserID,
+		ChannelID:     channel.ID,
+		ChannelType:   channel.ChannelType,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        DeliveryStatusPending,
+		NextAttemptAt: &now,
+	}
+	if follow != nil && follow.ID > 0 {
+		followID := follow.ID
+		delivery.FollowID = &followID
+	}
+	if streamerID > 0 {
+		delivery.StreamerID = &streamerID
+	}
+	return delivery
+}
+
+// NewDigestDelivery queues the digest that replaces batched, which all belong to one channel. A digest
+// of a single notification keeps its event, follow and streamer.
+func NewDigestDelivery(batched []*NotificationDelivery, payload map[string]any, now time.Time) *NotificationDelivery {
+	first := batched[0]
+	digest := &NotificationDelivery{
+		UserID:        first.UserID,
+		ChannelID:     first.ChannelID,
+		ChannelType:   first.ChannelType,
+		EventType:     NotificationEventDigest,
+		Payload:       payload,
+		Status:        DeliveryStatusPending,
+		NextAttemptAt: &now,
+	}
+	if len(batched) == 1 {
+		digest.EventType = first.EventType
+		digest.FollowID = first.FollowID
+		digest.StreamerID = first.StreamerID
+	}
+	return digest
+}
+
+// Batch holds the delivery back for the channel's next digest.
+func (d *NotificationDelivery) Batch() {
+	d.Status = DeliveryStatusBatched
+	d.NextAttemptAt = nil
+}
+
+// Throttle holds the delivery back until the send rate limit lets the channel's overflow message out.
+func (d *NotificationDelivery) Throttle() {
+	d.Status = DeliveryStatusThrottled
+	d.NextAttemptAt = nil
+}
+
+// RouteDeliveries chains pending deliveries, one per channel in priority order, into a sequential route.
+//
+// First-success routes hold every step after the first until the previous one fails. Escalation
+// routes schedule step n at now+n*EscalateAfter up front, so an unacknowledged notification escalates
+// without further bookkeeping; a failed step pulls the next one forward.
+func RouteDeliveries(routeID string, routing NotificationRouting, deliveries []*NotificationDelivery, now time.Time) {
+	for step, delivery := range deliveries {
+		delivery.Route = &NotificationDeliveryRoute{
+			ID:            routeID,
+			Mode:          routing.Mode,
+			Step:          step,
+			EscalateAfter: routing.EscalateAfter,
+		}
+		if step == 0 {
+			continue
+		}
+		switch routing.Mode {
+		case RoutingModeEscalate:
+			at := now.Add(time.Duration(step) * routing.EscalateAfter)
+			delivery.NextAttemptAt = &at
+		default:
+			delivery.Status = DeliveryStatusHeld
+			delivery.NextAttemptAt = nil
+		}
+	}
+}
+
+// IsFinal reports whether workers are done with the delivery.
+func (d *NotificationDelivery) IsFinal() bool {
+	switch d.Status {
+	case DeliveryStatusSent, DeliveryStatusDead, DeliveryStatusSkipped, DeliveryStatusDigested:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarkSent records a successful attempt.
+func (d *NotificationDelivery) MarkSent(latency time.Duration, response map[string]any, at time.Time) {
+	d.Attempts++
+	d.Status = DeliveryStatusSent
+	d.Latency = latency
+	d.Response = response
+	d.Error = ""
+	d.NextAttemptAt = nil
+	d.LockedUntil = nil
+	d.DeliveredAt = &at
+}
+
+// MarkRetry records a transient failure and schedules the next attempt.
+func (d *NotificationDelivery) MarkRetry(latency time.Duration, response map[string]any, err error, next time.Time) {
+	d.markAttemptFailed(latency, response, err)
+	d.Status = DeliveryStatusRetrying
+	d.NextAttemptAt = &next
+}
+
+// MarkDead records a permanent failure; the delivery stays dead until replayed.
+func (d *NotificationDelivery) MarkDead(latency time.Duration, response map[string]any, err error) {
+	d.markAttemptFailed(latency, response, err)
+	d.Status = DeliveryStatusDead
+	d.NextAttemptAt = nil
+}
+
+// Requeue puts the delivery back in the queue with a fresh retry budget.
+func (d *NotificationDelivery) Requeue(now time.Time) {
+	d.Status = DeliveryStatusPending
+	d.Attempts = 0
+	d.Error = ""
+	d.NextAttemptAt = &now
+	d.LockedUntil = nil
+}
+
+func (d *NotificationDelivery) markAttemptFailed(latency time.Duration, response map[string]any, err error) {
+	d.Attempts++
+	d.Latency = latency
+	d.Response = response
+	d.LockedUntil = nil
+	if err != nil {
+		d.Error = err.Error()
+	}
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+)
+
+const (
+	MinDigestWindow = time.Minute
+	MaxDigestWindow = 6 * time.Hour
+)
+
+// dailySummaryGrace is how late a daily summary may still go out, e.g. after downtime. Older
+// summaries are skipped rather than sent hours after their time.
+const dailySummaryGrace = time.Hour
+
+// ValidateDigestWindow checks NotificationChannel.DigestWindow; zero turns digests off.
+func ValidateDigestWindow(window time.Duration) error {
+	if window == 0 {
+		return nil
+	}
+	if window < MinDigestWindow || window > MaxDigestWindow {
+		return errors.BadRequest("digest window must be between 1 minute and 6 hours").
+			WithDetail("digest_window_seconds", int64(window/time.Second))
+	}
+	return nil
+}
+
+// DailySummarySchedule sends a channel a summary of who streamed during the past day, once a day
+// at a fixed local time.
+type DailySummarySchedule struct {
+	// At is the local time of day as HH:MM.
+	At string
+	// Timezone is an IANA name such as "Asia/Shanghai"; empty means UTC.
+	Timezone string
+	// LastSentAt is when the most recent summary went out.
+	LastSentAt *time.Time
+}
+
+// NewDailySummarySchedule validates the time of day and timezone.
+func NewDailySummarySchedule(at, timezone string) (*DailySummarySchedule, error) {
+	schedule := &DailySummarySchedule{
+		At:       strings.TrimSpace(at),
+		Timezone: strings.TrimSpace(timezone),
+	}
+	if _, err := loadTimezone(schedule.Timezone); err != nil {
+		return nil, errors.BadRequest("daily summary timezone is invalid").WithDetail("timezone", schedule.Timezone)
+	}
+	if _, err := time.Parse(clockLayout, schedule.At); err != nil {
+		return nil, errors.BadRequest("daily summary time must be an HH:MM time").WithDetail("at", schedule.At)
+	}
+	return schedule, nil
+}
+
+// Due returns the scheduled time of the summary that should go out at now, and whether it is
+// still to be sent. The summary covers the 24 hours before the returned time.
+func (s *DailySummarySchedule) Due(now time.Time) (time.Time, bool) {
+	loc, err := loadTimezone(s.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+	clock, err := time.Parse(clockLayout, s.At)
+	if err != nil {
+		return time.Time{}, false
+	}
+	local := now.In(loc)
+	scheduled := time.Date(local.Year(), local.Month(), local.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	if local.Before(scheduled) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+	if now.Sub(scheduled) > dailySummaryGrace {
+		return scheduled, false
+	}
+	if s.LastSentAt != nil && !s.LastSentAt.Before(scheduled) {
+		return scheduled, false
+	}
+	return scheduled, true
+}
+
+// DigestEntry is one go-live notification folded into a digest.
+type DigestEntry struct {
+	Streamer string
+	Title    string
+	URL      string
+}
+
+// RenderDigest builds a single message listing every streamer of a digest with their title and link.
+func RenderDigest(locale i18n.Locale, entries []DigestEntry) *RenderedNotification {
+	var body strings.Builder
+	for i, entry := range entries {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		body.WriteString("• ")
+		body.WriteString(entry.Streamer)
+		if entry.Title != "" {
+			body.WriteString(": ")
+			body.WriteString(entry.Title)
+		}
+		if entry.URL != "" {
+			body.WriteString("\n  ")
+			body.WriteString(entry.URL)
+		}
+	}
+	return &RenderedNotification{
+		Title: i18n.T(locale, "notification.digest.title", countStreamers(locale, len(entries))),
+		Body:  body.String(),
+	}
+}
+
+// RenderOverflow collapses go-live notifications held back by the send rate limit into one message.
+func RenderOverflow(locale i18n.Locale, entries []DigestEntry) *RenderedNotification {
+	rendered := RenderDigest(locale, entries)
+	rendered.Title = i18n.T(locale, "notification.overflow.title", countMoreStreamers(locale, len(entries)))
+	return rendered
+}
+
+// DailySummaryEntry is what one streamer did during the day covered by a summary.
+type DailySummaryEntry struct {
+	Streamer string
+	Sessions int
+	Duration time.Duration
+	// Title is the title of the latest session.
+	Title string
+}
+
+// RenderDailySummary lists who streamed and for how long.
+func RenderDailySummary(locale i18n.Locale, entries []DailySummaryEntry) *RenderedNotification {
+	var body strings.Builder
+	for i, entry := range entries {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		fmt.Fprintf(&body, "• %s: %s", entry.Streamer, FormatStreamDuration(locale, entry.Duration))
+		if entry.Sessions > 1 {
+			body.WriteString(i18n.T(locale, "notification.daily_summary.streams", entry.Sessions))
+		}
+		if entry.Title != "" {
+			fmt.Fprintf(&body, " (%s)", entry.Title)
+		}
+	}
+	return &RenderedNotification{
+		Title: i18n.T(locale, "notification.daily_summary.title", countStreamers(locale, len(entries))),
+		Body:  body.String(),
+	}
+}
+
+// FormatStreamDuration renders a duration as hours and minutes, e.g. "2h 05m" or "45m" in English.
+func FormatStreamDuration(locale i18n.Locale, d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Hour {
+		return i18n.T(locale, "notification.duration.minutes", int(d/time.Minute))
+	}
+	return i18n.T(locale, "notification.duration.hours", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+func countMoreStreamers(locale i18n.Locale, n int) string {
+	if n == 1 {
+		return i18n.T(locale, "notification.more_streamers.one")
+	}
+	return i18n.T(locale, "notification.more_streamers.other", n)
+}
+
+func countStreamers(locale i18n.Locale, n int) string {
+	if n == 1 {
+		return i18n.T(locale, "notification.streamers.one")
+	}
+	return i18n.T(locale, "notification.streamers.other", n)
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailySummaryScheduleDue(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	schedule, err := NewDailySummarySchedule(" 09:00 ", "Asia/Shanghai")
+	require.NoError(t, err)
+	require.Equal(t, "09:00", schedule.At)
+
+	today := time.Date(2025, 6, 2, 9, 0, 0, 0, shanghai)
+	sentToday := today.Add(time.Minute)
+	sentYesterday := today.AddDate(0, 0, -1).Add(time.Minute)
+
+	cases := []struct {
+		name      string
+		now       time.Time
+		sent      *time.Time
+		scheduled time.Time
+		due       bool
+	}{
+		{name: "before today's time", now: today.Add(-time.Minute), sent: &sentYesterday, scheduled: today.AddDate(0, 0, -1)},
+		{name: "at today's time", now: today, sent: &sentYesterday, scheduled: today, due: true},
+		{name: "never sent", now: today.Add(5 * time.Minute), scheduled: today, due: true},
+		{name: "already sent", now: today.Add(5 * time.Minute), sent: &sentToday, scheduled: today},
+		{name: "too late to send", now: today.Add(2 * time.Hour), sent: &sentYesterday, scheduled: today},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule.LastSentAt = tc.sent
+			scheduled, due := schedule.Due(tc.now.UTC())
+			require.Equal(t, tc.due, due)
+			require.True(t, tc.scheduled.Equal(scheduled), "scheduled %s, want %s", scheduled, tc.scheduled)
+		})
+	}
+}
+
+func TestDailySummaryScheduleValidation(t *testing.T) {
+	_, err := NewDailySummarySchedule("25:00", "")
+	require.Error(t, err)
+	_, err = NewDailySummarySchedule("09:00", "Mars/Olympus")
+	require.Error(t, err)
+}
+
+func TestValidateDigestWindow(t *testing.T) {
+	require.NoError(t, ValidateDigestWindow(0))
+	require.NoError(t, ValidateDigestWindow(5*time.Minute))
+	require.Error(t, ValidateDigestWindow(30*time.Second))
+	require.Error(t, ValidateDigestWindow(7*time.Hour))
+}
+
+func TestRenderDailySummary(t *testing.T) {
+	entries := []DailySummaryEntry{
+		{Streamer: "Alice", Sessions: 1, Duration: 2*time.Hour + 5*time.Minute, Title: "Ranked"},
+		{Streamer: "Bob", Sessions: 2, Duration: 45 * time.Minute},
+	}
+	rendered := RenderDailySummary(i18n.English, entries)
+	require.Equal(t, "Daily summary: 2 streamers streamed", rendered.Title)
+	require.Equal(t, "• Alice: 2h 05m (Ranked)\n• Bob: 45m in 2 streams", rendered.Body)
+
+	rendered = RenderDailySummary(i18n.SimplifiedChinese, entries)
+	require.Equal(t, "每日汇总：2 位主播直播过", rendered.Title)
+	require.Equal(t, "• Alice: 2 小时 05 分钟 (Ranked)\n• Bob: 45 分钟，共 2 场", rendered.Body)
+}
@@ -0,0 +1,36 @@
+package domain
+
+// NotificationEventType identifies what triggered a notification.
+type NotificationEventType string
+
+const (
+	NotificationEventStreamOnline NotificationEventType = "stream_online"
+	// NotificationEventStreamOffline summarizes a broadcast once the streamer went offline.
+	NotificationEventStreamOffline NotificationEventType = "stream_offline"
+	// NotificationEventTitleChange and NotificationEventCategoryChange announce a settled change while live.
+	NotificationEventTitleChange    NotificationEventType = "title_change"
+	NotificationEventCategoryChange NotificationEventType = "category_change"
+	NotificationEventTest           NotificationEventType = "test"
+	// NotificationEventVerification carries the one-time code of a channel verification handshake.
+	NotificationEventVerification NotificationEventType = "verification"
+	// NotificationEventChannelDisabled tells the owner that one of their channels was disabled for failing.
+	NotificationEventChannelDisabled NotificationEventType = "channel_disabled"
+	// NotificationEventDigest combines notifications a digest channel batched over its window.
+	NotificationEventDigest       NotificationEventType = "digest"
+	NotificationEventDailySummary NotificationEventType = "daily_summary"
+)
+
+// IsDigestible reports whether the event is batched on channels with a digest window.
+func (t NotificationEventType) IsDigestible() bool {
+	return t == NotificationEventStreamOnline
+}
+
+// NotificationSeverity hints how intrusive a notification should be on targets that support it.
+type NotificationSeverity string
+
+const (
+	NotificationSeverityLow      NotificationSeverity = "low"
+	NotificationSeverityNormal   NotificationSeverity = "normal"
+	NotificationSeverityHigh     NotificationSeverity = "high"
+	NotificationSeverityCritical NotificationSeverity = "critical"
+)
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+const (
+	maxFilterValues       = 20
+	maxFilterValueLength  = 100
+	maxTitlePatternLength = 200
+)
+
+// NotificationFilter decides whether a go-live event of a follow notifies; every rule that is set has
+// to pass. Keywords and categories match case-insensitively.
+type NotificationFilter struct {
+	// TitleInclude requires the title to contain at least one of the keywords.
+	TitleInclude []string `json:"title_include,omitempty"`
+	// TitleExclude rejects titles containing any of the keywords.
+	TitleExclude []string `json:"title_exclude,omitempty"`
+	// TitlePattern is a regular expression the title has to match; prefix it with (?i) to ignore case.
+	TitlePattern string `json:"title_pattern,omitempty"`
+	// Categories only lets these categories through; empty allows any.
+	Categories        []string `json:"categories,omitempty"`
+	BlockedCategories []string `json:"blocked_categories,omitempty"`
+	MinViewers        int      `json:"min_viewers,omitempty"`
+}
+
+// Normalize trims the rules and drops empty and duplicate values.
+func (f NotificationFilter) Normalize() NotificationFilter {
+	f.TitleInclude = normalizeFilterValues(f.TitleInclude)
+	f.TitleExclude = normalizeFilterValues(f.TitleExclude)
+	f.TitlePattern = strings.TrimSpace(f.TitlePattern)
+	f.Categories = normalizeFilterValues(f.Categories)
+	f.BlockedCategories = normalizeFilterValues(f.BlockedCategories)
+	return f
+}
+
+func (f NotificationFilter) Validate() error {
+	lists := []struct {
+		field  string
+		values []string
+	}{
+		{"title_include", f.TitleInclude},
+		{"title_exclude", f.TitleExclude},
+		{"categories", f.Categories},
+		{"blocked_categories", f.BlockedCategories},
+	}
+	for _, list := range lists {
+		if len(list.values) > maxFilterValues {
+			return errors.BadRequestf("notification filter allows at most %d values per rule", maxFilterValues).
+				WithDetail("field", list.field)
+		}
+		for _, value := range list.values {
+			if utf8.RuneCountInString(value) > maxFilterValueLength {
+				return errors.BadRequestf("notification filter values must be at most %d characters", maxFilterValueLength).
+					WithDetail("field", list.field).
+					WithDetail("value", value)
+			}
+		}
+	}
+	if utf8.RuneCountInString(f.TitlePattern) > maxTitlePatternLength {
+		return errors.BadRequestf("title pattern must be at most %d characters", maxTitlePatternLength)
+	}
+	if _, err := regexp.Compile(f.TitlePattern); err != nil {
+		return errors.BadRequest("title pattern is not a valid regular expression").
+			WithDetail("title_pattern", f.TitlePattern).
+			WithDetail("reason", err.Error())
+	}
+	if f.MinViewers < 0 {
+		return errors.BadRequest("min viewers must not be negative").WithDetail("min_viewers", f.MinViewers)
+	}
+	return nil
+}
+
+// IsZero reports whether the filter has no rules and lets everything through.
+func (f NotificationFilter) IsZero() bool {
+	return len(f.TitleInclude) == 0 && len(f.TitleExclude) == 0 && f.TitlePattern == "" &&
+		len(f.Categories) == 0 && len(f.BlockedCategories) == 0 && f.MinViewers == 0
+}
+
+// Allows reports whether a stream with the given live status passes the filter.
+func (f NotificationFilter) Allows(status LiveStatusInfo) bool {
+	title := strings.ToLower(status.Title)
+	contains := func(keyword string) bool { return strings.Contains(title, strings.ToLower(keyword)) }
+	if len(f.TitleInclude) > 0 && !slices.ContainsFunc(f.TitleInclude, contains) {
+		return false
+	}
+	if slices.ContainsFunc(f.TitleExclude, contains) {
+		return false
+	}
+	if f.TitlePattern != "" {
+		pattern, err := regexp.Compile(f.TitlePattern)
+		if err != nil || !pattern.MatchString(status.Title) {
+			return false
+		}
+	}
+	isCategory := func(category string) bool { return strings.EqualFold(category, status.GameName) }
+	if len(f.Categories) > 0 && !slices.ContainsFunc(f.Categories, isCategory) {
+		return false
+	}
+	if slices.ContainsFunc(f.BlockedCategories, isCategory) {
+		return false
+	}
+	return status.Viewers >= f.MinViewers
+}
+
+func normalizeFilterValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !slices.ContainsFunc(result, func(existing string) bool { return strings.EqualFold(existing, value) }) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationFilterAllows(t *testing.T) {
+	status := LiveStatusInfo{IsLive: true, Title: "Elden Ring DLC blind run", GameName: "Elden Ring", Viewers: 500}
+
+	tests := []struct {
+		name   string
+		filter NotificationFilter
+		want   bool
+	}{
+		{name: "empty filter", filter: NotificationFilter{}, want: true},
+		{name: "include keyword ignores case", filter: NotificationFilter{TitleInclude: []string{"dlc", "speedrun"}}, want: true},
+		{name: "missing include keyword", filter: NotificationFilter{TitleInclude: []string{"speedrun"}}, want: false},
+		{name: "exclude keyword", filter: NotificationFilter{TitleExclude: []string{"BLIND"}}, want: false},
+		{name: "pattern matches", filter: NotificationFilter{TitlePattern: `(?i)^elden ring\b`}, want: true},
+		{name: "pattern does not match", filter: NotificationFilter{TitlePattern: `^Rerun`}, want: false},
+		{name: "allowed category", filter: NotificationFilter{Categories: []string{"elden ring"}}, want: true},
+		{name: "other category", filter: NotificationFilter{Categories: []string{"Just Chatting"}}, want: false},
+		{name: "blocked category", filter: NotificationFilter{BlockedCategories: []string{"Elden Ring"}}, want: false},
+		{name: "enough viewers", filter: NotificationFilter{MinViewers: 500}, want: true},
+		{name: "too few viewers", filter: NotificationFilter{MinViewers: 501}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.filter.Allows(status))
+		})
+	}
+}
+
+func TestUpdateFilter(t *testing.T) {
+	follow := &UserFollowedStreamer{}
+	require.NoError(t, follow.UpdateFilter(&NotificationFilter{TitleInclude: []string{" ranked ", "Ranked", ""}, MinViewers: 10}))
+	require.Equal(t, &NotificationFilter{TitleInclude: []string{"ranked"}, MinViewers: 10}, follow.Filter)
+
+	// A filter without rules is the same as none.
+	require.NoError(t, follow.UpdateFilter(&NotificationFilter{TitleExclude: []string{" "}}))
+	require.Nil(t, follow.Filter)
+
+	require.Error(t, follow.UpdateFilter(&NotificationFilter{TitlePattern: "(unclosed"}))
+	require.Error(t, follow.UpdateFilter(&NotificationFilter{MinViewers: -1}))
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+// NotificationPreference holds a user's notification settings that apply across all of their follows.
+// Users without a stored preference get NewNotificationPreference's defaults.
+type NotificationPreference struct {
+	ID      int64
+	UserID  int64
+	Routing NotificationRouting
+	// QuietHours is nil when the user has no schedule.
+	QuietHours *QuietHours
+	// DoNotDisturbUntil silences notifications like quiet hours until the given time.
+	DoNotDisturbUntil *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewNotificationPreference builds the default preference for a user.
+func NewNotificationPreference(userID int64) (*NotificationPreference, error) {
+	if userID <= 0 {
+		return nil, errors.BadRequest("user id must be greater than zero")
+	}
+	return &NotificationPreference{
+		UserID:  userID,
+		Routing: DefaultNotificationRouting,
+	}, nil
+}
+
+// UpdateQuietHours validates and stores the schedule; nil turns quiet hours off.
+func (p *NotificationPreference) UpdateQuietHours(quietHours *QuietHours) error {
+	if quietHours == nil {
+		p.QuietHours = nil
+		return nil
+	}
+	normalized := quietHours.Normalize()
+	if err := normalized.Validate(); err != nil {
+		return err
+	}
+	p.QuietHours = &normalized
+	return nil
+}
+
+// MaxDoNotDisturb bounds how long do-not-disturb can be switched on at once.
+const MaxDoNotDisturb = 7 * 24 * time.Hour
+
+// SetDoNotDisturb silences notifications for duration from now; zero switches do-not-disturb off.
+func (p *NotificationPreference) SetDoNotDisturb(now time.Time, duration time.Duration) error {
+	if duration == 0 {
+		p.DoNotDisturbUntil = nil
+		return nil
+	}
+	if duration < time.Minute || duration > MaxDoNotDisturb {
+		return errors.BadRequest("do-not-disturb must last between 1 minute and 7 days").
+			WithDetail("duration_minutes", int64(duration/time.Minute))
+	}
+	until := now.Add(duration)
+	p.DoNotDisturbUntil = &until
+	return nil
+}
+
+// QuietAction reports whether a notification at now has to be held back and how. Do-not-disturb
+// applies to every follow, while alwaysNotify follows bypass the quiet hours schedule. Do-not-disturb
+// uses the schedule's action, or delays when there is no schedule.
+func (p *NotificationPreference) QuietAction(now time.Time, alwaysNotify bool) (QuietHoursAction, bool) {
+	if p.DoNotDisturbUntil != nil && now.Before(*p.DoNotDisturbUntil) {
+		if p.QuietHours != nil {
+			return p.QuietHours.Action, true
+		}
+		return QuietHoursDelay, true
+	}
+	if alwaysNotify || p.QuietHours == nil {
+		return "", false
+	}
+	if _, active := p.QuietHours.ActiveUntil(now); active {
+		return p.QuietHours.Action, true
+	}
+	return "", false
+}
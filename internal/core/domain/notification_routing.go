@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+// NotificationRoutingMode decides which of a follow's channels a notification goes to.
+type NotificationRoutingMode string
+
+const (
+	// RoutingModeBroadcast sends through every channel at once.
+	RoutingModeBroadcast NotificationRoutingMode = "broadcast"
+	// RoutingModeFirstSuccess tries channels one at a time by priority and stops at the first delivery.
+	RoutingModeFirstSuccess NotificationRoutingMode = "first_success"
+	// RoutingModeEscalate sends through the primary channel and moves on to the next one when the
+	// previous channel failed, or was not acknowledged within EscalateAfter.
+	RoutingModeEscalate NotificationRoutingMode = "escalate"
+)
+
+const (
+	DefaultEscalateAfter = 5 * time.Minute
+	MaxEscalateAfter     = 24 * time.Hour
+)
+
+func (m NotificationRoutingMode) IsValid() bool {
+	switch m {
+	case RoutingModeBroadcast, RoutingModeFirstSuccess, RoutingModeEscalate:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationRouting is the routing policy of a user or, as an override, of a single follow.
+type NotificationRouting struct {
+	Mode NotificationRoutingMode
+	// EscalateAfter is only used by RoutingModeEscalate.
+	EscalateAfter time.Duration
+}
+
+// DefaultNotificationRouting applies until the user picks a policy.
+var DefaultNotificationRouting = NotificationRouting{Mode: RoutingModeBroadcast}
+
+// NewNotificationRouting validates mode and escalateAfter. An empty mode means broadcast and a zero
+// escalateAfter means DefaultEscalateAfter.
+func NewNotificationRouting(mode NotificationRoutingMode, escalateAfter time.Duration) (NotificationRouting, error) {
+	if mode == "" {
+		mode = RoutingModeBroadcast
+	}
+	if !mode.IsValid() {
+		return NotificationRouting{}, errors.BadRequest("notification routing mode is invalid").WithDetail("routing_mode", mode)
+	}
+	if mode != RoutingModeEscalate {
+		return NotificationRouting{Mode: mode}, nil
+	}
+	if escalateAfter == 0 {
+		escalateAfter = DefaultEscalateAfter
+	}
+	if escalateAfter < time.Second || escalateAfter > MaxEscalateAfter {
+		return NotificationRouting{}, errors.BadRequest("escalation delay must be between 1 second and 24 hours").
+			WithDetail("escalate_after_seconds", int64(escalateAfter/time.Second))
+	}
+	return NotificationRouting{Mode: mode, EscalateAfter: escalateAfter}, nil
+}
+
+// IsSequential reports whether channels are used one after another rather than all at once.
+func (r NotificationRouting) IsSequential() bool {
+	return r.Mode == RoutingModeFirstSuccess || r.Mode == RoutingModeEscalate
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNotificationRouting(t *testing.T) {
+	routing, err := NewNotificationRouting("", 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultNotificationRouting, routing)
+	require.False(t, routing.IsSequential())
+
+	routing, err = NewNotificationRouting(RoutingModeFirstSuccess, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, NotificationRouting{Mode: RoutingModeFirstSuccess}, routing)
+	require.True(t, routing.IsSequential())
+
+	routing, err = NewNotificationRouting(RoutingModeEscalate, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultEscalateAfter, routing.EscalateAfter)
+
+	routing, err = NewNotificationRouting(RoutingModeEscalate, 30*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, routing.EscalateAfter)
+}
+
+func TestNewNotificationRoutingValidation(t *testing.T) {
+	cases := []struct {
+		name          string
+		mode          NotificationRoutingMode
+		escalateAfter time.Duration
+		expectedMsg   string
+	}{
+		{
+			name:        "unknown mode",
+			mode:        "round_robin",
+			expectedMsg: "notification routing mode is invalid",
+		},
+		{
+			name:          "negative escalation delay",
+			mode:          RoutingModeEscalate,
+			escalateAfter: -time.Second,
+			expectedMsg:   "escalation delay must be between 1 second and 24 hours",
+		},
+		{
+			name:          "escalation delay too long",
+			mode:          RoutingModeEscalate,
+			escalateAfter: 25 * time.Hour,
+			expectedMsg:   "escalation delay must be between 1 second and 24 hours",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewNotificationRouting(tc.mode, tc.escalateAfter)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedMsg)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"github.com/ryuyb/fusion/internal/pkg/tmpl"
+)
+
+const maxNotificationTemplateLength = 2000
+
+// NotificationTemplate holds text/template sources for the title and body of a live notification.
+// Empty fields inherit from the next level: follow, then channel, then DefaultNotificationTemplate.
+type NotificationTemplate struct {
+	Title string
+	Body  string
+}
+
+// DefaultNotificationTemplate is used when neither the channel nor the follow customise the message.
+var DefaultNotificationTemplate = DefaultNotificationTemplateFor(i18n.Default)
+
+// DefaultNotificationTemplateFor is DefaultNotificationTemplate translated into locale.
+func DefaultNotificationTemplateFor(locale i18n.Locale) NotificationTemplate {
+	return NotificationTemplate{
+		Title: i18n.T(locale, "notification.live.title"),
+		Body:  i18n.T(locale, "notification.live.body"),
+	}
+}
+
+// NotificationTemplateVariables is the data a NotificationTemplate is executed against.
+type NotificationTemplateVariables struct {
+	Streamer  string
+	Alias     string
+	Platform  string
+	Title     string
+	Category  string
+	Viewers   int
+	StartTime time.Time
+	RoomURL   string
+	Cover     string
+}
+
+// NotificationTemplateVariable documents a field of NotificationTemplateVariables for API consumers.
+type NotificationTemplateVariable struct {
+	Name        string
+	Description string
+	Example     string
+}
+
+// NotificationTemplateVariableDocs lists every variable available as {{.Name}} in a template.
+var NotificationTemplateVariableDocs = []NotificationTemplateVariable{
+	{Name: "Streamer", Description: "Streamer display name on the platform", Example: "{{.Streamer}}"},
+	{Name: "Alias", Description: "Alias the user gave the streamer, empty when unset", Example: "{{if .Alias}}{{.Alias}}{{else}}{{.Streamer}}{{end}}"},
+	{Name: "Platform", Description: "Streaming platform type, e.g. douyu or bilibili", Example: "{{.Platform}}"},
+	{Name: "Title", Description: "Current live room title", Example: "{{.Title}}"},
+	{Name: "Category", Description: "Current game or category", Example: "{{.Category}}"},
+	{Name: "Viewers", Description: "Current viewer count", Example: "{{.Viewers}}"},
+	{Name: "StartTime", Description: "Time the broadcast started, a Go time.Time", Example: `{{.StartTime.Format "2006-01-02 15:04"}}`},
+	{Name: "RoomURL", Description: "Live room URL", Example: "{{.RoomURL}}"},
+	{Name: "Cover", Description: "Live room cover image URL", Example: "{{.Cover}}"},
+}
+
+// RenderedNotification is the result of executing a NotificationTemplate.
+type RenderedNotification struct {
+	Title string
+	Body  string
+}
+
+// NewNotificationTemplateVariables extracts template variables from a streamer and the follow that triggered it.
+func NewNotificationTemplateVariables(follow *UserFollowedStreamer, streamer *Streamer) *NotificationTemplateVariables {
+	vars := &NotificationTemplateVariables{
+		Streamer:  streamer.DisplayName,
+		Platform:  string(streamer.PlatformType),
+		Title:     streamer.LiveStatus.Title,
+		Category:  streamer.LiveStatus.GameName,
+		Viewers:   streamer.LiveStatus.Viewers,
+		StartTime: streamer.LiveStatus.StartTime,
+		RoomURL:   streamer.RoomURL,
+		Cover:     streamer.LiveStatus.CoverImage,
+	}
+	if follow != nil {
+		vars.Alias = strings.TrimSpace(follow.Alias)
+	}
+	return vars
+}
+
+// SampleNotificationTemplateVariables returns placeholder data used for validation and previews.
+func SampleNotificationTemplateVariables() *NotificationTemplateVariables {
+	return &NotificationTemplateVariables{
+		Streamer:  "Sample Streamer",
+		Alias:     "",
+		Platform:  string(StreamingPlatformTypeDouyu),
+		Title:     "Sample live title",
+		Category:  "Sample category",
+		Viewers:   12345,
+		StartTime: time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC),
+		RoomURL:   "https://www.douyu.com/1",
+		Cover:     "https://example.com/cover.jpg",
+	}
+}
+
+// IsZero reports whether the template customises nothing.
+func (t NotificationTemplate) IsZero() bool {
+	return strings.TrimSpace(t.Title) == "" && strings.TrimSpace(t.Body) == ""
+}
+
+// Normalize trims surrounding whitespace so blank templates are stored as unset.
+func (t NotificationTemplate) Normalize() NotificationTemplate {
+	return NotificationTemplate{
+		Title: strings.TrimSpace(t.Title),
+		Body:  strings.TrimSpace(t.Body),
+	}
+}
+
+// Merge returns t with every non-empty field of override applied on top.
+func (t NotificationTemplate) Merge(override NotificationTemplate) NotificationTemplate {
+	if strings.TrimSpace(override.Title) != "" {
+		t.Title = override.Title
+	}
+	if strings.TrimSpace(override.Body) != "" {
+		t.Body = override.Body
+	}
+	return t
+}
+
+// Validate parses both templates and executes them against sample data so that
+// references to unknown variables are rejected when the template is saved.
+func (t NotificationTemplate) Validate() error {
+	sample := SampleNotificationTemplateVariables()
+	for field, source := range map[string]string{"title": t.Title, "body": t.Body} {
+		if strings.TrimSpace(source) == "" {
+			continue
+		}
+		if utf8.RuneCountInString(source) > maxNotificationTemplateLength {
+			return errors.BadRequest("notification template is too long").
+				WithDetails(map[string]any{"field": field, "max_length": maxNotificationTemplateLength})
+		}
+		if _, err := executeNotificationTemplate(source, sample); err != nil {
+			return errors.BadRequest("notification template is invalid").
+				WithDetails(map[string]any{"field": field, "reason": err.Error()})
+		}
+	}
+	return nil
+}
+
+// Render executes the template, falling back to the default template of locale for empty fields or
+// empty output.
+func (t NotificationTemplate) Render(locale i18n.Locale, vars *NotificationTemplateVariables) (*RenderedNotification, error) {
+	fallback := DefaultNotificationTemplateFor(locale)
+	tpl := fallback.Merge(t)
+
+	title, err := renderNotificationTemplatePart(tpl.Title, fallback.Title, vars)
+	if err != nil {
+		return nil, errors.BadRequest("failed to render notification title").Wrap(err)
+	}
+	body, err := renderNotificationTemplatePart(tpl.Body, fallback.Body, vars)
+	if err != nil {
+		return nil, errors.BadRequest("failed to render notification body").Wrap(err)
+	}
+	return &RenderedNotification{Title: title, Body: body}, nil
+}
+
+func renderNotificationTemplatePart(source, fallback string, vars *NotificationTemplateVariables) (string, error) {
+	rendered, err := executeNotificationTemplate(source, vars)
+	if err != nil {
+		return "", err
+	}
+	if rendered == "" && source != fallback {
+		return executeNotificationTemplate(fallback, vars)
+	}
+	return rendered, nil
+}
+
+// notificationTemplateEngine rejects references to unknown variables, so mistakes surface when saving.
+var notificationTemplateEngine = tmpl.Engine{MissingKey: "error"}
+
+func executeNotificationTemplate(source string, vars *NotificationTemplateVariables) (string, error) {
+	tpl, err := notificationTemplateEngine.Parse("notification", source)
+	if err != nil {
+		return "", err
+	}
+	return tpl.Execute(context.Background(), vars)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationTemplateValidate(t *testing.T) {
+	require.NoError(t, NotificationTemplate{}.Validate())
+	require.NoError(t, NotificationTemplate{
+		Title: `{{.Streamer}} ({{.Viewers}})`,
+		Body:  `{{.StartTime.Format "15:04"}} {{.Category}} {{.Cover}}`,
+	}.Validate())
+
+	err := NotificationTemplate{Title: `{{.Streamer`}.Validate()
+	require.Error(t, err)
+	require.Equal(t, "title", errors.GetAppError(err).Details["field"])
+
+	err = NotificationTemplate{Body: `{{.Unknown}}`}.Validate()
+	require.Error(t, err)
+	require.Equal(t, "body", errors.GetAppError(err).Details["field"])
+}
+
+func TestNotificationTemplateValidateBoundsExecution(t *testing.T) {
+	for source, reason := range map[string]string{
+		`{{range 100000000000}}{{end}}`:                         "template runs too many iterations",
+		`{{range 1000}}0123456789{{end}}`:                       "template output is too large",
+		`{{define "a"}}{{template "a"}}{{end}}{{template "a"}}`: "template runs too many iterations",
+	} {
+		err := NotificationTemplate{Body: source}.Validate()
+		require.Error(t, err, source)
+		require.Equal(t, reason, errors.GetAppError(err).Details["reason"], source)
+	}
+}
+
+func TestNotificationTemplateRenderDefault(t *testing.T) {
+	vars := &NotificationTemplateVariables{Streamer: "Streamer"}
+
+	rendered, err := NotificationTemplate{}.Render(i18n.English, vars)
+	require.NoError(t, err)
+	require.Equal(t, "Streamer is live now!", rendered.Title)
+	require.Equal(t, "Tune in now.", rendered.Body)
+
+	rendered, err = NotificationTemplate{}.Render(i18n.SimplifiedChinese, vars)
+	require.NoError(t, err)
+	require.Equal(t, "Streamer 开播了！", rendered.Title)
+	require.Equal(t, "快来看看吧。", rendered.Body)
+}
+
+func TestNotificationTemplateRenderFallsBackOnEmptyOutput(t *testing.T) {
+	vars := &NotificationTemplateVariables{Streamer: "Streamer", Title: "Ranked"}
+
+	rendered, err := NotificationTemplate{Title: "{{.Alias}}", Body: "{{.Category}}"}.Render(i18n.English, vars)
+	require.NoError(t, err)
+	require.Equal(t, "Streamer is live now!", rendered.Title)
+	require.Equal(t, "Ranked", rendered.Body)
+}
+
+func TestNotificationTemplateMerge(t *testing.T) {
+	base := NotificationTemplate{Title: "channel title", Body: "channel body"}
+	merged := base.Merge(NotificationTemplate{Title: "follow title", Body: "  "})
+	require.Equal(t, NotificationTemplate{Title: "follow title", Body: "channel body"}, merged)
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+// QuietHoursAction decides what happens to a go-live notification during quiet hours or do-not-disturb.
+type QuietHoursAction string
+
+const (
+	// QuietHoursDrop never sends the notification for that broadcast.
+	QuietHoursDrop QuietHoursAction = "drop"
+	// QuietHoursDelay holds the notification back until the quiet period ends and sends it then,
+	// provided the stream is still live.
+	QuietHoursDelay QuietHoursAction = "delay"
+	// QuietHoursPassive sends right away with NotificationSeverityLow, which Bark delivers as a passive
+	// notification that does not light up the screen or play a sound.
+	QuietHoursPassive QuietHoursAction = "passive"
+)
+
+const (
+	maxQuietHoursRules = 14
+	clockLayout        = "15:04"
+)
+
+func (a QuietHoursAction) IsValid() bool {
+	switch a {
+	case QuietHoursDrop, QuietHoursDelay, QuietHoursPassive:
+		return true
+	default:
+		return false
+	}
+}
+
+// QuietHours is a weekly schedule of periods in which go-live notifications are held back.
+type QuietHours struct {
+	// Timezone is an IANA name such as "Asia/Shanghai"; empty means UTC.
+	Timezone string           `json:"timezone,omitempty"`
+	Action   QuietHoursAction `json:"action"`
+	Rules    []QuietHoursRule `json:"rules"`
+}
+
+// QuietHoursRule is a daily period in local time. An End at or before Start runs past midnight, e.g.
+// 23:00-07:00. Weekdays lists the days the period starts on; empty means every day.
+type QuietHoursRule struct {
+	Weekdays []time.Weekday `json:"weekdays,omitempty"`
+	Start    string         `json:"start"`
+	End      string         `json:"end"`
+}
+
+// Normalize trims the schedule and defaults the action to delay.
+func (q QuietHours) Normalize() QuietHours {
+	q.Timezone = strings.TrimSpace(q.Timezone)
+	if q.Action == "" {
+		q.Action = QuietHoursDelay
+	}
+	rules := make([]QuietHoursRule, len(q.Rules))
+	for i, rule := range q.Rules {
+		rule.Start = strings.TrimSpace(rule.Start)
+		rule.End = strings.TrimSpace(rule.End)
+		rule.Weekdays = slices.Compact(slices.Sorted(slices.Values(rule.Weekdays)))
+		rules[i] = rule
+	}
+	q.Rules = rules
+	return q
+}
+
+func (q QuietHours) Validate() error {
+	if _, err := q.location(); err != nil {
+		return errors.BadRequest("quiet hours timezone is invalid").WithDetail("timezone", q.Timezone)
+	}
+	if !q.Action.IsValid() {
+		return errors.BadRequest("quiet hours action is invalid").WithDetail("action", q.Action)
+	}
+	if len(q.Rules) == 0 {
+		return errors.BadRequest("quiet hours need at least one rule")
+	}
+	if len(q.Rules) > maxQuietHoursRules {
+		return errors.BadRequestf("quiet hours allow at most %d rules", maxQuietHoursRules)
+	}
+	for i, rule := range q.Rules {
+		if err := rule.validate(); err != nil {
+			return err.WithDetail("rule", i)
+		}
+	}
+	return nil
+}
+
+// ActiveUntil reports whether now falls within quiet hours and, if so, when that period ends.
+// Overlapping periods are merged by taking the latest end.
+func (q QuietHours) ActiveUntil(now time.Time) (time.Time, bool) {
+	loc, err := q.location()
+	if err != nil {
+		return time.Time{}, false
+	}
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	var until time.Time
+	for _, rule := range q.Rules {
+		start, length, err := rule.span()
+		if err != nil {
+			continue
+		}
+		// A period that started yesterday may still be running after midnight.
+		for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+			if len(rule.Weekdays) > 0 && !slices.Contains(rule.Weekdays, day.Weekday()) {
+				continue
+			}
+			from := time.Date(day.Year(), day.Month(), day.Day(), int(start/time.Hour), int(start%time.Hour/time.Minute), 0, 0, loc)
+			to := from.Add(length)
+			if !local.Before(from) && local.Before(to) && to.After(until) {
+				until = to
+			}
+		}
+	}
+	return until, !until.IsZero()
+}
+
+func (q QuietHours) location() (*time.Location, error) {
+	return loadTimezone(q.Timezone)
+}
+
+// loadTimezone resolves an IANA timezone name; empty means UTC.
+func loadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+func (r QuietHoursRule) validate() *errors.AppError {
+	for _, day := range r.Weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return errors.BadRequest("quiet hours weekday must be between 0 (Sunday) and 6 (Saturday)").WithDetail("weekday", day)
+		}
+	}
+	if _, _, err := r.span(); err != nil {
+		return errors.BadRequest("quiet hours start and end must be HH:MM times").
+			WithDetail("start", r.Start).
+			WithDetail("end", r.End)
+	}
+	return nil
+}
+
+// span returns the offset of Start from midnight and the length of the period.
+func (r QuietHoursRule) span() (time.Duration, time.Duration, error) {
+	start, err := time.Parse(clockLayout, r.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := time.Parse(clockLayout, r.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	length := end.Sub(start)
+	if length <= 0 {
+		length += 24 * time.Hour
+	}
+	return start.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)), length, nil
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHoursActiveUntil(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	quiet := QuietHours{
+		Timezone: "Asia/Shanghai",
+		Action:   QuietHoursDelay,
+		Rules: []QuietHoursRule{
+			// Sunday to Thursday nights, before a working day.
+			{Weekdays: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}, Start: "23:00", End: "07:00"},
+			{Start: "13:00", End: "14:00"},
+		},
+	}
+	require.NoError(t, quiet.Validate())
+
+	cases := []struct {
+		name   string
+		now    time.Time
+		until  time.Time
+		active bool
+	}{
+		{
+			name:   "monday night",
+			now:    time.Date(2025, 6, 2, 23, 30, 0, 0, shanghai),
+			until:  time.Date(2025, 6, 3, 7, 0, 0, 0, shanghai),
+			active: true,
+		},
+		{
+			name:   "after midnight of a period that started yesterday",
+			now:    time.Date(2025, 6, 3, 6, 59, 0, 0, shanghai),
+			until:  time.Date(2025, 6, 3, 7, 0, 0, 0, shanghai),
+			active: true,
+		},
+		{
+			name: "end is exclusive",
+			now:  time.Date(2025, 6, 3, 7, 0, 0, 0, shanghai),
+		},
+		{
+			name: "friday night is not quiet",
+			now:  time.Date(2025, 6, 6, 23, 30, 0, 0, shanghai),
+		},
+		{
+			name: "saturday early morning follows friday's rule",
+			now:  time.Date(2025, 6, 7, 2, 0, 0, 0, shanghai),
+		},
+		{
+			name:   "daily rule evaluated in the configured timezone",
+			now:    time.Date(2025, 6, 7, 5, 30, 0, 0, time.UTC),
+			until:  time.Date(2025, 6, 7, 14, 0, 0, 0, shanghai),
+			active: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			until, active := quiet.ActiveUntil(tc.now)
+			require.Equal(t, tc.active, active)
+			if tc.active {
+				require.True(t, tc.until.Equal(until), "got %s", until)
+			}
+		})
+	}
+}
+
+func TestQuietHoursValidation(t *testing.T) {
+	valid := []QuietHoursRule{{Start: "22:00", End: "06:00"}}
+	cases := []struct {
+		name        string
+		quiet       QuietHours
+		expectedMsg string
+	}{
+		{
+			name:        "unknown timezone",
+			quiet:       QuietHours{Timezone: "Mars/Olympus", Rules: valid},
+			expectedMsg: "quiet hours timezone is invalid",
+		},
+		{
+			name:        "unknown action",
+			quiet:       QuietHours{Action: "mute", Rules: valid},
+			expectedMsg: "quiet hours action is invalid",
+		},
+		{
+			name:        "no rules",
+			quiet:       QuietHours{},
+			expectedMsg: "quiet hours need at least one rule",
+		},
+		{
+			name:        "bad clock",
+			quiet:       QuietHours{Rules: []QuietHoursRule{{Start: "25:00", End: "06:00"}}},
+			expectedMsg: "quiet hours start and end must be HH:MM times",
+		},
+		{
+			name:        "bad weekday",
+			quiet:       QuietHours{Rules: []QuietHoursRule{{Weekdays: []time.Weekday{7}, Start: "22:00", End: "06:00"}}},
+			expectedMsg: "quiet hours weekday must be between 0 (Sunday) and 6 (Saturday)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quiet.Normalize().Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedMsg)
+		})
+	}
+}
+
+func TestNotificationPreferenceQuietAction(t *testing.T) {
+	now := time.Date(2025, 6, 2, 23, 30, 0, 0, time.UTC)
+	preference, err := NewNotificationPreference(1)
+	require.NoError(t, err)
+
+	_, quiet := preference.QuietAction(now, false)
+	require.False(t, quiet)
+
+	require.NoError(t, preference.UpdateQuietHours(&QuietHours{
+		Action: QuietHoursPassive,
+		Rules:  []QuietHoursRule{{Start: "23:00", End: "07:00"}},
+	}))
+	action, quiet := preference.QuietAction(now, false)
+	require.True(t, quiet)
+	require.Equal(t, QuietHoursPassive, action)
+
+	_, quiet = preference.QuietAction(now, true)
+	require.False(t, quiet, "always-notify follows bypass quiet hours")
+
+	noon := time.Date(2025, 6, 3, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, preference.SetDoNotDisturb(noon, time.Hour))
+	action, quiet = preference.QuietAction(noon.Add(time.Minute), true)
+	require.True(t, quiet, "do-not-disturb applies to always-notify follows too")
+	require.Equal(t, QuietHoursPassive, action)
+
+	_, quiet = preference.QuietAction(noon.Add(time.Hour), false)
+	require.False(t, quiet)
+
+	require.Error(t, preference.SetDoNotDisturb(noon, 8*24*time.Hour))
+	require.NoError(t, preference.SetDoNotDisturb(noon, 0))
+	require.Nil(t, preference.DoNotDisturbUntil)
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+)
+
+// StreamSession is one broadcast of a streamer, from going live until it was seen offline.
+type StreamSession struct {
+	ID         int64
+	StreamerID int64
+	// Title and GameName are the latest values seen during the session.
+	Title    string
+	GameName string
+	// Titles and Categories list every distinct title and category used, in order of first use.
+	Titles      []string
+	Categories  []string
+	PeakViewers int
+	// AnnouncedTitle and AnnouncedGameName are the values change events were last emitted for. The
+	// values the session started with count as announced by the go-live notification.
+	AnnouncedTitle    string
+	AnnouncedGameName string
+	// ChangedAt is when the title or category last changed; nil until one does.
+	ChangedAt *time.Time
+	StartedAt time.Time
+	// LastSeenAt is the last time the streamer was seen live.
+	LastSeenAt time.Time
+	// EndedAt is nil while the session is still live.
+	EndedAt   *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewStreamSession opens a session for a live streamer. It starts at the platform's start time when
+// known, otherwise at now.
+func NewStreamSession(streamer *Streamer, now time.Time) *StreamSession {
+	startedAt := streamer.LiveStatus.StartTime
+	if startedAt.IsZero() || startedAt.After(now) {
+		startedAt = now
+	}
+	session := &StreamSession{
+		StreamerID: streamer.ID,
+		StartedAt:  startedAt,
+	}
+	session.Observe(streamer.LiveStatus, now)
+	session.AnnouncedTitle = session.Title
+	session.AnnouncedGameName = session.GameName
+	session.ChangedAt = nil
+	return session
+}
+
+// IsSameBroadcast reports whether status still belongs to this session. A later platform start time
+// means the streamer went offline and live again between two checks; going live again less than
+// reconnectGrace after the session was last seen counts as a reconnect of the same broadcast. Platforms
+// also shift the start time on reconnects, e.g. Douyu's ShowTime.
+func (s *StreamSession) IsSameBroadcast(status LiveStatusInfo, reconnectGrace time.Duration) bool {
+	if status.StartTime.IsZero() || !status.StartTime.After(s.StartedAt) {
+		return true
+	}
+	return status.StartTime.Sub(s.LastSeenAt) < reconnectGrace
+}
+
+// OfflineWithin reports whether a streamer seen offline at now was last live less than reconnectGrace
+// ago, so the session is kept open in case they reconnect.
+func (s *StreamSession) OfflineWithin(now time.Time, reconnectGrace time.Duration) bool {
+	return now.Sub(s.LastSeenAt) < reconnectGrace
+}
+
+// Observe records that the session was live at now. An empty title or category means the platform did
+// not report one and keeps the previous value.
+func (s *StreamSession) Observe(status LiveStatusInfo, now time.Time) {
+	if (status.Title != "" && status.Title != s.Title) || (status.GameName != "" && status.GameName != s.GameName) {
+		s.ChangedAt = &now
+	}
+	s.Title = cmp.Or(status.Title, s.Title)
+	s.GameName = cmp.Or(status.GameName, s.GameName)
+	s.Titles = appendDistinct(s.Titles, status.Title)
+	s.Categories = appendDistinct(s.Categories, status.GameName)
+	s.PeakViewers = max(s.PeakViewers, status.Viewers)
+	s.LastSeenAt = now
+}
+
+func appendDistinct(values []string, value string) []string {
+	if value == "" || slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// StreamChangeDebounce is how long a new title or category has to stay before it is announced, so a
+// streamer editing the title several times in a row causes a single notification.
+const StreamChangeDebounce = 2 * time.Minute
+
+// StreamChange is a settled change of the title or category during a session.
+type StreamChange struct {
+	// EventType is NotificationEventTitleChange or NotificationEventCategoryChange.
+	EventType NotificationEventType
+	From      string
+	To        string
+}
+
+// SettledChanges returns the title and category changes that have held for StreamChangeDebounce and
+// were not announced yet, and marks them announced. A value changed and changed back in between
+// yields nothing.
+func (s *StreamSession) SettledChanges(now time.Time) []StreamChange {
+	if s.ChangedAt == nil || now.Sub(*s.ChangedAt) < StreamChangeDebounce {
+		return nil
+	}
+	var changes []StreamChange
+	if s.GameName != s.AnnouncedGameName {
+		changes = append(changes, StreamChange{EventType: NotificationEventCategoryChange, From: s.AnnouncedGameName, To: s.GameName})
+	}
+	if s.Title != s.AnnouncedTitle {
+		changes = append(changes, StreamChange{EventType: NotificationEventTitleChange, From: s.AnnouncedTitle, To: s.Title})
+	}
+	s.AnnouncedTitle = s.Title
+	s.AnnouncedGameName = s.GameName
+	s.ChangedAt = nil
+	return changes
+}
+
+// RenderStreamChange describes changes picked for one follow. A category change leads the message
+// since it is usually the reason to tune in; the current title is always included.
+func RenderStreamChange(locale i18n.Locale, streamer, title string, changes []StreamChange) *RenderedNotification {
+	rendered := &RenderedNotification{Title: i18n.T(locale, "notification.stream_change.title", streamer), Body: title}
+	for _, change := range changes {
+		if change.EventType == NotificationEventCategoryChange {
+			rendered.Title = i18n.T(locale, "notification.stream_change.category", streamer, change.To)
+			if change.From != "" {
+				rendered.Body = i18n.T(locale, "notification.stream_change.from", change.From, title)
+			}
+			break
+		}
+	}
+	return rendered
+}
+
+// End closes the session at at.
+func (s *StreamSession) End(at time.Time) {
+	s.EndedAt = &at
+}
+
+// Duration is how long the session ran, up to now while it is still live.
+func (s *StreamSession) Duration(now time.Time) time.Duration {
+	end := now
+	if s.EndedAt != nil {
+		end = *s.EndedAt
+	}
+	return max(end.Sub(s.StartedAt), 0)
+}
+
+// DurationWithin is the part of the session that falls between from and to.
+func (s *StreamSession) DurationWithin(from, to time.Time) time.Duration {
+	start := s.StartedAt
+	if start.Before(from) {
+		start = from
+	}
+	end := to
+	if s.EndedAt != nil && s.EndedAt.Before(to) {
+		end = *s.EndedAt
+	}
+	return max(end.Sub(start), 0)
+}
+
+// RenderStreamEnded summarizes an ended session: how long it ran, its peak viewers and the titles and
+// categories used.
+func RenderStreamEnded(locale i18n.Locale, streamer string, session *StreamSession) *RenderedNotification {
+	var body strings.Builder
+	body.WriteString(i18n.T(locale, "notification.stream_ended.duration", FormatStreamDuration(locale, session.Duration(session.LastSeenAt))))
+	if session.PeakViewers > 0 {
+		body.WriteString(i18n.T(locale, "notification.stream_ended.peak_viewers", session.PeakViewers))
+	}
+	if len(session.Titles) > 0 {
+		body.WriteString(i18n.T(locale, "notification.stream_ended.titles", strings.Join(session.Titles, " · ")))
+	}
+	if len(session.Categories) > 0 {
+		body.WriteString(i18n.T(locale, "notification.stream_ended.categories", strings.Join(session.Categories, " · ")))
+	}
+	return &RenderedNotification{
+		Title: i18n.T(locale, "notification.stream_ended.title", streamer),
+		Body:  body.String(),
+	}
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamSessionDurationWithin(t *testing.T) {
+	start := time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC)
+	session := &StreamSession{StartedAt: start}
+	from := start.Add(time.Hour)
+
+	// Still live: counted from the start of the period up to its end.
+	require.Equal(t, 3*time.Hour, session.DurationWithin(from, from.Add(3*time.Hour)))
+
+	session.End(start.Add(90 * time.Minute))
+	require.Equal(t, 30*time.Minute, session.DurationWithin(from, from.Add(3*time.Hour)))
+	require.Equal(t, 90*time.Minute, session.Duration(time.Now()))
+}
+
+func TestStreamSessionObserve(t *testing.T) {
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	streamer := &Streamer{ID: 1, LiveStatus: LiveStatusInfo{IsLive: true, Title: "Opening", GameName: "Chess", Viewers: 100, StartTime: now.Add(-time.Minute)}}
+	session := NewStreamSession(streamer, now)
+	require.Equal(t, now.Add(-time.Minute), session.StartedAt)
+
+	session.Observe(LiveStatusInfo{IsLive: true, Title: "Endgame", GameName: "Chess", Viewers: 300}, now.Add(time.Hour))
+	session.Observe(LiveStatusInfo{IsLive: true, Title: "Opening", GameName: "Go", Viewers: 200}, now.Add(2*time.Hour))
+	require.Equal(t, []string{"Opening", "Endgame"}, session.Titles)
+	require.Equal(t, []string{"Chess", "Go"}, session.Categories)
+	require.Equal(t, 300, session.PeakViewers)
+	require.Equal(t, "Opening", session.Title)
+
+	require.True(t, session.IsSameBroadcast(LiveStatusInfo{IsLive: true}, 0))
+	require.False(t, session.IsSameBroadcast(LiveStatusInfo{IsLive: true, StartTime: now.Add(3 * time.Hour)}, 0))
+	// A reconnect shortly after the session was last seen live continues it.
+	require.True(t, session.IsSameBroadcast(LiveStatusInfo{IsLive: true, StartTime: now.Add(2*time.Hour + 5*time.Minute)}, 10*time.Minute))
+	require.False(t, session.IsSameBroadcast(LiveStatusInfo{IsLive: true, StartTime: now.Add(2*time.Hour + 10*time.Minute)}, 10*time.Minute))
+	require.True(t, session.OfflineWithin(now.Add(2*time.Hour+9*time.Minute), 10*time.Minute))
+	require.False(t, session.OfflineWithin(now.Add(2*time.Hour+10*time.Minute), 10*time.Minute))
+
+	session.End(session.LastSeenAt)
+	rendered := RenderStreamEnded(i18n.English, "Grandmaster", session)
+	require.Equal(t, "Grandmaster finished streaming", rendered.Title)
+	require.Equal(t, "Streamed for 2h 01m, peak 300 viewers\nTitles: Opening · Endgame\nCategories: Chess · Go", rendered.Body)
+}
+
+func TestStreamSessionSettledChanges(t *testing.T) {
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	streamer := &Streamer{ID: 1, LiveStatus: LiveStatusInfo{IsLive: true, Title: "Hello", GameName: "Just Chatting"}}
+	session := NewStreamSession(streamer, now)
+	require.Nil(t, session.ChangedAt)
+
+	// Rapid edits only restart the debounce.
+	session.Observe(LiveStatusInfo{IsLive: true, Title: "Soon", GameName: "Just Chatting"}, now.Add(time.Minute))
+	session.Observe(LiveStatusInfo{IsLive: true, Title: "Ranked", GameName: "Chess"}, now.Add(2*time.Minute))
+	require.Empty(t, session.SettledChanges(now.Add(3*time.Minute)))
+
+	changes := session.SettledChanges(now.Add(2*time.Minute + StreamChangeDebounce))
+	require.Equal(t, []StreamChange{
+		{EventType: NotificationEventCategoryChange, From: "Just Chatting", To: "Chess"},
+		{EventType: NotificationEventTitleChange, From: "Hello", To: "Ranked"},
+	}, changes)
+	require.Empty(t, session.SettledChanges(now.Add(time.Hour)))
+
+	// Changing back before the change settled announces nothing.
+	session.Observe(LiveStatusInfo{IsLive: true, Title: "Typo", GameName: "Chess"}, now.Add(time.Hour))
+	session.Observe(LiveStatusInfo{IsLive: true, Title: "Ranked", GameName: "Chess"}, now.Add(time.Hour+time.Minute))
+	require.Empty(t, session.SettledChanges(now.Add(2*time.Hour)))
+
+	follow := &UserFollowedStreamer{}
+	require.NoError(t, follow.UpdateNotifyCategories([]string{" Chess ", "chess", ""}))
+	require.Equal(t, []string{"Chess"}, follow.NotifyCategories)
+	require.Equal(t, changes[:1], follow.StreamChanges(changes))
+
+	rendered := RenderStreamChange(i18n.English, "Magnus", "Ranked", follow.StreamChanges(changes))
+	require.Equal(t, "Magnus switched to Chess", rendered.Title)
+	require.Equal(t, "From Just Chatting\nRanked", rendered.Body)
+}
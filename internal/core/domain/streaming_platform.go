@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"fmt"
 	"net/url"
 	"strings"
 	"time"
@@ -57,7 +56,7 @@ func NewStreamingPlatform(platformType StreamingPlatformType, name, baseURL stri
 		return nil, errors.BadRequest("platform base url is required")
 	}
 	if _, err := url.ParseRequestURI(baseURL); err != nil {
-		return nil, errors.BadRequest(fmt.Sprintf("invalid platform base url: %v", err))
+		return nil, errors.BadRequestf("invalid platform base url: %v", err)
 	}
 
 	return &StreamingPlatform{
@@ -75,7 +74,7 @@ func (p *StreamingPlatform) UpdateMetadata(name, description, baseURL, logoURL s
 	}
 	if baseURL != "" {
 		if _, err := url.ParseRequestURI(baseURL); err != nil {
-			return errors.BadRequest(fmt.Sprintf("invalid platform base url: %v", err))
+			return errors.BadRequestf("invalid platform base url: %v", err)
 		}
 	}
 
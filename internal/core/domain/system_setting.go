@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// SystemSetting is an application-wide value persisted by the server itself.
+type SystemSetting struct {
+	ID        int64
+	Key       string
+	Value     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
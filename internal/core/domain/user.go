@@ -4,14 +4,18 @@ import (
 	"time"
 
 	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"github.com/ryuyb/fusion/internal/pkg/util"
 )
 
 type User struct {
-	ID        int64
-	Username  string
-	Email     string
-	Password  string
+	ID       int64
+	Username string
+	Email    string
+	Password string
+	// Locale is the language notifications and API errors are written in; empty negotiates it from the
+	// request's Accept-Language header and sends notifications in i18n.Default.
+	Locale    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -38,3 +42,17 @@ func (u *User) Update(username, email, password string) (*User, error) {
 	u.Password = hashPassword
 	return u, nil
 }
+
+// UpdateLocale sets the preferred locale; empty clears it.
+func (u *User) UpdateLocale(tag string) error {
+	if tag == "" {
+		u.Locale = ""
+		return nil
+	}
+	locale, ok := i18n.Parse(tag)
+	if !ok {
+		return errors.BadRequest("locale is not supported").WithDetail("locale", tag)
+	}
+	u.Locale = string(locale)
+	return nil
+}
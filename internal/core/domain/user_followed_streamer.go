@@ -1,24 +1,50 @@
 package domain
 
 import (
+	"maps"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ryuyb/fusion/internal/pkg/errors"
 )
 
 // UserFollowedStreamer links a user with a streamer they follow together with notification preferences.
 type UserFollowedStreamer struct {
-	ID                     int64
-	UserID                 int64
-	StreamerID             int64
-	Alias                  string
-	Notes                  string
-	NotificationsEnabled   bool
+	ID                   int64
+	UserID               int64
+	StreamerID           int64
+	Alias                string
+	Notes                string
+	NotificationsEnabled bool
+	// AlwaysNotify lets notifications for this follow through the user's quiet hours.
+	AlwaysNotify bool
+	// NotifyStreamEnd opts in to a summary notification when the streamer goes offline.
+	NotifyStreamEnd bool
+	// NotifyTitleChange notifies whenever the title changes during a stream.
+	NotifyTitleChange bool
+	// NotifyCategories notifies when the category changes to one of these during a stream; names
+	// match case-insensitively.
+	NotifyCategories       []string
 	NotificationChannelIDs []int64
+	Template               NotificationTemplate
+	// Filter limits which go-live events notify; nil lets every broadcast through.
+	Filter *NotificationFilter
+	// Routing overrides the user's NotificationPreference routing for this follow when set.
+	Routing *NotificationRouting
+	// ChannelOverrides lays config fields over a notification channel's config for this follow's
+	// notifications, keyed by channel ID, e.g. a critical Bark level for one streamer.
+	ChannelOverrides map[int64]map[string]any
+	// LastNotificationSentAt is when the current broadcast was last routed to the follow's channels.
+	// It only deduplicates broadcasts; which channels actually delivered is recorded per delivery.
 	LastNotificationSentAt *time.Time
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	// SnoozedUntil holds back every notification of the follow until then.
+	SnoozedUntil *time.Time
+	// MutedSessionID holds back the notifications about that one broadcast of the streamer.
+	MutedSessionID *int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // NewUserFollowedStreamer validates inputs and builds a follow relationship with default notification settings.
@@ -82,3 +108,130 @@ func normalizeNotificationChannelIDs(channelIDs []int64) ([]int64, error) {
 	}
 	return result, nil
 }
+
+// UpdateTemplate validates and stores the per-follow message template override.
+func (f *UserFollowedStreamer) UpdateTemplate(template NotificationTemplate) error {
+	template = template.Normalize()
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	f.Template = template
+	return nil
+}
+
+const (
+	maxNotifyCategories     = 20
+	maxNotifyCategoryLength = 100
+)
+
+// UpdateNotifyCategories validates and stores the categories that trigger a category change
+// notification; duplicates are dropped.
+func (f *UserFollowedStreamer) UpdateNotifyCategories(categories []string) error {
+	normalized := make([]string, 0, len(categories))
+	for _, category := range categories {
+		category = strings.TrimSpace(category)
+		if category == "" {
+			continue
+		}
+		if utf8.RuneCountInString(category) > maxNotifyCategoryLength {
+			return errors.BadRequestf("notify category must be at most %d characters", maxNotifyCategoryLength).
+				WithDetail("category", category)
+		}
+		if !slices.ContainsFunc(normalized, func(existing string) bool { return strings.EqualFold(existing, category) }) {
+			normalized = append(normalized, category)
+		}
+	}
+	if len(normalized) > maxNotifyCategories {
+		return errors.BadRequestf("at most %d notify categories are allowed", maxNotifyCategories)
+	}
+	if len(normalized) == 0 {
+		normalized = nil
+	}
+	f.NotifyCategories = normalized
+	return nil
+}
+
+// StreamChanges picks the changes the follow opted in to.
+func (f *UserFollowedStreamer) StreamChanges(changes []StreamChange) []StreamChange {
+	var picked []StreamChange
+	for _, change := range changes {
+		switch change.EventType {
+		case NotificationEventTitleChange:
+			if f.NotifyTitleChange {
+				picked = append(picked, change)
+			}
+		case NotificationEventCategoryChange:
+			if slices.ContainsFunc(f.NotifyCategories, func(category string) bool { return strings.EqualFold(category, change.To) }) {
+				picked = append(picked, change)
+			}
+		}
+	}
+	return picked
+}
+
+// UpdateFilter validates and stores the go-live filter; nil or a filter without rules removes it.
+func (f *UserFollowedStreamer) UpdateFilter(filter *NotificationFilter) error {
+	if filter == nil {
+		f.Filter = nil
+		return nil
+	}
+	normalized := filter.Normalize()
+	if err := normalized.Validate(); err != nil {
+		return err
+	}
+	if normalized.IsZero() {
+		f.Filter = nil
+		return nil
+	}
+	f.Filter = &normalized
+	return nil
+}
+
+// UpdateRouting sets the per-follow routing override; nil inherits the user's routing.
+func (f *UserFollowedStreamer) UpdateRouting(routing *NotificationRouting) {
+	if routing == nil {
+		f.Routing = nil
+		return
+	}
+	override := *routing
+	f.Routing = &override
+}
+
+// UpdateChannelOverrides stores the per-channel config overrides; channels without fields are dropped.
+// Whether the fields may be overridden depends on the channel type and is checked by the caller.
+func (f *UserFollowedStreamer) UpdateChannelOverrides(overrides map[int64]map[string]any) error {
+	var result map[int64]map[string]any
+	for channelID, fields := range overrides {
+		if channelID <= 0 {
+			return errors.BadRequest("notification channel id must be greater than zero")
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		if result == nil {
+			result = make(map[int64]map[string]any, len(overrides))
+		}
+		result[channelID] = maps.Clone(fields)
+	}
+	f.ChannelOverrides = result
+	return nil
+}
+
+// Snooze holds back the follow's notifications until the given time.
+func (f *UserFollowedStreamer) Snooze(until time.Time) {
+	f.SnoozedUntil = &until
+}
+
+// MuteSession holds back the follow's notifications about one broadcast; the next one notifies again.
+func (f *UserFollowedStreamer) MuteSession(sessionID int64) {
+	f.MutedSessionID = &sessionID
+}
+
+// IsSilenced reports whether notifications about the broadcast session are held back at now, either by
+// a snooze or because the session was muted. sessionID is zero when the session is not known.
+func (f *UserFollowedStreamer) IsSilenced(sessionID int64, now time.Time) bool {
+	if f.SnoozedUntil != nil && now.Before(*f.SnoozedUntil) {
+		return true
+	}
+	return sessionID != 0 && f.MutedSessionID != nil && *f.MutedSessionID == sessionID
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -71,3 +72,18 @@ func TestUserFollowedStreamerUpdatePreferences(t *testing.T) {
 
 	require.Error(t, follow.UpdatePreferences("", "", true, []int64{0}))
 }
+
+func TestUserFollowedStreamerIsSilenced(t *testing.T) {
+	now := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	follow := &UserFollowedStreamer{}
+	require.False(t, follow.IsSilenced(7, now))
+
+	follow.Snooze(now.Add(time.Hour))
+	require.True(t, follow.IsSilenced(7, now))
+	require.False(t, follow.IsSilenced(7, now.Add(time.Hour)))
+
+	follow.MuteSession(7)
+	require.True(t, follow.IsSilenced(7, now.Add(time.Hour)))
+	require.False(t, follow.IsSilenced(8, now.Add(time.Hour)))
+	require.False(t, follow.IsSilenced(0, now.Add(time.Hour)))
+}
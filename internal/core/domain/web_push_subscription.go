@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+const (
+	webPushP256dhLength = 65
+	webPushAuthLength   = 16
+)
+
+// WebPushSubscription is a browser PushSubscription that receives notifications for a user.
+type WebPushSubscription struct {
+	ID        int64
+	UserID    int64
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	UserAgent string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebPushSubscription validates the values produced by PushManager.subscribe() in the browser.
+func NewWebPushSubscription(userID int64, endpoint, p256dh, auth, userAgent string) (*WebPushSubscription, error) {
+	if userID <= 0 {
+		return nil, errors.BadRequest("user id must be greater than zero")
+	}
+	subscription := &WebPushSubscription{UserID: userID}
+	if err := subscription.UpdateKeys(endpoint, p256dh, auth, userAgent); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// UpdateKeys refreshes the endpoint and client keys after the browser rotated the subscription.
+func (s *WebPushSubscription) UpdateKeys(endpoint, p256dh, auth, userAgent string) error {
+	endpoint = strings.TrimSpace(endpoint)
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return errors.BadRequest("push subscription endpoint must be a valid https URL")
+	}
+	if key, err := DecodeWebPushKey(p256dh); err != nil || len(key) != webPushP256dhLength {
+		return errors.BadRequest("push subscription p256dh key is invalid")
+	}
+	if key, err := DecodeWebPushKey(auth); err != nil || len(key) != webPushAuthLength {
+		return errors.BadRequest("push subscription auth secret is invalid")
+	}
+
+	s.Endpoint = endpoint
+	s.P256dh = strings.TrimSpace(p256dh)
+	s.Auth = strings.TrimSpace(auth)
+	s.UserAgent = strings.TrimSpace(userAgent)
+	return nil
+}
+
+// DecodeWebPushKey decodes base64url keys; browsers emit them without padding but some libraries add it.
+func DecodeWebPushKey(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(strings.TrimSpace(value), "="))
+}
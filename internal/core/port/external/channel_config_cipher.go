@@ -0,0 +1,16 @@
+package external
+
+import "github.com/ryuyb/fusion/internal/core/domain"
+
+// ChannelConfigCipher protects the secret fields of a notification channel config at rest.
+type ChannelConfigCipher interface {
+	// Encrypt returns a copy of config with its secret fields sealed.
+	Encrypt(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error)
+
+	// Decrypt returns a copy of config with its secret fields opened. Fields that cannot
+	// be opened are left out and reported in the error.
+	Decrypt(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error)
+
+	// NeedsRotation reports whether a stored config holds plaintext secrets or secrets sealed with a retired key.
+	NeedsRotation(channelType domain.NotificationChannelType, config map[string]any) bool
+}
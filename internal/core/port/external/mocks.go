@@ -11,6 +11,226 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockChannelConfigCipher creates a new instance of MockChannelConfigCipher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChannelConfigCipher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChannelConfigCipher {
+	mock := &MockChannelConfigCipher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChannelConfigCipher is an autogenerated mock type for the ChannelConfigCipher type
+type MockChannelConfigCipher struct {
+	mock.Mock
+}
+
+type MockChannelConfigCipher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChannelConfigCipher) EXPECT() *MockChannelConfigCipher_Expecter {
+	return &MockChannelConfigCipher_Expecter{mock: &_m.Mock}
+}
+
+// Decrypt provides a mock function for the type MockChannelConfigCipher
+func (_mock *MockChannelConfigCipher) Decrypt(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error) {
+	ret := _mock.Called(channelType, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decrypt")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationChannelType, map[string]any) (map[string]any, error)); ok {
+		return returnFunc(channelType, config)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationChannelType, map[string]any) map[string]any); ok {
+		r0 = returnFunc(channelType, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.NotificationChannelType, map[string]any) error); ok {
+		r1 = returnFunc(channelType, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChannelConfigCipher_Decrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decrypt'
+type MockChannelConfigCipher_Decrypt_Call struct {
+	*mock.Call
+}
+
+// Decrypt is a helper method to define mock.On call
+//   - channelType domain.NotificationChannelType
+//   - config map[string]any
+func (_e *MockChannelConfigCipher_Expecter) Decrypt(channelType interface{}, config interface{}) *MockChannelConfigCipher_Decrypt_Call {
+	return &MockChannelConfigCipher_Decrypt_Call{Call: _e.mock.On("Decrypt", channelType, config)}
+}
+
+func (_c *MockChannelConfigCipher_Decrypt_Call) Run(run func(channelType domain.NotificationChannelType, config map[string]any)) *MockChannelConfigCipher_Decrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.NotificationChannelType
+		if args[0] != nil {
+			arg0 = args[0].(domain.NotificationChannelType)
+		}
+		var arg1 map[string]any
+		if args[1] != nil {
+			arg1 = args[1].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelConfigCipher_Decrypt_Call) Return(stringToAny map[string]any, err error) *MockChannelConfigCipher_Decrypt_Call {
+	_c.Call.Return(stringToAny, err)
+	return _c
+}
+
+func (_c *MockChannelConfigCipher_Decrypt_Call) RunAndReturn(run func(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error)) *MockChannelConfigCipher_Decrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Encrypt provides a mock function for the type MockChannelConfigCipher
+func (_mock *MockChannelConfigCipher) Encrypt(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error) {
+	ret := _mock.Called(channelType, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Encrypt")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationChannelType, map[string]any) (map[string]any, error)); ok {
+		return returnFunc(channelType, config)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationChannelType, map[string]any) map[string]any); ok {
+		r0 = returnFunc(channelType, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.NotificationChannelType, map[string]any) error); ok {
+		r1 = returnFunc(channelType, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChannelConfigCipher_Encrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Encrypt'
+type MockChannelConfigCipher_Encrypt_Call struct {
+	*mock.Call
+}
+
+// Encrypt is a helper method to define mock.On call
+//   - channelType domain.NotificationChannelType
+//   - config map[string]any
+func (_e *MockChannelConfigCipher_Expecter) Encrypt(channelType interface{}, config interface{}) *MockChannelConfigCipher_Encrypt_Call {
+	return &MockChannelConfigCipher_Encrypt_Call{Call: _e.mock.On("Encrypt", channelType, config)}
+}
+
+func (_c *MockChannelConfigCipher_Encrypt_Call) Run(run func(channelType domain.NotificationChannelType, config map[string]any)) *MockChannelConfigCipher_Encrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.NotificationChannelType
+		if args[0] != nil {
+			arg0 = args[0].(domain.NotificationChannelType)
+		}
+		var arg1 map[string]any
+		if args[1] != nil {
+			arg1 = args[1].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelConfigCipher_Encrypt_Call) Return(stringToAny map[string]any, err error) *MockChannelConfigCipher_Encrypt_Call {
+	_c.Call.Return(stringToAny, err)
+	return _c
+}
+
+func (_c *MockChannelConfigCipher_Encrypt_Call) RunAndReturn(run func(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error)) *MockChannelConfigCipher_Encrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NeedsRotation provides a mock function for the type MockChannelConfigCipher
+func (_mock *MockChannelConfigCipher) NeedsRotation(channelType domain.NotificationChannelType, config map[string]any) bool {
+	ret := _mock.Called(channelType, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NeedsRotation")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationChannelType, map[string]any) bool); ok {
+		r0 = returnFunc(channelType, config)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockChannelConfigCipher_NeedsRotation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsRotation'
+type MockChannelConfigCipher_NeedsRotation_Call struct {
+	*mock.Call
+}
+
+// NeedsRotation is a helper method to define mock.On call
+//   - channelType domain.NotificationChannelType
+//   - config map[string]any
+func (_e *MockChannelConfigCipher_Expecter) NeedsRotation(channelType interface{}, config interface{}) *MockChannelConfigCipher_NeedsRotation_Call {
+	return &MockChannelConfigCipher_NeedsRotation_Call{Call: _e.mock.On("NeedsRotation", channelType, config)}
+}
+
+func (_c *MockChannelConfigCipher_NeedsRotation_Call) Run(run func(channelType domain.NotificationChannelType, config map[string]any)) *MockChannelConfigCipher_NeedsRotation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.NotificationChannelType
+		if args[0] != nil {
+			arg0 = args[0].(domain.NotificationChannelType)
+		}
+		var arg1 map[string]any
+		if args[1] != nil {
+			arg1 = args[1].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelConfigCipher_NeedsRotation_Call) Return(b bool) *MockChannelConfigCipher_NeedsRotation_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockChannelConfigCipher_NeedsRotation_Call) RunAndReturn(run func(channelType domain.NotificationChannelType, config map[string]any) bool) *MockChannelConfigCipher_NeedsRotation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockNotificationProvider creates a new instance of MockNotificationProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockNotificationProvider(t interface {
@@ -38,6 +258,52 @@ func (_m *MockNotificationProvider) EXPECT() *MockNotificationProvider_Expecter
 	return &MockNotificationProvider_Expecter{mock: &_m.Mock}
 }
 
+// ConfigSchema provides a mock function for the type MockNotificationProvider
+func (_mock *MockNotificationProvider) ConfigSchema() *domain.ChannelConfigSchema {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigSchema")
+	}
+
+	var r0 *domain.ChannelConfigSchema
+	if returnFunc, ok := ret.Get(0).(func() *domain.ChannelConfigSchema); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ChannelConfigSchema)
+		}
+	}
+	return r0
+}
+
+// MockNotificationProvider_ConfigSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigSchema'
+type MockNotificationProvider_ConfigSchema_Call struct {
+	*mock.Call
+}
+
+// ConfigSchema is a helper method to define mock.On call
+func (_e *MockNotificationProvider_Expecter) ConfigSchema() *MockNotificationProvider_ConfigSchema_Call {
+	return &MockNotificationProvider_ConfigSchema_Call{Call: _e.mock.On("ConfigSchema")}
+}
+
+func (_c *MockNotificationProvider_ConfigSchema_Call) Run(run func()) *MockNotificationProvider_ConfigSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockNotificationProvider_ConfigSchema_Call) Return(channelConfigSchema *domain.ChannelConfigSchema) *MockNotificationProvider_ConfigSchema_Call {
+	_c.Call.Return(channelConfigSchema)
+	return _c
+}
+
+func (_c *MockNotificationProvider_ConfigSchema_Call) RunAndReturn(run func() *domain.ChannelConfigSchema) *MockNotificationProvider_ConfigSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChannelType provides a mock function for the type MockNotificationProvider
 func (_mock *MockNotificationProvider) GetChannelType() domain.NotificationChannelType {
 	ret := _mock.Called()
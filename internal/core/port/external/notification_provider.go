@@ -12,9 +12,44 @@ type NotificationProvider interface {
 	Send(ctx context.Context, channel *domain.NotificationChannel, data *NotificationData) error
 
 	TestConnection(ctx context.Context, config map[string]any) error
+
+	// ConfigSchema describes the NotificationChannel.Config the provider accepts.
+	ConfigSchema() *domain.ChannelConfigSchema
 }
 
 type NotificationData struct {
-	Title   string
-	Content string
+	Title    string `json:"title"`
+	Content  string `json:"content"`
+	URL      string `json:"url,omitempty"`       // click-through link, usually the live room
+	ImageURL string `json:"image_url,omitempty"` // large picture attached to the message, usually the live cover
+	IconURL  string `json:"icon_url,omitempty"`  // small image shown next to the message, usually the streamer avatar
+
+	EventType          domain.NotificationEventType `json:"event_type,omitempty"`
+	Severity           domain.NotificationSeverity  `json:"severity,omitempty"`
+	StreamerID         int64                        `json:"streamer_id,omitempty"`
+	StreamerName       string                       `json:"streamer_name,omitempty"` // follow alias or display name, used to list the streamer in digests
+	StreamTitle        string                       `json:"stream_title,omitempty"`
+	PlatformType       domain.StreamingPlatformType `json:"platform_type,omitempty"`
+	PlatformStreamerID string                       `json:"platform_streamer_id,omitempty"`
+
+	// Actions are signed links the user can follow from the notification, e.g. to snooze the streamer.
+	// They are made for each delivery as it is sent and never stored with the payload.
+	Actions []NotificationAction `json:"-"`
+}
+
+// NotificationAction is a link offered with a notification, shown as a button where the target supports it.
+type NotificationAction struct {
+	Type  domain.NotificationActionType
+	Label string
+	URL   string
+}
+
+// FindAction returns the action of the given type, nil when the notification does not offer it.
+func (d *NotificationData) FindAction(actionType domain.NotificationActionType) *NotificationAction {
+	for i := range d.Actions {
+		if d.Actions[i].Type == actionType {
+			return &d.Actions[i]
+		}
+	}
+	return nil
 }
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type InboxMessageRepository interface {
+	Create(ctx context.Context, message *domain.InboxMessage) (*domain.InboxMessage, error)
+
+	FindById(ctx context.Context, id int64) (*domain.InboxMessage, error)
+
+	// ListByUserId lists the user's messages, newest first; unreadOnly leaves out those already read.
+	ListByUserId(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*domain.InboxMessage, int, error)
+
+	CountUnread(ctx context.Context, userID int64) (int, error)
+
+	// MarkRead records when the message was read; a message read before keeps its first read time.
+	MarkRead(ctx context.Context, id int64, at time.Time) (*domain.InboxMessage, error)
+
+	// MarkAllRead marks every unread message of the user read and returns how many it marked.
+	MarkAllRead(ctx context.Context, userID int64, at time.Time) (int, error)
+
+	DeleteCreatedBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -6,18 +6,19 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockNotificationChannelRepository creates a new instance of MockNotificationChannelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockInboxMessageRepository creates a new instance of MockInboxMessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockNotificationChannelRepository(t interface {
+func NewMockInboxMessageRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockNotificationChannelRepository {
-	mock := &MockNotificationChannelRepository{}
+}) *MockInboxMessageRepository {
+	mock := &MockInboxMessageRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,68 +26,66 @@ func NewMockNotificationChannelRepository(t interface {
 	return mock
 }
 
-// MockNotificationChannelRepository is an autogenerated mock type for the NotificationChannelRepository type
-type MockNotificationChannelRepository struct {
+// MockInboxMessageRepository is an autogenerated mock type for the InboxMessageRepository type
+type MockInboxMessageRepository struct {
 	mock.Mock
 }
 
-type MockNotificationChannelRepository_Expecter struct {
+type MockInboxMessageRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockNotificationChannelRepository) EXPECT() *MockNotificationChannelRepository_Expecter {
-	return &MockNotificationChannelRepository_Expecter{mock: &_m.Mock}
+func (_m *MockInboxMessageRepository) EXPECT() *MockInboxMessageRepository_Expecter {
+	return &MockInboxMessageRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockNotificationChannelRepository
-func (_mock *MockNotificationChannelRepository) Create(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
-	ret := _mock.Called(ctx, channel)
+// CountUnread provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) CountUnread(ctx context.Context, userID int64) (int, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for CountUnread")
 	}
 
-	var r0 *domain.NotificationChannel
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) (*domain.NotificationChannel, error)); ok {
-		return returnFunc(ctx, channel)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) *domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, channel)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.NotificationChannel)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationChannel) error); ok {
-		r1 = returnFunc(ctx, channel)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockNotificationChannelRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockNotificationChannelRepository_Create_Call struct {
+// MockInboxMessageRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type MockInboxMessageRepository_CountUnread_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// CountUnread is a helper method to define mock.On call
 //   - ctx context.Context
-//   - channel *domain.NotificationChannel
-func (_e *MockNotificationChannelRepository_Expecter) Create(ctx interface{}, channel interface{}) *MockNotificationChannelRepository_Create_Call {
-	return &MockNotificationChannelRepository_Create_Call{Call: _e.mock.On("Create", ctx, channel)}
+//   - userID int64
+func (_e *MockInboxMessageRepository_Expecter) CountUnread(ctx interface{}, userID interface{}) *MockInboxMessageRepository_CountUnread_Call {
+	return &MockInboxMessageRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, userID)}
 }
 
-func (_c *MockNotificationChannelRepository_Create_Call) Run(run func(ctx context.Context, channel *domain.NotificationChannel)) *MockNotificationChannelRepository_Create_Call {
+func (_c *MockInboxMessageRepository_CountUnread_Call) Run(run func(ctx context.Context, userID int64)) *MockInboxMessageRepository_CountUnread_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.NotificationChannel
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.NotificationChannel)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -96,54 +95,65 @@ func (_c *MockNotificationChannelRepository_Create_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_Create_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_Create_Call {
-	_c.Call.Return(notificationChannel, err)
+func (_c *MockInboxMessageRepository_CountUnread_Call) Return(n int, err error) *MockInboxMessageRepository_CountUnread_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_Create_Call) RunAndReturn(run func(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_Create_Call {
+func (_c *MockInboxMessageRepository_CountUnread_Call) RunAndReturn(run func(ctx context.Context, userID int64) (int, error)) *MockInboxMessageRepository_CountUnread_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockNotificationChannelRepository
-func (_mock *MockNotificationChannelRepository) Delete(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// Create provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) Create(ctx context.Context, message *domain.InboxMessage) (*domain.InboxMessage, error) {
+	ret := _mock.Called(ctx, message)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Create")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 *domain.InboxMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.InboxMessage) (*domain.InboxMessage, error)); ok {
+		return returnFunc(ctx, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.InboxMessage) *domain.InboxMessage); ok {
+		r0 = returnFunc(ctx, message)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.InboxMessage)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.InboxMessage) error); ok {
+		r1 = returnFunc(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockNotificationChannelRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockNotificationChannelRepository_Delete_Call struct {
+// MockInboxMessageRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockInboxMessageRepository_Create_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockNotificationChannelRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockNotificationChannelRepository_Delete_Call {
-	return &MockNotificationChannelRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - message *domain.InboxMessage
+func (_e *MockInboxMessageRepository_Expecter) Create(ctx interface{}, message interface{}) *MockInboxMessageRepository_Create_Call {
+	return &MockInboxMessageRepository_Create_Call{Call: _e.mock.On("Create", ctx, message)}
 }
 
-func (_c *MockNotificationChannelRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelRepository_Delete_Call {
+func (_c *MockInboxMessageRepository_Create_Call) Run(run func(ctx context.Context, message *domain.InboxMessage)) *MockInboxMessageRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 *domain.InboxMessage
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(*domain.InboxMessage)
 		}
 		run(
 			arg0,
@@ -153,106 +163,100 @@ func (_c *MockNotificationChannelRepository_Delete_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_Delete_Call) Return(err error) *MockNotificationChannelRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockInboxMessageRepository_Create_Call) Return(inboxMessage *domain.InboxMessage, err error) *MockInboxMessageRepository_Create_Call {
+	_c.Call.Return(inboxMessage, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockNotificationChannelRepository_Delete_Call {
+func (_c *MockInboxMessageRepository_Create_Call) RunAndReturn(run func(ctx context.Context, message *domain.InboxMessage) (*domain.InboxMessage, error)) *MockInboxMessageRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExistByName provides a mock function for the type MockNotificationChannelRepository
-func (_mock *MockNotificationChannelRepository) ExistByName(ctx context.Context, userID int64, name string) (bool, error) {
-	ret := _mock.Called(ctx, userID, name)
+// DeleteCreatedBefore provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) (int, error) {
+	ret := _mock.Called(ctx, before)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByName")
+		panic("no return value specified for DeleteCreatedBefore")
 	}
 
-	var r0 bool
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (bool, error)); ok {
-		return returnFunc(ctx, userID, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, before)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
-		r0 = returnFunc(ctx, userID, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, before)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = returnFunc(ctx, userID, name)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockNotificationChannelRepository_ExistByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByName'
-type MockNotificationChannelRepository_ExistByName_Call struct {
+// MockInboxMessageRepository_DeleteCreatedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCreatedBefore'
+type MockInboxMessageRepository_DeleteCreatedBefore_Call struct {
 	*mock.Call
 }
 
-// ExistByName is a helper method to define mock.On call
+// DeleteCreatedBefore is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - name string
-func (_e *MockNotificationChannelRepository_Expecter) ExistByName(ctx interface{}, userID interface{}, name interface{}) *MockNotificationChannelRepository_ExistByName_Call {
-	return &MockNotificationChannelRepository_ExistByName_Call{Call: _e.mock.On("ExistByName", ctx, userID, name)}
+//   - before time.Time
+func (_e *MockInboxMessageRepository_Expecter) DeleteCreatedBefore(ctx interface{}, before interface{}) *MockInboxMessageRepository_DeleteCreatedBefore_Call {
+	return &MockInboxMessageRepository_DeleteCreatedBefore_Call{Call: _e.mock.On("DeleteCreatedBefore", ctx, before)}
 }
 
-func (_c *MockNotificationChannelRepository_ExistByName_Call) Run(run func(ctx context.Context, userID int64, name string)) *MockNotificationChannelRepository_ExistByName_Call {
+func (_c *MockInboxMessageRepository_DeleteCreatedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockInboxMessageRepository_DeleteCreatedBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_ExistByName_Call) Return(b bool, err error) *MockNotificationChannelRepository_ExistByName_Call {
-	_c.Call.Return(b, err)
+func (_c *MockInboxMessageRepository_DeleteCreatedBefore_Call) Return(n int, err error) *MockInboxMessageRepository_DeleteCreatedBefore_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_ExistByName_Call) RunAndReturn(run func(ctx context.Context, userID int64, name string) (bool, error)) *MockNotificationChannelRepository_ExistByName_Call {
+func (_c *MockInboxMessageRepository_DeleteCreatedBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int, error)) *MockInboxMessageRepository_DeleteCreatedBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockNotificationChannelRepository
-func (_mock *MockNotificationChannelRepository) FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error) {
+// FindById provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) FindById(ctx context.Context, id int64) (*domain.InboxMessage, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindById")
 	}
 
-	var r0 *domain.NotificationChannel
+	var r0 *domain.InboxMessage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannel, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.InboxMessage, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannel); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.InboxMessage); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.NotificationChannel)
+			r0 = ret.Get(0).(*domain.InboxMessage)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
@@ -263,19 +267,19 @@ func (_mock *MockNotificationChannelRepository) FindById(ctx context.Context, id
 	return r0, r1
 }
 
-// MockNotificationChannelRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockNotificationChannelRepository_FindById_Call struct {
+// MockInboxMessageRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockInboxMessageRepository_FindById_Call struct {
 	*mock.Call
 }
 
 // FindById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockNotificationChannelRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockNotificationChannelRepository_FindById_Call {
-	return &MockNotificationChannelRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+func (_e *MockInboxMessageRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockInboxMessageRepository_FindById_Call {
+	return &MockInboxMessageRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
 }
 
-func (_c *MockNotificationChannelRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelRepository_FindById_Call {
+func (_c *MockInboxMessageRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockInboxMessageRepository_FindById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -293,65 +297,66 @@ func (_c *MockNotificationChannelRepository_FindById_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_FindById_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_FindById_Call {
-	_c.Call.Return(notificationChannel, err)
+func (_c *MockInboxMessageRepository_FindById_Call) Return(inboxMessage *domain.InboxMessage, err error) *MockInboxMessageRepository_FindById_Call {
+	_c.Call.Return(inboxMessage, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_FindById_Call {
+func (_c *MockInboxMessageRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.InboxMessage, error)) *MockInboxMessageRepository_FindById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByUserId provides a mock function for the type MockNotificationChannelRepository
-func (_mock *MockNotificationChannelRepository) ListByUserId(ctx context.Context, userID int64, offset int, limit int) ([]*domain.NotificationChannel, int, error) {
-	ret := _mock.Called(ctx, userID, offset, limit)
+// ListByUserId provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) ListByUserId(ctx context.Context, userID int64, unreadOnly bool, offset int, limit int) ([]*domain.InboxMessage, int, error) {
+	ret := _mock.Called(ctx, userID, unreadOnly, offset, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListByUserId")
 	}
 
-	var r0 []*domain.NotificationChannel
+	var r0 []*domain.InboxMessage
 	var r1 int
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.NotificationChannel, int, error)); ok {
-		return returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, int, int) ([]*domain.InboxMessage, int, error)); ok {
+		return returnFunc(ctx, userID, unreadOnly, offset, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, int, int) []*domain.InboxMessage); ok {
+		r0 = returnFunc(ctx, userID, unreadOnly, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.NotificationChannel)
+			r0 = ret.Get(0).([]*domain.InboxMessage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
-		r1 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, bool, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, unreadOnly, offset, limit)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, bool, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, unreadOnly, offset, limit)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockNotificationChannelRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
-type MockNotificationChannelRepository_ListByUserId_Call struct {
+// MockInboxMessageRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockInboxMessageRepository_ListByUserId_Call struct {
 	*mock.Call
 }
 
 // ListByUserId is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
+//   - unreadOnly bool
 //   - offset int
 //   - limit int
-func (_e *MockNotificationChannelRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *MockNotificationChannelRepository_ListByUserId_Call {
-	return &MockNotificationChannelRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, offset, limit)}
+func (_e *MockInboxMessageRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, unreadOnly interface{}, offset interface{}, limit interface{}) *MockInboxMessageRepository_ListByUserId_Call {
+	return &MockInboxMessageRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, unreadOnly, offset, limit)}
 }
 
-func (_c *MockNotificationChannelRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, offset int, limit int)) *MockNotificationChannelRepository_ListByUserId_Call {
+func (_c *MockInboxMessageRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, unreadOnly bool, offset int, limit int)) *MockInboxMessageRepository_ListByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -361,367 +366,310 @@ func (_c *MockNotificationChannelRepository_ListByUserId_Call) Run(run func(ctx
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 int
+		var arg2 bool
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(bool)
 		}
 		var arg3 int
 		if args[3] != nil {
 			arg3 = args[3].(int)
 		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_ListByUserId_Call) Return(notificationChannels []*domain.NotificationChannel, n int, err error) *MockNotificationChannelRepository_ListByUserId_Call {
-	_c.Call.Return(notificationChannels, n, err)
+func (_c *MockInboxMessageRepository_ListByUserId_Call) Return(inboxMessages []*domain.InboxMessage, n int, err error) *MockInboxMessageRepository_ListByUserId_Call {
+	_c.Call.Return(inboxMessages, n, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, offset int, limit int) ([]*domain.NotificationChannel, int, error)) *MockNotificationChannelRepository_ListByUserId_Call {
+func (_c *MockInboxMessageRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, unreadOnly bool, offset int, limit int) ([]*domain.InboxMessage, int, error)) *MockInboxMessageRepository_ListByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockNotificationChannelRepository
-func (_mock *MockNotificationChannelRepository) Update(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
-	ret := _mock.Called(ctx, channel)
+// MarkAllRead provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) MarkAllRead(ctx context.Context, userID int64, at time.Time) (int, error) {
+	ret := _mock.Called(ctx, userID, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for MarkAllRead")
 	}
 
-	var r0 *domain.NotificationChannel
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) (*domain.NotificationChannel, error)); ok {
-		return returnFunc(ctx, channel)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (int, error)); ok {
+		return returnFunc(ctx, userID, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) *domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, channel)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) int); ok {
+		r0 = returnFunc(ctx, userID, at)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.NotificationChannel)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationChannel) error); ok {
-		r1 = returnFunc(ctx, channel)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockNotificationChannelRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockNotificationChannelRepository_Update_Call struct {
+// MockInboxMessageRepository_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MockInboxMessageRepository_MarkAllRead_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// MarkAllRead is a helper method to define mock.On call
 //   - ctx context.Context
-//   - channel *domain.NotificationChannel
-func (_e *MockNotificationChannelRepository_Expecter) Update(ctx interface{}, channel interface{}) *MockNotificationChannelRepository_Update_Call {
-	return &MockNotificationChannelRepository_Update_Call{Call: _e.mock.On("Update", ctx, channel)}
+//   - userID int64
+//   - at time.Time
+func (_e *MockInboxMessageRepository_Expecter) MarkAllRead(ctx interface{}, userID interface{}, at interface{}) *MockInboxMessageRepository_MarkAllRead_Call {
+	return &MockInboxMessageRepository_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", ctx, userID, at)}
 }
 
-func (_c *MockNotificationChannelRepository_Update_Call) Run(run func(ctx context.Context, channel *domain.NotificationChannel)) *MockNotificationChannelRepository_Update_Call {
+func (_c *MockInboxMessageRepository_MarkAllRead_Call) Run(run func(ctx context.Context, userID int64, at time.Time)) *MockInboxMessageRepository_MarkAllRead_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.NotificationChannel
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.NotificationChannel)
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_Update_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_Update_Call {
-	_c.Call.Return(notificationChannel, err)
+func (_c *MockInboxMessageRepository_MarkAllRead_Call) Return(n int, err error) *MockInboxMessageRepository_MarkAllRead_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelRepository_Update_Call) RunAndReturn(run func(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_Update_Call {
+func (_c *MockInboxMessageRepository_MarkAllRead_Call) RunAndReturn(run func(ctx context.Context, userID int64, at time.Time) (int, error)) *MockInboxMessageRepository_MarkAllRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockStreamerRepository creates a new instance of MockStreamerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockStreamerRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockStreamerRepository {
-	mock := &MockStreamerRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockStreamerRepository is an autogenerated mock type for the StreamerRepository type
-type MockStreamerRepository struct {
-	mock.Mock
-}
-
-type MockStreamerRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockStreamerRepository) EXPECT() *MockStreamerRepository_Expecter {
-	return &MockStreamerRepository_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) Create(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error) {
-	ret := _mock.Called(ctx, streamer)
+// MarkRead provides a mock function for the type MockInboxMessageRepository
+func (_mock *MockInboxMessageRepository) MarkRead(ctx context.Context, id int64, at time.Time) (*domain.InboxMessage, error) {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for MarkRead")
 	}
 
-	var r0 *domain.Streamer
+	var r0 *domain.InboxMessage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) (*domain.Streamer, error)); ok {
-		return returnFunc(ctx, streamer)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*domain.InboxMessage, error)); ok {
+		return returnFunc(ctx, id, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) *domain.Streamer); ok {
-		r0 = returnFunc(ctx, streamer)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) *domain.InboxMessage); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Streamer)
+			r0 = ret.Get(0).(*domain.InboxMessage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Streamer) error); ok {
-		r1 = returnFunc(ctx, streamer)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamerRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockStreamerRepository_Create_Call struct {
+// MockInboxMessageRepository_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockInboxMessageRepository_MarkRead_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// MarkRead is a helper method to define mock.On call
 //   - ctx context.Context
-//   - streamer *domain.Streamer
-func (_e *MockStreamerRepository_Expecter) Create(ctx interface{}, streamer interface{}) *MockStreamerRepository_Create_Call {
-	return &MockStreamerRepository_Create_Call{Call: _e.mock.On("Create", ctx, streamer)}
+//   - id int64
+//   - at time.Time
+func (_e *MockInboxMessageRepository_Expecter) MarkRead(ctx interface{}, id interface{}, at interface{}) *MockInboxMessageRepository_MarkRead_Call {
+	return &MockInboxMessageRepository_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, id, at)}
 }
 
-func (_c *MockStreamerRepository_Create_Call) Run(run func(ctx context.Context, streamer *domain.Streamer)) *MockStreamerRepository_Create_Call {
+func (_c *MockInboxMessageRepository_MarkRead_Call) Run(run func(ctx context.Context, id int64, at time.Time)) *MockInboxMessageRepository_MarkRead_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Streamer
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Streamer)
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamerRepository_Create_Call) Return(streamer1 *domain.Streamer, err error) *MockStreamerRepository_Create_Call {
-	_c.Call.Return(streamer1, err)
+func (_c *MockInboxMessageRepository_MarkRead_Call) Return(inboxMessage *domain.InboxMessage, err error) *MockInboxMessageRepository_MarkRead_Call {
+	_c.Call.Return(inboxMessage, err)
 	return _c
 }
 
-func (_c *MockStreamerRepository_Create_Call) RunAndReturn(run func(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error)) *MockStreamerRepository_Create_Call {
+func (_c *MockInboxMessageRepository_MarkRead_Call) RunAndReturn(run func(ctx context.Context, id int64, at time.Time) (*domain.InboxMessage, error)) *MockInboxMessageRepository_MarkRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) Delete(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// NewMockNotificationChannelRepository creates a new instance of MockNotificationChannelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationChannelRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationChannelRepository {
+	mock := &MockNotificationChannelRepository{}
+	mock.Mock.Test(t)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Delete")
-	}
+	t.Cleanup(func() { mock.AssertExpectations(t) })
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+	return mock
 }
 
-// MockStreamerRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockStreamerRepository_Delete_Call struct {
-	*mock.Call
+// MockNotificationChannelRepository is an autogenerated mock type for the NotificationChannelRepository type
+type MockNotificationChannelRepository struct {
+	mock.Mock
 }
 
-// Delete is a helper method to define mock.On call
-//   - ctx context.Context
-//   - id int64
-func (_e *MockStreamerRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockStreamerRepository_Delete_Call {
-	return &MockStreamerRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
-}
-
-func (_c *MockStreamerRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockStreamerRepository_Delete_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		run(
-			arg0,
-			arg1,
-		)
-	})
-	return _c
-}
-
-func (_c *MockStreamerRepository_Delete_Call) Return(err error) *MockStreamerRepository_Delete_Call {
-	_c.Call.Return(err)
-	return _c
+type MockNotificationChannelRepository_Expecter struct {
+	mock *mock.Mock
 }
 
-func (_c *MockStreamerRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockStreamerRepository_Delete_Call {
-	_c.Call.Return(run)
-	return _c
+func (_m *MockNotificationChannelRepository) EXPECT() *MockNotificationChannelRepository_Expecter {
+	return &MockNotificationChannelRepository_Expecter{mock: &_m.Mock}
 }
 
-// ExistByPlatformStreamerId provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) ExistByPlatformStreamerId(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (bool, error) {
-	ret := _mock.Called(ctx, platformType, platformStreamerID)
+// Create provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) Create(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, channel)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByPlatformStreamerId")
+		panic("no return value specified for Create")
 	}
 
-	var r0 bool
+	var r0 *domain.NotificationChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) (bool, error)); ok {
-		return returnFunc(ctx, platformType, platformStreamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, channel)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) bool); ok {
-		r0 = returnFunc(ctx, platformType, platformStreamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, channel)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannel)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType, string) error); ok {
-		r1 = returnFunc(ctx, platformType, platformStreamerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationChannel) error); ok {
+		r1 = returnFunc(ctx, channel)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamerRepository_ExistByPlatformStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByPlatformStreamerId'
-type MockStreamerRepository_ExistByPlatformStreamerId_Call struct {
+// MockNotificationChannelRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockNotificationChannelRepository_Create_Call struct {
 	*mock.Call
 }
 
-// ExistByPlatformStreamerId is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - platformType domain.StreamingPlatformType
-//   - platformStreamerID string
-func (_e *MockStreamerRepository_Expecter) ExistByPlatformStreamerId(ctx interface{}, platformType interface{}, platformStreamerID interface{}) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
-	return &MockStreamerRepository_ExistByPlatformStreamerId_Call{Call: _e.mock.On("ExistByPlatformStreamerId", ctx, platformType, platformStreamerID)}
+//   - channel *domain.NotificationChannel
+func (_e *MockNotificationChannelRepository_Expecter) Create(ctx interface{}, channel interface{}) *MockNotificationChannelRepository_Create_Call {
+	return &MockNotificationChannelRepository_Create_Call{Call: _e.mock.On("Create", ctx, channel)}
 }
 
-func (_c *MockStreamerRepository_ExistByPlatformStreamerId_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string)) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
+func (_c *MockNotificationChannelRepository_Create_Call) Run(run func(ctx context.Context, channel *domain.NotificationChannel)) *MockNotificationChannelRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.StreamingPlatformType
+		var arg1 *domain.NotificationChannel
 		if args[1] != nil {
-			arg1 = args[1].(domain.StreamingPlatformType)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(*domain.NotificationChannel)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamerRepository_ExistByPlatformStreamerId_Call) Return(b bool, err error) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
-	_c.Call.Return(b, err)
+func (_c *MockNotificationChannelRepository_Create_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_Create_Call {
+	_c.Call.Return(notificationChannel, err)
 	return _c
 }
 
-func (_c *MockStreamerRepository_ExistByPlatformStreamerId_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (bool, error)) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
+func (_c *MockNotificationChannelRepository_Create_Call) RunAndReturn(run func(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) FindById(ctx context.Context, id int64) (*domain.Streamer, error) {
+// Delete provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) Delete(ctx context.Context, id int64) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindById")
+		panic("no return value specified for Delete")
 	}
 
-	var r0 *domain.Streamer
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.Streamer, error)); ok {
-		return returnFunc(ctx, id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.Streamer); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Streamer)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStreamerRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockStreamerRepository_FindById_Call struct {
+// MockNotificationChannelRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockNotificationChannelRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// FindById is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockStreamerRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockStreamerRepository_FindById_Call {
-	return &MockStreamerRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+func (_e *MockNotificationChannelRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockNotificationChannelRepository_Delete_Call {
+	return &MockNotificationChannelRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *MockStreamerRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockStreamerRepository_FindById_Call {
+func (_c *MockNotificationChannelRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -739,70 +687,68 @@ func (_c *MockStreamerRepository_FindById_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockStreamerRepository_FindById_Call) Return(streamer *domain.Streamer, err error) *MockStreamerRepository_FindById_Call {
-	_c.Call.Return(streamer, err)
+func (_c *MockNotificationChannelRepository_Delete_Call) Return(err error) *MockNotificationChannelRepository_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStreamerRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.Streamer, error)) *MockStreamerRepository_FindById_Call {
+func (_c *MockNotificationChannelRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockNotificationChannelRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByPlatformStreamerId provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) FindByPlatformStreamerId(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (*domain.Streamer, error) {
-	ret := _mock.Called(ctx, platformType, platformStreamerID)
+// DisableUnhealthy provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) DisableUnhealthy(ctx context.Context, id int64, at time.Time) (bool, error) {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByPlatformStreamerId")
+		panic("no return value specified for DisableUnhealthy")
 	}
 
-	var r0 *domain.Streamer
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) (*domain.Streamer, error)); ok {
-		return returnFunc(ctx, platformType, platformStreamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, id, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) *domain.Streamer); ok {
-		r0 = returnFunc(ctx, platformType, platformStreamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) bool); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Streamer)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType, string) error); ok {
-		r1 = returnFunc(ctx, platformType, platformStreamerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamerRepository_FindByPlatformStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPlatformStreamerId'
-type MockStreamerRepository_FindByPlatformStreamerId_Call struct {
+// MockNotificationChannelRepository_DisableUnhealthy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableUnhealthy'
+type MockNotificationChannelRepository_DisableUnhealthy_Call struct {
 	*mock.Call
 }
 
-// FindByPlatformStreamerId is a helper method to define mock.On call
+// DisableUnhealthy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - platformType domain.StreamingPlatformType
-//   - platformStreamerID string
-func (_e *MockStreamerRepository_Expecter) FindByPlatformStreamerId(ctx interface{}, platformType interface{}, platformStreamerID interface{}) *MockStreamerRepository_FindByPlatformStreamerId_Call {
-	return &MockStreamerRepository_FindByPlatformStreamerId_Call{Call: _e.mock.On("FindByPlatformStreamerId", ctx, platformType, platformStreamerID)}
+//   - id int64
+//   - at time.Time
+func (_e *MockNotificationChannelRepository_Expecter) DisableUnhealthy(ctx interface{}, id interface{}, at interface{}) *MockNotificationChannelRepository_DisableUnhealthy_Call {
+	return &MockNotificationChannelRepository_DisableUnhealthy_Call{Call: _e.mock.On("DisableUnhealthy", ctx, id, at)}
 }
 
-func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string)) *MockStreamerRepository_FindByPlatformStreamerId_Call {
+func (_c *MockNotificationChannelRepository_DisableUnhealthy_Call) Run(run func(ctx context.Context, id int64, at time.Time)) *MockNotificationChannelRepository_DisableUnhealthy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.StreamingPlatformType
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(domain.StreamingPlatformType)
+			arg1 = args[1].(int64)
 		}
-		var arg2 string
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
@@ -813,76 +759,68 @@ func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) Return(streamer *domain.Streamer, err error) *MockStreamerRepository_FindByPlatformStreamerId_Call {
-	_c.Call.Return(streamer, err)
+func (_c *MockNotificationChannelRepository_DisableUnhealthy_Call) Return(b bool, err error) *MockNotificationChannelRepository_DisableUnhealthy_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (*domain.Streamer, error)) *MockStreamerRepository_FindByPlatformStreamerId_Call {
+func (_c *MockNotificationChannelRepository_DisableUnhealthy_Call) RunAndReturn(run func(ctx context.Context, id int64, at time.Time) (bool, error)) *MockNotificationChannelRepository_DisableUnhealthy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) List(ctx context.Context, offset int, limit int) ([]*domain.Streamer, int, error) {
-	ret := _mock.Called(ctx, offset, limit)
+// ExistByName provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) ExistByName(ctx context.Context, userID int64, name string) (bool, error) {
+	ret := _mock.Called(ctx, userID, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for ExistByName")
 	}
 
-	var r0 []*domain.Streamer
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.Streamer, int, error)); ok {
-		return returnFunc(ctx, offset, limit)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.Streamer); ok {
-		r0 = returnFunc(ctx, offset, limit)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Streamer)
-		}
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (bool, error)); ok {
+		return returnFunc(ctx, userID, name)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
-		r1 = returnFunc(ctx, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
+		r0 = returnFunc(ctx, userID, name)
 	} else {
-		r1 = ret.Get(1).(int)
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
-		r2 = returnFunc(ctx, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, userID, name)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockStreamerRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockStreamerRepository_List_Call struct {
+// MockNotificationChannelRepository_ExistByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByName'
+type MockNotificationChannelRepository_ExistByName_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// ExistByName is a helper method to define mock.On call
 //   - ctx context.Context
-//   - offset int
-//   - limit int
-func (_e *MockStreamerRepository_Expecter) List(ctx interface{}, offset interface{}, limit interface{}) *MockStreamerRepository_List_Call {
-	return &MockStreamerRepository_List_Call{Call: _e.mock.On("List", ctx, offset, limit)}
+//   - userID int64
+//   - name string
+func (_e *MockNotificationChannelRepository_Expecter) ExistByName(ctx interface{}, userID interface{}, name interface{}) *MockNotificationChannelRepository_ExistByName_Call {
+	return &MockNotificationChannelRepository_ExistByName_Call{Call: _e.mock.On("ExistByName", ctx, userID, name)}
 }
 
-func (_c *MockStreamerRepository_List_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockStreamerRepository_List_Call {
+func (_c *MockNotificationChannelRepository_ExistByName_Call) Run(run func(ctx context.Context, userID int64, name string)) *MockNotificationChannelRepository_ExistByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(int64)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -893,65 +831,65 @@ func (_c *MockStreamerRepository_List_Call) Run(run func(ctx context.Context, of
 	return _c
 }
 
-func (_c *MockStreamerRepository_List_Call) Return(streamers []*domain.Streamer, n int, err error) *MockStreamerRepository_List_Call {
-	_c.Call.Return(streamers, n, err)
+func (_c *MockNotificationChannelRepository_ExistByName_Call) Return(b bool, err error) *MockNotificationChannelRepository_ExistByName_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockStreamerRepository_List_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.Streamer, int, error)) *MockStreamerRepository_List_Call {
+func (_c *MockNotificationChannelRepository_ExistByName_Call) RunAndReturn(run func(ctx context.Context, userID int64, name string) (bool, error)) *MockNotificationChannelRepository_ExistByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockStreamerRepository
-func (_mock *MockStreamerRepository) Update(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error) {
-	ret := _mock.Called(ctx, streamer)
+// FindById provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for FindById")
 	}
 
-	var r0 *domain.Streamer
+	var r0 *domain.NotificationChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) (*domain.Streamer, error)); ok {
-		return returnFunc(ctx, streamer)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) *domain.Streamer); ok {
-		r0 = returnFunc(ctx, streamer)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Streamer)
+			r0 = ret.Get(0).(*domain.NotificationChannel)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Streamer) error); ok {
-		r1 = returnFunc(ctx, streamer)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamerRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockStreamerRepository_Update_Call struct {
+// MockNotificationChannelRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockNotificationChannelRepository_FindById_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// FindById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - streamer *domain.Streamer
-func (_e *MockStreamerRepository_Expecter) Update(ctx interface{}, streamer interface{}) *MockStreamerRepository_Update_Call {
-	return &MockStreamerRepository_Update_Call{Call: _e.mock.On("Update", ctx, streamer)}
+//   - id int64
+func (_e *MockNotificationChannelRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockNotificationChannelRepository_FindById_Call {
+	return &MockNotificationChannelRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
 }
 
-func (_c *MockStreamerRepository_Update_Call) Run(run func(ctx context.Context, streamer *domain.Streamer)) *MockStreamerRepository_Update_Call {
+func (_c *MockNotificationChannelRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelRepository_FindById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.Streamer
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.Streamer)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -961,92 +899,65 @@ func (_c *MockStreamerRepository_Update_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockStreamerRepository_Update_Call) Return(streamer1 *domain.Streamer, err error) *MockStreamerRepository_Update_Call {
-	_c.Call.Return(streamer1, err)
+func (_c *MockNotificationChannelRepository_FindById_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_FindById_Call {
+	_c.Call.Return(notificationChannel, err)
 	return _c
 }
 
-func (_c *MockStreamerRepository_Update_Call) RunAndReturn(run func(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error)) *MockStreamerRepository_Update_Call {
+func (_c *MockNotificationChannelRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_FindById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockStreamingPlatformRepository creates a new instance of MockStreamingPlatformRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockStreamingPlatformRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockStreamingPlatformRepository {
-	mock := &MockStreamingPlatformRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockStreamingPlatformRepository is an autogenerated mock type for the StreamingPlatformRepository type
-type MockStreamingPlatformRepository struct {
-	mock.Mock
-}
-
-type MockStreamingPlatformRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockStreamingPlatformRepository) EXPECT() *MockStreamingPlatformRepository_Expecter {
-	return &MockStreamingPlatformRepository_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) Create(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error) {
-	ret := _mock.Called(ctx, platform)
+// FindInboxByUserId provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) FindInboxByUserId(ctx context.Context, userID int64) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindInboxByUserId")
 	}
 
-	var r0 *domain.StreamingPlatform
+	var r0 *domain.NotificationChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) (*domain.StreamingPlatform, error)); ok {
-		return returnFunc(ctx, platform)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) *domain.StreamingPlatform); ok {
-		r0 = returnFunc(ctx, platform)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.StreamingPlatform)
+			r0 = ret.Get(0).(*domain.NotificationChannel)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.StreamingPlatform) error); ok {
-		r1 = returnFunc(ctx, platform)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamingPlatformRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockStreamingPlatformRepository_Create_Call struct {
+// MockNotificationChannelRepository_FindInboxByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindInboxByUserId'
+type MockNotificationChannelRepository_FindInboxByUserId_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// FindInboxByUserId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - platform *domain.StreamingPlatform
-func (_e *MockStreamingPlatformRepository_Expecter) Create(ctx interface{}, platform interface{}) *MockStreamingPlatformRepository_Create_Call {
-	return &MockStreamingPlatformRepository_Create_Call{Call: _e.mock.On("Create", ctx, platform)}
+//   - userID int64
+func (_e *MockNotificationChannelRepository_Expecter) FindInboxByUserId(ctx interface{}, userID interface{}) *MockNotificationChannelRepository_FindInboxByUserId_Call {
+	return &MockNotificationChannelRepository_FindInboxByUserId_Call{Call: _e.mock.On("FindInboxByUserId", ctx, userID)}
 }
 
-func (_c *MockStreamingPlatformRepository_Create_Call) Run(run func(ctx context.Context, platform *domain.StreamingPlatform)) *MockStreamingPlatformRepository_Create_Call {
+func (_c *MockNotificationChannelRepository_FindInboxByUserId_Call) Run(run func(ctx context.Context, userID int64)) *MockNotificationChannelRepository_FindInboxByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.StreamingPlatform
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.StreamingPlatform)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -1056,46 +967,65 @@ func (_c *MockStreamingPlatformRepository_Create_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_Create_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_Create_Call {
-	_c.Call.Return(streamingPlatform, err)
+func (_c *MockNotificationChannelRepository_FindInboxByUserId_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_FindInboxByUserId_Call {
+	_c.Call.Return(notificationChannel, err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_Create_Call) RunAndReturn(run func(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_Create_Call {
+func (_c *MockNotificationChannelRepository_FindInboxByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_FindInboxByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) Delete(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// ListByUserId provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) ListByUserId(ctx context.Context, userID int64, offset int, limit int) ([]*domain.NotificationChannel, int, error) {
+	ret := _mock.Called(ctx, userID, offset, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for ListByUserId")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 []*domain.NotificationChannel
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.NotificationChannel, int, error)); ok {
+		return returnFunc(ctx, userID, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, userID, offset, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationChannel)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockStreamingPlatformRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockStreamingPlatformRepository_Delete_Call struct {
+// MockNotificationChannelRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockNotificationChannelRepository_ListByUserId_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// ListByUserId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStreamingPlatformRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockStreamingPlatformRepository_Delete_Call {
-	return &MockStreamingPlatformRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - userID int64
+//   - offset int
+//   - limit int
+func (_e *MockNotificationChannelRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *MockNotificationChannelRepository_ListByUserId_Call {
+	return &MockNotificationChannelRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, offset, limit)}
 }
 
-func (_c *MockStreamingPlatformRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockStreamingPlatformRepository_Delete_Call {
+func (_c *MockNotificationChannelRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, offset int, limit int)) *MockNotificationChannelRepository_ListByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1105,197 +1035,220 @@ func (_c *MockStreamingPlatformRepository_Delete_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_Delete_Call) Return(err error) *MockStreamingPlatformRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockNotificationChannelRepository_ListByUserId_Call) Return(notificationChannels []*domain.NotificationChannel, n int, err error) *MockNotificationChannelRepository_ListByUserId_Call {
+	_c.Call.Return(notificationChannels, n, err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockStreamingPlatformRepository_Delete_Call {
+func (_c *MockNotificationChannelRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, offset int, limit int) ([]*domain.NotificationChannel, int, error)) *MockNotificationChannelRepository_ListByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExistByName provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) ExistByName(ctx context.Context, name string) (bool, error) {
-	ret := _mock.Called(ctx, name)
+// ListWithDailySummary provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) ListWithDailySummary(ctx context.Context, offset int, limit int) ([]*domain.NotificationChannel, int, error) {
+	ret := _mock.Called(ctx, offset, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByName")
+		panic("no return value specified for ListWithDailySummary")
 	}
 
-	var r0 bool
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return returnFunc(ctx, name)
+	var r0 []*domain.NotificationChannel
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.NotificationChannel, int, error)); ok {
+		return returnFunc(ctx, offset, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, offset, limit)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationChannel)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = returnFunc(ctx, offset, limit)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockStreamingPlatformRepository_ExistByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByName'
-type MockStreamingPlatformRepository_ExistByName_Call struct {
+// MockNotificationChannelRepository_ListWithDailySummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithDailySummary'
+type MockNotificationChannelRepository_ListWithDailySummary_Call struct {
 	*mock.Call
 }
 
-// ExistByName is a helper method to define mock.On call
+// ListWithDailySummary is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockStreamingPlatformRepository_Expecter) ExistByName(ctx interface{}, name interface{}) *MockStreamingPlatformRepository_ExistByName_Call {
-	return &MockStreamingPlatformRepository_ExistByName_Call{Call: _e.mock.On("ExistByName", ctx, name)}
+//   - offset int
+//   - limit int
+func (_e *MockNotificationChannelRepository_Expecter) ListWithDailySummary(ctx interface{}, offset interface{}, limit interface{}) *MockNotificationChannelRepository_ListWithDailySummary_Call {
+	return &MockNotificationChannelRepository_ListWithDailySummary_Call{Call: _e.mock.On("ListWithDailySummary", ctx, offset, limit)}
 }
 
-func (_c *MockStreamingPlatformRepository_ExistByName_Call) Run(run func(ctx context.Context, name string)) *MockStreamingPlatformRepository_ExistByName_Call {
+func (_c *MockNotificationChannelRepository_ListWithDailySummary_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockNotificationChannelRepository_ListWithDailySummary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_ExistByName_Call) Return(b bool, err error) *MockStreamingPlatformRepository_ExistByName_Call {
-	_c.Call.Return(b, err)
+func (_c *MockNotificationChannelRepository_ListWithDailySummary_Call) Return(notificationChannels []*domain.NotificationChannel, n int, err error) *MockNotificationChannelRepository_ListWithDailySummary_Call {
+	_c.Call.Return(notificationChannels, n, err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_ExistByName_Call) RunAndReturn(run func(ctx context.Context, name string) (bool, error)) *MockStreamingPlatformRepository_ExistByName_Call {
+func (_c *MockNotificationChannelRepository_ListWithDailySummary_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.NotificationChannel, int, error)) *MockNotificationChannelRepository_ListWithDailySummary_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExistByType provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) ExistByType(ctx context.Context, platformType domain.StreamingPlatformType) (bool, error) {
-	ret := _mock.Called(ctx, platformType)
+// MarkDailySummarySent provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) MarkDailySummarySent(ctx context.Context, id int64, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByType")
+		panic("no return value specified for MarkDailySummarySent")
 	}
 
-	var r0 bool
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) (bool, error)); ok {
-		return returnFunc(ctx, platformType)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) bool); ok {
-		r0 = returnFunc(ctx, platformType)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType) error); ok {
-		r1 = returnFunc(ctx, platformType)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStreamingPlatformRepository_ExistByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByType'
-type MockStreamingPlatformRepository_ExistByType_Call struct {
+// MockNotificationChannelRepository_MarkDailySummarySent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDailySummarySent'
+type MockNotificationChannelRepository_MarkDailySummarySent_Call struct {
 	*mock.Call
 }
 
-// ExistByType is a helper method to define mock.On call
+// MarkDailySummarySent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - platformType domain.StreamingPlatformType
-func (_e *MockStreamingPlatformRepository_Expecter) ExistByType(ctx interface{}, platformType interface{}) *MockStreamingPlatformRepository_ExistByType_Call {
-	return &MockStreamingPlatformRepository_ExistByType_Call{Call: _e.mock.On("ExistByType", ctx, platformType)}
+//   - id int64
+//   - at time.Time
+func (_e *MockNotificationChannelRepository_Expecter) MarkDailySummarySent(ctx interface{}, id interface{}, at interface{}) *MockNotificationChannelRepository_MarkDailySummarySent_Call {
+	return &MockNotificationChannelRepository_MarkDailySummarySent_Call{Call: _e.mock.On("MarkDailySummarySent", ctx, id, at)}
 }
 
-func (_c *MockStreamingPlatformRepository_ExistByType_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType)) *MockStreamingPlatformRepository_ExistByType_Call {
+func (_c *MockNotificationChannelRepository_MarkDailySummarySent_Call) Run(run func(ctx context.Context, id int64, at time.Time)) *MockNotificationChannelRepository_MarkDailySummarySent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.StreamingPlatformType
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(domain.StreamingPlatformType)
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_ExistByType_Call) Return(b bool, err error) *MockStreamingPlatformRepository_ExistByType_Call {
-	_c.Call.Return(b, err)
+func (_c *MockNotificationChannelRepository_MarkDailySummarySent_Call) Return(err error) *MockNotificationChannelRepository_MarkDailySummarySent_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_ExistByType_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType) (bool, error)) *MockStreamingPlatformRepository_ExistByType_Call {
+func (_c *MockNotificationChannelRepository_MarkDailySummarySent_Call) RunAndReturn(run func(ctx context.Context, id int64, at time.Time) error) *MockNotificationChannelRepository_MarkDailySummarySent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) FindById(ctx context.Context, id int64) (*domain.StreamingPlatform, error) {
-	ret := _mock.Called(ctx, id)
+// RecordSendFailure provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) RecordSendFailure(ctx context.Context, id int64, message string, at time.Time) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, id, message, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindById")
+		panic("no return value specified for RecordSendFailure")
 	}
 
-	var r0 *domain.StreamingPlatform
+	var r0 *domain.NotificationChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.StreamingPlatform, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, id, message, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.StreamingPlatform); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, id, message, at)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.StreamingPlatform)
+			r0 = ret.Get(0).(*domain.NotificationChannel)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, message, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamingPlatformRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockStreamingPlatformRepository_FindById_Call struct {
+// MockNotificationChannelRepository_RecordSendFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSendFailure'
+type MockNotificationChannelRepository_RecordSendFailure_Call struct {
 	*mock.Call
 }
 
-// FindById is a helper method to define mock.On call
+// RecordSendFailure is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockStreamingPlatformRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockStreamingPlatformRepository_FindById_Call {
-	return &MockStreamingPlatformRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+//   - message string
+//   - at time.Time
+func (_e *MockNotificationChannelRepository_Expecter) RecordSendFailure(ctx interface{}, id interface{}, message interface{}, at interface{}) *MockNotificationChannelRepository_RecordSendFailure_Call {
+	return &MockNotificationChannelRepository_RecordSendFailure_Call{Call: _e.mock.On("RecordSendFailure", ctx, id, message, at)}
 }
 
-func (_c *MockStreamingPlatformRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockStreamingPlatformRepository_FindById_Call {
+func (_c *MockNotificationChannelRepository_RecordSendFailure_Call) Run(run func(ctx context.Context, id int64, message string, at time.Time)) *MockNotificationChannelRepository_RecordSendFailure_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1305,141 +1258,206 @@ func (_c *MockStreamingPlatformRepository_FindById_Call) Run(run func(ctx contex
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_FindById_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_FindById_Call {
-	_c.Call.Return(streamingPlatform, err)
+func (_c *MockNotificationChannelRepository_RecordSendFailure_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_RecordSendFailure_Call {
+	_c.Call.Return(notificationChannel, err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_FindById_Call {
+func (_c *MockNotificationChannelRepository_RecordSendFailure_Call) RunAndReturn(run func(ctx context.Context, id int64, message string, at time.Time) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_RecordSendFailure_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByName provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) FindByName(ctx context.Context, name string) (*domain.StreamingPlatform, error) {
-	ret := _mock.Called(ctx, name)
+// RecordSendSuccess provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) RecordSendSuccess(ctx context.Context, id int64, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByName")
+		panic("no return value specified for RecordSendSuccess")
 	}
 
-	var r0 *domain.StreamingPlatform
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationChannelRepository_RecordSendSuccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSendSuccess'
+type MockNotificationChannelRepository_RecordSendSuccess_Call struct {
+	*mock.Call
+}
+
+// RecordSendSuccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - at time.Time
+func (_e *MockNotificationChannelRepository_Expecter) RecordSendSuccess(ctx interface{}, id interface{}, at interface{}) *MockNotificationChannelRepository_RecordSendSuccess_Call {
+	return &MockNotificationChannelRepository_RecordSendSuccess_Call{Call: _e.mock.On("RecordSendSuccess", ctx, id, at)}
+}
+
+func (_c *MockNotificationChannelRepository_RecordSendSuccess_Call) Run(run func(ctx context.Context, id int64, at time.Time)) *MockNotificationChannelRepository_RecordSendSuccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelRepository_RecordSendSuccess_Call) Return(err error) *MockNotificationChannelRepository_RecordSendSuccess_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationChannelRepository_RecordSendSuccess_Call) RunAndReturn(run func(ctx context.Context, id int64, at time.Time) error) *MockNotificationChannelRepository_RecordSendSuccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSecrets provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) RotateSecrets(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecrets")
+	}
+
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.StreamingPlatform, error)); ok {
-		return returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.StreamingPlatform); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.StreamingPlatform)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamingPlatformRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
-type MockStreamingPlatformRepository_FindByName_Call struct {
+// MockNotificationChannelRepository_RotateSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecrets'
+type MockNotificationChannelRepository_RotateSecrets_Call struct {
 	*mock.Call
 }
 
-// FindByName is a helper method to define mock.On call
+// RotateSecrets is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockStreamingPlatformRepository_Expecter) FindByName(ctx interface{}, name interface{}) *MockStreamingPlatformRepository_FindByName_Call {
-	return &MockStreamingPlatformRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+func (_e *MockNotificationChannelRepository_Expecter) RotateSecrets(ctx interface{}) *MockNotificationChannelRepository_RotateSecrets_Call {
+	return &MockNotificationChannelRepository_RotateSecrets_Call{Call: _e.mock.On("RotateSecrets", ctx)}
 }
 
-func (_c *MockStreamingPlatformRepository_FindByName_Call) Run(run func(ctx context.Context, name string)) *MockStreamingPlatformRepository_FindByName_Call {
+func (_c *MockNotificationChannelRepository_RotateSecrets_Call) Run(run func(ctx context.Context)) *MockNotificationChannelRepository_RotateSecrets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_FindByName_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_FindByName_Call {
-	_c.Call.Return(streamingPlatform, err)
+func (_c *MockNotificationChannelRepository_RotateSecrets_Call) Return(n int, err error) *MockNotificationChannelRepository_RotateSecrets_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_FindByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_FindByName_Call {
+func (_c *MockNotificationChannelRepository_RotateSecrets_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockNotificationChannelRepository_RotateSecrets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByType provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) FindByType(ctx context.Context, platformType domain.StreamingPlatformType) (*domain.StreamingPlatform, error) {
-	ret := _mock.Called(ctx, platformType)
+// Update provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) Update(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, channel)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByType")
+		panic("no return value specified for Update")
 	}
 
-	var r0 *domain.StreamingPlatform
+	var r0 *domain.NotificationChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) (*domain.StreamingPlatform, error)); ok {
-		return returnFunc(ctx, platformType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, channel)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) *domain.StreamingPlatform); ok {
-		r0 = returnFunc(ctx, platformType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationChannel) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, channel)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.StreamingPlatform)
+			r0 = ret.Get(0).(*domain.NotificationChannel)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType) error); ok {
-		r1 = returnFunc(ctx, platformType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationChannel) error); ok {
+		r1 = returnFunc(ctx, channel)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStreamingPlatformRepository_FindByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByType'
-type MockStreamingPlatformRepository_FindByType_Call struct {
+// MockNotificationChannelRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockNotificationChannelRepository_Update_Call struct {
 	*mock.Call
 }
 
-// FindByType is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
 //   - ctx context.Context
-//   - platformType domain.StreamingPlatformType
-func (_e *MockStreamingPlatformRepository_Expecter) FindByType(ctx interface{}, platformType interface{}) *MockStreamingPlatformRepository_FindByType_Call {
-	return &MockStreamingPlatformRepository_FindByType_Call{Call: _e.mock.On("FindByType", ctx, platformType)}
+//   - channel *domain.NotificationChannel
+func (_e *MockNotificationChannelRepository_Expecter) Update(ctx interface{}, channel interface{}) *MockNotificationChannelRepository_Update_Call {
+	return &MockNotificationChannelRepository_Update_Call{Call: _e.mock.On("Update", ctx, channel)}
 }
 
-func (_c *MockStreamingPlatformRepository_FindByType_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType)) *MockStreamingPlatformRepository_FindByType_Call {
+func (_c *MockNotificationChannelRepository_Update_Call) Run(run func(ctx context.Context, channel *domain.NotificationChannel)) *MockNotificationChannelRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.StreamingPlatformType
+		var arg1 *domain.NotificationChannel
 		if args[1] != nil {
-			arg1 = args[1].(domain.StreamingPlatformType)
+			arg1 = args[1].(*domain.NotificationChannel)
 		}
 		run(
 			arg0,
@@ -1449,76 +1467,59 @@ func (_c *MockStreamingPlatformRepository_FindByType_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_FindByType_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_FindByType_Call {
-	_c.Call.Return(streamingPlatform, err)
+func (_c *MockNotificationChannelRepository_Update_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelRepository_Update_Call {
+	_c.Call.Return(notificationChannel, err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_FindByType_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_FindByType_Call {
+func (_c *MockNotificationChannelRepository_Update_Call) RunAndReturn(run func(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error)) *MockNotificationChannelRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) List(ctx context.Context, offset int, limit int) ([]*domain.StreamingPlatform, int, error) {
-	ret := _mock.Called(ctx, offset, limit)
+// UpdateTestResult provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) UpdateTestResult(ctx context.Context, id int64, result *domain.NotificationChannelTestResult) error {
+	ret := _mock.Called(ctx, id, result)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for UpdateTestResult")
 	}
 
-	var r0 []*domain.StreamingPlatform
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.StreamingPlatform, int, error)); ok {
-		return returnFunc(ctx, offset, limit)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.StreamingPlatform); ok {
-		r0 = returnFunc(ctx, offset, limit)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.StreamingPlatform)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
-		r1 = returnFunc(ctx, offset, limit)
-	} else {
-		r1 = ret.Get(1).(int)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
-		r2 = returnFunc(ctx, offset, limit)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *domain.NotificationChannelTestResult) error); ok {
+		r0 = returnFunc(ctx, id, result)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Error(0)
 	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockStreamingPlatformRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockStreamingPlatformRepository_List_Call struct {
+// MockNotificationChannelRepository_UpdateTestResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTestResult'
+type MockNotificationChannelRepository_UpdateTestResult_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// UpdateTestResult is a helper method to define mock.On call
 //   - ctx context.Context
-//   - offset int
-//   - limit int
-func (_e *MockStreamingPlatformRepository_Expecter) List(ctx interface{}, offset interface{}, limit interface{}) *MockStreamingPlatformRepository_List_Call {
-	return &MockStreamingPlatformRepository_List_Call{Call: _e.mock.On("List", ctx, offset, limit)}
+//   - id int64
+//   - result *domain.NotificationChannelTestResult
+func (_e *MockNotificationChannelRepository_Expecter) UpdateTestResult(ctx interface{}, id interface{}, result interface{}) *MockNotificationChannelRepository_UpdateTestResult_Call {
+	return &MockNotificationChannelRepository_UpdateTestResult_Call{Call: _e.mock.On("UpdateTestResult", ctx, id, result)}
 }
 
-func (_c *MockStreamingPlatformRepository_List_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockStreamingPlatformRepository_List_Call {
+func (_c *MockNotificationChannelRepository_UpdateTestResult_Call) Run(run func(ctx context.Context, id int64, result *domain.NotificationChannelTestResult)) *MockNotificationChannelRepository_UpdateTestResult_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(int64)
 		}
-		var arg2 int
+		var arg2 *domain.NotificationChannelTestResult
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(*domain.NotificationChannelTestResult)
 		}
 		run(
 			arg0,
@@ -1529,91 +1530,92 @@ func (_c *MockStreamingPlatformRepository_List_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_List_Call) Return(streamingPlatforms []*domain.StreamingPlatform, n int, err error) *MockStreamingPlatformRepository_List_Call {
-	_c.Call.Return(streamingPlatforms, n, err)
+func (_c *MockNotificationChannelRepository_UpdateTestResult_Call) Return(err error) *MockNotificationChannelRepository_UpdateTestResult_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_List_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.StreamingPlatform, int, error)) *MockStreamingPlatformRepository_List_Call {
+func (_c *MockNotificationChannelRepository_UpdateTestResult_Call) RunAndReturn(run func(ctx context.Context, id int64, result *domain.NotificationChannelTestResult) error) *MockNotificationChannelRepository_UpdateTestResult_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockStreamingPlatformRepository
-func (_mock *MockStreamingPlatformRepository) Update(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error) {
-	ret := _mock.Called(ctx, platform)
+// UpdateVerification provides a mock function for the type MockNotificationChannelRepository
+func (_mock *MockNotificationChannelRepository) UpdateVerification(ctx context.Context, id int64, enable bool, verification *domain.ChannelVerification) error {
+	ret := _mock.Called(ctx, id, enable, verification)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for UpdateVerification")
 	}
 
-	var r0 *domain.StreamingPlatform
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) (*domain.StreamingPlatform, error)); ok {
-		return returnFunc(ctx, platform)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) *domain.StreamingPlatform); ok {
-		r0 = returnFunc(ctx, platform)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.StreamingPlatform)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.StreamingPlatform) error); ok {
-		r1 = returnFunc(ctx, platform)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, *domain.ChannelVerification) error); ok {
+		r0 = returnFunc(ctx, id, enable, verification)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStreamingPlatformRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockStreamingPlatformRepository_Update_Call struct {
+// MockNotificationChannelRepository_UpdateVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVerification'
+type MockNotificationChannelRepository_UpdateVerification_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// UpdateVerification is a helper method to define mock.On call
 //   - ctx context.Context
-//   - platform *domain.StreamingPlatform
-func (_e *MockStreamingPlatformRepository_Expecter) Update(ctx interface{}, platform interface{}) *MockStreamingPlatformRepository_Update_Call {
-	return &MockStreamingPlatformRepository_Update_Call{Call: _e.mock.On("Update", ctx, platform)}
+//   - id int64
+//   - enable bool
+//   - verification *domain.ChannelVerification
+func (_e *MockNotificationChannelRepository_Expecter) UpdateVerification(ctx interface{}, id interface{}, enable interface{}, verification interface{}) *MockNotificationChannelRepository_UpdateVerification_Call {
+	return &MockNotificationChannelRepository_UpdateVerification_Call{Call: _e.mock.On("UpdateVerification", ctx, id, enable, verification)}
 }
 
-func (_c *MockStreamingPlatformRepository_Update_Call) Run(run func(ctx context.Context, platform *domain.StreamingPlatform)) *MockStreamingPlatformRepository_Update_Call {
+func (_c *MockNotificationChannelRepository_UpdateVerification_Call) Run(run func(ctx context.Context, id int64, enable bool, verification *domain.ChannelVerification)) *MockNotificationChannelRepository_UpdateVerification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.StreamingPlatform
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.StreamingPlatform)
+			arg1 = args[1].(int64)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 *domain.ChannelVerification
+		if args[3] != nil {
+			arg3 = args[3].(*domain.ChannelVerification)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_Update_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_Update_Call {
-	_c.Call.Return(streamingPlatform, err)
+func (_c *MockNotificationChannelRepository_UpdateVerification_Call) Return(err error) *MockNotificationChannelRepository_UpdateVerification_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStreamingPlatformRepository_Update_Call) RunAndReturn(run func(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_Update_Call {
+func (_c *MockNotificationChannelRepository_UpdateVerification_Call) RunAndReturn(run func(ctx context.Context, id int64, enable bool, verification *domain.ChannelVerification) error) *MockNotificationChannelRepository_UpdateVerification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserFollowedStreamerRepository creates a new instance of MockUserFollowedStreamerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockNotificationDeliveryRepository creates a new instance of MockNotificationDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockUserFollowedStreamerRepository(t interface {
+func NewMockNotificationDeliveryRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockUserFollowedStreamerRepository {
-	mock := &MockUserFollowedStreamerRepository{}
+}) *MockNotificationDeliveryRepository {
+	mock := &MockNotificationDeliveryRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1621,184 +1623,213 @@ func NewMockUserFollowedStreamerRepository(t interface {
 	return mock
 }
 
-// MockUserFollowedStreamerRepository is an autogenerated mock type for the UserFollowedStreamerRepository type
-type MockUserFollowedStreamerRepository struct {
+// MockNotificationDeliveryRepository is an autogenerated mock type for the NotificationDeliveryRepository type
+type MockNotificationDeliveryRepository struct {
 	mock.Mock
 }
 
-type MockUserFollowedStreamerRepository_Expecter struct {
+type MockNotificationDeliveryRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockUserFollowedStreamerRepository) EXPECT() *MockUserFollowedStreamerRepository_Expecter {
-	return &MockUserFollowedStreamerRepository_Expecter{mock: &_m.Mock}
+func (_m *MockNotificationDeliveryRepository) EXPECT() *MockNotificationDeliveryRepository_Expecter {
+	return &MockNotificationDeliveryRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) Create(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error) {
-	ret := _mock.Called(ctx, follow)
+// Acknowledge provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) Acknowledge(ctx context.Context, id int64, at time.Time) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, id, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Acknowledge")
 	}
 
-	var r0 *domain.UserFollowedStreamer
+	var r0 *domain.NotificationDelivery
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)); ok {
-		return returnFunc(ctx, follow)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, id, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) *domain.UserFollowedStreamer); ok {
-		r0 = returnFunc(ctx, follow)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, id, at)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserFollowedStreamer) error); ok {
-		r1 = returnFunc(ctx, follow)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserFollowedStreamerRepository_Create_Call struct {
+// MockNotificationDeliveryRepository_Acknowledge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acknowledge'
+type MockNotificationDeliveryRepository_Acknowledge_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Acknowledge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - follow *domain.UserFollowedStreamer
-func (_e *MockUserFollowedStreamerRepository_Expecter) Create(ctx interface{}, follow interface{}) *MockUserFollowedStreamerRepository_Create_Call {
-	return &MockUserFollowedStreamerRepository_Create_Call{Call: _e.mock.On("Create", ctx, follow)}
+//   - id int64
+//   - at time.Time
+func (_e *MockNotificationDeliveryRepository_Expecter) Acknowledge(ctx interface{}, id interface{}, at interface{}) *MockNotificationDeliveryRepository_Acknowledge_Call {
+	return &MockNotificationDeliveryRepository_Acknowledge_Call{Call: _e.mock.On("Acknowledge", ctx, id, at)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_Create_Call) Run(run func(ctx context.Context, follow *domain.UserFollowedStreamer)) *MockUserFollowedStreamerRepository_Create_Call {
+func (_c *MockNotificationDeliveryRepository_Acknowledge_Call) Run(run func(ctx context.Context, id int64, at time.Time)) *MockNotificationDeliveryRepository_Acknowledge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.UserFollowedStreamer
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*domain.UserFollowedStreamer)
+			arg1 = args[1].(int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_Create_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_Create_Call {
-	_c.Call.Return(userFollowedStreamer, err)
+func (_c *MockNotificationDeliveryRepository_Acknowledge_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_Acknowledge_Call {
+	_c.Call.Return(notificationDelivery, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_Create_Call) RunAndReturn(run func(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_Create_Call {
+func (_c *MockNotificationDeliveryRepository_Acknowledge_Call) RunAndReturn(run func(ctx context.Context, id int64, at time.Time) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_Acknowledge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) Delete(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// ClaimDue provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, now, limit, lease)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for ClaimDue")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 []*domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, time.Duration) ([]*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, now, limit, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int, time.Duration) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, now, limit, lease)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int, time.Duration) error); ok {
+		r1 = returnFunc(ctx, now, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockUserFollowedStreamerRepository_Delete_Call struct {
+// MockNotificationDeliveryRepository_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type MockNotificationDeliveryRepository_ClaimDue_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// ClaimDue is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockUserFollowedStreamerRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockUserFollowedStreamerRepository_Delete_Call {
-	return &MockUserFollowedStreamerRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - now time.Time
+//   - limit int
+//   - lease time.Duration
+func (_e *MockNotificationDeliveryRepository_Expecter) ClaimDue(ctx interface{}, now interface{}, limit interface{}, lease interface{}) *MockNotificationDeliveryRepository_ClaimDue_Call {
+	return &MockNotificationDeliveryRepository_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, limit, lease)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockUserFollowedStreamerRepository_Delete_Call {
+func (_c *MockNotificationDeliveryRepository_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, limit int, lease time.Duration)) *MockNotificationDeliveryRepository_ClaimDue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_Delete_Call) Return(err error) *MockUserFollowedStreamerRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockNotificationDeliveryRepository_ClaimDue_Call) Return(notificationDeliverys []*domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_ClaimDue_Call {
+	_c.Call.Return(notificationDeliverys, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockUserFollowedStreamerRepository_Delete_Call {
+func (_c *MockNotificationDeliveryRepository_ClaimDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_ClaimDue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExistByUserAndStreamer provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) ExistByUserAndStreamer(ctx context.Context, userID int64, streamerID int64) (bool, error) {
-	ret := _mock.Called(ctx, userID, streamerID)
+// CountSentByChannelSince provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) CountSentByChannelSince(ctx context.Context, channelID int64, since time.Time) (int, error) {
+	ret := _mock.Called(ctx, channelID, since)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByUserAndStreamer")
+		panic("no return value specified for CountSentByChannelSince")
 	}
 
-	var r0 bool
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
-		return returnFunc(ctx, userID, streamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (int, error)); ok {
+		return returnFunc(ctx, channelID, since)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
-		r0 = returnFunc(ctx, userID, streamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) int); ok {
+		r0 = returnFunc(ctx, channelID, since)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = returnFunc(ctx, userID, streamerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = returnFunc(ctx, channelID, since)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByUserAndStreamer'
-type MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call struct {
+// MockNotificationDeliveryRepository_CountSentByChannelSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSentByChannelSince'
+type MockNotificationDeliveryRepository_CountSentByChannelSince_Call struct {
 	*mock.Call
 }
 
-// ExistByUserAndStreamer is a helper method to define mock.On call
+// CountSentByChannelSince is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - streamerID int64
-func (_e *MockUserFollowedStreamerRepository_Expecter) ExistByUserAndStreamer(ctx interface{}, userID interface{}, streamerID interface{}) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
-	return &MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call{Call: _e.mock.On("ExistByUserAndStreamer", ctx, userID, streamerID)}
+//   - channelID int64
+//   - since time.Time
+func (_e *MockNotificationDeliveryRepository_Expecter) CountSentByChannelSince(ctx interface{}, channelID interface{}, since interface{}) *MockNotificationDeliveryRepository_CountSentByChannelSince_Call {
+	return &MockNotificationDeliveryRepository_CountSentByChannelSince_Call{Call: _e.mock.On("CountSentByChannelSince", ctx, channelID, since)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) Run(run func(ctx context.Context, userID int64, streamerID int64)) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
+func (_c *MockNotificationDeliveryRepository_CountSentByChannelSince_Call) Run(run func(ctx context.Context, channelID int64, since time.Time)) *MockNotificationDeliveryRepository_CountSentByChannelSince_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1808,9 +1839,9 @@ func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) Run(ru
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 int64
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(int64)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
@@ -1821,57 +1852,56 @@ func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) Run(ru
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) Return(b bool, err error) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
-	_c.Call.Return(b, err)
+func (_c *MockNotificationDeliveryRepository_CountSentByChannelSince_Call) Return(n int, err error) *MockNotificationDeliveryRepository_CountSentByChannelSince_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) RunAndReturn(run func(ctx context.Context, userID int64, streamerID int64) (bool, error)) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
+func (_c *MockNotificationDeliveryRepository_CountSentByChannelSince_Call) RunAndReturn(run func(ctx context.Context, channelID int64, since time.Time) (int, error)) *MockNotificationDeliveryRepository_CountSentByChannelSince_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) FindById(ctx context.Context, id int64) (*domain.UserFollowedStreamer, error) {
-	ret := _mock.Called(ctx, id)
+// CountSentByUserSince provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) CountSentByUserSince(ctx context.Context, userID int64, since time.Time) (int, error) {
+	ret := _mock.Called(ctx, userID, since)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindById")
+		panic("no return value specified for CountSentByUserSince")
 	}
 
-	var r0 *domain.UserFollowedStreamer
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.UserFollowedStreamer, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) (int, error)); ok {
+		return returnFunc(ctx, userID, since)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.UserFollowedStreamer); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, time.Time) int); ok {
+		r0 = returnFunc(ctx, userID, since)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, since)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockUserFollowedStreamerRepository_FindById_Call struct {
+// MockNotificationDeliveryRepository_CountSentByUserSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSentByUserSince'
+type MockNotificationDeliveryRepository_CountSentByUserSince_Call struct {
 	*mock.Call
 }
 
-// FindById is a helper method to define mock.On call
+// CountSentByUserSince is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockUserFollowedStreamerRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockUserFollowedStreamerRepository_FindById_Call {
-	return &MockUserFollowedStreamerRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+//   - userID int64
+//   - since time.Time
+func (_e *MockNotificationDeliveryRepository_Expecter) CountSentByUserSince(ctx interface{}, userID interface{}, since interface{}) *MockNotificationDeliveryRepository_CountSentByUserSince_Call {
+	return &MockNotificationDeliveryRepository_CountSentByUserSince_Call{Call: _e.mock.On("CountSentByUserSince", ctx, userID, since)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockUserFollowedStreamerRepository_FindById_Call {
+func (_c *MockNotificationDeliveryRepository_CountSentByUserSince_Call) Run(run func(ctx context.Context, userID int64, since time.Time)) *MockNotificationDeliveryRepository_CountSentByUserSince_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1881,292 +1911,3380 @@ func (_c *MockUserFollowedStreamerRepository_FindById_Call) Run(run func(ctx con
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_FindById_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_FindById_Call {
-	_c.Call.Return(userFollowedStreamer, err)
+func (_c *MockNotificationDeliveryRepository_CountSentByUserSince_Call) Return(n int, err error) *MockNotificationDeliveryRepository_CountSentByUserSince_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_FindById_Call {
+func (_c *MockNotificationDeliveryRepository_CountSentByUserSince_Call) RunAndReturn(run func(ctx context.Context, userID int64, since time.Time) (int, error)) *MockNotificationDeliveryRepository_CountSentByUserSince_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByUserAndStreamer provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) FindByUserAndStreamer(ctx context.Context, userID int64, streamerID int64) (*domain.UserFollowedStreamer, error) {
-	ret := _mock.Called(ctx, userID, streamerID)
+// Create provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) Create(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, delivery)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByUserAndStreamer")
+		panic("no return value specified for Create")
 	}
 
-	var r0 *domain.UserFollowedStreamer
+	var r0 *domain.NotificationDelivery
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*domain.UserFollowedStreamer, error)); ok {
-		return returnFunc(ctx, userID, streamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, delivery)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *domain.UserFollowedStreamer); ok {
-		r0 = returnFunc(ctx, userID, streamerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, delivery)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = returnFunc(ctx, userID, streamerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDelivery) error); ok {
+		r1 = returnFunc(ctx, delivery)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserAndStreamer'
-type MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call struct {
+// MockNotificationDeliveryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockNotificationDeliveryRepository_Create_Call struct {
 	*mock.Call
 }
 
-// FindByUserAndStreamer is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - streamerID int64
-func (_e *MockUserFollowedStreamerRepository_Expecter) FindByUserAndStreamer(ctx interface{}, userID interface{}, streamerID interface{}) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
-	return &MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call{Call: _e.mock.On("FindByUserAndStreamer", ctx, userID, streamerID)}
+//   - delivery *domain.NotificationDelivery
+func (_e *MockNotificationDeliveryRepository_Expecter) Create(ctx interface{}, delivery interface{}) *MockNotificationDeliveryRepository_Create_Call {
+	return &MockNotificationDeliveryRepository_Create_Call{Call: _e.mock.On("Create", ctx, delivery)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call) Run(run func(ctx context.Context, userID int64, streamerID int64)) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
+func (_c *MockNotificationDeliveryRepository_Create_Call) Run(run func(ctx context.Context, delivery *domain.NotificationDelivery)) *MockNotificationDeliveryRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 *domain.NotificationDelivery
 		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		var arg2 int64
-		if args[2] != nil {
-			arg2 = args[2].(int64)
+			arg1 = args[1].(*domain.NotificationDelivery)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
-	_c.Call.Return(userFollowedStreamer, err)
+func (_c *MockNotificationDeliveryRepository_Create_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_Create_Call {
+	_c.Call.Return(notificationDelivery, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call) RunAndReturn(run func(ctx context.Context, userID int64, streamerID int64) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
+func (_c *MockNotificationDeliveryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByStreamerId provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) ListByStreamerId(ctx context.Context, streamerID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error) {
-	ret := _mock.Called(ctx, streamerID, offset, limit)
+// CreateBatch provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) CreateBatch(ctx context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, deliveries)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByStreamerId")
+		panic("no return value specified for CreateBatch")
 	}
 
-	var r0 []*domain.UserFollowedStreamer
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.UserFollowedStreamer, int, error)); ok {
-		return returnFunc(ctx, streamerID, offset, limit)
+	var r0 []*domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, deliveries)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.UserFollowedStreamer); ok {
-		r0 = returnFunc(ctx, streamerID, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*domain.NotificationDelivery) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, deliveries)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.UserFollowedStreamer)
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
-		r1 = returnFunc(ctx, streamerID, offset, limit)
-	} else {
-		r1 = ret.Get(1).(int)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = returnFunc(ctx, streamerID, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*domain.NotificationDelivery) error); ok {
+		r1 = returnFunc(ctx, deliveries)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_ListByStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByStreamerId'
-type MockUserFollowedStreamerRepository_ListByStreamerId_Call struct {
+// MockNotificationDeliveryRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockNotificationDeliveryRepository_CreateBatch_Call struct {
 	*mock.Call
 }
 
-// ListByStreamerId is a helper method to define mock.On call
+// CreateBatch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - streamerID int64
-//   - offset int
-//   - limit int
-func (_e *MockUserFollowedStreamerRepository_Expecter) ListByStreamerId(ctx interface{}, streamerID interface{}, offset interface{}, limit interface{}) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
-	return &MockUserFollowedStreamerRepository_ListByStreamerId_Call{Call: _e.mock.On("ListByStreamerId", ctx, streamerID, offset, limit)}
+//   - deliveries []*domain.NotificationDelivery
+func (_e *MockNotificationDeliveryRepository_Expecter) CreateBatch(ctx interface{}, deliveries interface{}) *MockNotificationDeliveryRepository_CreateBatch_Call {
+	return &MockNotificationDeliveryRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, deliveries)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_ListByStreamerId_Call) Run(run func(ctx context.Context, streamerID int64, offset int, limit int)) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
+func (_c *MockNotificationDeliveryRepository_CreateBatch_Call) Run(run func(ctx context.Context, deliveries []*domain.NotificationDelivery)) *MockNotificationDeliveryRepository_CreateBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 []*domain.NotificationDelivery
 		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg1 = args[1].([]*domain.NotificationDelivery)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_ListByStreamerId_Call) Return(userFollowedStreamers []*domain.UserFollowedStreamer, n int, err error) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
-	_c.Call.Return(userFollowedStreamers, n, err)
+func (_c *MockNotificationDeliveryRepository_CreateBatch_Call) Return(notificationDeliverys []*domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_CreateBatch_Call {
+	_c.Call.Return(notificationDeliverys, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_ListByStreamerId_Call) RunAndReturn(run func(ctx context.Context, streamerID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error)) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
+func (_c *MockNotificationDeliveryRepository_CreateBatch_Call) RunAndReturn(run func(ctx context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_CreateBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByUserId provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) ListByUserId(ctx context.Context, userID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error) {
-	ret := _mock.Called(ctx, userID, offset, limit)
+// CreateDigest provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) CreateDigest(ctx context.Context, digest *domain.NotificationDelivery, batchedIDs []int64) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, digest, batchedIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByUserId")
+		panic("no return value specified for CreateDigest")
 	}
 
-	var r0 []*domain.UserFollowedStreamer
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.UserFollowedStreamer, int, error)); ok {
-		return returnFunc(ctx, userID, offset, limit)
+	var r0 *domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery, []int64) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, digest, batchedIDs)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.UserFollowedStreamer); ok {
-		r0 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery, []int64) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, digest, batchedIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.UserFollowedStreamer)
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
-		r1 = returnFunc(ctx, userID, offset, limit)
-	} else {
-		r1 = ret.Get(1).(int)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = returnFunc(ctx, userID, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDelivery, []int64) error); ok {
+		r1 = returnFunc(ctx, digest, batchedIDs)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockUserFollowedStreamerRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
-type MockUserFollowedStreamerRepository_ListByUserId_Call struct {
+// MockNotificationDeliveryRepository_CreateDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDigest'
+type MockNotificationDeliveryRepository_CreateDigest_Call struct {
 	*mock.Call
 }
 
-// ListByUserId is a helper method to define mock.On call
+// CreateDigest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - offset int
-//   - limit int
-func (_e *MockUserFollowedStreamerRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *MockUserFollowedStreamerRepository_ListByUserId_Call {
-	return &MockUserFollowedStreamerRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, offset, limit)}
+//   - digest *domain.NotificationDelivery
+//   - batchedIDs []int64
+func (_e *MockNotificationDeliveryRepository_Expecter) CreateDigest(ctx interface{}, digest interface{}, batchedIDs interface{}) *MockNotificationDeliveryRepository_CreateDigest_Call {
+	return &MockNotificationDeliveryRepository_CreateDigest_Call{Call: _e.mock.On("CreateDigest", ctx, digest, batchedIDs)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, offset int, limit int)) *MockUserFollowedStreamerRepository_ListByUserId_Call {
+func (_c *MockNotificationDeliveryRepository_CreateDigest_Call) Run(run func(ctx context.Context, digest *domain.NotificationDelivery, batchedIDs []int64)) *MockNotificationDeliveryRepository_CreateDigest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 *domain.NotificationDelivery
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(*domain.NotificationDelivery)
 		}
-		var arg2 int
+		var arg2 []int64
 		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg2 = args[2].([]int64)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_ListByUserId_Call) Return(userFollowedStreamers []*domain.UserFollowedStreamer, n int, err error) *MockUserFollowedStreamerRepository_ListByUserId_Call {
-	_c.Call.Return(userFollowedStreamers, n, err)
+func (_c *MockNotificationDeliveryRepository_CreateDigest_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_CreateDigest_Call {
+	_c.Call.Return(notificationDelivery, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error)) *MockUserFollowedStreamerRepository_ListByUserId_Call {
+func (_c *MockNotificationDeliveryRepository_CreateDigest_Call) RunAndReturn(run func(ctx context.Context, digest *domain.NotificationDelivery, batchedIDs []int64) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_CreateDigest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockUserFollowedStreamerRepository
-func (_mock *MockUserFollowedStreamerRepository) Update(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error) {
-	ret := _mock.Called(ctx, follow)
+// DeleteCreatedBefore provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) (int, error) {
+	ret := _mock.Called(ctx, before)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for DeleteCreatedBefore")
 	}
 
-	var r0 *domain.UserFollowedStreamer
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)); ok {
-		return returnFunc(ctx, follow)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return returnFunc(ctx, before)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) *domain.UserFollowedStreamer); ok {
-		r0 = returnFunc(ctx, follow)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = returnFunc(ctx, before)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserFollowedStreamer) error); ok {
-		r1 = returnFunc(ctx, follow)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_DeleteCreatedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCreatedBefore'
+type MockNotificationDeliveryRepository_DeleteCreatedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteCreatedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockNotificationDeliveryRepository_Expecter) DeleteCreatedBefore(ctx interface{}, before interface{}) *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call {
+	return &MockNotificationDeliveryRepository_DeleteCreatedBefore_Call{Call: _e.mock.On("DeleteCreatedBefore", ctx, before)}
+}
+
+func (_c *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call) Return(n int, err error) *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (int, error)) *MockNotificationDeliveryRepository_DeleteCreatedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) FindById(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockNotificationDeliveryRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationDeliveryRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockNotificationDeliveryRepository_FindById_Call {
+	return &MockNotificationDeliveryRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockNotificationDeliveryRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationDeliveryRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_FindById_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_FindById_Call {
+	_c.Call.Return(notificationDelivery, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) List(ctx context.Context, filter *domain.NotificationDeliveryFilter, offset int, limit int) ([]*domain.NotificationDelivery, int, error) {
+	ret := _mock.Called(ctx, filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) ([]*domain.NotificationDelivery, int, error)); ok {
+		return returnFunc(ctx, filter, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) int); ok {
+		r1 = returnFunc(ctx, filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) error); ok {
+		r2 = returnFunc(ctx, filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationDeliveryRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockNotificationDeliveryRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.NotificationDeliveryFilter
+//   - offset int
+//   - limit int
+func (_e *MockNotificationDeliveryRepository_Expecter) List(ctx interface{}, filter interface{}, offset interface{}, limit interface{}) *MockNotificationDeliveryRepository_List_Call {
+	return &MockNotificationDeliveryRepository_List_Call{Call: _e.mock.On("List", ctx, filter, offset, limit)}
+}
+
+func (_c *MockNotificationDeliveryRepository_List_Call) Run(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter, offset int, limit int)) *MockNotificationDeliveryRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationDeliveryFilter)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_List_Call) Return(notificationDeliverys []*domain.NotificationDelivery, n int, err error) *MockNotificationDeliveryRepository_List_Call {
+	_c.Call.Return(notificationDeliverys, n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter, offset int, limit int) ([]*domain.NotificationDelivery, int, error)) *MockNotificationDeliveryRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBatched provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) ListBatched(ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBatched")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_ListBatched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBatched'
+type MockNotificationDeliveryRepository_ListBatched_Call struct {
+	*mock.Call
+}
+
+// ListBatched is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDeliveryRepository_Expecter) ListBatched(ctx interface{}) *MockNotificationDeliveryRepository_ListBatched_Call {
+	return &MockNotificationDeliveryRepository_ListBatched_Call{Call: _e.mock.On("ListBatched", ctx)}
+}
+
+func (_c *MockNotificationDeliveryRepository_ListBatched_Call) Run(run func(ctx context.Context)) *MockNotificationDeliveryRepository_ListBatched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_ListBatched_Call) Return(notificationDeliverys []*domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_ListBatched_Call {
+	_c.Call.Return(notificationDeliverys, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_ListBatched_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_ListBatched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserId provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) ListByUserId(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, offset int, limit int) ([]*domain.NotificationDelivery, int, error) {
+	ret := _mock.Called(ctx, userID, filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) ([]*domain.NotificationDelivery, int, error)); ok {
+		return returnFunc(ctx, userID, filter, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, userID, filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationDeliveryRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockNotificationDeliveryRepository_ListByUserId_Call struct {
+	*mock.Call
+}
+
+// ListByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - filter *domain.NotificationDeliveryFilter
+//   - offset int
+//   - limit int
+func (_e *MockNotificationDeliveryRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, filter interface{}, offset interface{}, limit interface{}) *MockNotificationDeliveryRepository_ListByUserId_Call {
+	return &MockNotificationDeliveryRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, filter, offset, limit)}
+}
+
+func (_c *MockNotificationDeliveryRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, offset int, limit int)) *MockNotificationDeliveryRepository_ListByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *domain.NotificationDeliveryFilter
+		if args[2] != nil {
+			arg2 = args[2].(*domain.NotificationDeliveryFilter)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_ListByUserId_Call) Return(notificationDeliverys []*domain.NotificationDelivery, n int, err error) *MockNotificationDeliveryRepository_ListByUserId_Call {
+	_c.Call.Return(notificationDeliverys, n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, offset int, limit int) ([]*domain.NotificationDelivery, int, error)) *MockNotificationDeliveryRepository_ListByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListThrottled provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) ListThrottled(ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListThrottled")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_ListThrottled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListThrottled'
+type MockNotificationDeliveryRepository_ListThrottled_Call struct {
+	*mock.Call
+}
+
+// ListThrottled is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDeliveryRepository_Expecter) ListThrottled(ctx interface{}) *MockNotificationDeliveryRepository_ListThrottled_Call {
+	return &MockNotificationDeliveryRepository_ListThrottled_Call{Call: _e.mock.On("ListThrottled", ctx)}
+}
+
+func (_c *MockNotificationDeliveryRepository_ListThrottled_Call) Run(run func(ctx context.Context)) *MockNotificationDeliveryRepository_ListThrottled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_ListThrottled_Call) Return(notificationDeliverys []*domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_ListThrottled_Call {
+	_c.Call.Return(notificationDeliverys, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_ListThrottled_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_ListThrottled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteRouteStep provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) PromoteRouteStep(ctx context.Context, routeID string, step int, at time.Time) (int, error) {
+	ret := _mock.Called(ctx, routeID, step, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteRouteStep")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, time.Time) (int, error)); ok {
+		return returnFunc(ctx, routeID, step, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, time.Time) int); ok {
+		r0 = returnFunc(ctx, routeID, step, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, time.Time) error); ok {
+		r1 = returnFunc(ctx, routeID, step, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_PromoteRouteStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteRouteStep'
+type MockNotificationDeliveryRepository_PromoteRouteStep_Call struct {
+	*mock.Call
+}
+
+// PromoteRouteStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - routeID string
+//   - step int
+//   - at time.Time
+func (_e *MockNotificationDeliveryRepository_Expecter) PromoteRouteStep(ctx interface{}, routeID interface{}, step interface{}, at interface{}) *MockNotificationDeliveryRepository_PromoteRouteStep_Call {
+	return &MockNotificationDeliveryRepository_PromoteRouteStep_Call{Call: _e.mock.On("PromoteRouteStep", ctx, routeID, step, at)}
+}
+
+func (_c *MockNotificationDeliveryRepository_PromoteRouteStep_Call) Run(run func(ctx context.Context, routeID string, step int, at time.Time)) *MockNotificationDeliveryRepository_PromoteRouteStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_PromoteRouteStep_Call) Return(n int, err error) *MockNotificationDeliveryRepository_PromoteRouteStep_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_PromoteRouteStep_Call) RunAndReturn(run func(ctx context.Context, routeID string, step int, at time.Time) (int, error)) *MockNotificationDeliveryRepository_PromoteRouteStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueDead provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) RequeueDead(ctx context.Context, filter *domain.NotificationDeliveryFilter, now time.Time) (int, error) {
+	ret := _mock.Called(ctx, filter, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueDead")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter, time.Time) (int, error)); ok {
+		return returnFunc(ctx, filter, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter, time.Time) int); ok {
+		r0 = returnFunc(ctx, filter, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDeliveryFilter, time.Time) error); ok {
+		r1 = returnFunc(ctx, filter, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_RequeueDead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueDead'
+type MockNotificationDeliveryRepository_RequeueDead_Call struct {
+	*mock.Call
+}
+
+// RequeueDead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.NotificationDeliveryFilter
+//   - now time.Time
+func (_e *MockNotificationDeliveryRepository_Expecter) RequeueDead(ctx interface{}, filter interface{}, now interface{}) *MockNotificationDeliveryRepository_RequeueDead_Call {
+	return &MockNotificationDeliveryRepository_RequeueDead_Call{Call: _e.mock.On("RequeueDead", ctx, filter, now)}
+}
+
+func (_c *MockNotificationDeliveryRepository_RequeueDead_Call) Run(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter, now time.Time)) *MockNotificationDeliveryRepository_RequeueDead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationDeliveryFilter)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_RequeueDead_Call) Return(n int, err error) *MockNotificationDeliveryRepository_RequeueDead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_RequeueDead_Call) RunAndReturn(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter, now time.Time) (int, error)) *MockNotificationDeliveryRepository_RequeueDead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SkipRoute provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) SkipRoute(ctx context.Context, routeID string, afterStep int) (int, error) {
+	ret := _mock.Called(ctx, routeID, afterStep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipRoute")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (int, error)); ok {
+		return returnFunc(ctx, routeID, afterStep)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) int); ok {
+		r0 = returnFunc(ctx, routeID, afterStep)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, routeID, afterStep)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_SkipRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkipRoute'
+type MockNotificationDeliveryRepository_SkipRoute_Call struct {
+	*mock.Call
+}
+
+// SkipRoute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - routeID string
+//   - afterStep int
+func (_e *MockNotificationDeliveryRepository_Expecter) SkipRoute(ctx interface{}, routeID interface{}, afterStep interface{}) *MockNotificationDeliveryRepository_SkipRoute_Call {
+	return &MockNotificationDeliveryRepository_SkipRoute_Call{Call: _e.mock.On("SkipRoute", ctx, routeID, afterStep)}
+}
+
+func (_c *MockNotificationDeliveryRepository_SkipRoute_Call) Run(run func(ctx context.Context, routeID string, afterStep int)) *MockNotificationDeliveryRepository_SkipRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_SkipRoute_Call) Return(n int, err error) *MockNotificationDeliveryRepository_SkipRoute_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_SkipRoute_Call) RunAndReturn(run func(ctx context.Context, routeID string, afterStep int) (int, error)) *MockNotificationDeliveryRepository_SkipRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockNotificationDeliveryRepository
+func (_mock *MockNotificationDeliveryRepository) Update(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDelivery) error); ok {
+		r1 = returnFunc(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockNotificationDeliveryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *domain.NotificationDelivery
+func (_e *MockNotificationDeliveryRepository_Expecter) Update(ctx interface{}, delivery interface{}) *MockNotificationDeliveryRepository_Update_Call {
+	return &MockNotificationDeliveryRepository_Update_Call{Call: _e.mock.On("Update", ctx, delivery)}
+}
+
+func (_c *MockNotificationDeliveryRepository_Update_Call) Run(run func(ctx context.Context, delivery *domain.NotificationDelivery)) *MockNotificationDeliveryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_Update_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryRepository_Update_Call {
+	_c.Call.Return(notificationDelivery, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryRepository_Update_Call) RunAndReturn(run func(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationPreferenceRepository creates a new instance of MockNotificationPreferenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationPreferenceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationPreferenceRepository {
+	mock := &MockNotificationPreferenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationPreferenceRepository is an autogenerated mock type for the NotificationPreferenceRepository type
+type MockNotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+type MockNotificationPreferenceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationPreferenceRepository) EXPECT() *MockNotificationPreferenceRepository_Expecter {
+	return &MockNotificationPreferenceRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockNotificationPreferenceRepository
+func (_mock *MockNotificationPreferenceRepository) Create(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+	ret := _mock.Called(ctx, preference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.NotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationPreference) (*domain.NotificationPreference, error)); ok {
+		return returnFunc(ctx, preference)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationPreference) *domain.NotificationPreference); ok {
+		r0 = returnFunc(ctx, preference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationPreference) error); ok {
+		r1 = returnFunc(ctx, preference)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockNotificationPreferenceRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preference *domain.NotificationPreference
+func (_e *MockNotificationPreferenceRepository_Expecter) Create(ctx interface{}, preference interface{}) *MockNotificationPreferenceRepository_Create_Call {
+	return &MockNotificationPreferenceRepository_Create_Call{Call: _e.mock.On("Create", ctx, preference)}
+}
+
+func (_c *MockNotificationPreferenceRepository_Create_Call) Run(run func(ctx context.Context, preference *domain.NotificationPreference)) *MockNotificationPreferenceRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationPreference
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationPreference)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_Create_Call) Return(notificationPreference *domain.NotificationPreference, err error) *MockNotificationPreferenceRepository_Create_Call {
+	_c.Call.Return(notificationPreference, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_Create_Call) RunAndReturn(run func(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error)) *MockNotificationPreferenceRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockNotificationPreferenceRepository
+func (_mock *MockNotificationPreferenceRepository) FindByUserId(ctx context.Context, userID int64) (*domain.NotificationPreference, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 *domain.NotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationPreference, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationPreference); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockNotificationPreferenceRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockNotificationPreferenceRepository_Expecter) FindByUserId(ctx interface{}, userID interface{}) *MockNotificationPreferenceRepository_FindByUserId_Call {
+	return &MockNotificationPreferenceRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userID)}
+}
+
+func (_c *MockNotificationPreferenceRepository_FindByUserId_Call) Run(run func(ctx context.Context, userID int64)) *MockNotificationPreferenceRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_FindByUserId_Call) Return(notificationPreference *domain.NotificationPreference, err error) *MockNotificationPreferenceRepository_FindByUserId_Call {
+	_c.Call.Return(notificationPreference, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64) (*domain.NotificationPreference, error)) *MockNotificationPreferenceRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockNotificationPreferenceRepository
+func (_mock *MockNotificationPreferenceRepository) Update(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+	ret := _mock.Called(ctx, preference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.NotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationPreference) (*domain.NotificationPreference, error)); ok {
+		return returnFunc(ctx, preference)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationPreference) *domain.NotificationPreference); ok {
+		r0 = returnFunc(ctx, preference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationPreference) error); ok {
+		r1 = returnFunc(ctx, preference)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockNotificationPreferenceRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preference *domain.NotificationPreference
+func (_e *MockNotificationPreferenceRepository_Expecter) Update(ctx interface{}, preference interface{}) *MockNotificationPreferenceRepository_Update_Call {
+	return &MockNotificationPreferenceRepository_Update_Call{Call: _e.mock.On("Update", ctx, preference)}
+}
+
+func (_c *MockNotificationPreferenceRepository_Update_Call) Run(run func(ctx context.Context, preference *domain.NotificationPreference)) *MockNotificationPreferenceRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationPreference
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationPreference)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_Update_Call) Return(notificationPreference *domain.NotificationPreference, err error) *MockNotificationPreferenceRepository_Update_Call {
+	_c.Call.Return(notificationPreference, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceRepository_Update_Call) RunAndReturn(run func(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error)) *MockNotificationPreferenceRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreamSessionRepository creates a new instance of MockStreamSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamSessionRepository {
+	mock := &MockStreamSessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStreamSessionRepository is an autogenerated mock type for the StreamSessionRepository type
+type MockStreamSessionRepository struct {
+	mock.Mock
+}
+
+type MockStreamSessionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreamSessionRepository) EXPECT() *MockStreamSessionRepository_Expecter {
+	return &MockStreamSessionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockStreamSessionRepository
+func (_mock *MockStreamSessionRepository) Create(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error) {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.StreamSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamSession) (*domain.StreamSession, error)); ok {
+		return returnFunc(ctx, session)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamSession) *domain.StreamSession); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.StreamSession) error); ok {
+		r1 = returnFunc(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamSessionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockStreamSessionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *domain.StreamSession
+func (_e *MockStreamSessionRepository_Expecter) Create(ctx interface{}, session interface{}) *MockStreamSessionRepository_Create_Call {
+	return &MockStreamSessionRepository_Create_Call{Call: _e.mock.On("Create", ctx, session)}
+}
+
+func (_c *MockStreamSessionRepository_Create_Call) Run(run func(ctx context.Context, session *domain.StreamSession)) *MockStreamSessionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.StreamSession
+		if args[1] != nil {
+			arg1 = args[1].(*domain.StreamSession)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_Create_Call) Return(streamSession *domain.StreamSession, err error) *MockStreamSessionRepository_Create_Call {
+	_c.Call.Return(streamSession, err)
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error)) *MockStreamSessionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindOpenByStreamerId provides a mock function for the type MockStreamSessionRepository
+func (_mock *MockStreamSessionRepository) FindOpenByStreamerId(ctx context.Context, streamerID int64) (*domain.StreamSession, error) {
+	ret := _mock.Called(ctx, streamerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOpenByStreamerId")
+	}
+
+	var r0 *domain.StreamSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.StreamSession, error)); ok {
+		return returnFunc(ctx, streamerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.StreamSession); ok {
+		r0 = returnFunc(ctx, streamerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, streamerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamSessionRepository_FindOpenByStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOpenByStreamerId'
+type MockStreamSessionRepository_FindOpenByStreamerId_Call struct {
+	*mock.Call
+}
+
+// FindOpenByStreamerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - streamerID int64
+func (_e *MockStreamSessionRepository_Expecter) FindOpenByStreamerId(ctx interface{}, streamerID interface{}) *MockStreamSessionRepository_FindOpenByStreamerId_Call {
+	return &MockStreamSessionRepository_FindOpenByStreamerId_Call{Call: _e.mock.On("FindOpenByStreamerId", ctx, streamerID)}
+}
+
+func (_c *MockStreamSessionRepository_FindOpenByStreamerId_Call) Run(run func(ctx context.Context, streamerID int64)) *MockStreamSessionRepository_FindOpenByStreamerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_FindOpenByStreamerId_Call) Return(streamSession *domain.StreamSession, err error) *MockStreamSessionRepository_FindOpenByStreamerId_Call {
+	_c.Call.Return(streamSession, err)
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_FindOpenByStreamerId_Call) RunAndReturn(run func(ctx context.Context, streamerID int64) (*domain.StreamSession, error)) *MockStreamSessionRepository_FindOpenByStreamerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByStreamerIds provides a mock function for the type MockStreamSessionRepository
+func (_mock *MockStreamSessionRepository) ListByStreamerIds(ctx context.Context, streamerIDs []int64, from time.Time, to time.Time) ([]*domain.StreamSession, error) {
+	ret := _mock.Called(ctx, streamerIDs, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStreamerIds")
+	}
+
+	var r0 []*domain.StreamSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) ([]*domain.StreamSession, error)); ok {
+		return returnFunc(ctx, streamerIDs, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64, time.Time, time.Time) []*domain.StreamSession); ok {
+		r0 = returnFunc(ctx, streamerIDs, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.StreamSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, streamerIDs, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamSessionRepository_ListByStreamerIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByStreamerIds'
+type MockStreamSessionRepository_ListByStreamerIds_Call struct {
+	*mock.Call
+}
+
+// ListByStreamerIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - streamerIDs []int64
+//   - from time.Time
+//   - to time.Time
+func (_e *MockStreamSessionRepository_Expecter) ListByStreamerIds(ctx interface{}, streamerIDs interface{}, from interface{}, to interface{}) *MockStreamSessionRepository_ListByStreamerIds_Call {
+	return &MockStreamSessionRepository_ListByStreamerIds_Call{Call: _e.mock.On("ListByStreamerIds", ctx, streamerIDs, from, to)}
+}
+
+func (_c *MockStreamSessionRepository_ListByStreamerIds_Call) Run(run func(ctx context.Context, streamerIDs []int64, from time.Time, to time.Time)) *MockStreamSessionRepository_ListByStreamerIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_ListByStreamerIds_Call) Return(streamSessions []*domain.StreamSession, err error) *MockStreamSessionRepository_ListByStreamerIds_Call {
+	_c.Call.Return(streamSessions, err)
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_ListByStreamerIds_Call) RunAndReturn(run func(ctx context.Context, streamerIDs []int64, from time.Time, to time.Time) ([]*domain.StreamSession, error)) *MockStreamSessionRepository_ListByStreamerIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockStreamSessionRepository
+func (_mock *MockStreamSessionRepository) Update(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error) {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.StreamSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamSession) (*domain.StreamSession, error)); ok {
+		return returnFunc(ctx, session)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamSession) *domain.StreamSession); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.StreamSession) error); ok {
+		r1 = returnFunc(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamSessionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockStreamSessionRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *domain.StreamSession
+func (_e *MockStreamSessionRepository_Expecter) Update(ctx interface{}, session interface{}) *MockStreamSessionRepository_Update_Call {
+	return &MockStreamSessionRepository_Update_Call{Call: _e.mock.On("Update", ctx, session)}
+}
+
+func (_c *MockStreamSessionRepository_Update_Call) Run(run func(ctx context.Context, session *domain.StreamSession)) *MockStreamSessionRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.StreamSession
+		if args[1] != nil {
+			arg1 = args[1].(*domain.StreamSession)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_Update_Call) Return(streamSession *domain.StreamSession, err error) *MockStreamSessionRepository_Update_Call {
+	_c.Call.Return(streamSession, err)
+	return _c
+}
+
+func (_c *MockStreamSessionRepository_Update_Call) RunAndReturn(run func(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error)) *MockStreamSessionRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreamerRepository creates a new instance of MockStreamerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamerRepository {
+	mock := &MockStreamerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStreamerRepository is an autogenerated mock type for the StreamerRepository type
+type MockStreamerRepository struct {
+	mock.Mock
+}
+
+type MockStreamerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreamerRepository) EXPECT() *MockStreamerRepository_Expecter {
+	return &MockStreamerRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) Create(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error) {
+	ret := _mock.Called(ctx, streamer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Streamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) (*domain.Streamer, error)); ok {
+		return returnFunc(ctx, streamer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) *domain.Streamer); ok {
+		r0 = returnFunc(ctx, streamer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Streamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Streamer) error); ok {
+		r1 = returnFunc(ctx, streamer)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamerRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockStreamerRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - streamer *domain.Streamer
+func (_e *MockStreamerRepository_Expecter) Create(ctx interface{}, streamer interface{}) *MockStreamerRepository_Create_Call {
+	return &MockStreamerRepository_Create_Call{Call: _e.mock.On("Create", ctx, streamer)}
+}
+
+func (_c *MockStreamerRepository_Create_Call) Run(run func(ctx context.Context, streamer *domain.Streamer)) *MockStreamerRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Streamer
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Streamer)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_Create_Call) Return(streamer1 *domain.Streamer, err error) *MockStreamerRepository_Create_Call {
+	_c.Call.Return(streamer1, err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_Create_Call) RunAndReturn(run func(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error)) *MockStreamerRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStreamerRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockStreamerRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStreamerRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockStreamerRepository_Delete_Call {
+	return &MockStreamerRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockStreamerRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockStreamerRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_Delete_Call) Return(err error) *MockStreamerRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockStreamerRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistByPlatformStreamerId provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) ExistByPlatformStreamerId(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (bool, error) {
+	ret := _mock.Called(ctx, platformType, platformStreamerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistByPlatformStreamerId")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) (bool, error)); ok {
+		return returnFunc(ctx, platformType, platformStreamerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) bool); ok {
+		r0 = returnFunc(ctx, platformType, platformStreamerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType, string) error); ok {
+		r1 = returnFunc(ctx, platformType, platformStreamerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamerRepository_ExistByPlatformStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByPlatformStreamerId'
+type MockStreamerRepository_ExistByPlatformStreamerId_Call struct {
+	*mock.Call
+}
+
+// ExistByPlatformStreamerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platformType domain.StreamingPlatformType
+//   - platformStreamerID string
+func (_e *MockStreamerRepository_Expecter) ExistByPlatformStreamerId(ctx interface{}, platformType interface{}, platformStreamerID interface{}) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
+	return &MockStreamerRepository_ExistByPlatformStreamerId_Call{Call: _e.mock.On("ExistByPlatformStreamerId", ctx, platformType, platformStreamerID)}
+}
+
+func (_c *MockStreamerRepository_ExistByPlatformStreamerId_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string)) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StreamingPlatformType
+		if args[1] != nil {
+			arg1 = args[1].(domain.StreamingPlatformType)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_ExistByPlatformStreamerId_Call) Return(b bool, err error) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_ExistByPlatformStreamerId_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (bool, error)) *MockStreamerRepository_ExistByPlatformStreamerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) FindById(ctx context.Context, id int64) (*domain.Streamer, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *domain.Streamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.Streamer, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.Streamer); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Streamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamerRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockStreamerRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStreamerRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockStreamerRepository_FindById_Call {
+	return &MockStreamerRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockStreamerRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockStreamerRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_FindById_Call) Return(streamer *domain.Streamer, err error) *MockStreamerRepository_FindById_Call {
+	_c.Call.Return(streamer, err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.Streamer, error)) *MockStreamerRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByPlatformStreamerId provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) FindByPlatformStreamerId(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (*domain.Streamer, error) {
+	ret := _mock.Called(ctx, platformType, platformStreamerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPlatformStreamerId")
+	}
+
+	var r0 *domain.Streamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) (*domain.Streamer, error)); ok {
+		return returnFunc(ctx, platformType, platformStreamerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType, string) *domain.Streamer); ok {
+		r0 = returnFunc(ctx, platformType, platformStreamerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Streamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType, string) error); ok {
+		r1 = returnFunc(ctx, platformType, platformStreamerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamerRepository_FindByPlatformStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPlatformStreamerId'
+type MockStreamerRepository_FindByPlatformStreamerId_Call struct {
+	*mock.Call
+}
+
+// FindByPlatformStreamerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platformType domain.StreamingPlatformType
+//   - platformStreamerID string
+func (_e *MockStreamerRepository_Expecter) FindByPlatformStreamerId(ctx interface{}, platformType interface{}, platformStreamerID interface{}) *MockStreamerRepository_FindByPlatformStreamerId_Call {
+	return &MockStreamerRepository_FindByPlatformStreamerId_Call{Call: _e.mock.On("FindByPlatformStreamerId", ctx, platformType, platformStreamerID)}
+}
+
+func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string)) *MockStreamerRepository_FindByPlatformStreamerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StreamingPlatformType
+		if args[1] != nil {
+			arg1 = args[1].(domain.StreamingPlatformType)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) Return(streamer *domain.Streamer, err error) *MockStreamerRepository_FindByPlatformStreamerId_Call {
+	_c.Call.Return(streamer, err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_FindByPlatformStreamerId_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType, platformStreamerID string) (*domain.Streamer, error)) *MockStreamerRepository_FindByPlatformStreamerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) List(ctx context.Context, offset int, limit int) ([]*domain.Streamer, int, error) {
+	ret := _mock.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Streamer
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.Streamer, int, error)); ok {
+		return returnFunc(ctx, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.Streamer); ok {
+		r0 = returnFunc(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Streamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = returnFunc(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockStreamerRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStreamerRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *MockStreamerRepository_Expecter) List(ctx interface{}, offset interface{}, limit interface{}) *MockStreamerRepository_List_Call {
+	return &MockStreamerRepository_List_Call{Call: _e.mock.On("List", ctx, offset, limit)}
+}
+
+func (_c *MockStreamerRepository_List_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockStreamerRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_List_Call) Return(streamers []*domain.Streamer, n int, err error) *MockStreamerRepository_List_Call {
+	_c.Call.Return(streamers, n, err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_List_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.Streamer, int, error)) *MockStreamerRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockStreamerRepository
+func (_mock *MockStreamerRepository) Update(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error) {
+	ret := _mock.Called(ctx, streamer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.Streamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) (*domain.Streamer, error)); ok {
+		return returnFunc(ctx, streamer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Streamer) *domain.Streamer); ok {
+		r0 = returnFunc(ctx, streamer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Streamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Streamer) error); ok {
+		r1 = returnFunc(ctx, streamer)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamerRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockStreamerRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - streamer *domain.Streamer
+func (_e *MockStreamerRepository_Expecter) Update(ctx interface{}, streamer interface{}) *MockStreamerRepository_Update_Call {
+	return &MockStreamerRepository_Update_Call{Call: _e.mock.On("Update", ctx, streamer)}
+}
+
+func (_c *MockStreamerRepository_Update_Call) Run(run func(ctx context.Context, streamer *domain.Streamer)) *MockStreamerRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Streamer
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Streamer)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamerRepository_Update_Call) Return(streamer1 *domain.Streamer, err error) *MockStreamerRepository_Update_Call {
+	_c.Call.Return(streamer1, err)
+	return _c
+}
+
+func (_c *MockStreamerRepository_Update_Call) RunAndReturn(run func(ctx context.Context, streamer *domain.Streamer) (*domain.Streamer, error)) *MockStreamerRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreamingPlatformRepository creates a new instance of MockStreamingPlatformRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamingPlatformRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamingPlatformRepository {
+	mock := &MockStreamingPlatformRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStreamingPlatformRepository is an autogenerated mock type for the StreamingPlatformRepository type
+type MockStreamingPlatformRepository struct {
+	mock.Mock
+}
+
+type MockStreamingPlatformRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreamingPlatformRepository) EXPECT() *MockStreamingPlatformRepository_Expecter {
+	return &MockStreamingPlatformRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) Create(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error) {
+	ret := _mock.Called(ctx, platform)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.StreamingPlatform
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) (*domain.StreamingPlatform, error)); ok {
+		return returnFunc(ctx, platform)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) *domain.StreamingPlatform); ok {
+		r0 = returnFunc(ctx, platform)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamingPlatform)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.StreamingPlatform) error); ok {
+		r1 = returnFunc(ctx, platform)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockStreamingPlatformRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform *domain.StreamingPlatform
+func (_e *MockStreamingPlatformRepository_Expecter) Create(ctx interface{}, platform interface{}) *MockStreamingPlatformRepository_Create_Call {
+	return &MockStreamingPlatformRepository_Create_Call{Call: _e.mock.On("Create", ctx, platform)}
+}
+
+func (_c *MockStreamingPlatformRepository_Create_Call) Run(run func(ctx context.Context, platform *domain.StreamingPlatform)) *MockStreamingPlatformRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.StreamingPlatform
+		if args[1] != nil {
+			arg1 = args[1].(*domain.StreamingPlatform)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_Create_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_Create_Call {
+	_c.Call.Return(streamingPlatform, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_Create_Call) RunAndReturn(run func(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStreamingPlatformRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockStreamingPlatformRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStreamingPlatformRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockStreamingPlatformRepository_Delete_Call {
+	return &MockStreamingPlatformRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockStreamingPlatformRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockStreamingPlatformRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_Delete_Call) Return(err error) *MockStreamingPlatformRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockStreamingPlatformRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistByName provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) ExistByName(ctx context.Context, name string) (bool, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistByName")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_ExistByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByName'
+type MockStreamingPlatformRepository_ExistByName_Call struct {
+	*mock.Call
+}
+
+// ExistByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStreamingPlatformRepository_Expecter) ExistByName(ctx interface{}, name interface{}) *MockStreamingPlatformRepository_ExistByName_Call {
+	return &MockStreamingPlatformRepository_ExistByName_Call{Call: _e.mock.On("ExistByName", ctx, name)}
+}
+
+func (_c *MockStreamingPlatformRepository_ExistByName_Call) Run(run func(ctx context.Context, name string)) *MockStreamingPlatformRepository_ExistByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_ExistByName_Call) Return(b bool, err error) *MockStreamingPlatformRepository_ExistByName_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_ExistByName_Call) RunAndReturn(run func(ctx context.Context, name string) (bool, error)) *MockStreamingPlatformRepository_ExistByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistByType provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) ExistByType(ctx context.Context, platformType domain.StreamingPlatformType) (bool, error) {
+	ret := _mock.Called(ctx, platformType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistByType")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) (bool, error)); ok {
+		return returnFunc(ctx, platformType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) bool); ok {
+		r0 = returnFunc(ctx, platformType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType) error); ok {
+		r1 = returnFunc(ctx, platformType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_ExistByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByType'
+type MockStreamingPlatformRepository_ExistByType_Call struct {
+	*mock.Call
+}
+
+// ExistByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platformType domain.StreamingPlatformType
+func (_e *MockStreamingPlatformRepository_Expecter) ExistByType(ctx interface{}, platformType interface{}) *MockStreamingPlatformRepository_ExistByType_Call {
+	return &MockStreamingPlatformRepository_ExistByType_Call{Call: _e.mock.On("ExistByType", ctx, platformType)}
+}
+
+func (_c *MockStreamingPlatformRepository_ExistByType_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType)) *MockStreamingPlatformRepository_ExistByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StreamingPlatformType
+		if args[1] != nil {
+			arg1 = args[1].(domain.StreamingPlatformType)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_ExistByType_Call) Return(b bool, err error) *MockStreamingPlatformRepository_ExistByType_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_ExistByType_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType) (bool, error)) *MockStreamingPlatformRepository_ExistByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) FindById(ctx context.Context, id int64) (*domain.StreamingPlatform, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *domain.StreamingPlatform
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.StreamingPlatform, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.StreamingPlatform); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamingPlatform)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockStreamingPlatformRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStreamingPlatformRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockStreamingPlatformRepository_FindById_Call {
+	return &MockStreamingPlatformRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockStreamingPlatformRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockStreamingPlatformRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_FindById_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_FindById_Call {
+	_c.Call.Return(streamingPlatform, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByName provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) FindByName(ctx context.Context, name string) (*domain.StreamingPlatform, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 *domain.StreamingPlatform
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.StreamingPlatform, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.StreamingPlatform); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamingPlatform)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
+type MockStreamingPlatformRepository_FindByName_Call struct {
+	*mock.Call
+}
+
+// FindByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStreamingPlatformRepository_Expecter) FindByName(ctx interface{}, name interface{}) *MockStreamingPlatformRepository_FindByName_Call {
+	return &MockStreamingPlatformRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+}
+
+func (_c *MockStreamingPlatformRepository_FindByName_Call) Run(run func(ctx context.Context, name string)) *MockStreamingPlatformRepository_FindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_FindByName_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_FindByName_Call {
+	_c.Call.Return(streamingPlatform, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_FindByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_FindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByType provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) FindByType(ctx context.Context, platformType domain.StreamingPlatformType) (*domain.StreamingPlatform, error) {
+	ret := _mock.Called(ctx, platformType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByType")
+	}
+
+	var r0 *domain.StreamingPlatform
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) (*domain.StreamingPlatform, error)); ok {
+		return returnFunc(ctx, platformType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StreamingPlatformType) *domain.StreamingPlatform); ok {
+		r0 = returnFunc(ctx, platformType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamingPlatform)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StreamingPlatformType) error); ok {
+		r1 = returnFunc(ctx, platformType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_FindByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByType'
+type MockStreamingPlatformRepository_FindByType_Call struct {
+	*mock.Call
+}
+
+// FindByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platformType domain.StreamingPlatformType
+func (_e *MockStreamingPlatformRepository_Expecter) FindByType(ctx interface{}, platformType interface{}) *MockStreamingPlatformRepository_FindByType_Call {
+	return &MockStreamingPlatformRepository_FindByType_Call{Call: _e.mock.On("FindByType", ctx, platformType)}
+}
+
+func (_c *MockStreamingPlatformRepository_FindByType_Call) Run(run func(ctx context.Context, platformType domain.StreamingPlatformType)) *MockStreamingPlatformRepository_FindByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StreamingPlatformType
+		if args[1] != nil {
+			arg1 = args[1].(domain.StreamingPlatformType)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_FindByType_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_FindByType_Call {
+	_c.Call.Return(streamingPlatform, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_FindByType_Call) RunAndReturn(run func(ctx context.Context, platformType domain.StreamingPlatformType) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_FindByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) List(ctx context.Context, offset int, limit int) ([]*domain.StreamingPlatform, int, error) {
+	ret := _mock.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.StreamingPlatform
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.StreamingPlatform, int, error)); ok {
+		return returnFunc(ctx, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.StreamingPlatform); ok {
+		r0 = returnFunc(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.StreamingPlatform)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = returnFunc(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockStreamingPlatformRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockStreamingPlatformRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *MockStreamingPlatformRepository_Expecter) List(ctx interface{}, offset interface{}, limit interface{}) *MockStreamingPlatformRepository_List_Call {
+	return &MockStreamingPlatformRepository_List_Call{Call: _e.mock.On("List", ctx, offset, limit)}
+}
+
+func (_c *MockStreamingPlatformRepository_List_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockStreamingPlatformRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_List_Call) Return(streamingPlatforms []*domain.StreamingPlatform, n int, err error) *MockStreamingPlatformRepository_List_Call {
+	_c.Call.Return(streamingPlatforms, n, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_List_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.StreamingPlatform, int, error)) *MockStreamingPlatformRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockStreamingPlatformRepository
+func (_mock *MockStreamingPlatformRepository) Update(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error) {
+	ret := _mock.Called(ctx, platform)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.StreamingPlatform
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) (*domain.StreamingPlatform, error)); ok {
+		return returnFunc(ctx, platform)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.StreamingPlatform) *domain.StreamingPlatform); ok {
+		r0 = returnFunc(ctx, platform)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StreamingPlatform)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.StreamingPlatform) error); ok {
+		r1 = returnFunc(ctx, platform)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStreamingPlatformRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockStreamingPlatformRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform *domain.StreamingPlatform
+func (_e *MockStreamingPlatformRepository_Expecter) Update(ctx interface{}, platform interface{}) *MockStreamingPlatformRepository_Update_Call {
+	return &MockStreamingPlatformRepository_Update_Call{Call: _e.mock.On("Update", ctx, platform)}
+}
+
+func (_c *MockStreamingPlatformRepository_Update_Call) Run(run func(ctx context.Context, platform *domain.StreamingPlatform)) *MockStreamingPlatformRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.StreamingPlatform
+		if args[1] != nil {
+			arg1 = args[1].(*domain.StreamingPlatform)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_Update_Call) Return(streamingPlatform *domain.StreamingPlatform, err error) *MockStreamingPlatformRepository_Update_Call {
+	_c.Call.Return(streamingPlatform, err)
+	return _c
+}
+
+func (_c *MockStreamingPlatformRepository_Update_Call) RunAndReturn(run func(ctx context.Context, platform *domain.StreamingPlatform) (*domain.StreamingPlatform, error)) *MockStreamingPlatformRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSystemSettingRepository creates a new instance of MockSystemSettingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSystemSettingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSystemSettingRepository {
+	mock := &MockSystemSettingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSystemSettingRepository is an autogenerated mock type for the SystemSettingRepository type
+type MockSystemSettingRepository struct {
+	mock.Mock
+}
+
+type MockSystemSettingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSystemSettingRepository) EXPECT() *MockSystemSettingRepository_Expecter {
+	return &MockSystemSettingRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockSystemSettingRepository
+func (_mock *MockSystemSettingRepository) Create(ctx context.Context, setting *domain.SystemSetting) (*domain.SystemSetting, error) {
+	ret := _mock.Called(ctx, setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.SystemSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SystemSetting) (*domain.SystemSetting, error)); ok {
+		return returnFunc(ctx, setting)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SystemSetting) *domain.SystemSetting); ok {
+		r0 = returnFunc(ctx, setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SystemSetting)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.SystemSetting) error); ok {
+		r1 = returnFunc(ctx, setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSystemSettingRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSystemSettingRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - setting *domain.SystemSetting
+func (_e *MockSystemSettingRepository_Expecter) Create(ctx interface{}, setting interface{}) *MockSystemSettingRepository_Create_Call {
+	return &MockSystemSettingRepository_Create_Call{Call: _e.mock.On("Create", ctx, setting)}
+}
+
+func (_c *MockSystemSettingRepository_Create_Call) Run(run func(ctx context.Context, setting *domain.SystemSetting)) *MockSystemSettingRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SystemSetting
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SystemSetting)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSystemSettingRepository_Create_Call) Return(systemSetting *domain.SystemSetting, err error) *MockSystemSettingRepository_Create_Call {
+	_c.Call.Return(systemSetting, err)
+	return _c
+}
+
+func (_c *MockSystemSettingRepository_Create_Call) RunAndReturn(run func(ctx context.Context, setting *domain.SystemSetting) (*domain.SystemSetting, error)) *MockSystemSettingRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKey provides a mock function for the type MockSystemSettingRepository
+func (_mock *MockSystemSettingRepository) FindByKey(ctx context.Context, key string) (*domain.SystemSetting, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKey")
+	}
+
+	var r0 *domain.SystemSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.SystemSetting, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.SystemSetting); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SystemSetting)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSystemSettingRepository_FindByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKey'
+type MockSystemSettingRepository_FindByKey_Call struct {
+	*mock.Call
+}
+
+// FindByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockSystemSettingRepository_Expecter) FindByKey(ctx interface{}, key interface{}) *MockSystemSettingRepository_FindByKey_Call {
+	return &MockSystemSettingRepository_FindByKey_Call{Call: _e.mock.On("FindByKey", ctx, key)}
+}
+
+func (_c *MockSystemSettingRepository_FindByKey_Call) Run(run func(ctx context.Context, key string)) *MockSystemSettingRepository_FindByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSystemSettingRepository_FindByKey_Call) Return(systemSetting *domain.SystemSetting, err error) *MockSystemSettingRepository_FindByKey_Call {
+	_c.Call.Return(systemSetting, err)
+	return _c
+}
+
+func (_c *MockSystemSettingRepository_FindByKey_Call) RunAndReturn(run func(ctx context.Context, key string) (*domain.SystemSetting, error)) *MockSystemSettingRepository_FindByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserFollowedStreamerRepository creates a new instance of MockUserFollowedStreamerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserFollowedStreamerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserFollowedStreamerRepository {
+	mock := &MockUserFollowedStreamerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserFollowedStreamerRepository is an autogenerated mock type for the UserFollowedStreamerRepository type
+type MockUserFollowedStreamerRepository struct {
+	mock.Mock
+}
+
+type MockUserFollowedStreamerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserFollowedStreamerRepository) EXPECT() *MockUserFollowedStreamerRepository_Expecter {
+	return &MockUserFollowedStreamerRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) Create(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error) {
+	ret := _mock.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.UserFollowedStreamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)); ok {
+		return returnFunc(ctx, follow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) *domain.UserFollowedStreamer); ok {
+		r0 = returnFunc(ctx, follow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserFollowedStreamer) error); ok {
+		r1 = returnFunc(ctx, follow)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserFollowedStreamerRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserFollowedStreamerRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow *domain.UserFollowedStreamer
+func (_e *MockUserFollowedStreamerRepository_Expecter) Create(ctx interface{}, follow interface{}) *MockUserFollowedStreamerRepository_Create_Call {
+	return &MockUserFollowedStreamerRepository_Create_Call{Call: _e.mock.On("Create", ctx, follow)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_Create_Call) Run(run func(ctx context.Context, follow *domain.UserFollowedStreamer)) *MockUserFollowedStreamerRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UserFollowedStreamer
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UserFollowedStreamer)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_Create_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_Create_Call {
+	_c.Call.Return(userFollowedStreamer, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_Create_Call) RunAndReturn(run func(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserFollowedStreamerRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserFollowedStreamerRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockUserFollowedStreamerRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockUserFollowedStreamerRepository_Delete_Call {
+	return &MockUserFollowedStreamerRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockUserFollowedStreamerRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_Delete_Call) Return(err error) *MockUserFollowedStreamerRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockUserFollowedStreamerRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistByUserAndStreamer provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) ExistByUserAndStreamer(ctx context.Context, userID int64, streamerID int64) (bool, error) {
+	ret := _mock.Called(ctx, userID, streamerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistByUserAndStreamer")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return returnFunc(ctx, userID, streamerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = returnFunc(ctx, userID, streamerID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userID, streamerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByUserAndStreamer'
+type MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call struct {
+	*mock.Call
+}
+
+// ExistByUserAndStreamer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - streamerID int64
+func (_e *MockUserFollowedStreamerRepository_Expecter) ExistByUserAndStreamer(ctx interface{}, userID interface{}, streamerID interface{}) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
+	return &MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call{Call: _e.mock.On("ExistByUserAndStreamer", ctx, userID, streamerID)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) Run(run func(ctx context.Context, userID int64, streamerID int64)) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) Return(b bool, err error) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call) RunAndReturn(run func(ctx context.Context, userID int64, streamerID int64) (bool, error)) *MockUserFollowedStreamerRepository_ExistByUserAndStreamer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) FindById(ctx context.Context, id int64) (*domain.UserFollowedStreamer, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *domain.UserFollowedStreamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.UserFollowedStreamer, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.UserFollowedStreamer); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserFollowedStreamerRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockUserFollowedStreamerRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockUserFollowedStreamerRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockUserFollowedStreamerRepository_FindById_Call {
+	return &MockUserFollowedStreamerRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockUserFollowedStreamerRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_FindById_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_FindById_Call {
+	_c.Call.Return(userFollowedStreamer, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserAndStreamer provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) FindByUserAndStreamer(ctx context.Context, userID int64, streamerID int64) (*domain.UserFollowedStreamer, error) {
+	ret := _mock.Called(ctx, userID, streamerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserAndStreamer")
+	}
+
+	var r0 *domain.UserFollowedStreamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*domain.UserFollowedStreamer, error)); ok {
+		return returnFunc(ctx, userID, streamerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *domain.UserFollowedStreamer); ok {
+		r0 = returnFunc(ctx, userID, streamerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userID, streamerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserAndStreamer'
+type MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call struct {
+	*mock.Call
+}
+
+// FindByUserAndStreamer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - streamerID int64
+func (_e *MockUserFollowedStreamerRepository_Expecter) FindByUserAndStreamer(ctx interface{}, userID interface{}, streamerID interface{}) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
+	return &MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call{Call: _e.mock.On("FindByUserAndStreamer", ctx, userID, streamerID)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call) Run(run func(ctx context.Context, userID int64, streamerID int64)) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
+	_c.Call.Return(userFollowedStreamer, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call) RunAndReturn(run func(ctx context.Context, userID int64, streamerID int64) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_FindByUserAndStreamer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByStreamerId provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) ListByStreamerId(ctx context.Context, streamerID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error) {
+	ret := _mock.Called(ctx, streamerID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByStreamerId")
+	}
+
+	var r0 []*domain.UserFollowedStreamer
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.UserFollowedStreamer, int, error)); ok {
+		return returnFunc(ctx, streamerID, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.UserFollowedStreamer); ok {
+		r0 = returnFunc(ctx, streamerID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.UserFollowedStreamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
+		r1 = returnFunc(ctx, streamerID, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, streamerID, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserFollowedStreamerRepository_ListByStreamerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByStreamerId'
+type MockUserFollowedStreamerRepository_ListByStreamerId_Call struct {
+	*mock.Call
+}
+
+// ListByStreamerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - streamerID int64
+//   - offset int
+//   - limit int
+func (_e *MockUserFollowedStreamerRepository_Expecter) ListByStreamerId(ctx interface{}, streamerID interface{}, offset interface{}, limit interface{}) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
+	return &MockUserFollowedStreamerRepository_ListByStreamerId_Call{Call: _e.mock.On("ListByStreamerId", ctx, streamerID, offset, limit)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_ListByStreamerId_Call) Run(run func(ctx context.Context, streamerID int64, offset int, limit int)) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_ListByStreamerId_Call) Return(userFollowedStreamers []*domain.UserFollowedStreamer, n int, err error) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
+	_c.Call.Return(userFollowedStreamers, n, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_ListByStreamerId_Call) RunAndReturn(run func(ctx context.Context, streamerID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error)) *MockUserFollowedStreamerRepository_ListByStreamerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserId provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) ListByUserId(ctx context.Context, userID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error) {
+	ret := _mock.Called(ctx, userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*domain.UserFollowedStreamer
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.UserFollowedStreamer, int, error)); ok {
+		return returnFunc(ctx, userID, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.UserFollowedStreamer); ok {
+		r0 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.UserFollowedStreamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserFollowedStreamerRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockUserFollowedStreamerRepository_ListByUserId_Call struct {
+	*mock.Call
+}
+
+// ListByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - offset int
+//   - limit int
+func (_e *MockUserFollowedStreamerRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *MockUserFollowedStreamerRepository_ListByUserId_Call {
+	return &MockUserFollowedStreamerRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, offset, limit)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, offset int, limit int)) *MockUserFollowedStreamerRepository_ListByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_ListByUserId_Call) Return(userFollowedStreamers []*domain.UserFollowedStreamer, n int, err error) *MockUserFollowedStreamerRepository_ListByUserId_Call {
+	_c.Call.Return(userFollowedStreamers, n, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, offset int, limit int) ([]*domain.UserFollowedStreamer, int, error)) *MockUserFollowedStreamerRepository_ListByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockUserFollowedStreamerRepository
+func (_mock *MockUserFollowedStreamerRepository) Update(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error) {
+	ret := _mock.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.UserFollowedStreamer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)); ok {
+		return returnFunc(ctx, follow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserFollowedStreamer) *domain.UserFollowedStreamer); ok {
+		r0 = returnFunc(ctx, follow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserFollowedStreamer)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.UserFollowedStreamer) error); ok {
+		r1 = returnFunc(ctx, follow)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2178,22 +5296,374 @@ type MockUserFollowedStreamerRepository_Update_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow *domain.UserFollowedStreamer
+func (_e *MockUserFollowedStreamerRepository_Expecter) Update(ctx interface{}, follow interface{}) *MockUserFollowedStreamerRepository_Update_Call {
+	return &MockUserFollowedStreamerRepository_Update_Call{Call: _e.mock.On("Update", ctx, follow)}
+}
+
+func (_c *MockUserFollowedStreamerRepository_Update_Call) Run(run func(ctx context.Context, follow *domain.UserFollowedStreamer)) *MockUserFollowedStreamerRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UserFollowedStreamer
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UserFollowedStreamer)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_Update_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_Update_Call {
+	_c.Call.Return(userFollowedStreamer, err)
+	return _c
+}
+
+func (_c *MockUserFollowedStreamerRepository_Update_Call) RunAndReturn(run func(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepository {
+	mock := &MockUserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserRepository is an autogenerated mock type for the UserRepository type
+type MockUserRepository struct {
+	mock.Mock
+}
+
+type MockUserRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
+	return &MockUserRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) (*domain.User, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) *domain.User); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+func (_e *MockUserRepository_Expecter) Create(ctx interface{}, user interface{}) *MockUserRepository_Create_Call {
+	return &MockUserRepository_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+}
+
+func (_c *MockUserRepository_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Create_Call) Return(user1 *domain.User, err error) *MockUserRepository_Create_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) (*domain.User, error)) *MockUserRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockUserRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockUserRepository_Delete_Call {
+	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockUserRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockUserRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) Return(err error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistByEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) ExistByEmail(ctx context.Context, email string) (bool, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistByEmail")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_ExistByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByEmail'
+type MockUserRepository_ExistByEmail_Call struct {
+	*mock.Call
+}
+
+// ExistByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockUserRepository_Expecter) ExistByEmail(ctx interface{}, email interface{}) *MockUserRepository_ExistByEmail_Call {
+	return &MockUserRepository_ExistByEmail_Call{Call: _e.mock.On("ExistByEmail", ctx, email)}
+}
+
+func (_c *MockUserRepository_ExistByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_ExistByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ExistByEmail_Call) Return(b bool, err error) *MockUserRepository_ExistByEmail_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserRepository_ExistByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (bool, error)) *MockUserRepository_ExistByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistByUsername provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) ExistByUsername(ctx context.Context, username string) (bool, error) {
+	ret := _mock.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistByUsername")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, username)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, username)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_ExistByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByUsername'
+type MockUserRepository_ExistByUsername_Call struct {
+	*mock.Call
+}
+
+// ExistByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockUserRepository_Expecter) ExistByUsername(ctx interface{}, username interface{}) *MockUserRepository_ExistByUsername_Call {
+	return &MockUserRepository_ExistByUsername_Call{Call: _e.mock.On("ExistByUsername", ctx, username)}
+}
+
+func (_c *MockUserRepository_ExistByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepository_ExistByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ExistByUsername_Call) Return(b bool, err error) *MockUserRepository_ExistByUsername_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserRepository_ExistByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (bool, error)) *MockUserRepository_ExistByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByEmail provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepository_FindByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEmail'
+type MockUserRepository_FindByEmail_Call struct {
+	*mock.Call
+}
+
+// FindByEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - follow *domain.UserFollowedStreamer
-func (_e *MockUserFollowedStreamerRepository_Expecter) Update(ctx interface{}, follow interface{}) *MockUserFollowedStreamerRepository_Update_Call {
-	return &MockUserFollowedStreamerRepository_Update_Call{Call: _e.mock.On("Update", ctx, follow)}
+//   - email string
+func (_e *MockUserRepository_Expecter) FindByEmail(ctx interface{}, email interface{}) *MockUserRepository_FindByEmail_Call {
+	return &MockUserRepository_FindByEmail_Call{Call: _e.mock.On("FindByEmail", ctx, email)}
 }
 
-func (_c *MockUserFollowedStreamerRepository_Update_Call) Run(run func(ctx context.Context, follow *domain.UserFollowedStreamer)) *MockUserFollowedStreamerRepository_Update_Call {
+func (_c *MockUserRepository_FindByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_FindByEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.UserFollowedStreamer
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.UserFollowedStreamer)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -2203,92 +5673,133 @@ func (_c *MockUserFollowedStreamerRepository_Update_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_Update_Call) Return(userFollowedStreamer *domain.UserFollowedStreamer, err error) *MockUserFollowedStreamerRepository_Update_Call {
-	_c.Call.Return(userFollowedStreamer, err)
+func (_c *MockUserRepository_FindByEmail_Call) Return(user *domain.User, err error) *MockUserRepository_FindByEmail_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockUserFollowedStreamerRepository_Update_Call) RunAndReturn(run func(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error)) *MockUserFollowedStreamerRepository_Update_Call {
+func (_c *MockUserRepository_FindByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockUserRepository_FindByEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUserRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUserRepository {
-	mock := &MockUserRepository{}
-	mock.Mock.Test(t)
+// FindById provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) FindById(ctx context.Context, id int64) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
 
-	return mock
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserRepository is an autogenerated mock type for the UserRepository type
-type MockUserRepository struct {
-	mock.Mock
+// MockUserRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockUserRepository_FindById_Call struct {
+	*mock.Call
 }
 
-type MockUserRepository_Expecter struct {
-	mock *mock.Mock
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockUserRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockUserRepository_FindById_Call {
+	return &MockUserRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
 }
 
-func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
-	return &MockUserRepository_Expecter{mock: &_m.Mock}
+func (_c *MockUserRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockUserRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
-	ret := _mock.Called(ctx, user)
+func (_c *MockUserRepository_FindById_Call) Return(user *domain.User, err error) *MockUserRepository_FindById_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.User, error)) *MockUserRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUsername provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
+	ret := _mock.Called(ctx, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindByUsername")
 	}
 
 	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) (*domain.User, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, username)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) *domain.User); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, username)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUserRepository_Create_Call struct {
+// MockUserRepository_FindByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUsername'
+type MockUserRepository_FindByUsername_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// FindByUsername is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *domain.User
-func (_e *MockUserRepository_Expecter) Create(ctx interface{}, user interface{}) *MockUserRepository_Create_Call {
-	return &MockUserRepository_Create_Call{Call: _e.mock.On("Create", ctx, user)}
+//   - username string
+func (_e *MockUserRepository_Expecter) FindByUsername(ctx interface{}, username interface{}) *MockUserRepository_FindByUsername_Call {
+	return &MockUserRepository_FindByUsername_Call{Call: _e.mock.On("FindByUsername", ctx, username)}
 }
 
-func (_c *MockUserRepository_Create_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepository_Create_Call {
+func (_c *MockUserRepository_FindByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepository_FindByUsername_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -2298,120 +5809,145 @@ func (_c *MockUserRepository_Create_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockUserRepository_Create_Call) Return(user1 *domain.User, err error) *MockUserRepository_Create_Call {
-	_c.Call.Return(user1, err)
+func (_c *MockUserRepository_FindByUsername_Call) Return(user *domain.User, err error) *MockUserRepository_FindByUsername_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) (*domain.User, error)) *MockUserRepository_Create_Call {
+func (_c *MockUserRepository_FindByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*domain.User, error)) *MockUserRepository_FindByUsername_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) Delete(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// List provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) List(ctx context.Context, offset int, limit int) ([]*domain.User, int, error) {
+	ret := _mock.Called(ctx, offset, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for List")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 []*domain.User
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.User, int, error)); ok {
+		return returnFunc(ctx, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.User); ok {
+		r0 = returnFunc(ctx, offset, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = returnFunc(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockUserRepository_Delete_Call struct {
+// MockUserRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserRepository_List_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// List is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockUserRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockUserRepository_Delete_Call {
-	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - offset int
+//   - limit int
+func (_e *MockUserRepository_Expecter) List(ctx interface{}, offset interface{}, limit interface{}) *MockUserRepository_List_Call {
+	return &MockUserRepository_List_Call{Call: _e.mock.On("List", ctx, offset, limit)}
 }
 
-func (_c *MockUserRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockUserRepository_Delete_Call {
+func (_c *MockUserRepository_List_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockUserRepository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_Delete_Call) Return(err error) *MockUserRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockUserRepository_List_Call) Return(users []*domain.User, n int, err error) *MockUserRepository_List_Call {
+	_c.Call.Return(users, n, err)
 	return _c
 }
 
-func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockUserRepository_Delete_Call {
+func (_c *MockUserRepository_List_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.User, int, error)) *MockUserRepository_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExistByEmail provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) ExistByEmail(ctx context.Context, email string) (bool, error) {
-	ret := _mock.Called(ctx, email)
+// Update provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
+	ret := _mock.Called(ctx, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByEmail")
+		panic("no return value specified for Update")
 	}
 
-	var r0 bool
+	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) (*domain.User, error)); ok {
+		return returnFunc(ctx, user)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) *domain.User); ok {
+		r0 = returnFunc(ctx, user)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User) error); ok {
+		r1 = returnFunc(ctx, user)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_ExistByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByEmail'
-type MockUserRepository_ExistByEmail_Call struct {
+// MockUserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockUserRepository_Update_Call struct {
 	*mock.Call
 }
 
-// ExistByEmail is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockUserRepository_Expecter) ExistByEmail(ctx interface{}, email interface{}) *MockUserRepository_ExistByEmail_Call {
-	return &MockUserRepository_ExistByEmail_Call{Call: _e.mock.On("ExistByEmail", ctx, email)}
+//   - user *domain.User
+func (_e *MockUserRepository_Expecter) Update(ctx interface{}, user interface{}) *MockUserRepository_Update_Call {
+	return &MockUserRepository_Update_Call{Call: _e.mock.On("Update", ctx, user)}
 }
 
-func (_c *MockUserRepository_ExistByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_ExistByEmail_Call {
+func (_c *MockUserRepository_Update_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *domain.User
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*domain.User)
 		}
 		run(
 			arg0,
@@ -2421,63 +5957,92 @@ func (_c *MockUserRepository_ExistByEmail_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockUserRepository_ExistByEmail_Call) Return(b bool, err error) *MockUserRepository_ExistByEmail_Call {
-	_c.Call.Return(b, err)
+func (_c *MockUserRepository_Update_Call) Return(user1 *domain.User, err error) *MockUserRepository_Update_Call {
+	_c.Call.Return(user1, err)
 	return _c
 }
 
-func (_c *MockUserRepository_ExistByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (bool, error)) *MockUserRepository_ExistByEmail_Call {
+func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) (*domain.User, error)) *MockUserRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExistByUsername provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) ExistByUsername(ctx context.Context, username string) (bool, error) {
-	ret := _mock.Called(ctx, username)
+// NewMockWebPushSubscriptionRepository creates a new instance of MockWebPushSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebPushSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebPushSubscriptionRepository {
+	mock := &MockWebPushSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebPushSubscriptionRepository is an autogenerated mock type for the WebPushSubscriptionRepository type
+type MockWebPushSubscriptionRepository struct {
+	mock.Mock
+}
+
+type MockWebPushSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebPushSubscriptionRepository) EXPECT() *MockWebPushSubscriptionRepository_Expecter {
+	return &MockWebPushSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockWebPushSubscriptionRepository
+func (_mock *MockWebPushSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error) {
+	ret := _mock.Called(ctx, subscription)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExistByUsername")
+		panic("no return value specified for Create")
 	}
 
-	var r0 bool
+	var r0 *domain.WebPushSubscription
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebPushSubscription) (*domain.WebPushSubscription, error)); ok {
+		return returnFunc(ctx, subscription)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebPushSubscription) *domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, subscription)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebPushSubscription)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.WebPushSubscription) error); ok {
+		r1 = returnFunc(ctx, subscription)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_ExistByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistByUsername'
-type MockUserRepository_ExistByUsername_Call struct {
+// MockWebPushSubscriptionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWebPushSubscriptionRepository_Create_Call struct {
 	*mock.Call
 }
 
-// ExistByUsername is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockUserRepository_Expecter) ExistByUsername(ctx interface{}, username interface{}) *MockUserRepository_ExistByUsername_Call {
-	return &MockUserRepository_ExistByUsername_Call{Call: _e.mock.On("ExistByUsername", ctx, username)}
+//   - subscription *domain.WebPushSubscription
+func (_e *MockWebPushSubscriptionRepository_Expecter) Create(ctx interface{}, subscription interface{}) *MockWebPushSubscriptionRepository_Create_Call {
+	return &MockWebPushSubscriptionRepository_Create_Call{Call: _e.mock.On("Create", ctx, subscription)}
 }
 
-func (_c *MockUserRepository_ExistByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepository_ExistByUsername_Call {
+func (_c *MockWebPushSubscriptionRepository_Create_Call) Run(run func(ctx context.Context, subscription *domain.WebPushSubscription)) *MockWebPushSubscriptionRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *domain.WebPushSubscription
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*domain.WebPushSubscription)
 		}
 		run(
 			arg0,
@@ -2487,65 +6052,54 @@ func (_c *MockUserRepository_ExistByUsername_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockUserRepository_ExistByUsername_Call) Return(b bool, err error) *MockUserRepository_ExistByUsername_Call {
-	_c.Call.Return(b, err)
+func (_c *MockWebPushSubscriptionRepository_Create_Call) Return(webPushSubscription *domain.WebPushSubscription, err error) *MockWebPushSubscriptionRepository_Create_Call {
+	_c.Call.Return(webPushSubscription, err)
 	return _c
 }
 
-func (_c *MockUserRepository_ExistByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (bool, error)) *MockUserRepository_ExistByUsername_Call {
+func (_c *MockWebPushSubscriptionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error)) *MockWebPushSubscriptionRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByEmail provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
-	ret := _mock.Called(ctx, email)
+// Delete provides a mock function for the type MockWebPushSubscriptionRepository
+func (_mock *MockWebPushSubscriptionRepository) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByEmail")
+		panic("no return value specified for Delete")
 	}
 
-	var r0 *domain.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, email)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, email)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockUserRepository_FindByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEmail'
-type MockUserRepository_FindByEmail_Call struct {
+// MockWebPushSubscriptionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWebPushSubscriptionRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// FindByEmail is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockUserRepository_Expecter) FindByEmail(ctx interface{}, email interface{}) *MockUserRepository_FindByEmail_Call {
-	return &MockUserRepository_FindByEmail_Call{Call: _e.mock.On("FindByEmail", ctx, email)}
+//   - id int64
+func (_e *MockWebPushSubscriptionRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockWebPushSubscriptionRepository_Delete_Call {
+	return &MockWebPushSubscriptionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *MockUserRepository_FindByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserRepository_FindByEmail_Call {
+func (_c *MockWebPushSubscriptionRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockWebPushSubscriptionRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -2555,65 +6109,65 @@ func (_c *MockUserRepository_FindByEmail_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockUserRepository_FindByEmail_Call) Return(user *domain.User, err error) *MockUserRepository_FindByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *MockWebPushSubscriptionRepository_Delete_Call) Return(err error) *MockWebPushSubscriptionRepository_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserRepository_FindByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *MockUserRepository_FindByEmail_Call {
+func (_c *MockWebPushSubscriptionRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockWebPushSubscriptionRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) FindById(ctx context.Context, id int64) (*domain.User, error) {
-	ret := _mock.Called(ctx, id)
+// FindByEndpoint provides a mock function for the type MockWebPushSubscriptionRepository
+func (_mock *MockWebPushSubscriptionRepository) FindByEndpoint(ctx context.Context, endpoint string) (*domain.WebPushSubscription, error) {
+	ret := _mock.Called(ctx, endpoint)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindById")
+		panic("no return value specified for FindByEndpoint")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.WebPushSubscription
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.User, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WebPushSubscription, error)); ok {
+		return returnFunc(ctx, endpoint)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.User); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, endpoint)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.WebPushSubscription)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, endpoint)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockUserRepository_FindById_Call struct {
+// MockWebPushSubscriptionRepository_FindByEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEndpoint'
+type MockWebPushSubscriptionRepository_FindByEndpoint_Call struct {
 	*mock.Call
 }
 
-// FindById is a helper method to define mock.On call
+// FindByEndpoint is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockUserRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockUserRepository_FindById_Call {
-	return &MockUserRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+//   - endpoint string
+func (_e *MockWebPushSubscriptionRepository_Expecter) FindByEndpoint(ctx interface{}, endpoint interface{}) *MockWebPushSubscriptionRepository_FindByEndpoint_Call {
+	return &MockWebPushSubscriptionRepository_FindByEndpoint_Call{Call: _e.mock.On("FindByEndpoint", ctx, endpoint)}
 }
 
-func (_c *MockUserRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockUserRepository_FindById_Call {
+func (_c *MockWebPushSubscriptionRepository_FindByEndpoint_Call) Run(run func(ctx context.Context, endpoint string)) *MockWebPushSubscriptionRepository_FindByEndpoint_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -2623,65 +6177,65 @@ func (_c *MockUserRepository_FindById_Call) Run(run func(ctx context.Context, id
 	return _c
 }
 
-func (_c *MockUserRepository_FindById_Call) Return(user *domain.User, err error) *MockUserRepository_FindById_Call {
-	_c.Call.Return(user, err)
+func (_c *MockWebPushSubscriptionRepository_FindByEndpoint_Call) Return(webPushSubscription *domain.WebPushSubscription, err error) *MockWebPushSubscriptionRepository_FindByEndpoint_Call {
+	_c.Call.Return(webPushSubscription, err)
 	return _c
 }
 
-func (_c *MockUserRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.User, error)) *MockUserRepository_FindById_Call {
+func (_c *MockWebPushSubscriptionRepository_FindByEndpoint_Call) RunAndReturn(run func(ctx context.Context, endpoint string) (*domain.WebPushSubscription, error)) *MockWebPushSubscriptionRepository_FindByEndpoint_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByUsername provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
-	ret := _mock.Called(ctx, username)
+// FindById provides a mock function for the type MockWebPushSubscriptionRepository
+func (_mock *MockWebPushSubscriptionRepository) FindById(ctx context.Context, id int64) (*domain.WebPushSubscription, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByUsername")
+		panic("no return value specified for FindById")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.WebPushSubscription
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.WebPushSubscription, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.WebPushSubscription)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, username)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_FindByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUsername'
-type MockUserRepository_FindByUsername_Call struct {
+// MockWebPushSubscriptionRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockWebPushSubscriptionRepository_FindById_Call struct {
 	*mock.Call
 }
 
-// FindByUsername is a helper method to define mock.On call
+// FindById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockUserRepository_Expecter) FindByUsername(ctx interface{}, username interface{}) *MockUserRepository_FindByUsername_Call {
-	return &MockUserRepository_FindByUsername_Call{Call: _e.mock.On("FindByUsername", ctx, username)}
+//   - id int64
+func (_e *MockWebPushSubscriptionRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockWebPushSubscriptionRepository_FindById_Call {
+	return &MockWebPushSubscriptionRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
 }
 
-func (_c *MockUserRepository_FindByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepository_FindByUsername_Call {
+func (_c *MockWebPushSubscriptionRepository_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockWebPushSubscriptionRepository_FindById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -2691,145 +6245,151 @@ func (_c *MockUserRepository_FindByUsername_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockUserRepository_FindByUsername_Call) Return(user *domain.User, err error) *MockUserRepository_FindByUsername_Call {
-	_c.Call.Return(user, err)
+func (_c *MockWebPushSubscriptionRepository_FindById_Call) Return(webPushSubscription *domain.WebPushSubscription, err error) *MockWebPushSubscriptionRepository_FindById_Call {
+	_c.Call.Return(webPushSubscription, err)
 	return _c
 }
 
-func (_c *MockUserRepository_FindByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*domain.User, error)) *MockUserRepository_FindByUsername_Call {
+func (_c *MockWebPushSubscriptionRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.WebPushSubscription, error)) *MockWebPushSubscriptionRepository_FindById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) List(ctx context.Context, offset int, limit int) ([]*domain.User, int, error) {
-	ret := _mock.Called(ctx, offset, limit)
+// ListByUserId provides a mock function for the type MockWebPushSubscriptionRepository
+func (_mock *MockWebPushSubscriptionRepository) ListByUserId(ctx context.Context, userID int64, offset int, limit int) ([]*domain.WebPushSubscription, int, error) {
+	ret := _mock.Called(ctx, userID, offset, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for List")
+		panic("no return value specified for ListByUserId")
 	}
 
-	var r0 []*domain.User
+	var r0 []*domain.WebPushSubscription
 	var r1 int
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*domain.User, int, error)); ok {
-		return returnFunc(ctx, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.WebPushSubscription, int, error)); ok {
+		return returnFunc(ctx, userID, offset, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*domain.User); ok {
-		r0 = returnFunc(ctx, offset, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, userID, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.User)
+			r0 = ret.Get(0).([]*domain.WebPushSubscription)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
-		r1 = returnFunc(ctx, offset, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, offset, limit)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
-		r2 = returnFunc(ctx, offset, limit)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, offset, limit)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockUserRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockUserRepository_List_Call struct {
+// MockWebPushSubscriptionRepository_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockWebPushSubscriptionRepository_ListByUserId_Call struct {
 	*mock.Call
 }
 
-// List is a helper method to define mock.On call
+// ListByUserId is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID int64
 //   - offset int
 //   - limit int
-func (_e *MockUserRepository_Expecter) List(ctx interface{}, offset interface{}, limit interface{}) *MockUserRepository_List_Call {
-	return &MockUserRepository_List_Call{Call: _e.mock.On("List", ctx, offset, limit)}
+func (_e *MockWebPushSubscriptionRepository_Expecter) ListByUserId(ctx interface{}, userID interface{}, offset interface{}, limit interface{}) *MockWebPushSubscriptionRepository_ListByUserId_Call {
+	return &MockWebPushSubscriptionRepository_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, offset, limit)}
 }
 
-func (_c *MockUserRepository_List_Call) Run(run func(ctx context.Context, offset int, limit int)) *MockUserRepository_List_Call {
+func (_c *MockWebPushSubscriptionRepository_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, offset int, limit int)) *MockWebPushSubscriptionRepository_ListByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(int64)
 		}
 		var arg2 int
 		if args[2] != nil {
 			arg2 = args[2].(int)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_List_Call) Return(users []*domain.User, n int, err error) *MockUserRepository_List_Call {
-	_c.Call.Return(users, n, err)
+func (_c *MockWebPushSubscriptionRepository_ListByUserId_Call) Return(webPushSubscriptions []*domain.WebPushSubscription, n int, err error) *MockWebPushSubscriptionRepository_ListByUserId_Call {
+	_c.Call.Return(webPushSubscriptions, n, err)
 	return _c
 }
 
-func (_c *MockUserRepository_List_Call) RunAndReturn(run func(ctx context.Context, offset int, limit int) ([]*domain.User, int, error)) *MockUserRepository_List_Call {
+func (_c *MockWebPushSubscriptionRepository_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, offset int, limit int) ([]*domain.WebPushSubscription, int, error)) *MockWebPushSubscriptionRepository_ListByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockUserRepository
-func (_mock *MockUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
-	ret := _mock.Called(ctx, user)
+// Update provides a mock function for the type MockWebPushSubscriptionRepository
+func (_mock *MockWebPushSubscriptionRepository) Update(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error) {
+	ret := _mock.Called(ctx, subscription)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.WebPushSubscription
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) (*domain.User, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebPushSubscription) (*domain.WebPushSubscription, error)); ok {
+		return returnFunc(ctx, subscription)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User) *domain.User); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebPushSubscription) *domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, subscription)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.WebPushSubscription)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.WebPushSubscription) error); ok {
+		r1 = returnFunc(ctx, subscription)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockUserRepository_Update_Call struct {
+// MockWebPushSubscriptionRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWebPushSubscriptionRepository_Update_Call struct {
 	*mock.Call
 }
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *domain.User
-func (_e *MockUserRepository_Expecter) Update(ctx interface{}, user interface{}) *MockUserRepository_Update_Call {
-	return &MockUserRepository_Update_Call{Call: _e.mock.On("Update", ctx, user)}
+//   - subscription *domain.WebPushSubscription
+func (_e *MockWebPushSubscriptionRepository_Expecter) Update(ctx interface{}, subscription interface{}) *MockWebPushSubscriptionRepository_Update_Call {
+	return &MockWebPushSubscriptionRepository_Update_Call{Call: _e.mock.On("Update", ctx, subscription)}
 }
 
-func (_c *MockUserRepository_Update_Call) Run(run func(ctx context.Context, user *domain.User)) *MockUserRepository_Update_Call {
+func (_c *MockWebPushSubscriptionRepository_Update_Call) Run(run func(ctx context.Context, subscription *domain.WebPushSubscription)) *MockWebPushSubscriptionRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.User
+		var arg1 *domain.WebPushSubscription
 		if args[1] != nil {
-			arg1 = args[1].(*domain.User)
+			arg1 = args[1].(*domain.WebPushSubscription)
 		}
 		run(
 			arg0,
@@ -2839,12 +6399,12 @@ func (_c *MockUserRepository_Update_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockUserRepository_Update_Call) Return(user1 *domain.User, err error) *MockUserRepository_Update_Call {
-	_c.Call.Return(user1, err)
+func (_c *MockWebPushSubscriptionRepository_Update_Call) Return(webPushSubscription *domain.WebPushSubscription, err error) *MockWebPushSubscriptionRepository_Update_Call {
+	_c.Call.Return(webPushSubscription, err)
 	return _c
 }
 
-func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(ctx context.Context, user *domain.User) (*domain.User, error)) *MockUserRepository_Update_Call {
+func (_c *MockWebPushSubscriptionRepository_Update_Call) RunAndReturn(run func(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error)) *MockWebPushSubscriptionRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
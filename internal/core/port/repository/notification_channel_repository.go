@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/domain"
 )
@@ -15,7 +16,33 @@ type NotificationChannelRepository interface {
 
 	FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error)
 
+	// FindInboxByUserId finds the user's built-in inbox channel.
+	FindInboxByUserId(ctx context.Context, userID int64) (*domain.NotificationChannel, error)
+
 	ListByUserId(ctx context.Context, userID int64, offset, limit int) ([]*domain.NotificationChannel, int, error)
 
 	ExistByName(ctx context.Context, userID int64, name string) (bool, error)
+
+	// ListWithDailySummary lists enabled channels that have a daily summary schedule.
+	ListWithDailySummary(ctx context.Context, offset, limit int) ([]*domain.NotificationChannel, int, error)
+
+	// MarkDailySummarySent records when the channel's daily summary went out.
+	MarkDailySummarySent(ctx context.Context, id int64, at time.Time) error
+
+	UpdateTestResult(ctx context.Context, id int64, result *domain.NotificationChannelTestResult) error
+
+	// UpdateVerification stores the pending verification, nil once confirmed, and the enable flag.
+	UpdateVerification(ctx context.Context, id int64, enable bool, verification *domain.ChannelVerification) error
+
+	// RecordSendSuccess ends the channel's failure streak and records when it last delivered.
+	RecordSendSuccess(ctx context.Context, id int64, at time.Time) error
+
+	// RecordSendFailure counts one more failed send and returns the channel with its updated health.
+	RecordSendFailure(ctx context.Context, id int64, message string, at time.Time) (*domain.NotificationChannel, error)
+
+	// DisableUnhealthy turns the channel off for failing and reports whether it was still on.
+	DisableUnhealthy(ctx context.Context, id int64, at time.Time) (bool, error)
+
+	// RotateSecrets re-encrypts stored secrets with the active key and returns how many channels changed.
+	RotateSecrets(ctx context.Context) (int, error)
 }
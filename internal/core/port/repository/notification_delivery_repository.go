@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type NotificationDeliveryRepository interface {
+	Create(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error)
+
+	// CreateBatch stores deliveries in one transaction, so a route is never queued half-way.
+	CreateBatch(ctx context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error)
+
+	Update(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error)
+
+	FindById(ctx context.Context, id int64) (*domain.NotificationDelivery, error)
+
+	ListByUserId(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, offset, limit int) ([]*domain.NotificationDelivery, int, error)
+
+	List(ctx context.Context, filter *domain.NotificationDeliveryFilter, offset, limit int) ([]*domain.NotificationDelivery, int, error)
+
+	// ClaimDue locks up to limit deliveries that are due (or whose lease expired) using
+	// SELECT ... FOR UPDATE SKIP LOCKED and marks them processing until now+lease.
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.NotificationDelivery, error)
+
+	// RequeueDead moves dead deliveries matching filter back to pending.
+	RequeueDead(ctx context.Context, filter *domain.NotificationDeliveryFilter, now time.Time) (int, error)
+
+	// Acknowledge stamps AcknowledgedAt unless the delivery was already acknowledged. Update never
+	// touches it, so a worker finishing the delivery concurrently cannot undo an acknowledgement.
+	Acknowledge(ctx context.Context, id int64, at time.Time) (*domain.NotificationDelivery, error)
+
+	// PromoteRouteStep makes the given step of a route due at at, if it is held or scheduled later and
+	// has not been attempted yet.
+	PromoteRouteStep(ctx context.Context, routeID string, step int, at time.Time) (int, error)
+
+	// SkipRoute marks the not yet attempted steps after afterStep as skipped.
+	SkipRoute(ctx context.Context, routeID string, afterStep int) (int, error)
+
+	// ListBatched returns every delivery waiting for a digest, ordered by channel and then age.
+	ListBatched(ctx context.Context) ([]*domain.NotificationDelivery, error)
+
+	// ListThrottled returns every delivery held back by the send rate limit, ordered by channel and then age.
+	ListThrottled(ctx context.Context) ([]*domain.NotificationDelivery, error)
+
+	// CreateDigest stores digest and marks the batched or throttled deliveries it replaces as digested,
+	// in one transaction. Deliveries that are neither anymore are left alone.
+	CreateDigest(ctx context.Context, digest *domain.NotificationDelivery, batchedIDs []int64) (*domain.NotificationDelivery, error)
+
+	// CountSentByChannelSince counts the deliveries created for a channel since since that were or will
+	// be sent on their own, i.e. not held by a route, batched, throttled, digested or skipped.
+	CountSentByChannelSince(ctx context.Context, channelID int64, since time.Time) (int, error)
+
+	// CountSentByUserSince is CountSentByChannelSince across all of a user's channels but the inbox, which
+	// is not rate limited.
+	CountSentByUserSince(ctx context.Context, userID int64, since time.Time) (int, error)
+
+	DeleteCreatedBefore(ctx context.Context, before time.Time) (int, error)
+}
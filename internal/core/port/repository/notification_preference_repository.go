@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type NotificationPreferenceRepository interface {
+	Create(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error)
+
+	Update(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error)
+
+	FindByUserId(ctx context.Context, userID int64) (*domain.NotificationPreference, error)
+}
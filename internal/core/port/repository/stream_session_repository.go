@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type StreamSessionRepository interface {
+	Create(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error)
+
+	Update(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error)
+
+	// FindOpenByStreamerId returns the streamer's session that has not ended yet.
+	FindOpenByStreamerId(ctx context.Context, streamerID int64) (*domain.StreamSession, error)
+
+	// ListByStreamerIds returns the sessions of the given streamers that overlap [from, to), oldest first.
+	ListByStreamerIds(ctx context.Context, streamerIDs []int64, from, to time.Time) ([]*domain.StreamSession, error)
+}
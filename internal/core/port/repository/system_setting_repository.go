@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type SystemSettingRepository interface {
+	Create(ctx context.Context, setting *domain.SystemSetting) (*domain.SystemSetting, error)
+
+	FindByKey(ctx context.Context, key string) (*domain.SystemSetting, error)
+}
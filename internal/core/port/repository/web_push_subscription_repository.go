@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type WebPushSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error)
+
+	Update(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error)
+
+	Delete(ctx context.Context, id int64) error
+
+	FindById(ctx context.Context, id int64) (*domain.WebPushSubscription, error)
+
+	FindByEndpoint(ctx context.Context, endpoint string) (*domain.WebPushSubscription, error)
+
+	ListByUserId(ctx context.Context, userID int64, offset, limit int) ([]*domain.WebPushSubscription, int, error)
+}
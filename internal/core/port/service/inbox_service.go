@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+// InboxService manages the in-app inbox every user has as a built-in notification channel.
+type InboxService interface {
+	// EnsureChannel returns the user's inbox channel, creating it the first time it is needed.
+	EnsureChannel(ctx context.Context, userID int64) (*domain.NotificationChannel, error)
+
+	// ListByUserId lists the user's messages, newest first; unreadOnly leaves out those already read.
+	ListByUserId(ctx context.Context, userID int64, unreadOnly bool, page, pageSize int) ([]*domain.InboxMessage, int, error)
+
+	CountUnread(ctx context.Context, userID int64) (int, error)
+
+	MarkRead(ctx context.Context, id int64) (*domain.InboxMessage, error)
+
+	// MarkAllRead marks every unread message of the user read and returns how many it marked.
+	MarkAllRead(ctx context.Context, userID int64) (int, error)
+
+	// PurgeExpired deletes messages older than the configured retention.
+	PurgeExpired(ctx context.Context) (int, error)
+}
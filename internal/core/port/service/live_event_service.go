@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+// LiveEventService fans real-time events out to the event streams users have open.
+type LiveEventService interface {
+	// Publish pushes an event to the user's open subscriptions and keeps it for replay.
+	Publish(ctx context.Context, userID int64, eventType domain.LiveEventType, data map[string]any) *domain.LiveEvent
+
+	// Subscribe opens a subscription to the user's events. replay holds the kept events after
+	// lastEventID, oldest first; zero replays nothing. Newer events arrive on events, which is closed
+	// once ctx is done, the subscriber falls too far behind or the service is closed.
+	Subscribe(ctx context.Context, userID, lastEventID int64) (replay []*domain.LiveEvent, events <-chan *domain.LiveEvent)
+
+	// Close ends every subscription, so open streams finish before the server shuts down.
+	Close()
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -169,13 +170,13 @@ func (_c *MockAuthService_Register_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
-// NewMockNotificationChannelService creates a new instance of MockNotificationChannelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockInboxService creates a new instance of MockInboxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockNotificationChannelService(t interface {
+func NewMockInboxService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockNotificationChannelService {
-	mock := &MockNotificationChannelService{}
+}) *MockInboxService {
+	mock := &MockInboxService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -183,68 +184,134 @@ func NewMockNotificationChannelService(t interface {
 	return mock
 }
 
-// MockNotificationChannelService is an autogenerated mock type for the NotificationChannelService type
-type MockNotificationChannelService struct {
+// MockInboxService is an autogenerated mock type for the InboxService type
+type MockInboxService struct {
 	mock.Mock
 }
 
-type MockNotificationChannelService_Expecter struct {
+type MockInboxService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockNotificationChannelService) EXPECT() *MockNotificationChannelService_Expecter {
-	return &MockNotificationChannelService_Expecter{mock: &_m.Mock}
+func (_m *MockInboxService) EXPECT() *MockInboxService_Expecter {
+	return &MockInboxService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockNotificationChannelService
-func (_mock *MockNotificationChannelService) Create(ctx context.Context, cmd *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error) {
-	ret := _mock.Called(ctx, cmd)
+// CountUnread provides a mock function for the type MockInboxService
+func (_mock *MockInboxService) CountUnread(ctx context.Context, userID int64) (int, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInboxService_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type MockInboxService_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockInboxService_Expecter) CountUnread(ctx interface{}, userID interface{}) *MockInboxService_CountUnread_Call {
+	return &MockInboxService_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, userID)}
+}
+
+func (_c *MockInboxService_CountUnread_Call) Run(run func(ctx context.Context, userID int64)) *MockInboxService_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInboxService_CountUnread_Call) Return(n int, err error) *MockInboxService_CountUnread_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockInboxService_CountUnread_Call) RunAndReturn(run func(ctx context.Context, userID int64) (int, error)) *MockInboxService_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnsureChannel provides a mock function for the type MockInboxService
+func (_mock *MockInboxService) EnsureChannel(ctx context.Context, userID int64) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureChannel")
 	}
 
 	var r0 *domain.NotificationChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error)); ok {
-		return returnFunc(ctx, cmd)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.CreateNotificationChannelCommand) *domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, cmd)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.NotificationChannel)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.CreateNotificationChannelCommand) error); ok {
-		r1 = returnFunc(ctx, cmd)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockNotificationChannelService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockNotificationChannelService_Create_Call struct {
+// MockInboxService_EnsureChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureChannel'
+type MockInboxService_EnsureChannel_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// EnsureChannel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - cmd *command.CreateNotificationChannelCommand
-func (_e *MockNotificationChannelService_Expecter) Create(ctx interface{}, cmd interface{}) *MockNotificationChannelService_Create_Call {
-	return &MockNotificationChannelService_Create_Call{Call: _e.mock.On("Create", ctx, cmd)}
+//   - userID int64
+func (_e *MockInboxService_Expecter) EnsureChannel(ctx interface{}, userID interface{}) *MockInboxService_EnsureChannel_Call {
+	return &MockInboxService_EnsureChannel_Call{Call: _e.mock.On("EnsureChannel", ctx, userID)}
 }
 
-func (_c *MockNotificationChannelService_Create_Call) Run(run func(ctx context.Context, cmd *command.CreateNotificationChannelCommand)) *MockNotificationChannelService_Create_Call {
+func (_c *MockInboxService_EnsureChannel_Call) Run(run func(ctx context.Context, userID int64)) *MockInboxService_EnsureChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *command.CreateNotificationChannelCommand
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*command.CreateNotificationChannelCommand)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -254,46 +321,66 @@ func (_c *MockNotificationChannelService_Create_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockNotificationChannelService_Create_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_Create_Call {
+func (_c *MockInboxService_EnsureChannel_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockInboxService_EnsureChannel_Call {
 	_c.Call.Return(notificationChannel, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelService_Create_Call) RunAndReturn(run func(ctx context.Context, cmd *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error)) *MockNotificationChannelService_Create_Call {
+func (_c *MockInboxService_EnsureChannel_Call) RunAndReturn(run func(ctx context.Context, userID int64) (*domain.NotificationChannel, error)) *MockInboxService_EnsureChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockNotificationChannelService
-func (_mock *MockNotificationChannelService) Delete(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// ListByUserId provides a mock function for the type MockInboxService
+func (_mock *MockInboxService) ListByUserId(ctx context.Context, userID int64, unreadOnly bool, page int, pageSize int) ([]*domain.InboxMessage, int, error) {
+	ret := _mock.Called(ctx, userID, unreadOnly, page, pageSize)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for ListByUserId")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 []*domain.InboxMessage
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, int, int) ([]*domain.InboxMessage, int, error)); ok {
+		return returnFunc(ctx, userID, unreadOnly, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool, int, int) []*domain.InboxMessage); ok {
+		r0 = returnFunc(ctx, userID, unreadOnly, page, pageSize)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.InboxMessage)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, bool, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, unreadOnly, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, bool, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, unreadOnly, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockNotificationChannelService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockNotificationChannelService_Delete_Call struct {
+// MockInboxService_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockInboxService_ListByUserId_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// ListByUserId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockNotificationChannelService_Expecter) Delete(ctx interface{}, id interface{}) *MockNotificationChannelService_Delete_Call {
-	return &MockNotificationChannelService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - userID int64
+//   - unreadOnly bool
+//   - page int
+//   - pageSize int
+func (_e *MockInboxService_Expecter) ListByUserId(ctx interface{}, userID interface{}, unreadOnly interface{}, page interface{}, pageSize interface{}) *MockInboxService_ListByUserId_Call {
+	return &MockInboxService_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, unreadOnly, page, pageSize)}
 }
 
-func (_c *MockNotificationChannelService_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelService_Delete_Call {
+func (_c *MockInboxService_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, unreadOnly bool, page int, pageSize int)) *MockInboxService_ListByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -303,65 +390,78 @@ func (_c *MockNotificationChannelService_Delete_Call) Run(run func(ctx context.C
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockNotificationChannelService_Delete_Call) Return(err error) *MockNotificationChannelService_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockInboxService_ListByUserId_Call) Return(inboxMessages []*domain.InboxMessage, n int, err error) *MockInboxService_ListByUserId_Call {
+	_c.Call.Return(inboxMessages, n, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelService_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockNotificationChannelService_Delete_Call {
+func (_c *MockInboxService_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, unreadOnly bool, page int, pageSize int) ([]*domain.InboxMessage, int, error)) *MockInboxService_ListByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockNotificationChannelService
-func (_mock *MockNotificationChannelService) FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error) {
-	ret := _mock.Called(ctx, id)
+// MarkAllRead provides a mock function for the type MockInboxService
+func (_mock *MockInboxService) MarkAllRead(ctx context.Context, userID int64) (int, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindById")
+		panic("no return value specified for MarkAllRead")
 	}
 
-	var r0 *domain.NotificationChannel
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannel, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (int, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) int); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.NotificationChannel)
-		}
+		r0 = ret.Get(0).(int)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockNotificationChannelService_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockNotificationChannelService_FindById_Call struct {
+// MockInboxService_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MockInboxService_MarkAllRead_Call struct {
 	*mock.Call
 }
 
-// FindById is a helper method to define mock.On call
+// MarkAllRead is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockNotificationChannelService_Expecter) FindById(ctx interface{}, id interface{}) *MockNotificationChannelService_FindById_Call {
-	return &MockNotificationChannelService_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+//   - userID int64
+func (_e *MockInboxService_Expecter) MarkAllRead(ctx interface{}, userID interface{}) *MockInboxService_MarkAllRead_Call {
+	return &MockInboxService_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", ctx, userID)}
 }
 
-func (_c *MockNotificationChannelService_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelService_FindById_Call {
+func (_c *MockInboxService_MarkAllRead_Call) Run(run func(ctx context.Context, userID int64)) *MockInboxService_MarkAllRead_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -379,65 +479,57 @@ func (_c *MockNotificationChannelService_FindById_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockNotificationChannelService_FindById_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_FindById_Call {
-	_c.Call.Return(notificationChannel, err)
+func (_c *MockInboxService_MarkAllRead_Call) Return(n int, err error) *MockInboxService_MarkAllRead_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelService_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationChannel, error)) *MockNotificationChannelService_FindById_Call {
+func (_c *MockInboxService_MarkAllRead_Call) RunAndReturn(run func(ctx context.Context, userID int64) (int, error)) *MockInboxService_MarkAllRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByUserId provides a mock function for the type MockNotificationChannelService
-func (_mock *MockNotificationChannelService) ListByUserId(ctx context.Context, userID int64, page int, pageSize int) ([]*domain.NotificationChannel, int, error) {
-	ret := _mock.Called(ctx, userID, page, pageSize)
+// MarkRead provides a mock function for the type MockInboxService
+func (_mock *MockInboxService) MarkRead(ctx context.Context, id int64) (*domain.InboxMessage, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByUserId")
+		panic("no return value specified for MarkRead")
 	}
 
-	var r0 []*domain.NotificationChannel
-	var r1 int
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.NotificationChannel, int, error)); ok {
-		return returnFunc(ctx, userID, page, pageSize)
+	var r0 *domain.InboxMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.InboxMessage, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.InboxMessage); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.NotificationChannel)
+			r0 = ret.Get(0).(*domain.InboxMessage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
-		r1 = returnFunc(ctx, userID, page, pageSize)
-	} else {
-		r1 = ret.Get(1).(int)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
-		r2 = returnFunc(ctx, userID, page, pageSize)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockNotificationChannelService_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
-type MockNotificationChannelService_ListByUserId_Call struct {
+// MockInboxService_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockInboxService_MarkRead_Call struct {
 	*mock.Call
 }
 
-// ListByUserId is a helper method to define mock.On call
+// MarkRead is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-//   - page int
-//   - pageSize int
-func (_e *MockNotificationChannelService_Expecter) ListByUserId(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *MockNotificationChannelService_ListByUserId_Call {
-	return &MockNotificationChannelService_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, page, pageSize)}
+//   - id int64
+func (_e *MockInboxService_Expecter) MarkRead(ctx interface{}, id interface{}) *MockInboxService_MarkRead_Call {
+	return &MockInboxService_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, id)}
 }
 
-func (_c *MockNotificationChannelService_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, page int, pageSize int)) *MockNotificationChannelService_ListByUserId_Call {
+func (_c *MockInboxService_MarkRead_Call) Run(run func(ctx context.Context, id int64)) *MockInboxService_MarkRead_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -447,98 +539,2459 @@ func (_c *MockNotificationChannelService_ListByUserId_Call) Run(run func(ctx con
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockNotificationChannelService_ListByUserId_Call) Return(notificationChannels []*domain.NotificationChannel, n int, err error) *MockNotificationChannelService_ListByUserId_Call {
-	_c.Call.Return(notificationChannels, n, err)
+func (_c *MockInboxService_MarkRead_Call) Return(inboxMessage *domain.InboxMessage, err error) *MockInboxService_MarkRead_Call {
+	_c.Call.Return(inboxMessage, err)
 	return _c
 }
 
-func (_c *MockNotificationChannelService_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, page int, pageSize int) ([]*domain.NotificationChannel, int, error)) *MockNotificationChannelService_ListByUserId_Call {
+func (_c *MockInboxService_MarkRead_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.InboxMessage, error)) *MockInboxService_MarkRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockNotificationChannelService
-func (_mock *MockNotificationChannelService) Update(ctx context.Context, cmd *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error) {
-	ret := _mock.Called(ctx, cmd)
+// PurgeExpired provides a mock function for the type MockInboxService
+func (_mock *MockInboxService) PurgeExpired(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for PurgeExpired")
 	}
 
-	var r0 *domain.NotificationChannel
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error)); ok {
-		return returnFunc(ctx, cmd)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.UpdateNotificationChannelCommand) *domain.NotificationChannel); ok {
-		r0 = returnFunc(ctx, cmd)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInboxService_PurgeExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpired'
+type MockInboxService_PurgeExpired_Call struct {
+	*mock.Call
+}
+
+// PurgeExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInboxService_Expecter) PurgeExpired(ctx interface{}) *MockInboxService_PurgeExpired_Call {
+	return &MockInboxService_PurgeExpired_Call{Call: _e.mock.On("PurgeExpired", ctx)}
+}
+
+func (_c *MockInboxService_PurgeExpired_Call) Run(run func(ctx context.Context)) *MockInboxService_PurgeExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInboxService_PurgeExpired_Call) Return(n int, err error) *MockInboxService_PurgeExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockInboxService_PurgeExpired_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockInboxService_PurgeExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLiveEventService creates a new instance of MockLiveEventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLiveEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLiveEventService {
+	mock := &MockLiveEventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLiveEventService is an autogenerated mock type for the LiveEventService type
+type MockLiveEventService struct {
+	mock.Mock
+}
+
+type MockLiveEventService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLiveEventService) EXPECT() *MockLiveEventService_Expecter {
+	return &MockLiveEventService_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockLiveEventService
+func (_mock *MockLiveEventService) Close() {
+	_mock.Called()
+	return
+}
+
+// MockLiveEventService_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockLiveEventService_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockLiveEventService_Expecter) Close() *MockLiveEventService_Close_Call {
+	return &MockLiveEventService_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockLiveEventService_Close_Call) Run(run func()) *MockLiveEventService_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLiveEventService_Close_Call) Return() *MockLiveEventService_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockLiveEventService_Close_Call) RunAndReturn(run func()) *MockLiveEventService_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockLiveEventService
+func (_mock *MockLiveEventService) Publish(ctx context.Context, userID int64, eventType domain.LiveEventType, data map[string]any) *domain.LiveEvent {
+	ret := _mock.Called(ctx, userID, eventType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 *domain.LiveEvent
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, domain.LiveEventType, map[string]any) *domain.LiveEvent); ok {
+		r0 = returnFunc(ctx, userID, eventType, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LiveEvent)
+		}
+	}
+	return r0
+}
+
+// MockLiveEventService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockLiveEventService_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - eventType domain.LiveEventType
+//   - data map[string]any
+func (_e *MockLiveEventService_Expecter) Publish(ctx interface{}, userID interface{}, eventType interface{}, data interface{}) *MockLiveEventService_Publish_Call {
+	return &MockLiveEventService_Publish_Call{Call: _e.mock.On("Publish", ctx, userID, eventType, data)}
+}
+
+func (_c *MockLiveEventService_Publish_Call) Run(run func(ctx context.Context, userID int64, eventType domain.LiveEventType, data map[string]any)) *MockLiveEventService_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 domain.LiveEventType
+		if args[2] != nil {
+			arg2 = args[2].(domain.LiveEventType)
+		}
+		var arg3 map[string]any
+		if args[3] != nil {
+			arg3 = args[3].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveEventService_Publish_Call) Return(liveEvent *domain.LiveEvent) *MockLiveEventService_Publish_Call {
+	_c.Call.Return(liveEvent)
+	return _c
+}
+
+func (_c *MockLiveEventService_Publish_Call) RunAndReturn(run func(ctx context.Context, userID int64, eventType domain.LiveEventType, data map[string]any) *domain.LiveEvent) *MockLiveEventService_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockLiveEventService
+func (_mock *MockLiveEventService) Subscribe(ctx context.Context, userID int64, lastEventID int64) ([]*domain.LiveEvent, <-chan *domain.LiveEvent) {
+	ret := _mock.Called(ctx, userID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 []*domain.LiveEvent
+	var r1 <-chan *domain.LiveEvent
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*domain.LiveEvent, <-chan *domain.LiveEvent)); ok {
+		return returnFunc(ctx, userID, lastEventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) []*domain.LiveEvent); ok {
+		r0 = returnFunc(ctx, userID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LiveEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) <-chan *domain.LiveEvent); ok {
+		r1 = returnFunc(ctx, userID, lastEventID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(<-chan *domain.LiveEvent)
+		}
+	}
+	return r0, r1
+}
+
+// MockLiveEventService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockLiveEventService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - lastEventID int64
+func (_e *MockLiveEventService_Expecter) Subscribe(ctx interface{}, userID interface{}, lastEventID interface{}) *MockLiveEventService_Subscribe_Call {
+	return &MockLiveEventService_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, userID, lastEventID)}
+}
+
+func (_c *MockLiveEventService_Subscribe_Call) Run(run func(ctx context.Context, userID int64, lastEventID int64)) *MockLiveEventService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLiveEventService_Subscribe_Call) Return(liveEvents []*domain.LiveEvent, v <-chan *domain.LiveEvent) *MockLiveEventService_Subscribe_Call {
+	_c.Call.Return(liveEvents, v)
+	return _c
+}
+
+func (_c *MockLiveEventService_Subscribe_Call) RunAndReturn(run func(ctx context.Context, userID int64, lastEventID int64) ([]*domain.LiveEvent, <-chan *domain.LiveEvent)) *MockLiveEventService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationActionService creates a new instance of MockNotificationActionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationActionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationActionService {
+	mock := &MockNotificationActionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationActionService is an autogenerated mock type for the NotificationActionService type
+type MockNotificationActionService struct {
+	mock.Mock
+}
+
+type MockNotificationActionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationActionService) EXPECT() *MockNotificationActionService_Expecter {
+	return &MockNotificationActionService_Expecter{mock: &_m.Mock}
+}
+
+// Inspect provides a mock function for the type MockNotificationActionService
+func (_mock *MockNotificationActionService) Inspect(ctx context.Context, token string) (*domain.NotificationActionToken, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Inspect")
+	}
+
+	var r0 *domain.NotificationActionToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.NotificationActionToken, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.NotificationActionToken); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationActionToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationActionService_Inspect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inspect'
+type MockNotificationActionService_Inspect_Call struct {
+	*mock.Call
+}
+
+// Inspect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockNotificationActionService_Expecter) Inspect(ctx interface{}, token interface{}) *MockNotificationActionService_Inspect_Call {
+	return &MockNotificationActionService_Inspect_Call{Call: _e.mock.On("Inspect", ctx, token)}
+}
+
+func (_c *MockNotificationActionService_Inspect_Call) Run(run func(ctx context.Context, token string)) *MockNotificationActionService_Inspect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationActionService_Inspect_Call) Return(notificationActionToken *domain.NotificationActionToken, err error) *MockNotificationActionService_Inspect_Call {
+	_c.Call.Return(notificationActionToken, err)
+	return _c
+}
+
+func (_c *MockNotificationActionService_Inspect_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.NotificationActionToken, error)) *MockNotificationActionService_Inspect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Perform provides a mock function for the type MockNotificationActionService
+func (_mock *MockNotificationActionService) Perform(ctx context.Context, token string, confirmed bool) (*domain.NotificationActionResult, error) {
+	ret := _mock.Called(ctx, token, confirmed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Perform")
+	}
+
+	var r0 *domain.NotificationActionResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (*domain.NotificationActionResult, error)); ok {
+		return returnFunc(ctx, token, confirmed)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) *domain.NotificationActionResult); ok {
+		r0 = returnFunc(ctx, token, confirmed)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationActionResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, token, confirmed)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationActionService_Perform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Perform'
+type MockNotificationActionService_Perform_Call struct {
+	*mock.Call
+}
+
+// Perform is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - confirmed bool
+func (_e *MockNotificationActionService_Expecter) Perform(ctx interface{}, token interface{}, confirmed interface{}) *MockNotificationActionService_Perform_Call {
+	return &MockNotificationActionService_Perform_Call{Call: _e.mock.On("Perform", ctx, token, confirmed)}
+}
+
+func (_c *MockNotificationActionService_Perform_Call) Run(run func(ctx context.Context, token string, confirmed bool)) *MockNotificationActionService_Perform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationActionService_Perform_Call) Return(notificationActionResult *domain.NotificationActionResult, err error) *MockNotificationActionService_Perform_Call {
+	_c.Call.Return(notificationActionResult, err)
+	return _c
+}
+
+func (_c *MockNotificationActionService_Perform_Call) RunAndReturn(run func(ctx context.Context, token string, confirmed bool) (*domain.NotificationActionResult, error)) *MockNotificationActionService_Perform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationChannelService creates a new instance of MockNotificationChannelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationChannelService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationChannelService {
+	mock := &MockNotificationChannelService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationChannelService is an autogenerated mock type for the NotificationChannelService type
+type MockNotificationChannelService struct {
+	mock.Mock
+}
+
+type MockNotificationChannelService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationChannelService) EXPECT() *MockNotificationChannelService_Expecter {
+	return &MockNotificationChannelService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) Create(ctx context.Context, cmd *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.CreateNotificationChannelCommand) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.CreateNotificationChannelCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockNotificationChannelService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd *command.CreateNotificationChannelCommand
+func (_e *MockNotificationChannelService_Expecter) Create(ctx interface{}, cmd interface{}) *MockNotificationChannelService_Create_Call {
+	return &MockNotificationChannelService_Create_Call{Call: _e.mock.On("Create", ctx, cmd)}
+}
+
+func (_c *MockNotificationChannelService_Create_Call) Run(run func(ctx context.Context, cmd *command.CreateNotificationChannelCommand)) *MockNotificationChannelService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *command.CreateNotificationChannelCommand
+		if args[1] != nil {
+			arg1 = args[1].(*command.CreateNotificationChannelCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Create_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_Create_Call {
+	_c.Call.Return(notificationChannel, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Create_Call) RunAndReturn(run func(ctx context.Context, cmd *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error)) *MockNotificationChannelService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationChannelService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockNotificationChannelService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationChannelService_Expecter) Delete(ctx interface{}, id interface{}) *MockNotificationChannelService_Delete_Call {
+	return &MockNotificationChannelService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockNotificationChannelService_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Delete_Call) Return(err error) *MockNotificationChannelService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockNotificationChannelService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *domain.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockNotificationChannelService_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationChannelService_Expecter) FindById(ctx interface{}, id interface{}) *MockNotificationChannelService_FindById_Call {
+	return &MockNotificationChannelService_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockNotificationChannelService_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelService_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_FindById_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_FindById_Call {
+	_c.Call.Return(notificationChannel, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationChannel, error)) *MockNotificationChannelService_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserId provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) ListByUserId(ctx context.Context, userID int64, page int, pageSize int) ([]*domain.NotificationChannel, int, error) {
+	ret := _mock.Called(ctx, userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*domain.NotificationChannel
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.NotificationChannel, int, error)); ok {
+		return returnFunc(ctx, userID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationChannelService_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockNotificationChannelService_ListByUserId_Call struct {
+	*mock.Call
+}
+
+// ListByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - page int
+//   - pageSize int
+func (_e *MockNotificationChannelService_Expecter) ListByUserId(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *MockNotificationChannelService_ListByUserId_Call {
+	return &MockNotificationChannelService_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, page, pageSize)}
+}
+
+func (_c *MockNotificationChannelService_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, page int, pageSize int)) *MockNotificationChannelService_ListByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_ListByUserId_Call) Return(notificationChannels []*domain.NotificationChannel, n int, err error) *MockNotificationChannelService_ListByUserId_Call {
+	_c.Call.Return(notificationChannels, n, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, page int, pageSize int) ([]*domain.NotificationChannel, int, error)) *MockNotificationChannelService_ListByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChannelTypes provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) ListChannelTypes(ctx context.Context) []*domain.NotificationChannelTypeSchema {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChannelTypes")
+	}
+
+	var r0 []*domain.NotificationChannelTypeSchema
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.NotificationChannelTypeSchema); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationChannelTypeSchema)
+		}
+	}
+	return r0
+}
+
+// MockNotificationChannelService_ListChannelTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChannelTypes'
+type MockNotificationChannelService_ListChannelTypes_Call struct {
+	*mock.Call
+}
+
+// ListChannelTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationChannelService_Expecter) ListChannelTypes(ctx interface{}) *MockNotificationChannelService_ListChannelTypes_Call {
+	return &MockNotificationChannelService_ListChannelTypes_Call{Call: _e.mock.On("ListChannelTypes", ctx)}
+}
+
+func (_c *MockNotificationChannelService_ListChannelTypes_Call) Run(run func(ctx context.Context)) *MockNotificationChannelService_ListChannelTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_ListChannelTypes_Call) Return(notificationChannelTypeSchemas []*domain.NotificationChannelTypeSchema) *MockNotificationChannelService_ListChannelTypes_Call {
+	_c.Call.Return(notificationChannelTypeSchemas)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_ListChannelTypes_Call) RunAndReturn(run func(ctx context.Context) []*domain.NotificationChannelTypeSchema) *MockNotificationChannelService_ListChannelTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendVerification provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) ResendVerification(ctx context.Context, id int64, locale i18n.Locale) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, id, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendVerification")
+	}
+
+	var r0 *domain.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, i18n.Locale) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, id, locale)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, i18n.Locale) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, id, locale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, i18n.Locale) error); ok {
+		r1 = returnFunc(ctx, id, locale)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_ResendVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendVerification'
+type MockNotificationChannelService_ResendVerification_Call struct {
+	*mock.Call
+}
+
+// ResendVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - locale i18n.Locale
+func (_e *MockNotificationChannelService_Expecter) ResendVerification(ctx interface{}, id interface{}, locale interface{}) *MockNotificationChannelService_ResendVerification_Call {
+	return &MockNotificationChannelService_ResendVerification_Call{Call: _e.mock.On("ResendVerification", ctx, id, locale)}
+}
+
+func (_c *MockNotificationChannelService_ResendVerification_Call) Run(run func(ctx context.Context, id int64, locale i18n.Locale)) *MockNotificationChannelService_ResendVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 i18n.Locale
+		if args[2] != nil {
+			arg2 = args[2].(i18n.Locale)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_ResendVerification_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_ResendVerification_Call {
+	_c.Call.Return(notificationChannel, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_ResendVerification_Call) RunAndReturn(run func(ctx context.Context, id int64, locale i18n.Locale) (*domain.NotificationChannel, error)) *MockNotificationChannelService_ResendVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSecrets provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) RotateSecrets(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecrets")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_RotateSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecrets'
+type MockNotificationChannelService_RotateSecrets_Call struct {
+	*mock.Call
+}
+
+// RotateSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationChannelService_Expecter) RotateSecrets(ctx interface{}) *MockNotificationChannelService_RotateSecrets_Call {
+	return &MockNotificationChannelService_RotateSecrets_Call{Call: _e.mock.On("RotateSecrets", ctx)}
+}
+
+func (_c *MockNotificationChannelService_RotateSecrets_Call) Run(run func(ctx context.Context)) *MockNotificationChannelService_RotateSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_RotateSecrets_Call) Return(n int, err error) *MockNotificationChannelService_RotateSecrets_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_RotateSecrets_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockNotificationChannelService_RotateSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Test provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) Test(ctx context.Context, id int64) (*domain.NotificationChannelTestResult, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Test")
+	}
+
+	var r0 *domain.NotificationChannelTestResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationChannelTestResult, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationChannelTestResult); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannelTestResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_Test_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Test'
+type MockNotificationChannelService_Test_Call struct {
+	*mock.Call
+}
+
+// Test is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationChannelService_Expecter) Test(ctx interface{}, id interface{}) *MockNotificationChannelService_Test_Call {
+	return &MockNotificationChannelService_Test_Call{Call: _e.mock.On("Test", ctx, id)}
+}
+
+func (_c *MockNotificationChannelService_Test_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationChannelService_Test_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Test_Call) Return(notificationChannelTestResult *domain.NotificationChannelTestResult, err error) *MockNotificationChannelService_Test_Call {
+	_c.Call.Return(notificationChannelTestResult, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Test_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationChannelTestResult, error)) *MockNotificationChannelService_Test_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TestConfig provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) TestConfig(ctx context.Context, channelType domain.NotificationChannelType, config map[string]any) (*domain.NotificationChannelTestResult, error) {
+	ret := _mock.Called(ctx, channelType, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TestConfig")
+	}
+
+	var r0 *domain.NotificationChannelTestResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationChannelType, map[string]any) (*domain.NotificationChannelTestResult, error)); ok {
+		return returnFunc(ctx, channelType, config)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationChannelType, map[string]any) *domain.NotificationChannelTestResult); ok {
+		r0 = returnFunc(ctx, channelType, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannelTestResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NotificationChannelType, map[string]any) error); ok {
+		r1 = returnFunc(ctx, channelType, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_TestConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestConfig'
+type MockNotificationChannelService_TestConfig_Call struct {
+	*mock.Call
+}
+
+// TestConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelType domain.NotificationChannelType
+//   - config map[string]any
+func (_e *MockNotificationChannelService_Expecter) TestConfig(ctx interface{}, channelType interface{}, config interface{}) *MockNotificationChannelService_TestConfig_Call {
+	return &MockNotificationChannelService_TestConfig_Call{Call: _e.mock.On("TestConfig", ctx, channelType, config)}
+}
+
+func (_c *MockNotificationChannelService_TestConfig_Call) Run(run func(ctx context.Context, channelType domain.NotificationChannelType, config map[string]any)) *MockNotificationChannelService_TestConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.NotificationChannelType
+		if args[1] != nil {
+			arg1 = args[1].(domain.NotificationChannelType)
+		}
+		var arg2 map[string]any
+		if args[2] != nil {
+			arg2 = args[2].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_TestConfig_Call) Return(notificationChannelTestResult *domain.NotificationChannelTestResult, err error) *MockNotificationChannelService_TestConfig_Call {
+	_c.Call.Return(notificationChannelTestResult, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_TestConfig_Call) RunAndReturn(run func(ctx context.Context, channelType domain.NotificationChannelType, config map[string]any) (*domain.NotificationChannelTestResult, error)) *MockNotificationChannelService_TestConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) Update(ctx context.Context, cmd *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.UpdateNotificationChannelCommand) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.UpdateNotificationChannelCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockNotificationChannelService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd *command.UpdateNotificationChannelCommand
+func (_e *MockNotificationChannelService_Expecter) Update(ctx interface{}, cmd interface{}) *MockNotificationChannelService_Update_Call {
+	return &MockNotificationChannelService_Update_Call{Call: _e.mock.On("Update", ctx, cmd)}
+}
+
+func (_c *MockNotificationChannelService_Update_Call) Run(run func(ctx context.Context, cmd *command.UpdateNotificationChannelCommand)) *MockNotificationChannelService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *command.UpdateNotificationChannelCommand
+		if args[1] != nil {
+			arg1 = args[1].(*command.UpdateNotificationChannelCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Update_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_Update_Call {
+	_c.Call.Return(notificationChannel, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Update_Call) RunAndReturn(run func(ctx context.Context, cmd *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error)) *MockNotificationChannelService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function for the type MockNotificationChannelService
+func (_mock *MockNotificationChannelService) Verify(ctx context.Context, id int64, code string) (*domain.NotificationChannel, error) {
+	ret := _mock.Called(ctx, id, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 *domain.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (*domain.NotificationChannel, error)); ok {
+		return returnFunc(ctx, id, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) *domain.NotificationChannel); ok {
+		r0 = returnFunc(ctx, id, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationChannel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, id, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationChannelService_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockNotificationChannelService_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - code string
+func (_e *MockNotificationChannelService_Expecter) Verify(ctx interface{}, id interface{}, code interface{}) *MockNotificationChannelService_Verify_Call {
+	return &MockNotificationChannelService_Verify_Call{Call: _e.mock.On("Verify", ctx, id, code)}
+}
+
+func (_c *MockNotificationChannelService_Verify_Call) Run(run func(ctx context.Context, id int64, code string)) *MockNotificationChannelService_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Verify_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_Verify_Call {
+	_c.Call.Return(notificationChannel, err)
+	return _c
+}
+
+func (_c *MockNotificationChannelService_Verify_Call) RunAndReturn(run func(ctx context.Context, id int64, code string) (*domain.NotificationChannel, error)) *MockNotificationChannelService_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationDeliveryService creates a new instance of MockNotificationDeliveryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationDeliveryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationDeliveryService {
+	mock := &MockNotificationDeliveryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationDeliveryService is an autogenerated mock type for the NotificationDeliveryService type
+type MockNotificationDeliveryService struct {
+	mock.Mock
+}
+
+type MockNotificationDeliveryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationDeliveryService) EXPECT() *MockNotificationDeliveryService_Expecter {
+	return &MockNotificationDeliveryService_Expecter{mock: &_m.Mock}
+}
+
+// Acknowledge provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) Acknowledge(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acknowledge")
+	}
+
+	var r0 *domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_Acknowledge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acknowledge'
+type MockNotificationDeliveryService_Acknowledge_Call struct {
+	*mock.Call
+}
+
+// Acknowledge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationDeliveryService_Expecter) Acknowledge(ctx interface{}, id interface{}) *MockNotificationDeliveryService_Acknowledge_Call {
+	return &MockNotificationDeliveryService_Acknowledge_Call{Call: _e.mock.On("Acknowledge", ctx, id)}
+}
+
+func (_c *MockNotificationDeliveryService_Acknowledge_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationDeliveryService_Acknowledge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Acknowledge_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryService_Acknowledge_Call {
+	_c.Call.Return(notificationDelivery, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Acknowledge_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryService_Acknowledge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimDue provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) ClaimDue(ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type MockNotificationDeliveryService_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDeliveryService_Expecter) ClaimDue(ctx interface{}) *MockNotificationDeliveryService_ClaimDue_Call {
+	return &MockNotificationDeliveryService_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx)}
+}
+
+func (_c *MockNotificationDeliveryService_ClaimDue_Call) Run(run func(ctx context.Context)) *MockNotificationDeliveryService_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ClaimDue_Call) Return(notificationDeliverys []*domain.NotificationDelivery, err error) *MockNotificationDeliveryService_ClaimDue_Call {
+	_c.Call.Return(notificationDeliverys, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ClaimDue_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.NotificationDelivery, error)) *MockNotificationDeliveryService_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) Dispatch(ctx context.Context, routing domain.NotificationRouting, follow *domain.UserFollowedStreamer, targets []DeliveryTarget) ([]*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, routing, follow, targets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationRouting, *domain.UserFollowedStreamer, []DeliveryTarget) ([]*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, routing, follow, targets)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationRouting, *domain.UserFollowedStreamer, []DeliveryTarget) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, routing, follow, targets)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NotificationRouting, *domain.UserFollowedStreamer, []DeliveryTarget) error); ok {
+		r1 = returnFunc(ctx, routing, follow, targets)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type MockNotificationDeliveryService_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - routing domain.NotificationRouting
+//   - follow *domain.UserFollowedStreamer
+//   - targets []DeliveryTarget
+func (_e *MockNotificationDeliveryService_Expecter) Dispatch(ctx interface{}, routing interface{}, follow interface{}, targets interface{}) *MockNotificationDeliveryService_Dispatch_Call {
+	return &MockNotificationDeliveryService_Dispatch_Call{Call: _e.mock.On("Dispatch", ctx, routing, follow, targets)}
+}
+
+func (_c *MockNotificationDeliveryService_Dispatch_Call) Run(run func(ctx context.Context, routing domain.NotificationRouting, follow *domain.UserFollowedStreamer, targets []DeliveryTarget)) *MockNotificationDeliveryService_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.NotificationRouting
+		if args[1] != nil {
+			arg1 = args[1].(domain.NotificationRouting)
+		}
+		var arg2 *domain.UserFollowedStreamer
+		if args[2] != nil {
+			arg2 = args[2].(*domain.UserFollowedStreamer)
+		}
+		var arg3 []DeliveryTarget
+		if args[3] != nil {
+			arg3 = args[3].([]DeliveryTarget)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Dispatch_Call) Return(notificationDeliverys []*domain.NotificationDelivery, err error) *MockNotificationDeliveryService_Dispatch_Call {
+	_c.Call.Return(notificationDeliverys, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Dispatch_Call) RunAndReturn(run func(ctx context.Context, routing domain.NotificationRouting, follow *domain.UserFollowedStreamer, targets []DeliveryTarget) ([]*domain.NotificationDelivery, error)) *MockNotificationDeliveryService_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) FindById(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockNotificationDeliveryService_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationDeliveryService_Expecter) FindById(ctx interface{}, id interface{}) *MockNotificationDeliveryService_FindById_Call {
+	return &MockNotificationDeliveryService_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockNotificationDeliveryService_FindById_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationDeliveryService_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_FindById_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryService_FindById_Call {
+	_c.Call.Return(notificationDelivery, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_FindById_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryService_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlushDigests provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) FlushDigests(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushDigests")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_FlushDigests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushDigests'
+type MockNotificationDeliveryService_FlushDigests_Call struct {
+	*mock.Call
+}
+
+// FlushDigests is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDeliveryService_Expecter) FlushDigests(ctx interface{}) *MockNotificationDeliveryService_FlushDigests_Call {
+	return &MockNotificationDeliveryService_FlushDigests_Call{Call: _e.mock.On("FlushDigests", ctx)}
+}
+
+func (_c *MockNotificationDeliveryService_FlushDigests_Call) Run(run func(ctx context.Context)) *MockNotificationDeliveryService_FlushDigests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_FlushDigests_Call) Return(n int, err error) *MockNotificationDeliveryService_FlushDigests_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_FlushDigests_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockNotificationDeliveryService_FlushDigests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlushThrottled provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) FlushThrottled(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushThrottled")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_FlushThrottled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushThrottled'
+type MockNotificationDeliveryService_FlushThrottled_Call struct {
+	*mock.Call
+}
+
+// FlushThrottled is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDeliveryService_Expecter) FlushThrottled(ctx interface{}) *MockNotificationDeliveryService_FlushThrottled_Call {
+	return &MockNotificationDeliveryService_FlushThrottled_Call{Call: _e.mock.On("FlushThrottled", ctx)}
+}
+
+func (_c *MockNotificationDeliveryService_FlushThrottled_Call) Run(run func(ctx context.Context)) *MockNotificationDeliveryService_FlushThrottled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_FlushThrottled_Call) Return(n int, err error) *MockNotificationDeliveryService_FlushThrottled_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_FlushThrottled_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockNotificationDeliveryService_FlushThrottled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserId provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) ListByUserId(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, page int, pageSize int) ([]*domain.NotificationDelivery, int, error) {
+	ret := _mock.Called(ctx, userID, filter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) ([]*domain.NotificationDelivery, int, error)); ok {
+		return returnFunc(ctx, userID, filter, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, userID, filter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, filter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *domain.NotificationDeliveryFilter, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, filter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationDeliveryService_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockNotificationDeliveryService_ListByUserId_Call struct {
+	*mock.Call
+}
+
+// ListByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - filter *domain.NotificationDeliveryFilter
+//   - page int
+//   - pageSize int
+func (_e *MockNotificationDeliveryService_Expecter) ListByUserId(ctx interface{}, userID interface{}, filter interface{}, page interface{}, pageSize interface{}) *MockNotificationDeliveryService_ListByUserId_Call {
+	return &MockNotificationDeliveryService_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, filter, page, pageSize)}
+}
+
+func (_c *MockNotificationDeliveryService_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, page int, pageSize int)) *MockNotificationDeliveryService_ListByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *domain.NotificationDeliveryFilter
+		if args[2] != nil {
+			arg2 = args[2].(*domain.NotificationDeliveryFilter)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ListByUserId_Call) Return(notificationDeliverys []*domain.NotificationDelivery, n int, err error) *MockNotificationDeliveryService_ListByUserId_Call {
+	_c.Call.Return(notificationDeliverys, n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, page int, pageSize int) ([]*domain.NotificationDelivery, int, error)) *MockNotificationDeliveryService_ListByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadLetters provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) ListDeadLetters(ctx context.Context, filter *domain.NotificationDeliveryFilter, page int, pageSize int) ([]*domain.NotificationDelivery, int, error) {
+	ret := _mock.Called(ctx, filter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetters")
+	}
+
+	var r0 []*domain.NotificationDelivery
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) ([]*domain.NotificationDelivery, int, error)); ok {
+		return returnFunc(ctx, filter, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) []*domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, filter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) int); ok {
+		r1 = returnFunc(ctx, filter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *domain.NotificationDeliveryFilter, int, int) error); ok {
+		r2 = returnFunc(ctx, filter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockNotificationDeliveryService_ListDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetters'
+type MockNotificationDeliveryService_ListDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.NotificationDeliveryFilter
+//   - page int
+//   - pageSize int
+func (_e *MockNotificationDeliveryService_Expecter) ListDeadLetters(ctx interface{}, filter interface{}, page interface{}, pageSize interface{}) *MockNotificationDeliveryService_ListDeadLetters_Call {
+	return &MockNotificationDeliveryService_ListDeadLetters_Call{Call: _e.mock.On("ListDeadLetters", ctx, filter, page, pageSize)}
+}
+
+func (_c *MockNotificationDeliveryService_ListDeadLetters_Call) Run(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter, page int, pageSize int)) *MockNotificationDeliveryService_ListDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationDeliveryFilter)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ListDeadLetters_Call) Return(notificationDeliverys []*domain.NotificationDelivery, n int, err error) *MockNotificationDeliveryService_ListDeadLetters_Call {
+	_c.Call.Return(notificationDeliverys, n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ListDeadLetters_Call) RunAndReturn(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter, page int, pageSize int) ([]*domain.NotificationDelivery, int, error)) *MockNotificationDeliveryService_ListDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Process provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) Process(ctx context.Context, delivery *domain.NotificationDelivery) error {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Process")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDelivery) error); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationDeliveryService_Process_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Process'
+type MockNotificationDeliveryService_Process_Call struct {
+	*mock.Call
+}
+
+// Process is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *domain.NotificationDelivery
+func (_e *MockNotificationDeliveryService_Expecter) Process(ctx interface{}, delivery interface{}) *MockNotificationDeliveryService_Process_Call {
+	return &MockNotificationDeliveryService_Process_Call{Call: _e.mock.On("Process", ctx, delivery)}
+}
+
+func (_c *MockNotificationDeliveryService_Process_Call) Run(run func(ctx context.Context, delivery *domain.NotificationDelivery)) *MockNotificationDeliveryService_Process_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Process_Call) Return(err error) *MockNotificationDeliveryService_Process_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Process_Call) RunAndReturn(run func(ctx context.Context, delivery *domain.NotificationDelivery) error) *MockNotificationDeliveryService_Process_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpired provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) PurgeExpired(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_PurgeExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpired'
+type MockNotificationDeliveryService_PurgeExpired_Call struct {
+	*mock.Call
+}
+
+// PurgeExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotificationDeliveryService_Expecter) PurgeExpired(ctx interface{}) *MockNotificationDeliveryService_PurgeExpired_Call {
+	return &MockNotificationDeliveryService_PurgeExpired_Call{Call: _e.mock.On("PurgeExpired", ctx)}
+}
+
+func (_c *MockNotificationDeliveryService_PurgeExpired_Call) Run(run func(ctx context.Context)) *MockNotificationDeliveryService_PurgeExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_PurgeExpired_Call) Return(n int, err error) *MockNotificationDeliveryService_PurgeExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_PurgeExpired_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockNotificationDeliveryService_PurgeExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayDeadLetters provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) ReplayDeadLetters(ctx context.Context, filter *domain.NotificationDeliveryFilter) (int, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetters")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter) (int, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationDeliveryFilter) int); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.NotificationDeliveryFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_ReplayDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDeadLetters'
+type MockNotificationDeliveryService_ReplayDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ReplayDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.NotificationDeliveryFilter
+func (_e *MockNotificationDeliveryService_Expecter) ReplayDeadLetters(ctx interface{}, filter interface{}) *MockNotificationDeliveryService_ReplayDeadLetters_Call {
+	return &MockNotificationDeliveryService_ReplayDeadLetters_Call{Call: _e.mock.On("ReplayDeadLetters", ctx, filter)}
+}
+
+func (_c *MockNotificationDeliveryService_ReplayDeadLetters_Call) Run(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter)) *MockNotificationDeliveryService_ReplayDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationDeliveryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ReplayDeadLetters_Call) Return(n int, err error) *MockNotificationDeliveryService_ReplayDeadLetters_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_ReplayDeadLetters_Call) RunAndReturn(run func(ctx context.Context, filter *domain.NotificationDeliveryFilter) (int, error)) *MockNotificationDeliveryService_ReplayDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resend provides a mock function for the type MockNotificationDeliveryService
+func (_mock *MockNotificationDeliveryService) Resend(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resend")
+	}
+
+	var r0 *domain.NotificationDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationDeliveryService_Resend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resend'
+type MockNotificationDeliveryService_Resend_Call struct {
+	*mock.Call
+}
+
+// Resend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockNotificationDeliveryService_Expecter) Resend(ctx interface{}, id interface{}) *MockNotificationDeliveryService_Resend_Call {
+	return &MockNotificationDeliveryService_Resend_Call{Call: _e.mock.On("Resend", ctx, id)}
+}
+
+func (_c *MockNotificationDeliveryService_Resend_Call) Run(run func(ctx context.Context, id int64)) *MockNotificationDeliveryService_Resend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Resend_Call) Return(notificationDelivery *domain.NotificationDelivery, err error) *MockNotificationDeliveryService_Resend_Call {
+	_c.Call.Return(notificationDelivery, err)
+	return _c
+}
+
+func (_c *MockNotificationDeliveryService_Resend_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.NotificationDelivery, error)) *MockNotificationDeliveryService_Resend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationPreferenceService creates a new instance of MockNotificationPreferenceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationPreferenceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationPreferenceService {
+	mock := &MockNotificationPreferenceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationPreferenceService is an autogenerated mock type for the NotificationPreferenceService type
+type MockNotificationPreferenceService struct {
+	mock.Mock
+}
+
+type MockNotificationPreferenceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationPreferenceService) EXPECT() *MockNotificationPreferenceService_Expecter {
+	return &MockNotificationPreferenceService_Expecter{mock: &_m.Mock}
+}
+
+// FindByUserId provides a mock function for the type MockNotificationPreferenceService
+func (_mock *MockNotificationPreferenceService) FindByUserId(ctx context.Context, userID int64) (*domain.NotificationPreference, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 *domain.NotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.NotificationPreference, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.NotificationPreference); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceService_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockNotificationPreferenceService_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *MockNotificationPreferenceService_Expecter) FindByUserId(ctx interface{}, userID interface{}) *MockNotificationPreferenceService_FindByUserId_Call {
+	return &MockNotificationPreferenceService_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userID)}
+}
+
+func (_c *MockNotificationPreferenceService_FindByUserId_Call) Run(run func(ctx context.Context, userID int64)) *MockNotificationPreferenceService_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceService_FindByUserId_Call) Return(notificationPreference *domain.NotificationPreference, err error) *MockNotificationPreferenceService_FindByUserId_Call {
+	_c.Call.Return(notificationPreference, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceService_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64) (*domain.NotificationPreference, error)) *MockNotificationPreferenceService_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDoNotDisturb provides a mock function for the type MockNotificationPreferenceService
+func (_mock *MockNotificationPreferenceService) SetDoNotDisturb(ctx context.Context, cmd *command.SetDoNotDisturbCommand) (*domain.NotificationPreference, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDoNotDisturb")
+	}
+
+	var r0 *domain.NotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.SetDoNotDisturbCommand) (*domain.NotificationPreference, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.SetDoNotDisturbCommand) *domain.NotificationPreference); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.SetDoNotDisturbCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceService_SetDoNotDisturb_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDoNotDisturb'
+type MockNotificationPreferenceService_SetDoNotDisturb_Call struct {
+	*mock.Call
+}
+
+// SetDoNotDisturb is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd *command.SetDoNotDisturbCommand
+func (_e *MockNotificationPreferenceService_Expecter) SetDoNotDisturb(ctx interface{}, cmd interface{}) *MockNotificationPreferenceService_SetDoNotDisturb_Call {
+	return &MockNotificationPreferenceService_SetDoNotDisturb_Call{Call: _e.mock.On("SetDoNotDisturb", ctx, cmd)}
+}
+
+func (_c *MockNotificationPreferenceService_SetDoNotDisturb_Call) Run(run func(ctx context.Context, cmd *command.SetDoNotDisturbCommand)) *MockNotificationPreferenceService_SetDoNotDisturb_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *command.SetDoNotDisturbCommand
+		if args[1] != nil {
+			arg1 = args[1].(*command.SetDoNotDisturbCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceService_SetDoNotDisturb_Call) Return(notificationPreference *domain.NotificationPreference, err error) *MockNotificationPreferenceService_SetDoNotDisturb_Call {
+	_c.Call.Return(notificationPreference, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceService_SetDoNotDisturb_Call) RunAndReturn(run func(ctx context.Context, cmd *command.SetDoNotDisturbCommand) (*domain.NotificationPreference, error)) *MockNotificationPreferenceService_SetDoNotDisturb_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockNotificationPreferenceService
+func (_mock *MockNotificationPreferenceService) Update(ctx context.Context, cmd *command.UpdateNotificationPreferenceCommand) (*domain.NotificationPreference, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.NotificationPreference
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.UpdateNotificationPreferenceCommand) (*domain.NotificationPreference, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.UpdateNotificationPreferenceCommand) *domain.NotificationPreference); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreference)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.UpdateNotificationPreferenceCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationPreferenceService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockNotificationPreferenceService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd *command.UpdateNotificationPreferenceCommand
+func (_e *MockNotificationPreferenceService_Expecter) Update(ctx interface{}, cmd interface{}) *MockNotificationPreferenceService_Update_Call {
+	return &MockNotificationPreferenceService_Update_Call{Call: _e.mock.On("Update", ctx, cmd)}
+}
+
+func (_c *MockNotificationPreferenceService_Update_Call) Run(run func(ctx context.Context, cmd *command.UpdateNotificationPreferenceCommand)) *MockNotificationPreferenceService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *command.UpdateNotificationPreferenceCommand
+		if args[1] != nil {
+			arg1 = args[1].(*command.UpdateNotificationPreferenceCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationPreferenceService_Update_Call) Return(notificationPreference *domain.NotificationPreference, err error) *MockNotificationPreferenceService_Update_Call {
+	_c.Call.Return(notificationPreference, err)
+	return _c
+}
+
+func (_c *MockNotificationPreferenceService_Update_Call) RunAndReturn(run func(ctx context.Context, cmd *command.UpdateNotificationPreferenceCommand) (*domain.NotificationPreference, error)) *MockNotificationPreferenceService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationTemplateService creates a new instance of MockNotificationTemplateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationTemplateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationTemplateService {
+	mock := &MockNotificationTemplateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationTemplateService is an autogenerated mock type for the NotificationTemplateService type
+type MockNotificationTemplateService struct {
+	mock.Mock
+}
+
+type MockNotificationTemplateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationTemplateService) EXPECT() *MockNotificationTemplateService_Expecter {
+	return &MockNotificationTemplateService_Expecter{mock: &_m.Mock}
+}
+
+// Preview provides a mock function for the type MockNotificationTemplateService
+func (_mock *MockNotificationTemplateService) Preview(ctx context.Context, cmd *command.PreviewNotificationTemplateCommand) (*domain.RenderedNotification, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preview")
+	}
+
+	var r0 *domain.RenderedNotification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.PreviewNotificationTemplateCommand) (*domain.RenderedNotification, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.PreviewNotificationTemplateCommand) *domain.RenderedNotification); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RenderedNotification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.PreviewNotificationTemplateCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationTemplateService_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type MockNotificationTemplateService_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd *command.PreviewNotificationTemplateCommand
+func (_e *MockNotificationTemplateService_Expecter) Preview(ctx interface{}, cmd interface{}) *MockNotificationTemplateService_Preview_Call {
+	return &MockNotificationTemplateService_Preview_Call{Call: _e.mock.On("Preview", ctx, cmd)}
+}
+
+func (_c *MockNotificationTemplateService_Preview_Call) Run(run func(ctx context.Context, cmd *command.PreviewNotificationTemplateCommand)) *MockNotificationTemplateService_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *command.PreviewNotificationTemplateCommand
+		if args[1] != nil {
+			arg1 = args[1].(*command.PreviewNotificationTemplateCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationTemplateService_Preview_Call) Return(renderedNotification *domain.RenderedNotification, err error) *MockNotificationTemplateService_Preview_Call {
+	_c.Call.Return(renderedNotification, err)
+	return _c
+}
+
+func (_c *MockNotificationTemplateService_Preview_Call) RunAndReturn(run func(ctx context.Context, cmd *command.PreviewNotificationTemplateCommand) (*domain.RenderedNotification, error)) *MockNotificationTemplateService_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Variables provides a mock function for the type MockNotificationTemplateService
+func (_mock *MockNotificationTemplateService) Variables() []domain.NotificationTemplateVariable {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Variables")
+	}
+
+	var r0 []domain.NotificationTemplateVariable
+	if returnFunc, ok := ret.Get(0).(func() []domain.NotificationTemplateVariable); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.NotificationChannel)
+			r0 = ret.Get(0).([]domain.NotificationTemplateVariable)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.UpdateNotificationChannelCommand) error); ok {
-		r1 = returnFunc(ctx, cmd)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+	return r0
 }
 
-// MockNotificationChannelService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockNotificationChannelService_Update_Call struct {
+// MockNotificationTemplateService_Variables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Variables'
+type MockNotificationTemplateService_Variables_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - ctx context.Context
-//   - cmd *command.UpdateNotificationChannelCommand
-func (_e *MockNotificationChannelService_Expecter) Update(ctx interface{}, cmd interface{}) *MockNotificationChannelService_Update_Call {
-	return &MockNotificationChannelService_Update_Call{Call: _e.mock.On("Update", ctx, cmd)}
+// Variables is a helper method to define mock.On call
+func (_e *MockNotificationTemplateService_Expecter) Variables() *MockNotificationTemplateService_Variables_Call {
+	return &MockNotificationTemplateService_Variables_Call{Call: _e.mock.On("Variables")}
 }
 
-func (_c *MockNotificationChannelService_Update_Call) Run(run func(ctx context.Context, cmd *command.UpdateNotificationChannelCommand)) *MockNotificationChannelService_Update_Call {
+func (_c *MockNotificationTemplateService_Variables_Call) Run(run func()) *MockNotificationTemplateService_Variables_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 *command.UpdateNotificationChannelCommand
-		if args[1] != nil {
-			arg1 = args[1].(*command.UpdateNotificationChannelCommand)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockNotificationChannelService_Update_Call) Return(notificationChannel *domain.NotificationChannel, err error) *MockNotificationChannelService_Update_Call {
-	_c.Call.Return(notificationChannel, err)
+func (_c *MockNotificationTemplateService_Variables_Call) Return(notificationTemplateVariables []domain.NotificationTemplateVariable) *MockNotificationTemplateService_Variables_Call {
+	_c.Call.Return(notificationTemplateVariables)
 	return _c
 }
 
-func (_c *MockNotificationChannelService_Update_Call) RunAndReturn(run func(ctx context.Context, cmd *command.UpdateNotificationChannelCommand) (*domain.NotificationChannel, error)) *MockNotificationChannelService_Update_Call {
+func (_c *MockNotificationTemplateService_Variables_Call) RunAndReturn(run func() []domain.NotificationTemplateVariable) *MockNotificationTemplateService_Variables_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2328,3 +4781,301 @@ func (_c *MockUserService_Update_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockWebPushService creates a new instance of MockWebPushService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebPushService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebPushService {
+	mock := &MockWebPushService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebPushService is an autogenerated mock type for the WebPushService type
+type MockWebPushService struct {
+	mock.Mock
+}
+
+type MockWebPushService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebPushService) EXPECT() *MockWebPushService_Expecter {
+	return &MockWebPushService_Expecter{mock: &_m.Mock}
+}
+
+// GetVAPIDPublicKey provides a mock function for the type MockWebPushService
+func (_mock *MockWebPushService) GetVAPIDPublicKey(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVAPIDPublicKey")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebPushService_GetVAPIDPublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVAPIDPublicKey'
+type MockWebPushService_GetVAPIDPublicKey_Call struct {
+	*mock.Call
+}
+
+// GetVAPIDPublicKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebPushService_Expecter) GetVAPIDPublicKey(ctx interface{}) *MockWebPushService_GetVAPIDPublicKey_Call {
+	return &MockWebPushService_GetVAPIDPublicKey_Call{Call: _e.mock.On("GetVAPIDPublicKey", ctx)}
+}
+
+func (_c *MockWebPushService_GetVAPIDPublicKey_Call) Run(run func(ctx context.Context)) *MockWebPushService_GetVAPIDPublicKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebPushService_GetVAPIDPublicKey_Call) Return(s string, err error) *MockWebPushService_GetVAPIDPublicKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockWebPushService_GetVAPIDPublicKey_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *MockWebPushService_GetVAPIDPublicKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserId provides a mock function for the type MockWebPushService
+func (_mock *MockWebPushService) ListByUserId(ctx context.Context, userID int64, page int, pageSize int) ([]*domain.WebPushSubscription, int, error) {
+	ret := _mock.Called(ctx, userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*domain.WebPushSubscription
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]*domain.WebPushSubscription, int, error)); ok {
+		return returnFunc(ctx, userID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int, int) []*domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebPushSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int, int) int); ok {
+		r1 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int, int) error); ok {
+		r2 = returnFunc(ctx, userID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockWebPushService_ListByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserId'
+type MockWebPushService_ListByUserId_Call struct {
+	*mock.Call
+}
+
+// ListByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - page int
+//   - pageSize int
+func (_e *MockWebPushService_Expecter) ListByUserId(ctx interface{}, userID interface{}, page interface{}, pageSize interface{}) *MockWebPushService_ListByUserId_Call {
+	return &MockWebPushService_ListByUserId_Call{Call: _e.mock.On("ListByUserId", ctx, userID, page, pageSize)}
+}
+
+func (_c *MockWebPushService_ListByUserId_Call) Run(run func(ctx context.Context, userID int64, page int, pageSize int)) *MockWebPushService_ListByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebPushService_ListByUserId_Call) Return(webPushSubscriptions []*domain.WebPushSubscription, n int, err error) *MockWebPushService_ListByUserId_Call {
+	_c.Call.Return(webPushSubscriptions, n, err)
+	return _c
+}
+
+func (_c *MockWebPushService_ListByUserId_Call) RunAndReturn(run func(ctx context.Context, userID int64, page int, pageSize int) ([]*domain.WebPushSubscription, int, error)) *MockWebPushService_ListByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockWebPushService
+func (_mock *MockWebPushService) Subscribe(ctx context.Context, cmd *command.SubscribeWebPushCommand) (*domain.WebPushSubscription, error) {
+	ret := _mock.Called(ctx, cmd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *domain.WebPushSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.SubscribeWebPushCommand) (*domain.WebPushSubscription, error)); ok {
+		return returnFunc(ctx, cmd)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *command.SubscribeWebPushCommand) *domain.WebPushSubscription); ok {
+		r0 = returnFunc(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebPushSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *command.SubscribeWebPushCommand) error); ok {
+		r1 = returnFunc(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebPushService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockWebPushService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd *command.SubscribeWebPushCommand
+func (_e *MockWebPushService_Expecter) Subscribe(ctx interface{}, cmd interface{}) *MockWebPushService_Subscribe_Call {
+	return &MockWebPushService_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, cmd)}
+}
+
+func (_c *MockWebPushService_Subscribe_Call) Run(run func(ctx context.Context, cmd *command.SubscribeWebPushCommand)) *MockWebPushService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *command.SubscribeWebPushCommand
+		if args[1] != nil {
+			arg1 = args[1].(*command.SubscribeWebPushCommand)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebPushService_Subscribe_Call) Return(webPushSubscription *domain.WebPushSubscription, err error) *MockWebPushService_Subscribe_Call {
+	_c.Call.Return(webPushSubscription, err)
+	return _c
+}
+
+func (_c *MockWebPushService_Subscribe_Call) RunAndReturn(run func(ctx context.Context, cmd *command.SubscribeWebPushCommand) (*domain.WebPushSubscription, error)) *MockWebPushService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function for the type MockWebPushService
+func (_mock *MockWebPushService) Unsubscribe(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebPushService_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type MockWebPushService_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWebPushService_Expecter) Unsubscribe(ctx interface{}, id interface{}) *MockWebPushService_Unsubscribe_Call {
+	return &MockWebPushService_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", ctx, id)}
+}
+
+func (_c *MockWebPushService_Unsubscribe_Call) Run(run func(ctx context.Context, id int64)) *MockWebPushService_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebPushService_Unsubscribe_Call) Return(err error) *MockWebPushService_Unsubscribe_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebPushService_Unsubscribe_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockWebPushService_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
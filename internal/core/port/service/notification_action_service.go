@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+// NotificationActionService carries out what users do from the signed links in their notifications.
+type NotificationActionService interface {
+	// Inspect checks the signed token without acting on it, e.g. to ask for confirmation first.
+	Inspect(ctx context.Context, token string) (*domain.NotificationActionToken, error)
+
+	// Perform checks the signed token and applies its action to the follow. Any action also
+	// acknowledges the notification the link came with, so its route stops escalating. Actions that
+	// need confirmation are refused unless confirmed is set, without acknowledging anything.
+	Perform(ctx context.Context, token string, confirmed bool) (*domain.NotificationActionResult, error)
+}
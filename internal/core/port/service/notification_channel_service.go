@@ -5,8 +5,11 @@ import (
 
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 )
 
+// NotificationChannelService manages channels on behalf of API clients: secret config
+// fields of returned channels are redacted.
 type NotificationChannelService interface {
 	Create(ctx context.Context, cmd *command.CreateNotificationChannelCommand) (*domain.NotificationChannel, error)
 
@@ -17,4 +20,21 @@ type NotificationChannelService interface {
 	FindById(ctx context.Context, id int64) (*domain.NotificationChannel, error)
 
 	ListByUserId(ctx context.Context, userID int64, page, pageSize int) ([]*domain.NotificationChannel, int, error)
+
+	ListChannelTypes(ctx context.Context) []*domain.NotificationChannelTypeSchema
+
+	// RotateSecrets re-encrypts stored channel secrets with the active master key.
+	RotateSecrets(ctx context.Context) (int, error)
+
+	// Test sends a test message through a saved channel and records the outcome on it.
+	Test(ctx context.Context, id int64) (*domain.NotificationChannelTestResult, error)
+
+	// ResendVerification sends a new verification code to a channel that is not verified yet.
+	ResendVerification(ctx context.Context, id int64, locale i18n.Locale) (*domain.NotificationChannel, error)
+
+	// Verify confirms a channel with the code it received and enables it.
+	Verify(ctx context.Context, id int64, code string) (*domain.NotificationChannel, error)
+
+	// TestConfig sends a test message using a config that has not been saved yet.
+	TestConfig(ctx context.Context, channelType domain.NotificationChannelType, config map[string]any) (*domain.NotificationChannelTestResult, error)
 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+)
+
+// DeliveryTarget is a channel together with the message rendered for it.
+type DeliveryTarget struct {
+	Channel *domain.NotificationChannel
+	Data    *external.NotificationData
+}
+
+type NotificationDeliveryService interface {
+	// Dispatch queues one notification for the targets, ordered by channel priority, as routing prescribes:
+	// all at once for broadcast, one step per channel otherwise. Targets whose channel type has no
+	// provider are left out. Channels with a digest window batch the notification for their next
+	// digest instead and take no part in sequential routes.
+	Dispatch(ctx context.Context, routing domain.NotificationRouting, follow *domain.UserFollowedStreamer, targets []DeliveryTarget) ([]*domain.NotificationDelivery, error)
+
+	// ClaimDue locks the next batch of due deliveries for the calling worker.
+	ClaimDue(ctx context.Context) ([]*domain.NotificationDelivery, error)
+
+	// Process sends a claimed delivery and records the outcome, scheduling a retry or dead-lettering it on failure.
+	// Once the delivery is sent or dead, the user's event streams hear about it.
+	Process(ctx context.Context, delivery *domain.NotificationDelivery) error
+
+	// Acknowledge records that the user saw the notification and stops its route from escalating further.
+	Acknowledge(ctx context.Context, id int64) (*domain.NotificationDelivery, error)
+
+	// FlushDigests queues a digest for every channel whose digest window has closed and returns how many were queued.
+	FlushDigests(ctx context.Context) (int, error)
+
+	// FlushThrottled sends the go-live notifications held back by the send rate limit, collapsed into one
+	// message per channel, for every channel the limit allows again. It returns how many were queued.
+	FlushThrottled(ctx context.Context) (int, error)
+
+	// Resend puts a delivery back in the queue.
+	Resend(ctx context.Context, id int64) (*domain.NotificationDelivery, error)
+
+	FindById(ctx context.Context, id int64) (*domain.NotificationDelivery, error)
+
+	ListByUserId(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, page, pageSize int) ([]*domain.NotificationDelivery, int, error)
+
+	ListDeadLetters(ctx context.Context, filter *domain.NotificationDeliveryFilter, page, pageSize int) ([]*domain.NotificationDelivery, int, error)
+
+	// ReplayDeadLetters requeues every dead delivery matching filter and returns how many were requeued.
+	ReplayDeadLetters(ctx context.Context, filter *domain.NotificationDeliveryFilter) (int, error)
+
+	// PurgeExpired removes deliveries older than the configured retention.
+	PurgeExpired(ctx context.Context) (int, error)
+}
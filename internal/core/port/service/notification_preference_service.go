@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type NotificationPreferenceService interface {
+	// FindByUserId returns the user's preference, or the defaults when none has been saved.
+	FindByUserId(ctx context.Context, userID int64) (*domain.NotificationPreference, error)
+
+	// Update saves the user's preference, creating it on first use. Do-not-disturb is left as it is.
+	Update(ctx context.Context, cmd *command.UpdateNotificationPreferenceCommand) (*domain.NotificationPreference, error)
+
+	// SetDoNotDisturb switches do-not-disturb on for the given number of minutes, or off.
+	SetDoNotDisturb(ctx context.Context, cmd *command.SetDoNotDisturbCommand) (*domain.NotificationPreference, error)
+}
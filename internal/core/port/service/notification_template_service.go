@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type NotificationTemplateService interface {
+	Preview(ctx context.Context, cmd *command.PreviewNotificationTemplateCommand) (*domain.RenderedNotification, error)
+
+	Variables() []domain.NotificationTemplateVariable
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+)
+
+type WebPushService interface {
+	GetVAPIDPublicKey(ctx context.Context) (string, error)
+
+	Subscribe(ctx context.Context, cmd *command.SubscribeWebPushCommand) (*domain.WebPushSubscription, error)
+
+	Unsubscribe(ctx context.Context, id int64) error
+
+	ListByUserId(ctx context.Context, userID int64, page, pageSize int) ([]*domain.WebPushSubscription, int, error)
+}
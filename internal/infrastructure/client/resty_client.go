@@ -1,6 +1,9 @@
 package client
 
 import (
+	"errors"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/samber/lo"
@@ -31,9 +34,9 @@ func NewRestyClient(logger *zap.Logger) *resty.Client {
 	client.AddRequestMiddleware(func(client *resty.Client, req *resty.Request) error {
 		logger.Debug("Outgoing HTTP req",
 			zap.String("method", req.Method),
-			zap.String("url", req.URL),
+			zap.String("url", redactURL(req.URL)),
 			zap.Any("path_params", req.PathParams),
-			zap.String("params", req.QueryParams.Encode()),
+			zap.String("params", redactQuery(req.QueryParams).Encode()),
 		)
 		return nil
 	})
@@ -42,7 +45,7 @@ func NewRestyClient(logger *zap.Logger) *resty.Client {
 	client.AddResponseMiddleware(func(c *resty.Client, resp *resty.Response) error {
 		logger.Debug("Incoming HTTP response",
 			zap.String("method", resp.Request.Method),
-			zap.String("url", resp.Request.URL),
+			zap.String("url", redactURL(resp.Request.URL)),
 			zap.Int("status_code", resp.StatusCode()),
 			zap.Duration("time", resp.Duration()),
 		)
@@ -54,18 +57,75 @@ func NewRestyClient(logger *zap.Logger) *resty.Client {
 		if v, ok := lo.ErrorsAs[*resty.ResponseError](err); ok {
 			logger.Error("HTTP request failed",
 				zap.String("method", req.Method),
-				zap.String("url", req.URL),
+				zap.String("url", redactURL(req.URL)),
 				zap.Int("status_code", v.Response.StatusCode()),
-				zap.Error(err),
+				zap.Error(RedactError(err)),
 			)
 		} else {
 			logger.Error("HTTP request error",
 				zap.String("method", req.Method),
-				zap.String("url", req.URL),
-				zap.Error(err),
+				zap.String("url", redactURL(req.URL)),
+				zap.Error(RedactError(err)),
 			)
 		}
 	})
 
 	return client
 }
+
+// sensitiveQueryParams are query parameters that carry credentials, e.g. robot webhook keys and signatures.
+var sensitiveQueryParams = []string{"key", "access_token", "token", "sign", "secret"}
+
+// redactURL masks credentials in a URL before it is logged.
+func redactURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	if parsed.User != nil {
+		parsed.User = url.User(parsed.User.Username())
+	}
+	if parsed.RawQuery != "" {
+		parsed.RawQuery = redactQuery(parsed.Query()).Encode()
+	}
+	return parsed.String()
+}
+
+// RedactError masks credentials in the URL that net/http puts in the message of transport errors,
+// e.g. a robot webhook key, so the error can be logged, stored and shown to users.
+func RedactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	redacted := &url.Error{Op: urlErr.Op, URL: redactURL(urlErr.URL), Err: urlErr.Err}
+	if err == error(urlErr) {
+		return redacted
+	}
+	return &redactedError{
+		message: strings.ReplaceAll(err.Error(), urlErr.URL, redacted.URL),
+		err:     redacted,
+	}
+}
+
+// redactedError keeps the redacted URL error in the chain of an error that wrapped the original.
+type redactedError struct {
+	message string
+	err     error
+}
+
+func (e *redactedError) Error() string { return e.message }
+
+func (e *redactedError) Unwrap() error { return e.err }
+
+func redactQuery(query url.Values) url.Values {
+	redacted := make(url.Values, len(query))
+	for name, values := range query {
+		if lo.Contains(sensitiveQueryParams, strings.ToLower(name)) {
+			redacted[name] = []string{"REDACTED"}
+			continue
+		}
+		redacted[name] = values
+	}
+	return redacted
+}
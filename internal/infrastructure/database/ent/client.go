@@ -15,11 +15,17 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamingplatform"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/systemsetting"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,16 +33,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// InboxMessage is the client for interacting with the InboxMessage builders.
+	InboxMessage *InboxMessageClient
 	// NotificationChannel is the client for interacting with the NotificationChannel builders.
 	NotificationChannel *NotificationChannelClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// StreamSession is the client for interacting with the StreamSession builders.
+	StreamSession *StreamSessionClient
 	// Streamer is the client for interacting with the Streamer builders.
 	Streamer *StreamerClient
 	// StreamingPlatform is the client for interacting with the StreamingPlatform builders.
 	StreamingPlatform *StreamingPlatformClient
+	// SystemSetting is the client for interacting with the SystemSetting builders.
+	SystemSetting *SystemSettingClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserFollowedStreamer is the client for interacting with the UserFollowedStreamer builders.
 	UserFollowedStreamer *UserFollowedStreamerClient
+	// WebPushSubscription is the client for interacting with the WebPushSubscription builders.
+	WebPushSubscription *WebPushSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -48,11 +66,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.InboxMessage = NewInboxMessageClient(c.config)
 	c.NotificationChannel = NewNotificationChannelClient(c.config)
+	c.NotificationDelivery = NewNotificationDeliveryClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.StreamSession = NewStreamSessionClient(c.config)
 	c.Streamer = NewStreamerClient(c.config)
 	c.StreamingPlatform = NewStreamingPlatformClient(c.config)
+	c.SystemSetting = NewSystemSettingClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserFollowedStreamer = NewUserFollowedStreamerClient(c.config)
+	c.WebPushSubscription = NewWebPushSubscriptionClient(c.config)
 }
 
 type (
@@ -143,13 +167,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		NotificationChannel:  NewNotificationChannelClient(cfg),
-		Streamer:             NewStreamerClient(cfg),
-		StreamingPlatform:    NewStreamingPlatformClient(cfg),
-		User:                 NewUserClient(cfg),
-		UserFollowedStreamer: NewUserFollowedStreamerClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		InboxMessage:           NewInboxMessageClient(cfg),
+		NotificationChannel:    NewNotificationChannelClient(cfg),
+		NotificationDelivery:   NewNotificationDeliveryClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		StreamSession:          NewStreamSessionClient(cfg),
+		Streamer:               NewStreamerClient(cfg),
+		StreamingPlatform:      NewStreamingPlatformClient(cfg),
+		SystemSetting:          NewSystemSettingClient(cfg),
+		User:                   NewUserClient(cfg),
+		UserFollowedStreamer:   NewUserFollowedStreamerClient(cfg),
+		WebPushSubscription:    NewWebPushSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -167,20 +197,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                  ctx,
-		config:               cfg,
-		NotificationChannel:  NewNotificationChannelClient(cfg),
-		Streamer:             NewStreamerClient(cfg),
-		StreamingPlatform:    NewStreamingPlatformClient(cfg),
-		User:                 NewUserClient(cfg),
-		UserFollowedStreamer: NewUserFollowedStreamerClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		InboxMessage:           NewInboxMessageClient(cfg),
+		NotificationChannel:    NewNotificationChannelClient(cfg),
+		NotificationDelivery:   NewNotificationDeliveryClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		StreamSession:          NewStreamSessionClient(cfg),
+		Streamer:               NewStreamerClient(cfg),
+		StreamingPlatform:      NewStreamingPlatformClient(cfg),
+		SystemSetting:          NewSystemSettingClient(cfg),
+		User:                   NewUserClient(cfg),
+		UserFollowedStreamer:   NewUserFollowedStreamerClient(cfg),
+		WebPushSubscription:    NewWebPushSubscriptionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		NotificationChannel.
+//		InboxMessage.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -202,41 +238,206 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.NotificationChannel.Use(hooks...)
-	c.Streamer.Use(hooks...)
-	c.StreamingPlatform.Use(hooks...)
-	c.User.Use(hooks...)
-	c.UserFollowedStreamer.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.InboxMessage, c.NotificationChannel, c.NotificationDelivery,
+		c.NotificationPreference, c.StreamSession, c.Streamer, c.StreamingPlatform,
+		c.SystemSetting, c.User, c.UserFollowedStreamer, c.WebPushSubscription,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.NotificationChannel.Intercept(interceptors...)
-	c.Streamer.Intercept(interceptors...)
-	c.StreamingPlatform.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
-	c.UserFollowedStreamer.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.InboxMessage, c.NotificationChannel, c.NotificationDelivery,
+		c.NotificationPreference, c.StreamSession, c.Streamer, c.StreamingPlatform,
+		c.SystemSetting, c.User, c.UserFollowedStreamer, c.WebPushSubscription,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *InboxMessageMutation:
+		return c.InboxMessage.mutate(ctx, m)
 	case *NotificationChannelMutation:
 		return c.NotificationChannel.mutate(ctx, m)
+	case *NotificationDeliveryMutation:
+		return c.NotificationDelivery.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *StreamSessionMutation:
+		return c.StreamSession.mutate(ctx, m)
 	case *StreamerMutation:
 		return c.Streamer.mutate(ctx, m)
 	case *StreamingPlatformMutation:
 		return c.StreamingPlatform.mutate(ctx, m)
+	case *SystemSettingMutation:
+		return c.SystemSetting.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserFollowedStreamerMutation:
 		return c.UserFollowedStreamer.mutate(ctx, m)
+	case *WebPushSubscriptionMutation:
+		return c.WebPushSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// InboxMessageClient is a client for the InboxMessage schema.
+type InboxMessageClient struct {
+	config
+}
+
+// NewInboxMessageClient returns a client for the InboxMessage from the given config.
+func NewInboxMessageClient(c config) *InboxMessageClient {
+	return &InboxMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inboxmessage.Hooks(f(g(h())))`.
+func (c *InboxMessageClient) Use(hooks ...Hook) {
+	c.hooks.InboxMessage = append(c.hooks.InboxMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inboxmessage.Intercept(f(g(h())))`.
+func (c *InboxMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InboxMessage = append(c.inters.InboxMessage, interceptors...)
+}
+
+// Create returns a builder for creating a InboxMessage entity.
+func (c *InboxMessageClient) Create() *InboxMessageCreate {
+	mutation := newInboxMessageMutation(c.config, OpCreate)
+	return &InboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InboxMessage entities.
+func (c *InboxMessageClient) CreateBulk(builders ...*InboxMessageCreate) *InboxMessageCreateBulk {
+	return &InboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InboxMessageClient) MapCreateBulk(slice any, setFunc func(*InboxMessageCreate, int)) *InboxMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InboxMessageCreateBulk{err: fmt.Errorf("calling to InboxMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InboxMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InboxMessage.
+func (c *InboxMessageClient) Update() *InboxMessageUpdate {
+	mutation := newInboxMessageMutation(c.config, OpUpdate)
+	return &InboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InboxMessageClient) UpdateOne(_m *InboxMessage) *InboxMessageUpdateOne {
+	mutation := newInboxMessageMutation(c.config, OpUpdateOne, withInboxMessage(_m))
+	return &InboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InboxMessageClient) UpdateOneID(id int64) *InboxMessageUpdateOne {
+	mutation := newInboxMessageMutation(c.config, OpUpdateOne, withInboxMessageID(id))
+	return &InboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InboxMessage.
+func (c *InboxMessageClient) Delete() *InboxMessageDelete {
+	mutation := newInboxMessageMutation(c.config, OpDelete)
+	return &InboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InboxMessageClient) DeleteOne(_m *InboxMessage) *InboxMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InboxMessageClient) DeleteOneID(id int64) *InboxMessageDeleteOne {
+	builder := c.Delete().Where(inboxmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InboxMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for InboxMessage.
+func (c *InboxMessageClient) Query() *InboxMessageQuery {
+	return &InboxMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInboxMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InboxMessage entity by its id.
+func (c *InboxMessageClient) Get(ctx context.Context, id int64) (*InboxMessage, error) {
+	return c.Query().Where(inboxmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InboxMessageClient) GetX(ctx context.Context, id int64) *InboxMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a InboxMessage.
+func (c *InboxMessageClient) QueryUser(_m *InboxMessage) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inboxmessage.Table, inboxmessage.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inboxmessage.UserTable, inboxmessage.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InboxMessageClient) Hooks() []Hook {
+	return c.hooks.InboxMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *InboxMessageClient) Interceptors() []Interceptor {
+	return c.inters.InboxMessage
+}
+
+func (c *InboxMessageClient) mutate(ctx context.Context, m *InboxMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InboxMessage mutation op: %q", m.Op())
+	}
+}
+
 // NotificationChannelClient is a client for the NotificationChannel schema.
 type NotificationChannelClient struct {
 	config
@@ -298,46 +499,493 @@ func (c *NotificationChannelClient) UpdateOne(_m *NotificationChannel) *Notifica
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationChannelClient) UpdateOneID(id int64) *NotificationChannelUpdateOne {
-	mutation := newNotificationChannelMutation(c.config, OpUpdateOne, withNotificationChannelID(id))
-	return &NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationChannelClient) UpdateOneID(id int64) *NotificationChannelUpdateOne {
+	mutation := newNotificationChannelMutation(c.config, OpUpdateOne, withNotificationChannelID(id))
+	return &NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationChannel.
+func (c *NotificationChannelClient) Delete() *NotificationChannelDelete {
+	mutation := newNotificationChannelMutation(c.config, OpDelete)
+	return &NotificationChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationChannelClient) DeleteOne(_m *NotificationChannel) *NotificationChannelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationChannelClient) DeleteOneID(id int64) *NotificationChannelDeleteOne {
+	builder := c.Delete().Where(notificationchannel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationChannelDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationChannel.
+func (c *NotificationChannelClient) Query() *NotificationChannelQuery {
+	return &NotificationChannelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationChannel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationChannel entity by its id.
+func (c *NotificationChannelClient) Get(ctx context.Context, id int64) (*NotificationChannel, error) {
+	return c.Query().Where(notificationchannel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationChannelClient) GetX(ctx context.Context, id int64) *NotificationChannel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a NotificationChannel.
+func (c *NotificationChannelClient) QueryUser(_m *NotificationChannel) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationchannel.Table, notificationchannel.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationchannel.UserTable, notificationchannel.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationChannelClient) Hooks() []Hook {
+	return c.hooks.NotificationChannel
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationChannelClient) Interceptors() []Interceptor {
+	return c.inters.NotificationChannel
+}
+
+func (c *NotificationChannelClient) mutate(ctx context.Context, m *NotificationChannelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationChannelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationChannelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationChannel mutation op: %q", m.Op())
+	}
+}
+
+// NotificationDeliveryClient is a client for the NotificationDelivery schema.
+type NotificationDeliveryClient struct {
+	config
+}
+
+// NewNotificationDeliveryClient returns a client for the NotificationDelivery from the given config.
+func NewNotificationDeliveryClient(c config) *NotificationDeliveryClient {
+	return &NotificationDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationdelivery.Hooks(f(g(h())))`.
+func (c *NotificationDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.NotificationDelivery = append(c.hooks.NotificationDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationdelivery.Intercept(f(g(h())))`.
+func (c *NotificationDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationDelivery = append(c.inters.NotificationDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationDelivery entity.
+func (c *NotificationDeliveryClient) Create() *NotificationDeliveryCreate {
+	mutation := newNotificationDeliveryMutation(c.config, OpCreate)
+	return &NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationDelivery entities.
+func (c *NotificationDeliveryClient) CreateBulk(builders ...*NotificationDeliveryCreate) *NotificationDeliveryCreateBulk {
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationDeliveryClient) MapCreateBulk(slice any, setFunc func(*NotificationDeliveryCreate, int)) *NotificationDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationDeliveryCreateBulk{err: fmt.Errorf("calling to NotificationDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Update() *NotificationDeliveryUpdate {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdate)
+	return &NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationDeliveryClient) UpdateOne(_m *NotificationDelivery) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDelivery(_m))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationDeliveryClient) UpdateOneID(id int64) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDeliveryID(id))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Delete() *NotificationDeliveryDelete {
+	mutation := newNotificationDeliveryMutation(c.config, OpDelete)
+	return &NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationDeliveryClient) DeleteOne(_m *NotificationDelivery) *NotificationDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationDeliveryClient) DeleteOneID(id int64) *NotificationDeliveryDeleteOne {
+	builder := c.Delete().Where(notificationdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Query() *NotificationDeliveryQuery {
+	return &NotificationDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationDelivery entity by its id.
+func (c *NotificationDeliveryClient) Get(ctx context.Context, id int64) (*NotificationDelivery, error) {
+	return c.Query().Where(notificationdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationDeliveryClient) GetX(ctx context.Context, id int64) *NotificationDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a NotificationDelivery.
+func (c *NotificationDeliveryClient) QueryUser(_m *NotificationDelivery) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationdelivery.Table, notificationdelivery.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationdelivery.UserTable, notificationdelivery.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationDeliveryClient) Hooks() []Hook {
+	return c.hooks.NotificationDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.NotificationDelivery
+}
+
+func (c *NotificationDeliveryClient) mutate(ctx context.Context, m *NotificationDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationDelivery mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id int64) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id int64) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id int64) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id int64) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a NotificationPreference.
+func (c *NotificationPreferenceClient) QueryUser(_m *NotificationPreference) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationpreference.Table, notificationpreference.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, notificationpreference.UserTable, notificationpreference.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// StreamSessionClient is a client for the StreamSession schema.
+type StreamSessionClient struct {
+	config
+}
+
+// NewStreamSessionClient returns a client for the StreamSession from the given config.
+func NewStreamSessionClient(c config) *StreamSessionClient {
+	return &StreamSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `streamsession.Hooks(f(g(h())))`.
+func (c *StreamSessionClient) Use(hooks ...Hook) {
+	c.hooks.StreamSession = append(c.hooks.StreamSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `streamsession.Intercept(f(g(h())))`.
+func (c *StreamSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StreamSession = append(c.inters.StreamSession, interceptors...)
+}
+
+// Create returns a builder for creating a StreamSession entity.
+func (c *StreamSessionClient) Create() *StreamSessionCreate {
+	mutation := newStreamSessionMutation(c.config, OpCreate)
+	return &StreamSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StreamSession entities.
+func (c *StreamSessionClient) CreateBulk(builders ...*StreamSessionCreate) *StreamSessionCreateBulk {
+	return &StreamSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StreamSessionClient) MapCreateBulk(slice any, setFunc func(*StreamSessionCreate, int)) *StreamSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StreamSessionCreateBulk{err: fmt.Errorf("calling to StreamSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StreamSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StreamSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StreamSession.
+func (c *StreamSessionClient) Update() *StreamSessionUpdate {
+	mutation := newStreamSessionMutation(c.config, OpUpdate)
+	return &StreamSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StreamSessionClient) UpdateOne(_m *StreamSession) *StreamSessionUpdateOne {
+	mutation := newStreamSessionMutation(c.config, OpUpdateOne, withStreamSession(_m))
+	return &StreamSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StreamSessionClient) UpdateOneID(id int64) *StreamSessionUpdateOne {
+	mutation := newStreamSessionMutation(c.config, OpUpdateOne, withStreamSessionID(id))
+	return &StreamSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationChannel.
-func (c *NotificationChannelClient) Delete() *NotificationChannelDelete {
-	mutation := newNotificationChannelMutation(c.config, OpDelete)
-	return &NotificationChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StreamSession.
+func (c *StreamSessionClient) Delete() *StreamSessionDelete {
+	mutation := newStreamSessionMutation(c.config, OpDelete)
+	return &StreamSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationChannelClient) DeleteOne(_m *NotificationChannel) *NotificationChannelDeleteOne {
+func (c *StreamSessionClient) DeleteOne(_m *StreamSession) *StreamSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationChannelClient) DeleteOneID(id int64) *NotificationChannelDeleteOne {
-	builder := c.Delete().Where(notificationchannel.ID(id))
+func (c *StreamSessionClient) DeleteOneID(id int64) *StreamSessionDeleteOne {
+	builder := c.Delete().Where(streamsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationChannelDeleteOne{builder}
+	return &StreamSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationChannel.
-func (c *NotificationChannelClient) Query() *NotificationChannelQuery {
-	return &NotificationChannelQuery{
+// Query returns a query builder for StreamSession.
+func (c *StreamSessionClient) Query() *StreamSessionQuery {
+	return &StreamSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationChannel},
+		ctx:    &QueryContext{Type: TypeStreamSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationChannel entity by its id.
-func (c *NotificationChannelClient) Get(ctx context.Context, id int64) (*NotificationChannel, error) {
-	return c.Query().Where(notificationchannel.ID(id)).Only(ctx)
+// Get returns a StreamSession entity by its id.
+func (c *StreamSessionClient) Get(ctx context.Context, id int64) (*StreamSession, error) {
+	return c.Query().Where(streamsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationChannelClient) GetX(ctx context.Context, id int64) *NotificationChannel {
+func (c *StreamSessionClient) GetX(ctx context.Context, id int64) *StreamSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -345,15 +993,15 @@ func (c *NotificationChannelClient) GetX(ctx context.Context, id int64) *Notific
 	return obj
 }
 
-// QueryUser queries the user edge of a NotificationChannel.
-func (c *NotificationChannelClient) QueryUser(_m *NotificationChannel) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryStreamer queries the streamer edge of a StreamSession.
+func (c *StreamSessionClient) QueryStreamer(_m *StreamSession) *StreamerQuery {
+	query := (&StreamerClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notificationchannel.Table, notificationchannel.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notificationchannel.UserTable, notificationchannel.UserColumn),
+			sqlgraph.From(streamsession.Table, streamsession.FieldID, id),
+			sqlgraph.To(streamer.Table, streamer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, streamsession.StreamerTable, streamsession.StreamerColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -362,27 +1010,27 @@ func (c *NotificationChannelClient) QueryUser(_m *NotificationChannel) *UserQuer
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationChannelClient) Hooks() []Hook {
-	return c.hooks.NotificationChannel
+func (c *StreamSessionClient) Hooks() []Hook {
+	return c.hooks.StreamSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationChannelClient) Interceptors() []Interceptor {
-	return c.inters.NotificationChannel
+func (c *StreamSessionClient) Interceptors() []Interceptor {
+	return c.inters.StreamSession
 }
 
-func (c *NotificationChannelClient) mutate(ctx context.Context, m *NotificationChannelMutation) (Value, error) {
+func (c *StreamSessionClient) mutate(ctx context.Context, m *StreamSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationChannelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StreamSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StreamSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StreamSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationChannelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StreamSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NotificationChannel mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StreamSession mutation op: %q", m.Op())
 	}
 }
 
@@ -510,6 +1158,22 @@ func (c *StreamerClient) QueryFollowers(_m *Streamer) *UserFollowedStreamerQuery
 	return query
 }
 
+// QuerySessions queries the sessions edge of a Streamer.
+func (c *StreamerClient) QuerySessions(_m *Streamer) *StreamSessionQuery {
+	query := (&StreamSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streamer.Table, streamer.FieldID, id),
+			sqlgraph.To(streamsession.Table, streamsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, streamer.SessionsTable, streamer.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *StreamerClient) Hooks() []Hook {
 	return c.hooks.Streamer
@@ -668,6 +1332,139 @@ func (c *StreamingPlatformClient) mutate(ctx context.Context, m *StreamingPlatfo
 	}
 }
 
+// SystemSettingClient is a client for the SystemSetting schema.
+type SystemSettingClient struct {
+	config
+}
+
+// NewSystemSettingClient returns a client for the SystemSetting from the given config.
+func NewSystemSettingClient(c config) *SystemSettingClient {
+	return &SystemSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemsetting.Hooks(f(g(h())))`.
+func (c *SystemSettingClient) Use(hooks ...Hook) {
+	c.hooks.SystemSetting = append(c.hooks.SystemSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemsetting.Intercept(f(g(h())))`.
+func (c *SystemSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemSetting = append(c.inters.SystemSetting, interceptors...)
+}
+
+// Create returns a builder for creating a SystemSetting entity.
+func (c *SystemSettingClient) Create() *SystemSettingCreate {
+	mutation := newSystemSettingMutation(c.config, OpCreate)
+	return &SystemSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemSetting entities.
+func (c *SystemSettingClient) CreateBulk(builders ...*SystemSettingCreate) *SystemSettingCreateBulk {
+	return &SystemSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemSettingClient) MapCreateBulk(slice any, setFunc func(*SystemSettingCreate, int)) *SystemSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemSettingCreateBulk{err: fmt.Errorf("calling to SystemSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemSetting.
+func (c *SystemSettingClient) Update() *SystemSettingUpdate {
+	mutation := newSystemSettingMutation(c.config, OpUpdate)
+	return &SystemSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemSettingClient) UpdateOne(_m *SystemSetting) *SystemSettingUpdateOne {
+	mutation := newSystemSettingMutation(c.config, OpUpdateOne, withSystemSetting(_m))
+	return &SystemSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemSettingClient) UpdateOneID(id int64) *SystemSettingUpdateOne {
+	mutation := newSystemSettingMutation(c.config, OpUpdateOne, withSystemSettingID(id))
+	return &SystemSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemSetting.
+func (c *SystemSettingClient) Delete() *SystemSettingDelete {
+	mutation := newSystemSettingMutation(c.config, OpDelete)
+	return &SystemSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemSettingClient) DeleteOne(_m *SystemSetting) *SystemSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemSettingClient) DeleteOneID(id int64) *SystemSettingDeleteOne {
+	builder := c.Delete().Where(systemsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemSetting.
+func (c *SystemSettingClient) Query() *SystemSettingQuery {
+	return &SystemSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemSetting entity by its id.
+func (c *SystemSettingClient) Get(ctx context.Context, id int64) (*SystemSetting, error) {
+	return c.Query().Where(systemsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemSettingClient) GetX(ctx context.Context, id int64) *SystemSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemSettingClient) Hooks() []Hook {
+	return c.hooks.SystemSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemSettingClient) Interceptors() []Interceptor {
+	return c.inters.SystemSetting
+}
+
+func (c *SystemSettingClient) mutate(ctx context.Context, m *SystemSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SystemSetting mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -808,6 +1605,70 @@ func (c *UserClient) QueryNotificationChannels(_m *User) *NotificationChannelQue
 	return query
 }
 
+// QueryWebPushSubscriptions queries the web_push_subscriptions edge of a User.
+func (c *UserClient) QueryWebPushSubscriptions(_m *User) *WebPushSubscriptionQuery {
+	query := (&WebPushSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(webpushsubscription.Table, webpushsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebPushSubscriptionsTable, user.WebPushSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationDeliveries queries the notification_deliveries edge of a User.
+func (c *UserClient) QueryNotificationDeliveries(_m *User) *NotificationDeliveryQuery {
+	query := (&NotificationDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notificationdelivery.Table, notificationdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationDeliveriesTable, user.NotificationDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInboxMessages queries the inbox_messages edge of a User.
+func (c *UserClient) QueryInboxMessages(_m *User) *InboxMessageQuery {
+	query := (&InboxMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(inboxmessage.Table, inboxmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InboxMessagesTable, user.InboxMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationPreference queries the notification_preference edge of a User.
+func (c *UserClient) QueryNotificationPreference(_m *User) *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.NotificationPreferenceTable, user.NotificationPreferenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -998,14 +1859,165 @@ func (c *UserFollowedStreamerClient) mutate(ctx context.Context, m *UserFollowed
 	}
 }
 
+// WebPushSubscriptionClient is a client for the WebPushSubscription schema.
+type WebPushSubscriptionClient struct {
+	config
+}
+
+// NewWebPushSubscriptionClient returns a client for the WebPushSubscription from the given config.
+func NewWebPushSubscriptionClient(c config) *WebPushSubscriptionClient {
+	return &WebPushSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webpushsubscription.Hooks(f(g(h())))`.
+func (c *WebPushSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebPushSubscription = append(c.hooks.WebPushSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webpushsubscription.Intercept(f(g(h())))`.
+func (c *WebPushSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebPushSubscription = append(c.inters.WebPushSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a WebPushSubscription entity.
+func (c *WebPushSubscriptionClient) Create() *WebPushSubscriptionCreate {
+	mutation := newWebPushSubscriptionMutation(c.config, OpCreate)
+	return &WebPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebPushSubscription entities.
+func (c *WebPushSubscriptionClient) CreateBulk(builders ...*WebPushSubscriptionCreate) *WebPushSubscriptionCreateBulk {
+	return &WebPushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebPushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebPushSubscriptionCreate, int)) *WebPushSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebPushSubscriptionCreateBulk{err: fmt.Errorf("calling to WebPushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebPushSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebPushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebPushSubscription.
+func (c *WebPushSubscriptionClient) Update() *WebPushSubscriptionUpdate {
+	mutation := newWebPushSubscriptionMutation(c.config, OpUpdate)
+	return &WebPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebPushSubscriptionClient) UpdateOne(_m *WebPushSubscription) *WebPushSubscriptionUpdateOne {
+	mutation := newWebPushSubscriptionMutation(c.config, OpUpdateOne, withWebPushSubscription(_m))
+	return &WebPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebPushSubscriptionClient) UpdateOneID(id int64) *WebPushSubscriptionUpdateOne {
+	mutation := newWebPushSubscriptionMutation(c.config, OpUpdateOne, withWebPushSubscriptionID(id))
+	return &WebPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebPushSubscription.
+func (c *WebPushSubscriptionClient) Delete() *WebPushSubscriptionDelete {
+	mutation := newWebPushSubscriptionMutation(c.config, OpDelete)
+	return &WebPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebPushSubscriptionClient) DeleteOne(_m *WebPushSubscription) *WebPushSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebPushSubscriptionClient) DeleteOneID(id int64) *WebPushSubscriptionDeleteOne {
+	builder := c.Delete().Where(webpushsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebPushSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for WebPushSubscription.
+func (c *WebPushSubscriptionClient) Query() *WebPushSubscriptionQuery {
+	return &WebPushSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebPushSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebPushSubscription entity by its id.
+func (c *WebPushSubscriptionClient) Get(ctx context.Context, id int64) (*WebPushSubscription, error) {
+	return c.Query().Where(webpushsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebPushSubscriptionClient) GetX(ctx context.Context, id int64) *WebPushSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a WebPushSubscription.
+func (c *WebPushSubscriptionClient) QueryUser(_m *WebPushSubscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webpushsubscription.Table, webpushsubscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webpushsubscription.UserTable, webpushsubscription.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebPushSubscriptionClient) Hooks() []Hook {
+	return c.hooks.WebPushSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebPushSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebPushSubscription
+}
+
+func (c *WebPushSubscriptionClient) mutate(ctx context.Context, m *WebPushSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebPushSubscription mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		NotificationChannel, Streamer, StreamingPlatform, User,
-		UserFollowedStreamer []ent.Hook
+		InboxMessage, NotificationChannel, NotificationDelivery, NotificationPreference,
+		StreamSession, Streamer, StreamingPlatform, SystemSetting, User,
+		UserFollowedStreamer, WebPushSubscription []ent.Hook
 	}
 	inters struct {
-		NotificationChannel, Streamer, StreamingPlatform, User,
-		UserFollowedStreamer []ent.Interceptor
+		InboxMessage, NotificationChannel, NotificationDelivery, NotificationPreference,
+		StreamSession, Streamer, StreamingPlatform, SystemSetting, User,
+		UserFollowedStreamer, WebPushSubscription []ent.Interceptor
 	}
 )
@@ -12,11 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamingplatform"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/systemsetting"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			notificationchannel.Table:  notificationchannel.ValidColumn,
-			streamer.Table:             streamer.ValidColumn,
-			streamingplatform.Table:    streamingplatform.ValidColumn,
-			user.Table:                 user.ValidColumn,
-			userfollowedstreamer.Table: userfollowedstreamer.ValidColumn,
+			inboxmessage.Table:           inboxmessage.ValidColumn,
+			notificationchannel.Table:    notificationchannel.ValidColumn,
+			notificationdelivery.Table:   notificationdelivery.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			streamsession.Table:          streamsession.ValidColumn,
+			streamer.Table:               streamer.ValidColumn,
+			streamingplatform.Table:      streamingplatform.ValidColumn,
+			systemsetting.Table:          systemsetting.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			userfollowedstreamer.Table:   userfollowedstreamer.ValidColumn,
+			webpushsubscription.Table:    webpushsubscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
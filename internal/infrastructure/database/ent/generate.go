@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target . --feature intercept ../schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target . --feature intercept,sql/lock ../schema
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+)
+
+// InboxMessageCreate is the builder for creating a InboxMessage entity.
+type InboxMessageCreate struct {
+	config
+	mutation *InboxMessageMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InboxMessageCreate) SetUserID(v int64) *InboxMessageCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *InboxMessageCreate) SetChannelID(v int64) *InboxMessageCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_c *InboxMessageCreate) SetStreamerID(v int64) *InboxMessageCreate {
+	_c.mutation.SetStreamerID(v)
+	return _c
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableStreamerID(v *int64) *InboxMessageCreate {
+	if v != nil {
+		_c.SetStreamerID(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *InboxMessageCreate) SetEventType(v string) *InboxMessageCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *InboxMessageCreate) SetSeverity(v string) *InboxMessageCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableSeverity(v *string) *InboxMessageCreate {
+	if v != nil {
+		_c.SetSeverity(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *InboxMessageCreate) SetTitle(v string) *InboxMessageCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *InboxMessageCreate) SetContent(v string) *InboxMessageCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *InboxMessageCreate) SetURL(v string) *InboxMessageCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableURL(v *string) *InboxMessageCreate {
+	if v != nil {
+		_c.SetURL(*v)
+	}
+	return _c
+}
+
+// SetImageURL sets the "image_url" field.
+func (_c *InboxMessageCreate) SetImageURL(v string) *InboxMessageCreate {
+	_c.mutation.SetImageURL(v)
+	return _c
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableImageURL(v *string) *InboxMessageCreate {
+	if v != nil {
+		_c.SetImageURL(*v)
+	}
+	return _c
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_c *InboxMessageCreate) SetIconURL(v string) *InboxMessageCreate {
+	_c.mutation.SetIconURL(v)
+	return _c
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableIconURL(v *string) *InboxMessageCreate {
+	if v != nil {
+		_c.SetIconURL(*v)
+	}
+	return _c
+}
+
+// SetReadAt sets the "read_at" field.
+func (_c *InboxMessageCreate) SetReadAt(v time.Time) *InboxMessageCreate {
+	_c.mutation.SetReadAt(v)
+	return _c
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableReadAt(v *time.Time) *InboxMessageCreate {
+	if v != nil {
+		_c.SetReadAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InboxMessageCreate) SetCreatedAt(v time.Time) *InboxMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InboxMessageCreate) SetNillableCreatedAt(v *time.Time) *InboxMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InboxMessageCreate) SetID(v int64) *InboxMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *InboxMessageCreate) SetUser(v *User) *InboxMessageCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the InboxMessageMutation object of the builder.
+func (_c *InboxMessageCreate) Mutation() *InboxMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the InboxMessage in the database.
+func (_c *InboxMessageCreate) Save(ctx context.Context) (*InboxMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InboxMessageCreate) SaveX(ctx context.Context) *InboxMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboxMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboxMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InboxMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := inboxmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InboxMessageCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InboxMessage.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := inboxmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "InboxMessage.channel_id"`)}
+	}
+	if v, ok := _c.mutation.ChannelID(); ok {
+		if err := inboxmessage.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.channel_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "InboxMessage.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := inboxmessage.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "InboxMessage.title"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "InboxMessage.content"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InboxMessage.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "InboxMessage.user"`)}
+	}
+	return nil
+}
+
+func (_c *InboxMessageCreate) sqlSave(ctx context.Context) (*InboxMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InboxMessageCreate) createSpec() (*InboxMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InboxMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inboxmessage.Table, sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(inboxmessage.FieldChannelID, field.TypeInt64, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.StreamerID(); ok {
+		_spec.SetField(inboxmessage.FieldStreamerID, field.TypeInt64, value)
+		_node.StreamerID = &value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(inboxmessage.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(inboxmessage.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(inboxmessage.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(inboxmessage.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(inboxmessage.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.ImageURL(); ok {
+		_spec.SetField(inboxmessage.FieldImageURL, field.TypeString, value)
+		_node.ImageURL = value
+	}
+	if value, ok := _c.mutation.IconURL(); ok {
+		_spec.SetField(inboxmessage.FieldIconURL, field.TypeString, value)
+		_node.IconURL = value
+	}
+	if value, ok := _c.mutation.ReadAt(); ok {
+		_spec.SetField(inboxmessage.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(inboxmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inboxmessage.UserTable,
+			Columns: []string{inboxmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InboxMessageCreateBulk is the builder for creating many InboxMessage entities in bulk.
+type InboxMessageCreateBulk struct {
+	config
+	err      error
+	builders []*InboxMessageCreate
+}
+
+// Save creates the InboxMessage entities in the database.
+func (_c *InboxMessageCreateBulk) Save(ctx context.Context) ([]*InboxMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InboxMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InboxMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InboxMessageCreateBulk) SaveX(ctx context.Context) []*InboxMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboxMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboxMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+)
+
+// InboxMessageUpdate is the builder for updating InboxMessage entities.
+type InboxMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InboxMessageMutation
+}
+
+// Where appends a list predicates to the InboxMessageUpdate builder.
+func (_u *InboxMessageUpdate) Where(ps ...predicate.InboxMessage) *InboxMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InboxMessageUpdate) SetUserID(v int64) *InboxMessageUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableUserID(v *int64) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_u *InboxMessageUpdate) SetChannelID(v int64) *InboxMessageUpdate {
+	_u.mutation.ResetChannelID()
+	_u.mutation.SetChannelID(v)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableChannelID(v *int64) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetChannelID(*v)
+	}
+	return _u
+}
+
+// AddChannelID adds value to the "channel_id" field.
+func (_u *InboxMessageUpdate) AddChannelID(v int64) *InboxMessageUpdate {
+	_u.mutation.AddChannelID(v)
+	return _u
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_u *InboxMessageUpdate) SetStreamerID(v int64) *InboxMessageUpdate {
+	_u.mutation.ResetStreamerID()
+	_u.mutation.SetStreamerID(v)
+	return _u
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableStreamerID(v *int64) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetStreamerID(*v)
+	}
+	return _u
+}
+
+// AddStreamerID adds value to the "streamer_id" field.
+func (_u *InboxMessageUpdate) AddStreamerID(v int64) *InboxMessageUpdate {
+	_u.mutation.AddStreamerID(v)
+	return _u
+}
+
+// ClearStreamerID clears the value of the "streamer_id" field.
+func (_u *InboxMessageUpdate) ClearStreamerID() *InboxMessageUpdate {
+	_u.mutation.ClearStreamerID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *InboxMessageUpdate) SetEventType(v string) *InboxMessageUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableEventType(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *InboxMessageUpdate) SetSeverity(v string) *InboxMessageUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableSeverity(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (_u *InboxMessageUpdate) ClearSeverity() *InboxMessageUpdate {
+	_u.mutation.ClearSeverity()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *InboxMessageUpdate) SetTitle(v string) *InboxMessageUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableTitle(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *InboxMessageUpdate) SetContent(v string) *InboxMessageUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableContent(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *InboxMessageUpdate) SetURL(v string) *InboxMessageUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableURL(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *InboxMessageUpdate) ClearURL() *InboxMessageUpdate {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetImageURL sets the "image_url" field.
+func (_u *InboxMessageUpdate) SetImageURL(v string) *InboxMessageUpdate {
+	_u.mutation.SetImageURL(v)
+	return _u
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableImageURL(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetImageURL(*v)
+	}
+	return _u
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (_u *InboxMessageUpdate) ClearImageURL() *InboxMessageUpdate {
+	_u.mutation.ClearImageURL()
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *InboxMessageUpdate) SetIconURL(v string) *InboxMessageUpdate {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableIconURL(v *string) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *InboxMessageUpdate) ClearIconURL() *InboxMessageUpdate {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *InboxMessageUpdate) SetReadAt(v time.Time) *InboxMessageUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *InboxMessageUpdate) SetNillableReadAt(v *time.Time) *InboxMessageUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *InboxMessageUpdate) ClearReadAt() *InboxMessageUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *InboxMessageUpdate) SetUser(v *User) *InboxMessageUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the InboxMessageMutation object of the builder.
+func (_u *InboxMessageUpdate) Mutation() *InboxMessageMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *InboxMessageUpdate) ClearUser() *InboxMessageUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InboxMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboxMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InboxMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboxMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboxMessageUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := inboxmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChannelID(); ok {
+		if err := inboxmessage.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.channel_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := inboxmessage.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.event_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InboxMessage.user"`)
+	}
+	return nil
+}
+
+func (_u *InboxMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inboxmessage.Table, inboxmessage.Columns, sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChannelID(); ok {
+		_spec.SetField(inboxmessage.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChannelID(); ok {
+		_spec.AddField(inboxmessage.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StreamerID(); ok {
+		_spec.SetField(inboxmessage.FieldStreamerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStreamerID(); ok {
+		_spec.AddField(inboxmessage.FieldStreamerID, field.TypeInt64, value)
+	}
+	if _u.mutation.StreamerIDCleared() {
+		_spec.ClearField(inboxmessage.FieldStreamerID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(inboxmessage.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(inboxmessage.FieldSeverity, field.TypeString, value)
+	}
+	if _u.mutation.SeverityCleared() {
+		_spec.ClearField(inboxmessage.FieldSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(inboxmessage.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(inboxmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(inboxmessage.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(inboxmessage.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageURL(); ok {
+		_spec.SetField(inboxmessage.FieldImageURL, field.TypeString, value)
+	}
+	if _u.mutation.ImageURLCleared() {
+		_spec.ClearField(inboxmessage.FieldImageURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(inboxmessage.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(inboxmessage.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(inboxmessage.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(inboxmessage.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inboxmessage.UserTable,
+			Columns: []string{inboxmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inboxmessage.UserTable,
+			Columns: []string{inboxmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InboxMessageUpdateOne is the builder for updating a single InboxMessage entity.
+type InboxMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InboxMessageMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InboxMessageUpdateOne) SetUserID(v int64) *InboxMessageUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableUserID(v *int64) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_u *InboxMessageUpdateOne) SetChannelID(v int64) *InboxMessageUpdateOne {
+	_u.mutation.ResetChannelID()
+	_u.mutation.SetChannelID(v)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableChannelID(v *int64) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetChannelID(*v)
+	}
+	return _u
+}
+
+// AddChannelID adds value to the "channel_id" field.
+func (_u *InboxMessageUpdateOne) AddChannelID(v int64) *InboxMessageUpdateOne {
+	_u.mutation.AddChannelID(v)
+	return _u
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_u *InboxMessageUpdateOne) SetStreamerID(v int64) *InboxMessageUpdateOne {
+	_u.mutation.ResetStreamerID()
+	_u.mutation.SetStreamerID(v)
+	return _u
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableStreamerID(v *int64) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetStreamerID(*v)
+	}
+	return _u
+}
+
+// AddStreamerID adds value to the "streamer_id" field.
+func (_u *InboxMessageUpdateOne) AddStreamerID(v int64) *InboxMessageUpdateOne {
+	_u.mutation.AddStreamerID(v)
+	return _u
+}
+
+// ClearStreamerID clears the value of the "streamer_id" field.
+func (_u *InboxMessageUpdateOne) ClearStreamerID() *InboxMessageUpdateOne {
+	_u.mutation.ClearStreamerID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *InboxMessageUpdateOne) SetEventType(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableEventType(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *InboxMessageUpdateOne) SetSeverity(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableSeverity(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (_u *InboxMessageUpdateOne) ClearSeverity() *InboxMessageUpdateOne {
+	_u.mutation.ClearSeverity()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *InboxMessageUpdateOne) SetTitle(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableTitle(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *InboxMessageUpdateOne) SetContent(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableContent(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *InboxMessageUpdateOne) SetURL(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableURL(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *InboxMessageUpdateOne) ClearURL() *InboxMessageUpdateOne {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetImageURL sets the "image_url" field.
+func (_u *InboxMessageUpdateOne) SetImageURL(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetImageURL(v)
+	return _u
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableImageURL(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetImageURL(*v)
+	}
+	return _u
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (_u *InboxMessageUpdateOne) ClearImageURL() *InboxMessageUpdateOne {
+	_u.mutation.ClearImageURL()
+	return _u
+}
+
+// SetIconURL sets the "icon_url" field.
+func (_u *InboxMessageUpdateOne) SetIconURL(v string) *InboxMessageUpdateOne {
+	_u.mutation.SetIconURL(v)
+	return _u
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableIconURL(v *string) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetIconURL(*v)
+	}
+	return _u
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (_u *InboxMessageUpdateOne) ClearIconURL() *InboxMessageUpdateOne {
+	_u.mutation.ClearIconURL()
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *InboxMessageUpdateOne) SetReadAt(v time.Time) *InboxMessageUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *InboxMessageUpdateOne) SetNillableReadAt(v *time.Time) *InboxMessageUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *InboxMessageUpdateOne) ClearReadAt() *InboxMessageUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *InboxMessageUpdateOne) SetUser(v *User) *InboxMessageUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the InboxMessageMutation object of the builder.
+func (_u *InboxMessageUpdateOne) Mutation() *InboxMessageMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *InboxMessageUpdateOne) ClearUser() *InboxMessageUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the InboxMessageUpdate builder.
+func (_u *InboxMessageUpdateOne) Where(ps ...predicate.InboxMessage) *InboxMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InboxMessageUpdateOne) Select(field string, fields ...string) *InboxMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InboxMessage entity.
+func (_u *InboxMessageUpdateOne) Save(ctx context.Context) (*InboxMessage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboxMessageUpdateOne) SaveX(ctx context.Context) *InboxMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InboxMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboxMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboxMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := inboxmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChannelID(); ok {
+		if err := inboxmessage.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.channel_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := inboxmessage.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "InboxMessage.event_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InboxMessage.user"`)
+	}
+	return nil
+}
+
+func (_u *InboxMessageUpdateOne) sqlSave(ctx context.Context) (_node *InboxMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inboxmessage.Table, inboxmessage.Columns, sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InboxMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inboxmessage.FieldID)
+		for _, f := range fields {
+			if !inboxmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inboxmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChannelID(); ok {
+		_spec.SetField(inboxmessage.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChannelID(); ok {
+		_spec.AddField(inboxmessage.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StreamerID(); ok {
+		_spec.SetField(inboxmessage.FieldStreamerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStreamerID(); ok {
+		_spec.AddField(inboxmessage.FieldStreamerID, field.TypeInt64, value)
+	}
+	if _u.mutation.StreamerIDCleared() {
+		_spec.ClearField(inboxmessage.FieldStreamerID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(inboxmessage.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(inboxmessage.FieldSeverity, field.TypeString, value)
+	}
+	if _u.mutation.SeverityCleared() {
+		_spec.ClearField(inboxmessage.FieldSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(inboxmessage.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(inboxmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(inboxmessage.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(inboxmessage.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageURL(); ok {
+		_spec.SetField(inboxmessage.FieldImageURL, field.TypeString, value)
+	}
+	if _u.mutation.ImageURLCleared() {
+		_spec.ClearField(inboxmessage.FieldImageURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IconURL(); ok {
+		_spec.SetField(inboxmessage.FieldIconURL, field.TypeString, value)
+	}
+	if _u.mutation.IconURLCleared() {
+		_spec.ClearField(inboxmessage.FieldIconURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(inboxmessage.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(inboxmessage.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inboxmessage.UserTable,
+			Columns: []string{inboxmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inboxmessage.UserTable,
+			Columns: []string{inboxmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InboxMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inboxmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
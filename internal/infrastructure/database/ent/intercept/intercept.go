@@ -8,12 +8,18 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamingplatform"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/systemsetting"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 )
 
 // The Query interface represents an operation that queries a graph.
@@ -72,6 +78,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The InboxMessageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type InboxMessageFunc func(context.Context, *ent.InboxMessageQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f InboxMessageFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.InboxMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.InboxMessageQuery", q)
+}
+
+// The TraverseInboxMessage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseInboxMessage func(context.Context, *ent.InboxMessageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseInboxMessage) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseInboxMessage) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InboxMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.InboxMessageQuery", q)
+}
+
 // The NotificationChannelFunc type is an adapter to allow the use of ordinary function as a Querier.
 type NotificationChannelFunc func(context.Context, *ent.NotificationChannelQuery) (ent.Value, error)
 
@@ -99,6 +132,87 @@ func (f TraverseNotificationChannel) Traverse(ctx context.Context, q ent.Query)
 	return fmt.Errorf("unexpected query type %T. expect *ent.NotificationChannelQuery", q)
 }
 
+// The NotificationDeliveryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NotificationDeliveryFunc func(context.Context, *ent.NotificationDeliveryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f NotificationDeliveryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.NotificationDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.NotificationDeliveryQuery", q)
+}
+
+// The TraverseNotificationDelivery type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNotificationDelivery func(context.Context, *ent.NotificationDeliveryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNotificationDelivery) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNotificationDelivery) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NotificationDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.NotificationDeliveryQuery", q)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f NotificationPreferenceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.NotificationPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.NotificationPreferenceQuery", q)
+}
+
+// The TraverseNotificationPreference type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNotificationPreference func(context.Context, *ent.NotificationPreferenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNotificationPreference) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNotificationPreference) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NotificationPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.NotificationPreferenceQuery", q)
+}
+
+// The StreamSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type StreamSessionFunc func(context.Context, *ent.StreamSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f StreamSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.StreamSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.StreamSessionQuery", q)
+}
+
+// The TraverseStreamSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseStreamSession func(context.Context, *ent.StreamSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseStreamSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseStreamSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StreamSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.StreamSessionQuery", q)
+}
+
 // The StreamerFunc type is an adapter to allow the use of ordinary function as a Querier.
 type StreamerFunc func(context.Context, *ent.StreamerQuery) (ent.Value, error)
 
@@ -153,6 +267,33 @@ func (f TraverseStreamingPlatform) Traverse(ctx context.Context, q ent.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *ent.StreamingPlatformQuery", q)
 }
 
+// The SystemSettingFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SystemSettingFunc func(context.Context, *ent.SystemSettingQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SystemSettingFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SystemSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SystemSettingQuery", q)
+}
+
+// The TraverseSystemSetting type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSystemSetting func(context.Context, *ent.SystemSettingQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSystemSetting) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSystemSetting) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SystemSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SystemSettingQuery", q)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserFunc func(context.Context, *ent.UserQuery) (ent.Value, error)
 
@@ -207,19 +348,58 @@ func (f TraverseUserFollowedStreamer) Traverse(ctx context.Context, q ent.Query)
 	return fmt.Errorf("unexpected query type %T. expect *ent.UserFollowedStreamerQuery", q)
 }
 
+// The WebPushSubscriptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebPushSubscriptionFunc func(context.Context, *ent.WebPushSubscriptionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebPushSubscriptionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WebPushSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WebPushSubscriptionQuery", q)
+}
+
+// The TraverseWebPushSubscription type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebPushSubscription func(context.Context, *ent.WebPushSubscriptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebPushSubscription) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebPushSubscription) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebPushSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WebPushSubscriptionQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.InboxMessageQuery:
+		return &query[*ent.InboxMessageQuery, predicate.InboxMessage, inboxmessage.OrderOption]{typ: ent.TypeInboxMessage, tq: q}, nil
 	case *ent.NotificationChannelQuery:
 		return &query[*ent.NotificationChannelQuery, predicate.NotificationChannel, notificationchannel.OrderOption]{typ: ent.TypeNotificationChannel, tq: q}, nil
+	case *ent.NotificationDeliveryQuery:
+		return &query[*ent.NotificationDeliveryQuery, predicate.NotificationDelivery, notificationdelivery.OrderOption]{typ: ent.TypeNotificationDelivery, tq: q}, nil
+	case *ent.NotificationPreferenceQuery:
+		return &query[*ent.NotificationPreferenceQuery, predicate.NotificationPreference, notificationpreference.OrderOption]{typ: ent.TypeNotificationPreference, tq: q}, nil
+	case *ent.StreamSessionQuery:
+		return &query[*ent.StreamSessionQuery, predicate.StreamSession, streamsession.OrderOption]{typ: ent.TypeStreamSession, tq: q}, nil
 	case *ent.StreamerQuery:
 		return &query[*ent.StreamerQuery, predicate.Streamer, streamer.OrderOption]{typ: ent.TypeStreamer, tq: q}, nil
 	case *ent.StreamingPlatformQuery:
 		return &query[*ent.StreamingPlatformQuery, predicate.StreamingPlatform, streamingplatform.OrderOption]{typ: ent.TypeStreamingPlatform, tq: q}, nil
+	case *ent.SystemSettingQuery:
+		return &query[*ent.SystemSettingQuery, predicate.SystemSetting, systemsetting.OrderOption]{typ: ent.TypeSystemSetting, tq: q}, nil
 	case *ent.UserQuery:
 		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
 	case *ent.UserFollowedStreamerQuery:
 		return &query[*ent.UserFollowedStreamerQuery, predicate.UserFollowedStreamer, userfollowedstreamer.OrderOption]{typ: ent.TypeUserFollowedStreamer, tq: q}, nil
+	case *ent.WebPushSubscriptionQuery:
+		return &query[*ent.WebPushSubscriptionQuery, predicate.WebPushSubscription, webpushsubscription.OrderOption]{typ: ent.TypeWebPushSubscription, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
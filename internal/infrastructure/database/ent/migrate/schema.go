@@ -8,6 +8,53 @@ import (
 )
 
 var (
+	// InboxMessagesColumns holds the columns for the "inbox_messages" table.
+	InboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "channel_id", Type: field.TypeInt64},
+		{Name: "streamer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "severity", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Size: 2147483647},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "url", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "image_url", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "icon_url", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+	}
+	// InboxMessagesTable holds the schema information for the "inbox_messages" table.
+	InboxMessagesTable = &schema.Table{
+		Name:       "inbox_messages",
+		Columns:    InboxMessagesColumns,
+		PrimaryKey: []*schema.Column{InboxMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "inbox_messages_users_inbox_messages",
+				Columns:    []*schema.Column{InboxMessagesColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inboxmessage_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboxMessagesColumns[12], InboxMessagesColumns[11]},
+			},
+			{
+				Name:    "inboxmessage_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboxMessagesColumns[12], InboxMessagesColumns[10]},
+			},
+			{
+				Name:    "inboxmessage_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboxMessagesColumns[11]},
+			},
+		},
+	}
 	// NotificationChannelsColumns holds the columns for the "notification_channels" table.
 	NotificationChannelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -16,6 +63,24 @@ var (
 		{Name: "config", Type: field.TypeJSON, Nullable: true},
 		{Name: "enable", Type: field.TypeBool, Default: true},
 		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "title_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "body_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "digest_window_seconds", Type: field.TypeInt64, Default: 0},
+		{Name: "daily_summary_at", Type: field.TypeString, Nullable: true},
+		{Name: "daily_summary_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "daily_summary_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_tested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_test_success", Type: field.TypeBool, Nullable: true},
+		{Name: "last_test_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "verification_code_hash", Type: field.TypeString, Nullable: true},
+		{Name: "verification_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verification_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "verification_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "last_failure_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_success_at", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt64},
@@ -28,7 +93,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "notification_channels_users_notification_channels",
-				Columns:    []*schema.Column{NotificationChannelsColumns[8]},
+				Columns:    []*schema.Column{NotificationChannelsColumns[26]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -37,17 +102,164 @@ var (
 			{
 				Name:    "notificationchannel_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{NotificationChannelsColumns[8]},
+				Columns: []*schema.Column{NotificationChannelsColumns[26]},
 			},
 			{
 				Name:    "notificationchannel_channel_type",
 				Unique:  false,
 				Columns: []*schema.Column{NotificationChannelsColumns[1]},
 			},
+			{
+				Name:    "notificationchannel_daily_summary_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationChannelsColumns[9]},
+			},
 			{
 				Name:    "notificationchannel_user_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{NotificationChannelsColumns[8], NotificationChannelsColumns[2]},
+				Columns: []*schema.Column{NotificationChannelsColumns[26], NotificationChannelsColumns[2]},
+			},
+		},
+	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "follow_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "channel_id", Type: field.TypeInt64},
+		{Name: "channel_type", Type: field.TypeString},
+		{Name: "streamer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "response", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "route_id", Type: field.TypeString, Nullable: true},
+		{Name: "route_mode", Type: field.TypeString, Nullable: true},
+		{Name: "route_step", Type: field.TypeInt, Default: 0},
+		{Name: "escalate_after_seconds", Type: field.TypeInt64, Default: 0},
+		{Name: "digest_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+	}
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_deliveries_users_notification_deliveries",
+				Columns:    []*schema.Column{NotificationDeliveriesColumns[23]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[23], NotificationDeliveriesColumns[21]},
+			},
+			{
+				Name:    "notificationdelivery_channel_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[2], NotificationDeliveriesColumns[21]},
+			},
+			{
+				Name:    "notificationdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[8], NotificationDeliveriesColumns[12]},
+			},
+			{
+				Name:    "notificationdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[21]},
+			},
+			{
+				Name:    "notificationdelivery_route_id_route_step",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[15], NotificationDeliveriesColumns[17]},
+			},
+			{
+				Name:    "notificationdelivery_status_channel_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[8], NotificationDeliveriesColumns[2]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "routing_mode", Type: field.TypeString},
+		{Name: "escalate_after_seconds", Type: field.TypeInt64, Default: 0},
+		{Name: "quiet_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "do_not_disturb_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64, Unique: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_users_notification_preference",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// StreamSessionsColumns holds the columns for the "stream_sessions" table.
+	StreamSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "game_name", Type: field.TypeString, Nullable: true},
+		{Name: "titles", Type: field.TypeJSON, Nullable: true},
+		{Name: "categories", Type: field.TypeJSON, Nullable: true},
+		{Name: "announced_title", Type: field.TypeString, Nullable: true},
+		{Name: "announced_game_name", Type: field.TypeString, Nullable: true},
+		{Name: "changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "peak_viewers", Type: field.TypeInt, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "streamer_id", Type: field.TypeInt64},
+	}
+	// StreamSessionsTable holds the schema information for the "stream_sessions" table.
+	StreamSessionsTable = &schema.Table{
+		Name:       "stream_sessions",
+		Columns:    StreamSessionsColumns,
+		PrimaryKey: []*schema.Column{StreamSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stream_sessions_streamers_sessions",
+				Columns:    []*schema.Column{StreamSessionsColumns[14]},
+				RefColumns: []*schema.Column{StreamersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "streamsession_streamer_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{StreamSessionsColumns[14], StreamSessionsColumns[9]},
+			},
+			{
+				Name:    "streamsession_streamer_id_ended_at",
+				Unique:  false,
+				Columns: []*schema.Column{StreamSessionsColumns[14], StreamSessionsColumns[11]},
 			},
 		},
 	}
@@ -122,12 +334,27 @@ var (
 			},
 		},
 	}
+	// SystemSettingsColumns holds the columns for the "system_settings" table.
+	SystemSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "value", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SystemSettingsTable holds the schema information for the "system_settings" table.
+	SystemSettingsTable = &schema.Table{
+		Name:       "system_settings",
+		Columns:    SystemSettingsColumns,
+		PrimaryKey: []*schema.Column{SystemSettingsColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "username", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString},
 		{Name: "password", Type: field.TypeString},
+		{Name: "locale", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -156,7 +383,19 @@ var (
 		{Name: "notes", Type: field.TypeString, Nullable: true},
 		{Name: "notifications_enabled", Type: field.TypeBool, Default: true},
 		{Name: "notification_channel_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "title_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "body_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "routing_mode", Type: field.TypeString, Nullable: true},
+		{Name: "escalate_after_seconds", Type: field.TypeInt64, Nullable: true},
+		{Name: "always_notify", Type: field.TypeBool, Default: false},
+		{Name: "notify_stream_end", Type: field.TypeBool, Default: false},
+		{Name: "notify_title_change", Type: field.TypeBool, Default: false},
+		{Name: "notify_categories", Type: field.TypeJSON, Nullable: true},
+		{Name: "notification_filter", Type: field.TypeJSON, Nullable: true},
+		{Name: "channel_overrides", Type: field.TypeJSON, Nullable: true},
 		{Name: "last_notification_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "snoozed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "muted_session_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "streamer_id", Type: field.TypeInt64},
@@ -170,13 +409,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_followed_streamers_streamers_followers",
-				Columns:    []*schema.Column{UserFollowedStreamersColumns[8]},
+				Columns:    []*schema.Column{UserFollowedStreamersColumns[20]},
 				RefColumns: []*schema.Column{StreamersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "user_followed_streamers_users_followed_streamers",
-				Columns:    []*schema.Column{UserFollowedStreamersColumns[9]},
+				Columns:    []*schema.Column{UserFollowedStreamersColumns[21]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -185,32 +424,75 @@ var (
 			{
 				Name:    "userfollowedstreamer_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserFollowedStreamersColumns[9]},
+				Columns: []*schema.Column{UserFollowedStreamersColumns[21]},
 			},
 			{
 				Name:    "userfollowedstreamer_streamer_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserFollowedStreamersColumns[8]},
+				Columns: []*schema.Column{UserFollowedStreamersColumns[20]},
 			},
 			{
 				Name:    "userfollowedstreamer_user_id_streamer_id",
 				Unique:  true,
-				Columns: []*schema.Column{UserFollowedStreamersColumns[9], UserFollowedStreamersColumns[8]},
+				Columns: []*schema.Column{UserFollowedStreamersColumns[21], UserFollowedStreamersColumns[20]},
+			},
+		},
+	}
+	// WebPushSubscriptionsColumns holds the columns for the "web_push_subscriptions" table.
+	WebPushSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "endpoint", Type: field.TypeString, Unique: true, Size: 2147483647},
+		{Name: "p256dh", Type: field.TypeString},
+		{Name: "auth", Type: field.TypeString},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+	}
+	// WebPushSubscriptionsTable holds the schema information for the "web_push_subscriptions" table.
+	WebPushSubscriptionsTable = &schema.Table{
+		Name:       "web_push_subscriptions",
+		Columns:    WebPushSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebPushSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "web_push_subscriptions_users_web_push_subscriptions",
+				Columns:    []*schema.Column{WebPushSubscriptionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webpushsubscription_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebPushSubscriptionsColumns[7]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		InboxMessagesTable,
 		NotificationChannelsTable,
+		NotificationDeliveriesTable,
+		NotificationPreferencesTable,
+		StreamSessionsTable,
 		StreamersTable,
 		StreamingPlatformsTable,
+		SystemSettingsTable,
 		UsersTable,
 		UserFollowedStreamersTable,
+		WebPushSubscriptionsTable,
 	}
 )
 
 func init() {
+	InboxMessagesTable.ForeignKeys[0].RefTable = UsersTable
 	NotificationChannelsTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationDeliveriesTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	StreamSessionsTable.ForeignKeys[0].RefTable = StreamersTable
 	UserFollowedStreamersTable.ForeignKeys[0].RefTable = StreamersTable
 	UserFollowedStreamersTable.ForeignKeys[1].RefTable = UsersTable
+	WebPushSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -11,12 +11,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamingplatform"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/systemsetting"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 )
 
 const (
@@ -28,46 +34,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeNotificationChannel  = "NotificationChannel"
-	TypeStreamer             = "Streamer"
-	TypeStreamingPlatform    = "StreamingPlatform"
-	TypeUser                 = "User"
-	TypeUserFollowedStreamer = "UserFollowedStreamer"
+	TypeInboxMessage           = "InboxMessage"
+	TypeNotificationChannel    = "NotificationChannel"
+	TypeNotificationDelivery   = "NotificationDelivery"
+	TypeNotificationPreference = "NotificationPreference"
+	TypeStreamSession          = "StreamSession"
+	TypeStreamer               = "Streamer"
+	TypeStreamingPlatform      = "StreamingPlatform"
+	TypeSystemSetting          = "SystemSetting"
+	TypeUser                   = "User"
+	TypeUserFollowedStreamer   = "UserFollowedStreamer"
+	TypeWebPushSubscription    = "WebPushSubscription"
 )
 
-// NotificationChannelMutation represents an operation that mutates the NotificationChannel nodes in the graph.
-type NotificationChannelMutation struct {
+// InboxMessageMutation represents an operation that mutates the InboxMessage nodes in the graph.
+type InboxMessageMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	channel_type  *string
-	name          *string
-	_config       *map[string]interface{}
-	enable        *bool
-	priority      *int
-	addpriority   *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int64
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*NotificationChannel, error)
-	predicates    []predicate.NotificationChannel
-}
-
-var _ ent.Mutation = (*NotificationChannelMutation)(nil)
-
-// notificationchannelOption allows management of the mutation configuration using functional options.
-type notificationchannelOption func(*NotificationChannelMutation)
-
-// newNotificationChannelMutation creates new mutation for the NotificationChannel entity.
-func newNotificationChannelMutation(c config, op Op, opts ...notificationchannelOption) *NotificationChannelMutation {
-	m := &NotificationChannelMutation{
+	op             Op
+	typ            string
+	id             *int64
+	channel_id     *int64
+	addchannel_id  *int64
+	streamer_id    *int64
+	addstreamer_id *int64
+	event_type     *string
+	severity       *string
+	title          *string
+	content        *string
+	url            *string
+	image_url      *string
+	icon_url       *string
+	read_at        *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *int64
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*InboxMessage, error)
+	predicates     []predicate.InboxMessage
+}
+
+var _ ent.Mutation = (*InboxMessageMutation)(nil)
+
+// inboxmessageOption allows management of the mutation configuration using functional options.
+type inboxmessageOption func(*InboxMessageMutation)
+
+// newInboxMessageMutation creates new mutation for the InboxMessage entity.
+func newInboxMessageMutation(c config, op Op, opts ...inboxmessageOption) *InboxMessageMutation {
+	m := &InboxMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotificationChannel,
+		typ:           TypeInboxMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -76,20 +93,20 @@ func newNotificationChannelMutation(c config, op Op, opts ...notificationchannel
 	return m
 }
 
-// withNotificationChannelID sets the ID field of the mutation.
-func withNotificationChannelID(id int64) notificationchannelOption {
-	return func(m *NotificationChannelMutation) {
+// withInboxMessageID sets the ID field of the mutation.
+func withInboxMessageID(id int64) inboxmessageOption {
+	return func(m *InboxMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NotificationChannel
+			value *InboxMessage
 		)
-		m.oldValue = func(ctx context.Context) (*NotificationChannel, error) {
+		m.oldValue = func(ctx context.Context) (*InboxMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NotificationChannel.Get(ctx, id)
+					value, err = m.Client().InboxMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -98,10 +115,10 @@ func withNotificationChannelID(id int64) notificationchannelOption {
 	}
 }
 
-// withNotificationChannel sets the old NotificationChannel of the mutation.
-func withNotificationChannel(node *NotificationChannel) notificationchannelOption {
-	return func(m *NotificationChannelMutation) {
-		m.oldValue = func(context.Context) (*NotificationChannel, error) {
+// withInboxMessage sets the old InboxMessage of the mutation.
+func withInboxMessage(node *InboxMessage) inboxmessageOption {
+	return func(m *InboxMessageMutation) {
+		m.oldValue = func(context.Context) (*InboxMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -110,7 +127,7 @@ func withNotificationChannel(node *NotificationChannel) notificationchannelOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationChannelMutation) Client() *Client {
+func (m InboxMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -118,7 +135,7 @@ func (m NotificationChannelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationChannelMutation) Tx() (*Tx, error) {
+func (m InboxMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -128,14 +145,14 @@ func (m NotificationChannelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of NotificationChannel entities.
-func (m *NotificationChannelMutation) SetID(id int64) {
+// operation is only accepted on creation of InboxMessage entities.
+func (m *InboxMessageMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationChannelMutation) ID() (id int64, exists bool) {
+func (m *InboxMessageMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -146,7 +163,7 @@ func (m *NotificationChannelMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationChannelMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *InboxMessageMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -155,19 +172,19 @@ func (m *NotificationChannelMutation) IDs(ctx context.Context) ([]int64, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NotificationChannel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().InboxMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *NotificationChannelMutation) SetUserID(i int64) {
+func (m *InboxMessageMutation) SetUserID(i int64) {
 	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *NotificationChannelMutation) UserID() (r int64, exists bool) {
+func (m *InboxMessageMutation) UserID() (r int64, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -175,10 +192,10 @@ func (m *NotificationChannelMutation) UserID() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *InboxMessageMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -193,331 +210,561 @@ func (m *NotificationChannelMutation) OldUserID(ctx context.Context) (v int64, e
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *NotificationChannelMutation) ResetUserID() {
+func (m *InboxMessageMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetChannelType sets the "channel_type" field.
-func (m *NotificationChannelMutation) SetChannelType(s string) {
-	m.channel_type = &s
+// SetChannelID sets the "channel_id" field.
+func (m *InboxMessageMutation) SetChannelID(i int64) {
+	m.channel_id = &i
+	m.addchannel_id = nil
 }
 
-// ChannelType returns the value of the "channel_type" field in the mutation.
-func (m *NotificationChannelMutation) ChannelType() (r string, exists bool) {
-	v := m.channel_type
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *InboxMessageMutation) ChannelID() (r int64, exists bool) {
+	v := m.channel_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChannelType returns the old "channel_type" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldChannelID returns the old "channel_id" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldChannelType(ctx context.Context) (v string, err error) {
+func (m *InboxMessageMutation) OldChannelID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChannelType is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChannelType requires an ID field in the mutation")
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChannelType: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
 	}
-	return oldValue.ChannelType, nil
+	return oldValue.ChannelID, nil
 }
 
-// ResetChannelType resets all changes to the "channel_type" field.
-func (m *NotificationChannelMutation) ResetChannelType() {
-	m.channel_type = nil
+// AddChannelID adds i to the "channel_id" field.
+func (m *InboxMessageMutation) AddChannelID(i int64) {
+	if m.addchannel_id != nil {
+		*m.addchannel_id += i
+	} else {
+		m.addchannel_id = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *NotificationChannelMutation) SetName(s string) {
-	m.name = &s
+// AddedChannelID returns the value that was added to the "channel_id" field in this mutation.
+func (m *InboxMessageMutation) AddedChannelID() (r int64, exists bool) {
+	v := m.addchannel_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NotificationChannelMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *InboxMessageMutation) ResetChannelID() {
+	m.channel_id = nil
+	m.addchannel_id = nil
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (m *InboxMessageMutation) SetStreamerID(i int64) {
+	m.streamer_id = &i
+	m.addstreamer_id = nil
+}
+
+// StreamerID returns the value of the "streamer_id" field in the mutation.
+func (m *InboxMessageMutation) StreamerID() (r int64, exists bool) {
+	v := m.streamer_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldStreamerID returns the old "streamer_id" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *InboxMessageMutation) OldStreamerID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStreamerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldStreamerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStreamerID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.StreamerID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NotificationChannelMutation) ResetName() {
-	m.name = nil
+// AddStreamerID adds i to the "streamer_id" field.
+func (m *InboxMessageMutation) AddStreamerID(i int64) {
+	if m.addstreamer_id != nil {
+		*m.addstreamer_id += i
+	} else {
+		m.addstreamer_id = &i
+	}
 }
 
-// SetConfig sets the "config" field.
-func (m *NotificationChannelMutation) SetConfig(value map[string]interface{}) {
-	m._config = &value
+// AddedStreamerID returns the value that was added to the "streamer_id" field in this mutation.
+func (m *InboxMessageMutation) AddedStreamerID() (r int64, exists bool) {
+	v := m.addstreamer_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *NotificationChannelMutation) Config() (r map[string]interface{}, exists bool) {
-	v := m._config
+// ClearStreamerID clears the value of the "streamer_id" field.
+func (m *InboxMessageMutation) ClearStreamerID() {
+	m.streamer_id = nil
+	m.addstreamer_id = nil
+	m.clearedFields[inboxmessage.FieldStreamerID] = struct{}{}
+}
+
+// StreamerIDCleared returns if the "streamer_id" field was cleared in this mutation.
+func (m *InboxMessageMutation) StreamerIDCleared() bool {
+	_, ok := m.clearedFields[inboxmessage.FieldStreamerID]
+	return ok
+}
+
+// ResetStreamerID resets all changes to the "streamer_id" field.
+func (m *InboxMessageMutation) ResetStreamerID() {
+	m.streamer_id = nil
+	m.addstreamer_id = nil
+	delete(m.clearedFields, inboxmessage.FieldStreamerID)
+}
+
+// SetEventType sets the "event_type" field.
+func (m *InboxMessageMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *InboxMessageMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *InboxMessageMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.Config, nil
-}
-
-// ClearConfig clears the value of the "config" field.
-func (m *NotificationChannelMutation) ClearConfig() {
-	m._config = nil
-	m.clearedFields[notificationchannel.FieldConfig] = struct{}{}
-}
-
-// ConfigCleared returns if the "config" field was cleared in this mutation.
-func (m *NotificationChannelMutation) ConfigCleared() bool {
-	_, ok := m.clearedFields[notificationchannel.FieldConfig]
-	return ok
+	return oldValue.EventType, nil
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *NotificationChannelMutation) ResetConfig() {
-	m._config = nil
-	delete(m.clearedFields, notificationchannel.FieldConfig)
+// ResetEventType resets all changes to the "event_type" field.
+func (m *InboxMessageMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetEnable sets the "enable" field.
-func (m *NotificationChannelMutation) SetEnable(b bool) {
-	m.enable = &b
+// SetSeverity sets the "severity" field.
+func (m *InboxMessageMutation) SetSeverity(s string) {
+	m.severity = &s
 }
 
-// Enable returns the value of the "enable" field in the mutation.
-func (m *NotificationChannelMutation) Enable() (r bool, exists bool) {
-	v := m.enable
+// Severity returns the value of the "severity" field in the mutation.
+func (m *InboxMessageMutation) Severity() (r string, exists bool) {
+	v := m.severity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnable returns the old "enable" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldSeverity returns the old "severity" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldEnable(ctx context.Context) (v bool, err error) {
+func (m *InboxMessageMutation) OldSeverity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnable is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnable requires an ID field in the mutation")
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnable: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
 	}
-	return oldValue.Enable, nil
+	return oldValue.Severity, nil
 }
 
-// ResetEnable resets all changes to the "enable" field.
-func (m *NotificationChannelMutation) ResetEnable() {
-	m.enable = nil
+// ClearSeverity clears the value of the "severity" field.
+func (m *InboxMessageMutation) ClearSeverity() {
+	m.severity = nil
+	m.clearedFields[inboxmessage.FieldSeverity] = struct{}{}
 }
 
-// SetPriority sets the "priority" field.
-func (m *NotificationChannelMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
+// SeverityCleared returns if the "severity" field was cleared in this mutation.
+func (m *InboxMessageMutation) SeverityCleared() bool {
+	_, ok := m.clearedFields[inboxmessage.FieldSeverity]
+	return ok
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *NotificationChannelMutation) Priority() (r int, exists bool) {
-	v := m.priority
+// ResetSeverity resets all changes to the "severity" field.
+func (m *InboxMessageMutation) ResetSeverity() {
+	m.severity = nil
+	delete(m.clearedFields, inboxmessage.FieldSeverity)
+}
+
+// SetTitle sets the "title" field.
+func (m *InboxMessageMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *InboxMessageMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldPriority(ctx context.Context) (v int, err error) {
+func (m *InboxMessageMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Priority, nil
+	return oldValue.Title, nil
 }
 
-// AddPriority adds i to the "priority" field.
-func (m *NotificationChannelMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
+// ResetTitle resets all changes to the "title" field.
+func (m *InboxMessageMutation) ResetTitle() {
+	m.title = nil
 }
 
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *NotificationChannelMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
+// SetContent sets the "content" field.
+func (m *InboxMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *InboxMessageMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *NotificationChannelMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// OldContent returns the old "content" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NotificationChannelMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetContent resets all changes to the "content" field.
+func (m *InboxMessageMutation) ResetContent() {
+	m.content = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationChannelMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetURL sets the "url" field.
+func (m *InboxMessageMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *InboxMessageMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InboxMessageMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.URL, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationChannelMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearURL clears the value of the "url" field.
+func (m *InboxMessageMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[inboxmessage.FieldURL] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NotificationChannelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *InboxMessageMutation) URLCleared() bool {
+	_, ok := m.clearedFields[inboxmessage.FieldURL]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotificationChannelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetURL resets all changes to the "url" field.
+func (m *InboxMessageMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, inboxmessage.FieldURL)
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *InboxMessageMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *InboxMessageMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the NotificationChannel entity.
-// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldImageURL returns the old "image_url" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationChannelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *InboxMessageMutation) OldImageURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ImageURL, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotificationChannelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearImageURL clears the value of the "image_url" field.
+func (m *InboxMessageMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[inboxmessage.FieldImageURL] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NotificationChannelMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[notificationchannel.FieldUserID] = struct{}{}
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *InboxMessageMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[inboxmessage.FieldImageURL]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NotificationChannelMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *InboxMessageMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, inboxmessage.FieldImageURL)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NotificationChannelMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetIconURL sets the "icon_url" field.
+func (m *InboxMessageMutation) SetIconURL(s string) {
+	m.icon_url = &s
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *NotificationChannelMutation) ResetUser() {
+// IconURL returns the value of the "icon_url" field in the mutation.
+func (m *InboxMessageMutation) IconURL() (r string, exists bool) {
+	v := m.icon_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIconURL returns the old "icon_url" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMessageMutation) OldIconURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIconURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIconURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIconURL: %w", err)
+	}
+	return oldValue.IconURL, nil
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (m *InboxMessageMutation) ClearIconURL() {
+	m.icon_url = nil
+	m.clearedFields[inboxmessage.FieldIconURL] = struct{}{}
+}
+
+// IconURLCleared returns if the "icon_url" field was cleared in this mutation.
+func (m *InboxMessageMutation) IconURLCleared() bool {
+	_, ok := m.clearedFields[inboxmessage.FieldIconURL]
+	return ok
+}
+
+// ResetIconURL resets all changes to the "icon_url" field.
+func (m *InboxMessageMutation) ResetIconURL() {
+	m.icon_url = nil
+	delete(m.clearedFields, inboxmessage.FieldIconURL)
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *InboxMessageMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *InboxMessageMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMessageMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *InboxMessageMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[inboxmessage.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *InboxMessageMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[inboxmessage.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *InboxMessageMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, inboxmessage.FieldReadAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InboxMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InboxMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the InboxMessage entity.
+// If the InboxMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InboxMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *InboxMessageMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[inboxmessage.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *InboxMessageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *InboxMessageMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *InboxMessageMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the NotificationChannelMutation builder.
-func (m *NotificationChannelMutation) Where(ps ...predicate.NotificationChannel) {
+// Where appends a list predicates to the InboxMessageMutation builder.
+func (m *InboxMessageMutation) Where(ps ...predicate.InboxMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotificationChannelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InboxMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationChannelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NotificationChannel, len(ps))
+func (m *InboxMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InboxMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -525,48 +772,60 @@ func (m *NotificationChannelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotificationChannelMutation) Op() Op {
+func (m *InboxMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotificationChannelMutation) SetOp(op Op) {
+func (m *InboxMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NotificationChannel).
-func (m *NotificationChannelMutation) Type() string {
+// Type returns the node type of this mutation (InboxMessage).
+func (m *InboxMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NotificationChannelMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *InboxMessageMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.user != nil {
-		fields = append(fields, notificationchannel.FieldUserID)
+		fields = append(fields, inboxmessage.FieldUserID)
 	}
-	if m.channel_type != nil {
-		fields = append(fields, notificationchannel.FieldChannelType)
+	if m.channel_id != nil {
+		fields = append(fields, inboxmessage.FieldChannelID)
 	}
-	if m.name != nil {
-		fields = append(fields, notificationchannel.FieldName)
+	if m.streamer_id != nil {
+		fields = append(fields, inboxmessage.FieldStreamerID)
 	}
-	if m._config != nil {
-		fields = append(fields, notificationchannel.FieldConfig)
+	if m.event_type != nil {
+		fields = append(fields, inboxmessage.FieldEventType)
 	}
-	if m.enable != nil {
-		fields = append(fields, notificationchannel.FieldEnable)
+	if m.severity != nil {
+		fields = append(fields, inboxmessage.FieldSeverity)
 	}
-	if m.priority != nil {
-		fields = append(fields, notificationchannel.FieldPriority)
+	if m.title != nil {
+		fields = append(fields, inboxmessage.FieldTitle)
 	}
-	if m.created_at != nil {
-		fields = append(fields, notificationchannel.FieldCreatedAt)
+	if m.content != nil {
+		fields = append(fields, inboxmessage.FieldContent)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, notificationchannel.FieldUpdatedAt)
+	if m.url != nil {
+		fields = append(fields, inboxmessage.FieldURL)
+	}
+	if m.image_url != nil {
+		fields = append(fields, inboxmessage.FieldImageURL)
+	}
+	if m.icon_url != nil {
+		fields = append(fields, inboxmessage.FieldIconURL)
+	}
+	if m.read_at != nil {
+		fields = append(fields, inboxmessage.FieldReadAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, inboxmessage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -574,24 +833,32 @@ func (m *NotificationChannelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotificationChannelMutation) Field(name string) (ent.Value, bool) {
+func (m *InboxMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notificationchannel.FieldUserID:
+	case inboxmessage.FieldUserID:
 		return m.UserID()
-	case notificationchannel.FieldChannelType:
-		return m.ChannelType()
-	case notificationchannel.FieldName:
-		return m.Name()
-	case notificationchannel.FieldConfig:
-		return m.Config()
-	case notificationchannel.FieldEnable:
-		return m.Enable()
-	case notificationchannel.FieldPriority:
-		return m.Priority()
-	case notificationchannel.FieldCreatedAt:
+	case inboxmessage.FieldChannelID:
+		return m.ChannelID()
+	case inboxmessage.FieldStreamerID:
+		return m.StreamerID()
+	case inboxmessage.FieldEventType:
+		return m.EventType()
+	case inboxmessage.FieldSeverity:
+		return m.Severity()
+	case inboxmessage.FieldTitle:
+		return m.Title()
+	case inboxmessage.FieldContent:
+		return m.Content()
+	case inboxmessage.FieldURL:
+		return m.URL()
+	case inboxmessage.FieldImageURL:
+		return m.ImageURL()
+	case inboxmessage.FieldIconURL:
+		return m.IconURL()
+	case inboxmessage.FieldReadAt:
+		return m.ReadAt()
+	case inboxmessage.FieldCreatedAt:
 		return m.CreatedAt()
-	case notificationchannel.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -599,99 +866,138 @@ func (m *NotificationChannelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotificationChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InboxMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notificationchannel.FieldUserID:
+	case inboxmessage.FieldUserID:
 		return m.OldUserID(ctx)
-	case notificationchannel.FieldChannelType:
-		return m.OldChannelType(ctx)
-	case notificationchannel.FieldName:
-		return m.OldName(ctx)
-	case notificationchannel.FieldConfig:
-		return m.OldConfig(ctx)
-	case notificationchannel.FieldEnable:
-		return m.OldEnable(ctx)
-	case notificationchannel.FieldPriority:
-		return m.OldPriority(ctx)
-	case notificationchannel.FieldCreatedAt:
+	case inboxmessage.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case inboxmessage.FieldStreamerID:
+		return m.OldStreamerID(ctx)
+	case inboxmessage.FieldEventType:
+		return m.OldEventType(ctx)
+	case inboxmessage.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case inboxmessage.FieldTitle:
+		return m.OldTitle(ctx)
+	case inboxmessage.FieldContent:
+		return m.OldContent(ctx)
+	case inboxmessage.FieldURL:
+		return m.OldURL(ctx)
+	case inboxmessage.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case inboxmessage.FieldIconURL:
+		return m.OldIconURL(ctx)
+	case inboxmessage.FieldReadAt:
+		return m.OldReadAt(ctx)
+	case inboxmessage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case notificationchannel.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown NotificationChannel field %s", name)
+	return nil, fmt.Errorf("unknown InboxMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationChannelMutation) SetField(name string, value ent.Value) error {
+func (m *InboxMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notificationchannel.FieldUserID:
+	case inboxmessage.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case notificationchannel.FieldChannelType:
+	case inboxmessage.FieldChannelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case inboxmessage.FieldStreamerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreamerID(v)
+		return nil
+	case inboxmessage.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChannelType(v)
+		m.SetEventType(v)
 		return nil
-	case notificationchannel.FieldName:
+	case inboxmessage.FieldSeverity:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSeverity(v)
 		return nil
-	case notificationchannel.FieldConfig:
-		v, ok := value.(map[string]interface{})
+	case inboxmessage.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfig(v)
+		m.SetTitle(v)
 		return nil
-	case notificationchannel.FieldEnable:
-		v, ok := value.(bool)
+	case inboxmessage.FieldContent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnable(v)
+		m.SetContent(v)
 		return nil
-	case notificationchannel.FieldPriority:
-		v, ok := value.(int)
+	case inboxmessage.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriority(v)
+		m.SetURL(v)
 		return nil
-	case notificationchannel.FieldCreatedAt:
+	case inboxmessage.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case inboxmessage.FieldIconURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconURL(v)
+		return nil
+	case inboxmessage.FieldReadAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetReadAt(v)
 		return nil
-	case notificationchannel.FieldUpdatedAt:
+	case inboxmessage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationChannel field %s", name)
+	return fmt.Errorf("unknown InboxMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotificationChannelMutation) AddedFields() []string {
+func (m *InboxMessageMutation) AddedFields() []string {
 	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, notificationchannel.FieldPriority)
+	if m.addchannel_id != nil {
+		fields = append(fields, inboxmessage.FieldChannelID)
+	}
+	if m.addstreamer_id != nil {
+		fields = append(fields, inboxmessage.FieldStreamerID)
 	}
 	return fields
 }
@@ -699,10 +1005,12 @@ func (m *NotificationChannelMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotificationChannelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InboxMessageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case notificationchannel.FieldPriority:
-		return m.AddedPriority()
+	case inboxmessage.FieldChannelID:
+		return m.AddedChannelID()
+	case inboxmessage.FieldStreamerID:
+		return m.AddedStreamerID()
 	}
 	return nil, false
 }
@@ -710,93 +1018,142 @@ func (m *NotificationChannelMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationChannelMutation) AddField(name string, value ent.Value) error {
+func (m *InboxMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case notificationchannel.FieldPriority:
-		v, ok := value.(int)
+	case inboxmessage.FieldChannelID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPriority(v)
+		m.AddChannelID(v)
+		return nil
+	case inboxmessage.FieldStreamerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStreamerID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationChannel numeric field %s", name)
+	return fmt.Errorf("unknown InboxMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotificationChannelMutation) ClearedFields() []string {
+func (m *InboxMessageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(notificationchannel.FieldConfig) {
-		fields = append(fields, notificationchannel.FieldConfig)
+	if m.FieldCleared(inboxmessage.FieldStreamerID) {
+		fields = append(fields, inboxmessage.FieldStreamerID)
+	}
+	if m.FieldCleared(inboxmessage.FieldSeverity) {
+		fields = append(fields, inboxmessage.FieldSeverity)
+	}
+	if m.FieldCleared(inboxmessage.FieldURL) {
+		fields = append(fields, inboxmessage.FieldURL)
+	}
+	if m.FieldCleared(inboxmessage.FieldImageURL) {
+		fields = append(fields, inboxmessage.FieldImageURL)
+	}
+	if m.FieldCleared(inboxmessage.FieldIconURL) {
+		fields = append(fields, inboxmessage.FieldIconURL)
+	}
+	if m.FieldCleared(inboxmessage.FieldReadAt) {
+		fields = append(fields, inboxmessage.FieldReadAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotificationChannelMutation) FieldCleared(name string) bool {
+func (m *InboxMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotificationChannelMutation) ClearField(name string) error {
+func (m *InboxMessageMutation) ClearField(name string) error {
 	switch name {
-	case notificationchannel.FieldConfig:
-		m.ClearConfig()
+	case inboxmessage.FieldStreamerID:
+		m.ClearStreamerID()
+		return nil
+	case inboxmessage.FieldSeverity:
+		m.ClearSeverity()
+		return nil
+	case inboxmessage.FieldURL:
+		m.ClearURL()
+		return nil
+	case inboxmessage.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case inboxmessage.FieldIconURL:
+		m.ClearIconURL()
+		return nil
+	case inboxmessage.FieldReadAt:
+		m.ClearReadAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationChannel nullable field %s", name)
+	return fmt.Errorf("unknown InboxMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotificationChannelMutation) ResetField(name string) error {
+func (m *InboxMessageMutation) ResetField(name string) error {
 	switch name {
-	case notificationchannel.FieldUserID:
+	case inboxmessage.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case notificationchannel.FieldChannelType:
-		m.ResetChannelType()
+	case inboxmessage.FieldChannelID:
+		m.ResetChannelID()
 		return nil
-	case notificationchannel.FieldName:
-		m.ResetName()
+	case inboxmessage.FieldStreamerID:
+		m.ResetStreamerID()
 		return nil
-	case notificationchannel.FieldConfig:
-		m.ResetConfig()
+	case inboxmessage.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case notificationchannel.FieldEnable:
-		m.ResetEnable()
+	case inboxmessage.FieldSeverity:
+		m.ResetSeverity()
 		return nil
-	case notificationchannel.FieldPriority:
-		m.ResetPriority()
+	case inboxmessage.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case notificationchannel.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case inboxmessage.FieldContent:
+		m.ResetContent()
 		return nil
-	case notificationchannel.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case inboxmessage.FieldURL:
+		m.ResetURL()
+		return nil
+	case inboxmessage.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case inboxmessage.FieldIconURL:
+		m.ResetIconURL()
+		return nil
+	case inboxmessage.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	case inboxmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationChannel field %s", name)
+	return fmt.Errorf("unknown InboxMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationChannelMutation) AddedEdges() []string {
+func (m *InboxMessageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, notificationchannel.EdgeUser)
+		edges = append(edges, inboxmessage.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotificationChannelMutation) AddedIDs(name string) []ent.Value {
+func (m *InboxMessageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notificationchannel.EdgeUser:
+	case inboxmessage.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -805,31 +1162,31 @@ func (m *NotificationChannelMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationChannelMutation) RemovedEdges() []string {
+func (m *InboxMessageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotificationChannelMutation) RemovedIDs(name string) []ent.Value {
+func (m *InboxMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationChannelMutation) ClearedEdges() []string {
+func (m *InboxMessageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, notificationchannel.EdgeUser)
+		edges = append(edges, inboxmessage.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotificationChannelMutation) EdgeCleared(name string) bool {
+func (m *InboxMessageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notificationchannel.EdgeUser:
+	case inboxmessage.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -837,71 +1194,80 @@ func (m *NotificationChannelMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotificationChannelMutation) ClearEdge(name string) error {
+func (m *InboxMessageMutation) ClearEdge(name string) error {
 	switch name {
-	case notificationchannel.EdgeUser:
+	case inboxmessage.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationChannel unique edge %s", name)
+	return fmt.Errorf("unknown InboxMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotificationChannelMutation) ResetEdge(name string) error {
+func (m *InboxMessageMutation) ResetEdge(name string) error {
 	switch name {
-	case notificationchannel.EdgeUser:
+	case inboxmessage.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown NotificationChannel edge %s", name)
+	return fmt.Errorf("unknown InboxMessage edge %s", name)
 }
 
-// StreamerMutation represents an operation that mutates the Streamer nodes in the graph.
-type StreamerMutation struct {
+// NotificationChannelMutation represents an operation that mutates the NotificationChannel nodes in the graph.
+type NotificationChannelMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int64
-	platform_type        *string
-	platform_streamer_id *string
-	display_name         *string
-	avatar_url           *string
-	room_url             *string
-	bio                  *string
-	tags                 *[]string
-	appendtags           []string
-	is_live              *bool
-	live_title           *string
-	live_game_name       *string
-	live_start_time      *time.Time
-	live_viewers         *int
-	addlive_viewers      *int
-	live_cover_image     *string
-	last_live_synced_at  *time.Time
-	last_synced_at       *time.Time
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	followers            map[int64]struct{}
-	removedfollowers     map[int64]struct{}
-	clearedfollowers     bool
-	done                 bool
-	oldValue             func(context.Context) (*Streamer, error)
-	predicates           []predicate.Streamer
+	op                       Op
+	typ                      string
+	id                       *int64
+	channel_type             *string
+	name                     *string
+	_config                  *map[string]interface{}
+	enable                   *bool
+	priority                 *int
+	addpriority              *int
+	title_template           *string
+	body_template            *string
+	digest_window_seconds    *int64
+	adddigest_window_seconds *int64
+	daily_summary_at         *string
+	daily_summary_timezone   *string
+	daily_summary_sent_at    *time.Time
+	last_tested_at           *time.Time
+	last_test_success        *bool
+	last_test_error          *string
+	verification_code_hash   *string
+	verification_expires_at  *time.Time
+	verification_attempts    *int
+	addverification_attempts *int
+	verification_sent_at     *time.Time
+	consecutive_failures     *int
+	addconsecutive_failures  *int
+	last_error               *string
+	last_failure_at          *time.Time
+	last_success_at          *time.Time
+	disabled_at              *time.Time
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	user                     *int64
+	cleareduser              bool
+	done                     bool
+	oldValue                 func(context.Context) (*NotificationChannel, error)
+	predicates               []predicate.NotificationChannel
 }
 
-var _ ent.Mutation = (*StreamerMutation)(nil)
+var _ ent.Mutation = (*NotificationChannelMutation)(nil)
 
-// streamerOption allows management of the mutation configuration using functional options.
-type streamerOption func(*StreamerMutation)
+// notificationchannelOption allows management of the mutation configuration using functional options.
+type notificationchannelOption func(*NotificationChannelMutation)
 
-// newStreamerMutation creates new mutation for the Streamer entity.
-func newStreamerMutation(c config, op Op, opts ...streamerOption) *StreamerMutation {
-	m := &StreamerMutation{
+// newNotificationChannelMutation creates new mutation for the NotificationChannel entity.
+func newNotificationChannelMutation(c config, op Op, opts ...notificationchannelOption) *NotificationChannelMutation {
+	m := &NotificationChannelMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStreamer,
+		typ:           TypeNotificationChannel,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -910,20 +1276,20 @@ func newStreamerMutation(c config, op Op, opts ...streamerOption) *StreamerMutat
 	return m
 }
 
-// withStreamerID sets the ID field of the mutation.
-func withStreamerID(id int64) streamerOption {
-	return func(m *StreamerMutation) {
+// withNotificationChannelID sets the ID field of the mutation.
+func withNotificationChannelID(id int64) notificationchannelOption {
+	return func(m *NotificationChannelMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Streamer
+			value *NotificationChannel
 		)
-		m.oldValue = func(ctx context.Context) (*Streamer, error) {
+		m.oldValue = func(ctx context.Context) (*NotificationChannel, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Streamer.Get(ctx, id)
+					value, err = m.Client().NotificationChannel.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -932,10 +1298,10 @@ func withStreamerID(id int64) streamerOption {
 	}
 }
 
-// withStreamer sets the old Streamer of the mutation.
-func withStreamer(node *Streamer) streamerOption {
-	return func(m *StreamerMutation) {
-		m.oldValue = func(context.Context) (*Streamer, error) {
+// withNotificationChannel sets the old NotificationChannel of the mutation.
+func withNotificationChannel(node *NotificationChannel) notificationchannelOption {
+	return func(m *NotificationChannelMutation) {
+		m.oldValue = func(context.Context) (*NotificationChannel, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -944,7 +1310,7 @@ func withStreamer(node *Streamer) streamerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StreamerMutation) Client() *Client {
+func (m NotificationChannelMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -952,7 +1318,7 @@ func (m StreamerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StreamerMutation) Tx() (*Tx, error) {
+func (m NotificationChannelMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -962,14 +1328,14 @@ func (m StreamerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Streamer entities.
-func (m *StreamerMutation) SetID(id int64) {
+// operation is only accepted on creation of NotificationChannel entities.
+func (m *NotificationChannelMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StreamerMutation) ID() (id int64, exists bool) {
+func (m *NotificationChannelMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -980,7 +1346,7 @@ func (m *StreamerMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StreamerMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *NotificationChannelMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -989,943 +1355,9993 @@ func (m *StreamerMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Streamer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NotificationChannel.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPlatformType sets the "platform_type" field.
-func (m *StreamerMutation) SetPlatformType(s string) {
-	m.platform_type = &s
+// SetUserID sets the "user_id" field.
+func (m *NotificationChannelMutation) SetUserID(i int64) {
+	m.user = &i
 }
 
-// PlatformType returns the value of the "platform_type" field in the mutation.
-func (m *StreamerMutation) PlatformType() (r string, exists bool) {
-	v := m.platform_type
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationChannelMutation) UserID() (r int64, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatformType returns the old "platform_type" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldPlatformType(ctx context.Context) (v string, err error) {
+func (m *NotificationChannelMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatformType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatformType requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatformType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.PlatformType, nil
+	return oldValue.UserID, nil
 }
 
-// ResetPlatformType resets all changes to the "platform_type" field.
-func (m *StreamerMutation) ResetPlatformType() {
-	m.platform_type = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationChannelMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetPlatformStreamerID sets the "platform_streamer_id" field.
-func (m *StreamerMutation) SetPlatformStreamerID(s string) {
-	m.platform_streamer_id = &s
+// SetChannelType sets the "channel_type" field.
+func (m *NotificationChannelMutation) SetChannelType(s string) {
+	m.channel_type = &s
 }
 
-// PlatformStreamerID returns the value of the "platform_streamer_id" field in the mutation.
-func (m *StreamerMutation) PlatformStreamerID() (r string, exists bool) {
-	v := m.platform_streamer_id
+// ChannelType returns the value of the "channel_type" field in the mutation.
+func (m *NotificationChannelMutation) ChannelType() (r string, exists bool) {
+	v := m.channel_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatformStreamerID returns the old "platform_streamer_id" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldChannelType returns the old "channel_type" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldPlatformStreamerID(ctx context.Context) (v string, err error) {
+func (m *NotificationChannelMutation) OldChannelType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatformStreamerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannelType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatformStreamerID requires an ID field in the mutation")
+		return v, errors.New("OldChannelType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatformStreamerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannelType: %w", err)
 	}
-	return oldValue.PlatformStreamerID, nil
+	return oldValue.ChannelType, nil
 }
 
-// ResetPlatformStreamerID resets all changes to the "platform_streamer_id" field.
-func (m *StreamerMutation) ResetPlatformStreamerID() {
-	m.platform_streamer_id = nil
+// ResetChannelType resets all changes to the "channel_type" field.
+func (m *NotificationChannelMutation) ResetChannelType() {
+	m.channel_type = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *StreamerMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetName sets the "name" field.
+func (m *NotificationChannelMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *StreamerMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// Name returns the value of the "name" field in the mutation.
+func (m *NotificationChannelMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *NotificationChannelMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.Name, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *StreamerMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetName resets all changes to the "name" field.
+func (m *NotificationChannelMutation) ResetName() {
+	m.name = nil
 }
 
-// SetAvatarURL sets the "avatar_url" field.
-func (m *StreamerMutation) SetAvatarURL(s string) {
-	m.avatar_url = &s
+// SetConfig sets the "config" field.
+func (m *NotificationChannelMutation) SetConfig(value map[string]interface{}) {
+	m._config = &value
 }
 
-// AvatarURL returns the value of the "avatar_url" field in the mutation.
-func (m *StreamerMutation) AvatarURL() (r string, exists bool) {
-	v := m.avatar_url
+// Config returns the value of the "config" field in the mutation.
+func (m *NotificationChannelMutation) Config() (r map[string]interface{}, exists bool) {
+	v := m._config
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatarURL returns the old "avatar_url" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldConfig returns the old "config" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldAvatarURL(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+		return v, errors.New("OldConfig requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
 	}
-	return oldValue.AvatarURL, nil
+	return oldValue.Config, nil
 }
 
-// ClearAvatarURL clears the value of the "avatar_url" field.
-func (m *StreamerMutation) ClearAvatarURL() {
-	m.avatar_url = nil
-	m.clearedFields[streamer.FieldAvatarURL] = struct{}{}
+// ClearConfig clears the value of the "config" field.
+func (m *NotificationChannelMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[notificationchannel.FieldConfig] = struct{}{}
 }
 
-// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
-func (m *StreamerMutation) AvatarURLCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldAvatarURL]
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *NotificationChannelMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldConfig]
 	return ok
 }
 
-// ResetAvatarURL resets all changes to the "avatar_url" field.
-func (m *StreamerMutation) ResetAvatarURL() {
-	m.avatar_url = nil
-	delete(m.clearedFields, streamer.FieldAvatarURL)
+// ResetConfig resets all changes to the "config" field.
+func (m *NotificationChannelMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, notificationchannel.FieldConfig)
 }
 
-// SetRoomURL sets the "room_url" field.
-func (m *StreamerMutation) SetRoomURL(s string) {
-	m.room_url = &s
+// SetEnable sets the "enable" field.
+func (m *NotificationChannelMutation) SetEnable(b bool) {
+	m.enable = &b
 }
 
-// RoomURL returns the value of the "room_url" field in the mutation.
-func (m *StreamerMutation) RoomURL() (r string, exists bool) {
-	v := m.room_url
+// Enable returns the value of the "enable" field in the mutation.
+func (m *NotificationChannelMutation) Enable() (r bool, exists bool) {
+	v := m.enable
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoomURL returns the old "room_url" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldEnable returns the old "enable" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldRoomURL(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldEnable(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoomURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnable is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoomURL requires an ID field in the mutation")
+		return v, errors.New("OldEnable requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoomURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnable: %w", err)
 	}
-	return oldValue.RoomURL, nil
-}
-
-// ClearRoomURL clears the value of the "room_url" field.
-func (m *StreamerMutation) ClearRoomURL() {
-	m.room_url = nil
-	m.clearedFields[streamer.FieldRoomURL] = struct{}{}
-}
-
-// RoomURLCleared returns if the "room_url" field was cleared in this mutation.
-func (m *StreamerMutation) RoomURLCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldRoomURL]
-	return ok
+	return oldValue.Enable, nil
 }
 
-// ResetRoomURL resets all changes to the "room_url" field.
-func (m *StreamerMutation) ResetRoomURL() {
-	m.room_url = nil
-	delete(m.clearedFields, streamer.FieldRoomURL)
+// ResetEnable resets all changes to the "enable" field.
+func (m *NotificationChannelMutation) ResetEnable() {
+	m.enable = nil
 }
 
-// SetBio sets the "bio" field.
-func (m *StreamerMutation) SetBio(s string) {
-	m.bio = &s
+// SetPriority sets the "priority" field.
+func (m *NotificationChannelMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *StreamerMutation) Bio() (r string, exists bool) {
-	v := m.bio
+// Priority returns the value of the "priority" field in the mutation.
+func (m *NotificationChannelMutation) Priority() (r int, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBio returns the old "bio" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldBio(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldPriority(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.Bio, nil
+	return oldValue.Priority, nil
 }
 
-// ClearBio clears the value of the "bio" field.
-func (m *StreamerMutation) ClearBio() {
-	m.bio = nil
-	m.clearedFields[streamer.FieldBio] = struct{}{}
+// AddPriority adds i to the "priority" field.
+func (m *NotificationChannelMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
 }
 
-// BioCleared returns if the "bio" field was cleared in this mutation.
-func (m *StreamerMutation) BioCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldBio]
-	return ok
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *NotificationChannelMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *StreamerMutation) ResetBio() {
-	m.bio = nil
-	delete(m.clearedFields, streamer.FieldBio)
+// ResetPriority resets all changes to the "priority" field.
+func (m *NotificationChannelMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// SetTags sets the "tags" field.
-func (m *StreamerMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// SetTitleTemplate sets the "title_template" field.
+func (m *NotificationChannelMutation) SetTitleTemplate(s string) {
+	m.title_template = &s
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *StreamerMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// TitleTemplate returns the value of the "title_template" field in the mutation.
+func (m *NotificationChannelMutation) TitleTemplate() (r string, exists bool) {
+	v := m.title_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldTitleTemplate returns the old "title_template" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldTags(ctx context.Context) (v []string, err error) {
+func (m *NotificationChannelMutation) OldTitleTemplate(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitleTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldTitleTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
-	}
-	return oldValue.Tags, nil
-}
-
-// AppendTags adds s to the "tags" field.
-func (m *StreamerMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
-}
-
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *StreamerMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldTitleTemplate: %w", err)
 	}
-	return m.appendtags, true
+	return oldValue.TitleTemplate, nil
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *StreamerMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[streamer.FieldTags] = struct{}{}
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (m *NotificationChannelMutation) ClearTitleTemplate() {
+	m.title_template = nil
+	m.clearedFields[notificationchannel.FieldTitleTemplate] = struct{}{}
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *StreamerMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldTags]
+// TitleTemplateCleared returns if the "title_template" field was cleared in this mutation.
+func (m *NotificationChannelMutation) TitleTemplateCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldTitleTemplate]
 	return ok
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *StreamerMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, streamer.FieldTags)
+// ResetTitleTemplate resets all changes to the "title_template" field.
+func (m *NotificationChannelMutation) ResetTitleTemplate() {
+	m.title_template = nil
+	delete(m.clearedFields, notificationchannel.FieldTitleTemplate)
 }
 
-// SetIsLive sets the "is_live" field.
-func (m *StreamerMutation) SetIsLive(b bool) {
-	m.is_live = &b
+// SetBodyTemplate sets the "body_template" field.
+func (m *NotificationChannelMutation) SetBodyTemplate(s string) {
+	m.body_template = &s
 }
 
-// IsLive returns the value of the "is_live" field in the mutation.
-func (m *StreamerMutation) IsLive() (r bool, exists bool) {
-	v := m.is_live
+// BodyTemplate returns the value of the "body_template" field in the mutation.
+func (m *NotificationChannelMutation) BodyTemplate() (r string, exists bool) {
+	v := m.body_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsLive returns the old "is_live" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldBodyTemplate returns the old "body_template" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldIsLive(ctx context.Context) (v bool, err error) {
+func (m *NotificationChannelMutation) OldBodyTemplate(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsLive is only allowed on UpdateOne operations")
+		return v, errors.New("OldBodyTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsLive requires an ID field in the mutation")
+		return v, errors.New("OldBodyTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsLive: %w", err)
+		return v, fmt.Errorf("querying old value for OldBodyTemplate: %w", err)
 	}
-	return oldValue.IsLive, nil
+	return oldValue.BodyTemplate, nil
 }
 
-// ResetIsLive resets all changes to the "is_live" field.
-func (m *StreamerMutation) ResetIsLive() {
-	m.is_live = nil
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (m *NotificationChannelMutation) ClearBodyTemplate() {
+	m.body_template = nil
+	m.clearedFields[notificationchannel.FieldBodyTemplate] = struct{}{}
 }
 
-// SetLiveTitle sets the "live_title" field.
-func (m *StreamerMutation) SetLiveTitle(s string) {
-	m.live_title = &s
+// BodyTemplateCleared returns if the "body_template" field was cleared in this mutation.
+func (m *NotificationChannelMutation) BodyTemplateCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldBodyTemplate]
+	return ok
 }
 
-// LiveTitle returns the value of the "live_title" field in the mutation.
-func (m *StreamerMutation) LiveTitle() (r string, exists bool) {
-	v := m.live_title
+// ResetBodyTemplate resets all changes to the "body_template" field.
+func (m *NotificationChannelMutation) ResetBodyTemplate() {
+	m.body_template = nil
+	delete(m.clearedFields, notificationchannel.FieldBodyTemplate)
+}
+
+// SetDigestWindowSeconds sets the "digest_window_seconds" field.
+func (m *NotificationChannelMutation) SetDigestWindowSeconds(i int64) {
+	m.digest_window_seconds = &i
+	m.adddigest_window_seconds = nil
+}
+
+// DigestWindowSeconds returns the value of the "digest_window_seconds" field in the mutation.
+func (m *NotificationChannelMutation) DigestWindowSeconds() (r int64, exists bool) {
+	v := m.digest_window_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLiveTitle returns the old "live_title" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldDigestWindowSeconds returns the old "digest_window_seconds" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLiveTitle(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldDigestWindowSeconds(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLiveTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDigestWindowSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLiveTitle requires an ID field in the mutation")
+		return v, errors.New("OldDigestWindowSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLiveTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDigestWindowSeconds: %w", err)
 	}
-	return oldValue.LiveTitle, nil
+	return oldValue.DigestWindowSeconds, nil
 }
 
-// ClearLiveTitle clears the value of the "live_title" field.
-func (m *StreamerMutation) ClearLiveTitle() {
-	m.live_title = nil
-	m.clearedFields[streamer.FieldLiveTitle] = struct{}{}
+// AddDigestWindowSeconds adds i to the "digest_window_seconds" field.
+func (m *NotificationChannelMutation) AddDigestWindowSeconds(i int64) {
+	if m.adddigest_window_seconds != nil {
+		*m.adddigest_window_seconds += i
+	} else {
+		m.adddigest_window_seconds = &i
+	}
 }
 
-// LiveTitleCleared returns if the "live_title" field was cleared in this mutation.
-func (m *StreamerMutation) LiveTitleCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLiveTitle]
-	return ok
+// AddedDigestWindowSeconds returns the value that was added to the "digest_window_seconds" field in this mutation.
+func (m *NotificationChannelMutation) AddedDigestWindowSeconds() (r int64, exists bool) {
+	v := m.adddigest_window_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetLiveTitle resets all changes to the "live_title" field.
-func (m *StreamerMutation) ResetLiveTitle() {
-	m.live_title = nil
-	delete(m.clearedFields, streamer.FieldLiveTitle)
+// ResetDigestWindowSeconds resets all changes to the "digest_window_seconds" field.
+func (m *NotificationChannelMutation) ResetDigestWindowSeconds() {
+	m.digest_window_seconds = nil
+	m.adddigest_window_seconds = nil
 }
 
-// SetLiveGameName sets the "live_game_name" field.
-func (m *StreamerMutation) SetLiveGameName(s string) {
-	m.live_game_name = &s
+// SetDailySummaryAt sets the "daily_summary_at" field.
+func (m *NotificationChannelMutation) SetDailySummaryAt(s string) {
+	m.daily_summary_at = &s
 }
 
-// LiveGameName returns the value of the "live_game_name" field in the mutation.
-func (m *StreamerMutation) LiveGameName() (r string, exists bool) {
-	v := m.live_game_name
+// DailySummaryAt returns the value of the "daily_summary_at" field in the mutation.
+func (m *NotificationChannelMutation) DailySummaryAt() (r string, exists bool) {
+	v := m.daily_summary_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLiveGameName returns the old "live_game_name" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldDailySummaryAt returns the old "daily_summary_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLiveGameName(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldDailySummaryAt(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLiveGameName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailySummaryAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLiveGameName requires an ID field in the mutation")
+		return v, errors.New("OldDailySummaryAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLiveGameName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailySummaryAt: %w", err)
 	}
-	return oldValue.LiveGameName, nil
+	return oldValue.DailySummaryAt, nil
 }
 
-// ClearLiveGameName clears the value of the "live_game_name" field.
-func (m *StreamerMutation) ClearLiveGameName() {
-	m.live_game_name = nil
-	m.clearedFields[streamer.FieldLiveGameName] = struct{}{}
+// ClearDailySummaryAt clears the value of the "daily_summary_at" field.
+func (m *NotificationChannelMutation) ClearDailySummaryAt() {
+	m.daily_summary_at = nil
+	m.clearedFields[notificationchannel.FieldDailySummaryAt] = struct{}{}
 }
 
-// LiveGameNameCleared returns if the "live_game_name" field was cleared in this mutation.
-func (m *StreamerMutation) LiveGameNameCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLiveGameName]
+// DailySummaryAtCleared returns if the "daily_summary_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) DailySummaryAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldDailySummaryAt]
 	return ok
 }
 
-// ResetLiveGameName resets all changes to the "live_game_name" field.
-func (m *StreamerMutation) ResetLiveGameName() {
-	m.live_game_name = nil
-	delete(m.clearedFields, streamer.FieldLiveGameName)
+// ResetDailySummaryAt resets all changes to the "daily_summary_at" field.
+func (m *NotificationChannelMutation) ResetDailySummaryAt() {
+	m.daily_summary_at = nil
+	delete(m.clearedFields, notificationchannel.FieldDailySummaryAt)
 }
 
-// SetLiveStartTime sets the "live_start_time" field.
-func (m *StreamerMutation) SetLiveStartTime(t time.Time) {
-	m.live_start_time = &t
+// SetDailySummaryTimezone sets the "daily_summary_timezone" field.
+func (m *NotificationChannelMutation) SetDailySummaryTimezone(s string) {
+	m.daily_summary_timezone = &s
 }
 
-// LiveStartTime returns the value of the "live_start_time" field in the mutation.
-func (m *StreamerMutation) LiveStartTime() (r time.Time, exists bool) {
-	v := m.live_start_time
+// DailySummaryTimezone returns the value of the "daily_summary_timezone" field in the mutation.
+func (m *NotificationChannelMutation) DailySummaryTimezone() (r string, exists bool) {
+	v := m.daily_summary_timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLiveStartTime returns the old "live_start_time" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldDailySummaryTimezone returns the old "daily_summary_timezone" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLiveStartTime(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationChannelMutation) OldDailySummaryTimezone(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLiveStartTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailySummaryTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLiveStartTime requires an ID field in the mutation")
+		return v, errors.New("OldDailySummaryTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLiveStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailySummaryTimezone: %w", err)
 	}
-	return oldValue.LiveStartTime, nil
+	return oldValue.DailySummaryTimezone, nil
 }
 
-// ClearLiveStartTime clears the value of the "live_start_time" field.
-func (m *StreamerMutation) ClearLiveStartTime() {
-	m.live_start_time = nil
-	m.clearedFields[streamer.FieldLiveStartTime] = struct{}{}
+// ClearDailySummaryTimezone clears the value of the "daily_summary_timezone" field.
+func (m *NotificationChannelMutation) ClearDailySummaryTimezone() {
+	m.daily_summary_timezone = nil
+	m.clearedFields[notificationchannel.FieldDailySummaryTimezone] = struct{}{}
 }
 
-// LiveStartTimeCleared returns if the "live_start_time" field was cleared in this mutation.
-func (m *StreamerMutation) LiveStartTimeCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLiveStartTime]
+// DailySummaryTimezoneCleared returns if the "daily_summary_timezone" field was cleared in this mutation.
+func (m *NotificationChannelMutation) DailySummaryTimezoneCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldDailySummaryTimezone]
 	return ok
 }
 
-// ResetLiveStartTime resets all changes to the "live_start_time" field.
-func (m *StreamerMutation) ResetLiveStartTime() {
-	m.live_start_time = nil
-	delete(m.clearedFields, streamer.FieldLiveStartTime)
+// ResetDailySummaryTimezone resets all changes to the "daily_summary_timezone" field.
+func (m *NotificationChannelMutation) ResetDailySummaryTimezone() {
+	m.daily_summary_timezone = nil
+	delete(m.clearedFields, notificationchannel.FieldDailySummaryTimezone)
 }
 
-// SetLiveViewers sets the "live_viewers" field.
-func (m *StreamerMutation) SetLiveViewers(i int) {
-	m.live_viewers = &i
-	m.addlive_viewers = nil
+// SetDailySummarySentAt sets the "daily_summary_sent_at" field.
+func (m *NotificationChannelMutation) SetDailySummarySentAt(t time.Time) {
+	m.daily_summary_sent_at = &t
 }
 
-// LiveViewers returns the value of the "live_viewers" field in the mutation.
-func (m *StreamerMutation) LiveViewers() (r int, exists bool) {
-	v := m.live_viewers
+// DailySummarySentAt returns the value of the "daily_summary_sent_at" field in the mutation.
+func (m *NotificationChannelMutation) DailySummarySentAt() (r time.Time, exists bool) {
+	v := m.daily_summary_sent_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLiveViewers returns the old "live_viewers" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldDailySummarySentAt returns the old "daily_summary_sent_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLiveViewers(ctx context.Context) (v *int, err error) {
+func (m *NotificationChannelMutation) OldDailySummarySentAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLiveViewers is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailySummarySentAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLiveViewers requires an ID field in the mutation")
+		return v, errors.New("OldDailySummarySentAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLiveViewers: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailySummarySentAt: %w", err)
 	}
-	return oldValue.LiveViewers, nil
+	return oldValue.DailySummarySentAt, nil
 }
 
-// AddLiveViewers adds i to the "live_viewers" field.
-func (m *StreamerMutation) AddLiveViewers(i int) {
-	if m.addlive_viewers != nil {
-		*m.addlive_viewers += i
-	} else {
-		m.addlive_viewers = &i
-	}
+// ClearDailySummarySentAt clears the value of the "daily_summary_sent_at" field.
+func (m *NotificationChannelMutation) ClearDailySummarySentAt() {
+	m.daily_summary_sent_at = nil
+	m.clearedFields[notificationchannel.FieldDailySummarySentAt] = struct{}{}
 }
 
-// AddedLiveViewers returns the value that was added to the "live_viewers" field in this mutation.
-func (m *StreamerMutation) AddedLiveViewers() (r int, exists bool) {
-	v := m.addlive_viewers
+// DailySummarySentAtCleared returns if the "daily_summary_sent_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) DailySummarySentAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldDailySummarySentAt]
+	return ok
+}
+
+// ResetDailySummarySentAt resets all changes to the "daily_summary_sent_at" field.
+func (m *NotificationChannelMutation) ResetDailySummarySentAt() {
+	m.daily_summary_sent_at = nil
+	delete(m.clearedFields, notificationchannel.FieldDailySummarySentAt)
+}
+
+// SetLastTestedAt sets the "last_tested_at" field.
+func (m *NotificationChannelMutation) SetLastTestedAt(t time.Time) {
+	m.last_tested_at = &t
+}
+
+// LastTestedAt returns the value of the "last_tested_at" field in the mutation.
+func (m *NotificationChannelMutation) LastTestedAt() (r time.Time, exists bool) {
+	v := m.last_tested_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearLiveViewers clears the value of the "live_viewers" field.
-func (m *StreamerMutation) ClearLiveViewers() {
-	m.live_viewers = nil
-	m.addlive_viewers = nil
-	m.clearedFields[streamer.FieldLiveViewers] = struct{}{}
+// OldLastTestedAt returns the old "last_tested_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldLastTestedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTestedAt: %w", err)
+	}
+	return oldValue.LastTestedAt, nil
 }
 
-// LiveViewersCleared returns if the "live_viewers" field was cleared in this mutation.
-func (m *StreamerMutation) LiveViewersCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLiveViewers]
+// ClearLastTestedAt clears the value of the "last_tested_at" field.
+func (m *NotificationChannelMutation) ClearLastTestedAt() {
+	m.last_tested_at = nil
+	m.clearedFields[notificationchannel.FieldLastTestedAt] = struct{}{}
+}
+
+// LastTestedAtCleared returns if the "last_tested_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) LastTestedAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldLastTestedAt]
 	return ok
 }
 
-// ResetLiveViewers resets all changes to the "live_viewers" field.
-func (m *StreamerMutation) ResetLiveViewers() {
-	m.live_viewers = nil
-	m.addlive_viewers = nil
-	delete(m.clearedFields, streamer.FieldLiveViewers)
+// ResetLastTestedAt resets all changes to the "last_tested_at" field.
+func (m *NotificationChannelMutation) ResetLastTestedAt() {
+	m.last_tested_at = nil
+	delete(m.clearedFields, notificationchannel.FieldLastTestedAt)
 }
 
-// SetLiveCoverImage sets the "live_cover_image" field.
-func (m *StreamerMutation) SetLiveCoverImage(s string) {
-	m.live_cover_image = &s
+// SetLastTestSuccess sets the "last_test_success" field.
+func (m *NotificationChannelMutation) SetLastTestSuccess(b bool) {
+	m.last_test_success = &b
 }
 
-// LiveCoverImage returns the value of the "live_cover_image" field in the mutation.
-func (m *StreamerMutation) LiveCoverImage() (r string, exists bool) {
-	v := m.live_cover_image
+// LastTestSuccess returns the value of the "last_test_success" field in the mutation.
+func (m *NotificationChannelMutation) LastTestSuccess() (r bool, exists bool) {
+	v := m.last_test_success
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLiveCoverImage returns the old "live_cover_image" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldLastTestSuccess returns the old "last_test_success" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLiveCoverImage(ctx context.Context) (v *string, err error) {
+func (m *NotificationChannelMutation) OldLastTestSuccess(ctx context.Context) (v *bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLiveCoverImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastTestSuccess is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLiveCoverImage requires an ID field in the mutation")
+		return v, errors.New("OldLastTestSuccess requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLiveCoverImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastTestSuccess: %w", err)
 	}
-	return oldValue.LiveCoverImage, nil
+	return oldValue.LastTestSuccess, nil
 }
 
-// ClearLiveCoverImage clears the value of the "live_cover_image" field.
-func (m *StreamerMutation) ClearLiveCoverImage() {
-	m.live_cover_image = nil
-	m.clearedFields[streamer.FieldLiveCoverImage] = struct{}{}
+// ClearLastTestSuccess clears the value of the "last_test_success" field.
+func (m *NotificationChannelMutation) ClearLastTestSuccess() {
+	m.last_test_success = nil
+	m.clearedFields[notificationchannel.FieldLastTestSuccess] = struct{}{}
 }
 
-// LiveCoverImageCleared returns if the "live_cover_image" field was cleared in this mutation.
-func (m *StreamerMutation) LiveCoverImageCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLiveCoverImage]
+// LastTestSuccessCleared returns if the "last_test_success" field was cleared in this mutation.
+func (m *NotificationChannelMutation) LastTestSuccessCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldLastTestSuccess]
 	return ok
 }
 
-// ResetLiveCoverImage resets all changes to the "live_cover_image" field.
-func (m *StreamerMutation) ResetLiveCoverImage() {
-	m.live_cover_image = nil
-	delete(m.clearedFields, streamer.FieldLiveCoverImage)
+// ResetLastTestSuccess resets all changes to the "last_test_success" field.
+func (m *NotificationChannelMutation) ResetLastTestSuccess() {
+	m.last_test_success = nil
+	delete(m.clearedFields, notificationchannel.FieldLastTestSuccess)
 }
 
-// SetLastLiveSyncedAt sets the "last_live_synced_at" field.
-func (m *StreamerMutation) SetLastLiveSyncedAt(t time.Time) {
-	m.last_live_synced_at = &t
+// SetLastTestError sets the "last_test_error" field.
+func (m *NotificationChannelMutation) SetLastTestError(s string) {
+	m.last_test_error = &s
 }
 
-// LastLiveSyncedAt returns the value of the "last_live_synced_at" field in the mutation.
-func (m *StreamerMutation) LastLiveSyncedAt() (r time.Time, exists bool) {
-	v := m.last_live_synced_at
+// LastTestError returns the value of the "last_test_error" field in the mutation.
+func (m *NotificationChannelMutation) LastTestError() (r string, exists bool) {
+	v := m.last_test_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastLiveSyncedAt returns the old "last_live_synced_at" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldLastTestError returns the old "last_test_error" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLastLiveSyncedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationChannelMutation) OldLastTestError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLiveSyncedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastTestError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLiveSyncedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastTestError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLiveSyncedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastTestError: %w", err)
 	}
-	return oldValue.LastLiveSyncedAt, nil
+	return oldValue.LastTestError, nil
 }
 
-// ClearLastLiveSyncedAt clears the value of the "last_live_synced_at" field.
-func (m *StreamerMutation) ClearLastLiveSyncedAt() {
-	m.last_live_synced_at = nil
-	m.clearedFields[streamer.FieldLastLiveSyncedAt] = struct{}{}
+// ClearLastTestError clears the value of the "last_test_error" field.
+func (m *NotificationChannelMutation) ClearLastTestError() {
+	m.last_test_error = nil
+	m.clearedFields[notificationchannel.FieldLastTestError] = struct{}{}
 }
 
-// LastLiveSyncedAtCleared returns if the "last_live_synced_at" field was cleared in this mutation.
-func (m *StreamerMutation) LastLiveSyncedAtCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLastLiveSyncedAt]
+// LastTestErrorCleared returns if the "last_test_error" field was cleared in this mutation.
+func (m *NotificationChannelMutation) LastTestErrorCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldLastTestError]
 	return ok
 }
 
-// ResetLastLiveSyncedAt resets all changes to the "last_live_synced_at" field.
-func (m *StreamerMutation) ResetLastLiveSyncedAt() {
-	m.last_live_synced_at = nil
-	delete(m.clearedFields, streamer.FieldLastLiveSyncedAt)
+// ResetLastTestError resets all changes to the "last_test_error" field.
+func (m *NotificationChannelMutation) ResetLastTestError() {
+	m.last_test_error = nil
+	delete(m.clearedFields, notificationchannel.FieldLastTestError)
 }
 
-// SetLastSyncedAt sets the "last_synced_at" field.
-func (m *StreamerMutation) SetLastSyncedAt(t time.Time) {
-	m.last_synced_at = &t
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (m *NotificationChannelMutation) SetVerificationCodeHash(s string) {
+	m.verification_code_hash = &s
 }
 
-// LastSyncedAt returns the value of the "last_synced_at" field in the mutation.
-func (m *StreamerMutation) LastSyncedAt() (r time.Time, exists bool) {
-	v := m.last_synced_at
+// VerificationCodeHash returns the value of the "verification_code_hash" field in the mutation.
+func (m *NotificationChannelMutation) VerificationCodeHash() (r string, exists bool) {
+	v := m.verification_code_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSyncedAt returns the old "last_synced_at" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldVerificationCodeHash returns the old "verification_code_hash" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldLastSyncedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationChannelMutation) OldVerificationCodeHash(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSyncedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerificationCodeHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSyncedAt requires an ID field in the mutation")
+		return v, errors.New("OldVerificationCodeHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSyncedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerificationCodeHash: %w", err)
 	}
-	return oldValue.LastSyncedAt, nil
+	return oldValue.VerificationCodeHash, nil
 }
 
-// ClearLastSyncedAt clears the value of the "last_synced_at" field.
-func (m *StreamerMutation) ClearLastSyncedAt() {
-	m.last_synced_at = nil
-	m.clearedFields[streamer.FieldLastSyncedAt] = struct{}{}
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (m *NotificationChannelMutation) ClearVerificationCodeHash() {
+	m.verification_code_hash = nil
+	m.clearedFields[notificationchannel.FieldVerificationCodeHash] = struct{}{}
 }
 
-// LastSyncedAtCleared returns if the "last_synced_at" field was cleared in this mutation.
-func (m *StreamerMutation) LastSyncedAtCleared() bool {
-	_, ok := m.clearedFields[streamer.FieldLastSyncedAt]
+// VerificationCodeHashCleared returns if the "verification_code_hash" field was cleared in this mutation.
+func (m *NotificationChannelMutation) VerificationCodeHashCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldVerificationCodeHash]
 	return ok
 }
 
-// ResetLastSyncedAt resets all changes to the "last_synced_at" field.
-func (m *StreamerMutation) ResetLastSyncedAt() {
-	m.last_synced_at = nil
-	delete(m.clearedFields, streamer.FieldLastSyncedAt)
+// ResetVerificationCodeHash resets all changes to the "verification_code_hash" field.
+func (m *NotificationChannelMutation) ResetVerificationCodeHash() {
+	m.verification_code_hash = nil
+	delete(m.clearedFields, notificationchannel.FieldVerificationCodeHash)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StreamerMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (m *NotificationChannelMutation) SetVerificationExpiresAt(t time.Time) {
+	m.verification_expires_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StreamerMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// VerificationExpiresAt returns the value of the "verification_expires_at" field in the mutation.
+func (m *NotificationChannelMutation) VerificationExpiresAt() (r time.Time, exists bool) {
+	v := m.verification_expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldVerificationExpiresAt returns the old "verification_expires_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotificationChannelMutation) OldVerificationExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerificationExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldVerificationExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerificationExpiresAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.VerificationExpiresAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StreamerMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (m *NotificationChannelMutation) ClearVerificationExpiresAt() {
+	m.verification_expires_at = nil
+	m.clearedFields[notificationchannel.FieldVerificationExpiresAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StreamerMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// VerificationExpiresAtCleared returns if the "verification_expires_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) VerificationExpiresAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldVerificationExpiresAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StreamerMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetVerificationExpiresAt resets all changes to the "verification_expires_at" field.
+func (m *NotificationChannelMutation) ResetVerificationExpiresAt() {
+	m.verification_expires_at = nil
+	delete(m.clearedFields, notificationchannel.FieldVerificationExpiresAt)
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (m *NotificationChannelMutation) SetVerificationAttempts(i int) {
+	m.verification_attempts = &i
+	m.addverification_attempts = nil
+}
+
+// VerificationAttempts returns the value of the "verification_attempts" field in the mutation.
+func (m *NotificationChannelMutation) VerificationAttempts() (r int, exists bool) {
+	v := m.verification_attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Streamer entity.
-// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// OldVerificationAttempts returns the old "verification_attempts" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotificationChannelMutation) OldVerificationAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVerificationAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldVerificationAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerificationAttempts: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.VerificationAttempts, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StreamerMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddVerificationAttempts adds i to the "verification_attempts" field.
+func (m *NotificationChannelMutation) AddVerificationAttempts(i int) {
+	if m.addverification_attempts != nil {
+		*m.addverification_attempts += i
+	} else {
+		m.addverification_attempts = &i
+	}
 }
 
-// AddFollowerIDs adds the "followers" edge to the UserFollowedStreamer entity by ids.
-func (m *StreamerMutation) AddFollowerIDs(ids ...int64) {
-	if m.followers == nil {
-		m.followers = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.followers[ids[i]] = struct{}{}
+// AddedVerificationAttempts returns the value that was added to the "verification_attempts" field in this mutation.
+func (m *NotificationChannelMutation) AddedVerificationAttempts() (r int, exists bool) {
+	v := m.addverification_attempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearFollowers clears the "followers" edge to the UserFollowedStreamer entity.
-func (m *StreamerMutation) ClearFollowers() {
-	m.clearedfollowers = true
+// ResetVerificationAttempts resets all changes to the "verification_attempts" field.
+func (m *NotificationChannelMutation) ResetVerificationAttempts() {
+	m.verification_attempts = nil
+	m.addverification_attempts = nil
 }
 
-// FollowersCleared reports if the "followers" edge to the UserFollowedStreamer entity was cleared.
-func (m *StreamerMutation) FollowersCleared() bool {
-	return m.clearedfollowers
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (m *NotificationChannelMutation) SetVerificationSentAt(t time.Time) {
+	m.verification_sent_at = &t
 }
 
-// RemoveFollowerIDs removes the "followers" edge to the UserFollowedStreamer entity by IDs.
-func (m *StreamerMutation) RemoveFollowerIDs(ids ...int64) {
-	if m.removedfollowers == nil {
-		m.removedfollowers = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.followers, ids[i])
-		m.removedfollowers[ids[i]] = struct{}{}
+// VerificationSentAt returns the value of the "verification_sent_at" field in the mutation.
+func (m *NotificationChannelMutation) VerificationSentAt() (r time.Time, exists bool) {
+	v := m.verification_sent_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFollowers returns the removed IDs of the "followers" edge to the UserFollowedStreamer entity.
-func (m *StreamerMutation) RemovedFollowersIDs() (ids []int64) {
-	for id := range m.removedfollowers {
-		ids = append(ids, id)
+// OldVerificationSentAt returns the old "verification_sent_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldVerificationSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationSentAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationSentAt: %w", err)
+	}
+	return oldValue.VerificationSentAt, nil
 }
 
-// FollowersIDs returns the "followers" edge IDs in the mutation.
-func (m *StreamerMutation) FollowersIDs() (ids []int64) {
-	for id := range m.followers {
-		ids = append(ids, id)
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (m *NotificationChannelMutation) ClearVerificationSentAt() {
+	m.verification_sent_at = nil
+	m.clearedFields[notificationchannel.FieldVerificationSentAt] = struct{}{}
+}
+
+// VerificationSentAtCleared returns if the "verification_sent_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) VerificationSentAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldVerificationSentAt]
+	return ok
+}
+
+// ResetVerificationSentAt resets all changes to the "verification_sent_at" field.
+func (m *NotificationChannelMutation) ResetVerificationSentAt() {
+	m.verification_sent_at = nil
+	delete(m.clearedFields, notificationchannel.FieldVerificationSentAt)
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *NotificationChannelMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *NotificationChannelMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetFollowers resets all changes to the "followers" edge.
-func (m *StreamerMutation) ResetFollowers() {
-	m.followers = nil
-	m.clearedfollowers = false
-	m.removedfollowers = nil
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
 }
 
-// Where appends a list predicates to the StreamerMutation builder.
-func (m *StreamerMutation) Where(ps ...predicate.Streamer) {
-	m.predicates = append(m.predicates, ps...)
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *NotificationChannelMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
 }
 
-// WhereP appends storage-level predicates to the StreamerMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StreamerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Streamer, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *NotificationChannelMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *StreamerMutation) Op() Op {
-	return m.op
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *NotificationChannelMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *StreamerMutation) SetOp(op Op) {
-	m.op = op
+// SetLastError sets the "last_error" field.
+func (m *NotificationChannelMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// Type returns the node type of this mutation (Streamer).
-func (m *StreamerMutation) Type() string {
-	return m.typ
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *NotificationChannelMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
+// OldLastError returns the old "last_error" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *NotificationChannelMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[notificationchannel.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *NotificationChannelMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *NotificationChannelMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, notificationchannel.FieldLastError)
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (m *NotificationChannelMutation) SetLastFailureAt(t time.Time) {
+	m.last_failure_at = &t
+}
+
+// LastFailureAt returns the value of the "last_failure_at" field in the mutation.
+func (m *NotificationChannelMutation) LastFailureAt() (r time.Time, exists bool) {
+	v := m.last_failure_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFailureAt returns the old "last_failure_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldLastFailureAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailureAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailureAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailureAt: %w", err)
+	}
+	return oldValue.LastFailureAt, nil
+}
+
+// ClearLastFailureAt clears the value of the "last_failure_at" field.
+func (m *NotificationChannelMutation) ClearLastFailureAt() {
+	m.last_failure_at = nil
+	m.clearedFields[notificationchannel.FieldLastFailureAt] = struct{}{}
+}
+
+// LastFailureAtCleared returns if the "last_failure_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) LastFailureAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldLastFailureAt]
+	return ok
+}
+
+// ResetLastFailureAt resets all changes to the "last_failure_at" field.
+func (m *NotificationChannelMutation) ResetLastFailureAt() {
+	m.last_failure_at = nil
+	delete(m.clearedFields, notificationchannel.FieldLastFailureAt)
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (m *NotificationChannelMutation) SetLastSuccessAt(t time.Time) {
+	m.last_success_at = &t
+}
+
+// LastSuccessAt returns the value of the "last_success_at" field in the mutation.
+func (m *NotificationChannelMutation) LastSuccessAt() (r time.Time, exists bool) {
+	v := m.last_success_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSuccessAt returns the old "last_success_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldLastSuccessAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSuccessAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSuccessAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSuccessAt: %w", err)
+	}
+	return oldValue.LastSuccessAt, nil
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (m *NotificationChannelMutation) ClearLastSuccessAt() {
+	m.last_success_at = nil
+	m.clearedFields[notificationchannel.FieldLastSuccessAt] = struct{}{}
+}
+
+// LastSuccessAtCleared returns if the "last_success_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) LastSuccessAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldLastSuccessAt]
+	return ok
+}
+
+// ResetLastSuccessAt resets all changes to the "last_success_at" field.
+func (m *NotificationChannelMutation) ResetLastSuccessAt() {
+	m.last_success_at = nil
+	delete(m.clearedFields, notificationchannel.FieldLastSuccessAt)
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (m *NotificationChannelMutation) SetDisabledAt(t time.Time) {
+	m.disabled_at = &t
+}
+
+// DisabledAt returns the value of the "disabled_at" field in the mutation.
+func (m *NotificationChannelMutation) DisabledAt() (r time.Time, exists bool) {
+	v := m.disabled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisabledAt returns the old "disabled_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldDisabledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabledAt: %w", err)
+	}
+	return oldValue.DisabledAt, nil
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (m *NotificationChannelMutation) ClearDisabledAt() {
+	m.disabled_at = nil
+	m.clearedFields[notificationchannel.FieldDisabledAt] = struct{}{}
+}
+
+// DisabledAtCleared returns if the "disabled_at" field was cleared in this mutation.
+func (m *NotificationChannelMutation) DisabledAtCleared() bool {
+	_, ok := m.clearedFields[notificationchannel.FieldDisabledAt]
+	return ok
+}
+
+// ResetDisabledAt resets all changes to the "disabled_at" field.
+func (m *NotificationChannelMutation) ResetDisabledAt() {
+	m.disabled_at = nil
+	delete(m.clearedFields, notificationchannel.FieldDisabledAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationChannelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationChannelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationChannelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationChannelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationChannelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationChannel entity.
+// If the NotificationChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationChannelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationChannelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationChannelMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[notificationchannel.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationChannelMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationChannelMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationChannelMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the NotificationChannelMutation builder.
+func (m *NotificationChannelMutation) Where(ps ...predicate.NotificationChannel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationChannelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationChannelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationChannel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationChannelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationChannelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationChannel).
+func (m *NotificationChannelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StreamerMutation) Fields() []string {
-	fields := make([]string, 0, 17)
-	if m.platform_type != nil {
-		fields = append(fields, streamer.FieldPlatformType)
+func (m *NotificationChannelMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.user != nil {
+		fields = append(fields, notificationchannel.FieldUserID)
+	}
+	if m.channel_type != nil {
+		fields = append(fields, notificationchannel.FieldChannelType)
+	}
+	if m.name != nil {
+		fields = append(fields, notificationchannel.FieldName)
+	}
+	if m._config != nil {
+		fields = append(fields, notificationchannel.FieldConfig)
+	}
+	if m.enable != nil {
+		fields = append(fields, notificationchannel.FieldEnable)
+	}
+	if m.priority != nil {
+		fields = append(fields, notificationchannel.FieldPriority)
+	}
+	if m.title_template != nil {
+		fields = append(fields, notificationchannel.FieldTitleTemplate)
+	}
+	if m.body_template != nil {
+		fields = append(fields, notificationchannel.FieldBodyTemplate)
+	}
+	if m.digest_window_seconds != nil {
+		fields = append(fields, notificationchannel.FieldDigestWindowSeconds)
+	}
+	if m.daily_summary_at != nil {
+		fields = append(fields, notificationchannel.FieldDailySummaryAt)
+	}
+	if m.daily_summary_timezone != nil {
+		fields = append(fields, notificationchannel.FieldDailySummaryTimezone)
+	}
+	if m.daily_summary_sent_at != nil {
+		fields = append(fields, notificationchannel.FieldDailySummarySentAt)
+	}
+	if m.last_tested_at != nil {
+		fields = append(fields, notificationchannel.FieldLastTestedAt)
+	}
+	if m.last_test_success != nil {
+		fields = append(fields, notificationchannel.FieldLastTestSuccess)
+	}
+	if m.last_test_error != nil {
+		fields = append(fields, notificationchannel.FieldLastTestError)
+	}
+	if m.verification_code_hash != nil {
+		fields = append(fields, notificationchannel.FieldVerificationCodeHash)
+	}
+	if m.verification_expires_at != nil {
+		fields = append(fields, notificationchannel.FieldVerificationExpiresAt)
+	}
+	if m.verification_attempts != nil {
+		fields = append(fields, notificationchannel.FieldVerificationAttempts)
+	}
+	if m.verification_sent_at != nil {
+		fields = append(fields, notificationchannel.FieldVerificationSentAt)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, notificationchannel.FieldConsecutiveFailures)
+	}
+	if m.last_error != nil {
+		fields = append(fields, notificationchannel.FieldLastError)
+	}
+	if m.last_failure_at != nil {
+		fields = append(fields, notificationchannel.FieldLastFailureAt)
+	}
+	if m.last_success_at != nil {
+		fields = append(fields, notificationchannel.FieldLastSuccessAt)
+	}
+	if m.disabled_at != nil {
+		fields = append(fields, notificationchannel.FieldDisabledAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notificationchannel.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationchannel.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationChannelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationchannel.FieldUserID:
+		return m.UserID()
+	case notificationchannel.FieldChannelType:
+		return m.ChannelType()
+	case notificationchannel.FieldName:
+		return m.Name()
+	case notificationchannel.FieldConfig:
+		return m.Config()
+	case notificationchannel.FieldEnable:
+		return m.Enable()
+	case notificationchannel.FieldPriority:
+		return m.Priority()
+	case notificationchannel.FieldTitleTemplate:
+		return m.TitleTemplate()
+	case notificationchannel.FieldBodyTemplate:
+		return m.BodyTemplate()
+	case notificationchannel.FieldDigestWindowSeconds:
+		return m.DigestWindowSeconds()
+	case notificationchannel.FieldDailySummaryAt:
+		return m.DailySummaryAt()
+	case notificationchannel.FieldDailySummaryTimezone:
+		return m.DailySummaryTimezone()
+	case notificationchannel.FieldDailySummarySentAt:
+		return m.DailySummarySentAt()
+	case notificationchannel.FieldLastTestedAt:
+		return m.LastTestedAt()
+	case notificationchannel.FieldLastTestSuccess:
+		return m.LastTestSuccess()
+	case notificationchannel.FieldLastTestError:
+		return m.LastTestError()
+	case notificationchannel.FieldVerificationCodeHash:
+		return m.VerificationCodeHash()
+	case notificationchannel.FieldVerificationExpiresAt:
+		return m.VerificationExpiresAt()
+	case notificationchannel.FieldVerificationAttempts:
+		return m.VerificationAttempts()
+	case notificationchannel.FieldVerificationSentAt:
+		return m.VerificationSentAt()
+	case notificationchannel.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case notificationchannel.FieldLastError:
+		return m.LastError()
+	case notificationchannel.FieldLastFailureAt:
+		return m.LastFailureAt()
+	case notificationchannel.FieldLastSuccessAt:
+		return m.LastSuccessAt()
+	case notificationchannel.FieldDisabledAt:
+		return m.DisabledAt()
+	case notificationchannel.FieldCreatedAt:
+		return m.CreatedAt()
+	case notificationchannel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationchannel.FieldUserID:
+		return m.OldUserID(ctx)
+	case notificationchannel.FieldChannelType:
+		return m.OldChannelType(ctx)
+	case notificationchannel.FieldName:
+		return m.OldName(ctx)
+	case notificationchannel.FieldConfig:
+		return m.OldConfig(ctx)
+	case notificationchannel.FieldEnable:
+		return m.OldEnable(ctx)
+	case notificationchannel.FieldPriority:
+		return m.OldPriority(ctx)
+	case notificationchannel.FieldTitleTemplate:
+		return m.OldTitleTemplate(ctx)
+	case notificationchannel.FieldBodyTemplate:
+		return m.OldBodyTemplate(ctx)
+	case notificationchannel.FieldDigestWindowSeconds:
+		return m.OldDigestWindowSeconds(ctx)
+	case notificationchannel.FieldDailySummaryAt:
+		return m.OldDailySummaryAt(ctx)
+	case notificationchannel.FieldDailySummaryTimezone:
+		return m.OldDailySummaryTimezone(ctx)
+	case notificationchannel.FieldDailySummarySentAt:
+		return m.OldDailySummarySentAt(ctx)
+	case notificationchannel.FieldLastTestedAt:
+		return m.OldLastTestedAt(ctx)
+	case notificationchannel.FieldLastTestSuccess:
+		return m.OldLastTestSuccess(ctx)
+	case notificationchannel.FieldLastTestError:
+		return m.OldLastTestError(ctx)
+	case notificationchannel.FieldVerificationCodeHash:
+		return m.OldVerificationCodeHash(ctx)
+	case notificationchannel.FieldVerificationExpiresAt:
+		return m.OldVerificationExpiresAt(ctx)
+	case notificationchannel.FieldVerificationAttempts:
+		return m.OldVerificationAttempts(ctx)
+	case notificationchannel.FieldVerificationSentAt:
+		return m.OldVerificationSentAt(ctx)
+	case notificationchannel.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case notificationchannel.FieldLastError:
+		return m.OldLastError(ctx)
+	case notificationchannel.FieldLastFailureAt:
+		return m.OldLastFailureAt(ctx)
+	case notificationchannel.FieldLastSuccessAt:
+		return m.OldLastSuccessAt(ctx)
+	case notificationchannel.FieldDisabledAt:
+		return m.OldDisabledAt(ctx)
+	case notificationchannel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationchannel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationChannel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationChannelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationchannel.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case notificationchannel.FieldChannelType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelType(v)
+		return nil
+	case notificationchannel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case notificationchannel.FieldConfig:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case notificationchannel.FieldEnable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnable(v)
+		return nil
+	case notificationchannel.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case notificationchannel.FieldTitleTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleTemplate(v)
+		return nil
+	case notificationchannel.FieldBodyTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyTemplate(v)
+		return nil
+	case notificationchannel.FieldDigestWindowSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigestWindowSeconds(v)
+		return nil
+	case notificationchannel.FieldDailySummaryAt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailySummaryAt(v)
+		return nil
+	case notificationchannel.FieldDailySummaryTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailySummaryTimezone(v)
+		return nil
+	case notificationchannel.FieldDailySummarySentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailySummarySentAt(v)
+		return nil
+	case notificationchannel.FieldLastTestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTestedAt(v)
+		return nil
+	case notificationchannel.FieldLastTestSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTestSuccess(v)
+		return nil
+	case notificationchannel.FieldLastTestError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTestError(v)
+		return nil
+	case notificationchannel.FieldVerificationCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationCodeHash(v)
+		return nil
+	case notificationchannel.FieldVerificationExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationExpiresAt(v)
+		return nil
+	case notificationchannel.FieldVerificationAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationAttempts(v)
+		return nil
+	case notificationchannel.FieldVerificationSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationSentAt(v)
+		return nil
+	case notificationchannel.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case notificationchannel.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case notificationchannel.FieldLastFailureAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailureAt(v)
+		return nil
+	case notificationchannel.FieldLastSuccessAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSuccessAt(v)
+		return nil
+	case notificationchannel.FieldDisabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabledAt(v)
+		return nil
+	case notificationchannel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notificationchannel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationChannelMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, notificationchannel.FieldPriority)
+	}
+	if m.adddigest_window_seconds != nil {
+		fields = append(fields, notificationchannel.FieldDigestWindowSeconds)
+	}
+	if m.addverification_attempts != nil {
+		fields = append(fields, notificationchannel.FieldVerificationAttempts)
+	}
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, notificationchannel.FieldConsecutiveFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationChannelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationchannel.FieldPriority:
+		return m.AddedPriority()
+	case notificationchannel.FieldDigestWindowSeconds:
+		return m.AddedDigestWindowSeconds()
+	case notificationchannel.FieldVerificationAttempts:
+		return m.AddedVerificationAttempts()
+	case notificationchannel.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationChannelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationchannel.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case notificationchannel.FieldDigestWindowSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDigestWindowSeconds(v)
+		return nil
+	case notificationchannel.FieldVerificationAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVerificationAttempts(v)
+		return nil
+	case notificationchannel.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationChannelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationchannel.FieldConfig) {
+		fields = append(fields, notificationchannel.FieldConfig)
+	}
+	if m.FieldCleared(notificationchannel.FieldTitleTemplate) {
+		fields = append(fields, notificationchannel.FieldTitleTemplate)
+	}
+	if m.FieldCleared(notificationchannel.FieldBodyTemplate) {
+		fields = append(fields, notificationchannel.FieldBodyTemplate)
+	}
+	if m.FieldCleared(notificationchannel.FieldDailySummaryAt) {
+		fields = append(fields, notificationchannel.FieldDailySummaryAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldDailySummaryTimezone) {
+		fields = append(fields, notificationchannel.FieldDailySummaryTimezone)
+	}
+	if m.FieldCleared(notificationchannel.FieldDailySummarySentAt) {
+		fields = append(fields, notificationchannel.FieldDailySummarySentAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldLastTestedAt) {
+		fields = append(fields, notificationchannel.FieldLastTestedAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldLastTestSuccess) {
+		fields = append(fields, notificationchannel.FieldLastTestSuccess)
+	}
+	if m.FieldCleared(notificationchannel.FieldLastTestError) {
+		fields = append(fields, notificationchannel.FieldLastTestError)
+	}
+	if m.FieldCleared(notificationchannel.FieldVerificationCodeHash) {
+		fields = append(fields, notificationchannel.FieldVerificationCodeHash)
+	}
+	if m.FieldCleared(notificationchannel.FieldVerificationExpiresAt) {
+		fields = append(fields, notificationchannel.FieldVerificationExpiresAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldVerificationSentAt) {
+		fields = append(fields, notificationchannel.FieldVerificationSentAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldLastError) {
+		fields = append(fields, notificationchannel.FieldLastError)
+	}
+	if m.FieldCleared(notificationchannel.FieldLastFailureAt) {
+		fields = append(fields, notificationchannel.FieldLastFailureAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldLastSuccessAt) {
+		fields = append(fields, notificationchannel.FieldLastSuccessAt)
+	}
+	if m.FieldCleared(notificationchannel.FieldDisabledAt) {
+		fields = append(fields, notificationchannel.FieldDisabledAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationChannelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationChannelMutation) ClearField(name string) error {
+	switch name {
+	case notificationchannel.FieldConfig:
+		m.ClearConfig()
+		return nil
+	case notificationchannel.FieldTitleTemplate:
+		m.ClearTitleTemplate()
+		return nil
+	case notificationchannel.FieldBodyTemplate:
+		m.ClearBodyTemplate()
+		return nil
+	case notificationchannel.FieldDailySummaryAt:
+		m.ClearDailySummaryAt()
+		return nil
+	case notificationchannel.FieldDailySummaryTimezone:
+		m.ClearDailySummaryTimezone()
+		return nil
+	case notificationchannel.FieldDailySummarySentAt:
+		m.ClearDailySummarySentAt()
+		return nil
+	case notificationchannel.FieldLastTestedAt:
+		m.ClearLastTestedAt()
+		return nil
+	case notificationchannel.FieldLastTestSuccess:
+		m.ClearLastTestSuccess()
+		return nil
+	case notificationchannel.FieldLastTestError:
+		m.ClearLastTestError()
+		return nil
+	case notificationchannel.FieldVerificationCodeHash:
+		m.ClearVerificationCodeHash()
+		return nil
+	case notificationchannel.FieldVerificationExpiresAt:
+		m.ClearVerificationExpiresAt()
+		return nil
+	case notificationchannel.FieldVerificationSentAt:
+		m.ClearVerificationSentAt()
+		return nil
+	case notificationchannel.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case notificationchannel.FieldLastFailureAt:
+		m.ClearLastFailureAt()
+		return nil
+	case notificationchannel.FieldLastSuccessAt:
+		m.ClearLastSuccessAt()
+		return nil
+	case notificationchannel.FieldDisabledAt:
+		m.ClearDisabledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationChannelMutation) ResetField(name string) error {
+	switch name {
+	case notificationchannel.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case notificationchannel.FieldChannelType:
+		m.ResetChannelType()
+		return nil
+	case notificationchannel.FieldName:
+		m.ResetName()
+		return nil
+	case notificationchannel.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case notificationchannel.FieldEnable:
+		m.ResetEnable()
+		return nil
+	case notificationchannel.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case notificationchannel.FieldTitleTemplate:
+		m.ResetTitleTemplate()
+		return nil
+	case notificationchannel.FieldBodyTemplate:
+		m.ResetBodyTemplate()
+		return nil
+	case notificationchannel.FieldDigestWindowSeconds:
+		m.ResetDigestWindowSeconds()
+		return nil
+	case notificationchannel.FieldDailySummaryAt:
+		m.ResetDailySummaryAt()
+		return nil
+	case notificationchannel.FieldDailySummaryTimezone:
+		m.ResetDailySummaryTimezone()
+		return nil
+	case notificationchannel.FieldDailySummarySentAt:
+		m.ResetDailySummarySentAt()
+		return nil
+	case notificationchannel.FieldLastTestedAt:
+		m.ResetLastTestedAt()
+		return nil
+	case notificationchannel.FieldLastTestSuccess:
+		m.ResetLastTestSuccess()
+		return nil
+	case notificationchannel.FieldLastTestError:
+		m.ResetLastTestError()
+		return nil
+	case notificationchannel.FieldVerificationCodeHash:
+		m.ResetVerificationCodeHash()
+		return nil
+	case notificationchannel.FieldVerificationExpiresAt:
+		m.ResetVerificationExpiresAt()
+		return nil
+	case notificationchannel.FieldVerificationAttempts:
+		m.ResetVerificationAttempts()
+		return nil
+	case notificationchannel.FieldVerificationSentAt:
+		m.ResetVerificationSentAt()
+		return nil
+	case notificationchannel.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case notificationchannel.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case notificationchannel.FieldLastFailureAt:
+		m.ResetLastFailureAt()
+		return nil
+	case notificationchannel.FieldLastSuccessAt:
+		m.ResetLastSuccessAt()
+		return nil
+	case notificationchannel.FieldDisabledAt:
+		m.ResetDisabledAt()
+		return nil
+	case notificationchannel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationchannel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationChannelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, notificationchannel.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationChannelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationchannel.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationChannelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationChannelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationChannelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, notificationchannel.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationChannelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationchannel.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationChannelMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationchannel.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationChannelMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationchannel.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationChannel edge %s", name)
+}
+
+// NotificationDeliveryMutation represents an operation that mutates the NotificationDelivery nodes in the graph.
+type NotificationDeliveryMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int64
+	follow_id                 *int64
+	addfollow_id              *int64
+	channel_id                *int64
+	addchannel_id             *int64
+	channel_type              *string
+	streamer_id               *int64
+	addstreamer_id            *int64
+	event_type                *string
+	payload                   *map[string]interface{}
+	response                  *map[string]interface{}
+	status                    *string
+	attempts                  *int
+	addattempts               *int
+	latency_ms                *int64
+	addlatency_ms             *int64
+	error                     *string
+	next_attempt_at           *time.Time
+	locked_until              *time.Time
+	delivered_at              *time.Time
+	route_id                  *string
+	route_mode                *string
+	route_step                *int
+	addroute_step             *int
+	escalate_after_seconds    *int64
+	addescalate_after_seconds *int64
+	digest_id                 *int64
+	adddigest_id              *int64
+	acknowledged_at           *time.Time
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	user                      *int64
+	cleareduser               bool
+	done                      bool
+	oldValue                  func(context.Context) (*NotificationDelivery, error)
+	predicates                []predicate.NotificationDelivery
+}
+
+var _ ent.Mutation = (*NotificationDeliveryMutation)(nil)
+
+// notificationdeliveryOption allows management of the mutation configuration using functional options.
+type notificationdeliveryOption func(*NotificationDeliveryMutation)
+
+// newNotificationDeliveryMutation creates new mutation for the NotificationDelivery entity.
+func newNotificationDeliveryMutation(c config, op Op, opts ...notificationdeliveryOption) *NotificationDeliveryMutation {
+	m := &NotificationDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationDeliveryID sets the ID field of the mutation.
+func withNotificationDeliveryID(id int64) notificationdeliveryOption {
+	return func(m *NotificationDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationDelivery sets the old NotificationDelivery of the mutation.
+func withNotificationDelivery(node *NotificationDelivery) notificationdeliveryOption {
+	return func(m *NotificationDeliveryMutation) {
+		m.oldValue = func(context.Context) (*NotificationDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationDelivery entities.
+func (m *NotificationDeliveryMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationDeliveryMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationDeliveryMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *NotificationDeliveryMutation) SetUserID(i int64) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationDeliveryMutation) UserID() (r int64, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationDeliveryMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetFollowID sets the "follow_id" field.
+func (m *NotificationDeliveryMutation) SetFollowID(i int64) {
+	m.follow_id = &i
+	m.addfollow_id = nil
+}
+
+// FollowID returns the value of the "follow_id" field in the mutation.
+func (m *NotificationDeliveryMutation) FollowID() (r int64, exists bool) {
+	v := m.follow_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFollowID returns the old "follow_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldFollowID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowID: %w", err)
+	}
+	return oldValue.FollowID, nil
+}
+
+// AddFollowID adds i to the "follow_id" field.
+func (m *NotificationDeliveryMutation) AddFollowID(i int64) {
+	if m.addfollow_id != nil {
+		*m.addfollow_id += i
+	} else {
+		m.addfollow_id = &i
+	}
+}
+
+// AddedFollowID returns the value that was added to the "follow_id" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedFollowID() (r int64, exists bool) {
+	v := m.addfollow_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFollowID clears the value of the "follow_id" field.
+func (m *NotificationDeliveryMutation) ClearFollowID() {
+	m.follow_id = nil
+	m.addfollow_id = nil
+	m.clearedFields[notificationdelivery.FieldFollowID] = struct{}{}
+}
+
+// FollowIDCleared returns if the "follow_id" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) FollowIDCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldFollowID]
+	return ok
+}
+
+// ResetFollowID resets all changes to the "follow_id" field.
+func (m *NotificationDeliveryMutation) ResetFollowID() {
+	m.follow_id = nil
+	m.addfollow_id = nil
+	delete(m.clearedFields, notificationdelivery.FieldFollowID)
+}
+
+// SetChannelID sets the "channel_id" field.
+func (m *NotificationDeliveryMutation) SetChannelID(i int64) {
+	m.channel_id = &i
+	m.addchannel_id = nil
+}
+
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *NotificationDeliveryMutation) ChannelID() (r int64, exists bool) {
+	v := m.channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelID returns the old "channel_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldChannelID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
+}
+
+// AddChannelID adds i to the "channel_id" field.
+func (m *NotificationDeliveryMutation) AddChannelID(i int64) {
+	if m.addchannel_id != nil {
+		*m.addchannel_id += i
+	} else {
+		m.addchannel_id = &i
+	}
+}
+
+// AddedChannelID returns the value that was added to the "channel_id" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedChannelID() (r int64, exists bool) {
+	v := m.addchannel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *NotificationDeliveryMutation) ResetChannelID() {
+	m.channel_id = nil
+	m.addchannel_id = nil
+}
+
+// SetChannelType sets the "channel_type" field.
+func (m *NotificationDeliveryMutation) SetChannelType(s string) {
+	m.channel_type = &s
+}
+
+// ChannelType returns the value of the "channel_type" field in the mutation.
+func (m *NotificationDeliveryMutation) ChannelType() (r string, exists bool) {
+	v := m.channel_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelType returns the old "channel_type" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldChannelType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelType: %w", err)
+	}
+	return oldValue.ChannelType, nil
+}
+
+// ResetChannelType resets all changes to the "channel_type" field.
+func (m *NotificationDeliveryMutation) ResetChannelType() {
+	m.channel_type = nil
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (m *NotificationDeliveryMutation) SetStreamerID(i int64) {
+	m.streamer_id = &i
+	m.addstreamer_id = nil
+}
+
+// StreamerID returns the value of the "streamer_id" field in the mutation.
+func (m *NotificationDeliveryMutation) StreamerID() (r int64, exists bool) {
+	v := m.streamer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStreamerID returns the old "streamer_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldStreamerID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStreamerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStreamerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStreamerID: %w", err)
+	}
+	return oldValue.StreamerID, nil
+}
+
+// AddStreamerID adds i to the "streamer_id" field.
+func (m *NotificationDeliveryMutation) AddStreamerID(i int64) {
+	if m.addstreamer_id != nil {
+		*m.addstreamer_id += i
+	} else {
+		m.addstreamer_id = &i
+	}
+}
+
+// AddedStreamerID returns the value that was added to the "streamer_id" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedStreamerID() (r int64, exists bool) {
+	v := m.addstreamer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStreamerID clears the value of the "streamer_id" field.
+func (m *NotificationDeliveryMutation) ClearStreamerID() {
+	m.streamer_id = nil
+	m.addstreamer_id = nil
+	m.clearedFields[notificationdelivery.FieldStreamerID] = struct{}{}
+}
+
+// StreamerIDCleared returns if the "streamer_id" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) StreamerIDCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldStreamerID]
+	return ok
+}
+
+// ResetStreamerID resets all changes to the "streamer_id" field.
+func (m *NotificationDeliveryMutation) ResetStreamerID() {
+	m.streamer_id = nil
+	m.addstreamer_id = nil
+	delete(m.clearedFields, notificationdelivery.FieldStreamerID)
+}
+
+// SetEventType sets the "event_type" field.
+func (m *NotificationDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *NotificationDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *NotificationDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *NotificationDeliveryMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *NotificationDeliveryMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *NotificationDeliveryMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[notificationdelivery.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *NotificationDeliveryMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, notificationdelivery.FieldPayload)
+}
+
+// SetResponse sets the "response" field.
+func (m *NotificationDeliveryMutation) SetResponse(value map[string]interface{}) {
+	m.response = &value
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *NotificationDeliveryMutation) Response() (r map[string]interface{}, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldResponse(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *NotificationDeliveryMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[notificationdelivery.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *NotificationDeliveryMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, notificationdelivery.FieldResponse)
+}
+
+// SetStatus sets the "status" field.
+func (m *NotificationDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NotificationDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *NotificationDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *NotificationDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *NotificationDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *NotificationDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *NotificationDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *NotificationDeliveryMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *NotificationDeliveryMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *NotificationDeliveryMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *NotificationDeliveryMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetError sets the "error" field.
+func (m *NotificationDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *NotificationDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *NotificationDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[notificationdelivery.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *NotificationDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, notificationdelivery.FieldError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *NotificationDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *NotificationDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *NotificationDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[notificationdelivery.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *NotificationDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, notificationdelivery.FieldNextAttemptAt)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *NotificationDeliveryMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *NotificationDeliveryMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *NotificationDeliveryMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[notificationdelivery.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *NotificationDeliveryMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, notificationdelivery.FieldLockedUntil)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *NotificationDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *NotificationDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *NotificationDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[notificationdelivery.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *NotificationDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, notificationdelivery.FieldDeliveredAt)
+}
+
+// SetRouteID sets the "route_id" field.
+func (m *NotificationDeliveryMutation) SetRouteID(s string) {
+	m.route_id = &s
+}
+
+// RouteID returns the value of the "route_id" field in the mutation.
+func (m *NotificationDeliveryMutation) RouteID() (r string, exists bool) {
+	v := m.route_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRouteID returns the old "route_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldRouteID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRouteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRouteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRouteID: %w", err)
+	}
+	return oldValue.RouteID, nil
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (m *NotificationDeliveryMutation) ClearRouteID() {
+	m.route_id = nil
+	m.clearedFields[notificationdelivery.FieldRouteID] = struct{}{}
+}
+
+// RouteIDCleared returns if the "route_id" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) RouteIDCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldRouteID]
+	return ok
+}
+
+// ResetRouteID resets all changes to the "route_id" field.
+func (m *NotificationDeliveryMutation) ResetRouteID() {
+	m.route_id = nil
+	delete(m.clearedFields, notificationdelivery.FieldRouteID)
+}
+
+// SetRouteMode sets the "route_mode" field.
+func (m *NotificationDeliveryMutation) SetRouteMode(s string) {
+	m.route_mode = &s
+}
+
+// RouteMode returns the value of the "route_mode" field in the mutation.
+func (m *NotificationDeliveryMutation) RouteMode() (r string, exists bool) {
+	v := m.route_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRouteMode returns the old "route_mode" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldRouteMode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRouteMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRouteMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRouteMode: %w", err)
+	}
+	return oldValue.RouteMode, nil
+}
+
+// ClearRouteMode clears the value of the "route_mode" field.
+func (m *NotificationDeliveryMutation) ClearRouteMode() {
+	m.route_mode = nil
+	m.clearedFields[notificationdelivery.FieldRouteMode] = struct{}{}
+}
+
+// RouteModeCleared returns if the "route_mode" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) RouteModeCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldRouteMode]
+	return ok
+}
+
+// ResetRouteMode resets all changes to the "route_mode" field.
+func (m *NotificationDeliveryMutation) ResetRouteMode() {
+	m.route_mode = nil
+	delete(m.clearedFields, notificationdelivery.FieldRouteMode)
+}
+
+// SetRouteStep sets the "route_step" field.
+func (m *NotificationDeliveryMutation) SetRouteStep(i int) {
+	m.route_step = &i
+	m.addroute_step = nil
+}
+
+// RouteStep returns the value of the "route_step" field in the mutation.
+func (m *NotificationDeliveryMutation) RouteStep() (r int, exists bool) {
+	v := m.route_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRouteStep returns the old "route_step" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldRouteStep(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRouteStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRouteStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRouteStep: %w", err)
+	}
+	return oldValue.RouteStep, nil
+}
+
+// AddRouteStep adds i to the "route_step" field.
+func (m *NotificationDeliveryMutation) AddRouteStep(i int) {
+	if m.addroute_step != nil {
+		*m.addroute_step += i
+	} else {
+		m.addroute_step = &i
+	}
+}
+
+// AddedRouteStep returns the value that was added to the "route_step" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedRouteStep() (r int, exists bool) {
+	v := m.addroute_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRouteStep resets all changes to the "route_step" field.
+func (m *NotificationDeliveryMutation) ResetRouteStep() {
+	m.route_step = nil
+	m.addroute_step = nil
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (m *NotificationDeliveryMutation) SetEscalateAfterSeconds(i int64) {
+	m.escalate_after_seconds = &i
+	m.addescalate_after_seconds = nil
+}
+
+// EscalateAfterSeconds returns the value of the "escalate_after_seconds" field in the mutation.
+func (m *NotificationDeliveryMutation) EscalateAfterSeconds() (r int64, exists bool) {
+	v := m.escalate_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalateAfterSeconds returns the old "escalate_after_seconds" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldEscalateAfterSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalateAfterSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalateAfterSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalateAfterSeconds: %w", err)
+	}
+	return oldValue.EscalateAfterSeconds, nil
+}
+
+// AddEscalateAfterSeconds adds i to the "escalate_after_seconds" field.
+func (m *NotificationDeliveryMutation) AddEscalateAfterSeconds(i int64) {
+	if m.addescalate_after_seconds != nil {
+		*m.addescalate_after_seconds += i
+	} else {
+		m.addescalate_after_seconds = &i
+	}
+}
+
+// AddedEscalateAfterSeconds returns the value that was added to the "escalate_after_seconds" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedEscalateAfterSeconds() (r int64, exists bool) {
+	v := m.addescalate_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEscalateAfterSeconds resets all changes to the "escalate_after_seconds" field.
+func (m *NotificationDeliveryMutation) ResetEscalateAfterSeconds() {
+	m.escalate_after_seconds = nil
+	m.addescalate_after_seconds = nil
+}
+
+// SetDigestID sets the "digest_id" field.
+func (m *NotificationDeliveryMutation) SetDigestID(i int64) {
+	m.digest_id = &i
+	m.adddigest_id = nil
+}
+
+// DigestID returns the value of the "digest_id" field in the mutation.
+func (m *NotificationDeliveryMutation) DigestID() (r int64, exists bool) {
+	v := m.digest_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigestID returns the old "digest_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldDigestID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigestID: %w", err)
+	}
+	return oldValue.DigestID, nil
+}
+
+// AddDigestID adds i to the "digest_id" field.
+func (m *NotificationDeliveryMutation) AddDigestID(i int64) {
+	if m.adddigest_id != nil {
+		*m.adddigest_id += i
+	} else {
+		m.adddigest_id = &i
+	}
+}
+
+// AddedDigestID returns the value that was added to the "digest_id" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedDigestID() (r int64, exists bool) {
+	v := m.adddigest_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDigestID clears the value of the "digest_id" field.
+func (m *NotificationDeliveryMutation) ClearDigestID() {
+	m.digest_id = nil
+	m.adddigest_id = nil
+	m.clearedFields[notificationdelivery.FieldDigestID] = struct{}{}
+}
+
+// DigestIDCleared returns if the "digest_id" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) DigestIDCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldDigestID]
+	return ok
+}
+
+// ResetDigestID resets all changes to the "digest_id" field.
+func (m *NotificationDeliveryMutation) ResetDigestID() {
+	m.digest_id = nil
+	m.adddigest_id = nil
+	delete(m.clearedFields, notificationdelivery.FieldDigestID)
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (m *NotificationDeliveryMutation) SetAcknowledgedAt(t time.Time) {
+	m.acknowledged_at = &t
+}
+
+// AcknowledgedAt returns the value of the "acknowledged_at" field in the mutation.
+func (m *NotificationDeliveryMutation) AcknowledgedAt() (r time.Time, exists bool) {
+	v := m.acknowledged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledgedAt returns the old "acknowledged_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldAcknowledgedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedAt: %w", err)
+	}
+	return oldValue.AcknowledgedAt, nil
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (m *NotificationDeliveryMutation) ClearAcknowledgedAt() {
+	m.acknowledged_at = nil
+	m.clearedFields[notificationdelivery.FieldAcknowledgedAt] = struct{}{}
+}
+
+// AcknowledgedAtCleared returns if the "acknowledged_at" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) AcknowledgedAtCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldAcknowledgedAt]
+	return ok
+}
+
+// ResetAcknowledgedAt resets all changes to the "acknowledged_at" field.
+func (m *NotificationDeliveryMutation) ResetAcknowledgedAt() {
+	m.acknowledged_at = nil
+	delete(m.clearedFields, notificationdelivery.FieldAcknowledgedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationDeliveryMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[notificationdelivery.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationDeliveryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationDeliveryMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationDeliveryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the NotificationDeliveryMutation builder.
+func (m *NotificationDeliveryMutation) Where(ps ...predicate.NotificationDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationDelivery).
+func (m *NotificationDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.user != nil {
+		fields = append(fields, notificationdelivery.FieldUserID)
+	}
+	if m.follow_id != nil {
+		fields = append(fields, notificationdelivery.FieldFollowID)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, notificationdelivery.FieldChannelID)
+	}
+	if m.channel_type != nil {
+		fields = append(fields, notificationdelivery.FieldChannelType)
+	}
+	if m.streamer_id != nil {
+		fields = append(fields, notificationdelivery.FieldStreamerID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, notificationdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, notificationdelivery.FieldPayload)
+	}
+	if m.response != nil {
+		fields = append(fields, notificationdelivery.FieldResponse)
+	}
+	if m.status != nil {
+		fields = append(fields, notificationdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, notificationdelivery.FieldAttempts)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, notificationdelivery.FieldLatencyMs)
+	}
+	if m.error != nil {
+		fields = append(fields, notificationdelivery.FieldError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, notificationdelivery.FieldNextAttemptAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, notificationdelivery.FieldLockedUntil)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, notificationdelivery.FieldDeliveredAt)
+	}
+	if m.route_id != nil {
+		fields = append(fields, notificationdelivery.FieldRouteID)
+	}
+	if m.route_mode != nil {
+		fields = append(fields, notificationdelivery.FieldRouteMode)
+	}
+	if m.route_step != nil {
+		fields = append(fields, notificationdelivery.FieldRouteStep)
+	}
+	if m.escalate_after_seconds != nil {
+		fields = append(fields, notificationdelivery.FieldEscalateAfterSeconds)
+	}
+	if m.digest_id != nil {
+		fields = append(fields, notificationdelivery.FieldDigestID)
+	}
+	if m.acknowledged_at != nil {
+		fields = append(fields, notificationdelivery.FieldAcknowledgedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notificationdelivery.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationdelivery.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationdelivery.FieldUserID:
+		return m.UserID()
+	case notificationdelivery.FieldFollowID:
+		return m.FollowID()
+	case notificationdelivery.FieldChannelID:
+		return m.ChannelID()
+	case notificationdelivery.FieldChannelType:
+		return m.ChannelType()
+	case notificationdelivery.FieldStreamerID:
+		return m.StreamerID()
+	case notificationdelivery.FieldEventType:
+		return m.EventType()
+	case notificationdelivery.FieldPayload:
+		return m.Payload()
+	case notificationdelivery.FieldResponse:
+		return m.Response()
+	case notificationdelivery.FieldStatus:
+		return m.Status()
+	case notificationdelivery.FieldAttempts:
+		return m.Attempts()
+	case notificationdelivery.FieldLatencyMs:
+		return m.LatencyMs()
+	case notificationdelivery.FieldError:
+		return m.Error()
+	case notificationdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case notificationdelivery.FieldLockedUntil:
+		return m.LockedUntil()
+	case notificationdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case notificationdelivery.FieldRouteID:
+		return m.RouteID()
+	case notificationdelivery.FieldRouteMode:
+		return m.RouteMode()
+	case notificationdelivery.FieldRouteStep:
+		return m.RouteStep()
+	case notificationdelivery.FieldEscalateAfterSeconds:
+		return m.EscalateAfterSeconds()
+	case notificationdelivery.FieldDigestID:
+		return m.DigestID()
+	case notificationdelivery.FieldAcknowledgedAt:
+		return m.AcknowledgedAt()
+	case notificationdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case notificationdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationdelivery.FieldUserID:
+		return m.OldUserID(ctx)
+	case notificationdelivery.FieldFollowID:
+		return m.OldFollowID(ctx)
+	case notificationdelivery.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case notificationdelivery.FieldChannelType:
+		return m.OldChannelType(ctx)
+	case notificationdelivery.FieldStreamerID:
+		return m.OldStreamerID(ctx)
+	case notificationdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case notificationdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case notificationdelivery.FieldResponse:
+		return m.OldResponse(ctx)
+	case notificationdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case notificationdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case notificationdelivery.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case notificationdelivery.FieldError:
+		return m.OldError(ctx)
+	case notificationdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case notificationdelivery.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case notificationdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case notificationdelivery.FieldRouteID:
+		return m.OldRouteID(ctx)
+	case notificationdelivery.FieldRouteMode:
+		return m.OldRouteMode(ctx)
+	case notificationdelivery.FieldRouteStep:
+		return m.OldRouteStep(ctx)
+	case notificationdelivery.FieldEscalateAfterSeconds:
+		return m.OldEscalateAfterSeconds(ctx)
+	case notificationdelivery.FieldDigestID:
+		return m.OldDigestID(ctx)
+	case notificationdelivery.FieldAcknowledgedAt:
+		return m.OldAcknowledgedAt(ctx)
+	case notificationdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationdelivery.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case notificationdelivery.FieldFollowID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowID(v)
+		return nil
+	case notificationdelivery.FieldChannelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case notificationdelivery.FieldChannelType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelType(v)
+		return nil
+	case notificationdelivery.FieldStreamerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreamerID(v)
+		return nil
+	case notificationdelivery.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case notificationdelivery.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case notificationdelivery.FieldResponse:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case notificationdelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case notificationdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case notificationdelivery.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case notificationdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case notificationdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case notificationdelivery.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case notificationdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case notificationdelivery.FieldRouteID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRouteID(v)
+		return nil
+	case notificationdelivery.FieldRouteMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRouteMode(v)
+		return nil
+	case notificationdelivery.FieldRouteStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRouteStep(v)
+		return nil
+	case notificationdelivery.FieldEscalateAfterSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalateAfterSeconds(v)
+		return nil
+	case notificationdelivery.FieldDigestID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigestID(v)
+		return nil
+	case notificationdelivery.FieldAcknowledgedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedAt(v)
+		return nil
+	case notificationdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notificationdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addfollow_id != nil {
+		fields = append(fields, notificationdelivery.FieldFollowID)
+	}
+	if m.addchannel_id != nil {
+		fields = append(fields, notificationdelivery.FieldChannelID)
+	}
+	if m.addstreamer_id != nil {
+		fields = append(fields, notificationdelivery.FieldStreamerID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, notificationdelivery.FieldAttempts)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, notificationdelivery.FieldLatencyMs)
+	}
+	if m.addroute_step != nil {
+		fields = append(fields, notificationdelivery.FieldRouteStep)
+	}
+	if m.addescalate_after_seconds != nil {
+		fields = append(fields, notificationdelivery.FieldEscalateAfterSeconds)
+	}
+	if m.adddigest_id != nil {
+		fields = append(fields, notificationdelivery.FieldDigestID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationdelivery.FieldFollowID:
+		return m.AddedFollowID()
+	case notificationdelivery.FieldChannelID:
+		return m.AddedChannelID()
+	case notificationdelivery.FieldStreamerID:
+		return m.AddedStreamerID()
+	case notificationdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case notificationdelivery.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case notificationdelivery.FieldRouteStep:
+		return m.AddedRouteStep()
+	case notificationdelivery.FieldEscalateAfterSeconds:
+		return m.AddedEscalateAfterSeconds()
+	case notificationdelivery.FieldDigestID:
+		return m.AddedDigestID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationdelivery.FieldFollowID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFollowID(v)
+		return nil
+	case notificationdelivery.FieldChannelID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChannelID(v)
+		return nil
+	case notificationdelivery.FieldStreamerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStreamerID(v)
+		return nil
+	case notificationdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case notificationdelivery.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	case notificationdelivery.FieldRouteStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRouteStep(v)
+		return nil
+	case notificationdelivery.FieldEscalateAfterSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalateAfterSeconds(v)
+		return nil
+	case notificationdelivery.FieldDigestID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDigestID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationdelivery.FieldFollowID) {
+		fields = append(fields, notificationdelivery.FieldFollowID)
+	}
+	if m.FieldCleared(notificationdelivery.FieldStreamerID) {
+		fields = append(fields, notificationdelivery.FieldStreamerID)
+	}
+	if m.FieldCleared(notificationdelivery.FieldPayload) {
+		fields = append(fields, notificationdelivery.FieldPayload)
+	}
+	if m.FieldCleared(notificationdelivery.FieldResponse) {
+		fields = append(fields, notificationdelivery.FieldResponse)
+	}
+	if m.FieldCleared(notificationdelivery.FieldError) {
+		fields = append(fields, notificationdelivery.FieldError)
+	}
+	if m.FieldCleared(notificationdelivery.FieldNextAttemptAt) {
+		fields = append(fields, notificationdelivery.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(notificationdelivery.FieldLockedUntil) {
+		fields = append(fields, notificationdelivery.FieldLockedUntil)
+	}
+	if m.FieldCleared(notificationdelivery.FieldDeliveredAt) {
+		fields = append(fields, notificationdelivery.FieldDeliveredAt)
+	}
+	if m.FieldCleared(notificationdelivery.FieldRouteID) {
+		fields = append(fields, notificationdelivery.FieldRouteID)
+	}
+	if m.FieldCleared(notificationdelivery.FieldRouteMode) {
+		fields = append(fields, notificationdelivery.FieldRouteMode)
+	}
+	if m.FieldCleared(notificationdelivery.FieldDigestID) {
+		fields = append(fields, notificationdelivery.FieldDigestID)
+	}
+	if m.FieldCleared(notificationdelivery.FieldAcknowledgedAt) {
+		fields = append(fields, notificationdelivery.FieldAcknowledgedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case notificationdelivery.FieldFollowID:
+		m.ClearFollowID()
+		return nil
+	case notificationdelivery.FieldStreamerID:
+		m.ClearStreamerID()
+		return nil
+	case notificationdelivery.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case notificationdelivery.FieldResponse:
+		m.ClearResponse()
+		return nil
+	case notificationdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case notificationdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case notificationdelivery.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case notificationdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	case notificationdelivery.FieldRouteID:
+		m.ClearRouteID()
+		return nil
+	case notificationdelivery.FieldRouteMode:
+		m.ClearRouteMode()
+		return nil
+	case notificationdelivery.FieldDigestID:
+		m.ClearDigestID()
+		return nil
+	case notificationdelivery.FieldAcknowledgedAt:
+		m.ClearAcknowledgedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case notificationdelivery.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case notificationdelivery.FieldFollowID:
+		m.ResetFollowID()
+		return nil
+	case notificationdelivery.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case notificationdelivery.FieldChannelType:
+		m.ResetChannelType()
+		return nil
+	case notificationdelivery.FieldStreamerID:
+		m.ResetStreamerID()
+		return nil
+	case notificationdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case notificationdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case notificationdelivery.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case notificationdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case notificationdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case notificationdelivery.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case notificationdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case notificationdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case notificationdelivery.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case notificationdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case notificationdelivery.FieldRouteID:
+		m.ResetRouteID()
+		return nil
+	case notificationdelivery.FieldRouteMode:
+		m.ResetRouteMode()
+		return nil
+	case notificationdelivery.FieldRouteStep:
+		m.ResetRouteStep()
+		return nil
+	case notificationdelivery.FieldEscalateAfterSeconds:
+		m.ResetEscalateAfterSeconds()
+		return nil
+	case notificationdelivery.FieldDigestID:
+		m.ResetDigestID()
+		return nil
+	case notificationdelivery.FieldAcknowledgedAt:
+		m.ResetAcknowledgedAt()
+		return nil
+	case notificationdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, notificationdelivery.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationdelivery.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, notificationdelivery.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationdelivery.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationdelivery.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationdelivery.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery edge %s", name)
+}
+
+// NotificationPreferenceMutation represents an operation that mutates the NotificationPreference nodes in the graph.
+type NotificationPreferenceMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int64
+	routing_mode              *string
+	escalate_after_seconds    *int64
+	addescalate_after_seconds *int64
+	quiet_hours               *map[string]interface{}
+	do_not_disturb_until      *time.Time
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	user                      *int64
+	cleareduser               bool
+	done                      bool
+	oldValue                  func(context.Context) (*NotificationPreference, error)
+	predicates                []predicate.NotificationPreference
+}
+
+var _ ent.Mutation = (*NotificationPreferenceMutation)(nil)
+
+// notificationpreferenceOption allows management of the mutation configuration using functional options.
+type notificationpreferenceOption func(*NotificationPreferenceMutation)
+
+// newNotificationPreferenceMutation creates new mutation for the NotificationPreference entity.
+func newNotificationPreferenceMutation(c config, op Op, opts ...notificationpreferenceOption) *NotificationPreferenceMutation {
+	m := &NotificationPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationPreferenceID sets the ID field of the mutation.
+func withNotificationPreferenceID(id int64) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationPreference
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationPreference sets the old NotificationPreference of the mutation.
+func withNotificationPreference(node *NotificationPreference) notificationpreferenceOption {
+	return func(m *NotificationPreferenceMutation) {
+		m.oldValue = func(context.Context) (*NotificationPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationPreference entities.
+func (m *NotificationPreferenceMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationPreferenceMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationPreferenceMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *NotificationPreferenceMutation) SetUserID(i int64) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationPreferenceMutation) UserID() (r int64, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationPreferenceMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRoutingMode sets the "routing_mode" field.
+func (m *NotificationPreferenceMutation) SetRoutingMode(s string) {
+	m.routing_mode = &s
+}
+
+// RoutingMode returns the value of the "routing_mode" field in the mutation.
+func (m *NotificationPreferenceMutation) RoutingMode() (r string, exists bool) {
+	v := m.routing_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoutingMode returns the old "routing_mode" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldRoutingMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutingMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutingMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutingMode: %w", err)
+	}
+	return oldValue.RoutingMode, nil
+}
+
+// ResetRoutingMode resets all changes to the "routing_mode" field.
+func (m *NotificationPreferenceMutation) ResetRoutingMode() {
+	m.routing_mode = nil
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (m *NotificationPreferenceMutation) SetEscalateAfterSeconds(i int64) {
+	m.escalate_after_seconds = &i
+	m.addescalate_after_seconds = nil
+}
+
+// EscalateAfterSeconds returns the value of the "escalate_after_seconds" field in the mutation.
+func (m *NotificationPreferenceMutation) EscalateAfterSeconds() (r int64, exists bool) {
+	v := m.escalate_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEscalateAfterSeconds returns the old "escalate_after_seconds" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldEscalateAfterSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalateAfterSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalateAfterSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalateAfterSeconds: %w", err)
+	}
+	return oldValue.EscalateAfterSeconds, nil
+}
+
+// AddEscalateAfterSeconds adds i to the "escalate_after_seconds" field.
+func (m *NotificationPreferenceMutation) AddEscalateAfterSeconds(i int64) {
+	if m.addescalate_after_seconds != nil {
+		*m.addescalate_after_seconds += i
+	} else {
+		m.addescalate_after_seconds = &i
+	}
+}
+
+// AddedEscalateAfterSeconds returns the value that was added to the "escalate_after_seconds" field in this mutation.
+func (m *NotificationPreferenceMutation) AddedEscalateAfterSeconds() (r int64, exists bool) {
+	v := m.addescalate_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEscalateAfterSeconds resets all changes to the "escalate_after_seconds" field.
+func (m *NotificationPreferenceMutation) ResetEscalateAfterSeconds() {
+	m.escalate_after_seconds = nil
+	m.addescalate_after_seconds = nil
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (m *NotificationPreferenceMutation) SetQuietHours(value map[string]interface{}) {
+	m.quiet_hours = &value
+}
+
+// QuietHours returns the value of the "quiet_hours" field in the mutation.
+func (m *NotificationPreferenceMutation) QuietHours() (r map[string]interface{}, exists bool) {
+	v := m.quiet_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietHours returns the old "quiet_hours" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldQuietHours(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHours: %w", err)
+	}
+	return oldValue.QuietHours, nil
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (m *NotificationPreferenceMutation) ClearQuietHours() {
+	m.quiet_hours = nil
+	m.clearedFields[notificationpreference.FieldQuietHours] = struct{}{}
+}
+
+// QuietHoursCleared returns if the "quiet_hours" field was cleared in this mutation.
+func (m *NotificationPreferenceMutation) QuietHoursCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldQuietHours]
+	return ok
+}
+
+// ResetQuietHours resets all changes to the "quiet_hours" field.
+func (m *NotificationPreferenceMutation) ResetQuietHours() {
+	m.quiet_hours = nil
+	delete(m.clearedFields, notificationpreference.FieldQuietHours)
+}
+
+// SetDoNotDisturbUntil sets the "do_not_disturb_until" field.
+func (m *NotificationPreferenceMutation) SetDoNotDisturbUntil(t time.Time) {
+	m.do_not_disturb_until = &t
+}
+
+// DoNotDisturbUntil returns the value of the "do_not_disturb_until" field in the mutation.
+func (m *NotificationPreferenceMutation) DoNotDisturbUntil() (r time.Time, exists bool) {
+	v := m.do_not_disturb_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoNotDisturbUntil returns the old "do_not_disturb_until" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldDoNotDisturbUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoNotDisturbUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoNotDisturbUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoNotDisturbUntil: %w", err)
+	}
+	return oldValue.DoNotDisturbUntil, nil
+}
+
+// ClearDoNotDisturbUntil clears the value of the "do_not_disturb_until" field.
+func (m *NotificationPreferenceMutation) ClearDoNotDisturbUntil() {
+	m.do_not_disturb_until = nil
+	m.clearedFields[notificationpreference.FieldDoNotDisturbUntil] = struct{}{}
+}
+
+// DoNotDisturbUntilCleared returns if the "do_not_disturb_until" field was cleared in this mutation.
+func (m *NotificationPreferenceMutation) DoNotDisturbUntilCleared() bool {
+	_, ok := m.clearedFields[notificationpreference.FieldDoNotDisturbUntil]
+	return ok
+}
+
+// ResetDoNotDisturbUntil resets all changes to the "do_not_disturb_until" field.
+func (m *NotificationPreferenceMutation) ResetDoNotDisturbUntil() {
+	m.do_not_disturb_until = nil
+	delete(m.clearedFields, notificationpreference.FieldDoNotDisturbUntil)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationPreferenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationPreferenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationPreferenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationPreferenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationPreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationPreference entity.
+// If the NotificationPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationPreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationPreferenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationPreferenceMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[notificationpreference.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationPreferenceMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationPreferenceMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationPreferenceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the NotificationPreferenceMutation builder.
+func (m *NotificationPreferenceMutation) Where(ps ...predicate.NotificationPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationPreference).
+func (m *NotificationPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user != nil {
+		fields = append(fields, notificationpreference.FieldUserID)
+	}
+	if m.routing_mode != nil {
+		fields = append(fields, notificationpreference.FieldRoutingMode)
+	}
+	if m.escalate_after_seconds != nil {
+		fields = append(fields, notificationpreference.FieldEscalateAfterSeconds)
+	}
+	if m.quiet_hours != nil {
+		fields = append(fields, notificationpreference.FieldQuietHours)
+	}
+	if m.do_not_disturb_until != nil {
+		fields = append(fields, notificationpreference.FieldDoNotDisturbUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notificationpreference.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationpreference.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldUserID:
+		return m.UserID()
+	case notificationpreference.FieldRoutingMode:
+		return m.RoutingMode()
+	case notificationpreference.FieldEscalateAfterSeconds:
+		return m.EscalateAfterSeconds()
+	case notificationpreference.FieldQuietHours:
+		return m.QuietHours()
+	case notificationpreference.FieldDoNotDisturbUntil:
+		return m.DoNotDisturbUntil()
+	case notificationpreference.FieldCreatedAt:
+		return m.CreatedAt()
+	case notificationpreference.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationpreference.FieldUserID:
+		return m.OldUserID(ctx)
+	case notificationpreference.FieldRoutingMode:
+		return m.OldRoutingMode(ctx)
+	case notificationpreference.FieldEscalateAfterSeconds:
+		return m.OldEscalateAfterSeconds(ctx)
+	case notificationpreference.FieldQuietHours:
+		return m.OldQuietHours(ctx)
+	case notificationpreference.FieldDoNotDisturbUntil:
+		return m.OldDoNotDisturbUntil(ctx)
+	case notificationpreference.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationpreference.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case notificationpreference.FieldRoutingMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingMode(v)
+		return nil
+	case notificationpreference.FieldEscalateAfterSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEscalateAfterSeconds(v)
+		return nil
+	case notificationpreference.FieldQuietHours:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHours(v)
+		return nil
+	case notificationpreference.FieldDoNotDisturbUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoNotDisturbUntil(v)
+		return nil
+	case notificationpreference.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notificationpreference.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationPreferenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addescalate_after_seconds != nil {
+		fields = append(fields, notificationpreference.FieldEscalateAfterSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationpreference.FieldEscalateAfterSeconds:
+		return m.AddedEscalateAfterSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationpreference.FieldEscalateAfterSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalateAfterSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationPreferenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationpreference.FieldQuietHours) {
+		fields = append(fields, notificationpreference.FieldQuietHours)
+	}
+	if m.FieldCleared(notificationpreference.FieldDoNotDisturbUntil) {
+		fields = append(fields, notificationpreference.FieldDoNotDisturbUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearField(name string) error {
+	switch name {
+	case notificationpreference.FieldQuietHours:
+		m.ClearQuietHours()
+		return nil
+	case notificationpreference.FieldDoNotDisturbUntil:
+		m.ClearDoNotDisturbUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case notificationpreference.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case notificationpreference.FieldRoutingMode:
+		m.ResetRoutingMode()
+		return nil
+	case notificationpreference.FieldEscalateAfterSeconds:
+		m.ResetEscalateAfterSeconds()
+		return nil
+	case notificationpreference.FieldQuietHours:
+		m.ResetQuietHours()
+		return nil
+	case notificationpreference.FieldDoNotDisturbUntil:
+		m.ResetDoNotDisturbUntil()
+		return nil
+	case notificationpreference.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationpreference.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, notificationpreference.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationPreferenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationpreference.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, notificationpreference.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationPreferenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationpreference.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationpreference.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationPreferenceMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationpreference.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationPreference edge %s", name)
+}
+
+// StreamSessionMutation represents an operation that mutates the StreamSession nodes in the graph.
+type StreamSessionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int64
+	title               *string
+	game_name           *string
+	titles              *[]string
+	appendtitles        []string
+	categories          *[]string
+	appendcategories    []string
+	announced_title     *string
+	announced_game_name *string
+	changed_at          *time.Time
+	peak_viewers        *int
+	addpeak_viewers     *int
+	started_at          *time.Time
+	last_seen_at        *time.Time
+	ended_at            *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	streamer            *int64
+	clearedstreamer     bool
+	done                bool
+	oldValue            func(context.Context) (*StreamSession, error)
+	predicates          []predicate.StreamSession
+}
+
+var _ ent.Mutation = (*StreamSessionMutation)(nil)
+
+// streamsessionOption allows management of the mutation configuration using functional options.
+type streamsessionOption func(*StreamSessionMutation)
+
+// newStreamSessionMutation creates new mutation for the StreamSession entity.
+func newStreamSessionMutation(c config, op Op, opts ...streamsessionOption) *StreamSessionMutation {
+	m := &StreamSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStreamSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStreamSessionID sets the ID field of the mutation.
+func withStreamSessionID(id int64) streamsessionOption {
+	return func(m *StreamSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StreamSession
+		)
+		m.oldValue = func(ctx context.Context) (*StreamSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StreamSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStreamSession sets the old StreamSession of the mutation.
+func withStreamSession(node *StreamSession) streamsessionOption {
+	return func(m *StreamSessionMutation) {
+		m.oldValue = func(context.Context) (*StreamSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StreamSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StreamSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StreamSession entities.
+func (m *StreamSessionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StreamSessionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StreamSessionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StreamSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (m *StreamSessionMutation) SetStreamerID(i int64) {
+	m.streamer = &i
+}
+
+// StreamerID returns the value of the "streamer_id" field in the mutation.
+func (m *StreamSessionMutation) StreamerID() (r int64, exists bool) {
+	v := m.streamer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStreamerID returns the old "streamer_id" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldStreamerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStreamerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStreamerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStreamerID: %w", err)
+	}
+	return oldValue.StreamerID, nil
+}
+
+// ResetStreamerID resets all changes to the "streamer_id" field.
+func (m *StreamSessionMutation) ResetStreamerID() {
+	m.streamer = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *StreamSessionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *StreamSessionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *StreamSessionMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[streamsession.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *StreamSessionMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *StreamSessionMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, streamsession.FieldTitle)
+}
+
+// SetGameName sets the "game_name" field.
+func (m *StreamSessionMutation) SetGameName(s string) {
+	m.game_name = &s
+}
+
+// GameName returns the value of the "game_name" field in the mutation.
+func (m *StreamSessionMutation) GameName() (r string, exists bool) {
+	v := m.game_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameName returns the old "game_name" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldGameName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameName: %w", err)
+	}
+	return oldValue.GameName, nil
+}
+
+// ClearGameName clears the value of the "game_name" field.
+func (m *StreamSessionMutation) ClearGameName() {
+	m.game_name = nil
+	m.clearedFields[streamsession.FieldGameName] = struct{}{}
+}
+
+// GameNameCleared returns if the "game_name" field was cleared in this mutation.
+func (m *StreamSessionMutation) GameNameCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldGameName]
+	return ok
+}
+
+// ResetGameName resets all changes to the "game_name" field.
+func (m *StreamSessionMutation) ResetGameName() {
+	m.game_name = nil
+	delete(m.clearedFields, streamsession.FieldGameName)
+}
+
+// SetTitles sets the "titles" field.
+func (m *StreamSessionMutation) SetTitles(s []string) {
+	m.titles = &s
+	m.appendtitles = nil
+}
+
+// Titles returns the value of the "titles" field in the mutation.
+func (m *StreamSessionMutation) Titles() (r []string, exists bool) {
+	v := m.titles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitles returns the old "titles" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldTitles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitles: %w", err)
+	}
+	return oldValue.Titles, nil
+}
+
+// AppendTitles adds s to the "titles" field.
+func (m *StreamSessionMutation) AppendTitles(s []string) {
+	m.appendtitles = append(m.appendtitles, s...)
+}
+
+// AppendedTitles returns the list of values that were appended to the "titles" field in this mutation.
+func (m *StreamSessionMutation) AppendedTitles() ([]string, bool) {
+	if len(m.appendtitles) == 0 {
+		return nil, false
+	}
+	return m.appendtitles, true
+}
+
+// ClearTitles clears the value of the "titles" field.
+func (m *StreamSessionMutation) ClearTitles() {
+	m.titles = nil
+	m.appendtitles = nil
+	m.clearedFields[streamsession.FieldTitles] = struct{}{}
+}
+
+// TitlesCleared returns if the "titles" field was cleared in this mutation.
+func (m *StreamSessionMutation) TitlesCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldTitles]
+	return ok
+}
+
+// ResetTitles resets all changes to the "titles" field.
+func (m *StreamSessionMutation) ResetTitles() {
+	m.titles = nil
+	m.appendtitles = nil
+	delete(m.clearedFields, streamsession.FieldTitles)
+}
+
+// SetCategories sets the "categories" field.
+func (m *StreamSessionMutation) SetCategories(s []string) {
+	m.categories = &s
+	m.appendcategories = nil
+}
+
+// Categories returns the value of the "categories" field in the mutation.
+func (m *StreamSessionMutation) Categories() (r []string, exists bool) {
+	v := m.categories
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategories returns the old "categories" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldCategories(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategories is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategories requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategories: %w", err)
+	}
+	return oldValue.Categories, nil
+}
+
+// AppendCategories adds s to the "categories" field.
+func (m *StreamSessionMutation) AppendCategories(s []string) {
+	m.appendcategories = append(m.appendcategories, s...)
+}
+
+// AppendedCategories returns the list of values that were appended to the "categories" field in this mutation.
+func (m *StreamSessionMutation) AppendedCategories() ([]string, bool) {
+	if len(m.appendcategories) == 0 {
+		return nil, false
+	}
+	return m.appendcategories, true
+}
+
+// ClearCategories clears the value of the "categories" field.
+func (m *StreamSessionMutation) ClearCategories() {
+	m.categories = nil
+	m.appendcategories = nil
+	m.clearedFields[streamsession.FieldCategories] = struct{}{}
+}
+
+// CategoriesCleared returns if the "categories" field was cleared in this mutation.
+func (m *StreamSessionMutation) CategoriesCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldCategories]
+	return ok
+}
+
+// ResetCategories resets all changes to the "categories" field.
+func (m *StreamSessionMutation) ResetCategories() {
+	m.categories = nil
+	m.appendcategories = nil
+	delete(m.clearedFields, streamsession.FieldCategories)
+}
+
+// SetAnnouncedTitle sets the "announced_title" field.
+func (m *StreamSessionMutation) SetAnnouncedTitle(s string) {
+	m.announced_title = &s
+}
+
+// AnnouncedTitle returns the value of the "announced_title" field in the mutation.
+func (m *StreamSessionMutation) AnnouncedTitle() (r string, exists bool) {
+	v := m.announced_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnouncedTitle returns the old "announced_title" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldAnnouncedTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnouncedTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnouncedTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnouncedTitle: %w", err)
+	}
+	return oldValue.AnnouncedTitle, nil
+}
+
+// ClearAnnouncedTitle clears the value of the "announced_title" field.
+func (m *StreamSessionMutation) ClearAnnouncedTitle() {
+	m.announced_title = nil
+	m.clearedFields[streamsession.FieldAnnouncedTitle] = struct{}{}
+}
+
+// AnnouncedTitleCleared returns if the "announced_title" field was cleared in this mutation.
+func (m *StreamSessionMutation) AnnouncedTitleCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldAnnouncedTitle]
+	return ok
+}
+
+// ResetAnnouncedTitle resets all changes to the "announced_title" field.
+func (m *StreamSessionMutation) ResetAnnouncedTitle() {
+	m.announced_title = nil
+	delete(m.clearedFields, streamsession.FieldAnnouncedTitle)
+}
+
+// SetAnnouncedGameName sets the "announced_game_name" field.
+func (m *StreamSessionMutation) SetAnnouncedGameName(s string) {
+	m.announced_game_name = &s
+}
+
+// AnnouncedGameName returns the value of the "announced_game_name" field in the mutation.
+func (m *StreamSessionMutation) AnnouncedGameName() (r string, exists bool) {
+	v := m.announced_game_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnouncedGameName returns the old "announced_game_name" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldAnnouncedGameName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnouncedGameName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnouncedGameName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnouncedGameName: %w", err)
+	}
+	return oldValue.AnnouncedGameName, nil
+}
+
+// ClearAnnouncedGameName clears the value of the "announced_game_name" field.
+func (m *StreamSessionMutation) ClearAnnouncedGameName() {
+	m.announced_game_name = nil
+	m.clearedFields[streamsession.FieldAnnouncedGameName] = struct{}{}
+}
+
+// AnnouncedGameNameCleared returns if the "announced_game_name" field was cleared in this mutation.
+func (m *StreamSessionMutation) AnnouncedGameNameCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldAnnouncedGameName]
+	return ok
+}
+
+// ResetAnnouncedGameName resets all changes to the "announced_game_name" field.
+func (m *StreamSessionMutation) ResetAnnouncedGameName() {
+	m.announced_game_name = nil
+	delete(m.clearedFields, streamsession.FieldAnnouncedGameName)
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *StreamSessionMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *StreamSessionMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedAt returns the old "changed_at" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ClearChangedAt clears the value of the "changed_at" field.
+func (m *StreamSessionMutation) ClearChangedAt() {
+	m.changed_at = nil
+	m.clearedFields[streamsession.FieldChangedAt] = struct{}{}
+}
+
+// ChangedAtCleared returns if the "changed_at" field was cleared in this mutation.
+func (m *StreamSessionMutation) ChangedAtCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldChangedAt]
+	return ok
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *StreamSessionMutation) ResetChangedAt() {
+	m.changed_at = nil
+	delete(m.clearedFields, streamsession.FieldChangedAt)
+}
+
+// SetPeakViewers sets the "peak_viewers" field.
+func (m *StreamSessionMutation) SetPeakViewers(i int) {
+	m.peak_viewers = &i
+	m.addpeak_viewers = nil
+}
+
+// PeakViewers returns the value of the "peak_viewers" field in the mutation.
+func (m *StreamSessionMutation) PeakViewers() (r int, exists bool) {
+	v := m.peak_viewers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeakViewers returns the old "peak_viewers" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldPeakViewers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeakViewers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeakViewers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeakViewers: %w", err)
+	}
+	return oldValue.PeakViewers, nil
+}
+
+// AddPeakViewers adds i to the "peak_viewers" field.
+func (m *StreamSessionMutation) AddPeakViewers(i int) {
+	if m.addpeak_viewers != nil {
+		*m.addpeak_viewers += i
+	} else {
+		m.addpeak_viewers = &i
+	}
+}
+
+// AddedPeakViewers returns the value that was added to the "peak_viewers" field in this mutation.
+func (m *StreamSessionMutation) AddedPeakViewers() (r int, exists bool) {
+	v := m.addpeak_viewers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeakViewers resets all changes to the "peak_viewers" field.
+func (m *StreamSessionMutation) ResetPeakViewers() {
+	m.peak_viewers = nil
+	m.addpeak_viewers = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *StreamSessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *StreamSessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *StreamSessionMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *StreamSessionMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *StreamSessionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *StreamSessionMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *StreamSessionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *StreamSessionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *StreamSessionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[streamsession.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *StreamSessionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[streamsession.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *StreamSessionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, streamsession.FieldEndedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StreamSessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StreamSessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StreamSessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StreamSessionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StreamSessionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the StreamSession entity.
+// If the StreamSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamSessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StreamSessionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearStreamer clears the "streamer" edge to the Streamer entity.
+func (m *StreamSessionMutation) ClearStreamer() {
+	m.clearedstreamer = true
+	m.clearedFields[streamsession.FieldStreamerID] = struct{}{}
+}
+
+// StreamerCleared reports if the "streamer" edge to the Streamer entity was cleared.
+func (m *StreamSessionMutation) StreamerCleared() bool {
+	return m.clearedstreamer
+}
+
+// StreamerIDs returns the "streamer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StreamerID instead. It exists only for internal usage by the builders.
+func (m *StreamSessionMutation) StreamerIDs() (ids []int64) {
+	if id := m.streamer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStreamer resets all changes to the "streamer" edge.
+func (m *StreamSessionMutation) ResetStreamer() {
+	m.streamer = nil
+	m.clearedstreamer = false
+}
+
+// Where appends a list predicates to the StreamSessionMutation builder.
+func (m *StreamSessionMutation) Where(ps ...predicate.StreamSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StreamSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StreamSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StreamSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StreamSessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StreamSessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StreamSession).
+func (m *StreamSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StreamSessionMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.streamer != nil {
+		fields = append(fields, streamsession.FieldStreamerID)
+	}
+	if m.title != nil {
+		fields = append(fields, streamsession.FieldTitle)
+	}
+	if m.game_name != nil {
+		fields = append(fields, streamsession.FieldGameName)
+	}
+	if m.titles != nil {
+		fields = append(fields, streamsession.FieldTitles)
+	}
+	if m.categories != nil {
+		fields = append(fields, streamsession.FieldCategories)
+	}
+	if m.announced_title != nil {
+		fields = append(fields, streamsession.FieldAnnouncedTitle)
+	}
+	if m.announced_game_name != nil {
+		fields = append(fields, streamsession.FieldAnnouncedGameName)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, streamsession.FieldChangedAt)
+	}
+	if m.peak_viewers != nil {
+		fields = append(fields, streamsession.FieldPeakViewers)
+	}
+	if m.started_at != nil {
+		fields = append(fields, streamsession.FieldStartedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, streamsession.FieldLastSeenAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, streamsession.FieldEndedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, streamsession.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, streamsession.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StreamSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case streamsession.FieldStreamerID:
+		return m.StreamerID()
+	case streamsession.FieldTitle:
+		return m.Title()
+	case streamsession.FieldGameName:
+		return m.GameName()
+	case streamsession.FieldTitles:
+		return m.Titles()
+	case streamsession.FieldCategories:
+		return m.Categories()
+	case streamsession.FieldAnnouncedTitle:
+		return m.AnnouncedTitle()
+	case streamsession.FieldAnnouncedGameName:
+		return m.AnnouncedGameName()
+	case streamsession.FieldChangedAt:
+		return m.ChangedAt()
+	case streamsession.FieldPeakViewers:
+		return m.PeakViewers()
+	case streamsession.FieldStartedAt:
+		return m.StartedAt()
+	case streamsession.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case streamsession.FieldEndedAt:
+		return m.EndedAt()
+	case streamsession.FieldCreatedAt:
+		return m.CreatedAt()
+	case streamsession.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StreamSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case streamsession.FieldStreamerID:
+		return m.OldStreamerID(ctx)
+	case streamsession.FieldTitle:
+		return m.OldTitle(ctx)
+	case streamsession.FieldGameName:
+		return m.OldGameName(ctx)
+	case streamsession.FieldTitles:
+		return m.OldTitles(ctx)
+	case streamsession.FieldCategories:
+		return m.OldCategories(ctx)
+	case streamsession.FieldAnnouncedTitle:
+		return m.OldAnnouncedTitle(ctx)
+	case streamsession.FieldAnnouncedGameName:
+		return m.OldAnnouncedGameName(ctx)
+	case streamsession.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	case streamsession.FieldPeakViewers:
+		return m.OldPeakViewers(ctx)
+	case streamsession.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case streamsession.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case streamsession.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case streamsession.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case streamsession.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StreamSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case streamsession.FieldStreamerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreamerID(v)
+		return nil
+	case streamsession.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case streamsession.FieldGameName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameName(v)
+		return nil
+	case streamsession.FieldTitles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitles(v)
+		return nil
+	case streamsession.FieldCategories:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategories(v)
+		return nil
+	case streamsession.FieldAnnouncedTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnouncedTitle(v)
+		return nil
+	case streamsession.FieldAnnouncedGameName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnouncedGameName(v)
+		return nil
+	case streamsession.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
+		return nil
+	case streamsession.FieldPeakViewers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeakViewers(v)
+		return nil
+	case streamsession.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case streamsession.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case streamsession.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case streamsession.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case streamsession.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StreamSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StreamSessionMutation) AddedFields() []string {
+	var fields []string
+	if m.addpeak_viewers != nil {
+		fields = append(fields, streamsession.FieldPeakViewers)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StreamSessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case streamsession.FieldPeakViewers:
+		return m.AddedPeakViewers()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case streamsession.FieldPeakViewers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeakViewers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StreamSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StreamSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(streamsession.FieldTitle) {
+		fields = append(fields, streamsession.FieldTitle)
+	}
+	if m.FieldCleared(streamsession.FieldGameName) {
+		fields = append(fields, streamsession.FieldGameName)
+	}
+	if m.FieldCleared(streamsession.FieldTitles) {
+		fields = append(fields, streamsession.FieldTitles)
+	}
+	if m.FieldCleared(streamsession.FieldCategories) {
+		fields = append(fields, streamsession.FieldCategories)
+	}
+	if m.FieldCleared(streamsession.FieldAnnouncedTitle) {
+		fields = append(fields, streamsession.FieldAnnouncedTitle)
+	}
+	if m.FieldCleared(streamsession.FieldAnnouncedGameName) {
+		fields = append(fields, streamsession.FieldAnnouncedGameName)
+	}
+	if m.FieldCleared(streamsession.FieldChangedAt) {
+		fields = append(fields, streamsession.FieldChangedAt)
+	}
+	if m.FieldCleared(streamsession.FieldEndedAt) {
+		fields = append(fields, streamsession.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StreamSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StreamSessionMutation) ClearField(name string) error {
+	switch name {
+	case streamsession.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case streamsession.FieldGameName:
+		m.ClearGameName()
+		return nil
+	case streamsession.FieldTitles:
+		m.ClearTitles()
+		return nil
+	case streamsession.FieldCategories:
+		m.ClearCategories()
+		return nil
+	case streamsession.FieldAnnouncedTitle:
+		m.ClearAnnouncedTitle()
+		return nil
+	case streamsession.FieldAnnouncedGameName:
+		m.ClearAnnouncedGameName()
+		return nil
+	case streamsession.FieldChangedAt:
+		m.ClearChangedAt()
+		return nil
+	case streamsession.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StreamSessionMutation) ResetField(name string) error {
+	switch name {
+	case streamsession.FieldStreamerID:
+		m.ResetStreamerID()
+		return nil
+	case streamsession.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case streamsession.FieldGameName:
+		m.ResetGameName()
+		return nil
+	case streamsession.FieldTitles:
+		m.ResetTitles()
+		return nil
+	case streamsession.FieldCategories:
+		m.ResetCategories()
+		return nil
+	case streamsession.FieldAnnouncedTitle:
+		m.ResetAnnouncedTitle()
+		return nil
+	case streamsession.FieldAnnouncedGameName:
+		m.ResetAnnouncedGameName()
+		return nil
+	case streamsession.FieldChangedAt:
+		m.ResetChangedAt()
+		return nil
+	case streamsession.FieldPeakViewers:
+		m.ResetPeakViewers()
+		return nil
+	case streamsession.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case streamsession.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case streamsession.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case streamsession.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case streamsession.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StreamSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.streamer != nil {
+		edges = append(edges, streamsession.EdgeStreamer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StreamSessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case streamsession.EdgeStreamer:
+		if id := m.streamer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StreamSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StreamSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StreamSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstreamer {
+		edges = append(edges, streamsession.EdgeStreamer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StreamSessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case streamsession.EdgeStreamer:
+		return m.clearedstreamer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StreamSessionMutation) ClearEdge(name string) error {
+	switch name {
+	case streamsession.EdgeStreamer:
+		m.ClearStreamer()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StreamSessionMutation) ResetEdge(name string) error {
+	switch name {
+	case streamsession.EdgeStreamer:
+		m.ResetStreamer()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamSession edge %s", name)
+}
+
+// StreamerMutation represents an operation that mutates the Streamer nodes in the graph.
+type StreamerMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int64
+	platform_type        *string
+	platform_streamer_id *string
+	display_name         *string
+	avatar_url           *string
+	room_url             *string
+	bio                  *string
+	tags                 *[]string
+	appendtags           []string
+	is_live              *bool
+	live_title           *string
+	live_game_name       *string
+	live_start_time      *time.Time
+	live_viewers         *int
+	addlive_viewers      *int
+	live_cover_image     *string
+	last_live_synced_at  *time.Time
+	last_synced_at       *time.Time
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	followers            map[int64]struct{}
+	removedfollowers     map[int64]struct{}
+	clearedfollowers     bool
+	sessions             map[int64]struct{}
+	removedsessions      map[int64]struct{}
+	clearedsessions      bool
+	done                 bool
+	oldValue             func(context.Context) (*Streamer, error)
+	predicates           []predicate.Streamer
+}
+
+var _ ent.Mutation = (*StreamerMutation)(nil)
+
+// streamerOption allows management of the mutation configuration using functional options.
+type streamerOption func(*StreamerMutation)
+
+// newStreamerMutation creates new mutation for the Streamer entity.
+func newStreamerMutation(c config, op Op, opts ...streamerOption) *StreamerMutation {
+	m := &StreamerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStreamer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStreamerID sets the ID field of the mutation.
+func withStreamerID(id int64) streamerOption {
+	return func(m *StreamerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Streamer
+		)
+		m.oldValue = func(ctx context.Context) (*Streamer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Streamer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStreamer sets the old Streamer of the mutation.
+func withStreamer(node *Streamer) streamerOption {
+	return func(m *StreamerMutation) {
+		m.oldValue = func(context.Context) (*Streamer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StreamerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StreamerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Streamer entities.
+func (m *StreamerMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StreamerMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StreamerMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Streamer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlatformType sets the "platform_type" field.
+func (m *StreamerMutation) SetPlatformType(s string) {
+	m.platform_type = &s
+}
+
+// PlatformType returns the value of the "platform_type" field in the mutation.
+func (m *StreamerMutation) PlatformType() (r string, exists bool) {
+	v := m.platform_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformType returns the old "platform_type" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldPlatformType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformType: %w", err)
+	}
+	return oldValue.PlatformType, nil
+}
+
+// ResetPlatformType resets all changes to the "platform_type" field.
+func (m *StreamerMutation) ResetPlatformType() {
+	m.platform_type = nil
+}
+
+// SetPlatformStreamerID sets the "platform_streamer_id" field.
+func (m *StreamerMutation) SetPlatformStreamerID(s string) {
+	m.platform_streamer_id = &s
+}
+
+// PlatformStreamerID returns the value of the "platform_streamer_id" field in the mutation.
+func (m *StreamerMutation) PlatformStreamerID() (r string, exists bool) {
+	v := m.platform_streamer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformStreamerID returns the old "platform_streamer_id" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldPlatformStreamerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformStreamerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformStreamerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformStreamerID: %w", err)
+	}
+	return oldValue.PlatformStreamerID, nil
+}
+
+// ResetPlatformStreamerID resets all changes to the "platform_streamer_id" field.
+func (m *StreamerMutation) ResetPlatformStreamerID() {
+	m.platform_streamer_id = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *StreamerMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *StreamerMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *StreamerMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (m *StreamerMutation) SetAvatarURL(s string) {
+	m.avatar_url = &s
+}
+
+// AvatarURL returns the value of the "avatar_url" field in the mutation.
+func (m *StreamerMutation) AvatarURL() (r string, exists bool) {
+	v := m.avatar_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarURL returns the old "avatar_url" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldAvatarURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+	}
+	return oldValue.AvatarURL, nil
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (m *StreamerMutation) ClearAvatarURL() {
+	m.avatar_url = nil
+	m.clearedFields[streamer.FieldAvatarURL] = struct{}{}
+}
+
+// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
+func (m *StreamerMutation) AvatarURLCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldAvatarURL]
+	return ok
+}
+
+// ResetAvatarURL resets all changes to the "avatar_url" field.
+func (m *StreamerMutation) ResetAvatarURL() {
+	m.avatar_url = nil
+	delete(m.clearedFields, streamer.FieldAvatarURL)
+}
+
+// SetRoomURL sets the "room_url" field.
+func (m *StreamerMutation) SetRoomURL(s string) {
+	m.room_url = &s
+}
+
+// RoomURL returns the value of the "room_url" field in the mutation.
+func (m *StreamerMutation) RoomURL() (r string, exists bool) {
+	v := m.room_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoomURL returns the old "room_url" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldRoomURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoomURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoomURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoomURL: %w", err)
+	}
+	return oldValue.RoomURL, nil
+}
+
+// ClearRoomURL clears the value of the "room_url" field.
+func (m *StreamerMutation) ClearRoomURL() {
+	m.room_url = nil
+	m.clearedFields[streamer.FieldRoomURL] = struct{}{}
+}
+
+// RoomURLCleared returns if the "room_url" field was cleared in this mutation.
+func (m *StreamerMutation) RoomURLCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldRoomURL]
+	return ok
+}
+
+// ResetRoomURL resets all changes to the "room_url" field.
+func (m *StreamerMutation) ResetRoomURL() {
+	m.room_url = nil
+	delete(m.clearedFields, streamer.FieldRoomURL)
+}
+
+// SetBio sets the "bio" field.
+func (m *StreamerMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *StreamerMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldBio(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ClearBio clears the value of the "bio" field.
+func (m *StreamerMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[streamer.FieldBio] = struct{}{}
+}
+
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *StreamerMutation) BioCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldBio]
+	return ok
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *StreamerMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, streamer.FieldBio)
+}
+
+// SetTags sets the "tags" field.
+func (m *StreamerMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *StreamerMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *StreamerMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *StreamerMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *StreamerMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[streamer.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *StreamerMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *StreamerMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, streamer.FieldTags)
+}
+
+// SetIsLive sets the "is_live" field.
+func (m *StreamerMutation) SetIsLive(b bool) {
+	m.is_live = &b
+}
+
+// IsLive returns the value of the "is_live" field in the mutation.
+func (m *StreamerMutation) IsLive() (r bool, exists bool) {
+	v := m.is_live
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLive returns the old "is_live" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldIsLive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLive: %w", err)
+	}
+	return oldValue.IsLive, nil
+}
+
+// ResetIsLive resets all changes to the "is_live" field.
+func (m *StreamerMutation) ResetIsLive() {
+	m.is_live = nil
+}
+
+// SetLiveTitle sets the "live_title" field.
+func (m *StreamerMutation) SetLiveTitle(s string) {
+	m.live_title = &s
+}
+
+// LiveTitle returns the value of the "live_title" field in the mutation.
+func (m *StreamerMutation) LiveTitle() (r string, exists bool) {
+	v := m.live_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiveTitle returns the old "live_title" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLiveTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiveTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiveTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiveTitle: %w", err)
+	}
+	return oldValue.LiveTitle, nil
+}
+
+// ClearLiveTitle clears the value of the "live_title" field.
+func (m *StreamerMutation) ClearLiveTitle() {
+	m.live_title = nil
+	m.clearedFields[streamer.FieldLiveTitle] = struct{}{}
+}
+
+// LiveTitleCleared returns if the "live_title" field was cleared in this mutation.
+func (m *StreamerMutation) LiveTitleCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLiveTitle]
+	return ok
+}
+
+// ResetLiveTitle resets all changes to the "live_title" field.
+func (m *StreamerMutation) ResetLiveTitle() {
+	m.live_title = nil
+	delete(m.clearedFields, streamer.FieldLiveTitle)
+}
+
+// SetLiveGameName sets the "live_game_name" field.
+func (m *StreamerMutation) SetLiveGameName(s string) {
+	m.live_game_name = &s
+}
+
+// LiveGameName returns the value of the "live_game_name" field in the mutation.
+func (m *StreamerMutation) LiveGameName() (r string, exists bool) {
+	v := m.live_game_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiveGameName returns the old "live_game_name" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLiveGameName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiveGameName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiveGameName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiveGameName: %w", err)
+	}
+	return oldValue.LiveGameName, nil
+}
+
+// ClearLiveGameName clears the value of the "live_game_name" field.
+func (m *StreamerMutation) ClearLiveGameName() {
+	m.live_game_name = nil
+	m.clearedFields[streamer.FieldLiveGameName] = struct{}{}
+}
+
+// LiveGameNameCleared returns if the "live_game_name" field was cleared in this mutation.
+func (m *StreamerMutation) LiveGameNameCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLiveGameName]
+	return ok
+}
+
+// ResetLiveGameName resets all changes to the "live_game_name" field.
+func (m *StreamerMutation) ResetLiveGameName() {
+	m.live_game_name = nil
+	delete(m.clearedFields, streamer.FieldLiveGameName)
+}
+
+// SetLiveStartTime sets the "live_start_time" field.
+func (m *StreamerMutation) SetLiveStartTime(t time.Time) {
+	m.live_start_time = &t
+}
+
+// LiveStartTime returns the value of the "live_start_time" field in the mutation.
+func (m *StreamerMutation) LiveStartTime() (r time.Time, exists bool) {
+	v := m.live_start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiveStartTime returns the old "live_start_time" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLiveStartTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiveStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiveStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiveStartTime: %w", err)
+	}
+	return oldValue.LiveStartTime, nil
+}
+
+// ClearLiveStartTime clears the value of the "live_start_time" field.
+func (m *StreamerMutation) ClearLiveStartTime() {
+	m.live_start_time = nil
+	m.clearedFields[streamer.FieldLiveStartTime] = struct{}{}
+}
+
+// LiveStartTimeCleared returns if the "live_start_time" field was cleared in this mutation.
+func (m *StreamerMutation) LiveStartTimeCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLiveStartTime]
+	return ok
+}
+
+// ResetLiveStartTime resets all changes to the "live_start_time" field.
+func (m *StreamerMutation) ResetLiveStartTime() {
+	m.live_start_time = nil
+	delete(m.clearedFields, streamer.FieldLiveStartTime)
+}
+
+// SetLiveViewers sets the "live_viewers" field.
+func (m *StreamerMutation) SetLiveViewers(i int) {
+	m.live_viewers = &i
+	m.addlive_viewers = nil
+}
+
+// LiveViewers returns the value of the "live_viewers" field in the mutation.
+func (m *StreamerMutation) LiveViewers() (r int, exists bool) {
+	v := m.live_viewers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiveViewers returns the old "live_viewers" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLiveViewers(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiveViewers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiveViewers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiveViewers: %w", err)
+	}
+	return oldValue.LiveViewers, nil
+}
+
+// AddLiveViewers adds i to the "live_viewers" field.
+func (m *StreamerMutation) AddLiveViewers(i int) {
+	if m.addlive_viewers != nil {
+		*m.addlive_viewers += i
+	} else {
+		m.addlive_viewers = &i
+	}
+}
+
+// AddedLiveViewers returns the value that was added to the "live_viewers" field in this mutation.
+func (m *StreamerMutation) AddedLiveViewers() (r int, exists bool) {
+	v := m.addlive_viewers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLiveViewers clears the value of the "live_viewers" field.
+func (m *StreamerMutation) ClearLiveViewers() {
+	m.live_viewers = nil
+	m.addlive_viewers = nil
+	m.clearedFields[streamer.FieldLiveViewers] = struct{}{}
+}
+
+// LiveViewersCleared returns if the "live_viewers" field was cleared in this mutation.
+func (m *StreamerMutation) LiveViewersCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLiveViewers]
+	return ok
+}
+
+// ResetLiveViewers resets all changes to the "live_viewers" field.
+func (m *StreamerMutation) ResetLiveViewers() {
+	m.live_viewers = nil
+	m.addlive_viewers = nil
+	delete(m.clearedFields, streamer.FieldLiveViewers)
+}
+
+// SetLiveCoverImage sets the "live_cover_image" field.
+func (m *StreamerMutation) SetLiveCoverImage(s string) {
+	m.live_cover_image = &s
+}
+
+// LiveCoverImage returns the value of the "live_cover_image" field in the mutation.
+func (m *StreamerMutation) LiveCoverImage() (r string, exists bool) {
+	v := m.live_cover_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiveCoverImage returns the old "live_cover_image" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLiveCoverImage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiveCoverImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiveCoverImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiveCoverImage: %w", err)
+	}
+	return oldValue.LiveCoverImage, nil
+}
+
+// ClearLiveCoverImage clears the value of the "live_cover_image" field.
+func (m *StreamerMutation) ClearLiveCoverImage() {
+	m.live_cover_image = nil
+	m.clearedFields[streamer.FieldLiveCoverImage] = struct{}{}
+}
+
+// LiveCoverImageCleared returns if the "live_cover_image" field was cleared in this mutation.
+func (m *StreamerMutation) LiveCoverImageCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLiveCoverImage]
+	return ok
+}
+
+// ResetLiveCoverImage resets all changes to the "live_cover_image" field.
+func (m *StreamerMutation) ResetLiveCoverImage() {
+	m.live_cover_image = nil
+	delete(m.clearedFields, streamer.FieldLiveCoverImage)
+}
+
+// SetLastLiveSyncedAt sets the "last_live_synced_at" field.
+func (m *StreamerMutation) SetLastLiveSyncedAt(t time.Time) {
+	m.last_live_synced_at = &t
+}
+
+// LastLiveSyncedAt returns the value of the "last_live_synced_at" field in the mutation.
+func (m *StreamerMutation) LastLiveSyncedAt() (r time.Time, exists bool) {
+	v := m.last_live_synced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLiveSyncedAt returns the old "last_live_synced_at" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLastLiveSyncedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLiveSyncedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLiveSyncedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLiveSyncedAt: %w", err)
+	}
+	return oldValue.LastLiveSyncedAt, nil
+}
+
+// ClearLastLiveSyncedAt clears the value of the "last_live_synced_at" field.
+func (m *StreamerMutation) ClearLastLiveSyncedAt() {
+	m.last_live_synced_at = nil
+	m.clearedFields[streamer.FieldLastLiveSyncedAt] = struct{}{}
+}
+
+// LastLiveSyncedAtCleared returns if the "last_live_synced_at" field was cleared in this mutation.
+func (m *StreamerMutation) LastLiveSyncedAtCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLastLiveSyncedAt]
+	return ok
+}
+
+// ResetLastLiveSyncedAt resets all changes to the "last_live_synced_at" field.
+func (m *StreamerMutation) ResetLastLiveSyncedAt() {
+	m.last_live_synced_at = nil
+	delete(m.clearedFields, streamer.FieldLastLiveSyncedAt)
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (m *StreamerMutation) SetLastSyncedAt(t time.Time) {
+	m.last_synced_at = &t
+}
+
+// LastSyncedAt returns the value of the "last_synced_at" field in the mutation.
+func (m *StreamerMutation) LastSyncedAt() (r time.Time, exists bool) {
+	v := m.last_synced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSyncedAt returns the old "last_synced_at" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldLastSyncedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSyncedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSyncedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSyncedAt: %w", err)
+	}
+	return oldValue.LastSyncedAt, nil
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (m *StreamerMutation) ClearLastSyncedAt() {
+	m.last_synced_at = nil
+	m.clearedFields[streamer.FieldLastSyncedAt] = struct{}{}
+}
+
+// LastSyncedAtCleared returns if the "last_synced_at" field was cleared in this mutation.
+func (m *StreamerMutation) LastSyncedAtCleared() bool {
+	_, ok := m.clearedFields[streamer.FieldLastSyncedAt]
+	return ok
+}
+
+// ResetLastSyncedAt resets all changes to the "last_synced_at" field.
+func (m *StreamerMutation) ResetLastSyncedAt() {
+	m.last_synced_at = nil
+	delete(m.clearedFields, streamer.FieldLastSyncedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StreamerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StreamerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StreamerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StreamerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StreamerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Streamer entity.
+// If the Streamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StreamerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddFollowerIDs adds the "followers" edge to the UserFollowedStreamer entity by ids.
+func (m *StreamerMutation) AddFollowerIDs(ids ...int64) {
+	if m.followers == nil {
+		m.followers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.followers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowers clears the "followers" edge to the UserFollowedStreamer entity.
+func (m *StreamerMutation) ClearFollowers() {
+	m.clearedfollowers = true
+}
+
+// FollowersCleared reports if the "followers" edge to the UserFollowedStreamer entity was cleared.
+func (m *StreamerMutation) FollowersCleared() bool {
+	return m.clearedfollowers
+}
+
+// RemoveFollowerIDs removes the "followers" edge to the UserFollowedStreamer entity by IDs.
+func (m *StreamerMutation) RemoveFollowerIDs(ids ...int64) {
+	if m.removedfollowers == nil {
+		m.removedfollowers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.followers, ids[i])
+		m.removedfollowers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowers returns the removed IDs of the "followers" edge to the UserFollowedStreamer entity.
+func (m *StreamerMutation) RemovedFollowersIDs() (ids []int64) {
+	for id := range m.removedfollowers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowersIDs returns the "followers" edge IDs in the mutation.
+func (m *StreamerMutation) FollowersIDs() (ids []int64) {
+	for id := range m.followers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowers resets all changes to the "followers" edge.
+func (m *StreamerMutation) ResetFollowers() {
+	m.followers = nil
+	m.clearedfollowers = false
+	m.removedfollowers = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the StreamSession entity by ids.
+func (m *StreamerMutation) AddSessionIDs(ids ...int64) {
+	if m.sessions == nil {
+		m.sessions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the StreamSession entity.
+func (m *StreamerMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the StreamSession entity was cleared.
+func (m *StreamerMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the StreamSession entity by IDs.
+func (m *StreamerMutation) RemoveSessionIDs(ids ...int64) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the StreamSession entity.
+func (m *StreamerMutation) RemovedSessionsIDs() (ids []int64) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *StreamerMutation) SessionsIDs() (ids []int64) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *StreamerMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// Where appends a list predicates to the StreamerMutation builder.
+func (m *StreamerMutation) Where(ps ...predicate.Streamer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StreamerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StreamerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Streamer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StreamerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StreamerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Streamer).
+func (m *StreamerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StreamerMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.platform_type != nil {
+		fields = append(fields, streamer.FieldPlatformType)
+	}
+	if m.platform_streamer_id != nil {
+		fields = append(fields, streamer.FieldPlatformStreamerID)
+	}
+	if m.display_name != nil {
+		fields = append(fields, streamer.FieldDisplayName)
+	}
+	if m.avatar_url != nil {
+		fields = append(fields, streamer.FieldAvatarURL)
+	}
+	if m.room_url != nil {
+		fields = append(fields, streamer.FieldRoomURL)
+	}
+	if m.bio != nil {
+		fields = append(fields, streamer.FieldBio)
+	}
+	if m.tags != nil {
+		fields = append(fields, streamer.FieldTags)
+	}
+	if m.is_live != nil {
+		fields = append(fields, streamer.FieldIsLive)
+	}
+	if m.live_title != nil {
+		fields = append(fields, streamer.FieldLiveTitle)
+	}
+	if m.live_game_name != nil {
+		fields = append(fields, streamer.FieldLiveGameName)
+	}
+	if m.live_start_time != nil {
+		fields = append(fields, streamer.FieldLiveStartTime)
+	}
+	if m.live_viewers != nil {
+		fields = append(fields, streamer.FieldLiveViewers)
+	}
+	if m.live_cover_image != nil {
+		fields = append(fields, streamer.FieldLiveCoverImage)
+	}
+	if m.last_live_synced_at != nil {
+		fields = append(fields, streamer.FieldLastLiveSyncedAt)
+	}
+	if m.last_synced_at != nil {
+		fields = append(fields, streamer.FieldLastSyncedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, streamer.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, streamer.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StreamerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case streamer.FieldPlatformType:
+		return m.PlatformType()
+	case streamer.FieldPlatformStreamerID:
+		return m.PlatformStreamerID()
+	case streamer.FieldDisplayName:
+		return m.DisplayName()
+	case streamer.FieldAvatarURL:
+		return m.AvatarURL()
+	case streamer.FieldRoomURL:
+		return m.RoomURL()
+	case streamer.FieldBio:
+		return m.Bio()
+	case streamer.FieldTags:
+		return m.Tags()
+	case streamer.FieldIsLive:
+		return m.IsLive()
+	case streamer.FieldLiveTitle:
+		return m.LiveTitle()
+	case streamer.FieldLiveGameName:
+		return m.LiveGameName()
+	case streamer.FieldLiveStartTime:
+		return m.LiveStartTime()
+	case streamer.FieldLiveViewers:
+		return m.LiveViewers()
+	case streamer.FieldLiveCoverImage:
+		return m.LiveCoverImage()
+	case streamer.FieldLastLiveSyncedAt:
+		return m.LastLiveSyncedAt()
+	case streamer.FieldLastSyncedAt:
+		return m.LastSyncedAt()
+	case streamer.FieldCreatedAt:
+		return m.CreatedAt()
+	case streamer.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StreamerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case streamer.FieldPlatformType:
+		return m.OldPlatformType(ctx)
+	case streamer.FieldPlatformStreamerID:
+		return m.OldPlatformStreamerID(ctx)
+	case streamer.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case streamer.FieldAvatarURL:
+		return m.OldAvatarURL(ctx)
+	case streamer.FieldRoomURL:
+		return m.OldRoomURL(ctx)
+	case streamer.FieldBio:
+		return m.OldBio(ctx)
+	case streamer.FieldTags:
+		return m.OldTags(ctx)
+	case streamer.FieldIsLive:
+		return m.OldIsLive(ctx)
+	case streamer.FieldLiveTitle:
+		return m.OldLiveTitle(ctx)
+	case streamer.FieldLiveGameName:
+		return m.OldLiveGameName(ctx)
+	case streamer.FieldLiveStartTime:
+		return m.OldLiveStartTime(ctx)
+	case streamer.FieldLiveViewers:
+		return m.OldLiveViewers(ctx)
+	case streamer.FieldLiveCoverImage:
+		return m.OldLiveCoverImage(ctx)
+	case streamer.FieldLastLiveSyncedAt:
+		return m.OldLastLiveSyncedAt(ctx)
+	case streamer.FieldLastSyncedAt:
+		return m.OldLastSyncedAt(ctx)
+	case streamer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case streamer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Streamer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case streamer.FieldPlatformType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformType(v)
+		return nil
+	case streamer.FieldPlatformStreamerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformStreamerID(v)
+		return nil
+	case streamer.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case streamer.FieldAvatarURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarURL(v)
+		return nil
+	case streamer.FieldRoomURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoomURL(v)
+		return nil
+	case streamer.FieldBio:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBio(v)
+		return nil
+	case streamer.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case streamer.FieldIsLive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLive(v)
+		return nil
+	case streamer.FieldLiveTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiveTitle(v)
+		return nil
+	case streamer.FieldLiveGameName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiveGameName(v)
+		return nil
+	case streamer.FieldLiveStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiveStartTime(v)
+		return nil
+	case streamer.FieldLiveViewers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiveViewers(v)
+		return nil
+	case streamer.FieldLiveCoverImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiveCoverImage(v)
+		return nil
+	case streamer.FieldLastLiveSyncedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLiveSyncedAt(v)
+		return nil
+	case streamer.FieldLastSyncedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSyncedAt(v)
+		return nil
+	case streamer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case streamer.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Streamer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StreamerMutation) AddedFields() []string {
+	var fields []string
+	if m.addlive_viewers != nil {
+		fields = append(fields, streamer.FieldLiveViewers)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StreamerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case streamer.FieldLiveViewers:
+		return m.AddedLiveViewers()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case streamer.FieldLiveViewers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLiveViewers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Streamer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StreamerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(streamer.FieldAvatarURL) {
+		fields = append(fields, streamer.FieldAvatarURL)
+	}
+	if m.FieldCleared(streamer.FieldRoomURL) {
+		fields = append(fields, streamer.FieldRoomURL)
+	}
+	if m.FieldCleared(streamer.FieldBio) {
+		fields = append(fields, streamer.FieldBio)
+	}
+	if m.FieldCleared(streamer.FieldTags) {
+		fields = append(fields, streamer.FieldTags)
+	}
+	if m.FieldCleared(streamer.FieldLiveTitle) {
+		fields = append(fields, streamer.FieldLiveTitle)
+	}
+	if m.FieldCleared(streamer.FieldLiveGameName) {
+		fields = append(fields, streamer.FieldLiveGameName)
+	}
+	if m.FieldCleared(streamer.FieldLiveStartTime) {
+		fields = append(fields, streamer.FieldLiveStartTime)
+	}
+	if m.FieldCleared(streamer.FieldLiveViewers) {
+		fields = append(fields, streamer.FieldLiveViewers)
+	}
+	if m.FieldCleared(streamer.FieldLiveCoverImage) {
+		fields = append(fields, streamer.FieldLiveCoverImage)
+	}
+	if m.FieldCleared(streamer.FieldLastLiveSyncedAt) {
+		fields = append(fields, streamer.FieldLastLiveSyncedAt)
+	}
+	if m.FieldCleared(streamer.FieldLastSyncedAt) {
+		fields = append(fields, streamer.FieldLastSyncedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StreamerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StreamerMutation) ClearField(name string) error {
+	switch name {
+	case streamer.FieldAvatarURL:
+		m.ClearAvatarURL()
+		return nil
+	case streamer.FieldRoomURL:
+		m.ClearRoomURL()
+		return nil
+	case streamer.FieldBio:
+		m.ClearBio()
+		return nil
+	case streamer.FieldTags:
+		m.ClearTags()
+		return nil
+	case streamer.FieldLiveTitle:
+		m.ClearLiveTitle()
+		return nil
+	case streamer.FieldLiveGameName:
+		m.ClearLiveGameName()
+		return nil
+	case streamer.FieldLiveStartTime:
+		m.ClearLiveStartTime()
+		return nil
+	case streamer.FieldLiveViewers:
+		m.ClearLiveViewers()
+		return nil
+	case streamer.FieldLiveCoverImage:
+		m.ClearLiveCoverImage()
+		return nil
+	case streamer.FieldLastLiveSyncedAt:
+		m.ClearLastLiveSyncedAt()
+		return nil
+	case streamer.FieldLastSyncedAt:
+		m.ClearLastSyncedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Streamer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StreamerMutation) ResetField(name string) error {
+	switch name {
+	case streamer.FieldPlatformType:
+		m.ResetPlatformType()
+		return nil
+	case streamer.FieldPlatformStreamerID:
+		m.ResetPlatformStreamerID()
+		return nil
+	case streamer.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case streamer.FieldAvatarURL:
+		m.ResetAvatarURL()
+		return nil
+	case streamer.FieldRoomURL:
+		m.ResetRoomURL()
+		return nil
+	case streamer.FieldBio:
+		m.ResetBio()
+		return nil
+	case streamer.FieldTags:
+		m.ResetTags()
+		return nil
+	case streamer.FieldIsLive:
+		m.ResetIsLive()
+		return nil
+	case streamer.FieldLiveTitle:
+		m.ResetLiveTitle()
+		return nil
+	case streamer.FieldLiveGameName:
+		m.ResetLiveGameName()
+		return nil
+	case streamer.FieldLiveStartTime:
+		m.ResetLiveStartTime()
+		return nil
+	case streamer.FieldLiveViewers:
+		m.ResetLiveViewers()
+		return nil
+	case streamer.FieldLiveCoverImage:
+		m.ResetLiveCoverImage()
+		return nil
+	case streamer.FieldLastLiveSyncedAt:
+		m.ResetLastLiveSyncedAt()
+		return nil
+	case streamer.FieldLastSyncedAt:
+		m.ResetLastSyncedAt()
+		return nil
+	case streamer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case streamer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Streamer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StreamerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.followers != nil {
+		edges = append(edges, streamer.EdgeFollowers)
+	}
+	if m.sessions != nil {
+		edges = append(edges, streamer.EdgeSessions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StreamerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case streamer.EdgeFollowers:
+		ids := make([]ent.Value, 0, len(m.followers))
+		for id := range m.followers {
+			ids = append(ids, id)
+		}
+		return ids
+	case streamer.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StreamerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedfollowers != nil {
+		edges = append(edges, streamer.EdgeFollowers)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, streamer.EdgeSessions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StreamerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case streamer.EdgeFollowers:
+		ids := make([]ent.Value, 0, len(m.removedfollowers))
+		for id := range m.removedfollowers {
+			ids = append(ids, id)
+		}
+		return ids
+	case streamer.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StreamerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfollowers {
+		edges = append(edges, streamer.EdgeFollowers)
+	}
+	if m.clearedsessions {
+		edges = append(edges, streamer.EdgeSessions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StreamerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case streamer.EdgeFollowers:
+		return m.clearedfollowers
+	case streamer.EdgeSessions:
+		return m.clearedsessions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StreamerMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Streamer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StreamerMutation) ResetEdge(name string) error {
+	switch name {
+	case streamer.EdgeFollowers:
+		m.ResetFollowers()
+		return nil
+	case streamer.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	}
+	return fmt.Errorf("unknown Streamer edge %s", name)
+}
+
+// StreamingPlatformMutation represents an operation that mutates the StreamingPlatform nodes in the graph.
+type StreamingPlatformMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	_type         *string
+	name          *string
+	description   *string
+	base_url      *string
+	logo_url      *string
+	enabled       *bool
+	priority      *int
+	addpriority   *int
+	metadata      *map[string]string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*StreamingPlatform, error)
+	predicates    []predicate.StreamingPlatform
+}
+
+var _ ent.Mutation = (*StreamingPlatformMutation)(nil)
+
+// streamingplatformOption allows management of the mutation configuration using functional options.
+type streamingplatformOption func(*StreamingPlatformMutation)
+
+// newStreamingPlatformMutation creates new mutation for the StreamingPlatform entity.
+func newStreamingPlatformMutation(c config, op Op, opts ...streamingplatformOption) *StreamingPlatformMutation {
+	m := &StreamingPlatformMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStreamingPlatform,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStreamingPlatformID sets the ID field of the mutation.
+func withStreamingPlatformID(id int64) streamingplatformOption {
+	return func(m *StreamingPlatformMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StreamingPlatform
+		)
+		m.oldValue = func(ctx context.Context) (*StreamingPlatform, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StreamingPlatform.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStreamingPlatform sets the old StreamingPlatform of the mutation.
+func withStreamingPlatform(node *StreamingPlatform) streamingplatformOption {
+	return func(m *StreamingPlatformMutation) {
+		m.oldValue = func(context.Context) (*StreamingPlatform, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StreamingPlatformMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StreamingPlatformMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StreamingPlatform entities.
+func (m *StreamingPlatformMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StreamingPlatformMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StreamingPlatformMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StreamingPlatform.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *StreamingPlatformMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *StreamingPlatformMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *StreamingPlatformMutation) ResetType() {
+	m._type = nil
+}
+
+// SetName sets the "name" field.
+func (m *StreamingPlatformMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StreamingPlatformMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StreamingPlatformMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *StreamingPlatformMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *StreamingPlatformMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *StreamingPlatformMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[streamingplatform.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *StreamingPlatformMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[streamingplatform.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *StreamingPlatformMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, streamingplatform.FieldDescription)
+}
+
+// SetBaseURL sets the "base_url" field.
+func (m *StreamingPlatformMutation) SetBaseURL(s string) {
+	m.base_url = &s
+}
+
+// BaseURL returns the value of the "base_url" field in the mutation.
+func (m *StreamingPlatformMutation) BaseURL() (r string, exists bool) {
+	v := m.base_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseURL returns the old "base_url" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldBaseURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseURL: %w", err)
+	}
+	return oldValue.BaseURL, nil
+}
+
+// ResetBaseURL resets all changes to the "base_url" field.
+func (m *StreamingPlatformMutation) ResetBaseURL() {
+	m.base_url = nil
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (m *StreamingPlatformMutation) SetLogoURL(s string) {
+	m.logo_url = &s
+}
+
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *StreamingPlatformMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURL returns the old "logo_url" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldLogoURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+	}
+	return oldValue.LogoURL, nil
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (m *StreamingPlatformMutation) ClearLogoURL() {
+	m.logo_url = nil
+	m.clearedFields[streamingplatform.FieldLogoURL] = struct{}{}
+}
+
+// LogoURLCleared returns if the "logo_url" field was cleared in this mutation.
+func (m *StreamingPlatformMutation) LogoURLCleared() bool {
+	_, ok := m.clearedFields[streamingplatform.FieldLogoURL]
+	return ok
+}
+
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *StreamingPlatformMutation) ResetLogoURL() {
+	m.logo_url = nil
+	delete(m.clearedFields, streamingplatform.FieldLogoURL)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *StreamingPlatformMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *StreamingPlatformMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *StreamingPlatformMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *StreamingPlatformMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *StreamingPlatformMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *StreamingPlatformMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *StreamingPlatformMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *StreamingPlatformMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *StreamingPlatformMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *StreamingPlatformMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *StreamingPlatformMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[streamingplatform.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *StreamingPlatformMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[streamingplatform.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *StreamingPlatformMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, streamingplatform.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StreamingPlatformMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StreamingPlatformMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StreamingPlatformMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StreamingPlatformMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StreamingPlatformMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the StreamingPlatform entity.
+// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StreamingPlatformMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StreamingPlatformMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the StreamingPlatformMutation builder.
+func (m *StreamingPlatformMutation) Where(ps ...predicate.StreamingPlatform) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StreamingPlatformMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StreamingPlatformMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StreamingPlatform, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StreamingPlatformMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StreamingPlatformMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StreamingPlatform).
+func (m *StreamingPlatformMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StreamingPlatformMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m._type != nil {
+		fields = append(fields, streamingplatform.FieldType)
+	}
+	if m.name != nil {
+		fields = append(fields, streamingplatform.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, streamingplatform.FieldDescription)
+	}
+	if m.base_url != nil {
+		fields = append(fields, streamingplatform.FieldBaseURL)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, streamingplatform.FieldLogoURL)
+	}
+	if m.enabled != nil {
+		fields = append(fields, streamingplatform.FieldEnabled)
+	}
+	if m.priority != nil {
+		fields = append(fields, streamingplatform.FieldPriority)
+	}
+	if m.metadata != nil {
+		fields = append(fields, streamingplatform.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, streamingplatform.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, streamingplatform.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StreamingPlatformMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case streamingplatform.FieldType:
+		return m.GetType()
+	case streamingplatform.FieldName:
+		return m.Name()
+	case streamingplatform.FieldDescription:
+		return m.Description()
+	case streamingplatform.FieldBaseURL:
+		return m.BaseURL()
+	case streamingplatform.FieldLogoURL:
+		return m.LogoURL()
+	case streamingplatform.FieldEnabled:
+		return m.Enabled()
+	case streamingplatform.FieldPriority:
+		return m.Priority()
+	case streamingplatform.FieldMetadata:
+		return m.Metadata()
+	case streamingplatform.FieldCreatedAt:
+		return m.CreatedAt()
+	case streamingplatform.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StreamingPlatformMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case streamingplatform.FieldType:
+		return m.OldType(ctx)
+	case streamingplatform.FieldName:
+		return m.OldName(ctx)
+	case streamingplatform.FieldDescription:
+		return m.OldDescription(ctx)
+	case streamingplatform.FieldBaseURL:
+		return m.OldBaseURL(ctx)
+	case streamingplatform.FieldLogoURL:
+		return m.OldLogoURL(ctx)
+	case streamingplatform.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case streamingplatform.FieldPriority:
+		return m.OldPriority(ctx)
+	case streamingplatform.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case streamingplatform.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case streamingplatform.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StreamingPlatform field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamingPlatformMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case streamingplatform.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case streamingplatform.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case streamingplatform.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case streamingplatform.FieldBaseURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseURL(v)
+		return nil
+	case streamingplatform.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
+	case streamingplatform.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case streamingplatform.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case streamingplatform.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case streamingplatform.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case streamingplatform.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StreamingPlatform field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StreamingPlatformMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, streamingplatform.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StreamingPlatformMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case streamingplatform.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StreamingPlatformMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case streamingplatform.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StreamingPlatform numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StreamingPlatformMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(streamingplatform.FieldDescription) {
+		fields = append(fields, streamingplatform.FieldDescription)
+	}
+	if m.FieldCleared(streamingplatform.FieldLogoURL) {
+		fields = append(fields, streamingplatform.FieldLogoURL)
+	}
+	if m.FieldCleared(streamingplatform.FieldMetadata) {
+		fields = append(fields, streamingplatform.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StreamingPlatformMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StreamingPlatformMutation) ClearField(name string) error {
+	switch name {
+	case streamingplatform.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case streamingplatform.FieldLogoURL:
+		m.ClearLogoURL()
+		return nil
+	case streamingplatform.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamingPlatform nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StreamingPlatformMutation) ResetField(name string) error {
+	switch name {
+	case streamingplatform.FieldType:
+		m.ResetType()
+		return nil
+	case streamingplatform.FieldName:
+		m.ResetName()
+		return nil
+	case streamingplatform.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case streamingplatform.FieldBaseURL:
+		m.ResetBaseURL()
+		return nil
+	case streamingplatform.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
+	case streamingplatform.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case streamingplatform.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case streamingplatform.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case streamingplatform.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case streamingplatform.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StreamingPlatform field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StreamingPlatformMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StreamingPlatformMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StreamingPlatformMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StreamingPlatformMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StreamingPlatformMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StreamingPlatformMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StreamingPlatformMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StreamingPlatform unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StreamingPlatformMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StreamingPlatform edge %s", name)
+}
+
+// SystemSettingMutation represents an operation that mutates the SystemSetting nodes in the graph.
+type SystemSettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	key           *string
+	value         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SystemSetting, error)
+	predicates    []predicate.SystemSetting
+}
+
+var _ ent.Mutation = (*SystemSettingMutation)(nil)
+
+// systemsettingOption allows management of the mutation configuration using functional options.
+type systemsettingOption func(*SystemSettingMutation)
+
+// newSystemSettingMutation creates new mutation for the SystemSetting entity.
+func newSystemSettingMutation(c config, op Op, opts ...systemsettingOption) *SystemSettingMutation {
+	m := &SystemSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSystemSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSystemSettingID sets the ID field of the mutation.
+func withSystemSettingID(id int64) systemsettingOption {
+	return func(m *SystemSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SystemSetting
+		)
+		m.oldValue = func(ctx context.Context) (*SystemSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SystemSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSystemSetting sets the old SystemSetting of the mutation.
+func withSystemSetting(node *SystemSetting) systemsettingOption {
+	return func(m *SystemSettingMutation) {
+		m.oldValue = func(context.Context) (*SystemSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SystemSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SystemSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SystemSetting entities.
+func (m *SystemSettingMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SystemSettingMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SystemSettingMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SystemSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *SystemSettingMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SystemSettingMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the SystemSetting entity.
+// If the SystemSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemSettingMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SystemSettingMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SystemSettingMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SystemSettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the SystemSetting entity.
+// If the SystemSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemSettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SystemSettingMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SystemSettingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SystemSettingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SystemSetting entity.
+// If the SystemSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SystemSettingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SystemSettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SystemSettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SystemSetting entity.
+// If the SystemSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemSettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SystemSettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SystemSettingMutation builder.
+func (m *SystemSettingMutation) Where(ps ...predicate.SystemSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SystemSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SystemSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SystemSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SystemSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SystemSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SystemSetting).
+func (m *SystemSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SystemSettingMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key != nil {
+		fields = append(fields, systemsetting.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, systemsetting.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, systemsetting.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, systemsetting.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SystemSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case systemsetting.FieldKey:
+		return m.Key()
+	case systemsetting.FieldValue:
+		return m.Value()
+	case systemsetting.FieldCreatedAt:
+		return m.CreatedAt()
+	case systemsetting.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SystemSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case systemsetting.FieldKey:
+		return m.OldKey(ctx)
+	case systemsetting.FieldValue:
+		return m.OldValue(ctx)
+	case systemsetting.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case systemsetting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SystemSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case systemsetting.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case systemsetting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case systemsetting.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case systemsetting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SystemSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SystemSettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SystemSettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SystemSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SystemSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SystemSettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SystemSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SystemSettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SystemSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SystemSettingMutation) ResetField(name string) error {
+	switch name {
+	case systemsetting.FieldKey:
+		m.ResetKey()
+		return nil
+	case systemsetting.FieldValue:
+		m.ResetValue()
+		return nil
+	case systemsetting.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case systemsetting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SystemSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SystemSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SystemSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SystemSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SystemSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SystemSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SystemSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SystemSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SystemSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SystemSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SystemSetting edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *int64
+	username                       *string
+	email                          *string
+	password                       *string
+	locale                         *string
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	followed_streamers             map[int64]struct{}
+	removedfollowed_streamers      map[int64]struct{}
+	clearedfollowed_streamers      bool
+	notification_channels          map[int64]struct{}
+	removednotification_channels   map[int64]struct{}
+	clearednotification_channels   bool
+	web_push_subscriptions         map[int64]struct{}
+	removedweb_push_subscriptions  map[int64]struct{}
+	clearedweb_push_subscriptions  bool
+	notification_deliveries        map[int64]struct{}
+	removednotification_deliveries map[int64]struct{}
+	clearednotification_deliveries bool
+	inbox_messages                 map[int64]struct{}
+	removedinbox_messages          map[int64]struct{}
+	clearedinbox_messages          bool
+	notification_preference        *int64
+	clearednotification_preference bool
+	done                           bool
+	oldValue                       func(context.Context) (*User, error)
+	predicates                     []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int64) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetLocale sets the "locale" field.
+func (m *UserMutation) SetLocale(s string) {
+	m.locale = &s
+}
+
+// Locale returns the value of the "locale" field in the mutation.
+func (m *UserMutation) Locale() (r string, exists bool) {
+	v := m.locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocale returns the old "locale" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLocale(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
+	}
+	return oldValue.Locale, nil
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (m *UserMutation) ClearLocale() {
+	m.locale = nil
+	m.clearedFields[user.FieldLocale] = struct{}{}
+}
+
+// LocaleCleared returns if the "locale" field was cleared in this mutation.
+func (m *UserMutation) LocaleCleared() bool {
+	_, ok := m.clearedFields[user.FieldLocale]
+	return ok
+}
+
+// ResetLocale resets all changes to the "locale" field.
+func (m *UserMutation) ResetLocale() {
+	m.locale = nil
+	delete(m.clearedFields, user.FieldLocale)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddFollowedStreamerIDs adds the "followed_streamers" edge to the UserFollowedStreamer entity by ids.
+func (m *UserMutation) AddFollowedStreamerIDs(ids ...int64) {
+	if m.followed_streamers == nil {
+		m.followed_streamers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.followed_streamers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowedStreamers clears the "followed_streamers" edge to the UserFollowedStreamer entity.
+func (m *UserMutation) ClearFollowedStreamers() {
+	m.clearedfollowed_streamers = true
+}
+
+// FollowedStreamersCleared reports if the "followed_streamers" edge to the UserFollowedStreamer entity was cleared.
+func (m *UserMutation) FollowedStreamersCleared() bool {
+	return m.clearedfollowed_streamers
+}
+
+// RemoveFollowedStreamerIDs removes the "followed_streamers" edge to the UserFollowedStreamer entity by IDs.
+func (m *UserMutation) RemoveFollowedStreamerIDs(ids ...int64) {
+	if m.removedfollowed_streamers == nil {
+		m.removedfollowed_streamers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.followed_streamers, ids[i])
+		m.removedfollowed_streamers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowedStreamers returns the removed IDs of the "followed_streamers" edge to the UserFollowedStreamer entity.
+func (m *UserMutation) RemovedFollowedStreamersIDs() (ids []int64) {
+	for id := range m.removedfollowed_streamers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowedStreamersIDs returns the "followed_streamers" edge IDs in the mutation.
+func (m *UserMutation) FollowedStreamersIDs() (ids []int64) {
+	for id := range m.followed_streamers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowedStreamers resets all changes to the "followed_streamers" edge.
+func (m *UserMutation) ResetFollowedStreamers() {
+	m.followed_streamers = nil
+	m.clearedfollowed_streamers = false
+	m.removedfollowed_streamers = nil
+}
+
+// AddNotificationChannelIDs adds the "notification_channels" edge to the NotificationChannel entity by ids.
+func (m *UserMutation) AddNotificationChannelIDs(ids ...int64) {
+	if m.notification_channels == nil {
+		m.notification_channels = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.notification_channels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotificationChannels clears the "notification_channels" edge to the NotificationChannel entity.
+func (m *UserMutation) ClearNotificationChannels() {
+	m.clearednotification_channels = true
+}
+
+// NotificationChannelsCleared reports if the "notification_channels" edge to the NotificationChannel entity was cleared.
+func (m *UserMutation) NotificationChannelsCleared() bool {
+	return m.clearednotification_channels
+}
+
+// RemoveNotificationChannelIDs removes the "notification_channels" edge to the NotificationChannel entity by IDs.
+func (m *UserMutation) RemoveNotificationChannelIDs(ids ...int64) {
+	if m.removednotification_channels == nil {
+		m.removednotification_channels = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.notification_channels, ids[i])
+		m.removednotification_channels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotificationChannels returns the removed IDs of the "notification_channels" edge to the NotificationChannel entity.
+func (m *UserMutation) RemovedNotificationChannelsIDs() (ids []int64) {
+	for id := range m.removednotification_channels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationChannelsIDs returns the "notification_channels" edge IDs in the mutation.
+func (m *UserMutation) NotificationChannelsIDs() (ids []int64) {
+	for id := range m.notification_channels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotificationChannels resets all changes to the "notification_channels" edge.
+func (m *UserMutation) ResetNotificationChannels() {
+	m.notification_channels = nil
+	m.clearednotification_channels = false
+	m.removednotification_channels = nil
+}
+
+// AddWebPushSubscriptionIDs adds the "web_push_subscriptions" edge to the WebPushSubscription entity by ids.
+func (m *UserMutation) AddWebPushSubscriptionIDs(ids ...int64) {
+	if m.web_push_subscriptions == nil {
+		m.web_push_subscriptions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.web_push_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebPushSubscriptions clears the "web_push_subscriptions" edge to the WebPushSubscription entity.
+func (m *UserMutation) ClearWebPushSubscriptions() {
+	m.clearedweb_push_subscriptions = true
+}
+
+// WebPushSubscriptionsCleared reports if the "web_push_subscriptions" edge to the WebPushSubscription entity was cleared.
+func (m *UserMutation) WebPushSubscriptionsCleared() bool {
+	return m.clearedweb_push_subscriptions
+}
+
+// RemoveWebPushSubscriptionIDs removes the "web_push_subscriptions" edge to the WebPushSubscription entity by IDs.
+func (m *UserMutation) RemoveWebPushSubscriptionIDs(ids ...int64) {
+	if m.removedweb_push_subscriptions == nil {
+		m.removedweb_push_subscriptions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.web_push_subscriptions, ids[i])
+		m.removedweb_push_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebPushSubscriptions returns the removed IDs of the "web_push_subscriptions" edge to the WebPushSubscription entity.
+func (m *UserMutation) RemovedWebPushSubscriptionsIDs() (ids []int64) {
+	for id := range m.removedweb_push_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebPushSubscriptionsIDs returns the "web_push_subscriptions" edge IDs in the mutation.
+func (m *UserMutation) WebPushSubscriptionsIDs() (ids []int64) {
+	for id := range m.web_push_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebPushSubscriptions resets all changes to the "web_push_subscriptions" edge.
+func (m *UserMutation) ResetWebPushSubscriptions() {
+	m.web_push_subscriptions = nil
+	m.clearedweb_push_subscriptions = false
+	m.removedweb_push_subscriptions = nil
+}
+
+// AddNotificationDeliveryIDs adds the "notification_deliveries" edge to the NotificationDelivery entity by ids.
+func (m *UserMutation) AddNotificationDeliveryIDs(ids ...int64) {
+	if m.notification_deliveries == nil {
+		m.notification_deliveries = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.notification_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotificationDeliveries clears the "notification_deliveries" edge to the NotificationDelivery entity.
+func (m *UserMutation) ClearNotificationDeliveries() {
+	m.clearednotification_deliveries = true
+}
+
+// NotificationDeliveriesCleared reports if the "notification_deliveries" edge to the NotificationDelivery entity was cleared.
+func (m *UserMutation) NotificationDeliveriesCleared() bool {
+	return m.clearednotification_deliveries
+}
+
+// RemoveNotificationDeliveryIDs removes the "notification_deliveries" edge to the NotificationDelivery entity by IDs.
+func (m *UserMutation) RemoveNotificationDeliveryIDs(ids ...int64) {
+	if m.removednotification_deliveries == nil {
+		m.removednotification_deliveries = make(map[int64]struct{})
 	}
-	if m.platform_streamer_id != nil {
-		fields = append(fields, streamer.FieldPlatformStreamerID)
+	for i := range ids {
+		delete(m.notification_deliveries, ids[i])
+		m.removednotification_deliveries[ids[i]] = struct{}{}
 	}
-	if m.display_name != nil {
-		fields = append(fields, streamer.FieldDisplayName)
+}
+
+// RemovedNotificationDeliveries returns the removed IDs of the "notification_deliveries" edge to the NotificationDelivery entity.
+func (m *UserMutation) RemovedNotificationDeliveriesIDs() (ids []int64) {
+	for id := range m.removednotification_deliveries {
+		ids = append(ids, id)
 	}
-	if m.avatar_url != nil {
-		fields = append(fields, streamer.FieldAvatarURL)
+	return
+}
+
+// NotificationDeliveriesIDs returns the "notification_deliveries" edge IDs in the mutation.
+func (m *UserMutation) NotificationDeliveriesIDs() (ids []int64) {
+	for id := range m.notification_deliveries {
+		ids = append(ids, id)
 	}
-	if m.room_url != nil {
-		fields = append(fields, streamer.FieldRoomURL)
+	return
+}
+
+// ResetNotificationDeliveries resets all changes to the "notification_deliveries" edge.
+func (m *UserMutation) ResetNotificationDeliveries() {
+	m.notification_deliveries = nil
+	m.clearednotification_deliveries = false
+	m.removednotification_deliveries = nil
+}
+
+// AddInboxMessageIDs adds the "inbox_messages" edge to the InboxMessage entity by ids.
+func (m *UserMutation) AddInboxMessageIDs(ids ...int64) {
+	if m.inbox_messages == nil {
+		m.inbox_messages = make(map[int64]struct{})
 	}
-	if m.bio != nil {
-		fields = append(fields, streamer.FieldBio)
+	for i := range ids {
+		m.inbox_messages[ids[i]] = struct{}{}
 	}
-	if m.tags != nil {
-		fields = append(fields, streamer.FieldTags)
+}
+
+// ClearInboxMessages clears the "inbox_messages" edge to the InboxMessage entity.
+func (m *UserMutation) ClearInboxMessages() {
+	m.clearedinbox_messages = true
+}
+
+// InboxMessagesCleared reports if the "inbox_messages" edge to the InboxMessage entity was cleared.
+func (m *UserMutation) InboxMessagesCleared() bool {
+	return m.clearedinbox_messages
+}
+
+// RemoveInboxMessageIDs removes the "inbox_messages" edge to the InboxMessage entity by IDs.
+func (m *UserMutation) RemoveInboxMessageIDs(ids ...int64) {
+	if m.removedinbox_messages == nil {
+		m.removedinbox_messages = make(map[int64]struct{})
 	}
-	if m.is_live != nil {
-		fields = append(fields, streamer.FieldIsLive)
+	for i := range ids {
+		delete(m.inbox_messages, ids[i])
+		m.removedinbox_messages[ids[i]] = struct{}{}
 	}
-	if m.live_title != nil {
-		fields = append(fields, streamer.FieldLiveTitle)
+}
+
+// RemovedInboxMessages returns the removed IDs of the "inbox_messages" edge to the InboxMessage entity.
+func (m *UserMutation) RemovedInboxMessagesIDs() (ids []int64) {
+	for id := range m.removedinbox_messages {
+		ids = append(ids, id)
 	}
-	if m.live_game_name != nil {
-		fields = append(fields, streamer.FieldLiveGameName)
+	return
+}
+
+// InboxMessagesIDs returns the "inbox_messages" edge IDs in the mutation.
+func (m *UserMutation) InboxMessagesIDs() (ids []int64) {
+	for id := range m.inbox_messages {
+		ids = append(ids, id)
 	}
-	if m.live_start_time != nil {
-		fields = append(fields, streamer.FieldLiveStartTime)
+	return
+}
+
+// ResetInboxMessages resets all changes to the "inbox_messages" edge.
+func (m *UserMutation) ResetInboxMessages() {
+	m.inbox_messages = nil
+	m.clearedinbox_messages = false
+	m.removedinbox_messages = nil
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by id.
+func (m *UserMutation) SetNotificationPreferenceID(id int64) {
+	m.notification_preference = &id
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (m *UserMutation) ClearNotificationPreference() {
+	m.clearednotification_preference = true
+}
+
+// NotificationPreferenceCleared reports if the "notification_preference" edge to the NotificationPreference entity was cleared.
+func (m *UserMutation) NotificationPreferenceCleared() bool {
+	return m.clearednotification_preference
+}
+
+// NotificationPreferenceID returns the "notification_preference" edge ID in the mutation.
+func (m *UserMutation) NotificationPreferenceID() (id int64, exists bool) {
+	if m.notification_preference != nil {
+		return *m.notification_preference, true
 	}
-	if m.live_viewers != nil {
-		fields = append(fields, streamer.FieldLiveViewers)
+	return
+}
+
+// NotificationPreferenceIDs returns the "notification_preference" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NotificationPreferenceID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) NotificationPreferenceIDs() (ids []int64) {
+	if id := m.notification_preference; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.live_cover_image != nil {
-		fields = append(fields, streamer.FieldLiveCoverImage)
+	return
+}
+
+// ResetNotificationPreference resets all changes to the "notification_preference" edge.
+func (m *UserMutation) ResetNotificationPreference() {
+	m.notification_preference = nil
+	m.clearednotification_preference = false
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.last_live_synced_at != nil {
-		fields = append(fields, streamer.FieldLastLiveSyncedAt)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.last_synced_at != nil {
-		fields = append(fields, streamer.FieldLastSyncedAt)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.locale != nil {
+		fields = append(fields, user.FieldLocale)
 	}
 	if m.created_at != nil {
-		fields = append(fields, streamer.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, streamer.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1933,41 +11349,19 @@ func (m *StreamerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StreamerMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case streamer.FieldPlatformType:
-		return m.PlatformType()
-	case streamer.FieldPlatformStreamerID:
-		return m.PlatformStreamerID()
-	case streamer.FieldDisplayName:
-		return m.DisplayName()
-	case streamer.FieldAvatarURL:
-		return m.AvatarURL()
-	case streamer.FieldRoomURL:
-		return m.RoomURL()
-	case streamer.FieldBio:
-		return m.Bio()
-	case streamer.FieldTags:
-		return m.Tags()
-	case streamer.FieldIsLive:
-		return m.IsLive()
-	case streamer.FieldLiveTitle:
-		return m.LiveTitle()
-	case streamer.FieldLiveGameName:
-		return m.LiveGameName()
-	case streamer.FieldLiveStartTime:
-		return m.LiveStartTime()
-	case streamer.FieldLiveViewers:
-		return m.LiveViewers()
-	case streamer.FieldLiveCoverImage:
-		return m.LiveCoverImage()
-	case streamer.FieldLastLiveSyncedAt:
-		return m.LastLiveSyncedAt()
-	case streamer.FieldLastSyncedAt:
-		return m.LastSyncedAt()
-	case streamer.FieldCreatedAt:
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldLocale:
+		return m.Locale()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case streamer.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1976,164 +11370,65 @@ func (m *StreamerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StreamerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case streamer.FieldPlatformType:
-		return m.OldPlatformType(ctx)
-	case streamer.FieldPlatformStreamerID:
-		return m.OldPlatformStreamerID(ctx)
-	case streamer.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case streamer.FieldAvatarURL:
-		return m.OldAvatarURL(ctx)
-	case streamer.FieldRoomURL:
-		return m.OldRoomURL(ctx)
-	case streamer.FieldBio:
-		return m.OldBio(ctx)
-	case streamer.FieldTags:
-		return m.OldTags(ctx)
-	case streamer.FieldIsLive:
-		return m.OldIsLive(ctx)
-	case streamer.FieldLiveTitle:
-		return m.OldLiveTitle(ctx)
-	case streamer.FieldLiveGameName:
-		return m.OldLiveGameName(ctx)
-	case streamer.FieldLiveStartTime:
-		return m.OldLiveStartTime(ctx)
-	case streamer.FieldLiveViewers:
-		return m.OldLiveViewers(ctx)
-	case streamer.FieldLiveCoverImage:
-		return m.OldLiveCoverImage(ctx)
-	case streamer.FieldLastLiveSyncedAt:
-		return m.OldLastLiveSyncedAt(ctx)
-	case streamer.FieldLastSyncedAt:
-		return m.OldLastSyncedAt(ctx)
-	case streamer.FieldCreatedAt:
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldLocale:
+		return m.OldLocale(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case streamer.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Streamer field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *StreamerMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case streamer.FieldPlatformType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatformType(v)
-		return nil
-	case streamer.FieldPlatformStreamerID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatformStreamerID(v)
-		return nil
-	case streamer.FieldDisplayName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayName(v)
-		return nil
-	case streamer.FieldAvatarURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatarURL(v)
-		return nil
-	case streamer.FieldRoomURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRoomURL(v)
-		return nil
-	case streamer.FieldBio:
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBio(v)
-		return nil
-	case streamer.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
-		return nil
-	case streamer.FieldIsLive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsLive(v)
+		m.SetUsername(v)
 		return nil
-	case streamer.FieldLiveTitle:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLiveTitle(v)
+		m.SetEmail(v)
 		return nil
-	case streamer.FieldLiveGameName:
+	case user.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLiveGameName(v)
-		return nil
-	case streamer.FieldLiveStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLiveStartTime(v)
-		return nil
-	case streamer.FieldLiveViewers:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLiveViewers(v)
+		m.SetPassword(v)
 		return nil
-	case streamer.FieldLiveCoverImage:
+	case user.FieldLocale:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLiveCoverImage(v)
-		return nil
-	case streamer.FieldLastLiveSyncedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastLiveSyncedAt(v)
-		return nil
-	case streamer.FieldLastSyncedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastSyncedAt(v)
+		m.SetLocale(v)
 		return nil
-	case streamer.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case streamer.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2141,232 +11436,203 @@ func (m *StreamerMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Streamer field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StreamerMutation) AddedFields() []string {
-	var fields []string
-	if m.addlive_viewers != nil {
-		fields = append(fields, streamer.FieldLiveViewers)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StreamerMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case streamer.FieldLiveViewers:
-		return m.AddedLiveViewers()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StreamerMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case streamer.FieldLiveViewers:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLiveViewers(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Streamer numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StreamerMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(streamer.FieldAvatarURL) {
-		fields = append(fields, streamer.FieldAvatarURL)
-	}
-	if m.FieldCleared(streamer.FieldRoomURL) {
-		fields = append(fields, streamer.FieldRoomURL)
-	}
-	if m.FieldCleared(streamer.FieldBio) {
-		fields = append(fields, streamer.FieldBio)
-	}
-	if m.FieldCleared(streamer.FieldTags) {
-		fields = append(fields, streamer.FieldTags)
-	}
-	if m.FieldCleared(streamer.FieldLiveTitle) {
-		fields = append(fields, streamer.FieldLiveTitle)
-	}
-	if m.FieldCleared(streamer.FieldLiveGameName) {
-		fields = append(fields, streamer.FieldLiveGameName)
-	}
-	if m.FieldCleared(streamer.FieldLiveStartTime) {
-		fields = append(fields, streamer.FieldLiveStartTime)
-	}
-	if m.FieldCleared(streamer.FieldLiveViewers) {
-		fields = append(fields, streamer.FieldLiveViewers)
-	}
-	if m.FieldCleared(streamer.FieldLiveCoverImage) {
-		fields = append(fields, streamer.FieldLiveCoverImage)
-	}
-	if m.FieldCleared(streamer.FieldLastLiveSyncedAt) {
-		fields = append(fields, streamer.FieldLastLiveSyncedAt)
-	}
-	if m.FieldCleared(streamer.FieldLastSyncedAt) {
-		fields = append(fields, streamer.FieldLastSyncedAt)
+	if m.FieldCleared(user.FieldLocale) {
+		fields = append(fields, user.FieldLocale)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StreamerMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StreamerMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case streamer.FieldAvatarURL:
-		m.ClearAvatarURL()
-		return nil
-	case streamer.FieldRoomURL:
-		m.ClearRoomURL()
-		return nil
-	case streamer.FieldBio:
-		m.ClearBio()
-		return nil
-	case streamer.FieldTags:
-		m.ClearTags()
-		return nil
-	case streamer.FieldLiveTitle:
-		m.ClearLiveTitle()
-		return nil
-	case streamer.FieldLiveGameName:
-		m.ClearLiveGameName()
-		return nil
-	case streamer.FieldLiveStartTime:
-		m.ClearLiveStartTime()
-		return nil
-	case streamer.FieldLiveViewers:
-		m.ClearLiveViewers()
-		return nil
-	case streamer.FieldLiveCoverImage:
-		m.ClearLiveCoverImage()
-		return nil
-	case streamer.FieldLastLiveSyncedAt:
-		m.ClearLastLiveSyncedAt()
-		return nil
-	case streamer.FieldLastSyncedAt:
-		m.ClearLastSyncedAt()
+	case user.FieldLocale:
+		m.ClearLocale()
 		return nil
 	}
-	return fmt.Errorf("unknown Streamer nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StreamerMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case streamer.FieldPlatformType:
-		m.ResetPlatformType()
-		return nil
-	case streamer.FieldPlatformStreamerID:
-		m.ResetPlatformStreamerID()
-		return nil
-	case streamer.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case streamer.FieldAvatarURL:
-		m.ResetAvatarURL()
-		return nil
-	case streamer.FieldRoomURL:
-		m.ResetRoomURL()
-		return nil
-	case streamer.FieldBio:
-		m.ResetBio()
-		return nil
-	case streamer.FieldTags:
-		m.ResetTags()
-		return nil
-	case streamer.FieldIsLive:
-		m.ResetIsLive()
-		return nil
-	case streamer.FieldLiveTitle:
-		m.ResetLiveTitle()
-		return nil
-	case streamer.FieldLiveGameName:
-		m.ResetLiveGameName()
-		return nil
-	case streamer.FieldLiveStartTime:
-		m.ResetLiveStartTime()
-		return nil
-	case streamer.FieldLiveViewers:
-		m.ResetLiveViewers()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case streamer.FieldLiveCoverImage:
-		m.ResetLiveCoverImage()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case streamer.FieldLastLiveSyncedAt:
-		m.ResetLastLiveSyncedAt()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case streamer.FieldLastSyncedAt:
-		m.ResetLastSyncedAt()
+	case user.FieldLocale:
+		m.ResetLocale()
 		return nil
-	case streamer.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case streamer.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Streamer field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StreamerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.followers != nil {
-		edges = append(edges, streamer.EdgeFollowers)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.followed_streamers != nil {
+		edges = append(edges, user.EdgeFollowedStreamers)
+	}
+	if m.notification_channels != nil {
+		edges = append(edges, user.EdgeNotificationChannels)
+	}
+	if m.web_push_subscriptions != nil {
+		edges = append(edges, user.EdgeWebPushSubscriptions)
+	}
+	if m.notification_deliveries != nil {
+		edges = append(edges, user.EdgeNotificationDeliveries)
+	}
+	if m.inbox_messages != nil {
+		edges = append(edges, user.EdgeInboxMessages)
+	}
+	if m.notification_preference != nil {
+		edges = append(edges, user.EdgeNotificationPreference)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StreamerMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case streamer.EdgeFollowers:
-		ids := make([]ent.Value, 0, len(m.followers))
-		for id := range m.followers {
+	case user.EdgeFollowedStreamers:
+		ids := make([]ent.Value, 0, len(m.followed_streamers))
+		for id := range m.followed_streamers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationChannels:
+		ids := make([]ent.Value, 0, len(m.notification_channels))
+		for id := range m.notification_channels {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebPushSubscriptions:
+		ids := make([]ent.Value, 0, len(m.web_push_subscriptions))
+		for id := range m.web_push_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationDeliveries:
+		ids := make([]ent.Value, 0, len(m.notification_deliveries))
+		for id := range m.notification_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInboxMessages:
+		ids := make([]ent.Value, 0, len(m.inbox_messages))
+		for id := range m.inbox_messages {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeNotificationPreference:
+		if id := m.notification_preference; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StreamerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedfollowers != nil {
-		edges = append(edges, streamer.EdgeFollowers)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedfollowed_streamers != nil {
+		edges = append(edges, user.EdgeFollowedStreamers)
+	}
+	if m.removednotification_channels != nil {
+		edges = append(edges, user.EdgeNotificationChannels)
+	}
+	if m.removedweb_push_subscriptions != nil {
+		edges = append(edges, user.EdgeWebPushSubscriptions)
+	}
+	if m.removednotification_deliveries != nil {
+		edges = append(edges, user.EdgeNotificationDeliveries)
+	}
+	if m.removedinbox_messages != nil {
+		edges = append(edges, user.EdgeInboxMessages)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StreamerMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case streamer.EdgeFollowers:
-		ids := make([]ent.Value, 0, len(m.removedfollowers))
-		for id := range m.removedfollowers {
+	case user.EdgeFollowedStreamers:
+		ids := make([]ent.Value, 0, len(m.removedfollowed_streamers))
+		for id := range m.removedfollowed_streamers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationChannels:
+		ids := make([]ent.Value, 0, len(m.removednotification_channels))
+		for id := range m.removednotification_channels {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebPushSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedweb_push_subscriptions))
+		for id := range m.removedweb_push_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationDeliveries:
+		ids := make([]ent.Value, 0, len(m.removednotification_deliveries))
+		for id := range m.removednotification_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInboxMessages:
+		ids := make([]ent.Value, 0, len(m.removedinbox_messages))
+		for id := range m.removedinbox_messages {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2375,77 +11641,136 @@ func (m *StreamerMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StreamerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfollowers {
-		edges = append(edges, streamer.EdgeFollowers)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedfollowed_streamers {
+		edges = append(edges, user.EdgeFollowedStreamers)
+	}
+	if m.clearednotification_channels {
+		edges = append(edges, user.EdgeNotificationChannels)
+	}
+	if m.clearedweb_push_subscriptions {
+		edges = append(edges, user.EdgeWebPushSubscriptions)
+	}
+	if m.clearednotification_deliveries {
+		edges = append(edges, user.EdgeNotificationDeliveries)
+	}
+	if m.clearedinbox_messages {
+		edges = append(edges, user.EdgeInboxMessages)
+	}
+	if m.clearednotification_preference {
+		edges = append(edges, user.EdgeNotificationPreference)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StreamerMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case streamer.EdgeFollowers:
-		return m.clearedfollowers
+	case user.EdgeFollowedStreamers:
+		return m.clearedfollowed_streamers
+	case user.EdgeNotificationChannels:
+		return m.clearednotification_channels
+	case user.EdgeWebPushSubscriptions:
+		return m.clearedweb_push_subscriptions
+	case user.EdgeNotificationDeliveries:
+		return m.clearednotification_deliveries
+	case user.EdgeInboxMessages:
+		return m.clearedinbox_messages
+	case user.EdgeNotificationPreference:
+		return m.clearednotification_preference
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StreamerMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
+	case user.EdgeNotificationPreference:
+		m.ClearNotificationPreference()
+		return nil
 	}
-	return fmt.Errorf("unknown Streamer unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StreamerMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case streamer.EdgeFollowers:
-		m.ResetFollowers()
+	case user.EdgeFollowedStreamers:
+		m.ResetFollowedStreamers()
+		return nil
+	case user.EdgeNotificationChannels:
+		m.ResetNotificationChannels()
+		return nil
+	case user.EdgeWebPushSubscriptions:
+		m.ResetWebPushSubscriptions()
+		return nil
+	case user.EdgeNotificationDeliveries:
+		m.ResetNotificationDeliveries()
+		return nil
+	case user.EdgeInboxMessages:
+		m.ResetInboxMessages()
+		return nil
+	case user.EdgeNotificationPreference:
+		m.ResetNotificationPreference()
 		return nil
 	}
-	return fmt.Errorf("unknown Streamer edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// StreamingPlatformMutation represents an operation that mutates the StreamingPlatform nodes in the graph.
-type StreamingPlatformMutation struct {
+// UserFollowedStreamerMutation represents an operation that mutates the UserFollowedStreamer nodes in the graph.
+type UserFollowedStreamerMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	_type         *string
-	name          *string
-	description   *string
-	base_url      *string
-	logo_url      *string
-	enabled       *bool
-	priority      *int
-	addpriority   *int
-	metadata      *map[string]string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*StreamingPlatform, error)
-	predicates    []predicate.StreamingPlatform
+	op                             Op
+	typ                            string
+	id                             *int64
+	alias                          *string
+	notes                          *string
+	notifications_enabled          *bool
+	notification_channel_ids       *[]int64
+	appendnotification_channel_ids []int64
+	title_template                 *string
+	body_template                  *string
+	routing_mode                   *string
+	escalate_after_seconds         *int64
+	addescalate_after_seconds      *int64
+	always_notify                  *bool
+	notify_stream_end              *bool
+	notify_title_change            *bool
+	notify_categories              *[]string
+	appendnotify_categories        []string
+	notification_filter            *map[string]interface{}
+	channel_overrides              *map[int64]map[string]interface{}
+	last_notification_sent_at      *time.Time
+	snoozed_until                  *time.Time
+	muted_session_id               *int64
+	addmuted_session_id            *int64
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	user                           *int64
+	cleareduser                    bool
+	streamer                       *int64
+	clearedstreamer                bool
+	done                           bool
+	oldValue                       func(context.Context) (*UserFollowedStreamer, error)
+	predicates                     []predicate.UserFollowedStreamer
 }
 
-var _ ent.Mutation = (*StreamingPlatformMutation)(nil)
+var _ ent.Mutation = (*UserFollowedStreamerMutation)(nil)
 
-// streamingplatformOption allows management of the mutation configuration using functional options.
-type streamingplatformOption func(*StreamingPlatformMutation)
+// userfollowedstreamerOption allows management of the mutation configuration using functional options.
+type userfollowedstreamerOption func(*UserFollowedStreamerMutation)
 
-// newStreamingPlatformMutation creates new mutation for the StreamingPlatform entity.
-func newStreamingPlatformMutation(c config, op Op, opts ...streamingplatformOption) *StreamingPlatformMutation {
-	m := &StreamingPlatformMutation{
+// newUserFollowedStreamerMutation creates new mutation for the UserFollowedStreamer entity.
+func newUserFollowedStreamerMutation(c config, op Op, opts ...userfollowedstreamerOption) *UserFollowedStreamerMutation {
+	m := &UserFollowedStreamerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStreamingPlatform,
+		typ:           TypeUserFollowedStreamer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2454,20 +11779,20 @@ func newStreamingPlatformMutation(c config, op Op, opts ...streamingplatformOpti
 	return m
 }
 
-// withStreamingPlatformID sets the ID field of the mutation.
-func withStreamingPlatformID(id int64) streamingplatformOption {
-	return func(m *StreamingPlatformMutation) {
+// withUserFollowedStreamerID sets the ID field of the mutation.
+func withUserFollowedStreamerID(id int64) userfollowedstreamerOption {
+	return func(m *UserFollowedStreamerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StreamingPlatform
+			value *UserFollowedStreamer
 		)
-		m.oldValue = func(ctx context.Context) (*StreamingPlatform, error) {
+		m.oldValue = func(ctx context.Context) (*UserFollowedStreamer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().StreamingPlatform.Get(ctx, id)
+					value, err = m.Client().UserFollowedStreamer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2476,10 +11801,10 @@ func withStreamingPlatformID(id int64) streamingplatformOption {
 	}
 }
 
-// withStreamingPlatform sets the old StreamingPlatform of the mutation.
-func withStreamingPlatform(node *StreamingPlatform) streamingplatformOption {
-	return func(m *StreamingPlatformMutation) {
-		m.oldValue = func(context.Context) (*StreamingPlatform, error) {
+// withUserFollowedStreamer sets the old UserFollowedStreamer of the mutation.
+func withUserFollowedStreamer(node *UserFollowedStreamer) userfollowedstreamerOption {
+	return func(m *UserFollowedStreamerMutation) {
+		m.oldValue = func(context.Context) (*UserFollowedStreamer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2488,7 +11813,7 @@ func withStreamingPlatform(node *StreamingPlatform) streamingplatformOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StreamingPlatformMutation) Client() *Client {
+func (m UserFollowedStreamerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2496,7 +11821,7 @@ func (m StreamingPlatformMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StreamingPlatformMutation) Tx() (*Tx, error) {
+func (m UserFollowedStreamerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2506,14 +11831,14 @@ func (m StreamingPlatformMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of StreamingPlatform entities.
-func (m *StreamingPlatformMutation) SetID(id int64) {
+// operation is only accepted on creation of UserFollowedStreamer entities.
+func (m *UserFollowedStreamerMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StreamingPlatformMutation) ID() (id int64, exists bool) {
+func (m *UserFollowedStreamerMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2524,7 +11849,7 @@ func (m *StreamingPlatformMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StreamingPlatformMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *UserFollowedStreamerMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2533,1041 +11858,946 @@ func (m *StreamingPlatformMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StreamingPlatform.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserFollowedStreamer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *StreamingPlatformMutation) SetType(s string) {
-	m._type = &s
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *StreamingPlatformMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *StreamingPlatformMutation) ResetType() {
-	m._type = nil
-}
-
-// SetName sets the "name" field.
-func (m *StreamingPlatformMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *StreamingPlatformMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *StreamingPlatformMutation) ResetName() {
-	m.name = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *StreamingPlatformMutation) SetDescription(s string) {
-	m.description = &s
+// SetUserID sets the "user_id" field.
+func (m *UserFollowedStreamerMutation) SetUserID(i int64) {
+	m.user = &i
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *StreamingPlatformMutation) Description() (r string, exists bool) {
-	v := m.description
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserFollowedStreamerMutation) UserID() (r int64, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldDescription returns the old "description" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+
+// OldUserID returns the old "user_id" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *UserFollowedStreamerMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *StreamingPlatformMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[streamingplatform.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *StreamingPlatformMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[streamingplatform.FieldDescription]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *StreamingPlatformMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, streamingplatform.FieldDescription)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserFollowedStreamerMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetBaseURL sets the "base_url" field.
-func (m *StreamingPlatformMutation) SetBaseURL(s string) {
-	m.base_url = &s
+// SetStreamerID sets the "streamer_id" field.
+func (m *UserFollowedStreamerMutation) SetStreamerID(i int64) {
+	m.streamer = &i
 }
 
-// BaseURL returns the value of the "base_url" field in the mutation.
-func (m *StreamingPlatformMutation) BaseURL() (r string, exists bool) {
-	v := m.base_url
+// StreamerID returns the value of the "streamer_id" field in the mutation.
+func (m *UserFollowedStreamerMutation) StreamerID() (r int64, exists bool) {
+	v := m.streamer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseURL returns the old "base_url" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldStreamerID returns the old "streamer_id" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldBaseURL(ctx context.Context) (v string, err error) {
+func (m *UserFollowedStreamerMutation) OldStreamerID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldStreamerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseURL requires an ID field in the mutation")
+		return v, errors.New("OldStreamerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldStreamerID: %w", err)
 	}
-	return oldValue.BaseURL, nil
+	return oldValue.StreamerID, nil
 }
 
-// ResetBaseURL resets all changes to the "base_url" field.
-func (m *StreamingPlatformMutation) ResetBaseURL() {
-	m.base_url = nil
+// ResetStreamerID resets all changes to the "streamer_id" field.
+func (m *UserFollowedStreamerMutation) ResetStreamerID() {
+	m.streamer = nil
 }
 
-// SetLogoURL sets the "logo_url" field.
-func (m *StreamingPlatformMutation) SetLogoURL(s string) {
-	m.logo_url = &s
+// SetAlias sets the "alias" field.
+func (m *UserFollowedStreamerMutation) SetAlias(s string) {
+	m.alias = &s
 }
 
-// LogoURL returns the value of the "logo_url" field in the mutation.
-func (m *StreamingPlatformMutation) LogoURL() (r string, exists bool) {
-	v := m.logo_url
+// Alias returns the value of the "alias" field in the mutation.
+func (m *UserFollowedStreamerMutation) Alias() (r string, exists bool) {
+	v := m.alias
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogoURL returns the old "logo_url" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldAlias returns the old "alias" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldLogoURL(ctx context.Context) (v *string, err error) {
+func (m *UserFollowedStreamerMutation) OldAlias(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+		return v, errors.New("OldAlias requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
 	}
-	return oldValue.LogoURL, nil
+	return oldValue.Alias, nil
 }
 
-// ClearLogoURL clears the value of the "logo_url" field.
-func (m *StreamingPlatformMutation) ClearLogoURL() {
-	m.logo_url = nil
-	m.clearedFields[streamingplatform.FieldLogoURL] = struct{}{}
+// ClearAlias clears the value of the "alias" field.
+func (m *UserFollowedStreamerMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[userfollowedstreamer.FieldAlias] = struct{}{}
 }
 
-// LogoURLCleared returns if the "logo_url" field was cleared in this mutation.
-func (m *StreamingPlatformMutation) LogoURLCleared() bool {
-	_, ok := m.clearedFields[streamingplatform.FieldLogoURL]
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldAlias]
 	return ok
 }
 
-// ResetLogoURL resets all changes to the "logo_url" field.
-func (m *StreamingPlatformMutation) ResetLogoURL() {
-	m.logo_url = nil
-	delete(m.clearedFields, streamingplatform.FieldLogoURL)
+// ResetAlias resets all changes to the "alias" field.
+func (m *UserFollowedStreamerMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldAlias)
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *StreamingPlatformMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetNotes sets the "notes" field.
+func (m *UserFollowedStreamerMutation) SetNotes(s string) {
+	m.notes = &s
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *StreamingPlatformMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// Notes returns the value of the "notes" field in the mutation.
+func (m *UserFollowedStreamerMutation) Notes() (r string, exists bool) {
+	v := m.notes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldNotes returns the old "notes" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *UserFollowedStreamerMutation) OldNotes(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldNotes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Notes, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *StreamingPlatformMutation) ResetEnabled() {
-	m.enabled = nil
+// ClearNotes clears the value of the "notes" field.
+func (m *UserFollowedStreamerMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[userfollowedstreamer.FieldNotes] = struct{}{}
 }
 
-// SetPriority sets the "priority" field.
-func (m *StreamingPlatformMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldNotes]
+	return ok
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *StreamingPlatformMutation) Priority() (r int, exists bool) {
-	v := m.priority
+// ResetNotes resets all changes to the "notes" field.
+func (m *UserFollowedStreamerMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldNotes)
+}
+
+// SetNotificationsEnabled sets the "notifications_enabled" field.
+func (m *UserFollowedStreamerMutation) SetNotificationsEnabled(b bool) {
+	m.notifications_enabled = &b
+}
+
+// NotificationsEnabled returns the value of the "notifications_enabled" field in the mutation.
+func (m *UserFollowedStreamerMutation) NotificationsEnabled() (r bool, exists bool) {
+	v := m.notifications_enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldNotificationsEnabled returns the old "notifications_enabled" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldPriority(ctx context.Context) (v int, err error) {
+func (m *UserFollowedStreamerMutation) OldNotificationsEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotificationsEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldNotificationsEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
-	}
-	return oldValue.Priority, nil
-}
-
-// AddPriority adds i to the "priority" field.
-func (m *StreamingPlatformMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
-}
-
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *StreamingPlatformMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldNotificationsEnabled: %w", err)
 	}
-	return *v, true
+	return oldValue.NotificationsEnabled, nil
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *StreamingPlatformMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ResetNotificationsEnabled resets all changes to the "notifications_enabled" field.
+func (m *UserFollowedStreamerMutation) ResetNotificationsEnabled() {
+	m.notifications_enabled = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *StreamingPlatformMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetNotificationChannelIds sets the "notification_channel_ids" field.
+func (m *UserFollowedStreamerMutation) SetNotificationChannelIds(i []int64) {
+	m.notification_channel_ids = &i
+	m.appendnotification_channel_ids = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *StreamingPlatformMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// NotificationChannelIds returns the value of the "notification_channel_ids" field in the mutation.
+func (m *UserFollowedStreamerMutation) NotificationChannelIds() (r []int64, exists bool) {
+	v := m.notification_channel_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldNotificationChannelIds returns the old "notification_channel_ids" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *UserFollowedStreamerMutation) OldNotificationChannelIds(ctx context.Context) (v []int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotificationChannelIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldNotificationChannelIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotificationChannelIds: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.NotificationChannelIds, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *StreamingPlatformMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[streamingplatform.FieldMetadata] = struct{}{}
+// AppendNotificationChannelIds adds i to the "notification_channel_ids" field.
+func (m *UserFollowedStreamerMutation) AppendNotificationChannelIds(i []int64) {
+	m.appendnotification_channel_ids = append(m.appendnotification_channel_ids, i...)
+}
+
+// AppendedNotificationChannelIds returns the list of values that were appended to the "notification_channel_ids" field in this mutation.
+func (m *UserFollowedStreamerMutation) AppendedNotificationChannelIds() ([]int64, bool) {
+	if len(m.appendnotification_channel_ids) == 0 {
+		return nil, false
+	}
+	return m.appendnotification_channel_ids, true
+}
+
+// ClearNotificationChannelIds clears the value of the "notification_channel_ids" field.
+func (m *UserFollowedStreamerMutation) ClearNotificationChannelIds() {
+	m.notification_channel_ids = nil
+	m.appendnotification_channel_ids = nil
+	m.clearedFields[userfollowedstreamer.FieldNotificationChannelIds] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *StreamingPlatformMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[streamingplatform.FieldMetadata]
+// NotificationChannelIdsCleared returns if the "notification_channel_ids" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) NotificationChannelIdsCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldNotificationChannelIds]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *StreamingPlatformMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, streamingplatform.FieldMetadata)
+// ResetNotificationChannelIds resets all changes to the "notification_channel_ids" field.
+func (m *UserFollowedStreamerMutation) ResetNotificationChannelIds() {
+	m.notification_channel_ids = nil
+	m.appendnotification_channel_ids = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldNotificationChannelIds)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StreamingPlatformMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTitleTemplate sets the "title_template" field.
+func (m *UserFollowedStreamerMutation) SetTitleTemplate(s string) {
+	m.title_template = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StreamingPlatformMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// TitleTemplate returns the value of the "title_template" field in the mutation.
+func (m *UserFollowedStreamerMutation) TitleTemplate() (r string, exists bool) {
+	v := m.title_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldTitleTemplate returns the old "title_template" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserFollowedStreamerMutation) OldTitleTemplate(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitleTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTitleTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitleTemplate: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TitleTemplate, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StreamingPlatformMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (m *UserFollowedStreamerMutation) ClearTitleTemplate() {
+	m.title_template = nil
+	m.clearedFields[userfollowedstreamer.FieldTitleTemplate] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StreamingPlatformMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// TitleTemplateCleared returns if the "title_template" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) TitleTemplateCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldTitleTemplate]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StreamingPlatformMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetTitleTemplate resets all changes to the "title_template" field.
+func (m *UserFollowedStreamerMutation) ResetTitleTemplate() {
+	m.title_template = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldTitleTemplate)
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (m *UserFollowedStreamerMutation) SetBodyTemplate(s string) {
+	m.body_template = &s
+}
+
+// BodyTemplate returns the value of the "body_template" field in the mutation.
+func (m *UserFollowedStreamerMutation) BodyTemplate() (r string, exists bool) {
+	v := m.body_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the StreamingPlatform entity.
-// If the StreamingPlatform object wasn't provided to the builder, the object is fetched from the database.
+// OldBodyTemplate returns the old "body_template" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StreamingPlatformMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserFollowedStreamerMutation) OldBodyTemplate(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBodyTemplate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldBodyTemplate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StreamingPlatformMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the StreamingPlatformMutation builder.
-func (m *StreamingPlatformMutation) Where(ps ...predicate.StreamingPlatform) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the StreamingPlatformMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StreamingPlatformMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StreamingPlatform, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+		return v, fmt.Errorf("querying old value for OldBodyTemplate: %w", err)
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *StreamingPlatformMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *StreamingPlatformMutation) SetOp(op Op) {
-	m.op = op
+	return oldValue.BodyTemplate, nil
 }
 
-// Type returns the node type of this mutation (StreamingPlatform).
-func (m *StreamingPlatformMutation) Type() string {
-	return m.typ
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (m *UserFollowedStreamerMutation) ClearBodyTemplate() {
+	m.body_template = nil
+	m.clearedFields[userfollowedstreamer.FieldBodyTemplate] = struct{}{}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *StreamingPlatformMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m._type != nil {
-		fields = append(fields, streamingplatform.FieldType)
-	}
-	if m.name != nil {
-		fields = append(fields, streamingplatform.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, streamingplatform.FieldDescription)
-	}
-	if m.base_url != nil {
-		fields = append(fields, streamingplatform.FieldBaseURL)
-	}
-	if m.logo_url != nil {
-		fields = append(fields, streamingplatform.FieldLogoURL)
-	}
-	if m.enabled != nil {
-		fields = append(fields, streamingplatform.FieldEnabled)
-	}
-	if m.priority != nil {
-		fields = append(fields, streamingplatform.FieldPriority)
-	}
-	if m.metadata != nil {
-		fields = append(fields, streamingplatform.FieldMetadata)
-	}
-	if m.created_at != nil {
-		fields = append(fields, streamingplatform.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, streamingplatform.FieldUpdatedAt)
-	}
-	return fields
+// BodyTemplateCleared returns if the "body_template" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) BodyTemplateCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldBodyTemplate]
+	return ok
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *StreamingPlatformMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case streamingplatform.FieldType:
-		return m.GetType()
-	case streamingplatform.FieldName:
-		return m.Name()
-	case streamingplatform.FieldDescription:
-		return m.Description()
-	case streamingplatform.FieldBaseURL:
-		return m.BaseURL()
-	case streamingplatform.FieldLogoURL:
-		return m.LogoURL()
-	case streamingplatform.FieldEnabled:
-		return m.Enabled()
-	case streamingplatform.FieldPriority:
-		return m.Priority()
-	case streamingplatform.FieldMetadata:
-		return m.Metadata()
-	case streamingplatform.FieldCreatedAt:
-		return m.CreatedAt()
-	case streamingplatform.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetBodyTemplate resets all changes to the "body_template" field.
+func (m *UserFollowedStreamerMutation) ResetBodyTemplate() {
+	m.body_template = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldBodyTemplate)
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *StreamingPlatformMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case streamingplatform.FieldType:
-		return m.OldType(ctx)
-	case streamingplatform.FieldName:
-		return m.OldName(ctx)
-	case streamingplatform.FieldDescription:
-		return m.OldDescription(ctx)
-	case streamingplatform.FieldBaseURL:
-		return m.OldBaseURL(ctx)
-	case streamingplatform.FieldLogoURL:
-		return m.OldLogoURL(ctx)
-	case streamingplatform.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case streamingplatform.FieldPriority:
-		return m.OldPriority(ctx)
-	case streamingplatform.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case streamingplatform.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case streamingplatform.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown StreamingPlatform field %s", name)
+// SetRoutingMode sets the "routing_mode" field.
+func (m *UserFollowedStreamerMutation) SetRoutingMode(s string) {
+	m.routing_mode = &s
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *StreamingPlatformMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case streamingplatform.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case streamingplatform.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case streamingplatform.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case streamingplatform.FieldBaseURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBaseURL(v)
-		return nil
-	case streamingplatform.FieldLogoURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLogoURL(v)
-		return nil
-	case streamingplatform.FieldEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnabled(v)
-		return nil
-	case streamingplatform.FieldPriority:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
-	case streamingplatform.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case streamingplatform.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case streamingplatform.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// RoutingMode returns the value of the "routing_mode" field in the mutation.
+func (m *UserFollowedStreamerMutation) RoutingMode() (r string, exists bool) {
+	v := m.routing_mode
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StreamingPlatform field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *StreamingPlatformMutation) AddedFields() []string {
-	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, streamingplatform.FieldPriority)
+// OldRoutingMode returns the old "routing_mode" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldRoutingMode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutingMode is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutingMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutingMode: %w", err)
+	}
+	return oldValue.RoutingMode, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *StreamingPlatformMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case streamingplatform.FieldPriority:
-		return m.AddedPriority()
-	}
-	return nil, false
+// ClearRoutingMode clears the value of the "routing_mode" field.
+func (m *UserFollowedStreamerMutation) ClearRoutingMode() {
+	m.routing_mode = nil
+	m.clearedFields[userfollowedstreamer.FieldRoutingMode] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *StreamingPlatformMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case streamingplatform.FieldPriority:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
+// RoutingModeCleared returns if the "routing_mode" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) RoutingModeCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldRoutingMode]
+	return ok
+}
+
+// ResetRoutingMode resets all changes to the "routing_mode" field.
+func (m *UserFollowedStreamerMutation) ResetRoutingMode() {
+	m.routing_mode = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldRoutingMode)
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (m *UserFollowedStreamerMutation) SetEscalateAfterSeconds(i int64) {
+	m.escalate_after_seconds = &i
+	m.addescalate_after_seconds = nil
+}
+
+// EscalateAfterSeconds returns the value of the "escalate_after_seconds" field in the mutation.
+func (m *UserFollowedStreamerMutation) EscalateAfterSeconds() (r int64, exists bool) {
+	v := m.escalate_after_seconds
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StreamingPlatform numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *StreamingPlatformMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(streamingplatform.FieldDescription) {
-		fields = append(fields, streamingplatform.FieldDescription)
+// OldEscalateAfterSeconds returns the old "escalate_after_seconds" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldEscalateAfterSeconds(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEscalateAfterSeconds is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(streamingplatform.FieldLogoURL) {
-		fields = append(fields, streamingplatform.FieldLogoURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEscalateAfterSeconds requires an ID field in the mutation")
 	}
-	if m.FieldCleared(streamingplatform.FieldMetadata) {
-		fields = append(fields, streamingplatform.FieldMetadata)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEscalateAfterSeconds: %w", err)
 	}
-	return fields
+	return oldValue.EscalateAfterSeconds, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *StreamingPlatformMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// AddEscalateAfterSeconds adds i to the "escalate_after_seconds" field.
+func (m *UserFollowedStreamerMutation) AddEscalateAfterSeconds(i int64) {
+	if m.addescalate_after_seconds != nil {
+		*m.addescalate_after_seconds += i
+	} else {
+		m.addescalate_after_seconds = &i
+	}
+}
+
+// AddedEscalateAfterSeconds returns the value that was added to the "escalate_after_seconds" field in this mutation.
+func (m *UserFollowedStreamerMutation) AddedEscalateAfterSeconds() (r int64, exists bool) {
+	v := m.addescalate_after_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEscalateAfterSeconds clears the value of the "escalate_after_seconds" field.
+func (m *UserFollowedStreamerMutation) ClearEscalateAfterSeconds() {
+	m.escalate_after_seconds = nil
+	m.addescalate_after_seconds = nil
+	m.clearedFields[userfollowedstreamer.FieldEscalateAfterSeconds] = struct{}{}
+}
+
+// EscalateAfterSecondsCleared returns if the "escalate_after_seconds" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) EscalateAfterSecondsCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldEscalateAfterSeconds]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *StreamingPlatformMutation) ClearField(name string) error {
-	switch name {
-	case streamingplatform.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case streamingplatform.FieldLogoURL:
-		m.ClearLogoURL()
-		return nil
-	case streamingplatform.FieldMetadata:
-		m.ClearMetadata()
-		return nil
+// ResetEscalateAfterSeconds resets all changes to the "escalate_after_seconds" field.
+func (m *UserFollowedStreamerMutation) ResetEscalateAfterSeconds() {
+	m.escalate_after_seconds = nil
+	m.addescalate_after_seconds = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldEscalateAfterSeconds)
+}
+
+// SetAlwaysNotify sets the "always_notify" field.
+func (m *UserFollowedStreamerMutation) SetAlwaysNotify(b bool) {
+	m.always_notify = &b
+}
+
+// AlwaysNotify returns the value of the "always_notify" field in the mutation.
+func (m *UserFollowedStreamerMutation) AlwaysNotify() (r bool, exists bool) {
+	v := m.always_notify
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StreamingPlatform nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *StreamingPlatformMutation) ResetField(name string) error {
-	switch name {
-	case streamingplatform.FieldType:
-		m.ResetType()
-		return nil
-	case streamingplatform.FieldName:
-		m.ResetName()
-		return nil
-	case streamingplatform.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case streamingplatform.FieldBaseURL:
-		m.ResetBaseURL()
-		return nil
-	case streamingplatform.FieldLogoURL:
-		m.ResetLogoURL()
-		return nil
-	case streamingplatform.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case streamingplatform.FieldPriority:
-		m.ResetPriority()
-		return nil
-	case streamingplatform.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case streamingplatform.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case streamingplatform.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldAlwaysNotify returns the old "always_notify" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldAlwaysNotify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlwaysNotify is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown StreamingPlatform field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlwaysNotify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlwaysNotify: %w", err)
+	}
+	return oldValue.AlwaysNotify, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StreamingPlatformMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetAlwaysNotify resets all changes to the "always_notify" field.
+func (m *UserFollowedStreamerMutation) ResetAlwaysNotify() {
+	m.always_notify = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *StreamingPlatformMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SetNotifyStreamEnd sets the "notify_stream_end" field.
+func (m *UserFollowedStreamerMutation) SetNotifyStreamEnd(b bool) {
+	m.notify_stream_end = &b
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StreamingPlatformMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// NotifyStreamEnd returns the value of the "notify_stream_end" field in the mutation.
+func (m *UserFollowedStreamerMutation) NotifyStreamEnd() (r bool, exists bool) {
+	v := m.notify_stream_end
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *StreamingPlatformMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldNotifyStreamEnd returns the old "notify_stream_end" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldNotifyStreamEnd(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifyStreamEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifyStreamEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifyStreamEnd: %w", err)
+	}
+	return oldValue.NotifyStreamEnd, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StreamingPlatformMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetNotifyStreamEnd resets all changes to the "notify_stream_end" field.
+func (m *UserFollowedStreamerMutation) ResetNotifyStreamEnd() {
+	m.notify_stream_end = nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *StreamingPlatformMutation) EdgeCleared(name string) bool {
-	return false
+// SetNotifyTitleChange sets the "notify_title_change" field.
+func (m *UserFollowedStreamerMutation) SetNotifyTitleChange(b bool) {
+	m.notify_title_change = &b
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *StreamingPlatformMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown StreamingPlatform unique edge %s", name)
+// NotifyTitleChange returns the value of the "notify_title_change" field in the mutation.
+func (m *UserFollowedStreamerMutation) NotifyTitleChange() (r bool, exists bool) {
+	v := m.notify_title_change
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *StreamingPlatformMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown StreamingPlatform edge %s", name)
+// OldNotifyTitleChange returns the old "notify_title_change" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldNotifyTitleChange(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifyTitleChange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifyTitleChange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifyTitleChange: %w", err)
+	}
+	return oldValue.NotifyTitleChange, nil
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                           Op
-	typ                          string
-	id                           *int64
-	username                     *string
-	email                        *string
-	password                     *string
-	created_at                   *time.Time
-	updated_at                   *time.Time
-	clearedFields                map[string]struct{}
-	followed_streamers           map[int64]struct{}
-	removedfollowed_streamers    map[int64]struct{}
-	clearedfollowed_streamers    bool
-	notification_channels        map[int64]struct{}
-	removednotification_channels map[int64]struct{}
-	clearednotification_channels bool
-	done                         bool
-	oldValue                     func(context.Context) (*User, error)
-	predicates                   []predicate.User
+// ResetNotifyTitleChange resets all changes to the "notify_title_change" field.
+func (m *UserFollowedStreamerMutation) ResetNotifyTitleChange() {
+	m.notify_title_change = nil
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// SetNotifyCategories sets the "notify_categories" field.
+func (m *UserFollowedStreamerMutation) SetNotifyCategories(s []string) {
+	m.notify_categories = &s
+	m.appendnotify_categories = nil
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// NotifyCategories returns the value of the "notify_categories" field in the mutation.
+func (m *UserFollowedStreamerMutation) NotifyCategories() (r []string, exists bool) {
+	v := m.notify_categories
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// OldNotifyCategories returns the old "notify_categories" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldNotifyCategories(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifyCategories is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifyCategories requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifyCategories: %w", err)
+	}
+	return oldValue.NotifyCategories, nil
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int64) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AppendNotifyCategories adds s to the "notify_categories" field.
+func (m *UserFollowedStreamerMutation) AppendNotifyCategories(s []string) {
+	m.appendnotify_categories = append(m.appendnotify_categories, s...)
+}
+
+// AppendedNotifyCategories returns the list of values that were appended to the "notify_categories" field in this mutation.
+func (m *UserFollowedStreamerMutation) AppendedNotifyCategories() ([]string, bool) {
+	if len(m.appendnotify_categories) == 0 {
+		return nil, false
+	}
+	return m.appendnotify_categories, true
+}
+
+// ClearNotifyCategories clears the value of the "notify_categories" field.
+func (m *UserFollowedStreamerMutation) ClearNotifyCategories() {
+	m.notify_categories = nil
+	m.appendnotify_categories = nil
+	m.clearedFields[userfollowedstreamer.FieldNotifyCategories] = struct{}{}
+}
+
+// NotifyCategoriesCleared returns if the "notify_categories" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) NotifyCategoriesCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldNotifyCategories]
+	return ok
+}
+
+// ResetNotifyCategories resets all changes to the "notify_categories" field.
+func (m *UserFollowedStreamerMutation) ResetNotifyCategories() {
+	m.notify_categories = nil
+	m.appendnotify_categories = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldNotifyCategories)
+}
+
+// SetNotificationFilter sets the "notification_filter" field.
+func (m *UserFollowedStreamerMutation) SetNotificationFilter(value map[string]interface{}) {
+	m.notification_filter = &value
+}
+
+// NotificationFilter returns the value of the "notification_filter" field in the mutation.
+func (m *UserFollowedStreamerMutation) NotificationFilter() (r map[string]interface{}, exists bool) {
+	v := m.notification_filter
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldNotificationFilter returns the old "notification_filter" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldNotificationFilter(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotificationFilter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotificationFilter requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotificationFilter: %w", err)
+	}
+	return oldValue.NotificationFilter, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearNotificationFilter clears the value of the "notification_filter" field.
+func (m *UserFollowedStreamerMutation) ClearNotificationFilter() {
+	m.notification_filter = nil
+	m.clearedFields[userfollowedstreamer.FieldNotificationFilter] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// NotificationFilterCleared returns if the "notification_filter" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) NotificationFilterCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldNotificationFilter]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id int64) {
-	m.id = &id
+// ResetNotificationFilter resets all changes to the "notification_filter" field.
+func (m *UserFollowedStreamerMutation) ResetNotificationFilter() {
+	m.notification_filter = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldNotificationFilter)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// SetChannelOverrides sets the "channel_overrides" field.
+func (m *UserFollowedStreamerMutation) SetChannelOverrides(value map[int64]map[string]interface{}) {
+	m.channel_overrides = &value
+}
+
+// ChannelOverrides returns the value of the "channel_overrides" field in the mutation.
+func (m *UserFollowedStreamerMutation) ChannelOverrides() (r map[int64]map[string]interface{}, exists bool) {
+	v := m.channel_overrides
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldChannelOverrides returns the old "channel_overrides" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserFollowedStreamerMutation) OldChannelOverrides(ctx context.Context) (v map[int64]map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelOverrides is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelOverrides requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelOverrides: %w", err)
 	}
+	return oldValue.ChannelOverrides, nil
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// ClearChannelOverrides clears the value of the "channel_overrides" field.
+func (m *UserFollowedStreamerMutation) ClearChannelOverrides() {
+	m.channel_overrides = nil
+	m.clearedFields[userfollowedstreamer.FieldChannelOverrides] = struct{}{}
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// ChannelOverridesCleared returns if the "channel_overrides" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) ChannelOverridesCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldChannelOverrides]
+	return ok
+}
+
+// ResetChannelOverrides resets all changes to the "channel_overrides" field.
+func (m *UserFollowedStreamerMutation) ResetChannelOverrides() {
+	m.channel_overrides = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldChannelOverrides)
+}
+
+// SetLastNotificationSentAt sets the "last_notification_sent_at" field.
+func (m *UserFollowedStreamerMutation) SetLastNotificationSentAt(t time.Time) {
+	m.last_notification_sent_at = &t
+}
+
+// LastNotificationSentAt returns the value of the "last_notification_sent_at" field in the mutation.
+func (m *UserFollowedStreamerMutation) LastNotificationSentAt() (r time.Time, exists bool) {
+	v := m.last_notification_sent_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastNotificationSentAt returns the old "last_notification_sent_at" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *UserFollowedStreamerMutation) OldLastNotificationSentAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastNotificationSentAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldLastNotificationSentAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastNotificationSentAt: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.LastNotificationSentAt, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ClearLastNotificationSentAt clears the value of the "last_notification_sent_at" field.
+func (m *UserFollowedStreamerMutation) ClearLastNotificationSentAt() {
+	m.last_notification_sent_at = nil
+	m.clearedFields[userfollowedstreamer.FieldLastNotificationSentAt] = struct{}{}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// LastNotificationSentAtCleared returns if the "last_notification_sent_at" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) LastNotificationSentAtCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldLastNotificationSentAt]
+	return ok
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// ResetLastNotificationSentAt resets all changes to the "last_notification_sent_at" field.
+func (m *UserFollowedStreamerMutation) ResetLastNotificationSentAt() {
+	m.last_notification_sent_at = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldLastNotificationSentAt)
+}
+
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (m *UserFollowedStreamerMutation) SetSnoozedUntil(t time.Time) {
+	m.snoozed_until = &t
+}
+
+// SnoozedUntil returns the value of the "snoozed_until" field in the mutation.
+func (m *UserFollowedStreamerMutation) SnoozedUntil() (r time.Time, exists bool) {
+	v := m.snoozed_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSnoozedUntil returns the old "snoozed_until" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UserFollowedStreamerMutation) OldSnoozedUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldSnoozedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldSnoozedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldSnoozedUntil: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.SnoozedUntil, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (m *UserFollowedStreamerMutation) ClearSnoozedUntil() {
+	m.snoozed_until = nil
+	m.clearedFields[userfollowedstreamer.FieldSnoozedUntil] = struct{}{}
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SnoozedUntilCleared returns if the "snoozed_until" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) SnoozedUntilCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldSnoozedUntil]
+	return ok
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// ResetSnoozedUntil resets all changes to the "snoozed_until" field.
+func (m *UserFollowedStreamerMutation) ResetSnoozedUntil() {
+	m.snoozed_until = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldSnoozedUntil)
+}
+
+// SetMutedSessionID sets the "muted_session_id" field.
+func (m *UserFollowedStreamerMutation) SetMutedSessionID(i int64) {
+	m.muted_session_id = &i
+	m.addmuted_session_id = nil
+}
+
+// MutedSessionID returns the value of the "muted_session_id" field in the mutation.
+func (m *UserFollowedStreamerMutation) MutedSessionID() (r int64, exists bool) {
+	v := m.muted_session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldMutedSessionID returns the old "muted_session_id" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *UserFollowedStreamerMutation) OldMutedSessionID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldMutedSessionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldMutedSessionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldMutedSessionID: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.MutedSessionID, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// AddMutedSessionID adds i to the "muted_session_id" field.
+func (m *UserFollowedStreamerMutation) AddMutedSessionID(i int64) {
+	if m.addmuted_session_id != nil {
+		*m.addmuted_session_id += i
+	} else {
+		m.addmuted_session_id = &i
+	}
+}
+
+// AddedMutedSessionID returns the value that was added to the "muted_session_id" field in this mutation.
+func (m *UserFollowedStreamerMutation) AddedMutedSessionID() (r int64, exists bool) {
+	v := m.addmuted_session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMutedSessionID clears the value of the "muted_session_id" field.
+func (m *UserFollowedStreamerMutation) ClearMutedSessionID() {
+	m.muted_session_id = nil
+	m.addmuted_session_id = nil
+	m.clearedFields[userfollowedstreamer.FieldMutedSessionID] = struct{}{}
+}
+
+// MutedSessionIDCleared returns if the "muted_session_id" field was cleared in this mutation.
+func (m *UserFollowedStreamerMutation) MutedSessionIDCleared() bool {
+	_, ok := m.clearedFields[userfollowedstreamer.FieldMutedSessionID]
+	return ok
+}
+
+// ResetMutedSessionID resets all changes to the "muted_session_id" field.
+func (m *UserFollowedStreamerMutation) ResetMutedSessionID() {
+	m.muted_session_id = nil
+	m.addmuted_session_id = nil
+	delete(m.clearedFields, userfollowedstreamer.FieldMutedSessionID)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *UserFollowedStreamerMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserFollowedStreamerMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3575,10 +12805,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserFollowedStreamerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3593,17 +12823,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *UserFollowedStreamerMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *UserFollowedStreamerMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserFollowedStreamerMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3611,145 +12841,91 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UserFollowedStreamer entity.
+// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserFollowedStreamerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
 		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddFollowedStreamerIDs adds the "followed_streamers" edge to the UserFollowedStreamer entity by ids.
-func (m *UserMutation) AddFollowedStreamerIDs(ids ...int64) {
-	if m.followed_streamers == nil {
-		m.followed_streamers = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.followed_streamers[ids[i]] = struct{}{}
-	}
-}
-
-// ClearFollowedStreamers clears the "followed_streamers" edge to the UserFollowedStreamer entity.
-func (m *UserMutation) ClearFollowedStreamers() {
-	m.clearedfollowed_streamers = true
-}
-
-// FollowedStreamersCleared reports if the "followed_streamers" edge to the UserFollowedStreamer entity was cleared.
-func (m *UserMutation) FollowedStreamersCleared() bool {
-	return m.clearedfollowed_streamers
-}
-
-// RemoveFollowedStreamerIDs removes the "followed_streamers" edge to the UserFollowedStreamer entity by IDs.
-func (m *UserMutation) RemoveFollowedStreamerIDs(ids ...int64) {
-	if m.removedfollowed_streamers == nil {
-		m.removedfollowed_streamers = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.followed_streamers, ids[i])
-		m.removedfollowed_streamers[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedFollowedStreamers returns the removed IDs of the "followed_streamers" edge to the UserFollowedStreamer entity.
-func (m *UserMutation) RemovedFollowedStreamersIDs() (ids []int64) {
-	for id := range m.removedfollowed_streamers {
-		ids = append(ids, id)
-	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// FollowedStreamersIDs returns the "followed_streamers" edge IDs in the mutation.
-func (m *UserMutation) FollowedStreamersIDs() (ids []int64) {
-	for id := range m.followed_streamers {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserFollowedStreamerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetFollowedStreamers resets all changes to the "followed_streamers" edge.
-func (m *UserMutation) ResetFollowedStreamers() {
-	m.followed_streamers = nil
-	m.clearedfollowed_streamers = false
-	m.removedfollowed_streamers = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserFollowedStreamerMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userfollowedstreamer.FieldUserID] = struct{}{}
 }
 
-// AddNotificationChannelIDs adds the "notification_channels" edge to the NotificationChannel entity by ids.
-func (m *UserMutation) AddNotificationChannelIDs(ids ...int64) {
-	if m.notification_channels == nil {
-		m.notification_channels = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.notification_channels[ids[i]] = struct{}{}
-	}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserFollowedStreamerMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ClearNotificationChannels clears the "notification_channels" edge to the NotificationChannel entity.
-func (m *UserMutation) ClearNotificationChannels() {
-	m.clearednotification_channels = true
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserFollowedStreamerMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// NotificationChannelsCleared reports if the "notification_channels" edge to the NotificationChannel entity was cleared.
-func (m *UserMutation) NotificationChannelsCleared() bool {
-	return m.clearednotification_channels
+// ResetUser resets all changes to the "user" edge.
+func (m *UserFollowedStreamerMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// RemoveNotificationChannelIDs removes the "notification_channels" edge to the NotificationChannel entity by IDs.
-func (m *UserMutation) RemoveNotificationChannelIDs(ids ...int64) {
-	if m.removednotification_channels == nil {
-		m.removednotification_channels = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.notification_channels, ids[i])
-		m.removednotification_channels[ids[i]] = struct{}{}
-	}
+// ClearStreamer clears the "streamer" edge to the Streamer entity.
+func (m *UserFollowedStreamerMutation) ClearStreamer() {
+	m.clearedstreamer = true
+	m.clearedFields[userfollowedstreamer.FieldStreamerID] = struct{}{}
 }
 
-// RemovedNotificationChannels returns the removed IDs of the "notification_channels" edge to the NotificationChannel entity.
-func (m *UserMutation) RemovedNotificationChannelsIDs() (ids []int64) {
-	for id := range m.removednotification_channels {
-		ids = append(ids, id)
-	}
-	return
+// StreamerCleared reports if the "streamer" edge to the Streamer entity was cleared.
+func (m *UserFollowedStreamerMutation) StreamerCleared() bool {
+	return m.clearedstreamer
 }
 
-// NotificationChannelsIDs returns the "notification_channels" edge IDs in the mutation.
-func (m *UserMutation) NotificationChannelsIDs() (ids []int64) {
-	for id := range m.notification_channels {
-		ids = append(ids, id)
+// StreamerIDs returns the "streamer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StreamerID instead. It exists only for internal usage by the builders.
+func (m *UserFollowedStreamerMutation) StreamerIDs() (ids []int64) {
+	if id := m.streamer; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetNotificationChannels resets all changes to the "notification_channels" edge.
-func (m *UserMutation) ResetNotificationChannels() {
-	m.notification_channels = nil
-	m.clearednotification_channels = false
-	m.removednotification_channels = nil
+// ResetStreamer resets all changes to the "streamer" edge.
+func (m *UserFollowedStreamerMutation) ResetStreamer() {
+	m.streamer = nil
+	m.clearedstreamer = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserFollowedStreamerMutation builder.
+func (m *UserFollowedStreamerMutation) Where(ps ...predicate.UserFollowedStreamer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserFollowedStreamerMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserFollowedStreamerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserFollowedStreamer, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3757,39 +12933,87 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserFollowedStreamerMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserFollowedStreamerMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserFollowedStreamer).
+func (m *UserFollowedStreamerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
+func (m *UserFollowedStreamerMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.user != nil {
+		fields = append(fields, userfollowedstreamer.FieldUserID)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.streamer != nil {
+		fields = append(fields, userfollowedstreamer.FieldStreamerID)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.alias != nil {
+		fields = append(fields, userfollowedstreamer.FieldAlias)
+	}
+	if m.notes != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotes)
+	}
+	if m.notifications_enabled != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotificationsEnabled)
+	}
+	if m.notification_channel_ids != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotificationChannelIds)
+	}
+	if m.title_template != nil {
+		fields = append(fields, userfollowedstreamer.FieldTitleTemplate)
+	}
+	if m.body_template != nil {
+		fields = append(fields, userfollowedstreamer.FieldBodyTemplate)
+	}
+	if m.routing_mode != nil {
+		fields = append(fields, userfollowedstreamer.FieldRoutingMode)
+	}
+	if m.escalate_after_seconds != nil {
+		fields = append(fields, userfollowedstreamer.FieldEscalateAfterSeconds)
+	}
+	if m.always_notify != nil {
+		fields = append(fields, userfollowedstreamer.FieldAlwaysNotify)
+	}
+	if m.notify_stream_end != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotifyStreamEnd)
+	}
+	if m.notify_title_change != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotifyTitleChange)
+	}
+	if m.notify_categories != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotifyCategories)
+	}
+	if m.notification_filter != nil {
+		fields = append(fields, userfollowedstreamer.FieldNotificationFilter)
+	}
+	if m.channel_overrides != nil {
+		fields = append(fields, userfollowedstreamer.FieldChannelOverrides)
+	}
+	if m.last_notification_sent_at != nil {
+		fields = append(fields, userfollowedstreamer.FieldLastNotificationSentAt)
+	}
+	if m.snoozed_until != nil {
+		fields = append(fields, userfollowedstreamer.FieldSnoozedUntil)
+	}
+	if m.muted_session_id != nil {
+		fields = append(fields, userfollowedstreamer.FieldMutedSessionID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, userfollowedstreamer.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, userfollowedstreamer.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3797,17 +13021,49 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserFollowedStreamerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldCreatedAt:
+	case userfollowedstreamer.FieldUserID:
+		return m.UserID()
+	case userfollowedstreamer.FieldStreamerID:
+		return m.StreamerID()
+	case userfollowedstreamer.FieldAlias:
+		return m.Alias()
+	case userfollowedstreamer.FieldNotes:
+		return m.Notes()
+	case userfollowedstreamer.FieldNotificationsEnabled:
+		return m.NotificationsEnabled()
+	case userfollowedstreamer.FieldNotificationChannelIds:
+		return m.NotificationChannelIds()
+	case userfollowedstreamer.FieldTitleTemplate:
+		return m.TitleTemplate()
+	case userfollowedstreamer.FieldBodyTemplate:
+		return m.BodyTemplate()
+	case userfollowedstreamer.FieldRoutingMode:
+		return m.RoutingMode()
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		return m.EscalateAfterSeconds()
+	case userfollowedstreamer.FieldAlwaysNotify:
+		return m.AlwaysNotify()
+	case userfollowedstreamer.FieldNotifyStreamEnd:
+		return m.NotifyStreamEnd()
+	case userfollowedstreamer.FieldNotifyTitleChange:
+		return m.NotifyTitleChange()
+	case userfollowedstreamer.FieldNotifyCategories:
+		return m.NotifyCategories()
+	case userfollowedstreamer.FieldNotificationFilter:
+		return m.NotificationFilter()
+	case userfollowedstreamer.FieldChannelOverrides:
+		return m.ChannelOverrides()
+	case userfollowedstreamer.FieldLastNotificationSentAt:
+		return m.LastNotificationSentAt()
+	case userfollowedstreamer.FieldSnoozedUntil:
+		return m.SnoozedUntil()
+	case userfollowedstreamer.FieldMutedSessionID:
+		return m.MutedSessionID()
+	case userfollowedstreamer.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case userfollowedstreamer.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3816,56 +13072,200 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserFollowedStreamerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldCreatedAt:
+	case userfollowedstreamer.FieldUserID:
+		return m.OldUserID(ctx)
+	case userfollowedstreamer.FieldStreamerID:
+		return m.OldStreamerID(ctx)
+	case userfollowedstreamer.FieldAlias:
+		return m.OldAlias(ctx)
+	case userfollowedstreamer.FieldNotes:
+		return m.OldNotes(ctx)
+	case userfollowedstreamer.FieldNotificationsEnabled:
+		return m.OldNotificationsEnabled(ctx)
+	case userfollowedstreamer.FieldNotificationChannelIds:
+		return m.OldNotificationChannelIds(ctx)
+	case userfollowedstreamer.FieldTitleTemplate:
+		return m.OldTitleTemplate(ctx)
+	case userfollowedstreamer.FieldBodyTemplate:
+		return m.OldBodyTemplate(ctx)
+	case userfollowedstreamer.FieldRoutingMode:
+		return m.OldRoutingMode(ctx)
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		return m.OldEscalateAfterSeconds(ctx)
+	case userfollowedstreamer.FieldAlwaysNotify:
+		return m.OldAlwaysNotify(ctx)
+	case userfollowedstreamer.FieldNotifyStreamEnd:
+		return m.OldNotifyStreamEnd(ctx)
+	case userfollowedstreamer.FieldNotifyTitleChange:
+		return m.OldNotifyTitleChange(ctx)
+	case userfollowedstreamer.FieldNotifyCategories:
+		return m.OldNotifyCategories(ctx)
+	case userfollowedstreamer.FieldNotificationFilter:
+		return m.OldNotificationFilter(ctx)
+	case userfollowedstreamer.FieldChannelOverrides:
+		return m.OldChannelOverrides(ctx)
+	case userfollowedstreamer.FieldLastNotificationSentAt:
+		return m.OldLastNotificationSentAt(ctx)
+	case userfollowedstreamer.FieldSnoozedUntil:
+		return m.OldSnoozedUntil(ctx)
+	case userfollowedstreamer.FieldMutedSessionID:
+		return m.OldMutedSessionID(ctx)
+	case userfollowedstreamer.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case userfollowedstreamer.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserFollowedStreamer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserFollowedStreamerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUsername:
+	case userfollowedstreamer.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case userfollowedstreamer.FieldStreamerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreamerID(v)
+		return nil
+	case userfollowedstreamer.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case userfollowedstreamer.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case userfollowedstreamer.FieldNotificationsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationsEnabled(v)
+		return nil
+	case userfollowedstreamer.FieldNotificationChannelIds:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationChannelIds(v)
+		return nil
+	case userfollowedstreamer.FieldTitleTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleTemplate(v)
+		return nil
+	case userfollowedstreamer.FieldBodyTemplate:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetBodyTemplate(v)
 		return nil
-	case user.FieldEmail:
+	case userfollowedstreamer.FieldRoutingMode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetRoutingMode(v)
 		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetEscalateAfterSeconds(v)
 		return nil
-	case user.FieldCreatedAt:
+	case userfollowedstreamer.FieldAlwaysNotify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlwaysNotify(v)
+		return nil
+	case userfollowedstreamer.FieldNotifyStreamEnd:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifyStreamEnd(v)
+		return nil
+	case userfollowedstreamer.FieldNotifyTitleChange:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifyTitleChange(v)
+		return nil
+	case userfollowedstreamer.FieldNotifyCategories:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifyCategories(v)
+		return nil
+	case userfollowedstreamer.FieldNotificationFilter:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationFilter(v)
+		return nil
+	case userfollowedstreamer.FieldChannelOverrides:
+		v, ok := value.(map[int64]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelOverrides(v)
+		return nil
+	case userfollowedstreamer.FieldLastNotificationSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastNotificationSentAt(v)
+		return nil
+	case userfollowedstreamer.FieldSnoozedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnoozedUntil(v)
+		return nil
+	case userfollowedstreamer.FieldMutedSessionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMutedSessionID(v)
+		return nil
+	case userfollowedstreamer.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case userfollowedstreamer.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3873,218 +13273,352 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserFollowedStreamer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *UserFollowedStreamerMutation) AddedFields() []string {
+	var fields []string
+	if m.addescalate_after_seconds != nil {
+		fields = append(fields, userfollowedstreamer.FieldEscalateAfterSeconds)
+	}
+	if m.addmuted_session_id != nil {
+		fields = append(fields, userfollowedstreamer.FieldMutedSessionID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserFollowedStreamerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		return m.AddedEscalateAfterSeconds()
+	case userfollowedstreamer.FieldMutedSessionID:
+		return m.AddedMutedSessionID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserFollowedStreamerMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEscalateAfterSeconds(v)
+		return nil
+	case userfollowedstreamer.FieldMutedSessionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMutedSessionID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserFollowedStreamer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *UserFollowedStreamerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(userfollowedstreamer.FieldAlias) {
+		fields = append(fields, userfollowedstreamer.FieldAlias)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldNotes) {
+		fields = append(fields, userfollowedstreamer.FieldNotes)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldNotificationChannelIds) {
+		fields = append(fields, userfollowedstreamer.FieldNotificationChannelIds)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldTitleTemplate) {
+		fields = append(fields, userfollowedstreamer.FieldTitleTemplate)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldBodyTemplate) {
+		fields = append(fields, userfollowedstreamer.FieldBodyTemplate)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldRoutingMode) {
+		fields = append(fields, userfollowedstreamer.FieldRoutingMode)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldEscalateAfterSeconds) {
+		fields = append(fields, userfollowedstreamer.FieldEscalateAfterSeconds)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldNotifyCategories) {
+		fields = append(fields, userfollowedstreamer.FieldNotifyCategories)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldNotificationFilter) {
+		fields = append(fields, userfollowedstreamer.FieldNotificationFilter)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldChannelOverrides) {
+		fields = append(fields, userfollowedstreamer.FieldChannelOverrides)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldLastNotificationSentAt) {
+		fields = append(fields, userfollowedstreamer.FieldLastNotificationSentAt)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldSnoozedUntil) {
+		fields = append(fields, userfollowedstreamer.FieldSnoozedUntil)
+	}
+	if m.FieldCleared(userfollowedstreamer.FieldMutedSessionID) {
+		fields = append(fields, userfollowedstreamer.FieldMutedSessionID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserFollowedStreamerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserFollowedStreamerMutation) ClearField(name string) error {
+	switch name {
+	case userfollowedstreamer.FieldAlias:
+		m.ClearAlias()
+		return nil
+	case userfollowedstreamer.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case userfollowedstreamer.FieldNotificationChannelIds:
+		m.ClearNotificationChannelIds()
+		return nil
+	case userfollowedstreamer.FieldTitleTemplate:
+		m.ClearTitleTemplate()
+		return nil
+	case userfollowedstreamer.FieldBodyTemplate:
+		m.ClearBodyTemplate()
+		return nil
+	case userfollowedstreamer.FieldRoutingMode:
+		m.ClearRoutingMode()
+		return nil
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		m.ClearEscalateAfterSeconds()
+		return nil
+	case userfollowedstreamer.FieldNotifyCategories:
+		m.ClearNotifyCategories()
+		return nil
+	case userfollowedstreamer.FieldNotificationFilter:
+		m.ClearNotificationFilter()
+		return nil
+	case userfollowedstreamer.FieldChannelOverrides:
+		m.ClearChannelOverrides()
+		return nil
+	case userfollowedstreamer.FieldLastNotificationSentAt:
+		m.ClearLastNotificationSentAt()
+		return nil
+	case userfollowedstreamer.FieldSnoozedUntil:
+		m.ClearSnoozedUntil()
+		return nil
+	case userfollowedstreamer.FieldMutedSessionID:
+		m.ClearMutedSessionID()
+		return nil
+	}
+	return fmt.Errorf("unknown UserFollowedStreamer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserFollowedStreamerMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
+	case userfollowedstreamer.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case userfollowedstreamer.FieldStreamerID:
+		m.ResetStreamerID()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case userfollowedstreamer.FieldAlias:
+		m.ResetAlias()
 		return nil
-	case user.FieldCreatedAt:
+	case userfollowedstreamer.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case userfollowedstreamer.FieldNotificationsEnabled:
+		m.ResetNotificationsEnabled()
+		return nil
+	case userfollowedstreamer.FieldNotificationChannelIds:
+		m.ResetNotificationChannelIds()
+		return nil
+	case userfollowedstreamer.FieldTitleTemplate:
+		m.ResetTitleTemplate()
+		return nil
+	case userfollowedstreamer.FieldBodyTemplate:
+		m.ResetBodyTemplate()
+		return nil
+	case userfollowedstreamer.FieldRoutingMode:
+		m.ResetRoutingMode()
+		return nil
+	case userfollowedstreamer.FieldEscalateAfterSeconds:
+		m.ResetEscalateAfterSeconds()
+		return nil
+	case userfollowedstreamer.FieldAlwaysNotify:
+		m.ResetAlwaysNotify()
+		return nil
+	case userfollowedstreamer.FieldNotifyStreamEnd:
+		m.ResetNotifyStreamEnd()
+		return nil
+	case userfollowedstreamer.FieldNotifyTitleChange:
+		m.ResetNotifyTitleChange()
+		return nil
+	case userfollowedstreamer.FieldNotifyCategories:
+		m.ResetNotifyCategories()
+		return nil
+	case userfollowedstreamer.FieldNotificationFilter:
+		m.ResetNotificationFilter()
+		return nil
+	case userfollowedstreamer.FieldChannelOverrides:
+		m.ResetChannelOverrides()
+		return nil
+	case userfollowedstreamer.FieldLastNotificationSentAt:
+		m.ResetLastNotificationSentAt()
+		return nil
+	case userfollowedstreamer.FieldSnoozedUntil:
+		m.ResetSnoozedUntil()
+		return nil
+	case userfollowedstreamer.FieldMutedSessionID:
+		m.ResetMutedSessionID()
+		return nil
+	case userfollowedstreamer.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case userfollowedstreamer.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserFollowedStreamer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *UserFollowedStreamerMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.followed_streamers != nil {
-		edges = append(edges, user.EdgeFollowedStreamers)
+	if m.user != nil {
+		edges = append(edges, userfollowedstreamer.EdgeUser)
 	}
-	if m.notification_channels != nil {
-		edges = append(edges, user.EdgeNotificationChannels)
+	if m.streamer != nil {
+		edges = append(edges, userfollowedstreamer.EdgeStreamer)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserFollowedStreamerMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeFollowedStreamers:
-		ids := make([]ent.Value, 0, len(m.followed_streamers))
-		for id := range m.followed_streamers {
-			ids = append(ids, id)
+	case userfollowedstreamer.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeNotificationChannels:
-		ids := make([]ent.Value, 0, len(m.notification_channels))
-		for id := range m.notification_channels {
-			ids = append(ids, id)
+	case userfollowedstreamer.EdgeStreamer:
+		if id := m.streamer; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *UserFollowedStreamerMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedfollowed_streamers != nil {
-		edges = append(edges, user.EdgeFollowedStreamers)
-	}
-	if m.removednotification_channels != nil {
-		edges = append(edges, user.EdgeNotificationChannels)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeFollowedStreamers:
-		ids := make([]ent.Value, 0, len(m.removedfollowed_streamers))
-		for id := range m.removedfollowed_streamers {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeNotificationChannels:
-		ids := make([]ent.Value, 0, len(m.removednotification_channels))
-		for id := range m.removednotification_channels {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserFollowedStreamerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *UserFollowedStreamerMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedfollowed_streamers {
-		edges = append(edges, user.EdgeFollowedStreamers)
+	if m.cleareduser {
+		edges = append(edges, userfollowedstreamer.EdgeUser)
 	}
-	if m.clearednotification_channels {
-		edges = append(edges, user.EdgeNotificationChannels)
+	if m.clearedstreamer {
+		edges = append(edges, userfollowedstreamer.EdgeStreamer)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserFollowedStreamerMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeFollowedStreamers:
-		return m.clearedfollowed_streamers
-	case user.EdgeNotificationChannels:
-		return m.clearednotification_channels
+	case userfollowedstreamer.EdgeUser:
+		return m.cleareduser
+	case userfollowedstreamer.EdgeStreamer:
+		return m.clearedstreamer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserFollowedStreamerMutation) ClearEdge(name string) error {
 	switch name {
+	case userfollowedstreamer.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userfollowedstreamer.EdgeStreamer:
+		m.ClearStreamer()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserFollowedStreamer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserFollowedStreamerMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeFollowedStreamers:
-		m.ResetFollowedStreamers()
+	case userfollowedstreamer.EdgeUser:
+		m.ResetUser()
 		return nil
-	case user.EdgeNotificationChannels:
-		m.ResetNotificationChannels()
+	case userfollowedstreamer.EdgeStreamer:
+		m.ResetStreamer()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserFollowedStreamer edge %s", name)
 }
 
-// UserFollowedStreamerMutation represents an operation that mutates the UserFollowedStreamer nodes in the graph.
-type UserFollowedStreamerMutation struct {
+// WebPushSubscriptionMutation represents an operation that mutates the WebPushSubscription nodes in the graph.
+type WebPushSubscriptionMutation struct {
 	config
-	op                             Op
-	typ                            string
-	id                             *int64
-	alias                          *string
-	notes                          *string
-	notifications_enabled          *bool
-	notification_channel_ids       *[]int64
-	appendnotification_channel_ids []int64
-	last_notification_sent_at      *time.Time
-	created_at                     *time.Time
-	updated_at                     *time.Time
-	clearedFields                  map[string]struct{}
-	user                           *int64
-	cleareduser                    bool
-	streamer                       *int64
-	clearedstreamer                bool
-	done                           bool
-	oldValue                       func(context.Context) (*UserFollowedStreamer, error)
-	predicates                     []predicate.UserFollowedStreamer
+	op            Op
+	typ           string
+	id            *int64
+	endpoint      *string
+	p256dh        *string
+	auth          *string
+	user_agent    *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int64
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*WebPushSubscription, error)
+	predicates    []predicate.WebPushSubscription
 }
 
-var _ ent.Mutation = (*UserFollowedStreamerMutation)(nil)
+var _ ent.Mutation = (*WebPushSubscriptionMutation)(nil)
 
-// userfollowedstreamerOption allows management of the mutation configuration using functional options.
-type userfollowedstreamerOption func(*UserFollowedStreamerMutation)
+// webpushsubscriptionOption allows management of the mutation configuration using functional options.
+type webpushsubscriptionOption func(*WebPushSubscriptionMutation)
 
-// newUserFollowedStreamerMutation creates new mutation for the UserFollowedStreamer entity.
-func newUserFollowedStreamerMutation(c config, op Op, opts ...userfollowedstreamerOption) *UserFollowedStreamerMutation {
-	m := &UserFollowedStreamerMutation{
+// newWebPushSubscriptionMutation creates new mutation for the WebPushSubscription entity.
+func newWebPushSubscriptionMutation(c config, op Op, opts ...webpushsubscriptionOption) *WebPushSubscriptionMutation {
+	m := &WebPushSubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserFollowedStreamer,
+		typ:           TypeWebPushSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4093,20 +13627,20 @@ func newUserFollowedStreamerMutation(c config, op Op, opts ...userfollowedstream
 	return m
 }
 
-// withUserFollowedStreamerID sets the ID field of the mutation.
-func withUserFollowedStreamerID(id int64) userfollowedstreamerOption {
-	return func(m *UserFollowedStreamerMutation) {
+// withWebPushSubscriptionID sets the ID field of the mutation.
+func withWebPushSubscriptionID(id int64) webpushsubscriptionOption {
+	return func(m *WebPushSubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserFollowedStreamer
+			value *WebPushSubscription
 		)
-		m.oldValue = func(ctx context.Context) (*UserFollowedStreamer, error) {
+		m.oldValue = func(ctx context.Context) (*WebPushSubscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserFollowedStreamer.Get(ctx, id)
+					value, err = m.Client().WebPushSubscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4115,10 +13649,10 @@ func withUserFollowedStreamerID(id int64) userfollowedstreamerOption {
 	}
 }
 
-// withUserFollowedStreamer sets the old UserFollowedStreamer of the mutation.
-func withUserFollowedStreamer(node *UserFollowedStreamer) userfollowedstreamerOption {
-	return func(m *UserFollowedStreamerMutation) {
-		m.oldValue = func(context.Context) (*UserFollowedStreamer, error) {
+// withWebPushSubscription sets the old WebPushSubscription of the mutation.
+func withWebPushSubscription(node *WebPushSubscription) webpushsubscriptionOption {
+	return func(m *WebPushSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebPushSubscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4127,7 +13661,7 @@ func withUserFollowedStreamer(node *UserFollowedStreamer) userfollowedstreamerOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserFollowedStreamerMutation) Client() *Client {
+func (m WebPushSubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4135,7 +13669,7 @@ func (m UserFollowedStreamerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserFollowedStreamerMutation) Tx() (*Tx, error) {
+func (m WebPushSubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4145,14 +13679,14 @@ func (m UserFollowedStreamerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of UserFollowedStreamer entities.
-func (m *UserFollowedStreamerMutation) SetID(id int64) {
+// operation is only accepted on creation of WebPushSubscription entities.
+func (m *WebPushSubscriptionMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserFollowedStreamerMutation) ID() (id int64, exists bool) {
+func (m *WebPushSubscriptionMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4163,7 +13697,7 @@ func (m *UserFollowedStreamerMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserFollowedStreamerMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *WebPushSubscriptionMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4172,19 +13706,19 @@ func (m *UserFollowedStreamerMutation) IDs(ctx context.Context) ([]int64, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserFollowedStreamer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebPushSubscription.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *UserFollowedStreamerMutation) SetUserID(i int64) {
+func (m *WebPushSubscriptionMutation) SetUserID(i int64) {
 	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *UserFollowedStreamerMutation) UserID() (r int64, exists bool) {
+func (m *WebPushSubscriptionMutation) UserID() (r int64, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -4192,10 +13726,10 @@ func (m *UserFollowedStreamerMutation) UserID() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *WebPushSubscriptionMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -4210,301 +13744,174 @@ func (m *UserFollowedStreamerMutation) OldUserID(ctx context.Context) (v int64,
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *UserFollowedStreamerMutation) ResetUserID() {
+func (m *WebPushSubscriptionMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetStreamerID sets the "streamer_id" field.
-func (m *UserFollowedStreamerMutation) SetStreamerID(i int64) {
-	m.streamer = &i
-}
-
-// StreamerID returns the value of the "streamer_id" field in the mutation.
-func (m *UserFollowedStreamerMutation) StreamerID() (r int64, exists bool) {
-	v := m.streamer
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStreamerID returns the old "streamer_id" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldStreamerID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStreamerID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStreamerID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStreamerID: %w", err)
-	}
-	return oldValue.StreamerID, nil
-}
-
-// ResetStreamerID resets all changes to the "streamer_id" field.
-func (m *UserFollowedStreamerMutation) ResetStreamerID() {
-	m.streamer = nil
-}
-
-// SetAlias sets the "alias" field.
-func (m *UserFollowedStreamerMutation) SetAlias(s string) {
-	m.alias = &s
-}
-
-// Alias returns the value of the "alias" field in the mutation.
-func (m *UserFollowedStreamerMutation) Alias() (r string, exists bool) {
-	v := m.alias
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAlias returns the old "alias" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldAlias(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlias requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
-	}
-	return oldValue.Alias, nil
-}
-
-// ClearAlias clears the value of the "alias" field.
-func (m *UserFollowedStreamerMutation) ClearAlias() {
-	m.alias = nil
-	m.clearedFields[userfollowedstreamer.FieldAlias] = struct{}{}
-}
-
-// AliasCleared returns if the "alias" field was cleared in this mutation.
-func (m *UserFollowedStreamerMutation) AliasCleared() bool {
-	_, ok := m.clearedFields[userfollowedstreamer.FieldAlias]
-	return ok
-}
-
-// ResetAlias resets all changes to the "alias" field.
-func (m *UserFollowedStreamerMutation) ResetAlias() {
-	m.alias = nil
-	delete(m.clearedFields, userfollowedstreamer.FieldAlias)
-}
-
-// SetNotes sets the "notes" field.
-func (m *UserFollowedStreamerMutation) SetNotes(s string) {
-	m.notes = &s
+// SetEndpoint sets the "endpoint" field.
+func (m *WebPushSubscriptionMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *UserFollowedStreamerMutation) Notes() (r string, exists bool) {
-	v := m.notes
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *WebPushSubscriptionMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotes returns the old "notes" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldNotes(ctx context.Context) (v *string, err error) {
+func (m *WebPushSubscriptionMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.Notes, nil
-}
-
-// ClearNotes clears the value of the "notes" field.
-func (m *UserFollowedStreamerMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[userfollowedstreamer.FieldNotes] = struct{}{}
-}
-
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *UserFollowedStreamerMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[userfollowedstreamer.FieldNotes]
-	return ok
+	return oldValue.Endpoint, nil
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *UserFollowedStreamerMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, userfollowedstreamer.FieldNotes)
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *WebPushSubscriptionMutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// SetNotificationsEnabled sets the "notifications_enabled" field.
-func (m *UserFollowedStreamerMutation) SetNotificationsEnabled(b bool) {
-	m.notifications_enabled = &b
+// SetP256dh sets the "p256dh" field.
+func (m *WebPushSubscriptionMutation) SetP256dh(s string) {
+	m.p256dh = &s
 }
 
-// NotificationsEnabled returns the value of the "notifications_enabled" field in the mutation.
-func (m *UserFollowedStreamerMutation) NotificationsEnabled() (r bool, exists bool) {
-	v := m.notifications_enabled
+// P256dh returns the value of the "p256dh" field in the mutation.
+func (m *WebPushSubscriptionMutation) P256dh() (r string, exists bool) {
+	v := m.p256dh
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotificationsEnabled returns the old "notifications_enabled" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldP256dh returns the old "p256dh" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldNotificationsEnabled(ctx context.Context) (v bool, err error) {
+func (m *WebPushSubscriptionMutation) OldP256dh(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotificationsEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldP256dh is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotificationsEnabled requires an ID field in the mutation")
+		return v, errors.New("OldP256dh requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotificationsEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldP256dh: %w", err)
 	}
-	return oldValue.NotificationsEnabled, nil
+	return oldValue.P256dh, nil
 }
 
-// ResetNotificationsEnabled resets all changes to the "notifications_enabled" field.
-func (m *UserFollowedStreamerMutation) ResetNotificationsEnabled() {
-	m.notifications_enabled = nil
+// ResetP256dh resets all changes to the "p256dh" field.
+func (m *WebPushSubscriptionMutation) ResetP256dh() {
+	m.p256dh = nil
 }
 
-// SetNotificationChannelIds sets the "notification_channel_ids" field.
-func (m *UserFollowedStreamerMutation) SetNotificationChannelIds(i []int64) {
-	m.notification_channel_ids = &i
-	m.appendnotification_channel_ids = nil
+// SetAuth sets the "auth" field.
+func (m *WebPushSubscriptionMutation) SetAuth(s string) {
+	m.auth = &s
 }
 
-// NotificationChannelIds returns the value of the "notification_channel_ids" field in the mutation.
-func (m *UserFollowedStreamerMutation) NotificationChannelIds() (r []int64, exists bool) {
-	v := m.notification_channel_ids
+// Auth returns the value of the "auth" field in the mutation.
+func (m *WebPushSubscriptionMutation) Auth() (r string, exists bool) {
+	v := m.auth
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotificationChannelIds returns the old "notification_channel_ids" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldAuth returns the old "auth" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldNotificationChannelIds(ctx context.Context) (v []int64, err error) {
+func (m *WebPushSubscriptionMutation) OldAuth(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotificationChannelIds is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotificationChannelIds requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotificationChannelIds: %w", err)
-	}
-	return oldValue.NotificationChannelIds, nil
-}
-
-// AppendNotificationChannelIds adds i to the "notification_channel_ids" field.
-func (m *UserFollowedStreamerMutation) AppendNotificationChannelIds(i []int64) {
-	m.appendnotification_channel_ids = append(m.appendnotification_channel_ids, i...)
-}
-
-// AppendedNotificationChannelIds returns the list of values that were appended to the "notification_channel_ids" field in this mutation.
-func (m *UserFollowedStreamerMutation) AppendedNotificationChannelIds() ([]int64, bool) {
-	if len(m.appendnotification_channel_ids) == 0 {
-		return nil, false
+		return v, errors.New("OldAuth requires an ID field in the mutation")
 	}
-	return m.appendnotification_channel_ids, true
-}
-
-// ClearNotificationChannelIds clears the value of the "notification_channel_ids" field.
-func (m *UserFollowedStreamerMutation) ClearNotificationChannelIds() {
-	m.notification_channel_ids = nil
-	m.appendnotification_channel_ids = nil
-	m.clearedFields[userfollowedstreamer.FieldNotificationChannelIds] = struct{}{}
-}
-
-// NotificationChannelIdsCleared returns if the "notification_channel_ids" field was cleared in this mutation.
-func (m *UserFollowedStreamerMutation) NotificationChannelIdsCleared() bool {
-	_, ok := m.clearedFields[userfollowedstreamer.FieldNotificationChannelIds]
-	return ok
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuth: %w", err)
+	}
+	return oldValue.Auth, nil
 }
 
-// ResetNotificationChannelIds resets all changes to the "notification_channel_ids" field.
-func (m *UserFollowedStreamerMutation) ResetNotificationChannelIds() {
-	m.notification_channel_ids = nil
-	m.appendnotification_channel_ids = nil
-	delete(m.clearedFields, userfollowedstreamer.FieldNotificationChannelIds)
+// ResetAuth resets all changes to the "auth" field.
+func (m *WebPushSubscriptionMutation) ResetAuth() {
+	m.auth = nil
 }
 
-// SetLastNotificationSentAt sets the "last_notification_sent_at" field.
-func (m *UserFollowedStreamerMutation) SetLastNotificationSentAt(t time.Time) {
-	m.last_notification_sent_at = &t
+// SetUserAgent sets the "user_agent" field.
+func (m *WebPushSubscriptionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// LastNotificationSentAt returns the value of the "last_notification_sent_at" field in the mutation.
-func (m *UserFollowedStreamerMutation) LastNotificationSentAt() (r time.Time, exists bool) {
-	v := m.last_notification_sent_at
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *WebPushSubscriptionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastNotificationSentAt returns the old "last_notification_sent_at" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldLastNotificationSentAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebPushSubscriptionMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastNotificationSentAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastNotificationSentAt requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastNotificationSentAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.LastNotificationSentAt, nil
+	return oldValue.UserAgent, nil
 }
 
-// ClearLastNotificationSentAt clears the value of the "last_notification_sent_at" field.
-func (m *UserFollowedStreamerMutation) ClearLastNotificationSentAt() {
-	m.last_notification_sent_at = nil
-	m.clearedFields[userfollowedstreamer.FieldLastNotificationSentAt] = struct{}{}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *WebPushSubscriptionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[webpushsubscription.FieldUserAgent] = struct{}{}
 }
 
-// LastNotificationSentAtCleared returns if the "last_notification_sent_at" field was cleared in this mutation.
-func (m *UserFollowedStreamerMutation) LastNotificationSentAtCleared() bool {
-	_, ok := m.clearedFields[userfollowedstreamer.FieldLastNotificationSentAt]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *WebPushSubscriptionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[webpushsubscription.FieldUserAgent]
 	return ok
 }
 
-// ResetLastNotificationSentAt resets all changes to the "last_notification_sent_at" field.
-func (m *UserFollowedStreamerMutation) ResetLastNotificationSentAt() {
-	m.last_notification_sent_at = nil
-	delete(m.clearedFields, userfollowedstreamer.FieldLastNotificationSentAt)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *WebPushSubscriptionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, webpushsubscription.FieldUserAgent)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserFollowedStreamerMutation) SetCreatedAt(t time.Time) {
+func (m *WebPushSubscriptionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserFollowedStreamerMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebPushSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4512,10 +13919,10 @@ func (m *UserFollowedStreamerMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebPushSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4530,17 +13937,17 @@ func (m *UserFollowedStreamerMutation) OldCreatedAt(ctx context.Context) (v time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserFollowedStreamerMutation) ResetCreatedAt() {
+func (m *WebPushSubscriptionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserFollowedStreamerMutation) SetUpdatedAt(t time.Time) {
+func (m *WebPushSubscriptionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserFollowedStreamerMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebPushSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4548,10 +13955,10 @@ func (m *UserFollowedStreamerMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UserFollowedStreamer entity.
-// If the UserFollowedStreamer object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebPushSubscription entity.
+// If the WebPushSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserFollowedStreamerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebPushSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4566,25 +13973,25 @@ func (m *UserFollowedStreamerMutation) OldUpdatedAt(ctx context.Context) (v time
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserFollowedStreamerMutation) ResetUpdatedAt() {
+func (m *WebPushSubscriptionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *UserFollowedStreamerMutation) ClearUser() {
+func (m *WebPushSubscriptionMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[userfollowedstreamer.FieldUserID] = struct{}{}
+	m.clearedFields[webpushsubscription.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserFollowedStreamerMutation) UserCleared() bool {
+func (m *WebPushSubscriptionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *UserFollowedStreamerMutation) UserIDs() (ids []int64) {
+func (m *WebPushSubscriptionMutation) UserIDs() (ids []int64) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4592,47 +13999,20 @@ func (m *UserFollowedStreamerMutation) UserIDs() (ids []int64) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *UserFollowedStreamerMutation) ResetUser() {
+func (m *WebPushSubscriptionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// ClearStreamer clears the "streamer" edge to the Streamer entity.
-func (m *UserFollowedStreamerMutation) ClearStreamer() {
-	m.clearedstreamer = true
-	m.clearedFields[userfollowedstreamer.FieldStreamerID] = struct{}{}
-}
-
-// StreamerCleared reports if the "streamer" edge to the Streamer entity was cleared.
-func (m *UserFollowedStreamerMutation) StreamerCleared() bool {
-	return m.clearedstreamer
-}
-
-// StreamerIDs returns the "streamer" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StreamerID instead. It exists only for internal usage by the builders.
-func (m *UserFollowedStreamerMutation) StreamerIDs() (ids []int64) {
-	if id := m.streamer; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetStreamer resets all changes to the "streamer" edge.
-func (m *UserFollowedStreamerMutation) ResetStreamer() {
-	m.streamer = nil
-	m.clearedstreamer = false
-}
-
-// Where appends a list predicates to the UserFollowedStreamerMutation builder.
-func (m *UserFollowedStreamerMutation) Where(ps ...predicate.UserFollowedStreamer) {
+// Where appends a list predicates to the WebPushSubscriptionMutation builder.
+func (m *WebPushSubscriptionMutation) Where(ps ...predicate.WebPushSubscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserFollowedStreamerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebPushSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserFollowedStreamerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserFollowedStreamer, len(ps))
+func (m *WebPushSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebPushSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4640,51 +14020,45 @@ func (m *UserFollowedStreamerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserFollowedStreamerMutation) Op() Op {
+func (m *WebPushSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserFollowedStreamerMutation) SetOp(op Op) {
+func (m *WebPushSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserFollowedStreamer).
-func (m *UserFollowedStreamerMutation) Type() string {
+// Type returns the node type of this mutation (WebPushSubscription).
+func (m *WebPushSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserFollowedStreamerMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *WebPushSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.user != nil {
-		fields = append(fields, userfollowedstreamer.FieldUserID)
-	}
-	if m.streamer != nil {
-		fields = append(fields, userfollowedstreamer.FieldStreamerID)
-	}
-	if m.alias != nil {
-		fields = append(fields, userfollowedstreamer.FieldAlias)
+		fields = append(fields, webpushsubscription.FieldUserID)
 	}
-	if m.notes != nil {
-		fields = append(fields, userfollowedstreamer.FieldNotes)
+	if m.endpoint != nil {
+		fields = append(fields, webpushsubscription.FieldEndpoint)
 	}
-	if m.notifications_enabled != nil {
-		fields = append(fields, userfollowedstreamer.FieldNotificationsEnabled)
+	if m.p256dh != nil {
+		fields = append(fields, webpushsubscription.FieldP256dh)
 	}
-	if m.notification_channel_ids != nil {
-		fields = append(fields, userfollowedstreamer.FieldNotificationChannelIds)
+	if m.auth != nil {
+		fields = append(fields, webpushsubscription.FieldAuth)
 	}
-	if m.last_notification_sent_at != nil {
-		fields = append(fields, userfollowedstreamer.FieldLastNotificationSentAt)
+	if m.user_agent != nil {
+		fields = append(fields, webpushsubscription.FieldUserAgent)
 	}
 	if m.created_at != nil {
-		fields = append(fields, userfollowedstreamer.FieldCreatedAt)
+		fields = append(fields, webpushsubscription.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, userfollowedstreamer.FieldUpdatedAt)
+		fields = append(fields, webpushsubscription.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4692,25 +14066,21 @@ func (m *UserFollowedStreamerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserFollowedStreamerMutation) Field(name string) (ent.Value, bool) {
+func (m *WebPushSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userfollowedstreamer.FieldUserID:
+	case webpushsubscription.FieldUserID:
 		return m.UserID()
-	case userfollowedstreamer.FieldStreamerID:
-		return m.StreamerID()
-	case userfollowedstreamer.FieldAlias:
-		return m.Alias()
-	case userfollowedstreamer.FieldNotes:
-		return m.Notes()
-	case userfollowedstreamer.FieldNotificationsEnabled:
-		return m.NotificationsEnabled()
-	case userfollowedstreamer.FieldNotificationChannelIds:
-		return m.NotificationChannelIds()
-	case userfollowedstreamer.FieldLastNotificationSentAt:
-		return m.LastNotificationSentAt()
-	case userfollowedstreamer.FieldCreatedAt:
+	case webpushsubscription.FieldEndpoint:
+		return m.Endpoint()
+	case webpushsubscription.FieldP256dh:
+		return m.P256dh()
+	case webpushsubscription.FieldAuth:
+		return m.Auth()
+	case webpushsubscription.FieldUserAgent:
+		return m.UserAgent()
+	case webpushsubscription.FieldCreatedAt:
 		return m.CreatedAt()
-	case userfollowedstreamer.FieldUpdatedAt:
+	case webpushsubscription.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4719,92 +14089,74 @@ func (m *UserFollowedStreamerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserFollowedStreamerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebPushSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userfollowedstreamer.FieldUserID:
+	case webpushsubscription.FieldUserID:
 		return m.OldUserID(ctx)
-	case userfollowedstreamer.FieldStreamerID:
-		return m.OldStreamerID(ctx)
-	case userfollowedstreamer.FieldAlias:
-		return m.OldAlias(ctx)
-	case userfollowedstreamer.FieldNotes:
-		return m.OldNotes(ctx)
-	case userfollowedstreamer.FieldNotificationsEnabled:
-		return m.OldNotificationsEnabled(ctx)
-	case userfollowedstreamer.FieldNotificationChannelIds:
-		return m.OldNotificationChannelIds(ctx)
-	case userfollowedstreamer.FieldLastNotificationSentAt:
-		return m.OldLastNotificationSentAt(ctx)
-	case userfollowedstreamer.FieldCreatedAt:
+	case webpushsubscription.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case webpushsubscription.FieldP256dh:
+		return m.OldP256dh(ctx)
+	case webpushsubscription.FieldAuth:
+		return m.OldAuth(ctx)
+	case webpushsubscription.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case webpushsubscription.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case userfollowedstreamer.FieldUpdatedAt:
+	case webpushsubscription.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserFollowedStreamer field %s", name)
+	return nil, fmt.Errorf("unknown WebPushSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserFollowedStreamerMutation) SetField(name string, value ent.Value) error {
+func (m *WebPushSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userfollowedstreamer.FieldUserID:
+	case webpushsubscription.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case userfollowedstreamer.FieldStreamerID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStreamerID(v)
-		return nil
-	case userfollowedstreamer.FieldAlias:
+	case webpushsubscription.FieldEndpoint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlias(v)
+		m.SetEndpoint(v)
 		return nil
-	case userfollowedstreamer.FieldNotes:
+	case webpushsubscription.FieldP256dh:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNotes(v)
-		return nil
-	case userfollowedstreamer.FieldNotificationsEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotificationsEnabled(v)
+		m.SetP256dh(v)
 		return nil
-	case userfollowedstreamer.FieldNotificationChannelIds:
-		v, ok := value.([]int64)
+	case webpushsubscription.FieldAuth:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNotificationChannelIds(v)
+		m.SetAuth(v)
 		return nil
-	case userfollowedstreamer.FieldLastNotificationSentAt:
-		v, ok := value.(time.Time)
+	case webpushsubscription.FieldUserAgent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastNotificationSentAt(v)
+		m.SetUserAgent(v)
 		return nil
-	case userfollowedstreamer.FieldCreatedAt:
+	case webpushsubscription.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case userfollowedstreamer.FieldUpdatedAt:
+	case webpushsubscription.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4812,12 +14164,12 @@ func (m *UserFollowedStreamerMutation) SetField(name string, value ent.Value) er
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserFollowedStreamer field %s", name)
+	return fmt.Errorf("unknown WebPushSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserFollowedStreamerMutation) AddedFields() []string {
+func (m *WebPushSubscriptionMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -4825,7 +14177,7 @@ func (m *UserFollowedStreamerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserFollowedStreamerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebPushSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -4834,181 +14186,139 @@ func (m *UserFollowedStreamerMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserFollowedStreamerMutation) AddField(name string, value ent.Value) error {
+func (m *WebPushSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown UserFollowedStreamer numeric field %s", name)
+	return fmt.Errorf("unknown WebPushSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserFollowedStreamerMutation) ClearedFields() []string {
+func (m *WebPushSubscriptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(userfollowedstreamer.FieldAlias) {
-		fields = append(fields, userfollowedstreamer.FieldAlias)
-	}
-	if m.FieldCleared(userfollowedstreamer.FieldNotes) {
-		fields = append(fields, userfollowedstreamer.FieldNotes)
-	}
-	if m.FieldCleared(userfollowedstreamer.FieldNotificationChannelIds) {
-		fields = append(fields, userfollowedstreamer.FieldNotificationChannelIds)
-	}
-	if m.FieldCleared(userfollowedstreamer.FieldLastNotificationSentAt) {
-		fields = append(fields, userfollowedstreamer.FieldLastNotificationSentAt)
+	if m.FieldCleared(webpushsubscription.FieldUserAgent) {
+		fields = append(fields, webpushsubscription.FieldUserAgent)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserFollowedStreamerMutation) FieldCleared(name string) bool {
+func (m *WebPushSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserFollowedStreamerMutation) ClearField(name string) error {
+func (m *WebPushSubscriptionMutation) ClearField(name string) error {
 	switch name {
-	case userfollowedstreamer.FieldAlias:
-		m.ClearAlias()
-		return nil
-	case userfollowedstreamer.FieldNotes:
-		m.ClearNotes()
-		return nil
-	case userfollowedstreamer.FieldNotificationChannelIds:
-		m.ClearNotificationChannelIds()
-		return nil
-	case userfollowedstreamer.FieldLastNotificationSentAt:
-		m.ClearLastNotificationSentAt()
+	case webpushsubscription.FieldUserAgent:
+		m.ClearUserAgent()
 		return nil
 	}
-	return fmt.Errorf("unknown UserFollowedStreamer nullable field %s", name)
+	return fmt.Errorf("unknown WebPushSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserFollowedStreamerMutation) ResetField(name string) error {
+func (m *WebPushSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case userfollowedstreamer.FieldUserID:
+	case webpushsubscription.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case userfollowedstreamer.FieldStreamerID:
-		m.ResetStreamerID()
-		return nil
-	case userfollowedstreamer.FieldAlias:
-		m.ResetAlias()
-		return nil
-	case userfollowedstreamer.FieldNotes:
-		m.ResetNotes()
+	case webpushsubscription.FieldEndpoint:
+		m.ResetEndpoint()
 		return nil
-	case userfollowedstreamer.FieldNotificationsEnabled:
-		m.ResetNotificationsEnabled()
+	case webpushsubscription.FieldP256dh:
+		m.ResetP256dh()
 		return nil
-	case userfollowedstreamer.FieldNotificationChannelIds:
-		m.ResetNotificationChannelIds()
+	case webpushsubscription.FieldAuth:
+		m.ResetAuth()
 		return nil
-	case userfollowedstreamer.FieldLastNotificationSentAt:
-		m.ResetLastNotificationSentAt()
+	case webpushsubscription.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
-	case userfollowedstreamer.FieldCreatedAt:
+	case webpushsubscription.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case userfollowedstreamer.FieldUpdatedAt:
+	case webpushsubscription.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UserFollowedStreamer field %s", name)
+	return fmt.Errorf("unknown WebPushSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserFollowedStreamerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebPushSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, userfollowedstreamer.EdgeUser)
-	}
-	if m.streamer != nil {
-		edges = append(edges, userfollowedstreamer.EdgeStreamer)
+		edges = append(edges, webpushsubscription.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserFollowedStreamerMutation) AddedIDs(name string) []ent.Value {
+func (m *WebPushSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userfollowedstreamer.EdgeUser:
+	case webpushsubscription.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case userfollowedstreamer.EdgeStreamer:
-		if id := m.streamer; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserFollowedStreamerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebPushSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserFollowedStreamerMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebPushSubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserFollowedStreamerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebPushSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, userfollowedstreamer.EdgeUser)
-	}
-	if m.clearedstreamer {
-		edges = append(edges, userfollowedstreamer.EdgeStreamer)
+		edges = append(edges, webpushsubscription.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserFollowedStreamerMutation) EdgeCleared(name string) bool {
+func (m *WebPushSubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userfollowedstreamer.EdgeUser:
+	case webpushsubscription.EdgeUser:
 		return m.cleareduser
-	case userfollowedstreamer.EdgeStreamer:
-		return m.clearedstreamer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserFollowedStreamerMutation) ClearEdge(name string) error {
+func (m *WebPushSubscriptionMutation) ClearEdge(name string) error {
 	switch name {
-	case userfollowedstreamer.EdgeUser:
+	case webpushsubscription.EdgeUser:
 		m.ClearUser()
 		return nil
-	case userfollowedstreamer.EdgeStreamer:
-		m.ClearStreamer()
-		return nil
 	}
-	return fmt.Errorf("unknown UserFollowedStreamer unique edge %s", name)
+	return fmt.Errorf("unknown WebPushSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserFollowedStreamerMutation) ResetEdge(name string) error {
+func (m *WebPushSubscriptionMutation) ResetEdge(name string) error {
 	switch name {
-	case userfollowedstreamer.EdgeUser:
+	case webpushsubscription.EdgeUser:
 		m.ResetUser()
 		return nil
-	case userfollowedstreamer.EdgeStreamer:
-		m.ResetStreamer()
-		return nil
 	}
-	return fmt.Errorf("unknown UserFollowedStreamer edge %s", name)
+	return fmt.Errorf("unknown WebPushSubscription edge %s", name)
 }
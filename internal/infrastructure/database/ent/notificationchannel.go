@@ -31,6 +31,42 @@ type NotificationChannel struct {
 	Enable bool `json:"enable,omitempty"`
 	// Priority holds the value of the "priority" field.
 	Priority int `json:"priority,omitempty"`
+	// TitleTemplate holds the value of the "title_template" field.
+	TitleTemplate *string `json:"title_template,omitempty"`
+	// BodyTemplate holds the value of the "body_template" field.
+	BodyTemplate *string `json:"body_template,omitempty"`
+	// DigestWindowSeconds holds the value of the "digest_window_seconds" field.
+	DigestWindowSeconds int64 `json:"digest_window_seconds,omitempty"`
+	// DailySummaryAt holds the value of the "daily_summary_at" field.
+	DailySummaryAt *string `json:"daily_summary_at,omitempty"`
+	// DailySummaryTimezone holds the value of the "daily_summary_timezone" field.
+	DailySummaryTimezone *string `json:"daily_summary_timezone,omitempty"`
+	// DailySummarySentAt holds the value of the "daily_summary_sent_at" field.
+	DailySummarySentAt *time.Time `json:"daily_summary_sent_at,omitempty"`
+	// LastTestedAt holds the value of the "last_tested_at" field.
+	LastTestedAt *time.Time `json:"last_tested_at,omitempty"`
+	// LastTestSuccess holds the value of the "last_test_success" field.
+	LastTestSuccess *bool `json:"last_test_success,omitempty"`
+	// LastTestError holds the value of the "last_test_error" field.
+	LastTestError *string `json:"last_test_error,omitempty"`
+	// VerificationCodeHash holds the value of the "verification_code_hash" field.
+	VerificationCodeHash *string `json:"-"`
+	// VerificationExpiresAt holds the value of the "verification_expires_at" field.
+	VerificationExpiresAt *time.Time `json:"verification_expires_at,omitempty"`
+	// VerificationAttempts holds the value of the "verification_attempts" field.
+	VerificationAttempts int `json:"verification_attempts,omitempty"`
+	// VerificationSentAt holds the value of the "verification_sent_at" field.
+	VerificationSentAt *time.Time `json:"verification_sent_at,omitempty"`
+	// ConsecutiveFailures holds the value of the "consecutive_failures" field.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// LastFailureAt holds the value of the "last_failure_at" field.
+	LastFailureAt *time.Time `json:"last_failure_at,omitempty"`
+	// LastSuccessAt holds the value of the "last_success_at" field.
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	// DisabledAt holds the value of the "disabled_at" field.
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -68,13 +104,13 @@ func (*NotificationChannel) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case notificationchannel.FieldConfig:
 			values[i] = new([]byte)
-		case notificationchannel.FieldEnable:
+		case notificationchannel.FieldEnable, notificationchannel.FieldLastTestSuccess:
 			values[i] = new(sql.NullBool)
-		case notificationchannel.FieldID, notificationchannel.FieldUserID, notificationchannel.FieldPriority:
+		case notificationchannel.FieldID, notificationchannel.FieldUserID, notificationchannel.FieldPriority, notificationchannel.FieldDigestWindowSeconds, notificationchannel.FieldVerificationAttempts, notificationchannel.FieldConsecutiveFailures:
 			values[i] = new(sql.NullInt64)
-		case notificationchannel.FieldChannelType, notificationchannel.FieldName:
+		case notificationchannel.FieldChannelType, notificationchannel.FieldName, notificationchannel.FieldTitleTemplate, notificationchannel.FieldBodyTemplate, notificationchannel.FieldDailySummaryAt, notificationchannel.FieldDailySummaryTimezone, notificationchannel.FieldLastTestError, notificationchannel.FieldVerificationCodeHash, notificationchannel.FieldLastError:
 			values[i] = new(sql.NullString)
-		case notificationchannel.FieldCreatedAt, notificationchannel.FieldUpdatedAt:
+		case notificationchannel.FieldDailySummarySentAt, notificationchannel.FieldLastTestedAt, notificationchannel.FieldVerificationExpiresAt, notificationchannel.FieldVerificationSentAt, notificationchannel.FieldLastFailureAt, notificationchannel.FieldLastSuccessAt, notificationchannel.FieldDisabledAt, notificationchannel.FieldCreatedAt, notificationchannel.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,6 +171,129 @@ func (_m *NotificationChannel) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				_m.Priority = int(value.Int64)
 			}
+		case notificationchannel.FieldTitleTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_template", values[i])
+			} else if value.Valid {
+				_m.TitleTemplate = new(string)
+				*_m.TitleTemplate = value.String
+			}
+		case notificationchannel.FieldBodyTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_template", values[i])
+			} else if value.Valid {
+				_m.BodyTemplate = new(string)
+				*_m.BodyTemplate = value.String
+			}
+		case notificationchannel.FieldDigestWindowSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field digest_window_seconds", values[i])
+			} else if value.Valid {
+				_m.DigestWindowSeconds = value.Int64
+			}
+		case notificationchannel.FieldDailySummaryAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_summary_at", values[i])
+			} else if value.Valid {
+				_m.DailySummaryAt = new(string)
+				*_m.DailySummaryAt = value.String
+			}
+		case notificationchannel.FieldDailySummaryTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_summary_timezone", values[i])
+			} else if value.Valid {
+				_m.DailySummaryTimezone = new(string)
+				*_m.DailySummaryTimezone = value.String
+			}
+		case notificationchannel.FieldDailySummarySentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_summary_sent_at", values[i])
+			} else if value.Valid {
+				_m.DailySummarySentAt = new(time.Time)
+				*_m.DailySummarySentAt = value.Time
+			}
+		case notificationchannel.FieldLastTestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_tested_at", values[i])
+			} else if value.Valid {
+				_m.LastTestedAt = new(time.Time)
+				*_m.LastTestedAt = value.Time
+			}
+		case notificationchannel.FieldLastTestSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field last_test_success", values[i])
+			} else if value.Valid {
+				_m.LastTestSuccess = new(bool)
+				*_m.LastTestSuccess = value.Bool
+			}
+		case notificationchannel.FieldLastTestError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_test_error", values[i])
+			} else if value.Valid {
+				_m.LastTestError = new(string)
+				*_m.LastTestError = value.String
+			}
+		case notificationchannel.FieldVerificationCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_code_hash", values[i])
+			} else if value.Valid {
+				_m.VerificationCodeHash = new(string)
+				*_m.VerificationCodeHash = value.String
+			}
+		case notificationchannel.FieldVerificationExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_expires_at", values[i])
+			} else if value.Valid {
+				_m.VerificationExpiresAt = new(time.Time)
+				*_m.VerificationExpiresAt = value.Time
+			}
+		case notificationchannel.FieldVerificationAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_attempts", values[i])
+			} else if value.Valid {
+				_m.VerificationAttempts = int(value.Int64)
+			}
+		case notificationchannel.FieldVerificationSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_sent_at", values[i])
+			} else if value.Valid {
+				_m.VerificationSentAt = new(time.Time)
+				*_m.VerificationSentAt = value.Time
+			}
+		case notificationchannel.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case notificationchannel.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = new(string)
+				*_m.LastError = value.String
+			}
+		case notificationchannel.FieldLastFailureAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure_at", values[i])
+			} else if value.Valid {
+				_m.LastFailureAt = new(time.Time)
+				*_m.LastFailureAt = value.Time
+			}
+		case notificationchannel.FieldLastSuccessAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_success_at", values[i])
+			} else if value.Valid {
+				_m.LastSuccessAt = new(time.Time)
+				*_m.LastSuccessAt = value.Time
+			}
+		case notificationchannel.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				_m.DisabledAt = new(time.Time)
+				*_m.DisabledAt = value.Time
+			}
 		case notificationchannel.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -206,6 +365,87 @@ func (_m *NotificationChannel) String() string {
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
 	builder.WriteString(", ")
+	if v := _m.TitleTemplate; v != nil {
+		builder.WriteString("title_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BodyTemplate; v != nil {
+		builder.WriteString("body_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("digest_window_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DigestWindowSeconds))
+	builder.WriteString(", ")
+	if v := _m.DailySummaryAt; v != nil {
+		builder.WriteString("daily_summary_at=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DailySummaryTimezone; v != nil {
+		builder.WriteString("daily_summary_timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DailySummarySentAt; v != nil {
+		builder.WriteString("daily_summary_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastTestedAt; v != nil {
+		builder.WriteString("last_tested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastTestSuccess; v != nil {
+		builder.WriteString("last_test_success=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastTestError; v != nil {
+		builder.WriteString("last_test_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("verification_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.VerificationExpiresAt; v != nil {
+		builder.WriteString("verification_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("verification_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VerificationAttempts))
+	builder.WriteString(", ")
+	if v := _m.VerificationSentAt; v != nil {
+		builder.WriteString("verification_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LastFailureAt; v != nil {
+		builder.WriteString("last_failure_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSuccessAt; v != nil {
+		builder.WriteString("last_success_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
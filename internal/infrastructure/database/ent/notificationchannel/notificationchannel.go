@@ -26,6 +26,42 @@ const (
 	FieldEnable = "enable"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
+	// FieldTitleTemplate holds the string denoting the title_template field in the database.
+	FieldTitleTemplate = "title_template"
+	// FieldBodyTemplate holds the string denoting the body_template field in the database.
+	FieldBodyTemplate = "body_template"
+	// FieldDigestWindowSeconds holds the string denoting the digest_window_seconds field in the database.
+	FieldDigestWindowSeconds = "digest_window_seconds"
+	// FieldDailySummaryAt holds the string denoting the daily_summary_at field in the database.
+	FieldDailySummaryAt = "daily_summary_at"
+	// FieldDailySummaryTimezone holds the string denoting the daily_summary_timezone field in the database.
+	FieldDailySummaryTimezone = "daily_summary_timezone"
+	// FieldDailySummarySentAt holds the string denoting the daily_summary_sent_at field in the database.
+	FieldDailySummarySentAt = "daily_summary_sent_at"
+	// FieldLastTestedAt holds the string denoting the last_tested_at field in the database.
+	FieldLastTestedAt = "last_tested_at"
+	// FieldLastTestSuccess holds the string denoting the last_test_success field in the database.
+	FieldLastTestSuccess = "last_test_success"
+	// FieldLastTestError holds the string denoting the last_test_error field in the database.
+	FieldLastTestError = "last_test_error"
+	// FieldVerificationCodeHash holds the string denoting the verification_code_hash field in the database.
+	FieldVerificationCodeHash = "verification_code_hash"
+	// FieldVerificationExpiresAt holds the string denoting the verification_expires_at field in the database.
+	FieldVerificationExpiresAt = "verification_expires_at"
+	// FieldVerificationAttempts holds the string denoting the verification_attempts field in the database.
+	FieldVerificationAttempts = "verification_attempts"
+	// FieldVerificationSentAt holds the string denoting the verification_sent_at field in the database.
+	FieldVerificationSentAt = "verification_sent_at"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastFailureAt holds the string denoting the last_failure_at field in the database.
+	FieldLastFailureAt = "last_failure_at"
+	// FieldLastSuccessAt holds the string denoting the last_success_at field in the database.
+	FieldLastSuccessAt = "last_success_at"
+	// FieldDisabledAt holds the string denoting the disabled_at field in the database.
+	FieldDisabledAt = "disabled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -52,6 +88,24 @@ var Columns = []string{
 	FieldConfig,
 	FieldEnable,
 	FieldPriority,
+	FieldTitleTemplate,
+	FieldBodyTemplate,
+	FieldDigestWindowSeconds,
+	FieldDailySummaryAt,
+	FieldDailySummaryTimezone,
+	FieldDailySummarySentAt,
+	FieldLastTestedAt,
+	FieldLastTestSuccess,
+	FieldLastTestError,
+	FieldVerificationCodeHash,
+	FieldVerificationExpiresAt,
+	FieldVerificationAttempts,
+	FieldVerificationSentAt,
+	FieldConsecutiveFailures,
+	FieldLastError,
+	FieldLastFailureAt,
+	FieldLastSuccessAt,
+	FieldDisabledAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -79,6 +133,18 @@ var (
 	DefaultEnable bool
 	// DefaultPriority holds the default value on creation for the "priority" field.
 	DefaultPriority int
+	// DefaultDigestWindowSeconds holds the default value on creation for the "digest_window_seconds" field.
+	DefaultDigestWindowSeconds int64
+	// DigestWindowSecondsValidator is a validator for the "digest_window_seconds" field. It is called by the builders before save.
+	DigestWindowSecondsValidator func(int64) error
+	// DefaultVerificationAttempts holds the default value on creation for the "verification_attempts" field.
+	DefaultVerificationAttempts int
+	// VerificationAttemptsValidator is a validator for the "verification_attempts" field. It is called by the builders before save.
+	VerificationAttemptsValidator func(int) error
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	ConsecutiveFailuresValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -120,6 +186,96 @@ func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
 }
 
+// ByTitleTemplate orders the results by the title_template field.
+func ByTitleTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleTemplate, opts...).ToFunc()
+}
+
+// ByBodyTemplate orders the results by the body_template field.
+func ByBodyTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodyTemplate, opts...).ToFunc()
+}
+
+// ByDigestWindowSeconds orders the results by the digest_window_seconds field.
+func ByDigestWindowSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigestWindowSeconds, opts...).ToFunc()
+}
+
+// ByDailySummaryAt orders the results by the daily_summary_at field.
+func ByDailySummaryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailySummaryAt, opts...).ToFunc()
+}
+
+// ByDailySummaryTimezone orders the results by the daily_summary_timezone field.
+func ByDailySummaryTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailySummaryTimezone, opts...).ToFunc()
+}
+
+// ByDailySummarySentAt orders the results by the daily_summary_sent_at field.
+func ByDailySummarySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailySummarySentAt, opts...).ToFunc()
+}
+
+// ByLastTestedAt orders the results by the last_tested_at field.
+func ByLastTestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTestedAt, opts...).ToFunc()
+}
+
+// ByLastTestSuccess orders the results by the last_test_success field.
+func ByLastTestSuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTestSuccess, opts...).ToFunc()
+}
+
+// ByLastTestError orders the results by the last_test_error field.
+func ByLastTestError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTestError, opts...).ToFunc()
+}
+
+// ByVerificationCodeHash orders the results by the verification_code_hash field.
+func ByVerificationCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationCodeHash, opts...).ToFunc()
+}
+
+// ByVerificationExpiresAt orders the results by the verification_expires_at field.
+func ByVerificationExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationExpiresAt, opts...).ToFunc()
+}
+
+// ByVerificationAttempts orders the results by the verification_attempts field.
+func ByVerificationAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationAttempts, opts...).ToFunc()
+}
+
+// ByVerificationSentAt orders the results by the verification_sent_at field.
+func ByVerificationSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationSentAt, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastFailureAt orders the results by the last_failure_at field.
+func ByLastFailureAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFailureAt, opts...).ToFunc()
+}
+
+// ByLastSuccessAt orders the results by the last_success_at field.
+func ByLastSuccessAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSuccessAt, opts...).ToFunc()
+}
+
+// ByDisabledAt orders the results by the disabled_at field.
+func ByDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
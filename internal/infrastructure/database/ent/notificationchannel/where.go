@@ -80,6 +80,96 @@ func Priority(v int) predicate.NotificationChannel {
 	return predicate.NotificationChannel(sql.FieldEQ(FieldPriority, v))
 }
 
+// TitleTemplate applies equality check predicate on the "title_template" field. It's identical to TitleTemplateEQ.
+func TitleTemplate(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldTitleTemplate, v))
+}
+
+// BodyTemplate applies equality check predicate on the "body_template" field. It's identical to BodyTemplateEQ.
+func BodyTemplate(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldBodyTemplate, v))
+}
+
+// DigestWindowSeconds applies equality check predicate on the "digest_window_seconds" field. It's identical to DigestWindowSecondsEQ.
+func DigestWindowSeconds(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDigestWindowSeconds, v))
+}
+
+// DailySummaryAt applies equality check predicate on the "daily_summary_at" field. It's identical to DailySummaryAtEQ.
+func DailySummaryAt(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDailySummaryAt, v))
+}
+
+// DailySummaryTimezone applies equality check predicate on the "daily_summary_timezone" field. It's identical to DailySummaryTimezoneEQ.
+func DailySummaryTimezone(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDailySummaryTimezone, v))
+}
+
+// DailySummarySentAt applies equality check predicate on the "daily_summary_sent_at" field. It's identical to DailySummarySentAtEQ.
+func DailySummarySentAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDailySummarySentAt, v))
+}
+
+// LastTestedAt applies equality check predicate on the "last_tested_at" field. It's identical to LastTestedAtEQ.
+func LastTestedAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastTestedAt, v))
+}
+
+// LastTestSuccess applies equality check predicate on the "last_test_success" field. It's identical to LastTestSuccessEQ.
+func LastTestSuccess(v bool) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastTestSuccess, v))
+}
+
+// LastTestError applies equality check predicate on the "last_test_error" field. It's identical to LastTestErrorEQ.
+func LastTestError(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastTestError, v))
+}
+
+// VerificationCodeHash applies equality check predicate on the "verification_code_hash" field. It's identical to VerificationCodeHashEQ.
+func VerificationCodeHash(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationExpiresAt applies equality check predicate on the "verification_expires_at" field. It's identical to VerificationExpiresAtEQ.
+func VerificationExpiresAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationAttempts applies equality check predicate on the "verification_attempts" field. It's identical to VerificationAttemptsEQ.
+func VerificationAttempts(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationAttempts, v))
+}
+
+// VerificationSentAt applies equality check predicate on the "verification_sent_at" field. It's identical to VerificationSentAtEQ.
+func VerificationSentAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationSentAt, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastFailureAt applies equality check predicate on the "last_failure_at" field. It's identical to LastFailureAtEQ.
+func LastFailureAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// LastSuccessAt applies equality check predicate on the "last_success_at" field. It's identical to LastSuccessAtEQ.
+func LastSuccessAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// DisabledAt applies equality check predicate on the "disabled_at" field. It's identical to DisabledAtEQ.
+func DisabledAt(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDisabledAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.NotificationChannel {
 	return predicate.NotificationChannel(sql.FieldEQ(FieldCreatedAt, v))
@@ -300,6 +390,1021 @@ func PriorityLTE(v int) predicate.NotificationChannel {
 	return predicate.NotificationChannel(sql.FieldLTE(FieldPriority, v))
 }
 
+// TitleTemplateEQ applies the EQ predicate on the "title_template" field.
+func TitleTemplateEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldTitleTemplate, v))
+}
+
+// TitleTemplateNEQ applies the NEQ predicate on the "title_template" field.
+func TitleTemplateNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldTitleTemplate, v))
+}
+
+// TitleTemplateIn applies the In predicate on the "title_template" field.
+func TitleTemplateIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldTitleTemplate, vs...))
+}
+
+// TitleTemplateNotIn applies the NotIn predicate on the "title_template" field.
+func TitleTemplateNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldTitleTemplate, vs...))
+}
+
+// TitleTemplateGT applies the GT predicate on the "title_template" field.
+func TitleTemplateGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldTitleTemplate, v))
+}
+
+// TitleTemplateGTE applies the GTE predicate on the "title_template" field.
+func TitleTemplateGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldTitleTemplate, v))
+}
+
+// TitleTemplateLT applies the LT predicate on the "title_template" field.
+func TitleTemplateLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldTitleTemplate, v))
+}
+
+// TitleTemplateLTE applies the LTE predicate on the "title_template" field.
+func TitleTemplateLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldTitleTemplate, v))
+}
+
+// TitleTemplateContains applies the Contains predicate on the "title_template" field.
+func TitleTemplateContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldTitleTemplate, v))
+}
+
+// TitleTemplateHasPrefix applies the HasPrefix predicate on the "title_template" field.
+func TitleTemplateHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldTitleTemplate, v))
+}
+
+// TitleTemplateHasSuffix applies the HasSuffix predicate on the "title_template" field.
+func TitleTemplateHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldTitleTemplate, v))
+}
+
+// TitleTemplateIsNil applies the IsNil predicate on the "title_template" field.
+func TitleTemplateIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldTitleTemplate))
+}
+
+// TitleTemplateNotNil applies the NotNil predicate on the "title_template" field.
+func TitleTemplateNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldTitleTemplate))
+}
+
+// TitleTemplateEqualFold applies the EqualFold predicate on the "title_template" field.
+func TitleTemplateEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldTitleTemplate, v))
+}
+
+// TitleTemplateContainsFold applies the ContainsFold predicate on the "title_template" field.
+func TitleTemplateContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldTitleTemplate, v))
+}
+
+// BodyTemplateEQ applies the EQ predicate on the "body_template" field.
+func BodyTemplateEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldBodyTemplate, v))
+}
+
+// BodyTemplateNEQ applies the NEQ predicate on the "body_template" field.
+func BodyTemplateNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldBodyTemplate, v))
+}
+
+// BodyTemplateIn applies the In predicate on the "body_template" field.
+func BodyTemplateIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldBodyTemplate, vs...))
+}
+
+// BodyTemplateNotIn applies the NotIn predicate on the "body_template" field.
+func BodyTemplateNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldBodyTemplate, vs...))
+}
+
+// BodyTemplateGT applies the GT predicate on the "body_template" field.
+func BodyTemplateGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldBodyTemplate, v))
+}
+
+// BodyTemplateGTE applies the GTE predicate on the "body_template" field.
+func BodyTemplateGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldBodyTemplate, v))
+}
+
+// BodyTemplateLT applies the LT predicate on the "body_template" field.
+func BodyTemplateLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldBodyTemplate, v))
+}
+
+// BodyTemplateLTE applies the LTE predicate on the "body_template" field.
+func BodyTemplateLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldBodyTemplate, v))
+}
+
+// BodyTemplateContains applies the Contains predicate on the "body_template" field.
+func BodyTemplateContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldBodyTemplate, v))
+}
+
+// BodyTemplateHasPrefix applies the HasPrefix predicate on the "body_template" field.
+func BodyTemplateHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldBodyTemplate, v))
+}
+
+// BodyTemplateHasSuffix applies the HasSuffix predicate on the "body_template" field.
+func BodyTemplateHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldBodyTemplate, v))
+}
+
+// BodyTemplateIsNil applies the IsNil predicate on the "body_template" field.
+func BodyTemplateIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldBodyTemplate))
+}
+
+// BodyTemplateNotNil applies the NotNil predicate on the "body_template" field.
+func BodyTemplateNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldBodyTemplate))
+}
+
+// BodyTemplateEqualFold applies the EqualFold predicate on the "body_template" field.
+func BodyTemplateEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldBodyTemplate, v))
+}
+
+// BodyTemplateContainsFold applies the ContainsFold predicate on the "body_template" field.
+func BodyTemplateContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldBodyTemplate, v))
+}
+
+// DigestWindowSecondsEQ applies the EQ predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsEQ(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDigestWindowSeconds, v))
+}
+
+// DigestWindowSecondsNEQ applies the NEQ predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsNEQ(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldDigestWindowSeconds, v))
+}
+
+// DigestWindowSecondsIn applies the In predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsIn(vs ...int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldDigestWindowSeconds, vs...))
+}
+
+// DigestWindowSecondsNotIn applies the NotIn predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsNotIn(vs ...int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldDigestWindowSeconds, vs...))
+}
+
+// DigestWindowSecondsGT applies the GT predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsGT(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldDigestWindowSeconds, v))
+}
+
+// DigestWindowSecondsGTE applies the GTE predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsGTE(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldDigestWindowSeconds, v))
+}
+
+// DigestWindowSecondsLT applies the LT predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsLT(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldDigestWindowSeconds, v))
+}
+
+// DigestWindowSecondsLTE applies the LTE predicate on the "digest_window_seconds" field.
+func DigestWindowSecondsLTE(v int64) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldDigestWindowSeconds, v))
+}
+
+// DailySummaryAtEQ applies the EQ predicate on the "daily_summary_at" field.
+func DailySummaryAtEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtNEQ applies the NEQ predicate on the "daily_summary_at" field.
+func DailySummaryAtNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtIn applies the In predicate on the "daily_summary_at" field.
+func DailySummaryAtIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldDailySummaryAt, vs...))
+}
+
+// DailySummaryAtNotIn applies the NotIn predicate on the "daily_summary_at" field.
+func DailySummaryAtNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldDailySummaryAt, vs...))
+}
+
+// DailySummaryAtGT applies the GT predicate on the "daily_summary_at" field.
+func DailySummaryAtGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtGTE applies the GTE predicate on the "daily_summary_at" field.
+func DailySummaryAtGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtLT applies the LT predicate on the "daily_summary_at" field.
+func DailySummaryAtLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtLTE applies the LTE predicate on the "daily_summary_at" field.
+func DailySummaryAtLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtContains applies the Contains predicate on the "daily_summary_at" field.
+func DailySummaryAtContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtHasPrefix applies the HasPrefix predicate on the "daily_summary_at" field.
+func DailySummaryAtHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtHasSuffix applies the HasSuffix predicate on the "daily_summary_at" field.
+func DailySummaryAtHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtIsNil applies the IsNil predicate on the "daily_summary_at" field.
+func DailySummaryAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldDailySummaryAt))
+}
+
+// DailySummaryAtNotNil applies the NotNil predicate on the "daily_summary_at" field.
+func DailySummaryAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldDailySummaryAt))
+}
+
+// DailySummaryAtEqualFold applies the EqualFold predicate on the "daily_summary_at" field.
+func DailySummaryAtEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldDailySummaryAt, v))
+}
+
+// DailySummaryAtContainsFold applies the ContainsFold predicate on the "daily_summary_at" field.
+func DailySummaryAtContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldDailySummaryAt, v))
+}
+
+// DailySummaryTimezoneEQ applies the EQ predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneNEQ applies the NEQ predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneIn applies the In predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldDailySummaryTimezone, vs...))
+}
+
+// DailySummaryTimezoneNotIn applies the NotIn predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldDailySummaryTimezone, vs...))
+}
+
+// DailySummaryTimezoneGT applies the GT predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneGTE applies the GTE predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneLT applies the LT predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneLTE applies the LTE predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneContains applies the Contains predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneHasPrefix applies the HasPrefix predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneHasSuffix applies the HasSuffix predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneIsNil applies the IsNil predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldDailySummaryTimezone))
+}
+
+// DailySummaryTimezoneNotNil applies the NotNil predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldDailySummaryTimezone))
+}
+
+// DailySummaryTimezoneEqualFold applies the EqualFold predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldDailySummaryTimezone, v))
+}
+
+// DailySummaryTimezoneContainsFold applies the ContainsFold predicate on the "daily_summary_timezone" field.
+func DailySummaryTimezoneContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldDailySummaryTimezone, v))
+}
+
+// DailySummarySentAtEQ applies the EQ predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDailySummarySentAt, v))
+}
+
+// DailySummarySentAtNEQ applies the NEQ predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldDailySummarySentAt, v))
+}
+
+// DailySummarySentAtIn applies the In predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldDailySummarySentAt, vs...))
+}
+
+// DailySummarySentAtNotIn applies the NotIn predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldDailySummarySentAt, vs...))
+}
+
+// DailySummarySentAtGT applies the GT predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldDailySummarySentAt, v))
+}
+
+// DailySummarySentAtGTE applies the GTE predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldDailySummarySentAt, v))
+}
+
+// DailySummarySentAtLT applies the LT predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldDailySummarySentAt, v))
+}
+
+// DailySummarySentAtLTE applies the LTE predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldDailySummarySentAt, v))
+}
+
+// DailySummarySentAtIsNil applies the IsNil predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldDailySummarySentAt))
+}
+
+// DailySummarySentAtNotNil applies the NotNil predicate on the "daily_summary_sent_at" field.
+func DailySummarySentAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldDailySummarySentAt))
+}
+
+// LastTestedAtEQ applies the EQ predicate on the "last_tested_at" field.
+func LastTestedAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastTestedAt, v))
+}
+
+// LastTestedAtNEQ applies the NEQ predicate on the "last_tested_at" field.
+func LastTestedAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldLastTestedAt, v))
+}
+
+// LastTestedAtIn applies the In predicate on the "last_tested_at" field.
+func LastTestedAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldLastTestedAt, vs...))
+}
+
+// LastTestedAtNotIn applies the NotIn predicate on the "last_tested_at" field.
+func LastTestedAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldLastTestedAt, vs...))
+}
+
+// LastTestedAtGT applies the GT predicate on the "last_tested_at" field.
+func LastTestedAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldLastTestedAt, v))
+}
+
+// LastTestedAtGTE applies the GTE predicate on the "last_tested_at" field.
+func LastTestedAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldLastTestedAt, v))
+}
+
+// LastTestedAtLT applies the LT predicate on the "last_tested_at" field.
+func LastTestedAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldLastTestedAt, v))
+}
+
+// LastTestedAtLTE applies the LTE predicate on the "last_tested_at" field.
+func LastTestedAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldLastTestedAt, v))
+}
+
+// LastTestedAtIsNil applies the IsNil predicate on the "last_tested_at" field.
+func LastTestedAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldLastTestedAt))
+}
+
+// LastTestedAtNotNil applies the NotNil predicate on the "last_tested_at" field.
+func LastTestedAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldLastTestedAt))
+}
+
+// LastTestSuccessEQ applies the EQ predicate on the "last_test_success" field.
+func LastTestSuccessEQ(v bool) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastTestSuccess, v))
+}
+
+// LastTestSuccessNEQ applies the NEQ predicate on the "last_test_success" field.
+func LastTestSuccessNEQ(v bool) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldLastTestSuccess, v))
+}
+
+// LastTestSuccessIsNil applies the IsNil predicate on the "last_test_success" field.
+func LastTestSuccessIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldLastTestSuccess))
+}
+
+// LastTestSuccessNotNil applies the NotNil predicate on the "last_test_success" field.
+func LastTestSuccessNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldLastTestSuccess))
+}
+
+// LastTestErrorEQ applies the EQ predicate on the "last_test_error" field.
+func LastTestErrorEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastTestError, v))
+}
+
+// LastTestErrorNEQ applies the NEQ predicate on the "last_test_error" field.
+func LastTestErrorNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldLastTestError, v))
+}
+
+// LastTestErrorIn applies the In predicate on the "last_test_error" field.
+func LastTestErrorIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldLastTestError, vs...))
+}
+
+// LastTestErrorNotIn applies the NotIn predicate on the "last_test_error" field.
+func LastTestErrorNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldLastTestError, vs...))
+}
+
+// LastTestErrorGT applies the GT predicate on the "last_test_error" field.
+func LastTestErrorGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldLastTestError, v))
+}
+
+// LastTestErrorGTE applies the GTE predicate on the "last_test_error" field.
+func LastTestErrorGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldLastTestError, v))
+}
+
+// LastTestErrorLT applies the LT predicate on the "last_test_error" field.
+func LastTestErrorLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldLastTestError, v))
+}
+
+// LastTestErrorLTE applies the LTE predicate on the "last_test_error" field.
+func LastTestErrorLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldLastTestError, v))
+}
+
+// LastTestErrorContains applies the Contains predicate on the "last_test_error" field.
+func LastTestErrorContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldLastTestError, v))
+}
+
+// LastTestErrorHasPrefix applies the HasPrefix predicate on the "last_test_error" field.
+func LastTestErrorHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldLastTestError, v))
+}
+
+// LastTestErrorHasSuffix applies the HasSuffix predicate on the "last_test_error" field.
+func LastTestErrorHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldLastTestError, v))
+}
+
+// LastTestErrorIsNil applies the IsNil predicate on the "last_test_error" field.
+func LastTestErrorIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldLastTestError))
+}
+
+// LastTestErrorNotNil applies the NotNil predicate on the "last_test_error" field.
+func LastTestErrorNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldLastTestError))
+}
+
+// LastTestErrorEqualFold applies the EqualFold predicate on the "last_test_error" field.
+func LastTestErrorEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldLastTestError, v))
+}
+
+// LastTestErrorContainsFold applies the ContainsFold predicate on the "last_test_error" field.
+func LastTestErrorContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldLastTestError, v))
+}
+
+// VerificationCodeHashEQ applies the EQ predicate on the "verification_code_hash" field.
+func VerificationCodeHashEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashNEQ applies the NEQ predicate on the "verification_code_hash" field.
+func VerificationCodeHashNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashIn applies the In predicate on the "verification_code_hash" field.
+func VerificationCodeHashIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldVerificationCodeHash, vs...))
+}
+
+// VerificationCodeHashNotIn applies the NotIn predicate on the "verification_code_hash" field.
+func VerificationCodeHashNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldVerificationCodeHash, vs...))
+}
+
+// VerificationCodeHashGT applies the GT predicate on the "verification_code_hash" field.
+func VerificationCodeHashGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashGTE applies the GTE predicate on the "verification_code_hash" field.
+func VerificationCodeHashGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashLT applies the LT predicate on the "verification_code_hash" field.
+func VerificationCodeHashLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashLTE applies the LTE predicate on the "verification_code_hash" field.
+func VerificationCodeHashLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashContains applies the Contains predicate on the "verification_code_hash" field.
+func VerificationCodeHashContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashHasPrefix applies the HasPrefix predicate on the "verification_code_hash" field.
+func VerificationCodeHashHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashHasSuffix applies the HasSuffix predicate on the "verification_code_hash" field.
+func VerificationCodeHashHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashIsNil applies the IsNil predicate on the "verification_code_hash" field.
+func VerificationCodeHashIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldVerificationCodeHash))
+}
+
+// VerificationCodeHashNotNil applies the NotNil predicate on the "verification_code_hash" field.
+func VerificationCodeHashNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldVerificationCodeHash))
+}
+
+// VerificationCodeHashEqualFold applies the EqualFold predicate on the "verification_code_hash" field.
+func VerificationCodeHashEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldVerificationCodeHash, v))
+}
+
+// VerificationCodeHashContainsFold applies the ContainsFold predicate on the "verification_code_hash" field.
+func VerificationCodeHashContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldVerificationCodeHash, v))
+}
+
+// VerificationExpiresAtEQ applies the EQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtNEQ applies the NEQ predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIn applies the In predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtNotIn applies the NotIn predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldVerificationExpiresAt, vs...))
+}
+
+// VerificationExpiresAtGT applies the GT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtGTE applies the GTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLT applies the LT predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtLTE applies the LTE predicate on the "verification_expires_at" field.
+func VerificationExpiresAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldVerificationExpiresAt, v))
+}
+
+// VerificationExpiresAtIsNil applies the IsNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldVerificationExpiresAt))
+}
+
+// VerificationExpiresAtNotNil applies the NotNil predicate on the "verification_expires_at" field.
+func VerificationExpiresAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldVerificationExpiresAt))
+}
+
+// VerificationAttemptsEQ applies the EQ predicate on the "verification_attempts" field.
+func VerificationAttemptsEQ(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsNEQ applies the NEQ predicate on the "verification_attempts" field.
+func VerificationAttemptsNEQ(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsIn applies the In predicate on the "verification_attempts" field.
+func VerificationAttemptsIn(vs ...int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldVerificationAttempts, vs...))
+}
+
+// VerificationAttemptsNotIn applies the NotIn predicate on the "verification_attempts" field.
+func VerificationAttemptsNotIn(vs ...int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldVerificationAttempts, vs...))
+}
+
+// VerificationAttemptsGT applies the GT predicate on the "verification_attempts" field.
+func VerificationAttemptsGT(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsGTE applies the GTE predicate on the "verification_attempts" field.
+func VerificationAttemptsGTE(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsLT applies the LT predicate on the "verification_attempts" field.
+func VerificationAttemptsLT(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldVerificationAttempts, v))
+}
+
+// VerificationAttemptsLTE applies the LTE predicate on the "verification_attempts" field.
+func VerificationAttemptsLTE(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldVerificationAttempts, v))
+}
+
+// VerificationSentAtEQ applies the EQ predicate on the "verification_sent_at" field.
+func VerificationSentAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldVerificationSentAt, v))
+}
+
+// VerificationSentAtNEQ applies the NEQ predicate on the "verification_sent_at" field.
+func VerificationSentAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldVerificationSentAt, v))
+}
+
+// VerificationSentAtIn applies the In predicate on the "verification_sent_at" field.
+func VerificationSentAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldVerificationSentAt, vs...))
+}
+
+// VerificationSentAtNotIn applies the NotIn predicate on the "verification_sent_at" field.
+func VerificationSentAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldVerificationSentAt, vs...))
+}
+
+// VerificationSentAtGT applies the GT predicate on the "verification_sent_at" field.
+func VerificationSentAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldVerificationSentAt, v))
+}
+
+// VerificationSentAtGTE applies the GTE predicate on the "verification_sent_at" field.
+func VerificationSentAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldVerificationSentAt, v))
+}
+
+// VerificationSentAtLT applies the LT predicate on the "verification_sent_at" field.
+func VerificationSentAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldVerificationSentAt, v))
+}
+
+// VerificationSentAtLTE applies the LTE predicate on the "verification_sent_at" field.
+func VerificationSentAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldVerificationSentAt, v))
+}
+
+// VerificationSentAtIsNil applies the IsNil predicate on the "verification_sent_at" field.
+func VerificationSentAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldVerificationSentAt))
+}
+
+// VerificationSentAtNotNil applies the NotNil predicate on the "verification_sent_at" field.
+func VerificationSentAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldVerificationSentAt))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastFailureAtEQ applies the EQ predicate on the "last_failure_at" field.
+func LastFailureAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtNEQ applies the NEQ predicate on the "last_failure_at" field.
+func LastFailureAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtIn applies the In predicate on the "last_failure_at" field.
+func LastFailureAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtNotIn applies the NotIn predicate on the "last_failure_at" field.
+func LastFailureAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtGT applies the GT predicate on the "last_failure_at" field.
+func LastFailureAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtGTE applies the GTE predicate on the "last_failure_at" field.
+func LastFailureAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLT applies the LT predicate on the "last_failure_at" field.
+func LastFailureAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLTE applies the LTE predicate on the "last_failure_at" field.
+func LastFailureAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldLastFailureAt, v))
+}
+
+// LastFailureAtIsNil applies the IsNil predicate on the "last_failure_at" field.
+func LastFailureAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldLastFailureAt))
+}
+
+// LastFailureAtNotNil applies the NotNil predicate on the "last_failure_at" field.
+func LastFailureAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldLastFailureAt))
+}
+
+// LastSuccessAtEQ applies the EQ predicate on the "last_success_at" field.
+func LastSuccessAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtNEQ applies the NEQ predicate on the "last_success_at" field.
+func LastSuccessAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIn applies the In predicate on the "last_success_at" field.
+func LastSuccessAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtNotIn applies the NotIn predicate on the "last_success_at" field.
+func LastSuccessAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtGT applies the GT predicate on the "last_success_at" field.
+func LastSuccessAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtGTE applies the GTE predicate on the "last_success_at" field.
+func LastSuccessAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLT applies the LT predicate on the "last_success_at" field.
+func LastSuccessAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLTE applies the LTE predicate on the "last_success_at" field.
+func LastSuccessAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIsNil applies the IsNil predicate on the "last_success_at" field.
+func LastSuccessAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldLastSuccessAt))
+}
+
+// LastSuccessAtNotNil applies the NotNil predicate on the "last_success_at" field.
+func LastSuccessAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldLastSuccessAt))
+}
+
+// DisabledAtEQ applies the EQ predicate on the "disabled_at" field.
+func DisabledAtEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldEQ(FieldDisabledAt, v))
+}
+
+// DisabledAtNEQ applies the NEQ predicate on the "disabled_at" field.
+func DisabledAtNEQ(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNEQ(FieldDisabledAt, v))
+}
+
+// DisabledAtIn applies the In predicate on the "disabled_at" field.
+func DisabledAtIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIn(FieldDisabledAt, vs...))
+}
+
+// DisabledAtNotIn applies the NotIn predicate on the "disabled_at" field.
+func DisabledAtNotIn(vs ...time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotIn(FieldDisabledAt, vs...))
+}
+
+// DisabledAtGT applies the GT predicate on the "disabled_at" field.
+func DisabledAtGT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGT(FieldDisabledAt, v))
+}
+
+// DisabledAtGTE applies the GTE predicate on the "disabled_at" field.
+func DisabledAtGTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldGTE(FieldDisabledAt, v))
+}
+
+// DisabledAtLT applies the LT predicate on the "disabled_at" field.
+func DisabledAtLT(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLT(FieldDisabledAt, v))
+}
+
+// DisabledAtLTE applies the LTE predicate on the "disabled_at" field.
+func DisabledAtLTE(v time.Time) predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldLTE(FieldDisabledAt, v))
+}
+
+// DisabledAtIsNil applies the IsNil predicate on the "disabled_at" field.
+func DisabledAtIsNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldIsNull(FieldDisabledAt))
+}
+
+// DisabledAtNotNil applies the NotNil predicate on the "disabled_at" field.
+func DisabledAtNotNil() predicate.NotificationChannel {
+	return predicate.NotificationChannel(sql.FieldNotNull(FieldDisabledAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.NotificationChannel {
 	return predicate.NotificationChannel(sql.FieldEQ(FieldCreatedAt, v))
@@ -73,6 +73,258 @@ func (_c *NotificationChannelCreate) SetNillablePriority(v *int) *NotificationCh
 	return _c
 }
 
+// SetTitleTemplate sets the "title_template" field.
+func (_c *NotificationChannelCreate) SetTitleTemplate(v string) *NotificationChannelCreate {
+	_c.mutation.SetTitleTemplate(v)
+	return _c
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableTitleTemplate(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetTitleTemplate(*v)
+	}
+	return _c
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (_c *NotificationChannelCreate) SetBodyTemplate(v string) *NotificationChannelCreate {
+	_c.mutation.SetBodyTemplate(v)
+	return _c
+}
+
+// SetNillableBodyTemplate sets the "body_template" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableBodyTemplate(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetBodyTemplate(*v)
+	}
+	return _c
+}
+
+// SetDigestWindowSeconds sets the "digest_window_seconds" field.
+func (_c *NotificationChannelCreate) SetDigestWindowSeconds(v int64) *NotificationChannelCreate {
+	_c.mutation.SetDigestWindowSeconds(v)
+	return _c
+}
+
+// SetNillableDigestWindowSeconds sets the "digest_window_seconds" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableDigestWindowSeconds(v *int64) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetDigestWindowSeconds(*v)
+	}
+	return _c
+}
+
+// SetDailySummaryAt sets the "daily_summary_at" field.
+func (_c *NotificationChannelCreate) SetDailySummaryAt(v string) *NotificationChannelCreate {
+	_c.mutation.SetDailySummaryAt(v)
+	return _c
+}
+
+// SetNillableDailySummaryAt sets the "daily_summary_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableDailySummaryAt(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetDailySummaryAt(*v)
+	}
+	return _c
+}
+
+// SetDailySummaryTimezone sets the "daily_summary_timezone" field.
+func (_c *NotificationChannelCreate) SetDailySummaryTimezone(v string) *NotificationChannelCreate {
+	_c.mutation.SetDailySummaryTimezone(v)
+	return _c
+}
+
+// SetNillableDailySummaryTimezone sets the "daily_summary_timezone" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableDailySummaryTimezone(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetDailySummaryTimezone(*v)
+	}
+	return _c
+}
+
+// SetDailySummarySentAt sets the "daily_summary_sent_at" field.
+func (_c *NotificationChannelCreate) SetDailySummarySentAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetDailySummarySentAt(v)
+	return _c
+}
+
+// SetNillableDailySummarySentAt sets the "daily_summary_sent_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableDailySummarySentAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetDailySummarySentAt(*v)
+	}
+	return _c
+}
+
+// SetLastTestedAt sets the "last_tested_at" field.
+func (_c *NotificationChannelCreate) SetLastTestedAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetLastTestedAt(v)
+	return _c
+}
+
+// SetNillableLastTestedAt sets the "last_tested_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableLastTestedAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetLastTestedAt(*v)
+	}
+	return _c
+}
+
+// SetLastTestSuccess sets the "last_test_success" field.
+func (_c *NotificationChannelCreate) SetLastTestSuccess(v bool) *NotificationChannelCreate {
+	_c.mutation.SetLastTestSuccess(v)
+	return _c
+}
+
+// SetNillableLastTestSuccess sets the "last_test_success" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableLastTestSuccess(v *bool) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetLastTestSuccess(*v)
+	}
+	return _c
+}
+
+// SetLastTestError sets the "last_test_error" field.
+func (_c *NotificationChannelCreate) SetLastTestError(v string) *NotificationChannelCreate {
+	_c.mutation.SetLastTestError(v)
+	return _c
+}
+
+// SetNillableLastTestError sets the "last_test_error" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableLastTestError(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetLastTestError(*v)
+	}
+	return _c
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (_c *NotificationChannelCreate) SetVerificationCodeHash(v string) *NotificationChannelCreate {
+	_c.mutation.SetVerificationCodeHash(v)
+	return _c
+}
+
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableVerificationCodeHash(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetVerificationCodeHash(*v)
+	}
+	return _c
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (_c *NotificationChannelCreate) SetVerificationExpiresAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetVerificationExpiresAt(v)
+	return _c
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableVerificationExpiresAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetVerificationExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (_c *NotificationChannelCreate) SetVerificationAttempts(v int) *NotificationChannelCreate {
+	_c.mutation.SetVerificationAttempts(v)
+	return _c
+}
+
+// SetNillableVerificationAttempts sets the "verification_attempts" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableVerificationAttempts(v *int) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetVerificationAttempts(*v)
+	}
+	return _c
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (_c *NotificationChannelCreate) SetVerificationSentAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetVerificationSentAt(v)
+	return _c
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableVerificationSentAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetVerificationSentAt(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *NotificationChannelCreate) SetConsecutiveFailures(v int) *NotificationChannelCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableConsecutiveFailures(v *int) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *NotificationChannelCreate) SetLastError(v string) *NotificationChannelCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableLastError(v *string) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_c *NotificationChannelCreate) SetLastFailureAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetLastFailureAt(v)
+	return _c
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableLastFailureAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetLastFailureAt(*v)
+	}
+	return _c
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (_c *NotificationChannelCreate) SetLastSuccessAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetLastSuccessAt(v)
+	return _c
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableLastSuccessAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetLastSuccessAt(*v)
+	}
+	return _c
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (_c *NotificationChannelCreate) SetDisabledAt(v time.Time) *NotificationChannelCreate {
+	_c.mutation.SetDisabledAt(v)
+	return _c
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (_c *NotificationChannelCreate) SetNillableDisabledAt(v *time.Time) *NotificationChannelCreate {
+	if v != nil {
+		_c.SetDisabledAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *NotificationChannelCreate) SetCreatedAt(v time.Time) *NotificationChannelCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -159,6 +411,18 @@ func (_c *NotificationChannelCreate) defaults() {
 		v := notificationchannel.DefaultPriority
 		_c.mutation.SetPriority(v)
 	}
+	if _, ok := _c.mutation.DigestWindowSeconds(); !ok {
+		v := notificationchannel.DefaultDigestWindowSeconds
+		_c.mutation.SetDigestWindowSeconds(v)
+	}
+	if _, ok := _c.mutation.VerificationAttempts(); !ok {
+		v := notificationchannel.DefaultVerificationAttempts
+		_c.mutation.SetVerificationAttempts(v)
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := notificationchannel.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := notificationchannel.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -201,6 +465,30 @@ func (_c *NotificationChannelCreate) check() error {
 	if _, ok := _c.mutation.Priority(); !ok {
 		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "NotificationChannel.priority"`)}
 	}
+	if _, ok := _c.mutation.DigestWindowSeconds(); !ok {
+		return &ValidationError{Name: "digest_window_seconds", err: errors.New(`ent: missing required field "NotificationChannel.digest_window_seconds"`)}
+	}
+	if v, ok := _c.mutation.DigestWindowSeconds(); ok {
+		if err := notificationchannel.DigestWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "digest_window_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.digest_window_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerificationAttempts(); !ok {
+		return &ValidationError{Name: "verification_attempts", err: errors.New(`ent: missing required field "NotificationChannel.verification_attempts"`)}
+	}
+	if v, ok := _c.mutation.VerificationAttempts(); ok {
+		if err := notificationchannel.VerificationAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "verification_attempts", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.verification_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "NotificationChannel.consecutive_failures"`)}
+	}
+	if v, ok := _c.mutation.ConsecutiveFailures(); ok {
+		if err := notificationchannel.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.consecutive_failures": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationChannel.created_at"`)}
 	}
@@ -262,6 +550,78 @@ func (_c *NotificationChannelCreate) createSpec() (*NotificationChannel, *sqlgra
 		_spec.SetField(notificationchannel.FieldPriority, field.TypeInt, value)
 		_node.Priority = value
 	}
+	if value, ok := _c.mutation.TitleTemplate(); ok {
+		_spec.SetField(notificationchannel.FieldTitleTemplate, field.TypeString, value)
+		_node.TitleTemplate = &value
+	}
+	if value, ok := _c.mutation.BodyTemplate(); ok {
+		_spec.SetField(notificationchannel.FieldBodyTemplate, field.TypeString, value)
+		_node.BodyTemplate = &value
+	}
+	if value, ok := _c.mutation.DigestWindowSeconds(); ok {
+		_spec.SetField(notificationchannel.FieldDigestWindowSeconds, field.TypeInt64, value)
+		_node.DigestWindowSeconds = value
+	}
+	if value, ok := _c.mutation.DailySummaryAt(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummaryAt, field.TypeString, value)
+		_node.DailySummaryAt = &value
+	}
+	if value, ok := _c.mutation.DailySummaryTimezone(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummaryTimezone, field.TypeString, value)
+		_node.DailySummaryTimezone = &value
+	}
+	if value, ok := _c.mutation.DailySummarySentAt(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummarySentAt, field.TypeTime, value)
+		_node.DailySummarySentAt = &value
+	}
+	if value, ok := _c.mutation.LastTestedAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestedAt, field.TypeTime, value)
+		_node.LastTestedAt = &value
+	}
+	if value, ok := _c.mutation.LastTestSuccess(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestSuccess, field.TypeBool, value)
+		_node.LastTestSuccess = &value
+	}
+	if value, ok := _c.mutation.LastTestError(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestError, field.TypeString, value)
+		_node.LastTestError = &value
+	}
+	if value, ok := _c.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationCodeHash, field.TypeString, value)
+		_node.VerificationCodeHash = &value
+	}
+	if value, ok := _c.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationExpiresAt, field.TypeTime, value)
+		_node.VerificationExpiresAt = &value
+	}
+	if value, ok := _c.mutation.VerificationAttempts(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationAttempts, field.TypeInt, value)
+		_node.VerificationAttempts = value
+	}
+	if value, ok := _c.mutation.VerificationSentAt(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationSentAt, field.TypeTime, value)
+		_node.VerificationSentAt = &value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(notificationchannel.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(notificationchannel.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.LastFailureAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = &value
+	}
+	if value, ok := _c.mutation.LastSuccessAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastSuccessAt, field.TypeTime, value)
+		_node.LastSuccessAt = &value
+	}
+	if value, ok := _c.mutation.DisabledAt(); ok {
+		_spec.SetField(notificationchannel.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(notificationchannel.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -118,6 +118,369 @@ func (_u *NotificationChannelUpdate) AddPriority(v int) *NotificationChannelUpda
 	return _u
 }
 
+// SetTitleTemplate sets the "title_template" field.
+func (_u *NotificationChannelUpdate) SetTitleTemplate(v string) *NotificationChannelUpdate {
+	_u.mutation.SetTitleTemplate(v)
+	return _u
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableTitleTemplate(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetTitleTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (_u *NotificationChannelUpdate) ClearTitleTemplate() *NotificationChannelUpdate {
+	_u.mutation.ClearTitleTemplate()
+	return _u
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (_u *NotificationChannelUpdate) SetBodyTemplate(v string) *NotificationChannelUpdate {
+	_u.mutation.SetBodyTemplate(v)
+	return _u
+}
+
+// SetNillableBodyTemplate sets the "body_template" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableBodyTemplate(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetBodyTemplate(*v)
+	}
+	return _u
+}
+
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (_u *NotificationChannelUpdate) ClearBodyTemplate() *NotificationChannelUpdate {
+	_u.mutation.ClearBodyTemplate()
+	return _u
+}
+
+// SetDigestWindowSeconds sets the "digest_window_seconds" field.
+func (_u *NotificationChannelUpdate) SetDigestWindowSeconds(v int64) *NotificationChannelUpdate {
+	_u.mutation.ResetDigestWindowSeconds()
+	_u.mutation.SetDigestWindowSeconds(v)
+	return _u
+}
+
+// SetNillableDigestWindowSeconds sets the "digest_window_seconds" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableDigestWindowSeconds(v *int64) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetDigestWindowSeconds(*v)
+	}
+	return _u
+}
+
+// AddDigestWindowSeconds adds value to the "digest_window_seconds" field.
+func (_u *NotificationChannelUpdate) AddDigestWindowSeconds(v int64) *NotificationChannelUpdate {
+	_u.mutation.AddDigestWindowSeconds(v)
+	return _u
+}
+
+// SetDailySummaryAt sets the "daily_summary_at" field.
+func (_u *NotificationChannelUpdate) SetDailySummaryAt(v string) *NotificationChannelUpdate {
+	_u.mutation.SetDailySummaryAt(v)
+	return _u
+}
+
+// SetNillableDailySummaryAt sets the "daily_summary_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableDailySummaryAt(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetDailySummaryAt(*v)
+	}
+	return _u
+}
+
+// ClearDailySummaryAt clears the value of the "daily_summary_at" field.
+func (_u *NotificationChannelUpdate) ClearDailySummaryAt() *NotificationChannelUpdate {
+	_u.mutation.ClearDailySummaryAt()
+	return _u
+}
+
+// SetDailySummaryTimezone sets the "daily_summary_timezone" field.
+func (_u *NotificationChannelUpdate) SetDailySummaryTimezone(v string) *NotificationChannelUpdate {
+	_u.mutation.SetDailySummaryTimezone(v)
+	return _u
+}
+
+// SetNillableDailySummaryTimezone sets the "daily_summary_timezone" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableDailySummaryTimezone(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetDailySummaryTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDailySummaryTimezone clears the value of the "daily_summary_timezone" field.
+func (_u *NotificationChannelUpdate) ClearDailySummaryTimezone() *NotificationChannelUpdate {
+	_u.mutation.ClearDailySummaryTimezone()
+	return _u
+}
+
+// SetDailySummarySentAt sets the "daily_summary_sent_at" field.
+func (_u *NotificationChannelUpdate) SetDailySummarySentAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetDailySummarySentAt(v)
+	return _u
+}
+
+// SetNillableDailySummarySentAt sets the "daily_summary_sent_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableDailySummarySentAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetDailySummarySentAt(*v)
+	}
+	return _u
+}
+
+// ClearDailySummarySentAt clears the value of the "daily_summary_sent_at" field.
+func (_u *NotificationChannelUpdate) ClearDailySummarySentAt() *NotificationChannelUpdate {
+	_u.mutation.ClearDailySummarySentAt()
+	return _u
+}
+
+// SetLastTestedAt sets the "last_tested_at" field.
+func (_u *NotificationChannelUpdate) SetLastTestedAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetLastTestedAt(v)
+	return _u
+}
+
+// SetNillableLastTestedAt sets the "last_tested_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableLastTestedAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetLastTestedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastTestedAt clears the value of the "last_tested_at" field.
+func (_u *NotificationChannelUpdate) ClearLastTestedAt() *NotificationChannelUpdate {
+	_u.mutation.ClearLastTestedAt()
+	return _u
+}
+
+// SetLastTestSuccess sets the "last_test_success" field.
+func (_u *NotificationChannelUpdate) SetLastTestSuccess(v bool) *NotificationChannelUpdate {
+	_u.mutation.SetLastTestSuccess(v)
+	return _u
+}
+
+// SetNillableLastTestSuccess sets the "last_test_success" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableLastTestSuccess(v *bool) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetLastTestSuccess(*v)
+	}
+	return _u
+}
+
+// ClearLastTestSuccess clears the value of the "last_test_success" field.
+func (_u *NotificationChannelUpdate) ClearLastTestSuccess() *NotificationChannelUpdate {
+	_u.mutation.ClearLastTestSuccess()
+	return _u
+}
+
+// SetLastTestError sets the "last_test_error" field.
+func (_u *NotificationChannelUpdate) SetLastTestError(v string) *NotificationChannelUpdate {
+	_u.mutation.SetLastTestError(v)
+	return _u
+}
+
+// SetNillableLastTestError sets the "last_test_error" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableLastTestError(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetLastTestError(*v)
+	}
+	return _u
+}
+
+// ClearLastTestError clears the value of the "last_test_error" field.
+func (_u *NotificationChannelUpdate) ClearLastTestError() *NotificationChannelUpdate {
+	_u.mutation.ClearLastTestError()
+	return _u
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (_u *NotificationChannelUpdate) SetVerificationCodeHash(v string) *NotificationChannelUpdate {
+	_u.mutation.SetVerificationCodeHash(v)
+	return _u
+}
+
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableVerificationCodeHash(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetVerificationCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (_u *NotificationChannelUpdate) ClearVerificationCodeHash() *NotificationChannelUpdate {
+	_u.mutation.ClearVerificationCodeHash()
+	return _u
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (_u *NotificationChannelUpdate) SetVerificationExpiresAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetVerificationExpiresAt(v)
+	return _u
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableVerificationExpiresAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetVerificationExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (_u *NotificationChannelUpdate) ClearVerificationExpiresAt() *NotificationChannelUpdate {
+	_u.mutation.ClearVerificationExpiresAt()
+	return _u
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (_u *NotificationChannelUpdate) SetVerificationAttempts(v int) *NotificationChannelUpdate {
+	_u.mutation.ResetVerificationAttempts()
+	_u.mutation.SetVerificationAttempts(v)
+	return _u
+}
+
+// SetNillableVerificationAttempts sets the "verification_attempts" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableVerificationAttempts(v *int) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetVerificationAttempts(*v)
+	}
+	return _u
+}
+
+// AddVerificationAttempts adds value to the "verification_attempts" field.
+func (_u *NotificationChannelUpdate) AddVerificationAttempts(v int) *NotificationChannelUpdate {
+	_u.mutation.AddVerificationAttempts(v)
+	return _u
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (_u *NotificationChannelUpdate) SetVerificationSentAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetVerificationSentAt(v)
+	return _u
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableVerificationSentAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetVerificationSentAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (_u *NotificationChannelUpdate) ClearVerificationSentAt() *NotificationChannelUpdate {
+	_u.mutation.ClearVerificationSentAt()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *NotificationChannelUpdate) SetConsecutiveFailures(v int) *NotificationChannelUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableConsecutiveFailures(v *int) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *NotificationChannelUpdate) AddConsecutiveFailures(v int) *NotificationChannelUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *NotificationChannelUpdate) SetLastError(v string) *NotificationChannelUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableLastError(v *string) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *NotificationChannelUpdate) ClearLastError() *NotificationChannelUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_u *NotificationChannelUpdate) SetLastFailureAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetLastFailureAt(v)
+	return _u
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableLastFailureAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetLastFailureAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFailureAt clears the value of the "last_failure_at" field.
+func (_u *NotificationChannelUpdate) ClearLastFailureAt() *NotificationChannelUpdate {
+	_u.mutation.ClearLastFailureAt()
+	return _u
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (_u *NotificationChannelUpdate) SetLastSuccessAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetLastSuccessAt(v)
+	return _u
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableLastSuccessAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetLastSuccessAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (_u *NotificationChannelUpdate) ClearLastSuccessAt() *NotificationChannelUpdate {
+	_u.mutation.ClearLastSuccessAt()
+	return _u
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (_u *NotificationChannelUpdate) SetDisabledAt(v time.Time) *NotificationChannelUpdate {
+	_u.mutation.SetDisabledAt(v)
+	return _u
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdate) SetNillableDisabledAt(v *time.Time) *NotificationChannelUpdate {
+	if v != nil {
+		_u.SetDisabledAt(*v)
+	}
+	return _u
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (_u *NotificationChannelUpdate) ClearDisabledAt() *NotificationChannelUpdate {
+	_u.mutation.ClearDisabledAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *NotificationChannelUpdate) SetUpdatedAt(v time.Time) *NotificationChannelUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -193,6 +556,21 @@ func (_u *NotificationChannelUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DigestWindowSeconds(); ok {
+		if err := notificationchannel.DigestWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "digest_window_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.digest_window_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationAttempts(); ok {
+		if err := notificationchannel.VerificationAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "verification_attempts", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.verification_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := notificationchannel.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.consecutive_failures": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "NotificationChannel.user"`)
 	}
@@ -232,6 +610,114 @@ func (_u *NotificationChannelUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(notificationchannel.FieldPriority, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.TitleTemplate(); ok {
+		_spec.SetField(notificationchannel.FieldTitleTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TitleTemplateCleared() {
+		_spec.ClearField(notificationchannel.FieldTitleTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodyTemplate(); ok {
+		_spec.SetField(notificationchannel.FieldBodyTemplate, field.TypeString, value)
+	}
+	if _u.mutation.BodyTemplateCleared() {
+		_spec.ClearField(notificationchannel.FieldBodyTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.DigestWindowSeconds(); ok {
+		_spec.SetField(notificationchannel.FieldDigestWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDigestWindowSeconds(); ok {
+		_spec.AddField(notificationchannel.FieldDigestWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DailySummaryAt(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummaryAt, field.TypeString, value)
+	}
+	if _u.mutation.DailySummaryAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDailySummaryAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.DailySummaryTimezone(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummaryTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DailySummaryTimezoneCleared() {
+		_spec.ClearField(notificationchannel.FieldDailySummaryTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DailySummarySentAt(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummarySentAt, field.TypeTime, value)
+	}
+	if _u.mutation.DailySummarySentAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDailySummarySentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTestedAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastTestedAtCleared() {
+		_spec.ClearField(notificationchannel.FieldLastTestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTestSuccess(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestSuccess, field.TypeBool, value)
+	}
+	if _u.mutation.LastTestSuccessCleared() {
+		_spec.ClearField(notificationchannel.FieldLastTestSuccess, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LastTestError(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestError, field.TypeString, value)
+	}
+	if _u.mutation.LastTestErrorCleared() {
+		_spec.ClearField(notificationchannel.FieldLastTestError, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.VerificationCodeHashCleared() {
+		_spec.ClearField(notificationchannel.FieldVerificationCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationExpiresAtCleared() {
+		_spec.ClearField(notificationchannel.FieldVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerificationAttempts(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVerificationAttempts(); ok {
+		_spec.AddField(notificationchannel.FieldVerificationAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VerificationSentAt(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationSentAtCleared() {
+		_spec.ClearField(notificationchannel.FieldVerificationSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(notificationchannel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(notificationchannel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(notificationchannel.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(notificationchannel.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastFailureAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFailureAtCleared() {
+		_spec.ClearField(notificationchannel.FieldLastFailureAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSuccessAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(notificationchannel.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisabledAt(); ok {
+		_spec.SetField(notificationchannel.FieldDisabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.DisabledAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDisabledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notificationchannel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -373,6 +859,369 @@ func (_u *NotificationChannelUpdateOne) AddPriority(v int) *NotificationChannelU
 	return _u
 }
 
+// SetTitleTemplate sets the "title_template" field.
+func (_u *NotificationChannelUpdateOne) SetTitleTemplate(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetTitleTemplate(v)
+	return _u
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableTitleTemplate(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetTitleTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (_u *NotificationChannelUpdateOne) ClearTitleTemplate() *NotificationChannelUpdateOne {
+	_u.mutation.ClearTitleTemplate()
+	return _u
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (_u *NotificationChannelUpdateOne) SetBodyTemplate(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetBodyTemplate(v)
+	return _u
+}
+
+// SetNillableBodyTemplate sets the "body_template" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableBodyTemplate(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetBodyTemplate(*v)
+	}
+	return _u
+}
+
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (_u *NotificationChannelUpdateOne) ClearBodyTemplate() *NotificationChannelUpdateOne {
+	_u.mutation.ClearBodyTemplate()
+	return _u
+}
+
+// SetDigestWindowSeconds sets the "digest_window_seconds" field.
+func (_u *NotificationChannelUpdateOne) SetDigestWindowSeconds(v int64) *NotificationChannelUpdateOne {
+	_u.mutation.ResetDigestWindowSeconds()
+	_u.mutation.SetDigestWindowSeconds(v)
+	return _u
+}
+
+// SetNillableDigestWindowSeconds sets the "digest_window_seconds" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableDigestWindowSeconds(v *int64) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetDigestWindowSeconds(*v)
+	}
+	return _u
+}
+
+// AddDigestWindowSeconds adds value to the "digest_window_seconds" field.
+func (_u *NotificationChannelUpdateOne) AddDigestWindowSeconds(v int64) *NotificationChannelUpdateOne {
+	_u.mutation.AddDigestWindowSeconds(v)
+	return _u
+}
+
+// SetDailySummaryAt sets the "daily_summary_at" field.
+func (_u *NotificationChannelUpdateOne) SetDailySummaryAt(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetDailySummaryAt(v)
+	return _u
+}
+
+// SetNillableDailySummaryAt sets the "daily_summary_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableDailySummaryAt(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetDailySummaryAt(*v)
+	}
+	return _u
+}
+
+// ClearDailySummaryAt clears the value of the "daily_summary_at" field.
+func (_u *NotificationChannelUpdateOne) ClearDailySummaryAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearDailySummaryAt()
+	return _u
+}
+
+// SetDailySummaryTimezone sets the "daily_summary_timezone" field.
+func (_u *NotificationChannelUpdateOne) SetDailySummaryTimezone(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetDailySummaryTimezone(v)
+	return _u
+}
+
+// SetNillableDailySummaryTimezone sets the "daily_summary_timezone" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableDailySummaryTimezone(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetDailySummaryTimezone(*v)
+	}
+	return _u
+}
+
+// ClearDailySummaryTimezone clears the value of the "daily_summary_timezone" field.
+func (_u *NotificationChannelUpdateOne) ClearDailySummaryTimezone() *NotificationChannelUpdateOne {
+	_u.mutation.ClearDailySummaryTimezone()
+	return _u
+}
+
+// SetDailySummarySentAt sets the "daily_summary_sent_at" field.
+func (_u *NotificationChannelUpdateOne) SetDailySummarySentAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetDailySummarySentAt(v)
+	return _u
+}
+
+// SetNillableDailySummarySentAt sets the "daily_summary_sent_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableDailySummarySentAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetDailySummarySentAt(*v)
+	}
+	return _u
+}
+
+// ClearDailySummarySentAt clears the value of the "daily_summary_sent_at" field.
+func (_u *NotificationChannelUpdateOne) ClearDailySummarySentAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearDailySummarySentAt()
+	return _u
+}
+
+// SetLastTestedAt sets the "last_tested_at" field.
+func (_u *NotificationChannelUpdateOne) SetLastTestedAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetLastTestedAt(v)
+	return _u
+}
+
+// SetNillableLastTestedAt sets the "last_tested_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableLastTestedAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetLastTestedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastTestedAt clears the value of the "last_tested_at" field.
+func (_u *NotificationChannelUpdateOne) ClearLastTestedAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearLastTestedAt()
+	return _u
+}
+
+// SetLastTestSuccess sets the "last_test_success" field.
+func (_u *NotificationChannelUpdateOne) SetLastTestSuccess(v bool) *NotificationChannelUpdateOne {
+	_u.mutation.SetLastTestSuccess(v)
+	return _u
+}
+
+// SetNillableLastTestSuccess sets the "last_test_success" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableLastTestSuccess(v *bool) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetLastTestSuccess(*v)
+	}
+	return _u
+}
+
+// ClearLastTestSuccess clears the value of the "last_test_success" field.
+func (_u *NotificationChannelUpdateOne) ClearLastTestSuccess() *NotificationChannelUpdateOne {
+	_u.mutation.ClearLastTestSuccess()
+	return _u
+}
+
+// SetLastTestError sets the "last_test_error" field.
+func (_u *NotificationChannelUpdateOne) SetLastTestError(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetLastTestError(v)
+	return _u
+}
+
+// SetNillableLastTestError sets the "last_test_error" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableLastTestError(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetLastTestError(*v)
+	}
+	return _u
+}
+
+// ClearLastTestError clears the value of the "last_test_error" field.
+func (_u *NotificationChannelUpdateOne) ClearLastTestError() *NotificationChannelUpdateOne {
+	_u.mutation.ClearLastTestError()
+	return _u
+}
+
+// SetVerificationCodeHash sets the "verification_code_hash" field.
+func (_u *NotificationChannelUpdateOne) SetVerificationCodeHash(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetVerificationCodeHash(v)
+	return _u
+}
+
+// SetNillableVerificationCodeHash sets the "verification_code_hash" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableVerificationCodeHash(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetVerificationCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearVerificationCodeHash clears the value of the "verification_code_hash" field.
+func (_u *NotificationChannelUpdateOne) ClearVerificationCodeHash() *NotificationChannelUpdateOne {
+	_u.mutation.ClearVerificationCodeHash()
+	return _u
+}
+
+// SetVerificationExpiresAt sets the "verification_expires_at" field.
+func (_u *NotificationChannelUpdateOne) SetVerificationExpiresAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetVerificationExpiresAt(v)
+	return _u
+}
+
+// SetNillableVerificationExpiresAt sets the "verification_expires_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableVerificationExpiresAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetVerificationExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationExpiresAt clears the value of the "verification_expires_at" field.
+func (_u *NotificationChannelUpdateOne) ClearVerificationExpiresAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearVerificationExpiresAt()
+	return _u
+}
+
+// SetVerificationAttempts sets the "verification_attempts" field.
+func (_u *NotificationChannelUpdateOne) SetVerificationAttempts(v int) *NotificationChannelUpdateOne {
+	_u.mutation.ResetVerificationAttempts()
+	_u.mutation.SetVerificationAttempts(v)
+	return _u
+}
+
+// SetNillableVerificationAttempts sets the "verification_attempts" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableVerificationAttempts(v *int) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetVerificationAttempts(*v)
+	}
+	return _u
+}
+
+// AddVerificationAttempts adds value to the "verification_attempts" field.
+func (_u *NotificationChannelUpdateOne) AddVerificationAttempts(v int) *NotificationChannelUpdateOne {
+	_u.mutation.AddVerificationAttempts(v)
+	return _u
+}
+
+// SetVerificationSentAt sets the "verification_sent_at" field.
+func (_u *NotificationChannelUpdateOne) SetVerificationSentAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetVerificationSentAt(v)
+	return _u
+}
+
+// SetNillableVerificationSentAt sets the "verification_sent_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableVerificationSentAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetVerificationSentAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationSentAt clears the value of the "verification_sent_at" field.
+func (_u *NotificationChannelUpdateOne) ClearVerificationSentAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearVerificationSentAt()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *NotificationChannelUpdateOne) SetConsecutiveFailures(v int) *NotificationChannelUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableConsecutiveFailures(v *int) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *NotificationChannelUpdateOne) AddConsecutiveFailures(v int) *NotificationChannelUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *NotificationChannelUpdateOne) SetLastError(v string) *NotificationChannelUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableLastError(v *string) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *NotificationChannelUpdateOne) ClearLastError() *NotificationChannelUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_u *NotificationChannelUpdateOne) SetLastFailureAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetLastFailureAt(v)
+	return _u
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableLastFailureAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetLastFailureAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFailureAt clears the value of the "last_failure_at" field.
+func (_u *NotificationChannelUpdateOne) ClearLastFailureAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearLastFailureAt()
+	return _u
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (_u *NotificationChannelUpdateOne) SetLastSuccessAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetLastSuccessAt(v)
+	return _u
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableLastSuccessAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetLastSuccessAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (_u *NotificationChannelUpdateOne) ClearLastSuccessAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearLastSuccessAt()
+	return _u
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (_u *NotificationChannelUpdateOne) SetDisabledAt(v time.Time) *NotificationChannelUpdateOne {
+	_u.mutation.SetDisabledAt(v)
+	return _u
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (_u *NotificationChannelUpdateOne) SetNillableDisabledAt(v *time.Time) *NotificationChannelUpdateOne {
+	if v != nil {
+		_u.SetDisabledAt(*v)
+	}
+	return _u
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (_u *NotificationChannelUpdateOne) ClearDisabledAt() *NotificationChannelUpdateOne {
+	_u.mutation.ClearDisabledAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *NotificationChannelUpdateOne) SetUpdatedAt(v time.Time) *NotificationChannelUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -461,6 +1310,21 @@ func (_u *NotificationChannelUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DigestWindowSeconds(); ok {
+		if err := notificationchannel.DigestWindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "digest_window_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.digest_window_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationAttempts(); ok {
+		if err := notificationchannel.VerificationAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "verification_attempts", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.verification_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := notificationchannel.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.consecutive_failures": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "NotificationChannel.user"`)
 	}
@@ -517,6 +1381,114 @@ func (_u *NotificationChannelUpdateOne) sqlSave(ctx context.Context) (_node *Not
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(notificationchannel.FieldPriority, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.TitleTemplate(); ok {
+		_spec.SetField(notificationchannel.FieldTitleTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TitleTemplateCleared() {
+		_spec.ClearField(notificationchannel.FieldTitleTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodyTemplate(); ok {
+		_spec.SetField(notificationchannel.FieldBodyTemplate, field.TypeString, value)
+	}
+	if _u.mutation.BodyTemplateCleared() {
+		_spec.ClearField(notificationchannel.FieldBodyTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.DigestWindowSeconds(); ok {
+		_spec.SetField(notificationchannel.FieldDigestWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDigestWindowSeconds(); ok {
+		_spec.AddField(notificationchannel.FieldDigestWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DailySummaryAt(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummaryAt, field.TypeString, value)
+	}
+	if _u.mutation.DailySummaryAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDailySummaryAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.DailySummaryTimezone(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummaryTimezone, field.TypeString, value)
+	}
+	if _u.mutation.DailySummaryTimezoneCleared() {
+		_spec.ClearField(notificationchannel.FieldDailySummaryTimezone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DailySummarySentAt(); ok {
+		_spec.SetField(notificationchannel.FieldDailySummarySentAt, field.TypeTime, value)
+	}
+	if _u.mutation.DailySummarySentAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDailySummarySentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTestedAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastTestedAtCleared() {
+		_spec.ClearField(notificationchannel.FieldLastTestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTestSuccess(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestSuccess, field.TypeBool, value)
+	}
+	if _u.mutation.LastTestSuccessCleared() {
+		_spec.ClearField(notificationchannel.FieldLastTestSuccess, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LastTestError(); ok {
+		_spec.SetField(notificationchannel.FieldLastTestError, field.TypeString, value)
+	}
+	if _u.mutation.LastTestErrorCleared() {
+		_spec.ClearField(notificationchannel.FieldLastTestError, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationCodeHash(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.VerificationCodeHashCleared() {
+		_spec.ClearField(notificationchannel.FieldVerificationCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationExpiresAt(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationExpiresAtCleared() {
+		_spec.ClearField(notificationchannel.FieldVerificationExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerificationAttempts(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVerificationAttempts(); ok {
+		_spec.AddField(notificationchannel.FieldVerificationAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.VerificationSentAt(); ok {
+		_spec.SetField(notificationchannel.FieldVerificationSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationSentAtCleared() {
+		_spec.ClearField(notificationchannel.FieldVerificationSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(notificationchannel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(notificationchannel.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(notificationchannel.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(notificationchannel.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastFailureAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFailureAtCleared() {
+		_spec.ClearField(notificationchannel.FieldLastFailureAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSuccessAt(); ok {
+		_spec.SetField(notificationchannel.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(notificationchannel.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisabledAt(); ok {
+		_spec.SetField(notificationchannel.FieldDisabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.DisabledAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDisabledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(notificationchannel.FieldUpdatedAt, field.TypeTime, value)
 	}
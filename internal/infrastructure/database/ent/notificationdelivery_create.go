@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+)
+
+// NotificationDeliveryCreate is the builder for creating a NotificationDelivery entity.
+type NotificationDeliveryCreate struct {
+	config
+	mutation *NotificationDeliveryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *NotificationDeliveryCreate) SetUserID(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetFollowID sets the "follow_id" field.
+func (_c *NotificationDeliveryCreate) SetFollowID(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetFollowID(v)
+	return _c
+}
+
+// SetNillableFollowID sets the "follow_id" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableFollowID(v *int64) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetFollowID(*v)
+	}
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *NotificationDeliveryCreate) SetChannelID(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetChannelType sets the "channel_type" field.
+func (_c *NotificationDeliveryCreate) SetChannelType(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetChannelType(v)
+	return _c
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_c *NotificationDeliveryCreate) SetStreamerID(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetStreamerID(v)
+	return _c
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableStreamerID(v *int64) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetStreamerID(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *NotificationDeliveryCreate) SetEventType(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *NotificationDeliveryCreate) SetPayload(v map[string]interface{}) *NotificationDeliveryCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetResponse sets the "response" field.
+func (_c *NotificationDeliveryCreate) SetResponse(v map[string]interface{}) *NotificationDeliveryCreate {
+	_c.mutation.SetResponse(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *NotificationDeliveryCreate) SetStatus(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *NotificationDeliveryCreate) SetAttempts(v int) *NotificationDeliveryCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableAttempts(v *int) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_c *NotificationDeliveryCreate) SetLatencyMs(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetLatencyMs(v)
+	return _c
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableLatencyMs(v *int64) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetLatencyMs(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *NotificationDeliveryCreate) SetError(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableError(v *string) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *NotificationDeliveryCreate) SetNextAttemptAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableNextAttemptAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *NotificationDeliveryCreate) SetLockedUntil(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableLockedUntil(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *NotificationDeliveryCreate) SetDeliveredAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableDeliveredAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetRouteID sets the "route_id" field.
+func (_c *NotificationDeliveryCreate) SetRouteID(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetRouteID(v)
+	return _c
+}
+
+// SetNillableRouteID sets the "route_id" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableRouteID(v *string) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetRouteID(*v)
+	}
+	return _c
+}
+
+// SetRouteMode sets the "route_mode" field.
+func (_c *NotificationDeliveryCreate) SetRouteMode(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetRouteMode(v)
+	return _c
+}
+
+// SetNillableRouteMode sets the "route_mode" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableRouteMode(v *string) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetRouteMode(*v)
+	}
+	return _c
+}
+
+// SetRouteStep sets the "route_step" field.
+func (_c *NotificationDeliveryCreate) SetRouteStep(v int) *NotificationDeliveryCreate {
+	_c.mutation.SetRouteStep(v)
+	return _c
+}
+
+// SetNillableRouteStep sets the "route_step" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableRouteStep(v *int) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetRouteStep(*v)
+	}
+	return _c
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_c *NotificationDeliveryCreate) SetEscalateAfterSeconds(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetEscalateAfterSeconds(v)
+	return _c
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableEscalateAfterSeconds(v *int64) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetEscalateAfterSeconds(*v)
+	}
+	return _c
+}
+
+// SetDigestID sets the "digest_id" field.
+func (_c *NotificationDeliveryCreate) SetDigestID(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetDigestID(v)
+	return _c
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableDigestID(v *int64) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetDigestID(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_c *NotificationDeliveryCreate) SetAcknowledgedAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetAcknowledgedAt(v)
+	return _c
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableAcknowledgedAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetAcknowledgedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationDeliveryCreate) SetCreatedAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableCreatedAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NotificationDeliveryCreate) SetUpdatedAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableUpdatedAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationDeliveryCreate) SetID(v int64) *NotificationDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *NotificationDeliveryCreate) SetUser(v *User) *NotificationDeliveryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (_c *NotificationDeliveryCreate) Mutation() *NotificationDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationDelivery in the database.
+func (_c *NotificationDeliveryCreate) Save(ctx context.Context) (*NotificationDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationDeliveryCreate) SaveX(ctx context.Context) *NotificationDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.Payload(); !ok {
+		v := notificationdelivery.DefaultPayload
+		_c.mutation.SetPayload(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := notificationdelivery.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		v := notificationdelivery.DefaultLatencyMs
+		_c.mutation.SetLatencyMs(v)
+	}
+	if _, ok := _c.mutation.RouteStep(); !ok {
+		v := notificationdelivery.DefaultRouteStep
+		_c.mutation.SetRouteStep(v)
+	}
+	if _, ok := _c.mutation.EscalateAfterSeconds(); !ok {
+		v := notificationdelivery.DefaultEscalateAfterSeconds
+		_c.mutation.SetEscalateAfterSeconds(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationDeliveryCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "NotificationDelivery.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := notificationdelivery.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "NotificationDelivery.channel_id"`)}
+	}
+	if v, ok := _c.mutation.ChannelID(); ok {
+		if err := notificationdelivery.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChannelType(); !ok {
+		return &ValidationError{Name: "channel_type", err: errors.New(`ent: missing required field "NotificationDelivery.channel_type"`)}
+	}
+	if v, ok := _c.mutation.ChannelType(); ok {
+		if err := notificationdelivery.ChannelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "channel_type", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "NotificationDelivery.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := notificationdelivery.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NotificationDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "NotificationDelivery.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := notificationdelivery.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "NotificationDelivery.latency_ms"`)}
+	}
+	if v, ok := _c.mutation.LatencyMs(); ok {
+		if err := notificationdelivery.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RouteStep(); !ok {
+		return &ValidationError{Name: "route_step", err: errors.New(`ent: missing required field "NotificationDelivery.route_step"`)}
+	}
+	if v, ok := _c.mutation.RouteStep(); ok {
+		if err := notificationdelivery.RouteStepValidator(v); err != nil {
+			return &ValidationError{Name: "route_step", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.route_step": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EscalateAfterSeconds(); !ok {
+		return &ValidationError{Name: "escalate_after_seconds", err: errors.New(`ent: missing required field "NotificationDelivery.escalate_after_seconds"`)}
+	}
+	if v, ok := _c.mutation.EscalateAfterSeconds(); ok {
+		if err := notificationdelivery.EscalateAfterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "escalate_after_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.escalate_after_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationDelivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationDelivery.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationDelivery.user"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationDeliveryCreate) sqlSave(ctx context.Context) (*NotificationDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationDeliveryCreate) createSpec() (*NotificationDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdelivery.Table, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.FollowID(); ok {
+		_spec.SetField(notificationdelivery.FieldFollowID, field.TypeInt64, value)
+		_node.FollowID = &value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(notificationdelivery.FieldChannelID, field.TypeInt64, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.ChannelType(); ok {
+		_spec.SetField(notificationdelivery.FieldChannelType, field.TypeString, value)
+		_node.ChannelType = value
+	}
+	if value, ok := _c.mutation.StreamerID(); ok {
+		_spec.SetField(notificationdelivery.FieldStreamerID, field.TypeInt64, value)
+		_node.StreamerID = &value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(notificationdelivery.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(notificationdelivery.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Response(); ok {
+		_spec.SetField(notificationdelivery.FieldResponse, field.TypeJSON, value)
+		_node.Response = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LatencyMs(); ok {
+		_spec.SetField(notificationdelivery.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(notificationdelivery.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(notificationdelivery.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := _c.mutation.RouteID(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteID, field.TypeString, value)
+		_node.RouteID = &value
+	}
+	if value, ok := _c.mutation.RouteMode(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteMode, field.TypeString, value)
+		_node.RouteMode = &value
+	}
+	if value, ok := _c.mutation.RouteStep(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteStep, field.TypeInt, value)
+		_node.RouteStep = value
+	}
+	if value, ok := _c.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(notificationdelivery.FieldEscalateAfterSeconds, field.TypeInt64, value)
+		_node.EscalateAfterSeconds = value
+	}
+	if value, ok := _c.mutation.DigestID(); ok {
+		_spec.SetField(notificationdelivery.FieldDigestID, field.TypeInt64, value)
+		_node.DigestID = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldAcknowledgedAt, field.TypeTime, value)
+		_node.AcknowledgedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationDeliveryCreateBulk is the builder for creating many NotificationDelivery entities in bulk.
+type NotificationDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDeliveryCreate
+}
+
+// Save creates the NotificationDelivery entities in the database.
+func (_c *NotificationDeliveryCreateBulk) Save(ctx context.Context) ([]*NotificationDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationDeliveryCreateBulk) SaveX(ctx context.Context) []*NotificationDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
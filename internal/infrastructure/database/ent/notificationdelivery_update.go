@@ -0,0 +1,1457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+)
+
+// NotificationDeliveryUpdate is the builder for updating NotificationDelivery entities.
+type NotificationDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (_u *NotificationDeliveryUpdate) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationDeliveryUpdate) SetUserID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableUserID(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetFollowID sets the "follow_id" field.
+func (_u *NotificationDeliveryUpdate) SetFollowID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.ResetFollowID()
+	_u.mutation.SetFollowID(v)
+	return _u
+}
+
+// SetNillableFollowID sets the "follow_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableFollowID(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetFollowID(*v)
+	}
+	return _u
+}
+
+// AddFollowID adds value to the "follow_id" field.
+func (_u *NotificationDeliveryUpdate) AddFollowID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.AddFollowID(v)
+	return _u
+}
+
+// ClearFollowID clears the value of the "follow_id" field.
+func (_u *NotificationDeliveryUpdate) ClearFollowID() *NotificationDeliveryUpdate {
+	_u.mutation.ClearFollowID()
+	return _u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_u *NotificationDeliveryUpdate) SetChannelID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.ResetChannelID()
+	_u.mutation.SetChannelID(v)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableChannelID(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetChannelID(*v)
+	}
+	return _u
+}
+
+// AddChannelID adds value to the "channel_id" field.
+func (_u *NotificationDeliveryUpdate) AddChannelID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.AddChannelID(v)
+	return _u
+}
+
+// SetChannelType sets the "channel_type" field.
+func (_u *NotificationDeliveryUpdate) SetChannelType(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetChannelType(v)
+	return _u
+}
+
+// SetNillableChannelType sets the "channel_type" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableChannelType(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetChannelType(*v)
+	}
+	return _u
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_u *NotificationDeliveryUpdate) SetStreamerID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.ResetStreamerID()
+	_u.mutation.SetStreamerID(v)
+	return _u
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableStreamerID(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetStreamerID(*v)
+	}
+	return _u
+}
+
+// AddStreamerID adds value to the "streamer_id" field.
+func (_u *NotificationDeliveryUpdate) AddStreamerID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.AddStreamerID(v)
+	return _u
+}
+
+// ClearStreamerID clears the value of the "streamer_id" field.
+func (_u *NotificationDeliveryUpdate) ClearStreamerID() *NotificationDeliveryUpdate {
+	_u.mutation.ClearStreamerID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *NotificationDeliveryUpdate) SetEventType(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableEventType(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *NotificationDeliveryUpdate) SetPayload(v map[string]interface{}) *NotificationDeliveryUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *NotificationDeliveryUpdate) ClearPayload() *NotificationDeliveryUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetResponse sets the "response" field.
+func (_u *NotificationDeliveryUpdate) SetResponse(v map[string]interface{}) *NotificationDeliveryUpdate {
+	_u.mutation.SetResponse(v)
+	return _u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (_u *NotificationDeliveryUpdate) ClearResponse() *NotificationDeliveryUpdate {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NotificationDeliveryUpdate) SetStatus(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableStatus(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NotificationDeliveryUpdate) SetAttempts(v int) *NotificationDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableAttempts(v *int) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NotificationDeliveryUpdate) AddAttempts(v int) *NotificationDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *NotificationDeliveryUpdate) SetLatencyMs(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableLatencyMs(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *NotificationDeliveryUpdate) AddLatencyMs(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *NotificationDeliveryUpdate) SetError(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableError(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *NotificationDeliveryUpdate) ClearError() *NotificationDeliveryUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *NotificationDeliveryUpdate) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableNextAttemptAt(v *time.Time) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *NotificationDeliveryUpdate) ClearNextAttemptAt() *NotificationDeliveryUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *NotificationDeliveryUpdate) SetLockedUntil(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableLockedUntil(v *time.Time) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *NotificationDeliveryUpdate) ClearLockedUntil() *NotificationDeliveryUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *NotificationDeliveryUpdate) SetDeliveredAt(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableDeliveredAt(v *time.Time) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *NotificationDeliveryUpdate) ClearDeliveredAt() *NotificationDeliveryUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetRouteID sets the "route_id" field.
+func (_u *NotificationDeliveryUpdate) SetRouteID(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetRouteID(v)
+	return _u
+}
+
+// SetNillableRouteID sets the "route_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableRouteID(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetRouteID(*v)
+	}
+	return _u
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (_u *NotificationDeliveryUpdate) ClearRouteID() *NotificationDeliveryUpdate {
+	_u.mutation.ClearRouteID()
+	return _u
+}
+
+// SetRouteMode sets the "route_mode" field.
+func (_u *NotificationDeliveryUpdate) SetRouteMode(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetRouteMode(v)
+	return _u
+}
+
+// SetNillableRouteMode sets the "route_mode" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableRouteMode(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetRouteMode(*v)
+	}
+	return _u
+}
+
+// ClearRouteMode clears the value of the "route_mode" field.
+func (_u *NotificationDeliveryUpdate) ClearRouteMode() *NotificationDeliveryUpdate {
+	_u.mutation.ClearRouteMode()
+	return _u
+}
+
+// SetRouteStep sets the "route_step" field.
+func (_u *NotificationDeliveryUpdate) SetRouteStep(v int) *NotificationDeliveryUpdate {
+	_u.mutation.ResetRouteStep()
+	_u.mutation.SetRouteStep(v)
+	return _u
+}
+
+// SetNillableRouteStep sets the "route_step" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableRouteStep(v *int) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetRouteStep(*v)
+	}
+	return _u
+}
+
+// AddRouteStep adds value to the "route_step" field.
+func (_u *NotificationDeliveryUpdate) AddRouteStep(v int) *NotificationDeliveryUpdate {
+	_u.mutation.AddRouteStep(v)
+	return _u
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_u *NotificationDeliveryUpdate) SetEscalateAfterSeconds(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.ResetEscalateAfterSeconds()
+	_u.mutation.SetEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableEscalateAfterSeconds(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetEscalateAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddEscalateAfterSeconds adds value to the "escalate_after_seconds" field.
+func (_u *NotificationDeliveryUpdate) AddEscalateAfterSeconds(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.AddEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetDigestID sets the "digest_id" field.
+func (_u *NotificationDeliveryUpdate) SetDigestID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.ResetDigestID()
+	_u.mutation.SetDigestID(v)
+	return _u
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableDigestID(v *int64) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetDigestID(*v)
+	}
+	return _u
+}
+
+// AddDigestID adds value to the "digest_id" field.
+func (_u *NotificationDeliveryUpdate) AddDigestID(v int64) *NotificationDeliveryUpdate {
+	_u.mutation.AddDigestID(v)
+	return _u
+}
+
+// ClearDigestID clears the value of the "digest_id" field.
+func (_u *NotificationDeliveryUpdate) ClearDigestID() *NotificationDeliveryUpdate {
+	_u.mutation.ClearDigestID()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *NotificationDeliveryUpdate) SetAcknowledgedAt(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableAcknowledgedAt(v *time.Time) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *NotificationDeliveryUpdate) ClearAcknowledgedAt() *NotificationDeliveryUpdate {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationDeliveryUpdate) SetUpdatedAt(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdate) SetUser(v *User) *NotificationDeliveryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (_u *NotificationDeliveryUpdate) Mutation() *NotificationDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdate) ClearUser() *NotificationDeliveryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := notificationdelivery.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChannelID(); ok {
+		if err := notificationdelivery.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChannelType(); ok {
+		if err := notificationdelivery.ChannelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "channel_type", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := notificationdelivery.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := notificationdelivery.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LatencyMs(); ok {
+		if err := notificationdelivery.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RouteStep(); ok {
+		if err := notificationdelivery.RouteStepValidator(v); err != nil {
+			return &ValidationError{Name: "route_step", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.route_step": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		if err := notificationdelivery.EscalateAfterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "escalate_after_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.escalate_after_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FollowID(); ok {
+		_spec.SetField(notificationdelivery.FieldFollowID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFollowID(); ok {
+		_spec.AddField(notificationdelivery.FieldFollowID, field.TypeInt64, value)
+	}
+	if _u.mutation.FollowIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldFollowID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ChannelID(); ok {
+		_spec.SetField(notificationdelivery.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChannelID(); ok {
+		_spec.AddField(notificationdelivery.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChannelType(); ok {
+		_spec.SetField(notificationdelivery.FieldChannelType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StreamerID(); ok {
+		_spec.SetField(notificationdelivery.FieldStreamerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStreamerID(); ok {
+		_spec.AddField(notificationdelivery.FieldStreamerID, field.TypeInt64, value)
+	}
+	if _u.mutation.StreamerIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldStreamerID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(notificationdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(notificationdelivery.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(notificationdelivery.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Response(); ok {
+		_spec.SetField(notificationdelivery.FieldResponse, field.TypeJSON, value)
+	}
+	if _u.mutation.ResponseCleared() {
+		_spec.ClearField(notificationdelivery.FieldResponse, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(notificationdelivery.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(notificationdelivery.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(notificationdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(notificationdelivery.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(notificationdelivery.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RouteID(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteID, field.TypeString, value)
+	}
+	if _u.mutation.RouteIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldRouteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RouteMode(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteMode, field.TypeString, value)
+	}
+	if _u.mutation.RouteModeCleared() {
+		_spec.ClearField(notificationdelivery.FieldRouteMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.RouteStep(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRouteStep(); ok {
+		_spec.AddField(notificationdelivery.FieldRouteStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(notificationdelivery.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEscalateAfterSeconds(); ok {
+		_spec.AddField(notificationdelivery.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DigestID(); ok {
+		_spec.SetField(notificationdelivery.FieldDigestID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDigestID(); ok {
+		_spec.AddField(notificationdelivery.FieldDigestID, field.TypeInt64, value)
+	}
+	if _u.mutation.DigestIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldDigestID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationDeliveryUpdateOne is the builder for updating a single NotificationDelivery entity.
+type NotificationDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetUserID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableUserID(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetFollowID sets the "follow_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetFollowID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetFollowID()
+	_u.mutation.SetFollowID(v)
+	return _u
+}
+
+// SetNillableFollowID sets the "follow_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableFollowID(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetFollowID(*v)
+	}
+	return _u
+}
+
+// AddFollowID adds value to the "follow_id" field.
+func (_u *NotificationDeliveryUpdateOne) AddFollowID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddFollowID(v)
+	return _u
+}
+
+// ClearFollowID clears the value of the "follow_id" field.
+func (_u *NotificationDeliveryUpdateOne) ClearFollowID() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearFollowID()
+	return _u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetChannelID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetChannelID()
+	_u.mutation.SetChannelID(v)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableChannelID(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetChannelID(*v)
+	}
+	return _u
+}
+
+// AddChannelID adds value to the "channel_id" field.
+func (_u *NotificationDeliveryUpdateOne) AddChannelID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddChannelID(v)
+	return _u
+}
+
+// SetChannelType sets the "channel_type" field.
+func (_u *NotificationDeliveryUpdateOne) SetChannelType(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetChannelType(v)
+	return _u
+}
+
+// SetNillableChannelType sets the "channel_type" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableChannelType(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetChannelType(*v)
+	}
+	return _u
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetStreamerID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetStreamerID()
+	_u.mutation.SetStreamerID(v)
+	return _u
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableStreamerID(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStreamerID(*v)
+	}
+	return _u
+}
+
+// AddStreamerID adds value to the "streamer_id" field.
+func (_u *NotificationDeliveryUpdateOne) AddStreamerID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddStreamerID(v)
+	return _u
+}
+
+// ClearStreamerID clears the value of the "streamer_id" field.
+func (_u *NotificationDeliveryUpdateOne) ClearStreamerID() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearStreamerID()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *NotificationDeliveryUpdateOne) SetEventType(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableEventType(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *NotificationDeliveryUpdateOne) SetPayload(v map[string]interface{}) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *NotificationDeliveryUpdateOne) ClearPayload() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetResponse sets the "response" field.
+func (_u *NotificationDeliveryUpdateOne) SetResponse(v map[string]interface{}) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetResponse(v)
+	return _u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (_u *NotificationDeliveryUpdateOne) ClearResponse() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NotificationDeliveryUpdateOne) SetStatus(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableStatus(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NotificationDeliveryUpdateOne) SetAttempts(v int) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableAttempts(v *int) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NotificationDeliveryUpdateOne) AddAttempts(v int) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *NotificationDeliveryUpdateOne) SetLatencyMs(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableLatencyMs(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *NotificationDeliveryUpdateOne) AddLatencyMs(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *NotificationDeliveryUpdateOne) SetError(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableError(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *NotificationDeliveryUpdateOne) ClearError() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *NotificationDeliveryUpdateOne) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableNextAttemptAt(v *time.Time) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *NotificationDeliveryUpdateOne) ClearNextAttemptAt() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *NotificationDeliveryUpdateOne) SetLockedUntil(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableLockedUntil(v *time.Time) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *NotificationDeliveryUpdateOne) ClearLockedUntil() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *NotificationDeliveryUpdateOne) SetDeliveredAt(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableDeliveredAt(v *time.Time) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *NotificationDeliveryUpdateOne) ClearDeliveredAt() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetRouteID sets the "route_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetRouteID(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetRouteID(v)
+	return _u
+}
+
+// SetNillableRouteID sets the "route_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableRouteID(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetRouteID(*v)
+	}
+	return _u
+}
+
+// ClearRouteID clears the value of the "route_id" field.
+func (_u *NotificationDeliveryUpdateOne) ClearRouteID() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearRouteID()
+	return _u
+}
+
+// SetRouteMode sets the "route_mode" field.
+func (_u *NotificationDeliveryUpdateOne) SetRouteMode(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetRouteMode(v)
+	return _u
+}
+
+// SetNillableRouteMode sets the "route_mode" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableRouteMode(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetRouteMode(*v)
+	}
+	return _u
+}
+
+// ClearRouteMode clears the value of the "route_mode" field.
+func (_u *NotificationDeliveryUpdateOne) ClearRouteMode() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearRouteMode()
+	return _u
+}
+
+// SetRouteStep sets the "route_step" field.
+func (_u *NotificationDeliveryUpdateOne) SetRouteStep(v int) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetRouteStep()
+	_u.mutation.SetRouteStep(v)
+	return _u
+}
+
+// SetNillableRouteStep sets the "route_step" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableRouteStep(v *int) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetRouteStep(*v)
+	}
+	return _u
+}
+
+// AddRouteStep adds value to the "route_step" field.
+func (_u *NotificationDeliveryUpdateOne) AddRouteStep(v int) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddRouteStep(v)
+	return _u
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_u *NotificationDeliveryUpdateOne) SetEscalateAfterSeconds(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetEscalateAfterSeconds()
+	_u.mutation.SetEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableEscalateAfterSeconds(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEscalateAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddEscalateAfterSeconds adds value to the "escalate_after_seconds" field.
+func (_u *NotificationDeliveryUpdateOne) AddEscalateAfterSeconds(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetDigestID sets the "digest_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetDigestID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetDigestID()
+	_u.mutation.SetDigestID(v)
+	return _u
+}
+
+// SetNillableDigestID sets the "digest_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableDigestID(v *int64) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDigestID(*v)
+	}
+	return _u
+}
+
+// AddDigestID adds value to the "digest_id" field.
+func (_u *NotificationDeliveryUpdateOne) AddDigestID(v int64) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddDigestID(v)
+	return _u
+}
+
+// ClearDigestID clears the value of the "digest_id" field.
+func (_u *NotificationDeliveryUpdateOne) ClearDigestID() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearDigestID()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *NotificationDeliveryUpdateOne) SetAcknowledgedAt(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableAcknowledgedAt(v *time.Time) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *NotificationDeliveryUpdateOne) ClearAcknowledgedAt() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationDeliveryUpdateOne) SetUpdatedAt(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdateOne) SetUser(v *User) *NotificationDeliveryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (_u *NotificationDeliveryUpdateOne) Mutation() *NotificationDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdateOne) ClearUser() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (_u *NotificationDeliveryUpdateOne) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationDeliveryUpdateOne) Select(field string, fields ...string) *NotificationDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationDelivery entity.
+func (_u *NotificationDeliveryUpdateOne) Save(ctx context.Context) (*NotificationDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdateOne) SaveX(ctx context.Context) *NotificationDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := notificationdelivery.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChannelID(); ok {
+		if err := notificationdelivery.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChannelType(); ok {
+		if err := notificationdelivery.ChannelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "channel_type", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := notificationdelivery.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := notificationdelivery.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LatencyMs(); ok {
+		if err := notificationdelivery.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RouteStep(); ok {
+		if err := notificationdelivery.RouteStepValidator(v); err != nil {
+			return &ValidationError{Name: "route_step", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.route_step": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		if err := notificationdelivery.EscalateAfterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "escalate_after_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.escalate_after_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *NotificationDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdelivery.FieldID)
+		for _, f := range fields {
+			if !notificationdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FollowID(); ok {
+		_spec.SetField(notificationdelivery.FieldFollowID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFollowID(); ok {
+		_spec.AddField(notificationdelivery.FieldFollowID, field.TypeInt64, value)
+	}
+	if _u.mutation.FollowIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldFollowID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ChannelID(); ok {
+		_spec.SetField(notificationdelivery.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChannelID(); ok {
+		_spec.AddField(notificationdelivery.FieldChannelID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChannelType(); ok {
+		_spec.SetField(notificationdelivery.FieldChannelType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StreamerID(); ok {
+		_spec.SetField(notificationdelivery.FieldStreamerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStreamerID(); ok {
+		_spec.AddField(notificationdelivery.FieldStreamerID, field.TypeInt64, value)
+	}
+	if _u.mutation.StreamerIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldStreamerID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(notificationdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(notificationdelivery.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(notificationdelivery.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Response(); ok {
+		_spec.SetField(notificationdelivery.FieldResponse, field.TypeJSON, value)
+	}
+	if _u.mutation.ResponseCleared() {
+		_spec.ClearField(notificationdelivery.FieldResponse, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(notificationdelivery.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(notificationdelivery.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(notificationdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(notificationdelivery.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(notificationdelivery.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RouteID(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteID, field.TypeString, value)
+	}
+	if _u.mutation.RouteIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldRouteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RouteMode(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteMode, field.TypeString, value)
+	}
+	if _u.mutation.RouteModeCleared() {
+		_spec.ClearField(notificationdelivery.FieldRouteMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.RouteStep(); ok {
+		_spec.SetField(notificationdelivery.FieldRouteStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRouteStep(); ok {
+		_spec.AddField(notificationdelivery.FieldRouteStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(notificationdelivery.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEscalateAfterSeconds(); ok {
+		_spec.AddField(notificationdelivery.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DigestID(); ok {
+		_spec.SetField(notificationdelivery.FieldDigestID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDigestID(); ok {
+		_spec.AddField(notificationdelivery.FieldDigestID, field.TypeInt64, value)
+	}
+	if _u.mutation.DigestIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldDigestID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
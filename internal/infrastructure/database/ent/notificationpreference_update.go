@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationPreferenceUpdate) SetUserID(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableUserID(v *int64) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRoutingMode sets the "routing_mode" field.
+func (_u *NotificationPreferenceUpdate) SetRoutingMode(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetRoutingMode(v)
+	return _u
+}
+
+// SetNillableRoutingMode sets the "routing_mode" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableRoutingMode(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetRoutingMode(*v)
+	}
+	return _u
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_u *NotificationPreferenceUpdate) SetEscalateAfterSeconds(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.ResetEscalateAfterSeconds()
+	_u.mutation.SetEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEscalateAfterSeconds(v *int64) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEscalateAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddEscalateAfterSeconds adds value to the "escalate_after_seconds" field.
+func (_u *NotificationPreferenceUpdate) AddEscalateAfterSeconds(v int64) *NotificationPreferenceUpdate {
+	_u.mutation.AddEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (_u *NotificationPreferenceUpdate) SetQuietHours(v map[string]interface{}) *NotificationPreferenceUpdate {
+	_u.mutation.SetQuietHours(v)
+	return _u
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (_u *NotificationPreferenceUpdate) ClearQuietHours() *NotificationPreferenceUpdate {
+	_u.mutation.ClearQuietHours()
+	return _u
+}
+
+// SetDoNotDisturbUntil sets the "do_not_disturb_until" field.
+func (_u *NotificationPreferenceUpdate) SetDoNotDisturbUntil(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetDoNotDisturbUntil(v)
+	return _u
+}
+
+// SetNillableDoNotDisturbUntil sets the "do_not_disturb_until" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableDoNotDisturbUntil(v *time.Time) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetDoNotDisturbUntil(*v)
+	}
+	return _u
+}
+
+// ClearDoNotDisturbUntil clears the value of the "do_not_disturb_until" field.
+func (_u *NotificationPreferenceUpdate) ClearDoNotDisturbUntil() *NotificationPreferenceUpdate {
+	_u.mutation.ClearDoNotDisturbUntil()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdate) SetUpdatedAt(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdate) SetUser(v *User) *NotificationPreferenceUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdate) ClearUser() *NotificationPreferenceUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := notificationpreference.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoutingMode(); ok {
+		if err := notificationpreference.RoutingModeValidator(v); err != nil {
+			return &ValidationError{Name: "routing_mode", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.routing_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		if err := notificationpreference.EscalateAfterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "escalate_after_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.escalate_after_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RoutingMode(); ok {
+		_spec.SetField(notificationpreference.FieldRoutingMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(notificationpreference.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEscalateAfterSeconds(); ok {
+		_spec.AddField(notificationpreference.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.QuietHours(); ok {
+		_spec.SetField(notificationpreference.FieldQuietHours, field.TypeJSON, value)
+	}
+	if _u.mutation.QuietHoursCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DoNotDisturbUntil(); ok {
+		_spec.SetField(notificationpreference.FieldDoNotDisturbUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DoNotDisturbUntilCleared() {
+		_spec.ClearField(notificationpreference.FieldDoNotDisturbUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationPreferenceUpdateOne) SetUserID(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableUserID(v *int64) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRoutingMode sets the "routing_mode" field.
+func (_u *NotificationPreferenceUpdateOne) SetRoutingMode(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetRoutingMode(v)
+	return _u
+}
+
+// SetNillableRoutingMode sets the "routing_mode" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableRoutingMode(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetRoutingMode(*v)
+	}
+	return _u
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_u *NotificationPreferenceUpdateOne) SetEscalateAfterSeconds(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.ResetEscalateAfterSeconds()
+	_u.mutation.SetEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEscalateAfterSeconds(v *int64) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEscalateAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddEscalateAfterSeconds adds value to the "escalate_after_seconds" field.
+func (_u *NotificationPreferenceUpdateOne) AddEscalateAfterSeconds(v int64) *NotificationPreferenceUpdateOne {
+	_u.mutation.AddEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (_u *NotificationPreferenceUpdateOne) SetQuietHours(v map[string]interface{}) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetQuietHours(v)
+	return _u
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (_u *NotificationPreferenceUpdateOne) ClearQuietHours() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearQuietHours()
+	return _u
+}
+
+// SetDoNotDisturbUntil sets the "do_not_disturb_until" field.
+func (_u *NotificationPreferenceUpdateOne) SetDoNotDisturbUntil(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetDoNotDisturbUntil(v)
+	return _u
+}
+
+// SetNillableDoNotDisturbUntil sets the "do_not_disturb_until" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableDoNotDisturbUntil(v *time.Time) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetDoNotDisturbUntil(*v)
+	}
+	return _u
+}
+
+// ClearDoNotDisturbUntil clears the value of the "do_not_disturb_until" field.
+func (_u *NotificationPreferenceUpdateOne) ClearDoNotDisturbUntil() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearDoNotDisturbUntil()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdateOne) SetUpdatedAt(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdateOne) SetUser(v *User) *NotificationPreferenceUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdateOne) ClearUser() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (_u *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := notificationpreference.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoutingMode(); ok {
+		if err := notificationpreference.RoutingModeValidator(v); err != nil {
+			return &ValidationError{Name: "routing_mode", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.routing_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		if err := notificationpreference.EscalateAfterSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "escalate_after_seconds", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.escalate_after_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RoutingMode(); ok {
+		_spec.SetField(notificationpreference.FieldRoutingMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(notificationpreference.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEscalateAfterSeconds(); ok {
+		_spec.AddField(notificationpreference.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.QuietHours(); ok {
+		_spec.SetField(notificationpreference.FieldQuietHours, field.TypeJSON, value)
+	}
+	if _u.mutation.QuietHoursCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DoNotDisturbUntil(); ok {
+		_spec.SetField(notificationpreference.FieldDoNotDisturbUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DoNotDisturbUntilCleared() {
+		_spec.ClearField(notificationpreference.FieldDoNotDisturbUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,17 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// InboxMessage is the predicate function for inboxmessage builders.
+type InboxMessage func(*sql.Selector)
+
 // NotificationChannel is the predicate function for notificationchannel builders.
 type NotificationChannel func(*sql.Selector)
 
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// StreamSession is the predicate function for streamsession builders.
+type StreamSession func(*sql.Selector)
+
 // Streamer is the predicate function for streamer builders.
 type Streamer func(*sql.Selector)
 
 // StreamingPlatform is the predicate function for streamingplatform builders.
 type StreamingPlatform func(*sql.Selector)
 
+// SystemSetting is the predicate function for systemsetting builders.
+type SystemSetting func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // UserFollowedStreamer is the predicate function for userfollowedstreamer builders.
 type UserFollowedStreamer func(*sql.Selector)
+
+// WebPushSubscription is the predicate function for webpushsubscription builders.
+type WebPushSubscription func(*sql.Selector)
@@ -5,11 +5,17 @@ package ent
 import (
 	"time"
 
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamingplatform"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/systemsetting"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/schema"
 )
 
@@ -17,6 +23,24 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	inboxmessageFields := schema.InboxMessage{}.Fields()
+	_ = inboxmessageFields
+	// inboxmessageDescUserID is the schema descriptor for user_id field.
+	inboxmessageDescUserID := inboxmessageFields[1].Descriptor()
+	// inboxmessage.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	inboxmessage.UserIDValidator = inboxmessageDescUserID.Validators[0].(func(int64) error)
+	// inboxmessageDescChannelID is the schema descriptor for channel_id field.
+	inboxmessageDescChannelID := inboxmessageFields[2].Descriptor()
+	// inboxmessage.ChannelIDValidator is a validator for the "channel_id" field. It is called by the builders before save.
+	inboxmessage.ChannelIDValidator = inboxmessageDescChannelID.Validators[0].(func(int64) error)
+	// inboxmessageDescEventType is the schema descriptor for event_type field.
+	inboxmessageDescEventType := inboxmessageFields[4].Descriptor()
+	// inboxmessage.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	inboxmessage.EventTypeValidator = inboxmessageDescEventType.Validators[0].(func(string) error)
+	// inboxmessageDescCreatedAt is the schema descriptor for created_at field.
+	inboxmessageDescCreatedAt := inboxmessageFields[12].Descriptor()
+	// inboxmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inboxmessage.DefaultCreatedAt = inboxmessageDescCreatedAt.Default.(func() time.Time)
 	notificationchannelFields := schema.NotificationChannel{}.Fields()
 	_ = notificationchannelFields
 	// notificationchannelDescUserID is the schema descriptor for user_id field.
@@ -43,16 +67,142 @@ func init() {
 	notificationchannelDescPriority := notificationchannelFields[6].Descriptor()
 	// notificationchannel.DefaultPriority holds the default value on creation for the priority field.
 	notificationchannel.DefaultPriority = notificationchannelDescPriority.Default.(int)
+	// notificationchannelDescDigestWindowSeconds is the schema descriptor for digest_window_seconds field.
+	notificationchannelDescDigestWindowSeconds := notificationchannelFields[9].Descriptor()
+	// notificationchannel.DefaultDigestWindowSeconds holds the default value on creation for the digest_window_seconds field.
+	notificationchannel.DefaultDigestWindowSeconds = notificationchannelDescDigestWindowSeconds.Default.(int64)
+	// notificationchannel.DigestWindowSecondsValidator is a validator for the "digest_window_seconds" field. It is called by the builders before save.
+	notificationchannel.DigestWindowSecondsValidator = notificationchannelDescDigestWindowSeconds.Validators[0].(func(int64) error)
+	// notificationchannelDescVerificationAttempts is the schema descriptor for verification_attempts field.
+	notificationchannelDescVerificationAttempts := notificationchannelFields[18].Descriptor()
+	// notificationchannel.DefaultVerificationAttempts holds the default value on creation for the verification_attempts field.
+	notificationchannel.DefaultVerificationAttempts = notificationchannelDescVerificationAttempts.Default.(int)
+	// notificationchannel.VerificationAttemptsValidator is a validator for the "verification_attempts" field. It is called by the builders before save.
+	notificationchannel.VerificationAttemptsValidator = notificationchannelDescVerificationAttempts.Validators[0].(func(int) error)
+	// notificationchannelDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	notificationchannelDescConsecutiveFailures := notificationchannelFields[20].Descriptor()
+	// notificationchannel.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	notificationchannel.DefaultConsecutiveFailures = notificationchannelDescConsecutiveFailures.Default.(int)
+	// notificationchannel.ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	notificationchannel.ConsecutiveFailuresValidator = notificationchannelDescConsecutiveFailures.Validators[0].(func(int) error)
 	// notificationchannelDescCreatedAt is the schema descriptor for created_at field.
-	notificationchannelDescCreatedAt := notificationchannelFields[7].Descriptor()
+	notificationchannelDescCreatedAt := notificationchannelFields[25].Descriptor()
 	// notificationchannel.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notificationchannel.DefaultCreatedAt = notificationchannelDescCreatedAt.Default.(func() time.Time)
 	// notificationchannelDescUpdatedAt is the schema descriptor for updated_at field.
-	notificationchannelDescUpdatedAt := notificationchannelFields[8].Descriptor()
+	notificationchannelDescUpdatedAt := notificationchannelFields[26].Descriptor()
 	// notificationchannel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notificationchannel.DefaultUpdatedAt = notificationchannelDescUpdatedAt.Default.(func() time.Time)
 	// notificationchannel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	notificationchannel.UpdateDefaultUpdatedAt = notificationchannelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	notificationdeliveryFields := schema.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescUserID is the schema descriptor for user_id field.
+	notificationdeliveryDescUserID := notificationdeliveryFields[1].Descriptor()
+	// notificationdelivery.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notificationdelivery.UserIDValidator = notificationdeliveryDescUserID.Validators[0].(func(int64) error)
+	// notificationdeliveryDescChannelID is the schema descriptor for channel_id field.
+	notificationdeliveryDescChannelID := notificationdeliveryFields[3].Descriptor()
+	// notificationdelivery.ChannelIDValidator is a validator for the "channel_id" field. It is called by the builders before save.
+	notificationdelivery.ChannelIDValidator = notificationdeliveryDescChannelID.Validators[0].(func(int64) error)
+	// notificationdeliveryDescChannelType is the schema descriptor for channel_type field.
+	notificationdeliveryDescChannelType := notificationdeliveryFields[4].Descriptor()
+	// notificationdelivery.ChannelTypeValidator is a validator for the "channel_type" field. It is called by the builders before save.
+	notificationdelivery.ChannelTypeValidator = notificationdeliveryDescChannelType.Validators[0].(func(string) error)
+	// notificationdeliveryDescEventType is the schema descriptor for event_type field.
+	notificationdeliveryDescEventType := notificationdeliveryFields[6].Descriptor()
+	// notificationdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	notificationdelivery.EventTypeValidator = notificationdeliveryDescEventType.Validators[0].(func(string) error)
+	// notificationdeliveryDescPayload is the schema descriptor for payload field.
+	notificationdeliveryDescPayload := notificationdeliveryFields[7].Descriptor()
+	// notificationdelivery.DefaultPayload holds the default value on creation for the payload field.
+	notificationdelivery.DefaultPayload = notificationdeliveryDescPayload.Default.(map[string]interface{})
+	// notificationdeliveryDescStatus is the schema descriptor for status field.
+	notificationdeliveryDescStatus := notificationdeliveryFields[9].Descriptor()
+	// notificationdelivery.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	notificationdelivery.StatusValidator = notificationdeliveryDescStatus.Validators[0].(func(string) error)
+	// notificationdeliveryDescAttempts is the schema descriptor for attempts field.
+	notificationdeliveryDescAttempts := notificationdeliveryFields[10].Descriptor()
+	// notificationdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	notificationdelivery.DefaultAttempts = notificationdeliveryDescAttempts.Default.(int)
+	// notificationdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	notificationdelivery.AttemptsValidator = notificationdeliveryDescAttempts.Validators[0].(func(int) error)
+	// notificationdeliveryDescLatencyMs is the schema descriptor for latency_ms field.
+	notificationdeliveryDescLatencyMs := notificationdeliveryFields[11].Descriptor()
+	// notificationdelivery.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	notificationdelivery.DefaultLatencyMs = notificationdeliveryDescLatencyMs.Default.(int64)
+	// notificationdelivery.LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	notificationdelivery.LatencyMsValidator = notificationdeliveryDescLatencyMs.Validators[0].(func(int64) error)
+	// notificationdeliveryDescRouteStep is the schema descriptor for route_step field.
+	notificationdeliveryDescRouteStep := notificationdeliveryFields[18].Descriptor()
+	// notificationdelivery.DefaultRouteStep holds the default value on creation for the route_step field.
+	notificationdelivery.DefaultRouteStep = notificationdeliveryDescRouteStep.Default.(int)
+	// notificationdelivery.RouteStepValidator is a validator for the "route_step" field. It is called by the builders before save.
+	notificationdelivery.RouteStepValidator = notificationdeliveryDescRouteStep.Validators[0].(func(int) error)
+	// notificationdeliveryDescEscalateAfterSeconds is the schema descriptor for escalate_after_seconds field.
+	notificationdeliveryDescEscalateAfterSeconds := notificationdeliveryFields[19].Descriptor()
+	// notificationdelivery.DefaultEscalateAfterSeconds holds the default value on creation for the escalate_after_seconds field.
+	notificationdelivery.DefaultEscalateAfterSeconds = notificationdeliveryDescEscalateAfterSeconds.Default.(int64)
+	// notificationdelivery.EscalateAfterSecondsValidator is a validator for the "escalate_after_seconds" field. It is called by the builders before save.
+	notificationdelivery.EscalateAfterSecondsValidator = notificationdeliveryDescEscalateAfterSeconds.Validators[0].(func(int64) error)
+	// notificationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notificationdeliveryDescCreatedAt := notificationdeliveryFields[22].Descriptor()
+	// notificationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdelivery.DefaultCreatedAt = notificationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notificationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdeliveryDescUpdatedAt := notificationdeliveryFields[23].Descriptor()
+	// notificationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdelivery.DefaultUpdatedAt = notificationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notificationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdelivery.UpdateDefaultUpdatedAt = notificationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescUserID is the schema descriptor for user_id field.
+	notificationpreferenceDescUserID := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notificationpreference.UserIDValidator = notificationpreferenceDescUserID.Validators[0].(func(int64) error)
+	// notificationpreferenceDescRoutingMode is the schema descriptor for routing_mode field.
+	notificationpreferenceDescRoutingMode := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.RoutingModeValidator is a validator for the "routing_mode" field. It is called by the builders before save.
+	notificationpreference.RoutingModeValidator = notificationpreferenceDescRoutingMode.Validators[0].(func(string) error)
+	// notificationpreferenceDescEscalateAfterSeconds is the schema descriptor for escalate_after_seconds field.
+	notificationpreferenceDescEscalateAfterSeconds := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultEscalateAfterSeconds holds the default value on creation for the escalate_after_seconds field.
+	notificationpreference.DefaultEscalateAfterSeconds = notificationpreferenceDescEscalateAfterSeconds.Default.(int64)
+	// notificationpreference.EscalateAfterSecondsValidator is a validator for the "escalate_after_seconds" field. It is called by the builders before save.
+	notificationpreference.EscalateAfterSecondsValidator = notificationpreferenceDescEscalateAfterSeconds.Validators[0].(func(int64) error)
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceFields[6].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceFields[7].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	streamsessionFields := schema.StreamSession{}.Fields()
+	_ = streamsessionFields
+	// streamsessionDescStreamerID is the schema descriptor for streamer_id field.
+	streamsessionDescStreamerID := streamsessionFields[1].Descriptor()
+	// streamsession.StreamerIDValidator is a validator for the "streamer_id" field. It is called by the builders before save.
+	streamsession.StreamerIDValidator = streamsessionDescStreamerID.Validators[0].(func(int64) error)
+	// streamsessionDescPeakViewers is the schema descriptor for peak_viewers field.
+	streamsessionDescPeakViewers := streamsessionFields[9].Descriptor()
+	// streamsession.DefaultPeakViewers holds the default value on creation for the peak_viewers field.
+	streamsession.DefaultPeakViewers = streamsessionDescPeakViewers.Default.(int)
+	// streamsession.PeakViewersValidator is a validator for the "peak_viewers" field. It is called by the builders before save.
+	streamsession.PeakViewersValidator = streamsessionDescPeakViewers.Validators[0].(func(int) error)
+	// streamsessionDescCreatedAt is the schema descriptor for created_at field.
+	streamsessionDescCreatedAt := streamsessionFields[13].Descriptor()
+	// streamsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	streamsession.DefaultCreatedAt = streamsessionDescCreatedAt.Default.(func() time.Time)
+	// streamsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	streamsessionDescUpdatedAt := streamsessionFields[14].Descriptor()
+	// streamsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	streamsession.DefaultUpdatedAt = streamsessionDescUpdatedAt.Default.(func() time.Time)
+	// streamsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	streamsession.UpdateDefaultUpdatedAt = streamsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	streamerFields := schema.Streamer{}.Fields()
 	_ = streamerFields
 	// streamerDescPlatformType is the schema descriptor for platform_type field.
@@ -121,6 +271,22 @@ func init() {
 	streamingplatform.DefaultUpdatedAt = streamingplatformDescUpdatedAt.Default.(func() time.Time)
 	// streamingplatform.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	streamingplatform.UpdateDefaultUpdatedAt = streamingplatformDescUpdatedAt.UpdateDefault.(func() time.Time)
+	systemsettingFields := schema.SystemSetting{}.Fields()
+	_ = systemsettingFields
+	// systemsettingDescKey is the schema descriptor for key field.
+	systemsettingDescKey := systemsettingFields[1].Descriptor()
+	// systemsetting.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	systemsetting.KeyValidator = systemsettingDescKey.Validators[0].(func(string) error)
+	// systemsettingDescCreatedAt is the schema descriptor for created_at field.
+	systemsettingDescCreatedAt := systemsettingFields[3].Descriptor()
+	// systemsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	systemsetting.DefaultCreatedAt = systemsettingDescCreatedAt.Default.(func() time.Time)
+	// systemsettingDescUpdatedAt is the schema descriptor for updated_at field.
+	systemsettingDescUpdatedAt := systemsettingFields[4].Descriptor()
+	// systemsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	systemsetting.DefaultUpdatedAt = systemsettingDescUpdatedAt.Default.(func() time.Time)
+	// systemsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	systemsetting.UpdateDefaultUpdatedAt = systemsettingDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -136,11 +302,11 @@ func init() {
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[5].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[5].Descriptor()
+	userDescUpdatedAt := userFields[6].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -163,14 +329,54 @@ func init() {
 	userfollowedstreamerDescNotificationChannelIds := userfollowedstreamerFields[6].Descriptor()
 	// userfollowedstreamer.DefaultNotificationChannelIds holds the default value on creation for the notification_channel_ids field.
 	userfollowedstreamer.DefaultNotificationChannelIds = userfollowedstreamerDescNotificationChannelIds.Default.([]int64)
+	// userfollowedstreamerDescAlwaysNotify is the schema descriptor for always_notify field.
+	userfollowedstreamerDescAlwaysNotify := userfollowedstreamerFields[11].Descriptor()
+	// userfollowedstreamer.DefaultAlwaysNotify holds the default value on creation for the always_notify field.
+	userfollowedstreamer.DefaultAlwaysNotify = userfollowedstreamerDescAlwaysNotify.Default.(bool)
+	// userfollowedstreamerDescNotifyStreamEnd is the schema descriptor for notify_stream_end field.
+	userfollowedstreamerDescNotifyStreamEnd := userfollowedstreamerFields[12].Descriptor()
+	// userfollowedstreamer.DefaultNotifyStreamEnd holds the default value on creation for the notify_stream_end field.
+	userfollowedstreamer.DefaultNotifyStreamEnd = userfollowedstreamerDescNotifyStreamEnd.Default.(bool)
+	// userfollowedstreamerDescNotifyTitleChange is the schema descriptor for notify_title_change field.
+	userfollowedstreamerDescNotifyTitleChange := userfollowedstreamerFields[13].Descriptor()
+	// userfollowedstreamer.DefaultNotifyTitleChange holds the default value on creation for the notify_title_change field.
+	userfollowedstreamer.DefaultNotifyTitleChange = userfollowedstreamerDescNotifyTitleChange.Default.(bool)
 	// userfollowedstreamerDescCreatedAt is the schema descriptor for created_at field.
-	userfollowedstreamerDescCreatedAt := userfollowedstreamerFields[8].Descriptor()
+	userfollowedstreamerDescCreatedAt := userfollowedstreamerFields[20].Descriptor()
 	// userfollowedstreamer.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userfollowedstreamer.DefaultCreatedAt = userfollowedstreamerDescCreatedAt.Default.(func() time.Time)
 	// userfollowedstreamerDescUpdatedAt is the schema descriptor for updated_at field.
-	userfollowedstreamerDescUpdatedAt := userfollowedstreamerFields[9].Descriptor()
+	userfollowedstreamerDescUpdatedAt := userfollowedstreamerFields[21].Descriptor()
 	// userfollowedstreamer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	userfollowedstreamer.DefaultUpdatedAt = userfollowedstreamerDescUpdatedAt.Default.(func() time.Time)
 	// userfollowedstreamer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	userfollowedstreamer.UpdateDefaultUpdatedAt = userfollowedstreamerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webpushsubscriptionFields := schema.WebPushSubscription{}.Fields()
+	_ = webpushsubscriptionFields
+	// webpushsubscriptionDescUserID is the schema descriptor for user_id field.
+	webpushsubscriptionDescUserID := webpushsubscriptionFields[1].Descriptor()
+	// webpushsubscription.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	webpushsubscription.UserIDValidator = webpushsubscriptionDescUserID.Validators[0].(func(int64) error)
+	// webpushsubscriptionDescEndpoint is the schema descriptor for endpoint field.
+	webpushsubscriptionDescEndpoint := webpushsubscriptionFields[2].Descriptor()
+	// webpushsubscription.EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
+	webpushsubscription.EndpointValidator = webpushsubscriptionDescEndpoint.Validators[0].(func(string) error)
+	// webpushsubscriptionDescP256dh is the schema descriptor for p256dh field.
+	webpushsubscriptionDescP256dh := webpushsubscriptionFields[3].Descriptor()
+	// webpushsubscription.P256dhValidator is a validator for the "p256dh" field. It is called by the builders before save.
+	webpushsubscription.P256dhValidator = webpushsubscriptionDescP256dh.Validators[0].(func(string) error)
+	// webpushsubscriptionDescAuth is the schema descriptor for auth field.
+	webpushsubscriptionDescAuth := webpushsubscriptionFields[4].Descriptor()
+	// webpushsubscription.AuthValidator is a validator for the "auth" field. It is called by the builders before save.
+	webpushsubscription.AuthValidator = webpushsubscriptionDescAuth.Validators[0].(func(string) error)
+	// webpushsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webpushsubscriptionDescCreatedAt := webpushsubscriptionFields[6].Descriptor()
+	// webpushsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webpushsubscription.DefaultCreatedAt = webpushsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webpushsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webpushsubscriptionDescUpdatedAt := webpushsubscriptionFields[7].Descriptor()
+	// webpushsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webpushsubscription.DefaultUpdatedAt = webpushsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// webpushsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webpushsubscription.UpdateDefaultUpdatedAt = webpushsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -62,9 +62,11 @@ type Streamer struct {
 type StreamerEdges struct {
 	// Followers holds the value of the followers edge.
 	Followers []*UserFollowedStreamer `json:"followers,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*StreamSession `json:"sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // FollowersOrErr returns the Followers value or an error if the edge
@@ -76,6 +78,15 @@ func (e StreamerEdges) FollowersOrErr() ([]*UserFollowedStreamer, error) {
 	return nil, &NotLoadedError{edge: "followers"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e StreamerEdges) SessionsOrErr() ([]*StreamSession, error) {
+	if e.loadedTypes[1] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Streamer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -244,6 +255,11 @@ func (_m *Streamer) QueryFollowers() *UserFollowedStreamerQuery {
 	return NewStreamerClient(_m.config).QueryFollowers(_m)
 }
 
+// QuerySessions queries the "sessions" edge of the Streamer entity.
+func (_m *Streamer) QuerySessions() *StreamSessionQuery {
+	return NewStreamerClient(_m.config).QuerySessions(_m)
+}
+
 // Update returns a builder for updating this Streamer.
 // Note that you need to call Streamer.Unwrap() before calling this method if this Streamer
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -50,6 +50,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeFollowers holds the string denoting the followers edge name in mutations.
 	EdgeFollowers = "followers"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
 	// Table holds the table name of the streamer in the database.
 	Table = "streamers"
 	// FollowersTable is the table that holds the followers relation/edge.
@@ -59,6 +61,13 @@ const (
 	FollowersInverseTable = "user_followed_streamers"
 	// FollowersColumn is the table column denoting the followers relation/edge.
 	FollowersColumn = "streamer_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "stream_sessions"
+	// SessionsInverseTable is the table name for the StreamSession entity.
+	// It exists in this package in order to avoid circular dependency with the "streamsession" package.
+	SessionsInverseTable = "stream_sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "streamer_id"
 )
 
 // Columns holds all SQL columns for streamer fields.
@@ -213,6 +222,20 @@ func ByFollowers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFollowersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFollowersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -220,3 +243,10 @@ func newFollowersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FollowersTable, FollowersColumn),
 	)
 }
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
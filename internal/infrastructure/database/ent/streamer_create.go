@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
 )
 
@@ -248,6 +249,21 @@ func (_c *StreamerCreate) AddFollowers(v ...*UserFollowedStreamer) *StreamerCrea
 	return _c.AddFollowerIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the StreamSession entity by IDs.
+func (_c *StreamerCreate) AddSessionIDs(ids ...int64) *StreamerCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the StreamSession entity.
+func (_c *StreamerCreate) AddSessions(v ...*StreamSession) *StreamerCreate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
 // Mutation returns the StreamerMutation object of the builder.
 func (_c *StreamerCreate) Mutation() *StreamerMutation {
 	return _c.mutation
@@ -452,6 +468,22 @@ func (_c *StreamerCreate) createSpec() (*Streamer, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,11 +9,13 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
 )
 
@@ -25,6 +27,8 @@ type StreamerQuery struct {
 	inters        []Interceptor
 	predicates    []predicate.Streamer
 	withFollowers *UserFollowedStreamerQuery
+	withSessions  *StreamSessionQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +87,28 @@ func (_q *StreamerQuery) QueryFollowers() *UserFollowedStreamerQuery {
 	return query
 }
 
+// QuerySessions chains the current query on the "sessions" edge.
+func (_q *StreamerQuery) QuerySessions() *StreamSessionQuery {
+	query := (&StreamSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(streamer.Table, streamer.FieldID, selector),
+			sqlgraph.To(streamsession.Table, streamsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, streamer.SessionsTable, streamer.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Streamer entity from the query.
 // Returns a *NotFoundError when no Streamer was found.
 func (_q *StreamerQuery) First(ctx context.Context) (*Streamer, error) {
@@ -276,6 +302,7 @@ func (_q *StreamerQuery) Clone() *StreamerQuery {
 		inters:        append([]Interceptor{}, _q.inters...),
 		predicates:    append([]predicate.Streamer{}, _q.predicates...),
 		withFollowers: _q.withFollowers.Clone(),
+		withSessions:  _q.withSessions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +320,17 @@ func (_q *StreamerQuery) WithFollowers(opts ...func(*UserFollowedStreamerQuery))
 	return _q
 }
 
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StreamerQuery) WithSessions(opts ...func(*StreamSessionQuery)) *StreamerQuery {
+	query := (&StreamSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSessions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +409,9 @@ func (_q *StreamerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 	var (
 		nodes       = []*Streamer{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withFollowers != nil,
+			_q.withSessions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +423,9 @@ func (_q *StreamerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -400,6 +442,13 @@ func (_q *StreamerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 			return nil, err
 		}
 	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *Streamer) { n.Edges.Sessions = []*StreamSession{} },
+			func(n *Streamer, e *StreamSession) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,9 +482,42 @@ func (_q *StreamerQuery) loadFollowers(ctx context.Context, query *UserFollowedS
 	}
 	return nil
 }
+func (_q *StreamerQuery) loadSessions(ctx context.Context, query *StreamSessionQuery, nodes []*Streamer, init func(*Streamer), assign func(*Streamer, *StreamSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Streamer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(streamsession.FieldStreamerID)
+	}
+	query.Where(predicate.StreamSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(streamer.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StreamerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "streamer_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *StreamerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -498,6 +580,9 @@ func (_q *StreamerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -515,6 +600,32 @@ func (_q *StreamerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *StreamerQuery) ForUpdate(opts ...sql.LockOption) *StreamerQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *StreamerQuery) ForShare(opts ...sql.LockOption) *StreamerQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // StreamerGroupBy is the group-by builder for Streamer entities.
 type StreamerGroupBy struct {
 	selector
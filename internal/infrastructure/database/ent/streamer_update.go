@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
 )
 
@@ -332,6 +333,21 @@ func (_u *StreamerUpdate) AddFollowers(v ...*UserFollowedStreamer) *StreamerUpda
 	return _u.AddFollowerIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the StreamSession entity by IDs.
+func (_u *StreamerUpdate) AddSessionIDs(ids ...int64) *StreamerUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the StreamSession entity.
+func (_u *StreamerUpdate) AddSessions(v ...*StreamSession) *StreamerUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
 // Mutation returns the StreamerMutation object of the builder.
 func (_u *StreamerUpdate) Mutation() *StreamerMutation {
 	return _u.mutation
@@ -358,6 +374,27 @@ func (_u *StreamerUpdate) RemoveFollowers(v ...*UserFollowedStreamer) *StreamerU
 	return _u.RemoveFollowerIDs(ids...)
 }
 
+// ClearSessions clears all "sessions" edges to the StreamSession entity.
+func (_u *StreamerUpdate) ClearSessions() *StreamerUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to StreamSession entities by IDs.
+func (_u *StreamerUpdate) RemoveSessionIDs(ids ...int64) *StreamerUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to StreamSession entities.
+func (_u *StreamerUpdate) RemoveSessions(v ...*StreamSession) *StreamerUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *StreamerUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -560,6 +597,51 @@ func (_u *StreamerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{streamer.Label}
@@ -882,6 +964,21 @@ func (_u *StreamerUpdateOne) AddFollowers(v ...*UserFollowedStreamer) *StreamerU
 	return _u.AddFollowerIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the StreamSession entity by IDs.
+func (_u *StreamerUpdateOne) AddSessionIDs(ids ...int64) *StreamerUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the StreamSession entity.
+func (_u *StreamerUpdateOne) AddSessions(v ...*StreamSession) *StreamerUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
 // Mutation returns the StreamerMutation object of the builder.
 func (_u *StreamerUpdateOne) Mutation() *StreamerMutation {
 	return _u.mutation
@@ -908,6 +1005,27 @@ func (_u *StreamerUpdateOne) RemoveFollowers(v ...*UserFollowedStreamer) *Stream
 	return _u.RemoveFollowerIDs(ids...)
 }
 
+// ClearSessions clears all "sessions" edges to the StreamSession entity.
+func (_u *StreamerUpdateOne) ClearSessions() *StreamerUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to StreamSession entities by IDs.
+func (_u *StreamerUpdateOne) RemoveSessionIDs(ids ...int64) *StreamerUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to StreamSession entities.
+func (_u *StreamerUpdateOne) RemoveSessions(v ...*StreamSession) *StreamerUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
 // Where appends a list predicates to the StreamerUpdate builder.
 func (_u *StreamerUpdateOne) Where(ps ...predicate.Streamer) *StreamerUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1140,6 +1258,51 @@ func (_u *StreamerUpdateOne) sqlSave(ctx context.Context) (_node *Streamer, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   streamer.SessionsTable,
+			Columns: []string{streamer.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Streamer{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+)
+
+// StreamSession is the model entity for the StreamSession schema.
+type StreamSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// StreamerID holds the value of the "streamer_id" field.
+	StreamerID int64 `json:"streamer_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
+	// GameName holds the value of the "game_name" field.
+	GameName *string `json:"game_name,omitempty"`
+	// Titles holds the value of the "titles" field.
+	Titles []string `json:"titles,omitempty"`
+	// Categories holds the value of the "categories" field.
+	Categories []string `json:"categories,omitempty"`
+	// AnnouncedTitle holds the value of the "announced_title" field.
+	AnnouncedTitle *string `json:"announced_title,omitempty"`
+	// AnnouncedGameName holds the value of the "announced_game_name" field.
+	AnnouncedGameName *string `json:"announced_game_name,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt *time.Time `json:"changed_at,omitempty"`
+	// PeakViewers holds the value of the "peak_viewers" field.
+	PeakViewers int `json:"peak_viewers,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StreamSessionQuery when eager-loading is set.
+	Edges        StreamSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StreamSessionEdges holds the relations/edges for other nodes in the graph.
+type StreamSessionEdges struct {
+	// Streamer holds the value of the streamer edge.
+	Streamer *Streamer `json:"streamer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StreamerOrErr returns the Streamer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StreamSessionEdges) StreamerOrErr() (*Streamer, error) {
+	if e.Streamer != nil {
+		return e.Streamer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: streamer.Label}
+	}
+	return nil, &NotLoadedError{edge: "streamer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StreamSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case streamsession.FieldTitles, streamsession.FieldCategories:
+			values[i] = new([]byte)
+		case streamsession.FieldID, streamsession.FieldStreamerID, streamsession.FieldPeakViewers:
+			values[i] = new(sql.NullInt64)
+		case streamsession.FieldTitle, streamsession.FieldGameName, streamsession.FieldAnnouncedTitle, streamsession.FieldAnnouncedGameName:
+			values[i] = new(sql.NullString)
+		case streamsession.FieldChangedAt, streamsession.FieldStartedAt, streamsession.FieldLastSeenAt, streamsession.FieldEndedAt, streamsession.FieldCreatedAt, streamsession.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StreamSession fields.
+func (_m *StreamSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case streamsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case streamsession.FieldStreamerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field streamer_id", values[i])
+			} else if value.Valid {
+				_m.StreamerID = value.Int64
+			}
+		case streamsession.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = new(string)
+				*_m.Title = value.String
+			}
+		case streamsession.FieldGameName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field game_name", values[i])
+			} else if value.Valid {
+				_m.GameName = new(string)
+				*_m.GameName = value.String
+			}
+		case streamsession.FieldTitles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field titles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Titles); err != nil {
+					return fmt.Errorf("unmarshal field titles: %w", err)
+				}
+			}
+		case streamsession.FieldCategories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field categories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Categories); err != nil {
+					return fmt.Errorf("unmarshal field categories: %w", err)
+				}
+			}
+		case streamsession.FieldAnnouncedTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field announced_title", values[i])
+			} else if value.Valid {
+				_m.AnnouncedTitle = new(string)
+				*_m.AnnouncedTitle = value.String
+			}
+		case streamsession.FieldAnnouncedGameName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field announced_game_name", values[i])
+			} else if value.Valid {
+				_m.AnnouncedGameName = new(string)
+				*_m.AnnouncedGameName = value.String
+			}
+		case streamsession.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				_m.ChangedAt = new(time.Time)
+				*_m.ChangedAt = value.Time
+			}
+		case streamsession.FieldPeakViewers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field peak_viewers", values[i])
+			} else if value.Valid {
+				_m.PeakViewers = int(value.Int64)
+			}
+		case streamsession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case streamsession.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case streamsession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case streamsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case streamsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StreamSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *StreamSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStreamer queries the "streamer" edge of the StreamSession entity.
+func (_m *StreamSession) QueryStreamer() *StreamerQuery {
+	return NewStreamSessionClient(_m.config).QueryStreamer(_m)
+}
+
+// Update returns a builder for updating this StreamSession.
+// Note that you need to call StreamSession.Unwrap() before calling this method if this StreamSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StreamSession) Update() *StreamSessionUpdateOne {
+	return NewStreamSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StreamSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StreamSession) Unwrap() *StreamSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StreamSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StreamSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("StreamSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("streamer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StreamerID))
+	builder.WriteString(", ")
+	if v := _m.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.GameName; v != nil {
+		builder.WriteString("game_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("titles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Titles))
+	builder.WriteString(", ")
+	builder.WriteString("categories=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Categories))
+	builder.WriteString(", ")
+	if v := _m.AnnouncedTitle; v != nil {
+		builder.WriteString("announced_title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AnnouncedGameName; v != nil {
+		builder.WriteString("announced_game_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ChangedAt; v != nil {
+		builder.WriteString("changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("peak_viewers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeakViewers))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StreamSessions is a parsable slice of StreamSession.
+type StreamSessions []*StreamSession
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package streamsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the streamsession type in the database.
+	Label = "stream_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStreamerID holds the string denoting the streamer_id field in the database.
+	FieldStreamerID = "streamer_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldGameName holds the string denoting the game_name field in the database.
+	FieldGameName = "game_name"
+	// FieldTitles holds the string denoting the titles field in the database.
+	FieldTitles = "titles"
+	// FieldCategories holds the string denoting the categories field in the database.
+	FieldCategories = "categories"
+	// FieldAnnouncedTitle holds the string denoting the announced_title field in the database.
+	FieldAnnouncedTitle = "announced_title"
+	// FieldAnnouncedGameName holds the string denoting the announced_game_name field in the database.
+	FieldAnnouncedGameName = "announced_game_name"
+	// FieldChangedAt holds the string denoting the changed_at field in the database.
+	FieldChangedAt = "changed_at"
+	// FieldPeakViewers holds the string denoting the peak_viewers field in the database.
+	FieldPeakViewers = "peak_viewers"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeStreamer holds the string denoting the streamer edge name in mutations.
+	EdgeStreamer = "streamer"
+	// Table holds the table name of the streamsession in the database.
+	Table = "stream_sessions"
+	// StreamerTable is the table that holds the streamer relation/edge.
+	StreamerTable = "stream_sessions"
+	// StreamerInverseTable is the table name for the Streamer entity.
+	// It exists in this package in order to avoid circular dependency with the "streamer" package.
+	StreamerInverseTable = "streamers"
+	// StreamerColumn is the table column denoting the streamer relation/edge.
+	StreamerColumn = "streamer_id"
+)
+
+// Columns holds all SQL columns for streamsession fields.
+var Columns = []string{
+	FieldID,
+	FieldStreamerID,
+	FieldTitle,
+	FieldGameName,
+	FieldTitles,
+	FieldCategories,
+	FieldAnnouncedTitle,
+	FieldAnnouncedGameName,
+	FieldChangedAt,
+	FieldPeakViewers,
+	FieldStartedAt,
+	FieldLastSeenAt,
+	FieldEndedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// StreamerIDValidator is a validator for the "streamer_id" field. It is called by the builders before save.
+	StreamerIDValidator func(int64) error
+	// DefaultPeakViewers holds the default value on creation for the "peak_viewers" field.
+	DefaultPeakViewers int
+	// PeakViewersValidator is a validator for the "peak_viewers" field. It is called by the builders before save.
+	PeakViewersValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the StreamSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStreamerID orders the results by the streamer_id field.
+func ByStreamerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreamerID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByGameName orders the results by the game_name field.
+func ByGameName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGameName, opts...).ToFunc()
+}
+
+// ByAnnouncedTitle orders the results by the announced_title field.
+func ByAnnouncedTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnouncedTitle, opts...).ToFunc()
+}
+
+// ByAnnouncedGameName orders the results by the announced_game_name field.
+func ByAnnouncedGameName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnouncedGameName, opts...).ToFunc()
+}
+
+// ByChangedAt orders the results by the changed_at field.
+func ByChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedAt, opts...).ToFunc()
+}
+
+// ByPeakViewers orders the results by the peak_viewers field.
+func ByPeakViewers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeakViewers, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStreamerField orders the results by streamer field.
+func ByStreamerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStreamerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStreamerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StreamerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StreamerTable, StreamerColumn),
+	)
+}
@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package streamsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldID, id))
+}
+
+// StreamerID applies equality check predicate on the "streamer_id" field. It's identical to StreamerIDEQ.
+func StreamerID(v int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldStreamerID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldTitle, v))
+}
+
+// GameName applies equality check predicate on the "game_name" field. It's identical to GameNameEQ.
+func GameName(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldGameName, v))
+}
+
+// AnnouncedTitle applies equality check predicate on the "announced_title" field. It's identical to AnnouncedTitleEQ.
+func AnnouncedTitle(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedGameName applies equality check predicate on the "announced_game_name" field. It's identical to AnnouncedGameNameEQ.
+func AnnouncedGameName(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldAnnouncedGameName, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// PeakViewers applies equality check predicate on the "peak_viewers" field. It's identical to PeakViewersEQ.
+func PeakViewers(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldPeakViewers, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StreamerIDEQ applies the EQ predicate on the "streamer_id" field.
+func StreamerIDEQ(v int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldStreamerID, v))
+}
+
+// StreamerIDNEQ applies the NEQ predicate on the "streamer_id" field.
+func StreamerIDNEQ(v int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldStreamerID, v))
+}
+
+// StreamerIDIn applies the In predicate on the "streamer_id" field.
+func StreamerIDIn(vs ...int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldStreamerID, vs...))
+}
+
+// StreamerIDNotIn applies the NotIn predicate on the "streamer_id" field.
+func StreamerIDNotIn(vs ...int64) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldStreamerID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// GameNameEQ applies the EQ predicate on the "game_name" field.
+func GameNameEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldGameName, v))
+}
+
+// GameNameNEQ applies the NEQ predicate on the "game_name" field.
+func GameNameNEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldGameName, v))
+}
+
+// GameNameIn applies the In predicate on the "game_name" field.
+func GameNameIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldGameName, vs...))
+}
+
+// GameNameNotIn applies the NotIn predicate on the "game_name" field.
+func GameNameNotIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldGameName, vs...))
+}
+
+// GameNameGT applies the GT predicate on the "game_name" field.
+func GameNameGT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldGameName, v))
+}
+
+// GameNameGTE applies the GTE predicate on the "game_name" field.
+func GameNameGTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldGameName, v))
+}
+
+// GameNameLT applies the LT predicate on the "game_name" field.
+func GameNameLT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldGameName, v))
+}
+
+// GameNameLTE applies the LTE predicate on the "game_name" field.
+func GameNameLTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldGameName, v))
+}
+
+// GameNameContains applies the Contains predicate on the "game_name" field.
+func GameNameContains(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContains(FieldGameName, v))
+}
+
+// GameNameHasPrefix applies the HasPrefix predicate on the "game_name" field.
+func GameNameHasPrefix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasPrefix(FieldGameName, v))
+}
+
+// GameNameHasSuffix applies the HasSuffix predicate on the "game_name" field.
+func GameNameHasSuffix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasSuffix(FieldGameName, v))
+}
+
+// GameNameIsNil applies the IsNil predicate on the "game_name" field.
+func GameNameIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldGameName))
+}
+
+// GameNameNotNil applies the NotNil predicate on the "game_name" field.
+func GameNameNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldGameName))
+}
+
+// GameNameEqualFold applies the EqualFold predicate on the "game_name" field.
+func GameNameEqualFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEqualFold(FieldGameName, v))
+}
+
+// GameNameContainsFold applies the ContainsFold predicate on the "game_name" field.
+func GameNameContainsFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContainsFold(FieldGameName, v))
+}
+
+// TitlesIsNil applies the IsNil predicate on the "titles" field.
+func TitlesIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldTitles))
+}
+
+// TitlesNotNil applies the NotNil predicate on the "titles" field.
+func TitlesNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldTitles))
+}
+
+// CategoriesIsNil applies the IsNil predicate on the "categories" field.
+func CategoriesIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldCategories))
+}
+
+// CategoriesNotNil applies the NotNil predicate on the "categories" field.
+func CategoriesNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldCategories))
+}
+
+// AnnouncedTitleEQ applies the EQ predicate on the "announced_title" field.
+func AnnouncedTitleEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleNEQ applies the NEQ predicate on the "announced_title" field.
+func AnnouncedTitleNEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleIn applies the In predicate on the "announced_title" field.
+func AnnouncedTitleIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldAnnouncedTitle, vs...))
+}
+
+// AnnouncedTitleNotIn applies the NotIn predicate on the "announced_title" field.
+func AnnouncedTitleNotIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldAnnouncedTitle, vs...))
+}
+
+// AnnouncedTitleGT applies the GT predicate on the "announced_title" field.
+func AnnouncedTitleGT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleGTE applies the GTE predicate on the "announced_title" field.
+func AnnouncedTitleGTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleLT applies the LT predicate on the "announced_title" field.
+func AnnouncedTitleLT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleLTE applies the LTE predicate on the "announced_title" field.
+func AnnouncedTitleLTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleContains applies the Contains predicate on the "announced_title" field.
+func AnnouncedTitleContains(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContains(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleHasPrefix applies the HasPrefix predicate on the "announced_title" field.
+func AnnouncedTitleHasPrefix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasPrefix(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleHasSuffix applies the HasSuffix predicate on the "announced_title" field.
+func AnnouncedTitleHasSuffix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasSuffix(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleIsNil applies the IsNil predicate on the "announced_title" field.
+func AnnouncedTitleIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldAnnouncedTitle))
+}
+
+// AnnouncedTitleNotNil applies the NotNil predicate on the "announced_title" field.
+func AnnouncedTitleNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldAnnouncedTitle))
+}
+
+// AnnouncedTitleEqualFold applies the EqualFold predicate on the "announced_title" field.
+func AnnouncedTitleEqualFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEqualFold(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedTitleContainsFold applies the ContainsFold predicate on the "announced_title" field.
+func AnnouncedTitleContainsFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContainsFold(FieldAnnouncedTitle, v))
+}
+
+// AnnouncedGameNameEQ applies the EQ predicate on the "announced_game_name" field.
+func AnnouncedGameNameEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameNEQ applies the NEQ predicate on the "announced_game_name" field.
+func AnnouncedGameNameNEQ(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameIn applies the In predicate on the "announced_game_name" field.
+func AnnouncedGameNameIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldAnnouncedGameName, vs...))
+}
+
+// AnnouncedGameNameNotIn applies the NotIn predicate on the "announced_game_name" field.
+func AnnouncedGameNameNotIn(vs ...string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldAnnouncedGameName, vs...))
+}
+
+// AnnouncedGameNameGT applies the GT predicate on the "announced_game_name" field.
+func AnnouncedGameNameGT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameGTE applies the GTE predicate on the "announced_game_name" field.
+func AnnouncedGameNameGTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameLT applies the LT predicate on the "announced_game_name" field.
+func AnnouncedGameNameLT(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameLTE applies the LTE predicate on the "announced_game_name" field.
+func AnnouncedGameNameLTE(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameContains applies the Contains predicate on the "announced_game_name" field.
+func AnnouncedGameNameContains(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContains(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameHasPrefix applies the HasPrefix predicate on the "announced_game_name" field.
+func AnnouncedGameNameHasPrefix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasPrefix(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameHasSuffix applies the HasSuffix predicate on the "announced_game_name" field.
+func AnnouncedGameNameHasSuffix(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldHasSuffix(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameIsNil applies the IsNil predicate on the "announced_game_name" field.
+func AnnouncedGameNameIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldAnnouncedGameName))
+}
+
+// AnnouncedGameNameNotNil applies the NotNil predicate on the "announced_game_name" field.
+func AnnouncedGameNameNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldAnnouncedGameName))
+}
+
+// AnnouncedGameNameEqualFold applies the EqualFold predicate on the "announced_game_name" field.
+func AnnouncedGameNameEqualFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEqualFold(FieldAnnouncedGameName, v))
+}
+
+// AnnouncedGameNameContainsFold applies the ContainsFold predicate on the "announced_game_name" field.
+func AnnouncedGameNameContainsFold(v string) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldContainsFold(FieldAnnouncedGameName, v))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// ChangedAtIsNil applies the IsNil predicate on the "changed_at" field.
+func ChangedAtIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldChangedAt))
+}
+
+// ChangedAtNotNil applies the NotNil predicate on the "changed_at" field.
+func ChangedAtNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldChangedAt))
+}
+
+// PeakViewersEQ applies the EQ predicate on the "peak_viewers" field.
+func PeakViewersEQ(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldPeakViewers, v))
+}
+
+// PeakViewersNEQ applies the NEQ predicate on the "peak_viewers" field.
+func PeakViewersNEQ(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldPeakViewers, v))
+}
+
+// PeakViewersIn applies the In predicate on the "peak_viewers" field.
+func PeakViewersIn(vs ...int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldPeakViewers, vs...))
+}
+
+// PeakViewersNotIn applies the NotIn predicate on the "peak_viewers" field.
+func PeakViewersNotIn(vs ...int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldPeakViewers, vs...))
+}
+
+// PeakViewersGT applies the GT predicate on the "peak_viewers" field.
+func PeakViewersGT(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldPeakViewers, v))
+}
+
+// PeakViewersGTE applies the GTE predicate on the "peak_viewers" field.
+func PeakViewersGTE(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldPeakViewers, v))
+}
+
+// PeakViewersLT applies the LT predicate on the "peak_viewers" field.
+func PeakViewersLT(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldPeakViewers, v))
+}
+
+// PeakViewersLTE applies the LTE predicate on the "peak_viewers" field.
+func PeakViewersLTE(v int) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldPeakViewers, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotNull(FieldEndedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StreamSession {
+	return predicate.StreamSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasStreamer applies the HasEdge predicate on the "streamer" edge.
+func HasStreamer() predicate.StreamSession {
+	return predicate.StreamSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StreamerTable, StreamerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStreamerWith applies the HasEdge predicate on the "streamer" edge with a given conditions (other predicates).
+func HasStreamerWith(preds ...predicate.Streamer) predicate.StreamSession {
+	return predicate.StreamSession(func(s *sql.Selector) {
+		step := newStreamerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StreamSession) predicate.StreamSession {
+	return predicate.StreamSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StreamSession) predicate.StreamSession {
+	return predicate.StreamSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StreamSession) predicate.StreamSession {
+	return predicate.StreamSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+)
+
+// StreamSessionCreate is the builder for creating a StreamSession entity.
+type StreamSessionCreate struct {
+	config
+	mutation *StreamSessionMutation
+	hooks    []Hook
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_c *StreamSessionCreate) SetStreamerID(v int64) *StreamSessionCreate {
+	_c.mutation.SetStreamerID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *StreamSessionCreate) SetTitle(v string) *StreamSessionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableTitle(v *string) *StreamSessionCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetGameName sets the "game_name" field.
+func (_c *StreamSessionCreate) SetGameName(v string) *StreamSessionCreate {
+	_c.mutation.SetGameName(v)
+	return _c
+}
+
+// SetNillableGameName sets the "game_name" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableGameName(v *string) *StreamSessionCreate {
+	if v != nil {
+		_c.SetGameName(*v)
+	}
+	return _c
+}
+
+// SetTitles sets the "titles" field.
+func (_c *StreamSessionCreate) SetTitles(v []string) *StreamSessionCreate {
+	_c.mutation.SetTitles(v)
+	return _c
+}
+
+// SetCategories sets the "categories" field.
+func (_c *StreamSessionCreate) SetCategories(v []string) *StreamSessionCreate {
+	_c.mutation.SetCategories(v)
+	return _c
+}
+
+// SetAnnouncedTitle sets the "announced_title" field.
+func (_c *StreamSessionCreate) SetAnnouncedTitle(v string) *StreamSessionCreate {
+	_c.mutation.SetAnnouncedTitle(v)
+	return _c
+}
+
+// SetNillableAnnouncedTitle sets the "announced_title" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableAnnouncedTitle(v *string) *StreamSessionCreate {
+	if v != nil {
+		_c.SetAnnouncedTitle(*v)
+	}
+	return _c
+}
+
+// SetAnnouncedGameName sets the "announced_game_name" field.
+func (_c *StreamSessionCreate) SetAnnouncedGameName(v string) *StreamSessionCreate {
+	_c.mutation.SetAnnouncedGameName(v)
+	return _c
+}
+
+// SetNillableAnnouncedGameName sets the "announced_game_name" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableAnnouncedGameName(v *string) *StreamSessionCreate {
+	if v != nil {
+		_c.SetAnnouncedGameName(*v)
+	}
+	return _c
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_c *StreamSessionCreate) SetChangedAt(v time.Time) *StreamSessionCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableChangedAt(v *time.Time) *StreamSessionCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetPeakViewers sets the "peak_viewers" field.
+func (_c *StreamSessionCreate) SetPeakViewers(v int) *StreamSessionCreate {
+	_c.mutation.SetPeakViewers(v)
+	return _c
+}
+
+// SetNillablePeakViewers sets the "peak_viewers" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillablePeakViewers(v *int) *StreamSessionCreate {
+	if v != nil {
+		_c.SetPeakViewers(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *StreamSessionCreate) SetStartedAt(v time.Time) *StreamSessionCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *StreamSessionCreate) SetLastSeenAt(v time.Time) *StreamSessionCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *StreamSessionCreate) SetEndedAt(v time.Time) *StreamSessionCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableEndedAt(v *time.Time) *StreamSessionCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StreamSessionCreate) SetCreatedAt(v time.Time) *StreamSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableCreatedAt(v *time.Time) *StreamSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StreamSessionCreate) SetUpdatedAt(v time.Time) *StreamSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StreamSessionCreate) SetNillableUpdatedAt(v *time.Time) *StreamSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StreamSessionCreate) SetID(v int64) *StreamSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetStreamer sets the "streamer" edge to the Streamer entity.
+func (_c *StreamSessionCreate) SetStreamer(v *Streamer) *StreamSessionCreate {
+	return _c.SetStreamerID(v.ID)
+}
+
+// Mutation returns the StreamSessionMutation object of the builder.
+func (_c *StreamSessionCreate) Mutation() *StreamSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the StreamSession in the database.
+func (_c *StreamSessionCreate) Save(ctx context.Context) (*StreamSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StreamSessionCreate) SaveX(ctx context.Context) *StreamSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StreamSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StreamSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StreamSessionCreate) defaults() {
+	if _, ok := _c.mutation.PeakViewers(); !ok {
+		v := streamsession.DefaultPeakViewers
+		_c.mutation.SetPeakViewers(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := streamsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := streamsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StreamSessionCreate) check() error {
+	if _, ok := _c.mutation.StreamerID(); !ok {
+		return &ValidationError{Name: "streamer_id", err: errors.New(`ent: missing required field "StreamSession.streamer_id"`)}
+	}
+	if v, ok := _c.mutation.StreamerID(); ok {
+		if err := streamsession.StreamerIDValidator(v); err != nil {
+			return &ValidationError{Name: "streamer_id", err: fmt.Errorf(`ent: validator failed for field "StreamSession.streamer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeakViewers(); !ok {
+		return &ValidationError{Name: "peak_viewers", err: errors.New(`ent: missing required field "StreamSession.peak_viewers"`)}
+	}
+	if v, ok := _c.mutation.PeakViewers(); ok {
+		if err := streamsession.PeakViewersValidator(v); err != nil {
+			return &ValidationError{Name: "peak_viewers", err: fmt.Errorf(`ent: validator failed for field "StreamSession.peak_viewers": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "StreamSession.started_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "StreamSession.last_seen_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StreamSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StreamSession.updated_at"`)}
+	}
+	if len(_c.mutation.StreamerIDs()) == 0 {
+		return &ValidationError{Name: "streamer", err: errors.New(`ent: missing required edge "StreamSession.streamer"`)}
+	}
+	return nil
+}
+
+func (_c *StreamSessionCreate) sqlSave(ctx context.Context) (*StreamSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StreamSessionCreate) createSpec() (*StreamSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StreamSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(streamsession.Table, sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(streamsession.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := _c.mutation.GameName(); ok {
+		_spec.SetField(streamsession.FieldGameName, field.TypeString, value)
+		_node.GameName = &value
+	}
+	if value, ok := _c.mutation.Titles(); ok {
+		_spec.SetField(streamsession.FieldTitles, field.TypeJSON, value)
+		_node.Titles = value
+	}
+	if value, ok := _c.mutation.Categories(); ok {
+		_spec.SetField(streamsession.FieldCategories, field.TypeJSON, value)
+		_node.Categories = value
+	}
+	if value, ok := _c.mutation.AnnouncedTitle(); ok {
+		_spec.SetField(streamsession.FieldAnnouncedTitle, field.TypeString, value)
+		_node.AnnouncedTitle = &value
+	}
+	if value, ok := _c.mutation.AnnouncedGameName(); ok {
+		_spec.SetField(streamsession.FieldAnnouncedGameName, field.TypeString, value)
+		_node.AnnouncedGameName = &value
+	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(streamsession.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = &value
+	}
+	if value, ok := _c.mutation.PeakViewers(); ok {
+		_spec.SetField(streamsession.FieldPeakViewers, field.TypeInt, value)
+		_node.PeakViewers = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(streamsession.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(streamsession.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(streamsession.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(streamsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(streamsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.StreamerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   streamsession.StreamerTable,
+			Columns: []string{streamsession.StreamerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamer.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StreamerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StreamSessionCreateBulk is the builder for creating many StreamSession entities in bulk.
+type StreamSessionCreateBulk struct {
+	config
+	err      error
+	builders []*StreamSessionCreate
+}
+
+// Save creates the StreamSession entities in the database.
+func (_c *StreamSessionCreateBulk) Save(ctx context.Context) ([]*StreamSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StreamSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StreamSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StreamSessionCreateBulk) SaveX(ctx context.Context) []*StreamSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StreamSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StreamSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
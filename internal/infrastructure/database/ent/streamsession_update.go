@@ -0,0 +1,914 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+)
+
+// StreamSessionUpdate is the builder for updating StreamSession entities.
+type StreamSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StreamSessionMutation
+}
+
+// Where appends a list predicates to the StreamSessionUpdate builder.
+func (_u *StreamSessionUpdate) Where(ps ...predicate.StreamSession) *StreamSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_u *StreamSessionUpdate) SetStreamerID(v int64) *StreamSessionUpdate {
+	_u.mutation.SetStreamerID(v)
+	return _u
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableStreamerID(v *int64) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetStreamerID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *StreamSessionUpdate) SetTitle(v string) *StreamSessionUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableTitle(v *string) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *StreamSessionUpdate) ClearTitle() *StreamSessionUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetGameName sets the "game_name" field.
+func (_u *StreamSessionUpdate) SetGameName(v string) *StreamSessionUpdate {
+	_u.mutation.SetGameName(v)
+	return _u
+}
+
+// SetNillableGameName sets the "game_name" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableGameName(v *string) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetGameName(*v)
+	}
+	return _u
+}
+
+// ClearGameName clears the value of the "game_name" field.
+func (_u *StreamSessionUpdate) ClearGameName() *StreamSessionUpdate {
+	_u.mutation.ClearGameName()
+	return _u
+}
+
+// SetTitles sets the "titles" field.
+func (_u *StreamSessionUpdate) SetTitles(v []string) *StreamSessionUpdate {
+	_u.mutation.SetTitles(v)
+	return _u
+}
+
+// AppendTitles appends value to the "titles" field.
+func (_u *StreamSessionUpdate) AppendTitles(v []string) *StreamSessionUpdate {
+	_u.mutation.AppendTitles(v)
+	return _u
+}
+
+// ClearTitles clears the value of the "titles" field.
+func (_u *StreamSessionUpdate) ClearTitles() *StreamSessionUpdate {
+	_u.mutation.ClearTitles()
+	return _u
+}
+
+// SetCategories sets the "categories" field.
+func (_u *StreamSessionUpdate) SetCategories(v []string) *StreamSessionUpdate {
+	_u.mutation.SetCategories(v)
+	return _u
+}
+
+// AppendCategories appends value to the "categories" field.
+func (_u *StreamSessionUpdate) AppendCategories(v []string) *StreamSessionUpdate {
+	_u.mutation.AppendCategories(v)
+	return _u
+}
+
+// ClearCategories clears the value of the "categories" field.
+func (_u *StreamSessionUpdate) ClearCategories() *StreamSessionUpdate {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// SetAnnouncedTitle sets the "announced_title" field.
+func (_u *StreamSessionUpdate) SetAnnouncedTitle(v string) *StreamSessionUpdate {
+	_u.mutation.SetAnnouncedTitle(v)
+	return _u
+}
+
+// SetNillableAnnouncedTitle sets the "announced_title" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableAnnouncedTitle(v *string) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetAnnouncedTitle(*v)
+	}
+	return _u
+}
+
+// ClearAnnouncedTitle clears the value of the "announced_title" field.
+func (_u *StreamSessionUpdate) ClearAnnouncedTitle() *StreamSessionUpdate {
+	_u.mutation.ClearAnnouncedTitle()
+	return _u
+}
+
+// SetAnnouncedGameName sets the "announced_game_name" field.
+func (_u *StreamSessionUpdate) SetAnnouncedGameName(v string) *StreamSessionUpdate {
+	_u.mutation.SetAnnouncedGameName(v)
+	return _u
+}
+
+// SetNillableAnnouncedGameName sets the "announced_game_name" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableAnnouncedGameName(v *string) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetAnnouncedGameName(*v)
+	}
+	return _u
+}
+
+// ClearAnnouncedGameName clears the value of the "announced_game_name" field.
+func (_u *StreamSessionUpdate) ClearAnnouncedGameName() *StreamSessionUpdate {
+	_u.mutation.ClearAnnouncedGameName()
+	return _u
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_u *StreamSessionUpdate) SetChangedAt(v time.Time) *StreamSessionUpdate {
+	_u.mutation.SetChangedAt(v)
+	return _u
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableChangedAt(v *time.Time) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearChangedAt clears the value of the "changed_at" field.
+func (_u *StreamSessionUpdate) ClearChangedAt() *StreamSessionUpdate {
+	_u.mutation.ClearChangedAt()
+	return _u
+}
+
+// SetPeakViewers sets the "peak_viewers" field.
+func (_u *StreamSessionUpdate) SetPeakViewers(v int) *StreamSessionUpdate {
+	_u.mutation.ResetPeakViewers()
+	_u.mutation.SetPeakViewers(v)
+	return _u
+}
+
+// SetNillablePeakViewers sets the "peak_viewers" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillablePeakViewers(v *int) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetPeakViewers(*v)
+	}
+	return _u
+}
+
+// AddPeakViewers adds value to the "peak_viewers" field.
+func (_u *StreamSessionUpdate) AddPeakViewers(v int) *StreamSessionUpdate {
+	_u.mutation.AddPeakViewers(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *StreamSessionUpdate) SetStartedAt(v time.Time) *StreamSessionUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableStartedAt(v *time.Time) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *StreamSessionUpdate) SetLastSeenAt(v time.Time) *StreamSessionUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableLastSeenAt(v *time.Time) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *StreamSessionUpdate) SetEndedAt(v time.Time) *StreamSessionUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *StreamSessionUpdate) SetNillableEndedAt(v *time.Time) *StreamSessionUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *StreamSessionUpdate) ClearEndedAt() *StreamSessionUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StreamSessionUpdate) SetUpdatedAt(v time.Time) *StreamSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStreamer sets the "streamer" edge to the Streamer entity.
+func (_u *StreamSessionUpdate) SetStreamer(v *Streamer) *StreamSessionUpdate {
+	return _u.SetStreamerID(v.ID)
+}
+
+// Mutation returns the StreamSessionMutation object of the builder.
+func (_u *StreamSessionUpdate) Mutation() *StreamSessionMutation {
+	return _u.mutation
+}
+
+// ClearStreamer clears the "streamer" edge to the Streamer entity.
+func (_u *StreamSessionUpdate) ClearStreamer() *StreamSessionUpdate {
+	_u.mutation.ClearStreamer()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StreamSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StreamSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StreamSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StreamSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StreamSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := streamsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StreamSessionUpdate) check() error {
+	if v, ok := _u.mutation.StreamerID(); ok {
+		if err := streamsession.StreamerIDValidator(v); err != nil {
+			return &ValidationError{Name: "streamer_id", err: fmt.Errorf(`ent: validator failed for field "StreamSession.streamer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PeakViewers(); ok {
+		if err := streamsession.PeakViewersValidator(v); err != nil {
+			return &ValidationError{Name: "peak_viewers", err: fmt.Errorf(`ent: validator failed for field "StreamSession.peak_viewers": %w`, err)}
+		}
+	}
+	if _u.mutation.StreamerCleared() && len(_u.mutation.StreamerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StreamSession.streamer"`)
+	}
+	return nil
+}
+
+func (_u *StreamSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(streamsession.Table, streamsession.Columns, sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(streamsession.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(streamsession.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.GameName(); ok {
+		_spec.SetField(streamsession.FieldGameName, field.TypeString, value)
+	}
+	if _u.mutation.GameNameCleared() {
+		_spec.ClearField(streamsession.FieldGameName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Titles(); ok {
+		_spec.SetField(streamsession.FieldTitles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTitles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, streamsession.FieldTitles, value)
+		})
+	}
+	if _u.mutation.TitlesCleared() {
+		_spec.ClearField(streamsession.FieldTitles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Categories(); ok {
+		_spec.SetField(streamsession.FieldCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, streamsession.FieldCategories, value)
+		})
+	}
+	if _u.mutation.CategoriesCleared() {
+		_spec.ClearField(streamsession.FieldCategories, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AnnouncedTitle(); ok {
+		_spec.SetField(streamsession.FieldAnnouncedTitle, field.TypeString, value)
+	}
+	if _u.mutation.AnnouncedTitleCleared() {
+		_spec.ClearField(streamsession.FieldAnnouncedTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnnouncedGameName(); ok {
+		_spec.SetField(streamsession.FieldAnnouncedGameName, field.TypeString, value)
+	}
+	if _u.mutation.AnnouncedGameNameCleared() {
+		_spec.ClearField(streamsession.FieldAnnouncedGameName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangedAt(); ok {
+		_spec.SetField(streamsession.FieldChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChangedAtCleared() {
+		_spec.ClearField(streamsession.FieldChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeakViewers(); ok {
+		_spec.SetField(streamsession.FieldPeakViewers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeakViewers(); ok {
+		_spec.AddField(streamsession.FieldPeakViewers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(streamsession.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(streamsession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(streamsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(streamsession.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(streamsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StreamerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   streamsession.StreamerTable,
+			Columns: []string{streamsession.StreamerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamer.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StreamerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   streamsession.StreamerTable,
+			Columns: []string{streamsession.StreamerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamer.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{streamsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StreamSessionUpdateOne is the builder for updating a single StreamSession entity.
+type StreamSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StreamSessionMutation
+}
+
+// SetStreamerID sets the "streamer_id" field.
+func (_u *StreamSessionUpdateOne) SetStreamerID(v int64) *StreamSessionUpdateOne {
+	_u.mutation.SetStreamerID(v)
+	return _u
+}
+
+// SetNillableStreamerID sets the "streamer_id" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableStreamerID(v *int64) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetStreamerID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *StreamSessionUpdateOne) SetTitle(v string) *StreamSessionUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableTitle(v *string) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *StreamSessionUpdateOne) ClearTitle() *StreamSessionUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetGameName sets the "game_name" field.
+func (_u *StreamSessionUpdateOne) SetGameName(v string) *StreamSessionUpdateOne {
+	_u.mutation.SetGameName(v)
+	return _u
+}
+
+// SetNillableGameName sets the "game_name" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableGameName(v *string) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetGameName(*v)
+	}
+	return _u
+}
+
+// ClearGameName clears the value of the "game_name" field.
+func (_u *StreamSessionUpdateOne) ClearGameName() *StreamSessionUpdateOne {
+	_u.mutation.ClearGameName()
+	return _u
+}
+
+// SetTitles sets the "titles" field.
+func (_u *StreamSessionUpdateOne) SetTitles(v []string) *StreamSessionUpdateOne {
+	_u.mutation.SetTitles(v)
+	return _u
+}
+
+// AppendTitles appends value to the "titles" field.
+func (_u *StreamSessionUpdateOne) AppendTitles(v []string) *StreamSessionUpdateOne {
+	_u.mutation.AppendTitles(v)
+	return _u
+}
+
+// ClearTitles clears the value of the "titles" field.
+func (_u *StreamSessionUpdateOne) ClearTitles() *StreamSessionUpdateOne {
+	_u.mutation.ClearTitles()
+	return _u
+}
+
+// SetCategories sets the "categories" field.
+func (_u *StreamSessionUpdateOne) SetCategories(v []string) *StreamSessionUpdateOne {
+	_u.mutation.SetCategories(v)
+	return _u
+}
+
+// AppendCategories appends value to the "categories" field.
+func (_u *StreamSessionUpdateOne) AppendCategories(v []string) *StreamSessionUpdateOne {
+	_u.mutation.AppendCategories(v)
+	return _u
+}
+
+// ClearCategories clears the value of the "categories" field.
+func (_u *StreamSessionUpdateOne) ClearCategories() *StreamSessionUpdateOne {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// SetAnnouncedTitle sets the "announced_title" field.
+func (_u *StreamSessionUpdateOne) SetAnnouncedTitle(v string) *StreamSessionUpdateOne {
+	_u.mutation.SetAnnouncedTitle(v)
+	return _u
+}
+
+// SetNillableAnnouncedTitle sets the "announced_title" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableAnnouncedTitle(v *string) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetAnnouncedTitle(*v)
+	}
+	return _u
+}
+
+// ClearAnnouncedTitle clears the value of the "announced_title" field.
+func (_u *StreamSessionUpdateOne) ClearAnnouncedTitle() *StreamSessionUpdateOne {
+	_u.mutation.ClearAnnouncedTitle()
+	return _u
+}
+
+// SetAnnouncedGameName sets the "announced_game_name" field.
+func (_u *StreamSessionUpdateOne) SetAnnouncedGameName(v string) *StreamSessionUpdateOne {
+	_u.mutation.SetAnnouncedGameName(v)
+	return _u
+}
+
+// SetNillableAnnouncedGameName sets the "announced_game_name" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableAnnouncedGameName(v *string) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetAnnouncedGameName(*v)
+	}
+	return _u
+}
+
+// ClearAnnouncedGameName clears the value of the "announced_game_name" field.
+func (_u *StreamSessionUpdateOne) ClearAnnouncedGameName() *StreamSessionUpdateOne {
+	_u.mutation.ClearAnnouncedGameName()
+	return _u
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_u *StreamSessionUpdateOne) SetChangedAt(v time.Time) *StreamSessionUpdateOne {
+	_u.mutation.SetChangedAt(v)
+	return _u
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableChangedAt(v *time.Time) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearChangedAt clears the value of the "changed_at" field.
+func (_u *StreamSessionUpdateOne) ClearChangedAt() *StreamSessionUpdateOne {
+	_u.mutation.ClearChangedAt()
+	return _u
+}
+
+// SetPeakViewers sets the "peak_viewers" field.
+func (_u *StreamSessionUpdateOne) SetPeakViewers(v int) *StreamSessionUpdateOne {
+	_u.mutation.ResetPeakViewers()
+	_u.mutation.SetPeakViewers(v)
+	return _u
+}
+
+// SetNillablePeakViewers sets the "peak_viewers" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillablePeakViewers(v *int) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetPeakViewers(*v)
+	}
+	return _u
+}
+
+// AddPeakViewers adds value to the "peak_viewers" field.
+func (_u *StreamSessionUpdateOne) AddPeakViewers(v int) *StreamSessionUpdateOne {
+	_u.mutation.AddPeakViewers(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *StreamSessionUpdateOne) SetStartedAt(v time.Time) *StreamSessionUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableStartedAt(v *time.Time) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *StreamSessionUpdateOne) SetLastSeenAt(v time.Time) *StreamSessionUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableLastSeenAt(v *time.Time) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *StreamSessionUpdateOne) SetEndedAt(v time.Time) *StreamSessionUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *StreamSessionUpdateOne) SetNillableEndedAt(v *time.Time) *StreamSessionUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *StreamSessionUpdateOne) ClearEndedAt() *StreamSessionUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StreamSessionUpdateOne) SetUpdatedAt(v time.Time) *StreamSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStreamer sets the "streamer" edge to the Streamer entity.
+func (_u *StreamSessionUpdateOne) SetStreamer(v *Streamer) *StreamSessionUpdateOne {
+	return _u.SetStreamerID(v.ID)
+}
+
+// Mutation returns the StreamSessionMutation object of the builder.
+func (_u *StreamSessionUpdateOne) Mutation() *StreamSessionMutation {
+	return _u.mutation
+}
+
+// ClearStreamer clears the "streamer" edge to the Streamer entity.
+func (_u *StreamSessionUpdateOne) ClearStreamer() *StreamSessionUpdateOne {
+	_u.mutation.ClearStreamer()
+	return _u
+}
+
+// Where appends a list predicates to the StreamSessionUpdate builder.
+func (_u *StreamSessionUpdateOne) Where(ps ...predicate.StreamSession) *StreamSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StreamSessionUpdateOne) Select(field string, fields ...string) *StreamSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StreamSession entity.
+func (_u *StreamSessionUpdateOne) Save(ctx context.Context) (*StreamSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StreamSessionUpdateOne) SaveX(ctx context.Context) *StreamSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StreamSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StreamSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StreamSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := streamsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StreamSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.StreamerID(); ok {
+		if err := streamsession.StreamerIDValidator(v); err != nil {
+			return &ValidationError{Name: "streamer_id", err: fmt.Errorf(`ent: validator failed for field "StreamSession.streamer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PeakViewers(); ok {
+		if err := streamsession.PeakViewersValidator(v); err != nil {
+			return &ValidationError{Name: "peak_viewers", err: fmt.Errorf(`ent: validator failed for field "StreamSession.peak_viewers": %w`, err)}
+		}
+	}
+	if _u.mutation.StreamerCleared() && len(_u.mutation.StreamerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StreamSession.streamer"`)
+	}
+	return nil
+}
+
+func (_u *StreamSessionUpdateOne) sqlSave(ctx context.Context) (_node *StreamSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(streamsession.Table, streamsession.Columns, sqlgraph.NewFieldSpec(streamsession.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StreamSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, streamsession.FieldID)
+		for _, f := range fields {
+			if !streamsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != streamsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(streamsession.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(streamsession.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.GameName(); ok {
+		_spec.SetField(streamsession.FieldGameName, field.TypeString, value)
+	}
+	if _u.mutation.GameNameCleared() {
+		_spec.ClearField(streamsession.FieldGameName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Titles(); ok {
+		_spec.SetField(streamsession.FieldTitles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTitles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, streamsession.FieldTitles, value)
+		})
+	}
+	if _u.mutation.TitlesCleared() {
+		_spec.ClearField(streamsession.FieldTitles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Categories(); ok {
+		_spec.SetField(streamsession.FieldCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, streamsession.FieldCategories, value)
+		})
+	}
+	if _u.mutation.CategoriesCleared() {
+		_spec.ClearField(streamsession.FieldCategories, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AnnouncedTitle(); ok {
+		_spec.SetField(streamsession.FieldAnnouncedTitle, field.TypeString, value)
+	}
+	if _u.mutation.AnnouncedTitleCleared() {
+		_spec.ClearField(streamsession.FieldAnnouncedTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnnouncedGameName(); ok {
+		_spec.SetField(streamsession.FieldAnnouncedGameName, field.TypeString, value)
+	}
+	if _u.mutation.AnnouncedGameNameCleared() {
+		_spec.ClearField(streamsession.FieldAnnouncedGameName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangedAt(); ok {
+		_spec.SetField(streamsession.FieldChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChangedAtCleared() {
+		_spec.ClearField(streamsession.FieldChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PeakViewers(); ok {
+		_spec.SetField(streamsession.FieldPeakViewers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPeakViewers(); ok {
+		_spec.AddField(streamsession.FieldPeakViewers, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(streamsession.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(streamsession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(streamsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(streamsession.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(streamsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StreamerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   streamsession.StreamerTable,
+			Columns: []string{streamsession.StreamerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamer.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StreamerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   streamsession.StreamerTable,
+			Columns: []string{streamsession.StreamerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(streamer.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StreamSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{streamsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,16 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// InboxMessage is the client for interacting with the InboxMessage builders.
+	InboxMessage *InboxMessageClient
 	// NotificationChannel is the client for interacting with the NotificationChannel builders.
 	NotificationChannel *NotificationChannelClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// StreamSession is the client for interacting with the StreamSession builders.
+	StreamSession *StreamSessionClient
 	// Streamer is the client for interacting with the Streamer builders.
 	Streamer *StreamerClient
 	// StreamingPlatform is the client for interacting with the StreamingPlatform builders.
 	StreamingPlatform *StreamingPlatformClient
+	// SystemSetting is the client for interacting with the SystemSetting builders.
+	SystemSetting *SystemSettingClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserFollowedStreamer is the client for interacting with the UserFollowedStreamer builders.
 	UserFollowedStreamer *UserFollowedStreamerClient
+	// WebPushSubscription is the client for interacting with the WebPushSubscription builders.
+	WebPushSubscription *WebPushSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,11 +165,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.InboxMessage = NewInboxMessageClient(tx.config)
 	tx.NotificationChannel = NewNotificationChannelClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.StreamSession = NewStreamSessionClient(tx.config)
 	tx.Streamer = NewStreamerClient(tx.config)
 	tx.StreamingPlatform = NewStreamingPlatformClient(tx.config)
+	tx.SystemSetting = NewSystemSettingClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserFollowedStreamer = NewUserFollowedStreamerClient(tx.config)
+	tx.WebPushSubscription = NewWebPushSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -167,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: NotificationChannel.QueryXXX(), the query will be executed
+// applies a query, for example: InboxMessage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 )
 
@@ -23,6 +24,8 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
+	// Locale holds the value of the "locale" field.
+	Locale *string `json:"locale,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -39,9 +42,17 @@ type UserEdges struct {
 	FollowedStreamers []*UserFollowedStreamer `json:"followed_streamers,omitempty"`
 	// NotificationChannels holds the value of the notification_channels edge.
 	NotificationChannels []*NotificationChannel `json:"notification_channels,omitempty"`
+	// WebPushSubscriptions holds the value of the web_push_subscriptions edge.
+	WebPushSubscriptions []*WebPushSubscription `json:"web_push_subscriptions,omitempty"`
+	// NotificationDeliveries holds the value of the notification_deliveries edge.
+	NotificationDeliveries []*NotificationDelivery `json:"notification_deliveries,omitempty"`
+	// InboxMessages holds the value of the inbox_messages edge.
+	InboxMessages []*InboxMessage `json:"inbox_messages,omitempty"`
+	// NotificationPreference holds the value of the notification_preference edge.
+	NotificationPreference *NotificationPreference `json:"notification_preference,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // FollowedStreamersOrErr returns the FollowedStreamers value or an error if the edge
@@ -62,6 +73,44 @@ func (e UserEdges) NotificationChannelsOrErr() ([]*NotificationChannel, error) {
 	return nil, &NotLoadedError{edge: "notification_channels"}
 }
 
+// WebPushSubscriptionsOrErr returns the WebPushSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebPushSubscriptionsOrErr() ([]*WebPushSubscription, error) {
+	if e.loadedTypes[2] {
+		return e.WebPushSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "web_push_subscriptions"}
+}
+
+// NotificationDeliveriesOrErr returns the NotificationDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationDeliveriesOrErr() ([]*NotificationDelivery, error) {
+	if e.loadedTypes[3] {
+		return e.NotificationDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_deliveries"}
+}
+
+// InboxMessagesOrErr returns the InboxMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InboxMessagesOrErr() ([]*InboxMessage, error) {
+	if e.loadedTypes[4] {
+		return e.InboxMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "inbox_messages"}
+}
+
+// NotificationPreferenceOrErr returns the NotificationPreference value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) NotificationPreferenceOrErr() (*NotificationPreference, error) {
+	if e.NotificationPreference != nil {
+		return e.NotificationPreference, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: notificationpreference.Label}
+	}
+	return nil, &NotLoadedError{edge: "notification_preference"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -69,7 +118,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldPassword:
+		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldLocale:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -112,6 +161,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Password = value.String
 			}
+		case user.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = new(string)
+				*_m.Locale = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -147,6 +203,26 @@ func (_m *User) QueryNotificationChannels() *NotificationChannelQuery {
 	return NewUserClient(_m.config).QueryNotificationChannels(_m)
 }
 
+// QueryWebPushSubscriptions queries the "web_push_subscriptions" edge of the User entity.
+func (_m *User) QueryWebPushSubscriptions() *WebPushSubscriptionQuery {
+	return NewUserClient(_m.config).QueryWebPushSubscriptions(_m)
+}
+
+// QueryNotificationDeliveries queries the "notification_deliveries" edge of the User entity.
+func (_m *User) QueryNotificationDeliveries() *NotificationDeliveryQuery {
+	return NewUserClient(_m.config).QueryNotificationDeliveries(_m)
+}
+
+// QueryInboxMessages queries the "inbox_messages" edge of the User entity.
+func (_m *User) QueryInboxMessages() *InboxMessageQuery {
+	return NewUserClient(_m.config).QueryInboxMessages(_m)
+}
+
+// QueryNotificationPreference queries the "notification_preference" edge of the User entity.
+func (_m *User) QueryNotificationPreference() *NotificationPreferenceQuery {
+	return NewUserClient(_m.config).QueryNotificationPreference(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -178,6 +254,11 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	if v := _m.Locale; v != nil {
+		builder.WriteString("locale=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -20,6 +20,8 @@ const (
 	FieldEmail = "email"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -28,6 +30,14 @@ const (
 	EdgeFollowedStreamers = "followed_streamers"
 	// EdgeNotificationChannels holds the string denoting the notification_channels edge name in mutations.
 	EdgeNotificationChannels = "notification_channels"
+	// EdgeWebPushSubscriptions holds the string denoting the web_push_subscriptions edge name in mutations.
+	EdgeWebPushSubscriptions = "web_push_subscriptions"
+	// EdgeNotificationDeliveries holds the string denoting the notification_deliveries edge name in mutations.
+	EdgeNotificationDeliveries = "notification_deliveries"
+	// EdgeInboxMessages holds the string denoting the inbox_messages edge name in mutations.
+	EdgeInboxMessages = "inbox_messages"
+	// EdgeNotificationPreference holds the string denoting the notification_preference edge name in mutations.
+	EdgeNotificationPreference = "notification_preference"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// FollowedStreamersTable is the table that holds the followed_streamers relation/edge.
@@ -44,6 +54,34 @@ const (
 	NotificationChannelsInverseTable = "notification_channels"
 	// NotificationChannelsColumn is the table column denoting the notification_channels relation/edge.
 	NotificationChannelsColumn = "user_id"
+	// WebPushSubscriptionsTable is the table that holds the web_push_subscriptions relation/edge.
+	WebPushSubscriptionsTable = "web_push_subscriptions"
+	// WebPushSubscriptionsInverseTable is the table name for the WebPushSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "webpushsubscription" package.
+	WebPushSubscriptionsInverseTable = "web_push_subscriptions"
+	// WebPushSubscriptionsColumn is the table column denoting the web_push_subscriptions relation/edge.
+	WebPushSubscriptionsColumn = "user_id"
+	// NotificationDeliveriesTable is the table that holds the notification_deliveries relation/edge.
+	NotificationDeliveriesTable = "notification_deliveries"
+	// NotificationDeliveriesInverseTable is the table name for the NotificationDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationdelivery" package.
+	NotificationDeliveriesInverseTable = "notification_deliveries"
+	// NotificationDeliveriesColumn is the table column denoting the notification_deliveries relation/edge.
+	NotificationDeliveriesColumn = "user_id"
+	// InboxMessagesTable is the table that holds the inbox_messages relation/edge.
+	InboxMessagesTable = "inbox_messages"
+	// InboxMessagesInverseTable is the table name for the InboxMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "inboxmessage" package.
+	InboxMessagesInverseTable = "inbox_messages"
+	// InboxMessagesColumn is the table column denoting the inbox_messages relation/edge.
+	InboxMessagesColumn = "user_id"
+	// NotificationPreferenceTable is the table that holds the notification_preference relation/edge.
+	NotificationPreferenceTable = "notification_preferences"
+	// NotificationPreferenceInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferenceInverseTable = "notification_preferences"
+	// NotificationPreferenceColumn is the table column denoting the notification_preference relation/edge.
+	NotificationPreferenceColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -52,6 +90,7 @@ var Columns = []string{
 	FieldUsername,
 	FieldEmail,
 	FieldPassword,
+	FieldLocale,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -104,6 +143,11 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
 
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -141,6 +185,55 @@ func ByNotificationChannels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpt
 		sqlgraph.OrderByNeighborTerms(s, newNotificationChannelsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByWebPushSubscriptionsCount orders the results by web_push_subscriptions count.
+func ByWebPushSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebPushSubscriptionsStep(), opts...)
+	}
+}
+
+// ByWebPushSubscriptions orders the results by web_push_subscriptions terms.
+func ByWebPushSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebPushSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationDeliveriesCount orders the results by notification_deliveries count.
+func ByNotificationDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationDeliveriesStep(), opts...)
+	}
+}
+
+// ByNotificationDeliveries orders the results by notification_deliveries terms.
+func ByNotificationDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInboxMessagesCount orders the results by inbox_messages count.
+func ByInboxMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInboxMessagesStep(), opts...)
+	}
+}
+
+// ByInboxMessages orders the results by inbox_messages terms.
+func ByInboxMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInboxMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferenceField orders the results by notification_preference field.
+func ByNotificationPreferenceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferenceStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newFollowedStreamersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -155,3 +248,31 @@ func newNotificationChannelsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotificationChannelsTable, NotificationChannelsColumn),
 	)
 }
+func newWebPushSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebPushSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebPushSubscriptionsTable, WebPushSubscriptionsColumn),
+	)
+}
+func newNotificationDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationDeliveriesTable, NotificationDeliveriesColumn),
+	)
+}
+func newInboxMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InboxMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InboxMessagesTable, InboxMessagesColumn),
+	)
+}
+func newNotificationPreferenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, NotificationPreferenceTable, NotificationPreferenceColumn),
+	)
+}
@@ -9,24 +9,34 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                      *QueryContext
-	order                    []user.OrderOption
-	inters                   []Interceptor
-	predicates               []predicate.User
-	withFollowedStreamers    *UserFollowedStreamerQuery
-	withNotificationChannels *NotificationChannelQuery
+	ctx                        *QueryContext
+	order                      []user.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.User
+	withFollowedStreamers      *UserFollowedStreamerQuery
+	withNotificationChannels   *NotificationChannelQuery
+	withWebPushSubscriptions   *WebPushSubscriptionQuery
+	withNotificationDeliveries *NotificationDeliveryQuery
+	withInboxMessages          *InboxMessageQuery
+	withNotificationPreference *NotificationPreferenceQuery
+	modifiers                  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +117,94 @@ func (_q *UserQuery) QueryNotificationChannels() *NotificationChannelQuery {
 	return query
 }
 
+// QueryWebPushSubscriptions chains the current query on the "web_push_subscriptions" edge.
+func (_q *UserQuery) QueryWebPushSubscriptions() *WebPushSubscriptionQuery {
+	query := (&WebPushSubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(webpushsubscription.Table, webpushsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebPushSubscriptionsTable, user.WebPushSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationDeliveries chains the current query on the "notification_deliveries" edge.
+func (_q *UserQuery) QueryNotificationDeliveries() *NotificationDeliveryQuery {
+	query := (&NotificationDeliveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notificationdelivery.Table, notificationdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationDeliveriesTable, user.NotificationDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInboxMessages chains the current query on the "inbox_messages" edge.
+func (_q *UserQuery) QueryInboxMessages() *InboxMessageQuery {
+	query := (&InboxMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(inboxmessage.Table, inboxmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InboxMessagesTable, user.InboxMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationPreference chains the current query on the "notification_preference" edge.
+func (_q *UserQuery) QueryNotificationPreference() *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.NotificationPreferenceTable, user.NotificationPreferenceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -294,13 +392,17 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:                   _q.config,
-		ctx:                      _q.ctx.Clone(),
-		order:                    append([]user.OrderOption{}, _q.order...),
-		inters:                   append([]Interceptor{}, _q.inters...),
-		predicates:               append([]predicate.User{}, _q.predicates...),
-		withFollowedStreamers:    _q.withFollowedStreamers.Clone(),
-		withNotificationChannels: _q.withNotificationChannels.Clone(),
+		config:                     _q.config,
+		ctx:                        _q.ctx.Clone(),
+		order:                      append([]user.OrderOption{}, _q.order...),
+		inters:                     append([]Interceptor{}, _q.inters...),
+		predicates:                 append([]predicate.User{}, _q.predicates...),
+		withFollowedStreamers:      _q.withFollowedStreamers.Clone(),
+		withNotificationChannels:   _q.withNotificationChannels.Clone(),
+		withWebPushSubscriptions:   _q.withWebPushSubscriptions.Clone(),
+		withNotificationDeliveries: _q.withNotificationDeliveries.Clone(),
+		withInboxMessages:          _q.withInboxMessages.Clone(),
+		withNotificationPreference: _q.withNotificationPreference.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +431,50 @@ func (_q *UserQuery) WithNotificationChannels(opts ...func(*NotificationChannelQ
 	return _q
 }
 
+// WithWebPushSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "web_push_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithWebPushSubscriptions(opts ...func(*WebPushSubscriptionQuery)) *UserQuery {
+	query := (&WebPushSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebPushSubscriptions = query
+	return _q
+}
+
+// WithNotificationDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "notification_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNotificationDeliveries(opts ...func(*NotificationDeliveryQuery)) *UserQuery {
+	query := (&NotificationDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationDeliveries = query
+	return _q
+}
+
+// WithInboxMessages tells the query-builder to eager-load the nodes that are connected to
+// the "inbox_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithInboxMessages(opts ...func(*InboxMessageQuery)) *UserQuery {
+	query := (&InboxMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInboxMessages = query
+	return _q
+}
+
+// WithNotificationPreference tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preference" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNotificationPreference(opts ...func(*NotificationPreferenceQuery)) *UserQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationPreference = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +553,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withFollowedStreamers != nil,
 			_q.withNotificationChannels != nil,
+			_q.withWebPushSubscriptions != nil,
+			_q.withNotificationDeliveries != nil,
+			_q.withInboxMessages != nil,
+			_q.withNotificationPreference != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +571,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -448,6 +601,37 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withWebPushSubscriptions; query != nil {
+		if err := _q.loadWebPushSubscriptions(ctx, query, nodes,
+			func(n *User) { n.Edges.WebPushSubscriptions = []*WebPushSubscription{} },
+			func(n *User, e *WebPushSubscription) {
+				n.Edges.WebPushSubscriptions = append(n.Edges.WebPushSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationDeliveries; query != nil {
+		if err := _q.loadNotificationDeliveries(ctx, query, nodes,
+			func(n *User) { n.Edges.NotificationDeliveries = []*NotificationDelivery{} },
+			func(n *User, e *NotificationDelivery) {
+				n.Edges.NotificationDeliveries = append(n.Edges.NotificationDeliveries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInboxMessages; query != nil {
+		if err := _q.loadInboxMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.InboxMessages = []*InboxMessage{} },
+			func(n *User, e *InboxMessage) { n.Edges.InboxMessages = append(n.Edges.InboxMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationPreference; query != nil {
+		if err := _q.loadNotificationPreference(ctx, query, nodes, nil,
+			func(n *User, e *NotificationPreference) { n.Edges.NotificationPreference = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -511,9 +695,129 @@ func (_q *UserQuery) loadNotificationChannels(ctx context.Context, query *Notifi
 	}
 	return nil
 }
+func (_q *UserQuery) loadWebPushSubscriptions(ctx context.Context, query *WebPushSubscriptionQuery, nodes []*User, init func(*User), assign func(*User, *WebPushSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webpushsubscription.FieldUserID)
+	}
+	query.Where(predicate.WebPushSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WebPushSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadNotificationDeliveries(ctx context.Context, query *NotificationDeliveryQuery, nodes []*User, init func(*User), assign func(*User, *NotificationDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationdelivery.FieldUserID)
+	}
+	query.Where(predicate.NotificationDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadInboxMessages(ctx context.Context, query *InboxMessageQuery, nodes []*User, init func(*User), assign func(*User, *InboxMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(inboxmessage.FieldUserID)
+	}
+	query.Where(predicate.InboxMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.InboxMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadNotificationPreference(ctx context.Context, query *NotificationPreferenceQuery, nodes []*User, init func(*User), assign func(*User, *NotificationPreference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationpreference.FieldUserID)
+	}
+	query.Where(predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationPreferenceColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -576,6 +880,9 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -593,6 +900,32 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
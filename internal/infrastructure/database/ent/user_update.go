@@ -11,10 +11,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/userfollowedstreamer"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -72,6 +76,26 @@ func (_u *UserUpdate) SetNillablePassword(v *string) *UserUpdate {
 	return _u
 }
 
+// SetLocale sets the "locale" field.
+func (_u *UserUpdate) SetLocale(v string) *UserUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLocale(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (_u *UserUpdate) ClearLocale() *UserUpdate {
+	_u.mutation.ClearLocale()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -108,6 +132,70 @@ func (_u *UserUpdate) AddNotificationChannels(v ...*NotificationChannel) *UserUp
 	return _u.AddNotificationChannelIDs(ids...)
 }
 
+// AddWebPushSubscriptionIDs adds the "web_push_subscriptions" edge to the WebPushSubscription entity by IDs.
+func (_u *UserUpdate) AddWebPushSubscriptionIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddWebPushSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddWebPushSubscriptions adds the "web_push_subscriptions" edges to the WebPushSubscription entity.
+func (_u *UserUpdate) AddWebPushSubscriptions(v ...*WebPushSubscription) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebPushSubscriptionIDs(ids...)
+}
+
+// AddNotificationDeliveryIDs adds the "notification_deliveries" edge to the NotificationDelivery entity by IDs.
+func (_u *UserUpdate) AddNotificationDeliveryIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddNotificationDeliveryIDs(ids...)
+	return _u
+}
+
+// AddNotificationDeliveries adds the "notification_deliveries" edges to the NotificationDelivery entity.
+func (_u *UserUpdate) AddNotificationDeliveries(v ...*NotificationDelivery) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationDeliveryIDs(ids...)
+}
+
+// AddInboxMessageIDs adds the "inbox_messages" edge to the InboxMessage entity by IDs.
+func (_u *UserUpdate) AddInboxMessageIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddInboxMessageIDs(ids...)
+	return _u
+}
+
+// AddInboxMessages adds the "inbox_messages" edges to the InboxMessage entity.
+func (_u *UserUpdate) AddInboxMessages(v ...*InboxMessage) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInboxMessageIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (_u *UserUpdate) SetNotificationPreferenceID(id int64) *UserUpdate {
+	_u.mutation.SetNotificationPreferenceID(id)
+	return _u
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (_u *UserUpdate) SetNillableNotificationPreferenceID(id *int64) *UserUpdate {
+	if id != nil {
+		_u = _u.SetNotificationPreferenceID(*id)
+	}
+	return _u
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (_u *UserUpdate) SetNotificationPreference(v *NotificationPreference) *UserUpdate {
+	return _u.SetNotificationPreferenceID(v.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -155,6 +243,75 @@ func (_u *UserUpdate) RemoveNotificationChannels(v ...*NotificationChannel) *Use
 	return _u.RemoveNotificationChannelIDs(ids...)
 }
 
+// ClearWebPushSubscriptions clears all "web_push_subscriptions" edges to the WebPushSubscription entity.
+func (_u *UserUpdate) ClearWebPushSubscriptions() *UserUpdate {
+	_u.mutation.ClearWebPushSubscriptions()
+	return _u
+}
+
+// RemoveWebPushSubscriptionIDs removes the "web_push_subscriptions" edge to WebPushSubscription entities by IDs.
+func (_u *UserUpdate) RemoveWebPushSubscriptionIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveWebPushSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveWebPushSubscriptions removes "web_push_subscriptions" edges to WebPushSubscription entities.
+func (_u *UserUpdate) RemoveWebPushSubscriptions(v ...*WebPushSubscription) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebPushSubscriptionIDs(ids...)
+}
+
+// ClearNotificationDeliveries clears all "notification_deliveries" edges to the NotificationDelivery entity.
+func (_u *UserUpdate) ClearNotificationDeliveries() *UserUpdate {
+	_u.mutation.ClearNotificationDeliveries()
+	return _u
+}
+
+// RemoveNotificationDeliveryIDs removes the "notification_deliveries" edge to NotificationDelivery entities by IDs.
+func (_u *UserUpdate) RemoveNotificationDeliveryIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveNotificationDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationDeliveries removes "notification_deliveries" edges to NotificationDelivery entities.
+func (_u *UserUpdate) RemoveNotificationDeliveries(v ...*NotificationDelivery) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationDeliveryIDs(ids...)
+}
+
+// ClearInboxMessages clears all "inbox_messages" edges to the InboxMessage entity.
+func (_u *UserUpdate) ClearInboxMessages() *UserUpdate {
+	_u.mutation.ClearInboxMessages()
+	return _u
+}
+
+// RemoveInboxMessageIDs removes the "inbox_messages" edge to InboxMessage entities by IDs.
+func (_u *UserUpdate) RemoveInboxMessageIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveInboxMessageIDs(ids...)
+	return _u
+}
+
+// RemoveInboxMessages removes "inbox_messages" edges to InboxMessage entities.
+func (_u *UserUpdate) RemoveInboxMessages(v ...*InboxMessage) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInboxMessageIDs(ids...)
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (_u *UserUpdate) ClearNotificationPreference() *UserUpdate {
+	_u.mutation.ClearNotificationPreference()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -232,6 +389,12 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if _u.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -325,6 +488,170 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WebPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebPushSubscriptionsTable,
+			Columns: []string{user.WebPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebPushSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.WebPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebPushSubscriptionsTable,
+			Columns: []string{user.WebPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebPushSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebPushSubscriptionsTable,
+			Columns: []string{user.WebPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationDeliveriesTable,
+			Columns: []string{user.NotificationDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.NotificationDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationDeliveriesTable,
+			Columns: []string{user.NotificationDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationDeliveriesTable,
+			Columns: []string{user.NotificationDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InboxMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InboxMessagesTable,
+			Columns: []string{user.InboxMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInboxMessagesIDs(); len(nodes) > 0 && !_u.mutation.InboxMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InboxMessagesTable,
+			Columns: []string{user.InboxMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InboxMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InboxMessagesTable,
+			Columns: []string{user.InboxMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -387,6 +714,26 @@ func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetLocale sets the "locale" field.
+func (_u *UserUpdateOne) SetLocale(v string) *UserUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLocale(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (_u *UserUpdateOne) ClearLocale() *UserUpdateOne {
+	_u.mutation.ClearLocale()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -423,6 +770,70 @@ func (_u *UserUpdateOne) AddNotificationChannels(v ...*NotificationChannel) *Use
 	return _u.AddNotificationChannelIDs(ids...)
 }
 
+// AddWebPushSubscriptionIDs adds the "web_push_subscriptions" edge to the WebPushSubscription entity by IDs.
+func (_u *UserUpdateOne) AddWebPushSubscriptionIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddWebPushSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddWebPushSubscriptions adds the "web_push_subscriptions" edges to the WebPushSubscription entity.
+func (_u *UserUpdateOne) AddWebPushSubscriptions(v ...*WebPushSubscription) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebPushSubscriptionIDs(ids...)
+}
+
+// AddNotificationDeliveryIDs adds the "notification_deliveries" edge to the NotificationDelivery entity by IDs.
+func (_u *UserUpdateOne) AddNotificationDeliveryIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddNotificationDeliveryIDs(ids...)
+	return _u
+}
+
+// AddNotificationDeliveries adds the "notification_deliveries" edges to the NotificationDelivery entity.
+func (_u *UserUpdateOne) AddNotificationDeliveries(v ...*NotificationDelivery) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationDeliveryIDs(ids...)
+}
+
+// AddInboxMessageIDs adds the "inbox_messages" edge to the InboxMessage entity by IDs.
+func (_u *UserUpdateOne) AddInboxMessageIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddInboxMessageIDs(ids...)
+	return _u
+}
+
+// AddInboxMessages adds the "inbox_messages" edges to the InboxMessage entity.
+func (_u *UserUpdateOne) AddInboxMessages(v ...*InboxMessage) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInboxMessageIDs(ids...)
+}
+
+// SetNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID.
+func (_u *UserUpdateOne) SetNotificationPreferenceID(id int64) *UserUpdateOne {
+	_u.mutation.SetNotificationPreferenceID(id)
+	return _u
+}
+
+// SetNillableNotificationPreferenceID sets the "notification_preference" edge to the NotificationPreference entity by ID if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableNotificationPreferenceID(id *int64) *UserUpdateOne {
+	if id != nil {
+		_u = _u.SetNotificationPreferenceID(*id)
+	}
+	return _u
+}
+
+// SetNotificationPreference sets the "notification_preference" edge to the NotificationPreference entity.
+func (_u *UserUpdateOne) SetNotificationPreference(v *NotificationPreference) *UserUpdateOne {
+	return _u.SetNotificationPreferenceID(v.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -470,6 +881,75 @@ func (_u *UserUpdateOne) RemoveNotificationChannels(v ...*NotificationChannel) *
 	return _u.RemoveNotificationChannelIDs(ids...)
 }
 
+// ClearWebPushSubscriptions clears all "web_push_subscriptions" edges to the WebPushSubscription entity.
+func (_u *UserUpdateOne) ClearWebPushSubscriptions() *UserUpdateOne {
+	_u.mutation.ClearWebPushSubscriptions()
+	return _u
+}
+
+// RemoveWebPushSubscriptionIDs removes the "web_push_subscriptions" edge to WebPushSubscription entities by IDs.
+func (_u *UserUpdateOne) RemoveWebPushSubscriptionIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveWebPushSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveWebPushSubscriptions removes "web_push_subscriptions" edges to WebPushSubscription entities.
+func (_u *UserUpdateOne) RemoveWebPushSubscriptions(v ...*WebPushSubscription) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebPushSubscriptionIDs(ids...)
+}
+
+// ClearNotificationDeliveries clears all "notification_deliveries" edges to the NotificationDelivery entity.
+func (_u *UserUpdateOne) ClearNotificationDeliveries() *UserUpdateOne {
+	_u.mutation.ClearNotificationDeliveries()
+	return _u
+}
+
+// RemoveNotificationDeliveryIDs removes the "notification_deliveries" edge to NotificationDelivery entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationDeliveryIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveNotificationDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationDeliveries removes "notification_deliveries" edges to NotificationDelivery entities.
+func (_u *UserUpdateOne) RemoveNotificationDeliveries(v ...*NotificationDelivery) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationDeliveryIDs(ids...)
+}
+
+// ClearInboxMessages clears all "inbox_messages" edges to the InboxMessage entity.
+func (_u *UserUpdateOne) ClearInboxMessages() *UserUpdateOne {
+	_u.mutation.ClearInboxMessages()
+	return _u
+}
+
+// RemoveInboxMessageIDs removes the "inbox_messages" edge to InboxMessage entities by IDs.
+func (_u *UserUpdateOne) RemoveInboxMessageIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveInboxMessageIDs(ids...)
+	return _u
+}
+
+// RemoveInboxMessages removes "inbox_messages" edges to InboxMessage entities.
+func (_u *UserUpdateOne) RemoveInboxMessages(v ...*InboxMessage) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInboxMessageIDs(ids...)
+}
+
+// ClearNotificationPreference clears the "notification_preference" edge to the NotificationPreference entity.
+func (_u *UserUpdateOne) ClearNotificationPreference() *UserUpdateOne {
+	_u.mutation.ClearNotificationPreference()
+	return _u
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -577,6 +1057,12 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if _u.mutation.LocaleCleared() {
+		_spec.ClearField(user.FieldLocale, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -670,6 +1156,170 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WebPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebPushSubscriptionsTable,
+			Columns: []string{user.WebPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebPushSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.WebPushSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebPushSubscriptionsTable,
+			Columns: []string{user.WebPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebPushSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebPushSubscriptionsTable,
+			Columns: []string{user.WebPushSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationDeliveriesTable,
+			Columns: []string{user.NotificationDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.NotificationDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationDeliveriesTable,
+			Columns: []string{user.NotificationDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationDeliveriesTable,
+			Columns: []string{user.NotificationDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InboxMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InboxMessagesTable,
+			Columns: []string{user.InboxMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInboxMessagesIDs(); len(nodes) > 0 && !_u.mutation.InboxMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InboxMessagesTable,
+			Columns: []string{user.InboxMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InboxMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InboxMessagesTable,
+			Columns: []string{user.InboxMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inboxmessage.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.NotificationPreferenceTable,
+			Columns: []string{user.NotificationPreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -32,8 +32,32 @@ type UserFollowedStreamer struct {
 	NotificationsEnabled bool `json:"notifications_enabled,omitempty"`
 	// NotificationChannelIds holds the value of the "notification_channel_ids" field.
 	NotificationChannelIds []int64 `json:"notification_channel_ids,omitempty"`
+	// TitleTemplate holds the value of the "title_template" field.
+	TitleTemplate *string `json:"title_template,omitempty"`
+	// BodyTemplate holds the value of the "body_template" field.
+	BodyTemplate *string `json:"body_template,omitempty"`
+	// RoutingMode holds the value of the "routing_mode" field.
+	RoutingMode *string `json:"routing_mode,omitempty"`
+	// EscalateAfterSeconds holds the value of the "escalate_after_seconds" field.
+	EscalateAfterSeconds *int64 `json:"escalate_after_seconds,omitempty"`
+	// AlwaysNotify holds the value of the "always_notify" field.
+	AlwaysNotify bool `json:"always_notify,omitempty"`
+	// NotifyStreamEnd holds the value of the "notify_stream_end" field.
+	NotifyStreamEnd bool `json:"notify_stream_end,omitempty"`
+	// NotifyTitleChange holds the value of the "notify_title_change" field.
+	NotifyTitleChange bool `json:"notify_title_change,omitempty"`
+	// NotifyCategories holds the value of the "notify_categories" field.
+	NotifyCategories []string `json:"notify_categories,omitempty"`
+	// NotificationFilter holds the value of the "notification_filter" field.
+	NotificationFilter map[string]interface{} `json:"notification_filter,omitempty"`
+	// ChannelOverrides holds the value of the "channel_overrides" field.
+	ChannelOverrides map[int64]map[string]interface{} `json:"channel_overrides,omitempty"`
 	// LastNotificationSentAt holds the value of the "last_notification_sent_at" field.
 	LastNotificationSentAt *time.Time `json:"last_notification_sent_at,omitempty"`
+	// SnoozedUntil holds the value of the "snoozed_until" field.
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+	// MutedSessionID holds the value of the "muted_session_id" field.
+	MutedSessionID *int64 `json:"muted_session_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -82,15 +106,15 @@ func (*UserFollowedStreamer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case userfollowedstreamer.FieldNotificationChannelIds:
+		case userfollowedstreamer.FieldNotificationChannelIds, userfollowedstreamer.FieldNotifyCategories, userfollowedstreamer.FieldNotificationFilter, userfollowedstreamer.FieldChannelOverrides:
 			values[i] = new([]byte)
-		case userfollowedstreamer.FieldNotificationsEnabled:
+		case userfollowedstreamer.FieldNotificationsEnabled, userfollowedstreamer.FieldAlwaysNotify, userfollowedstreamer.FieldNotifyStreamEnd, userfollowedstreamer.FieldNotifyTitleChange:
 			values[i] = new(sql.NullBool)
-		case userfollowedstreamer.FieldID, userfollowedstreamer.FieldUserID, userfollowedstreamer.FieldStreamerID:
+		case userfollowedstreamer.FieldID, userfollowedstreamer.FieldUserID, userfollowedstreamer.FieldStreamerID, userfollowedstreamer.FieldEscalateAfterSeconds, userfollowedstreamer.FieldMutedSessionID:
 			values[i] = new(sql.NullInt64)
-		case userfollowedstreamer.FieldAlias, userfollowedstreamer.FieldNotes:
+		case userfollowedstreamer.FieldAlias, userfollowedstreamer.FieldNotes, userfollowedstreamer.FieldTitleTemplate, userfollowedstreamer.FieldBodyTemplate, userfollowedstreamer.FieldRoutingMode:
 			values[i] = new(sql.NullString)
-		case userfollowedstreamer.FieldLastNotificationSentAt, userfollowedstreamer.FieldCreatedAt, userfollowedstreamer.FieldUpdatedAt:
+		case userfollowedstreamer.FieldLastNotificationSentAt, userfollowedstreamer.FieldSnoozedUntil, userfollowedstreamer.FieldCreatedAt, userfollowedstreamer.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -153,6 +177,76 @@ func (_m *UserFollowedStreamer) assignValues(columns []string, values []any) err
 					return fmt.Errorf("unmarshal field notification_channel_ids: %w", err)
 				}
 			}
+		case userfollowedstreamer.FieldTitleTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_template", values[i])
+			} else if value.Valid {
+				_m.TitleTemplate = new(string)
+				*_m.TitleTemplate = value.String
+			}
+		case userfollowedstreamer.FieldBodyTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_template", values[i])
+			} else if value.Valid {
+				_m.BodyTemplate = new(string)
+				*_m.BodyTemplate = value.String
+			}
+		case userfollowedstreamer.FieldRoutingMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field routing_mode", values[i])
+			} else if value.Valid {
+				_m.RoutingMode = new(string)
+				*_m.RoutingMode = value.String
+			}
+		case userfollowedstreamer.FieldEscalateAfterSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escalate_after_seconds", values[i])
+			} else if value.Valid {
+				_m.EscalateAfterSeconds = new(int64)
+				*_m.EscalateAfterSeconds = value.Int64
+			}
+		case userfollowedstreamer.FieldAlwaysNotify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field always_notify", values[i])
+			} else if value.Valid {
+				_m.AlwaysNotify = value.Bool
+			}
+		case userfollowedstreamer.FieldNotifyStreamEnd:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_stream_end", values[i])
+			} else if value.Valid {
+				_m.NotifyStreamEnd = value.Bool
+			}
+		case userfollowedstreamer.FieldNotifyTitleChange:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_title_change", values[i])
+			} else if value.Valid {
+				_m.NotifyTitleChange = value.Bool
+			}
+		case userfollowedstreamer.FieldNotifyCategories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_categories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NotifyCategories); err != nil {
+					return fmt.Errorf("unmarshal field notify_categories: %w", err)
+				}
+			}
+		case userfollowedstreamer.FieldNotificationFilter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_filter", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NotificationFilter); err != nil {
+					return fmt.Errorf("unmarshal field notification_filter: %w", err)
+				}
+			}
+		case userfollowedstreamer.FieldChannelOverrides:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_overrides", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChannelOverrides); err != nil {
+					return fmt.Errorf("unmarshal field channel_overrides: %w", err)
+				}
+			}
 		case userfollowedstreamer.FieldLastNotificationSentAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_notification_sent_at", values[i])
@@ -160,6 +254,20 @@ func (_m *UserFollowedStreamer) assignValues(columns []string, values []any) err
 				_m.LastNotificationSentAt = new(time.Time)
 				*_m.LastNotificationSentAt = value.Time
 			}
+		case userfollowedstreamer.FieldSnoozedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field snoozed_until", values[i])
+			} else if value.Valid {
+				_m.SnoozedUntil = new(time.Time)
+				*_m.SnoozedUntil = value.Time
+			}
+		case userfollowedstreamer.FieldMutedSessionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_session_id", values[i])
+			} else if value.Valid {
+				_m.MutedSessionID = new(int64)
+				*_m.MutedSessionID = value.Int64
+			}
 		case userfollowedstreamer.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -240,11 +348,59 @@ func (_m *UserFollowedStreamer) String() string {
 	builder.WriteString("notification_channel_ids=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NotificationChannelIds))
 	builder.WriteString(", ")
+	if v := _m.TitleTemplate; v != nil {
+		builder.WriteString("title_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BodyTemplate; v != nil {
+		builder.WriteString("body_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RoutingMode; v != nil {
+		builder.WriteString("routing_mode=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EscalateAfterSeconds; v != nil {
+		builder.WriteString("escalate_after_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("always_notify=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlwaysNotify))
+	builder.WriteString(", ")
+	builder.WriteString("notify_stream_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyStreamEnd))
+	builder.WriteString(", ")
+	builder.WriteString("notify_title_change=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyTitleChange))
+	builder.WriteString(", ")
+	builder.WriteString("notify_categories=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyCategories))
+	builder.WriteString(", ")
+	builder.WriteString("notification_filter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotificationFilter))
+	builder.WriteString(", ")
+	builder.WriteString("channel_overrides=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChannelOverrides))
+	builder.WriteString(", ")
 	if v := _m.LastNotificationSentAt; v != nil {
 		builder.WriteString("last_notification_sent_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.SnoozedUntil; v != nil {
+		builder.WriteString("snoozed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.MutedSessionID; v != nil {
+		builder.WriteString("muted_session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -26,8 +26,32 @@ const (
 	FieldNotificationsEnabled = "notifications_enabled"
 	// FieldNotificationChannelIds holds the string denoting the notification_channel_ids field in the database.
 	FieldNotificationChannelIds = "notification_channel_ids"
+	// FieldTitleTemplate holds the string denoting the title_template field in the database.
+	FieldTitleTemplate = "title_template"
+	// FieldBodyTemplate holds the string denoting the body_template field in the database.
+	FieldBodyTemplate = "body_template"
+	// FieldRoutingMode holds the string denoting the routing_mode field in the database.
+	FieldRoutingMode = "routing_mode"
+	// FieldEscalateAfterSeconds holds the string denoting the escalate_after_seconds field in the database.
+	FieldEscalateAfterSeconds = "escalate_after_seconds"
+	// FieldAlwaysNotify holds the string denoting the always_notify field in the database.
+	FieldAlwaysNotify = "always_notify"
+	// FieldNotifyStreamEnd holds the string denoting the notify_stream_end field in the database.
+	FieldNotifyStreamEnd = "notify_stream_end"
+	// FieldNotifyTitleChange holds the string denoting the notify_title_change field in the database.
+	FieldNotifyTitleChange = "notify_title_change"
+	// FieldNotifyCategories holds the string denoting the notify_categories field in the database.
+	FieldNotifyCategories = "notify_categories"
+	// FieldNotificationFilter holds the string denoting the notification_filter field in the database.
+	FieldNotificationFilter = "notification_filter"
+	// FieldChannelOverrides holds the string denoting the channel_overrides field in the database.
+	FieldChannelOverrides = "channel_overrides"
 	// FieldLastNotificationSentAt holds the string denoting the last_notification_sent_at field in the database.
 	FieldLastNotificationSentAt = "last_notification_sent_at"
+	// FieldSnoozedUntil holds the string denoting the snoozed_until field in the database.
+	FieldSnoozedUntil = "snoozed_until"
+	// FieldMutedSessionID holds the string denoting the muted_session_id field in the database.
+	FieldMutedSessionID = "muted_session_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -63,7 +87,19 @@ var Columns = []string{
 	FieldNotes,
 	FieldNotificationsEnabled,
 	FieldNotificationChannelIds,
+	FieldTitleTemplate,
+	FieldBodyTemplate,
+	FieldRoutingMode,
+	FieldEscalateAfterSeconds,
+	FieldAlwaysNotify,
+	FieldNotifyStreamEnd,
+	FieldNotifyTitleChange,
+	FieldNotifyCategories,
+	FieldNotificationFilter,
+	FieldChannelOverrides,
 	FieldLastNotificationSentAt,
+	FieldSnoozedUntil,
+	FieldMutedSessionID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -87,6 +123,12 @@ var (
 	DefaultNotificationsEnabled bool
 	// DefaultNotificationChannelIds holds the default value on creation for the "notification_channel_ids" field.
 	DefaultNotificationChannelIds []int64
+	// DefaultAlwaysNotify holds the default value on creation for the "always_notify" field.
+	DefaultAlwaysNotify bool
+	// DefaultNotifyStreamEnd holds the default value on creation for the "notify_stream_end" field.
+	DefaultNotifyStreamEnd bool
+	// DefaultNotifyTitleChange holds the default value on creation for the "notify_title_change" field.
+	DefaultNotifyTitleChange bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -128,11 +170,56 @@ func ByNotificationsEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNotificationsEnabled, opts...).ToFunc()
 }
 
+// ByTitleTemplate orders the results by the title_template field.
+func ByTitleTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleTemplate, opts...).ToFunc()
+}
+
+// ByBodyTemplate orders the results by the body_template field.
+func ByBodyTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodyTemplate, opts...).ToFunc()
+}
+
+// ByRoutingMode orders the results by the routing_mode field.
+func ByRoutingMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoutingMode, opts...).ToFunc()
+}
+
+// ByEscalateAfterSeconds orders the results by the escalate_after_seconds field.
+func ByEscalateAfterSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalateAfterSeconds, opts...).ToFunc()
+}
+
+// ByAlwaysNotify orders the results by the always_notify field.
+func ByAlwaysNotify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlwaysNotify, opts...).ToFunc()
+}
+
+// ByNotifyStreamEnd orders the results by the notify_stream_end field.
+func ByNotifyStreamEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyStreamEnd, opts...).ToFunc()
+}
+
+// ByNotifyTitleChange orders the results by the notify_title_change field.
+func ByNotifyTitleChange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyTitleChange, opts...).ToFunc()
+}
+
 // ByLastNotificationSentAt orders the results by the last_notification_sent_at field.
 func ByLastNotificationSentAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastNotificationSentAt, opts...).ToFunc()
 }
 
+// BySnoozedUntil orders the results by the snoozed_until field.
+func BySnoozedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnoozedUntil, opts...).ToFunc()
+}
+
+// ByMutedSessionID orders the results by the muted_session_id field.
+func ByMutedSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMutedSessionID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -80,11 +80,56 @@ func NotificationsEnabled(v bool) predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldNotificationsEnabled, v))
 }
 
+// TitleTemplate applies equality check predicate on the "title_template" field. It's identical to TitleTemplateEQ.
+func TitleTemplate(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldTitleTemplate, v))
+}
+
+// BodyTemplate applies equality check predicate on the "body_template" field. It's identical to BodyTemplateEQ.
+func BodyTemplate(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldBodyTemplate, v))
+}
+
+// RoutingMode applies equality check predicate on the "routing_mode" field. It's identical to RoutingModeEQ.
+func RoutingMode(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldRoutingMode, v))
+}
+
+// EscalateAfterSeconds applies equality check predicate on the "escalate_after_seconds" field. It's identical to EscalateAfterSecondsEQ.
+func EscalateAfterSeconds(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldEscalateAfterSeconds, v))
+}
+
+// AlwaysNotify applies equality check predicate on the "always_notify" field. It's identical to AlwaysNotifyEQ.
+func AlwaysNotify(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldAlwaysNotify, v))
+}
+
+// NotifyStreamEnd applies equality check predicate on the "notify_stream_end" field. It's identical to NotifyStreamEndEQ.
+func NotifyStreamEnd(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldNotifyStreamEnd, v))
+}
+
+// NotifyTitleChange applies equality check predicate on the "notify_title_change" field. It's identical to NotifyTitleChangeEQ.
+func NotifyTitleChange(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldNotifyTitleChange, v))
+}
+
 // LastNotificationSentAt applies equality check predicate on the "last_notification_sent_at" field. It's identical to LastNotificationSentAtEQ.
 func LastNotificationSentAt(v time.Time) predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldLastNotificationSentAt, v))
 }
 
+// SnoozedUntil applies equality check predicate on the "snoozed_until" field. It's identical to SnoozedUntilEQ.
+func SnoozedUntil(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldSnoozedUntil, v))
+}
+
+// MutedSessionID applies equality check predicate on the "muted_session_id" field. It's identical to MutedSessionIDEQ.
+func MutedSessionID(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldMutedSessionID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldCreatedAt, v))
@@ -305,6 +350,341 @@ func NotificationChannelIdsNotNil() predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldNotificationChannelIds))
 }
 
+// TitleTemplateEQ applies the EQ predicate on the "title_template" field.
+func TitleTemplateEQ(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldTitleTemplate, v))
+}
+
+// TitleTemplateNEQ applies the NEQ predicate on the "title_template" field.
+func TitleTemplateNEQ(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldTitleTemplate, v))
+}
+
+// TitleTemplateIn applies the In predicate on the "title_template" field.
+func TitleTemplateIn(vs ...string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIn(FieldTitleTemplate, vs...))
+}
+
+// TitleTemplateNotIn applies the NotIn predicate on the "title_template" field.
+func TitleTemplateNotIn(vs ...string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotIn(FieldTitleTemplate, vs...))
+}
+
+// TitleTemplateGT applies the GT predicate on the "title_template" field.
+func TitleTemplateGT(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGT(FieldTitleTemplate, v))
+}
+
+// TitleTemplateGTE applies the GTE predicate on the "title_template" field.
+func TitleTemplateGTE(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGTE(FieldTitleTemplate, v))
+}
+
+// TitleTemplateLT applies the LT predicate on the "title_template" field.
+func TitleTemplateLT(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLT(FieldTitleTemplate, v))
+}
+
+// TitleTemplateLTE applies the LTE predicate on the "title_template" field.
+func TitleTemplateLTE(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLTE(FieldTitleTemplate, v))
+}
+
+// TitleTemplateContains applies the Contains predicate on the "title_template" field.
+func TitleTemplateContains(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldContains(FieldTitleTemplate, v))
+}
+
+// TitleTemplateHasPrefix applies the HasPrefix predicate on the "title_template" field.
+func TitleTemplateHasPrefix(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldHasPrefix(FieldTitleTemplate, v))
+}
+
+// TitleTemplateHasSuffix applies the HasSuffix predicate on the "title_template" field.
+func TitleTemplateHasSuffix(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldHasSuffix(FieldTitleTemplate, v))
+}
+
+// TitleTemplateIsNil applies the IsNil predicate on the "title_template" field.
+func TitleTemplateIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldTitleTemplate))
+}
+
+// TitleTemplateNotNil applies the NotNil predicate on the "title_template" field.
+func TitleTemplateNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldTitleTemplate))
+}
+
+// TitleTemplateEqualFold applies the EqualFold predicate on the "title_template" field.
+func TitleTemplateEqualFold(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEqualFold(FieldTitleTemplate, v))
+}
+
+// TitleTemplateContainsFold applies the ContainsFold predicate on the "title_template" field.
+func TitleTemplateContainsFold(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldContainsFold(FieldTitleTemplate, v))
+}
+
+// BodyTemplateEQ applies the EQ predicate on the "body_template" field.
+func BodyTemplateEQ(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldBodyTemplate, v))
+}
+
+// BodyTemplateNEQ applies the NEQ predicate on the "body_template" field.
+func BodyTemplateNEQ(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldBodyTemplate, v))
+}
+
+// BodyTemplateIn applies the In predicate on the "body_template" field.
+func BodyTemplateIn(vs ...string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIn(FieldBodyTemplate, vs...))
+}
+
+// BodyTemplateNotIn applies the NotIn predicate on the "body_template" field.
+func BodyTemplateNotIn(vs ...string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotIn(FieldBodyTemplate, vs...))
+}
+
+// BodyTemplateGT applies the GT predicate on the "body_template" field.
+func BodyTemplateGT(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGT(FieldBodyTemplate, v))
+}
+
+// BodyTemplateGTE applies the GTE predicate on the "body_template" field.
+func BodyTemplateGTE(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGTE(FieldBodyTemplate, v))
+}
+
+// BodyTemplateLT applies the LT predicate on the "body_template" field.
+func BodyTemplateLT(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLT(FieldBodyTemplate, v))
+}
+
+// BodyTemplateLTE applies the LTE predicate on the "body_template" field.
+func BodyTemplateLTE(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLTE(FieldBodyTemplate, v))
+}
+
+// BodyTemplateContains applies the Contains predicate on the "body_template" field.
+func BodyTemplateContains(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldContains(FieldBodyTemplate, v))
+}
+
+// BodyTemplateHasPrefix applies the HasPrefix predicate on the "body_template" field.
+func BodyTemplateHasPrefix(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldHasPrefix(FieldBodyTemplate, v))
+}
+
+// BodyTemplateHasSuffix applies the HasSuffix predicate on the "body_template" field.
+func BodyTemplateHasSuffix(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldHasSuffix(FieldBodyTemplate, v))
+}
+
+// BodyTemplateIsNil applies the IsNil predicate on the "body_template" field.
+func BodyTemplateIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldBodyTemplate))
+}
+
+// BodyTemplateNotNil applies the NotNil predicate on the "body_template" field.
+func BodyTemplateNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldBodyTemplate))
+}
+
+// BodyTemplateEqualFold applies the EqualFold predicate on the "body_template" field.
+func BodyTemplateEqualFold(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEqualFold(FieldBodyTemplate, v))
+}
+
+// BodyTemplateContainsFold applies the ContainsFold predicate on the "body_template" field.
+func BodyTemplateContainsFold(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldContainsFold(FieldBodyTemplate, v))
+}
+
+// RoutingModeEQ applies the EQ predicate on the "routing_mode" field.
+func RoutingModeEQ(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldRoutingMode, v))
+}
+
+// RoutingModeNEQ applies the NEQ predicate on the "routing_mode" field.
+func RoutingModeNEQ(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldRoutingMode, v))
+}
+
+// RoutingModeIn applies the In predicate on the "routing_mode" field.
+func RoutingModeIn(vs ...string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIn(FieldRoutingMode, vs...))
+}
+
+// RoutingModeNotIn applies the NotIn predicate on the "routing_mode" field.
+func RoutingModeNotIn(vs ...string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotIn(FieldRoutingMode, vs...))
+}
+
+// RoutingModeGT applies the GT predicate on the "routing_mode" field.
+func RoutingModeGT(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGT(FieldRoutingMode, v))
+}
+
+// RoutingModeGTE applies the GTE predicate on the "routing_mode" field.
+func RoutingModeGTE(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGTE(FieldRoutingMode, v))
+}
+
+// RoutingModeLT applies the LT predicate on the "routing_mode" field.
+func RoutingModeLT(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLT(FieldRoutingMode, v))
+}
+
+// RoutingModeLTE applies the LTE predicate on the "routing_mode" field.
+func RoutingModeLTE(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLTE(FieldRoutingMode, v))
+}
+
+// RoutingModeContains applies the Contains predicate on the "routing_mode" field.
+func RoutingModeContains(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldContains(FieldRoutingMode, v))
+}
+
+// RoutingModeHasPrefix applies the HasPrefix predicate on the "routing_mode" field.
+func RoutingModeHasPrefix(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldHasPrefix(FieldRoutingMode, v))
+}
+
+// RoutingModeHasSuffix applies the HasSuffix predicate on the "routing_mode" field.
+func RoutingModeHasSuffix(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldHasSuffix(FieldRoutingMode, v))
+}
+
+// RoutingModeIsNil applies the IsNil predicate on the "routing_mode" field.
+func RoutingModeIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldRoutingMode))
+}
+
+// RoutingModeNotNil applies the NotNil predicate on the "routing_mode" field.
+func RoutingModeNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldRoutingMode))
+}
+
+// RoutingModeEqualFold applies the EqualFold predicate on the "routing_mode" field.
+func RoutingModeEqualFold(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEqualFold(FieldRoutingMode, v))
+}
+
+// RoutingModeContainsFold applies the ContainsFold predicate on the "routing_mode" field.
+func RoutingModeContainsFold(v string) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldContainsFold(FieldRoutingMode, v))
+}
+
+// EscalateAfterSecondsEQ applies the EQ predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsEQ(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldEscalateAfterSeconds, v))
+}
+
+// EscalateAfterSecondsNEQ applies the NEQ predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsNEQ(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldEscalateAfterSeconds, v))
+}
+
+// EscalateAfterSecondsIn applies the In predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsIn(vs ...int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIn(FieldEscalateAfterSeconds, vs...))
+}
+
+// EscalateAfterSecondsNotIn applies the NotIn predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsNotIn(vs ...int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotIn(FieldEscalateAfterSeconds, vs...))
+}
+
+// EscalateAfterSecondsGT applies the GT predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsGT(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGT(FieldEscalateAfterSeconds, v))
+}
+
+// EscalateAfterSecondsGTE applies the GTE predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsGTE(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGTE(FieldEscalateAfterSeconds, v))
+}
+
+// EscalateAfterSecondsLT applies the LT predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsLT(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLT(FieldEscalateAfterSeconds, v))
+}
+
+// EscalateAfterSecondsLTE applies the LTE predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsLTE(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLTE(FieldEscalateAfterSeconds, v))
+}
+
+// EscalateAfterSecondsIsNil applies the IsNil predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldEscalateAfterSeconds))
+}
+
+// EscalateAfterSecondsNotNil applies the NotNil predicate on the "escalate_after_seconds" field.
+func EscalateAfterSecondsNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldEscalateAfterSeconds))
+}
+
+// AlwaysNotifyEQ applies the EQ predicate on the "always_notify" field.
+func AlwaysNotifyEQ(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldAlwaysNotify, v))
+}
+
+// AlwaysNotifyNEQ applies the NEQ predicate on the "always_notify" field.
+func AlwaysNotifyNEQ(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldAlwaysNotify, v))
+}
+
+// NotifyStreamEndEQ applies the EQ predicate on the "notify_stream_end" field.
+func NotifyStreamEndEQ(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldNotifyStreamEnd, v))
+}
+
+// NotifyStreamEndNEQ applies the NEQ predicate on the "notify_stream_end" field.
+func NotifyStreamEndNEQ(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldNotifyStreamEnd, v))
+}
+
+// NotifyTitleChangeEQ applies the EQ predicate on the "notify_title_change" field.
+func NotifyTitleChangeEQ(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldNotifyTitleChange, v))
+}
+
+// NotifyTitleChangeNEQ applies the NEQ predicate on the "notify_title_change" field.
+func NotifyTitleChangeNEQ(v bool) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldNotifyTitleChange, v))
+}
+
+// NotifyCategoriesIsNil applies the IsNil predicate on the "notify_categories" field.
+func NotifyCategoriesIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldNotifyCategories))
+}
+
+// NotifyCategoriesNotNil applies the NotNil predicate on the "notify_categories" field.
+func NotifyCategoriesNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldNotifyCategories))
+}
+
+// NotificationFilterIsNil applies the IsNil predicate on the "notification_filter" field.
+func NotificationFilterIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldNotificationFilter))
+}
+
+// NotificationFilterNotNil applies the NotNil predicate on the "notification_filter" field.
+func NotificationFilterNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldNotificationFilter))
+}
+
+// ChannelOverridesIsNil applies the IsNil predicate on the "channel_overrides" field.
+func ChannelOverridesIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldChannelOverrides))
+}
+
+// ChannelOverridesNotNil applies the NotNil predicate on the "channel_overrides" field.
+func ChannelOverridesNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldChannelOverrides))
+}
+
 // LastNotificationSentAtEQ applies the EQ predicate on the "last_notification_sent_at" field.
 func LastNotificationSentAtEQ(v time.Time) predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldLastNotificationSentAt, v))
@@ -355,6 +735,106 @@ func LastNotificationSentAtNotNil() predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldLastNotificationSentAt))
 }
 
+// SnoozedUntilEQ applies the EQ predicate on the "snoozed_until" field.
+func SnoozedUntilEQ(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilNEQ applies the NEQ predicate on the "snoozed_until" field.
+func SnoozedUntilNEQ(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilIn applies the In predicate on the "snoozed_until" field.
+func SnoozedUntilIn(vs ...time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIn(FieldSnoozedUntil, vs...))
+}
+
+// SnoozedUntilNotIn applies the NotIn predicate on the "snoozed_until" field.
+func SnoozedUntilNotIn(vs ...time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotIn(FieldSnoozedUntil, vs...))
+}
+
+// SnoozedUntilGT applies the GT predicate on the "snoozed_until" field.
+func SnoozedUntilGT(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGT(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilGTE applies the GTE predicate on the "snoozed_until" field.
+func SnoozedUntilGTE(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGTE(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilLT applies the LT predicate on the "snoozed_until" field.
+func SnoozedUntilLT(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLT(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilLTE applies the LTE predicate on the "snoozed_until" field.
+func SnoozedUntilLTE(v time.Time) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLTE(FieldSnoozedUntil, v))
+}
+
+// SnoozedUntilIsNil applies the IsNil predicate on the "snoozed_until" field.
+func SnoozedUntilIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldSnoozedUntil))
+}
+
+// SnoozedUntilNotNil applies the NotNil predicate on the "snoozed_until" field.
+func SnoozedUntilNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldSnoozedUntil))
+}
+
+// MutedSessionIDEQ applies the EQ predicate on the "muted_session_id" field.
+func MutedSessionIDEQ(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldMutedSessionID, v))
+}
+
+// MutedSessionIDNEQ applies the NEQ predicate on the "muted_session_id" field.
+func MutedSessionIDNEQ(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNEQ(FieldMutedSessionID, v))
+}
+
+// MutedSessionIDIn applies the In predicate on the "muted_session_id" field.
+func MutedSessionIDIn(vs ...int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIn(FieldMutedSessionID, vs...))
+}
+
+// MutedSessionIDNotIn applies the NotIn predicate on the "muted_session_id" field.
+func MutedSessionIDNotIn(vs ...int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotIn(FieldMutedSessionID, vs...))
+}
+
+// MutedSessionIDGT applies the GT predicate on the "muted_session_id" field.
+func MutedSessionIDGT(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGT(FieldMutedSessionID, v))
+}
+
+// MutedSessionIDGTE applies the GTE predicate on the "muted_session_id" field.
+func MutedSessionIDGTE(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldGTE(FieldMutedSessionID, v))
+}
+
+// MutedSessionIDLT applies the LT predicate on the "muted_session_id" field.
+func MutedSessionIDLT(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLT(FieldMutedSessionID, v))
+}
+
+// MutedSessionIDLTE applies the LTE predicate on the "muted_session_id" field.
+func MutedSessionIDLTE(v int64) predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldLTE(FieldMutedSessionID, v))
+}
+
+// MutedSessionIDIsNil applies the IsNil predicate on the "muted_session_id" field.
+func MutedSessionIDIsNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldIsNull(FieldMutedSessionID))
+}
+
+// MutedSessionIDNotNil applies the NotNil predicate on the "muted_session_id" field.
+func MutedSessionIDNotNil() predicate.UserFollowedStreamer {
+	return predicate.UserFollowedStreamer(sql.FieldNotNull(FieldMutedSessionID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserFollowedStreamer {
 	return predicate.UserFollowedStreamer(sql.FieldEQ(FieldCreatedAt, v))
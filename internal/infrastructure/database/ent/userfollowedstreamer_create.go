@@ -82,6 +82,122 @@ func (_c *UserFollowedStreamerCreate) SetNotificationChannelIds(v []int64) *User
 	return _c
 }
 
+// SetTitleTemplate sets the "title_template" field.
+func (_c *UserFollowedStreamerCreate) SetTitleTemplate(v string) *UserFollowedStreamerCreate {
+	_c.mutation.SetTitleTemplate(v)
+	return _c
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableTitleTemplate(v *string) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetTitleTemplate(*v)
+	}
+	return _c
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (_c *UserFollowedStreamerCreate) SetBodyTemplate(v string) *UserFollowedStreamerCreate {
+	_c.mutation.SetBodyTemplate(v)
+	return _c
+}
+
+// SetNillableBodyTemplate sets the "body_template" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableBodyTemplate(v *string) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetBodyTemplate(*v)
+	}
+	return _c
+}
+
+// SetRoutingMode sets the "routing_mode" field.
+func (_c *UserFollowedStreamerCreate) SetRoutingMode(v string) *UserFollowedStreamerCreate {
+	_c.mutation.SetRoutingMode(v)
+	return _c
+}
+
+// SetNillableRoutingMode sets the "routing_mode" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableRoutingMode(v *string) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetRoutingMode(*v)
+	}
+	return _c
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_c *UserFollowedStreamerCreate) SetEscalateAfterSeconds(v int64) *UserFollowedStreamerCreate {
+	_c.mutation.SetEscalateAfterSeconds(v)
+	return _c
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableEscalateAfterSeconds(v *int64) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetEscalateAfterSeconds(*v)
+	}
+	return _c
+}
+
+// SetAlwaysNotify sets the "always_notify" field.
+func (_c *UserFollowedStreamerCreate) SetAlwaysNotify(v bool) *UserFollowedStreamerCreate {
+	_c.mutation.SetAlwaysNotify(v)
+	return _c
+}
+
+// SetNillableAlwaysNotify sets the "always_notify" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableAlwaysNotify(v *bool) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetAlwaysNotify(*v)
+	}
+	return _c
+}
+
+// SetNotifyStreamEnd sets the "notify_stream_end" field.
+func (_c *UserFollowedStreamerCreate) SetNotifyStreamEnd(v bool) *UserFollowedStreamerCreate {
+	_c.mutation.SetNotifyStreamEnd(v)
+	return _c
+}
+
+// SetNillableNotifyStreamEnd sets the "notify_stream_end" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableNotifyStreamEnd(v *bool) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetNotifyStreamEnd(*v)
+	}
+	return _c
+}
+
+// SetNotifyTitleChange sets the "notify_title_change" field.
+func (_c *UserFollowedStreamerCreate) SetNotifyTitleChange(v bool) *UserFollowedStreamerCreate {
+	_c.mutation.SetNotifyTitleChange(v)
+	return _c
+}
+
+// SetNillableNotifyTitleChange sets the "notify_title_change" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableNotifyTitleChange(v *bool) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetNotifyTitleChange(*v)
+	}
+	return _c
+}
+
+// SetNotifyCategories sets the "notify_categories" field.
+func (_c *UserFollowedStreamerCreate) SetNotifyCategories(v []string) *UserFollowedStreamerCreate {
+	_c.mutation.SetNotifyCategories(v)
+	return _c
+}
+
+// SetNotificationFilter sets the "notification_filter" field.
+func (_c *UserFollowedStreamerCreate) SetNotificationFilter(v map[string]interface{}) *UserFollowedStreamerCreate {
+	_c.mutation.SetNotificationFilter(v)
+	return _c
+}
+
+// SetChannelOverrides sets the "channel_overrides" field.
+func (_c *UserFollowedStreamerCreate) SetChannelOverrides(v map[int64]map[string]interface{}) *UserFollowedStreamerCreate {
+	_c.mutation.SetChannelOverrides(v)
+	return _c
+}
+
 // SetLastNotificationSentAt sets the "last_notification_sent_at" field.
 func (_c *UserFollowedStreamerCreate) SetLastNotificationSentAt(v time.Time) *UserFollowedStreamerCreate {
 	_c.mutation.SetLastNotificationSentAt(v)
@@ -96,6 +212,34 @@ func (_c *UserFollowedStreamerCreate) SetNillableLastNotificationSentAt(v *time.
 	return _c
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_c *UserFollowedStreamerCreate) SetSnoozedUntil(v time.Time) *UserFollowedStreamerCreate {
+	_c.mutation.SetSnoozedUntil(v)
+	return _c
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableSnoozedUntil(v *time.Time) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetSnoozedUntil(*v)
+	}
+	return _c
+}
+
+// SetMutedSessionID sets the "muted_session_id" field.
+func (_c *UserFollowedStreamerCreate) SetMutedSessionID(v int64) *UserFollowedStreamerCreate {
+	_c.mutation.SetMutedSessionID(v)
+	return _c
+}
+
+// SetNillableMutedSessionID sets the "muted_session_id" field if the given value is not nil.
+func (_c *UserFollowedStreamerCreate) SetNillableMutedSessionID(v *int64) *UserFollowedStreamerCreate {
+	if v != nil {
+		_c.SetMutedSessionID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserFollowedStreamerCreate) SetCreatedAt(v time.Time) *UserFollowedStreamerCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -183,6 +327,18 @@ func (_c *UserFollowedStreamerCreate) defaults() {
 		v := userfollowedstreamer.DefaultNotificationChannelIds
 		_c.mutation.SetNotificationChannelIds(v)
 	}
+	if _, ok := _c.mutation.AlwaysNotify(); !ok {
+		v := userfollowedstreamer.DefaultAlwaysNotify
+		_c.mutation.SetAlwaysNotify(v)
+	}
+	if _, ok := _c.mutation.NotifyStreamEnd(); !ok {
+		v := userfollowedstreamer.DefaultNotifyStreamEnd
+		_c.mutation.SetNotifyStreamEnd(v)
+	}
+	if _, ok := _c.mutation.NotifyTitleChange(); !ok {
+		v := userfollowedstreamer.DefaultNotifyTitleChange
+		_c.mutation.SetNotifyTitleChange(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := userfollowedstreamer.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -214,6 +370,15 @@ func (_c *UserFollowedStreamerCreate) check() error {
 	if _, ok := _c.mutation.NotificationsEnabled(); !ok {
 		return &ValidationError{Name: "notifications_enabled", err: errors.New(`ent: missing required field "UserFollowedStreamer.notifications_enabled"`)}
 	}
+	if _, ok := _c.mutation.AlwaysNotify(); !ok {
+		return &ValidationError{Name: "always_notify", err: errors.New(`ent: missing required field "UserFollowedStreamer.always_notify"`)}
+	}
+	if _, ok := _c.mutation.NotifyStreamEnd(); !ok {
+		return &ValidationError{Name: "notify_stream_end", err: errors.New(`ent: missing required field "UserFollowedStreamer.notify_stream_end"`)}
+	}
+	if _, ok := _c.mutation.NotifyTitleChange(); !ok {
+		return &ValidationError{Name: "notify_title_change", err: errors.New(`ent: missing required field "UserFollowedStreamer.notify_title_change"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserFollowedStreamer.created_at"`)}
 	}
@@ -274,10 +439,58 @@ func (_c *UserFollowedStreamerCreate) createSpec() (*UserFollowedStreamer, *sqlg
 		_spec.SetField(userfollowedstreamer.FieldNotificationChannelIds, field.TypeJSON, value)
 		_node.NotificationChannelIds = value
 	}
+	if value, ok := _c.mutation.TitleTemplate(); ok {
+		_spec.SetField(userfollowedstreamer.FieldTitleTemplate, field.TypeString, value)
+		_node.TitleTemplate = &value
+	}
+	if value, ok := _c.mutation.BodyTemplate(); ok {
+		_spec.SetField(userfollowedstreamer.FieldBodyTemplate, field.TypeString, value)
+		_node.BodyTemplate = &value
+	}
+	if value, ok := _c.mutation.RoutingMode(); ok {
+		_spec.SetField(userfollowedstreamer.FieldRoutingMode, field.TypeString, value)
+		_node.RoutingMode = &value
+	}
+	if value, ok := _c.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64, value)
+		_node.EscalateAfterSeconds = &value
+	}
+	if value, ok := _c.mutation.AlwaysNotify(); ok {
+		_spec.SetField(userfollowedstreamer.FieldAlwaysNotify, field.TypeBool, value)
+		_node.AlwaysNotify = value
+	}
+	if value, ok := _c.mutation.NotifyStreamEnd(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyStreamEnd, field.TypeBool, value)
+		_node.NotifyStreamEnd = value
+	}
+	if value, ok := _c.mutation.NotifyTitleChange(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyTitleChange, field.TypeBool, value)
+		_node.NotifyTitleChange = value
+	}
+	if value, ok := _c.mutation.NotifyCategories(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyCategories, field.TypeJSON, value)
+		_node.NotifyCategories = value
+	}
+	if value, ok := _c.mutation.NotificationFilter(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotificationFilter, field.TypeJSON, value)
+		_node.NotificationFilter = value
+	}
+	if value, ok := _c.mutation.ChannelOverrides(); ok {
+		_spec.SetField(userfollowedstreamer.FieldChannelOverrides, field.TypeJSON, value)
+		_node.ChannelOverrides = value
+	}
 	if value, ok := _c.mutation.LastNotificationSentAt(); ok {
 		_spec.SetField(userfollowedstreamer.FieldLastNotificationSentAt, field.TypeTime, value)
 		_node.LastNotificationSentAt = &value
 	}
+	if value, ok := _c.mutation.SnoozedUntil(); ok {
+		_spec.SetField(userfollowedstreamer.FieldSnoozedUntil, field.TypeTime, value)
+		_node.SnoozedUntil = &value
+	}
+	if value, ok := _c.mutation.MutedSessionID(); ok {
+		_spec.SetField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64, value)
+		_node.MutedSessionID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(userfollowedstreamer.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -131,6 +131,177 @@ func (_u *UserFollowedStreamerUpdate) ClearNotificationChannelIds() *UserFollowe
 	return _u
 }
 
+// SetTitleTemplate sets the "title_template" field.
+func (_u *UserFollowedStreamerUpdate) SetTitleTemplate(v string) *UserFollowedStreamerUpdate {
+	_u.mutation.SetTitleTemplate(v)
+	return _u
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableTitleTemplate(v *string) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetTitleTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (_u *UserFollowedStreamerUpdate) ClearTitleTemplate() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearTitleTemplate()
+	return _u
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (_u *UserFollowedStreamerUpdate) SetBodyTemplate(v string) *UserFollowedStreamerUpdate {
+	_u.mutation.SetBodyTemplate(v)
+	return _u
+}
+
+// SetNillableBodyTemplate sets the "body_template" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableBodyTemplate(v *string) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetBodyTemplate(*v)
+	}
+	return _u
+}
+
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (_u *UserFollowedStreamerUpdate) ClearBodyTemplate() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearBodyTemplate()
+	return _u
+}
+
+// SetRoutingMode sets the "routing_mode" field.
+func (_u *UserFollowedStreamerUpdate) SetRoutingMode(v string) *UserFollowedStreamerUpdate {
+	_u.mutation.SetRoutingMode(v)
+	return _u
+}
+
+// SetNillableRoutingMode sets the "routing_mode" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableRoutingMode(v *string) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetRoutingMode(*v)
+	}
+	return _u
+}
+
+// ClearRoutingMode clears the value of the "routing_mode" field.
+func (_u *UserFollowedStreamerUpdate) ClearRoutingMode() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearRoutingMode()
+	return _u
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_u *UserFollowedStreamerUpdate) SetEscalateAfterSeconds(v int64) *UserFollowedStreamerUpdate {
+	_u.mutation.ResetEscalateAfterSeconds()
+	_u.mutation.SetEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableEscalateAfterSeconds(v *int64) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetEscalateAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddEscalateAfterSeconds adds value to the "escalate_after_seconds" field.
+func (_u *UserFollowedStreamerUpdate) AddEscalateAfterSeconds(v int64) *UserFollowedStreamerUpdate {
+	_u.mutation.AddEscalateAfterSeconds(v)
+	return _u
+}
+
+// ClearEscalateAfterSeconds clears the value of the "escalate_after_seconds" field.
+func (_u *UserFollowedStreamerUpdate) ClearEscalateAfterSeconds() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearEscalateAfterSeconds()
+	return _u
+}
+
+// SetAlwaysNotify sets the "always_notify" field.
+func (_u *UserFollowedStreamerUpdate) SetAlwaysNotify(v bool) *UserFollowedStreamerUpdate {
+	_u.mutation.SetAlwaysNotify(v)
+	return _u
+}
+
+// SetNillableAlwaysNotify sets the "always_notify" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableAlwaysNotify(v *bool) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetAlwaysNotify(*v)
+	}
+	return _u
+}
+
+// SetNotifyStreamEnd sets the "notify_stream_end" field.
+func (_u *UserFollowedStreamerUpdate) SetNotifyStreamEnd(v bool) *UserFollowedStreamerUpdate {
+	_u.mutation.SetNotifyStreamEnd(v)
+	return _u
+}
+
+// SetNillableNotifyStreamEnd sets the "notify_stream_end" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableNotifyStreamEnd(v *bool) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetNotifyStreamEnd(*v)
+	}
+	return _u
+}
+
+// SetNotifyTitleChange sets the "notify_title_change" field.
+func (_u *UserFollowedStreamerUpdate) SetNotifyTitleChange(v bool) *UserFollowedStreamerUpdate {
+	_u.mutation.SetNotifyTitleChange(v)
+	return _u
+}
+
+// SetNillableNotifyTitleChange sets the "notify_title_change" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableNotifyTitleChange(v *bool) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetNotifyTitleChange(*v)
+	}
+	return _u
+}
+
+// SetNotifyCategories sets the "notify_categories" field.
+func (_u *UserFollowedStreamerUpdate) SetNotifyCategories(v []string) *UserFollowedStreamerUpdate {
+	_u.mutation.SetNotifyCategories(v)
+	return _u
+}
+
+// AppendNotifyCategories appends value to the "notify_categories" field.
+func (_u *UserFollowedStreamerUpdate) AppendNotifyCategories(v []string) *UserFollowedStreamerUpdate {
+	_u.mutation.AppendNotifyCategories(v)
+	return _u
+}
+
+// ClearNotifyCategories clears the value of the "notify_categories" field.
+func (_u *UserFollowedStreamerUpdate) ClearNotifyCategories() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearNotifyCategories()
+	return _u
+}
+
+// SetNotificationFilter sets the "notification_filter" field.
+func (_u *UserFollowedStreamerUpdate) SetNotificationFilter(v map[string]interface{}) *UserFollowedStreamerUpdate {
+	_u.mutation.SetNotificationFilter(v)
+	return _u
+}
+
+// ClearNotificationFilter clears the value of the "notification_filter" field.
+func (_u *UserFollowedStreamerUpdate) ClearNotificationFilter() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearNotificationFilter()
+	return _u
+}
+
+// SetChannelOverrides sets the "channel_overrides" field.
+func (_u *UserFollowedStreamerUpdate) SetChannelOverrides(v map[int64]map[string]interface{}) *UserFollowedStreamerUpdate {
+	_u.mutation.SetChannelOverrides(v)
+	return _u
+}
+
+// ClearChannelOverrides clears the value of the "channel_overrides" field.
+func (_u *UserFollowedStreamerUpdate) ClearChannelOverrides() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearChannelOverrides()
+	return _u
+}
+
 // SetLastNotificationSentAt sets the "last_notification_sent_at" field.
 func (_u *UserFollowedStreamerUpdate) SetLastNotificationSentAt(v time.Time) *UserFollowedStreamerUpdate {
 	_u.mutation.SetLastNotificationSentAt(v)
@@ -151,6 +322,53 @@ func (_u *UserFollowedStreamerUpdate) ClearLastNotificationSentAt() *UserFollowe
 	return _u
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_u *UserFollowedStreamerUpdate) SetSnoozedUntil(v time.Time) *UserFollowedStreamerUpdate {
+	_u.mutation.SetSnoozedUntil(v)
+	return _u
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableSnoozedUntil(v *time.Time) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetSnoozedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (_u *UserFollowedStreamerUpdate) ClearSnoozedUntil() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearSnoozedUntil()
+	return _u
+}
+
+// SetMutedSessionID sets the "muted_session_id" field.
+func (_u *UserFollowedStreamerUpdate) SetMutedSessionID(v int64) *UserFollowedStreamerUpdate {
+	_u.mutation.ResetMutedSessionID()
+	_u.mutation.SetMutedSessionID(v)
+	return _u
+}
+
+// SetNillableMutedSessionID sets the "muted_session_id" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdate) SetNillableMutedSessionID(v *int64) *UserFollowedStreamerUpdate {
+	if v != nil {
+		_u.SetMutedSessionID(*v)
+	}
+	return _u
+}
+
+// AddMutedSessionID adds value to the "muted_session_id" field.
+func (_u *UserFollowedStreamerUpdate) AddMutedSessionID(v int64) *UserFollowedStreamerUpdate {
+	_u.mutation.AddMutedSessionID(v)
+	return _u
+}
+
+// ClearMutedSessionID clears the value of the "muted_session_id" field.
+func (_u *UserFollowedStreamerUpdate) ClearMutedSessionID() *UserFollowedStreamerUpdate {
+	_u.mutation.ClearMutedSessionID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserFollowedStreamerUpdate) SetUpdatedAt(v time.Time) *UserFollowedStreamerUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -279,12 +497,86 @@ func (_u *UserFollowedStreamerUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.NotificationChannelIdsCleared() {
 		_spec.ClearField(userfollowedstreamer.FieldNotificationChannelIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.TitleTemplate(); ok {
+		_spec.SetField(userfollowedstreamer.FieldTitleTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TitleTemplateCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldTitleTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodyTemplate(); ok {
+		_spec.SetField(userfollowedstreamer.FieldBodyTemplate, field.TypeString, value)
+	}
+	if _u.mutation.BodyTemplateCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldBodyTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoutingMode(); ok {
+		_spec.SetField(userfollowedstreamer.FieldRoutingMode, field.TypeString, value)
+	}
+	if _u.mutation.RoutingModeCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldRoutingMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEscalateAfterSeconds(); ok {
+		_spec.AddField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if _u.mutation.EscalateAfterSecondsCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AlwaysNotify(); ok {
+		_spec.SetField(userfollowedstreamer.FieldAlwaysNotify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyStreamEnd(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyStreamEnd, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyTitleChange(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyTitleChange, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyCategories(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNotifyCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userfollowedstreamer.FieldNotifyCategories, value)
+		})
+	}
+	if _u.mutation.NotifyCategoriesCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldNotifyCategories, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NotificationFilter(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotificationFilter, field.TypeJSON, value)
+	}
+	if _u.mutation.NotificationFilterCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldNotificationFilter, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChannelOverrides(); ok {
+		_spec.SetField(userfollowedstreamer.FieldChannelOverrides, field.TypeJSON, value)
+	}
+	if _u.mutation.ChannelOverridesCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldChannelOverrides, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.LastNotificationSentAt(); ok {
 		_spec.SetField(userfollowedstreamer.FieldLastNotificationSentAt, field.TypeTime, value)
 	}
 	if _u.mutation.LastNotificationSentAtCleared() {
 		_spec.ClearField(userfollowedstreamer.FieldLastNotificationSentAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SnoozedUntil(); ok {
+		_spec.SetField(userfollowedstreamer.FieldSnoozedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SnoozedUntilCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldSnoozedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MutedSessionID(); ok {
+		_spec.SetField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMutedSessionID(); ok {
+		_spec.AddField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64, value)
+	}
+	if _u.mutation.MutedSessionIDCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(userfollowedstreamer.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -466,6 +758,177 @@ func (_u *UserFollowedStreamerUpdateOne) ClearNotificationChannelIds() *UserFoll
 	return _u
 }
 
+// SetTitleTemplate sets the "title_template" field.
+func (_u *UserFollowedStreamerUpdateOne) SetTitleTemplate(v string) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetTitleTemplate(v)
+	return _u
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableTitleTemplate(v *string) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetTitleTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearTitleTemplate() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearTitleTemplate()
+	return _u
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (_u *UserFollowedStreamerUpdateOne) SetBodyTemplate(v string) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetBodyTemplate(v)
+	return _u
+}
+
+// SetNillableBodyTemplate sets the "body_template" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableBodyTemplate(v *string) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetBodyTemplate(*v)
+	}
+	return _u
+}
+
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearBodyTemplate() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearBodyTemplate()
+	return _u
+}
+
+// SetRoutingMode sets the "routing_mode" field.
+func (_u *UserFollowedStreamerUpdateOne) SetRoutingMode(v string) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetRoutingMode(v)
+	return _u
+}
+
+// SetNillableRoutingMode sets the "routing_mode" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableRoutingMode(v *string) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetRoutingMode(*v)
+	}
+	return _u
+}
+
+// ClearRoutingMode clears the value of the "routing_mode" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearRoutingMode() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearRoutingMode()
+	return _u
+}
+
+// SetEscalateAfterSeconds sets the "escalate_after_seconds" field.
+func (_u *UserFollowedStreamerUpdateOne) SetEscalateAfterSeconds(v int64) *UserFollowedStreamerUpdateOne {
+	_u.mutation.ResetEscalateAfterSeconds()
+	_u.mutation.SetEscalateAfterSeconds(v)
+	return _u
+}
+
+// SetNillableEscalateAfterSeconds sets the "escalate_after_seconds" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableEscalateAfterSeconds(v *int64) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetEscalateAfterSeconds(*v)
+	}
+	return _u
+}
+
+// AddEscalateAfterSeconds adds value to the "escalate_after_seconds" field.
+func (_u *UserFollowedStreamerUpdateOne) AddEscalateAfterSeconds(v int64) *UserFollowedStreamerUpdateOne {
+	_u.mutation.AddEscalateAfterSeconds(v)
+	return _u
+}
+
+// ClearEscalateAfterSeconds clears the value of the "escalate_after_seconds" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearEscalateAfterSeconds() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearEscalateAfterSeconds()
+	return _u
+}
+
+// SetAlwaysNotify sets the "always_notify" field.
+func (_u *UserFollowedStreamerUpdateOne) SetAlwaysNotify(v bool) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetAlwaysNotify(v)
+	return _u
+}
+
+// SetNillableAlwaysNotify sets the "always_notify" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableAlwaysNotify(v *bool) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetAlwaysNotify(*v)
+	}
+	return _u
+}
+
+// SetNotifyStreamEnd sets the "notify_stream_end" field.
+func (_u *UserFollowedStreamerUpdateOne) SetNotifyStreamEnd(v bool) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetNotifyStreamEnd(v)
+	return _u
+}
+
+// SetNillableNotifyStreamEnd sets the "notify_stream_end" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableNotifyStreamEnd(v *bool) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetNotifyStreamEnd(*v)
+	}
+	return _u
+}
+
+// SetNotifyTitleChange sets the "notify_title_change" field.
+func (_u *UserFollowedStreamerUpdateOne) SetNotifyTitleChange(v bool) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetNotifyTitleChange(v)
+	return _u
+}
+
+// SetNillableNotifyTitleChange sets the "notify_title_change" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableNotifyTitleChange(v *bool) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetNotifyTitleChange(*v)
+	}
+	return _u
+}
+
+// SetNotifyCategories sets the "notify_categories" field.
+func (_u *UserFollowedStreamerUpdateOne) SetNotifyCategories(v []string) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetNotifyCategories(v)
+	return _u
+}
+
+// AppendNotifyCategories appends value to the "notify_categories" field.
+func (_u *UserFollowedStreamerUpdateOne) AppendNotifyCategories(v []string) *UserFollowedStreamerUpdateOne {
+	_u.mutation.AppendNotifyCategories(v)
+	return _u
+}
+
+// ClearNotifyCategories clears the value of the "notify_categories" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearNotifyCategories() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearNotifyCategories()
+	return _u
+}
+
+// SetNotificationFilter sets the "notification_filter" field.
+func (_u *UserFollowedStreamerUpdateOne) SetNotificationFilter(v map[string]interface{}) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetNotificationFilter(v)
+	return _u
+}
+
+// ClearNotificationFilter clears the value of the "notification_filter" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearNotificationFilter() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearNotificationFilter()
+	return _u
+}
+
+// SetChannelOverrides sets the "channel_overrides" field.
+func (_u *UserFollowedStreamerUpdateOne) SetChannelOverrides(v map[int64]map[string]interface{}) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetChannelOverrides(v)
+	return _u
+}
+
+// ClearChannelOverrides clears the value of the "channel_overrides" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearChannelOverrides() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearChannelOverrides()
+	return _u
+}
+
 // SetLastNotificationSentAt sets the "last_notification_sent_at" field.
 func (_u *UserFollowedStreamerUpdateOne) SetLastNotificationSentAt(v time.Time) *UserFollowedStreamerUpdateOne {
 	_u.mutation.SetLastNotificationSentAt(v)
@@ -486,6 +949,53 @@ func (_u *UserFollowedStreamerUpdateOne) ClearLastNotificationSentAt() *UserFoll
 	return _u
 }
 
+// SetSnoozedUntil sets the "snoozed_until" field.
+func (_u *UserFollowedStreamerUpdateOne) SetSnoozedUntil(v time.Time) *UserFollowedStreamerUpdateOne {
+	_u.mutation.SetSnoozedUntil(v)
+	return _u
+}
+
+// SetNillableSnoozedUntil sets the "snoozed_until" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableSnoozedUntil(v *time.Time) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetSnoozedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSnoozedUntil clears the value of the "snoozed_until" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearSnoozedUntil() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearSnoozedUntil()
+	return _u
+}
+
+// SetMutedSessionID sets the "muted_session_id" field.
+func (_u *UserFollowedStreamerUpdateOne) SetMutedSessionID(v int64) *UserFollowedStreamerUpdateOne {
+	_u.mutation.ResetMutedSessionID()
+	_u.mutation.SetMutedSessionID(v)
+	return _u
+}
+
+// SetNillableMutedSessionID sets the "muted_session_id" field if the given value is not nil.
+func (_u *UserFollowedStreamerUpdateOne) SetNillableMutedSessionID(v *int64) *UserFollowedStreamerUpdateOne {
+	if v != nil {
+		_u.SetMutedSessionID(*v)
+	}
+	return _u
+}
+
+// AddMutedSessionID adds value to the "muted_session_id" field.
+func (_u *UserFollowedStreamerUpdateOne) AddMutedSessionID(v int64) *UserFollowedStreamerUpdateOne {
+	_u.mutation.AddMutedSessionID(v)
+	return _u
+}
+
+// ClearMutedSessionID clears the value of the "muted_session_id" field.
+func (_u *UserFollowedStreamerUpdateOne) ClearMutedSessionID() *UserFollowedStreamerUpdateOne {
+	_u.mutation.ClearMutedSessionID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserFollowedStreamerUpdateOne) SetUpdatedAt(v time.Time) *UserFollowedStreamerUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -644,12 +1154,86 @@ func (_u *UserFollowedStreamerUpdateOne) sqlSave(ctx context.Context) (_node *Us
 	if _u.mutation.NotificationChannelIdsCleared() {
 		_spec.ClearField(userfollowedstreamer.FieldNotificationChannelIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.TitleTemplate(); ok {
+		_spec.SetField(userfollowedstreamer.FieldTitleTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TitleTemplateCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldTitleTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.BodyTemplate(); ok {
+		_spec.SetField(userfollowedstreamer.FieldBodyTemplate, field.TypeString, value)
+	}
+	if _u.mutation.BodyTemplateCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldBodyTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoutingMode(); ok {
+		_spec.SetField(userfollowedstreamer.FieldRoutingMode, field.TypeString, value)
+	}
+	if _u.mutation.RoutingModeCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldRoutingMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.EscalateAfterSeconds(); ok {
+		_spec.SetField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEscalateAfterSeconds(); ok {
+		_spec.AddField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64, value)
+	}
+	if _u.mutation.EscalateAfterSecondsCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldEscalateAfterSeconds, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AlwaysNotify(); ok {
+		_spec.SetField(userfollowedstreamer.FieldAlwaysNotify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyStreamEnd(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyStreamEnd, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyTitleChange(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyTitleChange, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyCategories(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotifyCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNotifyCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userfollowedstreamer.FieldNotifyCategories, value)
+		})
+	}
+	if _u.mutation.NotifyCategoriesCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldNotifyCategories, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NotificationFilter(); ok {
+		_spec.SetField(userfollowedstreamer.FieldNotificationFilter, field.TypeJSON, value)
+	}
+	if _u.mutation.NotificationFilterCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldNotificationFilter, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChannelOverrides(); ok {
+		_spec.SetField(userfollowedstreamer.FieldChannelOverrides, field.TypeJSON, value)
+	}
+	if _u.mutation.ChannelOverridesCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldChannelOverrides, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.LastNotificationSentAt(); ok {
 		_spec.SetField(userfollowedstreamer.FieldLastNotificationSentAt, field.TypeTime, value)
 	}
 	if _u.mutation.LastNotificationSentAtCleared() {
 		_spec.ClearField(userfollowedstreamer.FieldLastNotificationSentAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SnoozedUntil(); ok {
+		_spec.SetField(userfollowedstreamer.FieldSnoozedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SnoozedUntilCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldSnoozedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MutedSessionID(); ok {
+		_spec.SetField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMutedSessionID(); ok {
+		_spec.AddField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64, value)
+	}
+	if _u.mutation.MutedSessionIDCleared() {
+		_spec.ClearField(userfollowedstreamer.FieldMutedSessionID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(userfollowedstreamer.FieldUpdatedAt, field.TypeTime, value)
 	}
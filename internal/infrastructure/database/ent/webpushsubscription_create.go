@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
+)
+
+// WebPushSubscriptionCreate is the builder for creating a WebPushSubscription entity.
+type WebPushSubscriptionCreate struct {
+	config
+	mutation *WebPushSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WebPushSubscriptionCreate) SetUserID(v int64) *WebPushSubscriptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_c *WebPushSubscriptionCreate) SetEndpoint(v string) *WebPushSubscriptionCreate {
+	_c.mutation.SetEndpoint(v)
+	return _c
+}
+
+// SetP256dh sets the "p256dh" field.
+func (_c *WebPushSubscriptionCreate) SetP256dh(v string) *WebPushSubscriptionCreate {
+	_c.mutation.SetP256dh(v)
+	return _c
+}
+
+// SetAuth sets the "auth" field.
+func (_c *WebPushSubscriptionCreate) SetAuth(v string) *WebPushSubscriptionCreate {
+	_c.mutation.SetAuth(v)
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *WebPushSubscriptionCreate) SetUserAgent(v string) *WebPushSubscriptionCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *WebPushSubscriptionCreate) SetNillableUserAgent(v *string) *WebPushSubscriptionCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebPushSubscriptionCreate) SetCreatedAt(v time.Time) *WebPushSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebPushSubscriptionCreate) SetNillableCreatedAt(v *time.Time) *WebPushSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WebPushSubscriptionCreate) SetUpdatedAt(v time.Time) *WebPushSubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WebPushSubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *WebPushSubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WebPushSubscriptionCreate) SetID(v int64) *WebPushSubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WebPushSubscriptionCreate) SetUser(v *User) *WebPushSubscriptionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the WebPushSubscriptionMutation object of the builder.
+func (_c *WebPushSubscriptionCreate) Mutation() *WebPushSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the WebPushSubscription in the database.
+func (_c *WebPushSubscriptionCreate) Save(ctx context.Context) (*WebPushSubscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebPushSubscriptionCreate) SaveX(ctx context.Context) *WebPushSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebPushSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebPushSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebPushSubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webpushsubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := webpushsubscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebPushSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WebPushSubscription.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := webpushsubscription.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "WebPushSubscription.endpoint"`)}
+	}
+	if v, ok := _c.mutation.Endpoint(); ok {
+		if err := webpushsubscription.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.endpoint": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.P256dh(); !ok {
+		return &ValidationError{Name: "p256dh", err: errors.New(`ent: missing required field "WebPushSubscription.p256dh"`)}
+	}
+	if v, ok := _c.mutation.P256dh(); ok {
+		if err := webpushsubscription.P256dhValidator(v); err != nil {
+			return &ValidationError{Name: "p256dh", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.p256dh": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Auth(); !ok {
+		return &ValidationError{Name: "auth", err: errors.New(`ent: missing required field "WebPushSubscription.auth"`)}
+	}
+	if v, ok := _c.mutation.Auth(); ok {
+		if err := webpushsubscription.AuthValidator(v); err != nil {
+			return &ValidationError{Name: "auth", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.auth": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebPushSubscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebPushSubscription.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WebPushSubscription.user"`)}
+	}
+	return nil
+}
+
+func (_c *WebPushSubscriptionCreate) sqlSave(ctx context.Context) (*WebPushSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebPushSubscriptionCreate) createSpec() (*WebPushSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebPushSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webpushsubscription.Table, sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Endpoint(); ok {
+		_spec.SetField(webpushsubscription.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := _c.mutation.P256dh(); ok {
+		_spec.SetField(webpushsubscription.FieldP256dh, field.TypeString, value)
+		_node.P256dh = value
+	}
+	if value, ok := _c.mutation.Auth(); ok {
+		_spec.SetField(webpushsubscription.FieldAuth, field.TypeString, value)
+		_node.Auth = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(webpushsubscription.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webpushsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(webpushsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webpushsubscription.UserTable,
+			Columns: []string{webpushsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebPushSubscriptionCreateBulk is the builder for creating many WebPushSubscription entities in bulk.
+type WebPushSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*WebPushSubscriptionCreate
+}
+
+// Save creates the WebPushSubscription entities in the database.
+func (_c *WebPushSubscriptionCreateBulk) Save(ctx context.Context) ([]*WebPushSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebPushSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebPushSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebPushSubscriptionCreateBulk) SaveX(ctx context.Context) []*WebPushSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebPushSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebPushSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
+)
+
+// WebPushSubscriptionUpdate is the builder for updating WebPushSubscription entities.
+type WebPushSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebPushSubscriptionMutation
+}
+
+// Where appends a list predicates to the WebPushSubscriptionUpdate builder.
+func (_u *WebPushSubscriptionUpdate) Where(ps ...predicate.WebPushSubscription) *WebPushSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebPushSubscriptionUpdate) SetUserID(v int64) *WebPushSubscriptionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdate) SetNillableUserID(v *int64) *WebPushSubscriptionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *WebPushSubscriptionUpdate) SetEndpoint(v string) *WebPushSubscriptionUpdate {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdate) SetNillableEndpoint(v *string) *WebPushSubscriptionUpdate {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// SetP256dh sets the "p256dh" field.
+func (_u *WebPushSubscriptionUpdate) SetP256dh(v string) *WebPushSubscriptionUpdate {
+	_u.mutation.SetP256dh(v)
+	return _u
+}
+
+// SetNillableP256dh sets the "p256dh" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdate) SetNillableP256dh(v *string) *WebPushSubscriptionUpdate {
+	if v != nil {
+		_u.SetP256dh(*v)
+	}
+	return _u
+}
+
+// SetAuth sets the "auth" field.
+func (_u *WebPushSubscriptionUpdate) SetAuth(v string) *WebPushSubscriptionUpdate {
+	_u.mutation.SetAuth(v)
+	return _u
+}
+
+// SetNillableAuth sets the "auth" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdate) SetNillableAuth(v *string) *WebPushSubscriptionUpdate {
+	if v != nil {
+		_u.SetAuth(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *WebPushSubscriptionUpdate) SetUserAgent(v string) *WebPushSubscriptionUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdate) SetNillableUserAgent(v *string) *WebPushSubscriptionUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *WebPushSubscriptionUpdate) ClearUserAgent() *WebPushSubscriptionUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebPushSubscriptionUpdate) SetUpdatedAt(v time.Time) *WebPushSubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WebPushSubscriptionUpdate) SetUser(v *User) *WebPushSubscriptionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WebPushSubscriptionMutation object of the builder.
+func (_u *WebPushSubscriptionUpdate) Mutation() *WebPushSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WebPushSubscriptionUpdate) ClearUser() *WebPushSubscriptionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebPushSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebPushSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebPushSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebPushSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebPushSubscriptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webpushsubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebPushSubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := webpushsubscription.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Endpoint(); ok {
+		if err := webpushsubscription.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.P256dh(); ok {
+		if err := webpushsubscription.P256dhValidator(v); err != nil {
+			return &ValidationError{Name: "p256dh", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.p256dh": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Auth(); ok {
+		if err := webpushsubscription.AuthValidator(v); err != nil {
+			return &ValidationError{Name: "auth", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.auth": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebPushSubscription.user"`)
+	}
+	return nil
+}
+
+func (_u *WebPushSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webpushsubscription.Table, webpushsubscription.Columns, sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(webpushsubscription.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.P256dh(); ok {
+		_spec.SetField(webpushsubscription.FieldP256dh, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Auth(); ok {
+		_spec.SetField(webpushsubscription.FieldAuth, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(webpushsubscription.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(webpushsubscription.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webpushsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webpushsubscription.UserTable,
+			Columns: []string{webpushsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webpushsubscription.UserTable,
+			Columns: []string{webpushsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webpushsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebPushSubscriptionUpdateOne is the builder for updating a single WebPushSubscription entity.
+type WebPushSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebPushSubscriptionMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebPushSubscriptionUpdateOne) SetUserID(v int64) *WebPushSubscriptionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdateOne) SetNillableUserID(v *int64) *WebPushSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *WebPushSubscriptionUpdateOne) SetEndpoint(v string) *WebPushSubscriptionUpdateOne {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdateOne) SetNillableEndpoint(v *string) *WebPushSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// SetP256dh sets the "p256dh" field.
+func (_u *WebPushSubscriptionUpdateOne) SetP256dh(v string) *WebPushSubscriptionUpdateOne {
+	_u.mutation.SetP256dh(v)
+	return _u
+}
+
+// SetNillableP256dh sets the "p256dh" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdateOne) SetNillableP256dh(v *string) *WebPushSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetP256dh(*v)
+	}
+	return _u
+}
+
+// SetAuth sets the "auth" field.
+func (_u *WebPushSubscriptionUpdateOne) SetAuth(v string) *WebPushSubscriptionUpdateOne {
+	_u.mutation.SetAuth(v)
+	return _u
+}
+
+// SetNillableAuth sets the "auth" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdateOne) SetNillableAuth(v *string) *WebPushSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetAuth(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *WebPushSubscriptionUpdateOne) SetUserAgent(v string) *WebPushSubscriptionUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *WebPushSubscriptionUpdateOne) SetNillableUserAgent(v *string) *WebPushSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *WebPushSubscriptionUpdateOne) ClearUserAgent() *WebPushSubscriptionUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebPushSubscriptionUpdateOne) SetUpdatedAt(v time.Time) *WebPushSubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WebPushSubscriptionUpdateOne) SetUser(v *User) *WebPushSubscriptionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WebPushSubscriptionMutation object of the builder.
+func (_u *WebPushSubscriptionUpdateOne) Mutation() *WebPushSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WebPushSubscriptionUpdateOne) ClearUser() *WebPushSubscriptionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the WebPushSubscriptionUpdate builder.
+func (_u *WebPushSubscriptionUpdateOne) Where(ps ...predicate.WebPushSubscription) *WebPushSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebPushSubscriptionUpdateOne) Select(field string, fields ...string) *WebPushSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebPushSubscription entity.
+func (_u *WebPushSubscriptionUpdateOne) Save(ctx context.Context) (*WebPushSubscription, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebPushSubscriptionUpdateOne) SaveX(ctx context.Context) *WebPushSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebPushSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebPushSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebPushSubscriptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webpushsubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebPushSubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := webpushsubscription.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Endpoint(); ok {
+		if err := webpushsubscription.EndpointValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.endpoint": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.P256dh(); ok {
+		if err := webpushsubscription.P256dhValidator(v); err != nil {
+			return &ValidationError{Name: "p256dh", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.p256dh": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Auth(); ok {
+		if err := webpushsubscription.AuthValidator(v); err != nil {
+			return &ValidationError{Name: "auth", err: fmt.Errorf(`ent: validator failed for field "WebPushSubscription.auth": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebPushSubscription.user"`)
+	}
+	return nil
+}
+
+func (_u *WebPushSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *WebPushSubscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webpushsubscription.Table, webpushsubscription.Columns, sqlgraph.NewFieldSpec(webpushsubscription.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebPushSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webpushsubscription.FieldID)
+		for _, f := range fields {
+			if !webpushsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webpushsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(webpushsubscription.FieldEndpoint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.P256dh(); ok {
+		_spec.SetField(webpushsubscription.FieldP256dh, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Auth(); ok {
+		_spec.SetField(webpushsubscription.FieldAuth, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(webpushsubscription.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(webpushsubscription.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webpushsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webpushsubscription.UserTable,
+			Columns: []string{webpushsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webpushsubscription.UserTable,
+			Columns: []string{webpushsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebPushSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webpushsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,6 +14,12 @@ var Module = fx.Module("database",
 		repository.NewStreamerRepository,
 		repository.NewNotificationChannelRepository,
 		repository.NewUserFollowedStreamerRepository,
+		repository.NewSystemSettingRepository,
+		repository.NewWebPushSubscriptionRepository,
+		repository.NewNotificationDeliveryRepository,
+		repository.NewNotificationPreferenceRepository,
+		repository.NewStreamSessionRepository,
+		repository.NewInboxMessageRepository,
 	),
 )
 
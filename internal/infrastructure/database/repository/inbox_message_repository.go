@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/inboxmessage"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type inboxMessageRepository struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewInboxMessageRepository(client *ent.Client, logger *zap.Logger) coreRepo.InboxMessageRepository {
+	return &inboxMessageRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *inboxMessageRepository) Create(ctx context.Context, message *domain.InboxMessage) (*domain.InboxMessage, error) {
+	created, err := r.client.InboxMessage.Create().
+		SetUserID(message.UserID).
+		SetChannelID(message.ChannelID).
+		SetNillableStreamerID(message.StreamerID).
+		SetEventType(string(message.EventType)).
+		SetSeverity(string(message.Severity)).
+		SetTitle(message.Title).
+		SetContent(message.Content).
+		SetURL(message.URL).
+		SetImageURL(message.ImageURL).
+		SetIconURL(message.IconURL).
+		SetNillableReadAt(message.ReadAt).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create inbox message",
+			zap.Error(err),
+			zap.Int64("user_id", message.UserID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "InboxMessage")
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *inboxMessageRepository) FindById(ctx context.Context, id int64) (*domain.InboxMessage, error) {
+	entity, err := r.client.InboxMessage.
+		Query().
+		Where(inboxmessage.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("InboxMessage").WithDetail("id", id)
+		}
+		r.logger.Error("failed to find inbox message by id", zap.Error(err), zap.Int64("id", id))
+		return nil, errors2.ConvertDatabaseError(err, "InboxMessage")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *inboxMessageRepository) ListByUserId(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*domain.InboxMessage, int, error) {
+	predicates := []predicate.InboxMessage{inboxmessage.UserIDEQ(userID)}
+	if unreadOnly {
+		predicates = append(predicates, inboxmessage.ReadAtIsNil())
+	}
+
+	total, err := r.client.InboxMessage.
+		Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count inbox messages", zap.Error(err), zap.Int64("user_id", userID))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	entities, err := r.client.InboxMessage.
+		Query().
+		Where(predicates...).
+		Offset(offset).
+		Limit(limit).
+		Order(
+			ent.Desc(inboxmessage.FieldCreatedAt),
+			ent.Desc(inboxmessage.FieldID),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list inbox messages", zap.Error(err), zap.Int64("user_id", userID))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.InboxMessage, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+	}
+	return results, total, nil
+}
+
+func (r *inboxMessageRepository) CountUnread(ctx context.Context, userID int64) (int, error) {
+	count, err := r.client.InboxMessage.
+		Query().
+		Where(
+			inboxmessage.UserIDEQ(userID),
+			inboxmessage.ReadAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count unread inbox messages", zap.Error(err), zap.Int64("user_id", userID))
+		return 0, errors2.DatabaseError(err)
+	}
+	return count, nil
+}
+
+func (r *inboxMessageRepository) MarkRead(ctx context.Context, id int64, at time.Time) (*domain.InboxMessage, error) {
+	if _, err := r.client.InboxMessage.
+		Update().
+		Where(
+			inboxmessage.ID(id),
+			inboxmessage.ReadAtIsNil(),
+		).
+		SetReadAt(at).
+		Save(ctx); err != nil {
+		r.logger.Error("failed to mark inbox message read", zap.Error(err), zap.Int64("id", id))
+		return nil, errors2.DatabaseError(err)
+	}
+	return r.FindById(ctx, id)
+}
+
+func (r *inboxMessageRepository) MarkAllRead(ctx context.Context, userID int64, at time.Time) (int, error) {
+	updated, err := r.client.InboxMessage.
+		Update().
+		Where(
+			inboxmessage.UserIDEQ(userID),
+			inboxmessage.ReadAtIsNil(),
+		).
+		SetReadAt(at).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to mark inbox messages read", zap.Error(err), zap.Int64("user_id", userID))
+		return 0, errors2.DatabaseError(err)
+	}
+	return updated, nil
+}
+
+func (r *inboxMessageRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := r.client.InboxMessage.
+		Delete().
+		Where(inboxmessage.CreatedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Error("failed to delete inbox messages", zap.Error(err), zap.Time("before", before))
+		return 0, errors2.DatabaseError(err)
+	}
+	return deleted, nil
+}
+
+func (r *inboxMessageRepository) toDomain(entity *ent.InboxMessage) *domain.InboxMessage {
+	return &domain.InboxMessage{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		ChannelID:  entity.ChannelID,
+		StreamerID: entity.StreamerID,
+		EventType:  domain.NotificationEventType(entity.EventType),
+		Severity:   domain.NotificationSeverity(entity.Severity),
+		Title:      entity.Title,
+		Content:    entity.Content,
+		URL:        entity.URL,
+		ImageURL:   entity.ImageURL,
+		IconURL:    entity.IconURL,
+		ReadAt:     entity.ReadAt,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
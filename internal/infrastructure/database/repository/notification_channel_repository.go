@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ryuyb/fusion/internal/core/domain"
+	coreExternal "github.com/ryuyb/fusion/internal/core/port/external"
 	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationchannel"
@@ -12,28 +14,56 @@ import (
 	"go.uber.org/zap"
 )
 
+const channelSecretRotationBatchSize = 100
+
+// notificationChannelRepository encrypts secret config fields on write and decrypts them on read,
+// so callers only ever see plaintext configs.
 type notificationChannelRepository struct {
 	client *ent.Client
+	cipher coreExternal.ChannelConfigCipher
 	logger *zap.Logger
 }
 
-func NewNotificationChannelRepository(client *ent.Client, logger *zap.Logger) coreRepo.NotificationChannelRepository {
+func NewNotificationChannelRepository(client *ent.Client, cipher coreExternal.ChannelConfigCipher, logger *zap.Logger) coreRepo.NotificationChannelRepository {
 	return &notificationChannelRepository{
 		client: client,
+		cipher: cipher,
 		logger: logger,
 	}
 }
 
 func (r *notificationChannelRepository) Create(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+	config, err := r.cipher.Encrypt(channel.ChannelType, channel.Config)
+	if err != nil {
+		return nil, err
+	}
 	builder := r.client.NotificationChannel.Create().
 		SetUserID(channel.UserID).
 		SetChannelType(string(channel.ChannelType)).
 		SetName(channel.Name).
-		SetEnable(channel.Enable).
-		SetPriority(channel.Priority)
+		SetPriority(channel.Priority).
+		SetDigestWindowSeconds(int64(channel.DigestWindow / time.Second))
+	setEnable(builder.Mutation(), channel.Enable)
 
-	if channel.Config != nil {
-		builder.SetConfig(channel.Config)
+	if config != nil {
+		builder.SetConfig(config)
+	}
+	if summary := channel.DailySummary; summary != nil {
+		builder.SetDailySummaryAt(summary.At).
+			SetDailySummaryTimezone(summary.Timezone).
+			SetNillableDailySummarySentAt(summary.LastSentAt)
+	}
+	if channel.Template.Title != "" {
+		builder.SetTitleTemplate(channel.Template.Title)
+	}
+	if channel.Template.Body != "" {
+		builder.SetBodyTemplate(channel.Template.Body)
+	}
+	if v := channel.Verification; v != nil {
+		builder.SetVerificationCodeHash(v.CodeHash).
+			SetVerificationExpiresAt(v.ExpiresAt).
+			SetVerificationAttempts(v.Attempts).
+			SetNillableVerificationSentAt(v.SentAt)
 	}
 
 	created, err := builder.Save(ctx)
@@ -49,17 +79,39 @@ func (r *notificationChannelRepository) Create(ctx context.Context, channel *dom
 }
 
 func (r *notificationChannelRepository) Update(ctx context.Context, channel *domain.NotificationChannel) (*domain.NotificationChannel, error) {
+	config, err := r.cipher.Encrypt(channel.ChannelType, channel.Config)
+	if err != nil {
+		return nil, err
+	}
 	builder := r.client.NotificationChannel.UpdateOneID(channel.ID).
 		SetChannelType(string(channel.ChannelType)).
 		SetName(channel.Name).
 		SetEnable(channel.Enable).
-		SetPriority(channel.Priority)
+		SetPriority(channel.Priority).
+		SetDigestWindowSeconds(int64(channel.DigestWindow / time.Second))
 
-	if channel.Config == nil {
+	// The send marker is owned by MarkDailySummarySent and kept across schedule changes.
+	if summary := channel.DailySummary; summary == nil {
+		builder.ClearDailySummaryAt().ClearDailySummaryTimezone()
+	} else {
+		builder.SetDailySummaryAt(summary.At).SetDailySummaryTimezone(summary.Timezone)
+	}
+	if config == nil {
 		builder.ClearConfig()
 	} else {
-		builder.SetConfig(channel.Config)
+		builder.SetConfig(config)
+	}
+	if channel.Template.Title == "" {
+		builder.ClearTitleTemplate()
+	} else {
+		builder.SetTitleTemplate(channel.Template.Title)
+	}
+	if channel.Template.Body == "" {
+		builder.ClearBodyTemplate()
+	} else {
+		builder.SetBodyTemplate(channel.Template.Body)
 	}
+	setVerification(builder.Mutation(), channel.Verification)
 
 	updated, err := builder.Save(ctx)
 	if err != nil {
@@ -102,6 +154,25 @@ func (r *notificationChannelRepository) FindById(ctx context.Context, id int64)
 	return r.toDomain(entity), nil
 }
 
+func (r *notificationChannelRepository) FindInboxByUserId(ctx context.Context, userID int64) (*domain.NotificationChannel, error) {
+	entity, err := r.client.NotificationChannel.
+		Query().
+		Where(
+			notificationchannel.UserIDEQ(userID),
+			notificationchannel.ChannelTypeEQ(string(domain.ChannelTypeInbox)),
+		).
+		Order(ent.Asc(notificationchannel.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("NotificationChannel").WithDetail("user_id", userID)
+		}
+		r.logger.Error("failed to find inbox notification channel", zap.Error(err), zap.Int64("user_id", userID))
+		return nil, errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return r.toDomain(entity), nil
+}
+
 func (r *notificationChannelRepository) ListByUserId(ctx context.Context, userID int64, offset, limit int) ([]*domain.NotificationChannel, int, error) {
 	total, err := r.client.NotificationChannel.
 		Query().
@@ -153,21 +224,270 @@ func (r *notificationChannelRepository) ExistByName(ctx context.Context, userID
 	return exist, nil
 }
 
+func (r *notificationChannelRepository) ListWithDailySummary(ctx context.Context, offset, limit int) ([]*domain.NotificationChannel, int, error) {
+	query := r.client.NotificationChannel.
+		Query().
+		Where(
+			notificationchannel.DailySummaryAtNotNil(),
+			notificationchannel.EnableEQ(true),
+		)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count notification channels with daily summary", zap.Error(err))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	entities, err := query.
+		Offset(offset).
+		Limit(limit).
+		Order(ent.Asc(notificationchannel.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list notification channels with daily summary", zap.Error(err))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.NotificationChannel, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+	}
+	return results, total, nil
+}
+
+func (r *notificationChannelRepository) MarkDailySummarySent(ctx context.Context, id int64, at time.Time) error {
+	if err := r.client.NotificationChannel.UpdateOneID(id).SetDailySummarySentAt(at).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errors2.NotFound("NotificationChannel").WithDetail("id", id)
+		}
+		r.logger.Error("failed to record notification channel daily summary", zap.Error(err), zap.Int64("id", id))
+		return errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return nil
+}
+
+// UpdateTestResult records the last test send without touching the rest of the channel.
+func (r *notificationChannelRepository) UpdateTestResult(ctx context.Context, id int64, result *domain.NotificationChannelTestResult) error {
+	builder := r.client.NotificationChannel.UpdateOneID(id).
+		SetLastTestedAt(result.TestedAt).
+		SetLastTestSuccess(result.Success)
+	if result.Error == "" {
+		builder.ClearLastTestError()
+	} else {
+		builder.SetLastTestError(result.Error)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errors2.NotFound("NotificationChannel").WithDetail("id", id)
+		}
+		r.logger.Error("failed to record notification channel test result", zap.Error(err), zap.Int64("id", id))
+		return errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return nil
+}
+
+// UpdateVerification records the verification handshake and the enable flag it controls without
+// touching the rest of the channel.
+func (r *notificationChannelRepository) UpdateVerification(ctx context.Context, id int64, enable bool, verification *domain.ChannelVerification) error {
+	builder := r.client.NotificationChannel.UpdateOneID(id)
+	setEnable(builder.Mutation(), enable)
+	setVerification(builder.Mutation(), verification)
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return errors2.NotFound("NotificationChannel").WithDetail("id", id)
+		}
+		r.logger.Error("failed to record notification channel verification", zap.Error(err), zap.Int64("id", id))
+		return errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return nil
+}
+
+// RecordSendSuccess ends the channel's failure streak.
+func (r *notificationChannelRepository) RecordSendSuccess(ctx context.Context, id int64, at time.Time) error {
+	err := r.client.NotificationChannel.UpdateOneID(id).
+		SetConsecutiveFailures(0).
+		SetLastSuccessAt(at).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors2.NotFound("NotificationChannel").WithDetail("id", id)
+		}
+		r.logger.Error("failed to record notification channel send success", zap.Error(err), zap.Int64("id", id))
+		return errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return nil
+}
+
+// RecordSendFailure extends the failure streak in place, so concurrent workers each count their
+// failure, and returns the channel as it is afterwards.
+func (r *notificationChannelRepository) RecordSendFailure(ctx context.Context, id int64, message string, at time.Time) (*domain.NotificationChannel, error) {
+	updated, err := r.client.NotificationChannel.UpdateOneID(id).
+		AddConsecutiveFailures(1).
+		SetLastError(message).
+		SetLastFailureAt(at).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("NotificationChannel").WithDetail("id", id)
+		}
+		r.logger.Error("failed to record notification channel send failure", zap.Error(err), zap.Int64("id", id))
+		return nil, errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return r.toDomain(updated), nil
+}
+
+// DisableUnhealthy turns an enabled channel off for failing. It reports false when the channel was
+// already off, so only one of several workers alerts the owner.
+func (r *notificationChannelRepository) DisableUnhealthy(ctx context.Context, id int64, at time.Time) (bool, error) {
+	affected, err := r.client.NotificationChannel.Update().
+		Where(notificationchannel.ID(id), notificationchannel.Enable(true)).
+		SetEnable(false).
+		SetDisabledAt(at).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to disable unhealthy notification channel", zap.Error(err), zap.Int64("id", id))
+		return false, errors2.ConvertDatabaseError(err, "NotificationChannel")
+	}
+	return affected > 0, nil
+}
+
+// setEnable turns the channel on or off. Saving it as enabled, whether by the user or a confirmed
+// verification, starts its failure streak over so a channel just turned back on or fixed is not
+// disabled again by its next failure.
+func setEnable(m *ent.NotificationChannelMutation, enable bool) {
+	m.SetEnable(enable)
+	if enable {
+		m.SetConsecutiveFailures(0)
+		m.ClearDisabledAt()
+	}
+}
+
+func setVerification(m *ent.NotificationChannelMutation, v *domain.ChannelVerification) {
+	if v == nil {
+		m.ClearVerificationCodeHash()
+		m.ClearVerificationExpiresAt()
+		m.ClearVerificationSentAt()
+		m.SetVerificationAttempts(0)
+		return
+	}
+	m.SetVerificationCodeHash(v.CodeHash)
+	m.SetVerificationExpiresAt(v.ExpiresAt)
+	m.SetVerificationAttempts(v.Attempts)
+	if v.SentAt == nil {
+		m.ClearVerificationSentAt()
+	} else {
+		m.SetVerificationSentAt(*v.SentAt)
+	}
+}
+
+// RotateSecrets re-encrypts configs that still hold plaintext secrets or secrets sealed with a retired key.
+func (r *notificationChannelRepository) RotateSecrets(ctx context.Context) (int, error) {
+	var (
+		rotated int
+		lastID  int64
+	)
+	for {
+		entities, err := r.client.NotificationChannel.
+			Query().
+			Where(notificationchannel.IDGT(lastID)).
+			Order(ent.Asc(notificationchannel.FieldID)).
+			Limit(channelSecretRotationBatchSize).
+			All(ctx)
+		if err != nil {
+			r.logger.Error("failed to list notification channels for secret rotation", zap.Error(err))
+			return rotated, errors2.DatabaseError(err)
+		}
+
+		for _, entity := range entities {
+			channelType := domain.NotificationChannelType(entity.ChannelType)
+			if !r.cipher.NeedsRotation(channelType, entity.Config) {
+				continue
+			}
+			config, err := r.cipher.Decrypt(channelType, entity.Config)
+			if err != nil {
+				r.logger.Error("skipping notification channel with unreadable secrets",
+					zap.Int64("id", entity.ID), zap.Error(err))
+				continue
+			}
+			if config, err = r.cipher.Encrypt(channelType, config); err != nil {
+				return rotated, err
+			}
+			if err := r.client.NotificationChannel.UpdateOneID(entity.ID).SetConfig(config).Exec(ctx); err != nil {
+				r.logger.Error("failed to rotate notification channel secrets", zap.Int64("id", entity.ID), zap.Error(err))
+				return rotated, errors2.ConvertDatabaseError(err, "NotificationChannel")
+			}
+			rotated++
+		}
+
+		if len(entities) < channelSecretRotationBatchSize {
+			return rotated, nil
+		}
+		lastID = entities[len(entities)-1].ID
+	}
+}
+
 func (r *notificationChannelRepository) toDomain(entity *ent.NotificationChannel) *domain.NotificationChannel {
-	var config map[string]any
-	if entity.Config != nil {
-		config = lo.Assign(map[string]any{}, entity.Config)
+	channelType := domain.NotificationChannelType(entity.ChannelType)
+	config, err := r.cipher.Decrypt(channelType, entity.Config)
+	if err != nil {
+		// Keep the channel usable for listing and editing; sends fail on the missing secret.
+		r.logger.Error("failed to decrypt notification channel secrets",
+			zap.Int64("id", entity.ID), zap.Error(err))
+	}
+
+	var lastTest *domain.NotificationChannelTestResult
+	if entity.LastTestedAt != nil {
+		lastTest = &domain.NotificationChannelTestResult{
+			Success:  lo.FromPtr(entity.LastTestSuccess),
+			Error:    lo.FromPtr(entity.LastTestError),
+			TestedAt: *entity.LastTestedAt,
+		}
+	}
+
+	var dailySummary *domain.DailySummarySchedule
+	if entity.DailySummaryAt != nil {
+		dailySummary = &domain.DailySummarySchedule{
+			At:         *entity.DailySummaryAt,
+			Timezone:   lo.FromPtr(entity.DailySummaryTimezone),
+			LastSentAt: entity.DailySummarySentAt,
+		}
+	}
+
+	var verification *domain.ChannelVerification
+	if entity.VerificationCodeHash != nil {
+		verification = &domain.ChannelVerification{
+			CodeHash:  *entity.VerificationCodeHash,
+			ExpiresAt: lo.FromPtr(entity.VerificationExpiresAt),
+			Attempts:  entity.VerificationAttempts,
+			SentAt:    entity.VerificationSentAt,
+		}
 	}
 
 	return &domain.NotificationChannel{
 		ID:          entity.ID,
 		UserID:      entity.UserID,
-		ChannelType: domain.NotificationChannelType(entity.ChannelType),
+		ChannelType: channelType,
 		Name:        entity.Name,
 		Config:      config,
 		Enable:      entity.Enable,
 		Priority:    entity.Priority,
-		CreatedAt:   entity.CreatedAt,
-		UpdatedAt:   entity.UpdatedAt,
+		Template: domain.NotificationTemplate{
+			Title: lo.FromPtr(entity.TitleTemplate),
+			Body:  lo.FromPtr(entity.BodyTemplate),
+		},
+		DigestWindow: time.Duration(entity.DigestWindowSeconds) * time.Second,
+		DailySummary: dailySummary,
+		LastTest:     lastTest,
+		Verification: verification,
+		Health: domain.ChannelHealth{
+			ConsecutiveFailures: entity.ConsecutiveFailures,
+			LastError:           lo.FromPtr(entity.LastError),
+			LastFailureAt:       entity.LastFailureAt,
+			LastSuccessAt:       entity.LastSuccessAt,
+			DisabledAt:          entity.DisabledAt,
+		},
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
 	}
 }
@@ -0,0 +1,579 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationdelivery"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/predicate"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type notificationDeliveryRepository struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewNotificationDeliveryRepository(client *ent.Client, logger *zap.Logger) coreRepo.NotificationDeliveryRepository {
+	return &notificationDeliveryRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *notificationDeliveryRepository) Create(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	created, err := r.createBuilder(r.client, delivery).Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create notification delivery",
+			zap.Error(err),
+			zap.Int64("user_id", delivery.UserID),
+			zap.Int64("channel_id", delivery.ChannelID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "NotificationDelivery")
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *notificationDeliveryRepository) CreateBatch(ctx context.Context, deliveries []*domain.NotificationDelivery) ([]*domain.NotificationDelivery, error) {
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Error("failed to start notification delivery batch transaction", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	builders := make([]*ent.NotificationDeliveryCreate, len(deliveries))
+	for i, delivery := range deliveries {
+		builders[i] = r.createBuilder(tx.Client(), delivery)
+	}
+	created, err := tx.NotificationDelivery.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Error("failed to create notification deliveries",
+			zap.Error(err),
+			zap.Int64("user_id", deliveries[0].UserID),
+			zap.Int("count", len(deliveries)),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "NotificationDelivery")
+	}
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit notification deliveries", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.NotificationDelivery, len(created))
+	for i, entity := range created {
+		results[i] = r.toDomain(entity)
+	}
+	return results, nil
+}
+
+func (r *notificationDeliveryRepository) createBuilder(client *ent.Client, delivery *domain.NotificationDelivery) *ent.NotificationDeliveryCreate {
+	builder := client.NotificationDelivery.Create().
+		SetUserID(delivery.UserID).
+		SetNillableFollowID(delivery.FollowID).
+		SetChannelID(delivery.ChannelID).
+		SetChannelType(string(delivery.ChannelType)).
+		SetNillableStreamerID(delivery.StreamerID).
+		SetEventType(string(delivery.EventType)).
+		SetStatus(string(delivery.Status)).
+		SetAttempts(delivery.Attempts).
+		SetLatencyMs(delivery.Latency.Milliseconds()).
+		SetNillableNextAttemptAt(delivery.NextAttemptAt).
+		SetNillableLockedUntil(delivery.LockedUntil).
+		SetNillableDeliveredAt(delivery.DeliveredAt).
+		SetNillableDigestID(delivery.DigestID).
+		SetNillableAcknowledgedAt(delivery.AcknowledgedAt)
+
+	if delivery.Payload != nil {
+		builder.SetPayload(delivery.Payload)
+	}
+	if delivery.Response != nil {
+		builder.SetResponse(delivery.Response)
+	}
+	if delivery.Error != "" {
+		builder.SetError(delivery.Error)
+	}
+	if route := delivery.Route; route != nil {
+		builder.SetRouteID(route.ID).
+			SetRouteMode(string(route.Mode)).
+			SetRouteStep(route.Step).
+			SetEscalateAfterSeconds(int64(route.EscalateAfter / time.Second))
+	}
+	return builder
+}
+
+func (r *notificationDeliveryRepository) Update(ctx context.Context, delivery *domain.NotificationDelivery) (*domain.NotificationDelivery, error) {
+	builder := r.client.NotificationDelivery.UpdateOneID(delivery.ID).
+		SetStatus(string(delivery.Status)).
+		SetAttempts(delivery.Attempts).
+		SetLatencyMs(delivery.Latency.Milliseconds())
+
+	if delivery.Payload == nil {
+		builder.ClearPayload()
+	} else {
+		builder.SetPayload(delivery.Payload)
+	}
+	if delivery.Response == nil {
+		builder.ClearResponse()
+	} else {
+		builder.SetResponse(delivery.Response)
+	}
+	if delivery.Error == "" {
+		builder.ClearError()
+	} else {
+		builder.SetError(delivery.Error)
+	}
+	if delivery.NextAttemptAt == nil {
+		builder.ClearNextAttemptAt()
+	} else {
+		builder.SetNextAttemptAt(*delivery.NextAttemptAt)
+	}
+	if delivery.LockedUntil == nil {
+		builder.ClearLockedUntil()
+	} else {
+		builder.SetLockedUntil(*delivery.LockedUntil)
+	}
+	if delivery.DeliveredAt == nil {
+		builder.ClearDeliveredAt()
+	} else {
+		builder.SetDeliveredAt(*delivery.DeliveredAt)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("NotificationDelivery").WithDetail("id", delivery.ID)
+		}
+		r.logger.Error("failed to update notification delivery",
+			zap.Error(err),
+			zap.Int64("id", delivery.ID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "NotificationDelivery")
+	}
+	return r.toDomain(updated), nil
+}
+
+func (r *notificationDeliveryRepository) FindById(ctx context.Context, id int64) (*domain.NotificationDelivery, error) {
+	entity, err := r.client.NotificationDelivery.
+		Query().
+		Where(notificationdelivery.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("NotificationDelivery").WithDetail("id", id)
+		}
+		r.logger.Error("failed to find notification delivery by id", zap.Error(err), zap.Int64("id", id))
+		return nil, errors2.ConvertDatabaseError(err, "NotificationDelivery")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *notificationDeliveryRepository) ListByUserId(ctx context.Context, userID int64, filter *domain.NotificationDeliveryFilter, offset, limit int) ([]*domain.NotificationDelivery, int, error) {
+	scoped := domain.NotificationDeliveryFilter{}
+	if filter != nil {
+		scoped = *filter
+	}
+	scoped.UserID = userID
+	return r.List(ctx, &scoped, offset, limit)
+}
+
+func (r *notificationDeliveryRepository) List(ctx context.Context, filter *domain.NotificationDeliveryFilter, offset, limit int) ([]*domain.NotificationDelivery, int, error) {
+	predicates := deliveryFilterPredicates(filter)
+
+	total, err := r.client.NotificationDelivery.
+		Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count notification deliveries", zap.Error(err))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	entities, err := r.client.NotificationDelivery.
+		Query().
+		Where(predicates...).
+		Offset(offset).
+		Limit(limit).
+		Order(
+			ent.Desc(notificationdelivery.FieldCreatedAt),
+			ent.Desc(notificationdelivery.FieldID),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list notification deliveries", zap.Error(err))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.NotificationDelivery, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+	}
+	return results, total, nil
+}
+
+func (r *notificationDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*domain.NotificationDelivery, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Error("failed to start claim transaction", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	entities, err := tx.NotificationDelivery.
+		Query().
+		Where(notificationdelivery.Or(
+			notificationdelivery.And(
+				notificationdelivery.StatusIn(string(domain.DeliveryStatusPending), string(domain.DeliveryStatusRetrying)),
+				notificationdelivery.NextAttemptAtLTE(now),
+			),
+			// A worker died while holding these; hand them to someone else.
+			notificationdelivery.And(
+				notificationdelivery.StatusEQ(string(domain.DeliveryStatusProcessing)),
+				notificationdelivery.LockedUntilLT(now),
+			),
+		)).
+		Order(
+			ent.Asc(notificationdelivery.FieldNextAttemptAt),
+			ent.Asc(notificationdelivery.FieldID),
+		).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Error("failed to claim notification deliveries", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+	if len(entities) == 0 {
+		return nil, tx.Commit()
+	}
+
+	ids := make([]int64, len(entities))
+	for i, entity := range entities {
+		ids[i] = entity.ID
+	}
+	lockedUntil := now.Add(lease)
+	if _, err := tx.NotificationDelivery.
+		Update().
+		Where(notificationdelivery.IDIn(ids...)).
+		SetStatus(string(domain.DeliveryStatusProcessing)).
+		SetLockedUntil(lockedUntil).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		r.logger.Error("failed to lock notification deliveries", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit claimed notification deliveries", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.NotificationDelivery, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+		results[i].Status = domain.DeliveryStatusProcessing
+		results[i].LockedUntil = &lockedUntil
+	}
+	return results, nil
+}
+
+func (r *notificationDeliveryRepository) RequeueDead(ctx context.Context, filter *domain.NotificationDeliveryFilter, now time.Time) (int, error) {
+	scoped := domain.NotificationDeliveryFilter{}
+	if filter != nil {
+		scoped = *filter
+	}
+	scoped.Status = domain.DeliveryStatusDead
+
+	updated, err := r.client.NotificationDelivery.
+		Update().
+		Where(deliveryFilterPredicates(&scoped)...).
+		SetStatus(string(domain.DeliveryStatusPending)).
+		SetAttempts(0).
+		SetNextAttemptAt(now).
+		ClearLockedUntil().
+		ClearError().
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to requeue dead notification deliveries", zap.Error(err))
+		return 0, errors2.DatabaseError(err)
+	}
+	return updated, nil
+}
+
+func (r *notificationDeliveryRepository) Acknowledge(ctx context.Context, id int64, at time.Time) (*domain.NotificationDelivery, error) {
+	if _, err := r.client.NotificationDelivery.
+		Update().
+		Where(
+			notificationdelivery.ID(id),
+			notificationdelivery.AcknowledgedAtIsNil(),
+		).
+		SetAcknowledgedAt(at).
+		Save(ctx); err != nil {
+		r.logger.Error("failed to acknowledge notification delivery", zap.Error(err), zap.Int64("id", id))
+		return nil, errors2.DatabaseError(err)
+	}
+	return r.FindById(ctx, id)
+}
+
+func (r *notificationDeliveryRepository) PromoteRouteStep(ctx context.Context, routeID string, step int, at time.Time) (int, error) {
+	updated, err := r.client.NotificationDelivery.
+		Update().
+		Where(
+			notificationdelivery.RouteIDEQ(routeID),
+			notificationdelivery.RouteStepEQ(step),
+			notificationdelivery.Or(
+				notificationdelivery.StatusEQ(string(domain.DeliveryStatusHeld)),
+				notificationdelivery.And(
+					notificationdelivery.StatusEQ(string(domain.DeliveryStatusPending)),
+					notificationdelivery.AttemptsEQ(0),
+					notificationdelivery.NextAttemptAtGT(at),
+				),
+			),
+		).
+		SetStatus(string(domain.DeliveryStatusPending)).
+		SetNextAttemptAt(at).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to promote notification route step",
+			zap.Error(err),
+			zap.String("route_id", routeID),
+			zap.Int("step", step),
+		)
+		return 0, errors2.DatabaseError(err)
+	}
+	return updated, nil
+}
+
+func (r *notificationDeliveryRepository) SkipRoute(ctx context.Context, routeID string, afterStep int) (int, error) {
+	updated, err := r.client.NotificationDelivery.
+		Update().
+		Where(
+			notificationdelivery.RouteIDEQ(routeID),
+			notificationdelivery.RouteStepGT(afterStep),
+			notificationdelivery.StatusIn(string(domain.DeliveryStatusHeld), string(domain.DeliveryStatusPending)),
+			notificationdelivery.AttemptsEQ(0),
+		).
+		SetStatus(string(domain.DeliveryStatusSkipped)).
+		ClearNextAttemptAt().
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to skip notification route",
+			zap.Error(err),
+			zap.String("route_id", routeID),
+			zap.Int("after_step", afterStep),
+		)
+		return 0, errors2.DatabaseError(err)
+	}
+	return updated, nil
+}
+
+func (r *notificationDeliveryRepository) ListBatched(ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	return r.listByStatusPerChannel(ctx, domain.DeliveryStatusBatched)
+}
+
+func (r *notificationDeliveryRepository) ListThrottled(ctx context.Context) ([]*domain.NotificationDelivery, error) {
+	return r.listByStatusPerChannel(ctx, domain.DeliveryStatusThrottled)
+}
+
+func (r *notificationDeliveryRepository) listByStatusPerChannel(ctx context.Context, status domain.NotificationDeliveryStatus) ([]*domain.NotificationDelivery, error) {
+	entities, err := r.client.NotificationDelivery.
+		Query().
+		Where(notificationdelivery.StatusEQ(string(status))).
+		Order(
+			ent.Asc(notificationdelivery.FieldChannelID),
+			ent.Asc(notificationdelivery.FieldID),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list notification deliveries by status", zap.Error(err), zap.String("status", string(status)))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.NotificationDelivery, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+	}
+	return results, nil
+}
+
+func (r *notificationDeliveryRepository) CreateDigest(ctx context.Context, digest *domain.NotificationDelivery, batchedIDs []int64) (*domain.NotificationDelivery, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		r.logger.Error("failed to start notification digest transaction", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	created, err := r.createBuilder(tx.Client(), digest).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		r.logger.Error("failed to create notification digest",
+			zap.Error(err),
+			zap.Int64("channel_id", digest.ChannelID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "NotificationDelivery")
+	}
+	if _, err := tx.NotificationDelivery.
+		Update().
+		Where(
+			notificationdelivery.IDIn(batchedIDs...),
+			notificationdelivery.StatusIn(string(domain.DeliveryStatusBatched), string(domain.DeliveryStatusThrottled)),
+		).
+		SetStatus(string(domain.DeliveryStatusDigested)).
+		SetDigestID(created.ID).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		r.logger.Error("failed to mark notification deliveries digested",
+			zap.Error(err),
+			zap.Int64("digest_id", created.ID),
+		)
+		return nil, errors2.DatabaseError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit notification digest", zap.Error(err))
+		return nil, errors2.DatabaseError(err)
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *notificationDeliveryRepository) CountSentByChannelSince(ctx context.Context, channelID int64, since time.Time) (int, error) {
+	count, err := r.client.NotificationDelivery.
+		Query().
+		Where(
+			notificationdelivery.ChannelIDEQ(channelID),
+			notificationdelivery.CreatedAtGTE(since),
+			notSentOnTheirOwn(),
+		).
+		Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count notification deliveries by channel", zap.Error(err), zap.Int64("channel_id", channelID))
+		return 0, errors2.DatabaseError(err)
+	}
+	return count, nil
+}
+
+func (r *notificationDeliveryRepository) CountSentByUserSince(ctx context.Context, userID int64, since time.Time) (int, error) {
+	count, err := r.client.NotificationDelivery.
+		Query().
+		Where(
+			notificationdelivery.UserIDEQ(userID),
+			notificationdelivery.ChannelTypeNEQ(string(domain.ChannelTypeInbox)),
+			notificationdelivery.CreatedAtGTE(since),
+			notSentOnTheirOwn(),
+		).
+		Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count notification deliveries by user", zap.Error(err), zap.Int64("user_id", userID))
+		return 0, errors2.DatabaseError(err)
+	}
+	return count, nil
+}
+
+// notSentOnTheirOwn leaves out deliveries that never reach a provider by themselves, and route steps
+// held back until an earlier step fails, which may never go out.
+func notSentOnTheirOwn() predicate.NotificationDelivery {
+	return notificationdelivery.StatusNotIn(
+		string(domain.DeliveryStatusHeld),
+		string(domain.DeliveryStatusBatched),
+		string(domain.DeliveryStatusThrottled),
+		string(domain.DeliveryStatusDigested),
+		string(domain.DeliveryStatusSkipped),
+	)
+}
+
+func (r *notificationDeliveryRepository) DeleteCreatedBefore(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := r.client.NotificationDelivery.
+		Delete().
+		Where(notificationdelivery.CreatedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Error("failed to delete notification deliveries", zap.Error(err), zap.Time("before", before))
+		return 0, errors2.DatabaseError(err)
+	}
+	return deleted, nil
+}
+
+func deliveryFilterPredicates(filter *domain.NotificationDeliveryFilter) []predicate.NotificationDelivery {
+	if filter == nil {
+		return nil
+	}
+	var predicates []predicate.NotificationDelivery
+	if filter.UserID > 0 {
+		predicates = append(predicates, notificationdelivery.UserIDEQ(filter.UserID))
+	}
+	if filter.Status != "" {
+		predicates = append(predicates, notificationdelivery.StatusEQ(string(filter.Status)))
+	}
+	if filter.ChannelID > 0 {
+		predicates = append(predicates, notificationdelivery.ChannelIDEQ(filter.ChannelID))
+	}
+	if filter.StreamerID > 0 {
+		predicates = append(predicates, notificationdelivery.StreamerIDEQ(filter.StreamerID))
+	}
+	if filter.FollowID > 0 {
+		predicates = append(predicates, notificationdelivery.FollowIDEQ(filter.FollowID))
+	}
+	if filter.EventType != "" {
+		predicates = append(predicates, notificationdelivery.EventTypeEQ(string(filter.EventType)))
+	}
+	if filter.RouteID != "" {
+		predicates = append(predicates, notificationdelivery.RouteIDEQ(filter.RouteID))
+	}
+	if !filter.From.IsZero() {
+		predicates = append(predicates, notificationdelivery.CreatedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, notificationdelivery.CreatedAtLT(filter.To))
+	}
+	return predicates
+}
+
+func (r *notificationDeliveryRepository) toDomain(entity *ent.NotificationDelivery) *domain.NotificationDelivery {
+	var payload, response map[string]any
+	if entity.Payload != nil {
+		payload = lo.Assign(map[string]any{}, entity.Payload)
+	}
+	if entity.Response != nil {
+		response = lo.Assign(map[string]any{}, entity.Response)
+	}
+	var route *domain.NotificationDeliveryRoute
+	if entity.RouteID != nil {
+		route = &domain.NotificationDeliveryRoute{
+			ID:            *entity.RouteID,
+			Mode:          domain.NotificationRoutingMode(lo.FromPtr(entity.RouteMode)),
+			Step:          entity.RouteStep,
+			EscalateAfter: time.Duration(entity.EscalateAfterSeconds) * time.Second,
+		}
+	}
+
+	return &domain.NotificationDelivery{
+		ID:             entity.ID,
+		UserID:         entity.UserID,
+		FollowID:       entity.FollowID,
+		ChannelID:      entity.ChannelID,
+		ChannelType:    domain.NotificationChannelType(entity.ChannelType),
+		StreamerID:     entity.StreamerID,
+		EventType:      domain.NotificationEventType(entity.EventType),
+		Payload:        payload,
+		Response:       response,
+		Status:         domain.NotificationDeliveryStatus(entity.Status),
+		Attempts:       entity.Attempts,
+		Latency:        time.Duration(entity.LatencyMs) * time.Millisecond,
+		Error:          lo.FromPtr(entity.Error),
+		NextAttemptAt:  entity.NextAttemptAt,
+		LockedUntil:    entity.LockedUntil,
+		DeliveredAt:    entity.DeliveredAt,
+		Route:          route,
+		DigestID:       entity.DigestID,
+		AcknowledgedAt: entity.AcknowledgedAt,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/notificationpreference"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type notificationPreferenceRepository struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewNotificationPreferenceRepository(client *ent.Client, logger *zap.Logger) coreRepo.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *notificationPreferenceRepository) Create(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+	quietHours, err := quietHoursToMap(preference.QuietHours)
+	if err != nil {
+		return nil, err
+	}
+	builder := r.client.NotificationPreference.Create().
+		SetUserID(preference.UserID).
+		SetRoutingMode(string(preference.Routing.Mode)).
+		SetEscalateAfterSeconds(int64(preference.Routing.EscalateAfter / time.Second)).
+		SetNillableDoNotDisturbUntil(preference.DoNotDisturbUntil)
+	if quietHours != nil {
+		builder.SetQuietHours(quietHours)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create notification preference",
+			zap.Error(err),
+			zap.Int64("user_id", preference.UserID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "NotificationPreference")
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *notificationPreferenceRepository) Update(ctx context.Context, preference *domain.NotificationPreference) (*domain.NotificationPreference, error) {
+	quietHours, err := quietHoursToMap(preference.QuietHours)
+	if err != nil {
+		return nil, err
+	}
+	builder := r.client.NotificationPreference.UpdateOneID(preference.ID).
+		SetRoutingMode(string(preference.Routing.Mode)).
+		SetEscalateAfterSeconds(int64(preference.Routing.EscalateAfter / time.Second))
+	if quietHours == nil {
+		builder.ClearQuietHours()
+	} else {
+		builder.SetQuietHours(quietHours)
+	}
+	if preference.DoNotDisturbUntil == nil {
+		builder.ClearDoNotDisturbUntil()
+	} else {
+		builder.SetDoNotDisturbUntil(*preference.DoNotDisturbUntil)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("NotificationPreference").WithDetail("id", preference.ID)
+		}
+		r.logger.Error("failed to update notification preference",
+			zap.Error(err),
+			zap.Int64("id", preference.ID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "NotificationPreference")
+	}
+	return r.toDomain(updated), nil
+}
+
+func (r *notificationPreferenceRepository) FindByUserId(ctx context.Context, userID int64) (*domain.NotificationPreference, error) {
+	entity, err := r.client.NotificationPreference.
+		Query().
+		Where(notificationpreference.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("NotificationPreference").WithDetail("user_id", userID)
+		}
+		r.logger.Error("failed to find notification preference by user id", zap.Error(err), zap.Int64("user_id", userID))
+		return nil, errors2.ConvertDatabaseError(err, "NotificationPreference")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *notificationPreferenceRepository) toDomain(entity *ent.NotificationPreference) *domain.NotificationPreference {
+	quietHours, err := quietHoursFromMap(entity.QuietHours)
+	if err != nil {
+		r.logger.Error("failed to decode quiet hours, ignoring them",
+			zap.Error(err),
+			zap.Int64("user_id", entity.UserID),
+		)
+	}
+	return &domain.NotificationPreference{
+		ID:     entity.ID,
+		UserID: entity.UserID,
+		Routing: domain.NotificationRouting{
+			Mode:          domain.NotificationRoutingMode(entity.RoutingMode),
+			EscalateAfter: time.Duration(entity.EscalateAfterSeconds) * time.Second,
+		},
+		QuietHours:        quietHours,
+		DoNotDisturbUntil: entity.DoNotDisturbUntil,
+		CreatedAt:         entity.CreatedAt,
+		UpdatedAt:         entity.UpdatedAt,
+	}
+}
+
+func quietHoursToMap(quietHours *domain.QuietHours) (map[string]any, error) {
+	if quietHours == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(quietHours)
+	if err != nil {
+		return nil, errors2.Internal(err)
+	}
+	var result map[string]any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, errors2.Internal(err)
+	}
+	return result, nil
+}
+
+func quietHoursFromMap(data map[string]any) (*domain.QuietHours, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var quietHours domain.QuietHours
+	if err := json.Unmarshal(raw, &quietHours); err != nil {
+		return nil, err
+	}
+	return &quietHours, nil
+}
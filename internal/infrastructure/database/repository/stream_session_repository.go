@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/streamsession"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type streamSessionRepository struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewStreamSessionRepository(client *ent.Client, logger *zap.Logger) coreRepo.StreamSessionRepository {
+	return &streamSessionRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *streamSessionRepository) Create(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error) {
+	builder := r.client.StreamSession.Create().
+		SetStreamerID(session.StreamerID).
+		SetPeakViewers(session.PeakViewers).
+		SetStartedAt(session.StartedAt).
+		SetLastSeenAt(session.LastSeenAt).
+		SetNillableEndedAt(session.EndedAt)
+	if session.Title != "" {
+		builder.SetTitle(session.Title)
+	}
+	if len(session.Titles) > 0 {
+		builder.SetTitles(session.Titles)
+	}
+	if len(session.Categories) > 0 {
+		builder.SetCategories(session.Categories)
+	}
+	if session.GameName != "" {
+		builder.SetGameName(session.GameName)
+	}
+	if session.AnnouncedTitle != "" {
+		builder.SetAnnouncedTitle(session.AnnouncedTitle)
+	}
+	if session.AnnouncedGameName != "" {
+		builder.SetAnnouncedGameName(session.AnnouncedGameName)
+	}
+	builder.SetNillableChangedAt(session.ChangedAt)
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create stream session",
+			zap.Error(err),
+			zap.Int64("streamer_id", session.StreamerID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "StreamSession")
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *streamSessionRepository) Update(ctx context.Context, session *domain.StreamSession) (*domain.StreamSession, error) {
+	builder := r.client.StreamSession.UpdateOneID(session.ID).
+		SetPeakViewers(session.PeakViewers).
+		SetStartedAt(session.StartedAt).
+		SetLastSeenAt(session.LastSeenAt)
+	if session.Title == "" {
+		builder.ClearTitle()
+	} else {
+		builder.SetTitle(session.Title)
+	}
+	if session.GameName == "" {
+		builder.ClearGameName()
+	} else {
+		builder.SetGameName(session.GameName)
+	}
+	if len(session.Titles) == 0 {
+		builder.ClearTitles()
+	} else {
+		builder.SetTitles(session.Titles)
+	}
+	if len(session.Categories) == 0 {
+		builder.ClearCategories()
+	} else {
+		builder.SetCategories(session.Categories)
+	}
+	if session.AnnouncedTitle == "" {
+		builder.ClearAnnouncedTitle()
+	} else {
+		builder.SetAnnouncedTitle(session.AnnouncedTitle)
+	}
+	if session.AnnouncedGameName == "" {
+		builder.ClearAnnouncedGameName()
+	} else {
+		builder.SetAnnouncedGameName(session.AnnouncedGameName)
+	}
+	if session.ChangedAt == nil {
+		builder.ClearChangedAt()
+	} else {
+		builder.SetChangedAt(*session.ChangedAt)
+	}
+	if session.EndedAt == nil {
+		builder.ClearEndedAt()
+	} else {
+		builder.SetEndedAt(*session.EndedAt)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("StreamSession").WithDetail("id", session.ID)
+		}
+		r.logger.Error("failed to update stream session",
+			zap.Error(err),
+			zap.Int64("id", session.ID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "StreamSession")
+	}
+	return r.toDomain(updated), nil
+}
+
+func (r *streamSessionRepository) FindOpenByStreamerId(ctx context.Context, streamerID int64) (*domain.StreamSession, error) {
+	entity, err := r.client.StreamSession.
+		Query().
+		Where(
+			streamsession.StreamerIDEQ(streamerID),
+			streamsession.EndedAtIsNil(),
+		).
+		Order(ent.Desc(streamsession.FieldStartedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("StreamSession").WithDetail("streamer_id", streamerID)
+		}
+		r.logger.Error("failed to find open stream session", zap.Error(err), zap.Int64("streamer_id", streamerID))
+		return nil, errors2.ConvertDatabaseError(err, "StreamSession")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *streamSessionRepository) ListByStreamerIds(ctx context.Context, streamerIDs []int64, from, to time.Time) ([]*domain.StreamSession, error) {
+	if len(streamerIDs) == 0 {
+		return nil, nil
+	}
+	entities, err := r.client.StreamSession.
+		Query().
+		Where(
+			streamsession.StreamerIDIn(streamerIDs...),
+			streamsession.StartedAtLT(to),
+			streamsession.Or(
+				streamsession.EndedAtIsNil(),
+				streamsession.EndedAtGT(from),
+			),
+		).
+		Order(ent.Asc(streamsession.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list stream sessions", zap.Error(err), zap.Int("streamers", len(streamerIDs)))
+		return nil, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.StreamSession, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+	}
+	return results, nil
+}
+
+func (r *streamSessionRepository) toDomain(entity *ent.StreamSession) *domain.StreamSession {
+	return &domain.StreamSession{
+		ID:                entity.ID,
+		StreamerID:        entity.StreamerID,
+		Title:             lo.FromPtr(entity.Title),
+		GameName:          lo.FromPtr(entity.GameName),
+		Titles:            entity.Titles,
+		Categories:        entity.Categories,
+		PeakViewers:       entity.PeakViewers,
+		AnnouncedTitle:    lo.FromPtr(entity.AnnouncedTitle),
+		AnnouncedGameName: lo.FromPtr(entity.AnnouncedGameName),
+		ChangedAt:         entity.ChangedAt,
+		StartedAt:         entity.StartedAt,
+		LastSeenAt:        entity.LastSeenAt,
+		EndedAt:           entity.EndedAt,
+		CreatedAt:         entity.CreatedAt,
+		UpdatedAt:         entity.UpdatedAt,
+	}
+}
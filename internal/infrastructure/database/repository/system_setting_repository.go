@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/systemsetting"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type systemSettingRepository struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewSystemSettingRepository(client *ent.Client, logger *zap.Logger) coreRepo.SystemSettingRepository {
+	return &systemSettingRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *systemSettingRepository) Create(ctx context.Context, setting *domain.SystemSetting) (*domain.SystemSetting, error) {
+	created, err := r.client.SystemSetting.Create().
+		SetKey(setting.Key).
+		SetValue(setting.Value).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create system setting", zap.Error(err), zap.String("key", setting.Key))
+		return nil, errors2.ConvertDatabaseError(err, "SystemSetting")
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *systemSettingRepository) FindByKey(ctx context.Context, key string) (*domain.SystemSetting, error) {
+	entity, err := r.client.SystemSetting.
+		Query().
+		Where(systemsetting.KeyEQ(key)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("SystemSetting").WithDetail("key", key)
+		}
+		r.logger.Error("failed to find system setting", zap.Error(err), zap.String("key", key))
+		return nil, errors2.ConvertDatabaseError(err, "SystemSetting")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *systemSettingRepository) toDomain(entity *ent.SystemSetting) *domain.SystemSetting {
+	return &domain.SystemSetting{
+		ID:        entity.ID,
+		Key:       entity.Key,
+		Value:     entity.Value,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"slices"
 	"time"
 
@@ -30,7 +31,10 @@ func (r *userFollowedStreamerRepository) Create(ctx context.Context, follow *dom
 	builder := r.client.UserFollowedStreamer.Create().
 		SetUserID(follow.UserID).
 		SetStreamerID(follow.StreamerID).
-		SetNotificationsEnabled(follow.NotificationsEnabled)
+		SetNotificationsEnabled(follow.NotificationsEnabled).
+		SetAlwaysNotify(follow.AlwaysNotify).
+		SetNotifyStreamEnd(follow.NotifyStreamEnd).
+		SetNotifyTitleChange(follow.NotifyTitleChange)
 
 	if follow.Alias != "" {
 		builder.SetAlias(follow.Alias)
@@ -41,9 +45,34 @@ func (r *userFollowedStreamerRepository) Create(ctx context.Context, follow *dom
 	if len(follow.NotificationChannelIDs) > 0 {
 		builder.SetNotificationChannelIds(follow.NotificationChannelIDs)
 	}
+	if len(follow.NotifyCategories) > 0 {
+		builder.SetNotifyCategories(follow.NotifyCategories)
+	}
+	filter, err := notificationFilterToMap(follow.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		builder.SetNotificationFilter(filter)
+	}
+	if len(follow.ChannelOverrides) > 0 {
+		builder.SetChannelOverrides(follow.ChannelOverrides)
+	}
+	if follow.Template.Title != "" {
+		builder.SetTitleTemplate(follow.Template.Title)
+	}
+	if follow.Template.Body != "" {
+		builder.SetBodyTemplate(follow.Template.Body)
+	}
+	if follow.Routing != nil {
+		builder.SetRoutingMode(string(follow.Routing.Mode)).
+			SetEscalateAfterSeconds(int64(follow.Routing.EscalateAfter / time.Second))
+	}
 	if follow.LastNotificationSentAt != nil {
 		builder.SetLastNotificationSentAt(*follow.LastNotificationSentAt)
 	}
+	builder.SetNillableSnoozedUntil(follow.SnoozedUntil).
+		SetNillableMutedSessionID(follow.MutedSessionID)
 
 	created, err := builder.Save(ctx)
 	if err != nil {
@@ -59,7 +88,10 @@ func (r *userFollowedStreamerRepository) Create(ctx context.Context, follow *dom
 
 func (r *userFollowedStreamerRepository) Update(ctx context.Context, follow *domain.UserFollowedStreamer) (*domain.UserFollowedStreamer, error) {
 	builder := r.client.UserFollowedStreamer.UpdateOneID(follow.ID).
-		SetNotificationsEnabled(follow.NotificationsEnabled)
+		SetNotificationsEnabled(follow.NotificationsEnabled).
+		SetAlwaysNotify(follow.AlwaysNotify).
+		SetNotifyStreamEnd(follow.NotifyStreamEnd).
+		SetNotifyTitleChange(follow.NotifyTitleChange)
 
 	if follow.Alias == "" {
 		builder.ClearAlias()
@@ -75,11 +107,56 @@ func (r *userFollowedStreamerRepository) Update(ctx context.Context, follow *dom
 	if len(follow.NotificationChannelIDs) > 0 {
 		builder.SetNotificationChannelIds(follow.NotificationChannelIDs)
 	}
+	if len(follow.NotifyCategories) == 0 {
+		builder.ClearNotifyCategories()
+	} else {
+		builder.SetNotifyCategories(follow.NotifyCategories)
+	}
+	filter, err := notificationFilterToMap(follow.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		builder.ClearNotificationFilter()
+	} else {
+		builder.SetNotificationFilter(filter)
+	}
+	if len(follow.ChannelOverrides) == 0 {
+		builder.ClearChannelOverrides()
+	} else {
+		builder.SetChannelOverrides(follow.ChannelOverrides)
+	}
+	if follow.Template.Title == "" {
+		builder.ClearTitleTemplate()
+	} else {
+		builder.SetTitleTemplate(follow.Template.Title)
+	}
+	if follow.Template.Body == "" {
+		builder.ClearBodyTemplate()
+	} else {
+		builder.SetBodyTemplate(follow.Template.Body)
+	}
+	if follow.Routing == nil {
+		builder.ClearRoutingMode().ClearEscalateAfterSeconds()
+	} else {
+		builder.SetRoutingMode(string(follow.Routing.Mode)).
+			SetEscalateAfterSeconds(int64(follow.Routing.EscalateAfter / time.Second))
+	}
 	if follow.LastNotificationSentAt == nil {
 		builder.ClearLastNotificationSentAt()
 	} else {
 		builder.SetLastNotificationSentAt(*follow.LastNotificationSentAt)
 	}
+	if follow.SnoozedUntil == nil {
+		builder.ClearSnoozedUntil()
+	} else {
+		builder.SetSnoozedUntil(*follow.SnoozedUntil)
+	}
+	if follow.MutedSessionID == nil {
+		builder.ClearMutedSessionID()
+	} else {
+		builder.SetMutedSessionID(*follow.MutedSessionID)
+	}
 
 	updated, err := builder.Save(ctx)
 	if err != nil {
@@ -228,11 +305,25 @@ func (r *userFollowedStreamerRepository) ListByStreamerId(ctx context.Context, s
 }
 
 func (r *userFollowedStreamerRepository) toDomain(entity *ent.UserFollowedStreamer) *domain.UserFollowedStreamer {
+	filter, err := notificationFilterFromMap(entity.NotificationFilter)
+	if err != nil {
+		r.logger.Error("failed to decode notification filter, ignoring it",
+			zap.Error(err),
+			zap.Int64("follow_id", entity.ID),
+		)
+	}
 	var lastNotification *time.Time
 	if entity.LastNotificationSentAt != nil {
 		clone := lo.FromPtr(entity.LastNotificationSentAt)
 		lastNotification = &clone
 	}
+	var routing *domain.NotificationRouting
+	if entity.RoutingMode != nil {
+		routing = &domain.NotificationRouting{
+			Mode:          domain.NotificationRoutingMode(*entity.RoutingMode),
+			EscalateAfter: time.Duration(lo.FromPtr(entity.EscalateAfterSeconds)) * time.Second,
+		}
+	}
 
 	return &domain.UserFollowedStreamer{
 		ID:                     entity.ID,
@@ -241,9 +332,52 @@ func (r *userFollowedStreamerRepository) toDomain(entity *ent.UserFollowedStream
 		Alias:                  lo.FromPtr(entity.Alias),
 		Notes:                  lo.FromPtr(entity.Notes),
 		NotificationsEnabled:   entity.NotificationsEnabled,
+		AlwaysNotify:           entity.AlwaysNotify,
+		NotifyStreamEnd:        entity.NotifyStreamEnd,
+		NotifyTitleChange:      entity.NotifyTitleChange,
+		NotifyCategories:       slices.Clone(entity.NotifyCategories),
 		NotificationChannelIDs: slices.Clone(entity.NotificationChannelIds),
+		Template: domain.NotificationTemplate{
+			Title: lo.FromPtr(entity.TitleTemplate),
+			Body:  lo.FromPtr(entity.BodyTemplate),
+		},
+		Filter:                 filter,
+		Routing:                routing,
+		ChannelOverrides:       entity.ChannelOverrides,
 		LastNotificationSentAt: lastNotification,
+		SnoozedUntil:           entity.SnoozedUntil,
+		MutedSessionID:         entity.MutedSessionID,
 		CreatedAt:              entity.CreatedAt,
 		UpdatedAt:              entity.UpdatedAt,
 	}
 }
+
+func notificationFilterToMap(filter *domain.NotificationFilter) (map[string]any, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(filter)
+	if err != nil {
+		return nil, errors2.Internal(err)
+	}
+	var result map[string]any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, errors2.Internal(err)
+	}
+	return result, nil
+}
+
+func notificationFilterFromMap(data map[string]any) (*domain.NotificationFilter, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var filter domain.NotificationFilter
+	if err := json.Unmarshal(raw, &filter); err != nil {
+		return nil, err
+	}
+	return &filter, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
 	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/user"
 	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +23,7 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain
 		SetUsername(user.Username).
 		SetEmail(user.Email).
 		SetPassword(user.Password).
+		SetNillableLocale(lo.EmptyableToPtr(user.Locale)).
 		Save(ctx)
 	if err != nil {
 		r.logger.Error("failed to create user",
@@ -35,12 +37,17 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain
 }
 
 func (r *userRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
-	updated, err := r.client.User.
+	builder := r.client.User.
 		UpdateOneID(user.ID).
 		SetUsername(user.Username).
 		SetEmail(user.Email).
-		SetPassword(user.Password).
-		Save(ctx)
+		SetPassword(user.Password)
+	if user.Locale == "" {
+		builder.ClearLocale()
+	} else {
+		builder.SetLocale(user.Locale)
+	}
+	updated, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors2.NotFound("User").WithDetail("id", user.ID)
@@ -161,6 +168,7 @@ func (r *userRepository) toDomain(user *ent.User) *domain.User {
 		Username:  user.Username,
 		Email:     user.Email,
 		Password:  user.Password,
+		Locale:    lo.FromPtr(user.Locale),
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent"
+	"github.com/ryuyb/fusion/internal/infrastructure/database/ent/webpushsubscription"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type webPushSubscriptionRepository struct {
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewWebPushSubscriptionRepository(client *ent.Client, logger *zap.Logger) coreRepo.WebPushSubscriptionRepository {
+	return &webPushSubscriptionRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *webPushSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error) {
+	builder := r.client.WebPushSubscription.Create().
+		SetUserID(subscription.UserID).
+		SetEndpoint(subscription.Endpoint).
+		SetP256dh(subscription.P256dh).
+		SetAuth(subscription.Auth)
+
+	if subscription.UserAgent != "" {
+		builder.SetUserAgent(subscription.UserAgent)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create web push subscription",
+			zap.Error(err),
+			zap.Int64("user_id", subscription.UserID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "WebPushSubscription")
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *webPushSubscriptionRepository) Update(ctx context.Context, subscription *domain.WebPushSubscription) (*domain.WebPushSubscription, error) {
+	builder := r.client.WebPushSubscription.UpdateOneID(subscription.ID).
+		SetUserID(subscription.UserID).
+		SetEndpoint(subscription.Endpoint).
+		SetP256dh(subscription.P256dh).
+		SetAuth(subscription.Auth)
+
+	if subscription.UserAgent == "" {
+		builder.ClearUserAgent()
+	} else {
+		builder.SetUserAgent(subscription.UserAgent)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("WebPushSubscription").WithDetail("id", subscription.ID)
+		}
+		r.logger.Error("failed to update web push subscription",
+			zap.Error(err),
+			zap.Int64("id", subscription.ID),
+		)
+		return nil, errors2.ConvertDatabaseError(err, "WebPushSubscription")
+	}
+	return r.toDomain(updated), nil
+}
+
+func (r *webPushSubscriptionRepository) Delete(ctx context.Context, id int64) error {
+	err := r.client.WebPushSubscription.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors2.NotFound("WebPushSubscription").WithDetail("id", id)
+		}
+		r.logger.Error("failed to delete web push subscription", zap.Error(err), zap.Int64("id", id))
+		return errors2.ConvertDatabaseError(err, "WebPushSubscription")
+	}
+	return nil
+}
+
+func (r *webPushSubscriptionRepository) FindById(ctx context.Context, id int64) (*domain.WebPushSubscription, error) {
+	entity, err := r.client.WebPushSubscription.
+		Query().
+		Where(webpushsubscription.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("WebPushSubscription").WithDetail("id", id)
+		}
+		r.logger.Error("failed to find web push subscription by id", zap.Error(err), zap.Int64("id", id))
+		return nil, errors2.ConvertDatabaseError(err, "WebPushSubscription")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *webPushSubscriptionRepository) FindByEndpoint(ctx context.Context, endpoint string) (*domain.WebPushSubscription, error) {
+	entity, err := r.client.WebPushSubscription.
+		Query().
+		Where(webpushsubscription.EndpointEQ(endpoint)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors2.NotFound("WebPushSubscription")
+		}
+		r.logger.Error("failed to find web push subscription by endpoint", zap.Error(err))
+		return nil, errors2.ConvertDatabaseError(err, "WebPushSubscription")
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *webPushSubscriptionRepository) ListByUserId(ctx context.Context, userID int64, offset, limit int) ([]*domain.WebPushSubscription, int, error) {
+	total, err := r.client.WebPushSubscription.
+		Query().
+		Where(webpushsubscription.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		r.logger.Error("failed to count web push subscriptions", zap.Error(err), zap.Int64("user_id", userID))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	entities, err := r.client.WebPushSubscription.
+		Query().
+		Where(webpushsubscription.UserIDEQ(userID)).
+		Offset(offset).
+		Limit(limit).
+		Order(
+			ent.Desc(webpushsubscription.FieldCreatedAt),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list web push subscriptions", zap.Error(err), zap.Int64("user_id", userID))
+		return nil, 0, errors2.DatabaseError(err)
+	}
+
+	results := make([]*domain.WebPushSubscription, len(entities))
+	for i, entity := range entities {
+		results[i] = r.toDomain(entity)
+	}
+	return results, total, nil
+}
+
+func (r *webPushSubscriptionRepository) toDomain(entity *ent.WebPushSubscription) *domain.WebPushSubscription {
+	return &domain.WebPushSubscription{
+		ID:        entity.ID,
+		UserID:    entity.UserID,
+		Endpoint:  entity.Endpoint,
+		P256dh:    entity.P256dh,
+		Auth:      entity.Auth,
+		UserAgent: lo.FromPtr(entity.UserAgent),
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InboxMessage stores a notification kept in a user's in-app inbox.
+type InboxMessage struct {
+	ent.Schema
+}
+
+func (InboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable(),
+		field.Int64("user_id").
+			Positive(),
+		field.Int64("channel_id").
+			Positive(),
+		field.Int64("streamer_id").
+			Optional().
+			Nillable(),
+		field.String("event_type").
+			NotEmpty(),
+		field.String("severity").
+			Optional(),
+		field.Text("title"),
+		field.Text("content"),
+		field.Text("url").
+			Optional(),
+		field.Text("image_url").
+			Optional(),
+		field.Text("icon_url").
+			Optional(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (InboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "read_at"),
+		index.Fields("created_at"),
+	}
+}
+
+func (InboxMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("inbox_messages").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
@@ -32,6 +32,61 @@ func (NotificationChannel) Fields() []ent.Field {
 			Default(true),
 		field.Int("priority").
 			Default(0),
+		field.Text("title_template").
+			Optional().
+			Nillable(),
+		field.Text("body_template").
+			Optional().
+			Nillable(),
+		field.Int64("digest_window_seconds").
+			Default(0).
+			NonNegative(),
+		field.String("daily_summary_at").
+			Optional().
+			Nillable(),
+		field.String("daily_summary_timezone").
+			Optional().
+			Nillable(),
+		field.Time("daily_summary_sent_at").
+			Optional().
+			Nillable(),
+		field.Time("last_tested_at").
+			Optional().
+			Nillable(),
+		field.Bool("last_test_success").
+			Optional().
+			Nillable(),
+		field.Text("last_test_error").
+			Optional().
+			Nillable(),
+		field.String("verification_code_hash").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Time("verification_expires_at").
+			Optional().
+			Nillable(),
+		field.Int("verification_attempts").
+			Default(0).
+			NonNegative(),
+		field.Time("verification_sent_at").
+			Optional().
+			Nillable(),
+		field.Int("consecutive_failures").
+			Default(0).
+			NonNegative(),
+		field.Text("last_error").
+			Optional().
+			Nillable(),
+		field.Time("last_failure_at").
+			Optional().
+			Nillable(),
+		field.Time("last_success_at").
+			Optional().
+			Nillable(),
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -45,6 +100,7 @@ func (NotificationChannel) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
 		index.Fields("channel_type"),
+		index.Fields("daily_summary_at"),
 		index.Fields("user_id", "name").
 			Unique(),
 	}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationDelivery is both the outbox that workers claim notifications from and the
+// history of every delivery. Follow, channel and streamer ids are kept as plain columns so
+// history survives their deletion.
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+func (NotificationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable(),
+		field.Int64("user_id").
+			Positive(),
+		field.Int64("follow_id").
+			Optional().
+			Nillable(),
+		field.Int64("channel_id").
+			Positive(),
+		field.String("channel_type").
+			NotEmpty(),
+		field.Int64("streamer_id").
+			Optional().
+			Nillable(),
+		field.String("event_type").
+			NotEmpty(),
+		field.JSON("payload", map[string]any{}).
+			Optional().
+			Default(map[string]any{}),
+		field.JSON("response", map[string]any{}).
+			Optional(),
+		field.String("status").
+			NotEmpty(),
+		field.Int("attempts").
+			Default(0).
+			NonNegative(),
+		field.Int64("latency_ms").
+			Default(0).
+			NonNegative(),
+		field.Text("error").
+			Optional().
+			Nillable(),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable(),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.String("route_id").
+			Optional().
+			Nillable(),
+		field.String("route_mode").
+			Optional().
+			Nillable(),
+		field.Int("route_step").
+			Default(0).
+			NonNegative(),
+		field.Int64("escalate_after_seconds").
+			Default(0).
+			NonNegative(),
+		field.Int64("digest_id").
+			Optional().
+			Nillable(),
+		field.Time("acknowledged_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("channel_id", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("created_at"),
+		index.Fields("route_id", "route_step"),
+		index.Fields("status", "channel_id"),
+	}
+}
+
+func (NotificationDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notification_deliveries").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationPreference stores per-user notification settings, at most one row per user.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable(),
+		field.Int64("user_id").
+			Positive().
+			Unique(),
+		field.String("routing_mode").
+			NotEmpty(),
+		field.Int64("escalate_after_seconds").
+			Default(0).
+			NonNegative(),
+		field.JSON("quiet_hours", map[string]any{}).
+			Optional(),
+		field.Time("do_not_disturb_until").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notification_preference").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StreamSession records each broadcast of a streamer as observed by the live status checks.
+type StreamSession struct {
+	ent.Schema
+}
+
+func (StreamSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable(),
+		field.Int64("streamer_id").
+			Positive(),
+		field.String("title").
+			Optional().
+			Nillable(),
+		field.String("game_name").
+			Optional().
+			Nillable(),
+		field.JSON("titles", []string{}).
+			Optional(),
+		field.JSON("categories", []string{}).
+			Optional(),
+		field.String("announced_title").
+			Optional().
+			Nillable(),
+		field.String("announced_game_name").
+			Optional().
+			Nillable(),
+		field.Time("changed_at").
+			Optional().
+			Nillable(),
+		field.Int("peak_viewers").
+			Default(0).
+			NonNegative(),
+		field.Time("started_at"),
+		field.Time("last_seen_at"),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (StreamSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("streamer_id", "started_at"),
+		index.Fields("streamer_id", "ended_at"),
+	}
+}
+
+func (StreamSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("streamer", Streamer.Type).
+			Ref("sessions").
+			Field("streamer_id").
+			Required().
+			Unique(),
+	}
+}
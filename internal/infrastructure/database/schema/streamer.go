@@ -80,5 +80,6 @@ func (Streamer) Indexes() []ent.Index {
 func (Streamer) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("followers", UserFollowedStreamer.Type),
+		edge.To("sessions", StreamSession.Type),
 	}
 }
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SystemSetting stores application-wide values generated at runtime, such as Web Push VAPID keys.
+type SystemSetting struct {
+	ent.Schema
+}
+
+func (SystemSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable(),
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Text("value").
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -25,6 +25,9 @@ func (User) Fields() []ent.Field {
 		field.String("password").
 			NotEmpty().
 			Sensitive(),
+		field.String("locale").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -45,5 +48,10 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("followed_streamers", UserFollowedStreamer.Type),
 		edge.To("notification_channels", NotificationChannel.Type),
+		edge.To("web_push_subscriptions", WebPushSubscription.Type),
+		edge.To("notification_deliveries", NotificationDelivery.Type),
+		edge.To("inbox_messages", InboxMessage.Type),
+		edge.To("notification_preference", NotificationPreference.Type).
+			Unique(),
 	}
 }
@@ -34,9 +34,39 @@ func (UserFollowedStreamer) Fields() []ent.Field {
 		field.JSON("notification_channel_ids", []int64{}).
 			Optional().
 			Default([]int64{}),
+		field.Text("title_template").
+			Optional().
+			Nillable(),
+		field.Text("body_template").
+			Optional().
+			Nillable(),
+		field.String("routing_mode").
+			Optional().
+			Nillable(),
+		field.Int64("escalate_after_seconds").
+			Optional().
+			Nillable(),
+		field.Bool("always_notify").
+			Default(false),
+		field.Bool("notify_stream_end").
+			Default(false),
+		field.Bool("notify_title_change").
+			Default(false),
+		field.JSON("notify_categories", []string{}).
+			Optional(),
+		field.JSON("notification_filter", map[string]any{}).
+			Optional(),
+		field.JSON("channel_overrides", map[int64]map[string]any{}).
+			Optional(),
 		field.Time("last_notification_sent_at").
 			Optional().
 			Nillable(),
+		field.Time("snoozed_until").
+			Optional().
+			Nillable(),
+		field.Int64("muted_session_id").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebPushSubscription stores a browser PushSubscription registered by a user.
+type WebPushSubscription struct {
+	ent.Schema
+}
+
+func (WebPushSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable(),
+		field.Int64("user_id").
+			Positive(),
+		field.Text("endpoint").
+			NotEmpty().
+			Unique(),
+		field.String("p256dh").
+			NotEmpty(),
+		field.String("auth").
+			NotEmpty().
+			Sensitive(),
+		field.String("user_agent").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (WebPushSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (WebPushSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("web_push_subscriptions").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
@@ -16,8 +16,19 @@ import (
 
 const (
 	DefaultURL = "https://api.day.app/push"
+
+	// tapActionOpen keeps opening the link on tap rather than an action link.
+	tapActionOpen = "open"
 )
 
+// severityLevels maps the notification severity onto Bark interruption levels; the channel "level" config wins.
+var severityLevels = map[domain.NotificationSeverity]string{
+	domain.NotificationSeverityLow:      "passive",
+	domain.NotificationSeverityNormal:   "active",
+	domain.NotificationSeverityHigh:     "timeSensitive",
+	domain.NotificationSeverityCritical: "critical",
+}
+
 type Provider struct {
 	logger *zap.Logger
 	client *resty.Client
@@ -50,6 +61,7 @@ func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel
 		Post(endpointURL)
 
 	if err != nil {
+		err = client.RedactError(err)
 		p.logger.Error("Failed to send bark notification", zap.Error(err))
 		return errors2.Internal(err)
 	}
@@ -67,8 +79,10 @@ func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel
 
 func (p *Provider) TestConnection(ctx context.Context, config map[string]any) error {
 	data := &external.NotificationData{
-		Title:   "Test Notification",
-		Content: "This is a test notification from Fusion",
+		Title:     "Test Notification",
+		Content:   "This is a test notification from Fusion",
+		EventType: domain.NotificationEventTest,
+		Severity:  domain.NotificationSeverityNormal,
 	}
 	tempChannel := &domain.NotificationChannel{Config: config}
 
@@ -92,6 +106,10 @@ func buildRequest(cfg map[string]any, data *external.NotificationData) (BarkRequ
 		Title:     data.Title,
 		Body:      data.Content,
 		DeviceKey: deviceKey,
+		Level:     severityLevels[data.Severity],
+		Icon:      data.IconURL,
+		Image:     data.ImageURL,
+		Url:       data.URL,
 	}
 
 	setOptionalStrings(cfg, "subtitle", &req.Subtitle)
@@ -115,6 +133,10 @@ func buildRequest(cfg map[string]any, data *external.NotificationData) (BarkRequ
 		}
 		req.Level = level
 	}
+	// Low severity is a deliberate downgrade, e.g. during quiet hours, so it wins over the configured level.
+	if data.Severity == domain.NotificationSeverityLow {
+		req.Level = severityLevels[domain.NotificationSeverityLow]
+	}
 
 	if volume, ok := toInt(cfg["volume"]); ok {
 		if volume < 0 || volume > 10 {
@@ -146,14 +168,19 @@ func buildRequest(cfg map[string]any, data *external.NotificationData) (BarkRequ
 				WithDetail("link", link)
 		}
 		req.Url = link
+	} else if link, ok := cfg["open_url"].(string); ok && strings.TrimSpace(link) != "" {
+		if !isValidURL(link) {
+			return BarkRequest{}, errors2.BadRequest("open_url must be a valid URL").
+				WithDetail("open_url", link)
+		}
+		req.Url = link
 	}
-	if req.Url == "" {
-		if link, ok := cfg["open_url"].(string); ok && strings.TrimSpace(link) != "" {
-			if !isValidURL(link) {
-				return BarkRequest{}, errors2.BadRequest("open_url must be a valid URL").
-					WithDetail("open_url", link)
-			}
-			req.Url = link
+
+	// Bark has a single tap target, so an action link replaces the link when one is picked. Mute and
+	// unfollow links open a page that asks for confirmation first.
+	if tap, ok := cfg["tap_action"].(string); ok && tap != tapActionOpen {
+		if action := data.FindAction(domain.NotificationActionType(tap)); action != nil {
+			req.Url = action.URL
 		}
 	}
 
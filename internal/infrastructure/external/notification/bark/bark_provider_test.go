@@ -134,6 +134,54 @@ func TestSendSuccessWithOptionalFields(t *testing.T) {
 	require.Equal(t, "https://example.com", received.Url)
 }
 
+func TestBuildRequestUsesNotificationData(t *testing.T) {
+	t.Parallel()
+	data := &external.NotificationData{
+		Title:    "title",
+		Content:  "content",
+		URL:      "https://live.example/room",
+		ImageURL: "https://live.example/cover.jpg",
+		IconURL:  "https://live.example/avatar.png",
+		Severity: domain.NotificationSeverityHigh,
+	}
+
+	req, err := buildRequest(map[string]any{"device_key": "abc"}, data)
+	require.NoError(t, err)
+	require.Equal(t, data.URL, req.Url)
+	require.Equal(t, data.IconURL, req.Icon)
+	require.Equal(t, data.ImageURL, req.Image)
+	require.Equal(t, "timeSensitive", req.Level)
+
+	req, err = buildRequest(map[string]any{
+		"device_key": "abc",
+		"link":       "https://override.example",
+		"icon":       "https://override.example/icon.png",
+		"level":      "passive",
+	}, data)
+	require.NoError(t, err)
+	require.Equal(t, "https://override.example", req.Url)
+	require.Equal(t, "https://override.example/icon.png", req.Icon)
+	require.Equal(t, "passive", req.Level)
+
+	data.Severity = domain.NotificationSeverityLow
+	req, err = buildRequest(map[string]any{"device_key": "abc", "level": "critical"}, data)
+	require.NoError(t, err)
+	require.Equal(t, "passive", req.Level)
+
+	data.Actions = []external.NotificationAction{{Type: domain.NotificationActionAcknowledge, URL: "https://fusion.example/ack"}}
+	req, err = buildRequest(map[string]any{"device_key": "abc", "tap_action": "ack"}, data)
+	require.NoError(t, err)
+	require.Equal(t, "https://fusion.example/ack", req.Url)
+	// Without the picked action on offer the tap keeps opening the link.
+	req, err = buildRequest(map[string]any{"device_key": "abc", "tap_action": "snooze"}, data)
+	require.NoError(t, err)
+	require.Equal(t, data.URL, req.Url)
+	data.Actions = append(data.Actions, external.NotificationAction{Type: domain.NotificationActionUnfollow, URL: "https://fusion.example/unfollow"})
+	req, err = buildRequest(map[string]any{"device_key": "abc", "tap_action": "unfollow"}, data)
+	require.NoError(t, err)
+	require.Equal(t, "https://fusion.example/unfollow", req.Url)
+}
+
 // Integration-style test hitting real Bark API; requires network and env opt-in.
 func TestSendBarkLiveInvalidDeviceKey(t *testing.T) {
 	if testing.Short() || os.Getenv("BARK_LIVE_TEST") != "1" {
@@ -158,3 +206,25 @@ var mockNotificationData = external.NotificationData{
 	Title:   "Test",
 	Content: "test content",
 }
+
+func TestConfigSchemaMatchesBuildRequest(t *testing.T) {
+	t.Parallel()
+	schema := NewProvider(zap.NewNop()).ConfigSchema()
+
+	require.NoError(t, schema.Validate(map[string]any{
+		"device_key": "abc",
+		"level":      "timeSensitive",
+		"volume":     float64(5),
+		"icon":       "https://example.com/icon.png",
+	}))
+
+	for _, cfg := range []map[string]any{
+		{},
+		{"device_key": "abc", "level": "invalid"},
+		{"device_key": "abc", "volume": float64(11)},
+		{"device_key": "abc", "icon": "://bad"},
+	} {
+		err := schema.Validate(cfg)
+		require.Equal(t, errors2.ErrCodeValidation, errors2.GetAppError(err).Code, "config %v", cfg)
+	}
+}
@@ -15,6 +15,7 @@ type BarkRequest struct {
 	Copy      string `json:"copy,omitempty"`
 	Sound     string `json:"sound,omitempty"`
 	Icon      string `json:"icon,omitempty"`
+	Image     string `json:"image,omitempty"`
 	Group     string `json:"group,omitempty"`
 	Url       string `json:"url,omitempty"`
 	Action    string `json:"action,omitempty"`
@@ -0,0 +1,44 @@
+package bark
+
+import "github.com/ryuyb/fusion/internal/core/domain"
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:     domain.SchemaTypeObject,
+	Title:    "Bark",
+	Required: []string{"device_key"},
+	Properties: map[string]*domain.ChannelConfigSchema{
+		"device_key": {Type: domain.SchemaTypeString, Title: "Device key", Description: "Key shown in the Bark app", Secret: true},
+		"url":        {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Server URL", Description: "Push endpoint of a self-hosted Bark server", Default: DefaultURL},
+		"subtitle":   {Type: domain.SchemaTypeString, Title: "Subtitle", Overridable: true},
+		"level": {
+			Type:        domain.SchemaTypeString,
+			Title:       "Interruption level",
+			Description: "Overrides the level derived from the notification severity",
+			Enum:        []any{"critical", "active", "timeSensitive", "passive"},
+			Overridable: true,
+		},
+		"volume":   {Type: domain.SchemaTypeInteger, Title: "Volume", Description: "Volume of critical alerts", Minimum: domain.SchemaBound(0), Maximum: domain.SchemaBound(10), Overridable: true},
+		"badge":    {Type: domain.SchemaTypeInteger, Title: "Badge", Minimum: domain.SchemaBound(0), Overridable: true},
+		"call":     {Type: domain.SchemaTypeString, Title: "Call", Description: `"1" repeats the ringtone for 30 seconds`, Overridable: true},
+		"autoCopy": {Type: domain.SchemaTypeString, Title: "Auto copy", Description: `"1" copies the message automatically`, Overridable: true},
+		"copy":     {Type: domain.SchemaTypeString, Title: "Copy text", Overridable: true},
+		"sound":    {Type: domain.SchemaTypeString, Title: "Sound", Overridable: true},
+		"group":    {Type: domain.SchemaTypeString, Title: "Group", Overridable: true},
+		"action":   {Type: domain.SchemaTypeString, Title: "Action", Description: `"none" disables the tap action`, Overridable: true},
+		"icon":     {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Icon URL", Overridable: true},
+		"link":     {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Link", Description: "Opened on tap instead of the live room", Overridable: true},
+		"open_url": {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Open URL", Description: "Alias of link", Overridable: true},
+		"tap_action": {
+			Type:        domain.SchemaTypeString,
+			Title:       "Tap action",
+			Description: "Action link opened on tap when the notification offers it, e.g. ack to stop escalation",
+			Enum:        []any{tapActionOpen, string(domain.NotificationActionAcknowledge), string(domain.NotificationActionSnooze), string(domain.NotificationActionMute), string(domain.NotificationActionUnfollow)},
+			Default:     tapActionOpen,
+			Overridable: true,
+		},
+	},
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
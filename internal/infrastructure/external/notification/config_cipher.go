@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/keyring"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+)
+
+// ChannelConfigCipher seals the config fields each provider schema marks as secret.
+// Values are JSON encoded before sealing so non-string secrets keep their type.
+type ChannelConfigCipher struct {
+	providers *NotificationProviderManager
+	keyring   *keyring.Keyring
+}
+
+func NewChannelConfigCipher(providers *NotificationProviderManager, keyring *keyring.Keyring) external.ChannelConfigCipher {
+	return &ChannelConfigCipher{providers: providers, keyring: keyring}
+}
+
+func (c *ChannelConfigCipher) Encrypt(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error) {
+	if config == nil || !c.keyring.Enabled() {
+		return config, nil
+	}
+	sealed := maps.Clone(config)
+	for _, field := range c.providers.SecretFields(channelType) {
+		value, ok := sealed[field]
+		if !ok || value == nil || isSealedValue(value) {
+			continue
+		}
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors2.Internal(err)
+		}
+		envelope, err := c.keyring.Seal(plaintext, secretAAD(channelType, field))
+		if err != nil {
+			return nil, err
+		}
+		sealed[field] = envelope
+	}
+	return sealed, nil
+}
+
+func (c *ChannelConfigCipher) Decrypt(channelType domain.NotificationChannelType, config map[string]any) (map[string]any, error) {
+	if config == nil {
+		return nil, nil
+	}
+	opened := maps.Clone(config)
+	var errs []error
+	for _, field := range c.providers.SecretFields(channelType) {
+		envelope, ok := opened[field].(string)
+		if !ok || !keyring.IsSealed(envelope) {
+			// Written before encryption was enabled; NeedsRotation picks these up.
+			continue
+		}
+		plaintext, err := c.keyring.Open(envelope, secretAAD(channelType, field))
+		if err != nil {
+			delete(opened, field)
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(plaintext, &value); err != nil {
+			delete(opened, field)
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+			continue
+		}
+		opened[field] = value
+	}
+	if len(errs) > 0 {
+		return opened, errors2.Internal(errors.Join(errs...))
+	}
+	return opened, nil
+}
+
+func (c *ChannelConfigCipher) NeedsRotation(channelType domain.NotificationChannelType, config map[string]any) bool {
+	for _, field := range c.providers.SecretFields(channelType) {
+		value, ok := config[field]
+		if !ok || value == nil {
+			continue
+		}
+		envelope, isString := value.(string)
+		if !isString || c.keyring.NeedsRotation(envelope) {
+			return c.keyring.Enabled()
+		}
+	}
+	return false
+}
+
+func isSealedValue(value any) bool {
+	s, ok := value.(string)
+	return ok && keyring.IsSealed(s)
+}
+
+// secretAAD binds a sealed value to the field it was written to.
+func secretAAD(channelType domain.NotificationChannelType, field string) []byte {
+	return []byte(string(channelType) + "." + field)
+}
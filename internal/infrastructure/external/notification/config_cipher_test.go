@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/keyring"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestConfigCipher(t *testing.T, keys map[string]string, active string) external.ChannelConfigCipher {
+	t.Helper()
+	provider := external.NewMockNotificationProvider(t)
+	provider.EXPECT().GetChannelType().Return(domain.ChannelTypeBark).Maybe()
+	provider.EXPECT().ConfigSchema().Return(&domain.ChannelConfigSchema{
+		Type: domain.SchemaTypeObject,
+		Properties: map[string]*domain.ChannelConfigSchema{
+			"device_key": {Type: domain.SchemaTypeString, Secret: true},
+			"sound":      {Type: domain.SchemaTypeString},
+		},
+	}).Maybe()
+	manager := NewNotificationProviderManager([]external.NotificationProvider{provider}, zap.NewNop())
+
+	kr, err := keyring.NewKeyring(&config.Config{Encryption: config.EncryptionConfig{ActiveKey: active, Keys: keys}}, zap.NewNop())
+	require.NoError(t, err)
+	return NewChannelConfigCipher(manager, kr)
+}
+
+func TestChannelConfigCipher_EncryptsSecretFields(t *testing.T) {
+	keys := map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, 32))}
+	cipher := newTestConfigCipher(t, keys, "k1")
+	plain := map[string]any{"device_key": "abc", "sound": "bell"}
+
+	require.True(t, cipher.NeedsRotation(domain.ChannelTypeBark, plain))
+
+	sealed, err := cipher.Encrypt(domain.ChannelTypeBark, plain)
+	require.NoError(t, err)
+	require.Equal(t, "abc", plain["device_key"], "input must not be modified")
+	require.True(t, keyring.IsSealed(sealed["device_key"].(string)))
+	require.Equal(t, "bell", sealed["sound"])
+	require.False(t, cipher.NeedsRotation(domain.ChannelTypeBark, sealed))
+
+	opened, err := cipher.Decrypt(domain.ChannelTypeBark, sealed)
+	require.NoError(t, err)
+	require.Equal(t, plain, opened)
+
+	// Secrets written before encryption was enabled are read as is.
+	opened, err = cipher.Decrypt(domain.ChannelTypeBark, plain)
+	require.NoError(t, err)
+	require.Equal(t, plain, opened)
+}
+
+func TestChannelConfigCipher_Disabled(t *testing.T) {
+	cipher := newTestConfigCipher(t, nil, "")
+	plain := map[string]any{"device_key": "abc"}
+
+	sealed, err := cipher.Encrypt(domain.ChannelTypeBark, plain)
+	require.NoError(t, err)
+	require.Equal(t, plain, sealed)
+	require.False(t, cipher.NeedsRotation(domain.ChannelTypeBark, plain))
+}
@@ -0,0 +1,24 @@
+package dingtalk
+
+// RobotRequest is the payload accepted by a DingTalk custom robot webhook.
+// refer: https://open.dingtalk.com/document/orgapp/custom-robots-send-group-messages
+type RobotRequest struct {
+	MsgType  string           `json:"msgtype"`
+	Markdown *MarkdownMessage `json:"markdown,omitempty"`
+	At       *At              `json:"at,omitempty"`
+}
+
+type MarkdownMessage struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type At struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	IsAtAll   bool     `json:"isAtAll,omitempty"`
+}
+
+type robotResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
@@ -0,0 +1,224 @@
+package dingtalk
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	"github.com/ryuyb/fusion/internal/infrastructure/client"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/render"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const (
+	DefaultWebhookURL = "https://oapi.dingtalk.com/robot/send"
+
+	msgTypeMarkdown = "markdown"
+)
+
+type Provider struct {
+	logger *zap.Logger
+	client *resty.Client
+	now    func() time.Time
+}
+
+func NewProvider(logger *zap.Logger) *Provider {
+	return &Provider{
+		logger: logger,
+		client: client.NewRestyClient(logger),
+		now:    time.Now,
+	}
+}
+
+func (p *Provider) GetChannelType() domain.NotificationChannelType {
+	return domain.ChannelTypeDingTalk
+}
+
+func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel, data *external.NotificationData) error {
+	endpointURL, err := p.resolveEndpoint(channel.Config)
+	if err != nil {
+		return err
+	}
+
+	req, err := buildRequest(channel.Config, data)
+	if err != nil {
+		return err
+	}
+
+	response, err := p.client.R().
+		SetContext(ctx).
+		SetContentType(fiber.MIMEApplicationJSONCharsetUTF8).
+		SetBody(req).
+		SetResult(&robotResponse{}).
+		Post(endpointURL)
+	if err != nil {
+		err = client.RedactError(err)
+		p.logger.Error("Failed to send dingtalk notification", zap.Error(err))
+		return errors2.Internal(err)
+	}
+
+	if err := p.checkHTTPStatus(response); err != nil {
+		return err
+	}
+
+	return parseRobotResult(response)
+}
+
+func (p *Provider) TestConnection(ctx context.Context, config map[string]any) error {
+	data := &external.NotificationData{
+		Title:   "Test Notification",
+		Content: "This is a test notification from Fusion",
+	}
+	return p.Send(ctx, &domain.NotificationChannel{Config: config}, data)
+}
+
+func buildRequest(cfg map[string]any, data *external.NotificationData) (RobotRequest, error) {
+	title, err := render.Title(cfg, data)
+	if err != nil {
+		return RobotRequest{}, err
+	}
+	titled := *data
+	titled.Title = title
+	text, err := render.Markdown(cfg, &titled)
+	if err != nil {
+		return RobotRequest{}, err
+	}
+
+	req := RobotRequest{
+		MsgType:  msgTypeMarkdown,
+		Markdown: &MarkdownMessage{Title: title, Text: text},
+	}
+
+	mobiles := toStringSlice(cfg["at_mobiles"])
+	atAll, _ := cfg["at_all"].(bool)
+	if len(mobiles) > 0 || atAll {
+		req.At = &At{AtMobiles: mobiles, IsAtAll: atAll}
+		// DingTalk only highlights mentions that also appear in the markdown text.
+		if len(mobiles) > 0 {
+			mentions := make([]string, len(mobiles))
+			for i, mobile := range mobiles {
+				mentions[i] = "@" + mobile
+			}
+			req.Markdown.Text += "\n\n" + strings.Join(mentions, " ")
+		}
+	}
+
+	return req, nil
+}
+
+// resolveEndpoint builds the webhook URL and appends the timestamp signature when the robot uses the "secret" security mode.
+func (p *Provider) resolveEndpoint(cfg map[string]any) (string, error) {
+	var endpoint string
+	if webhook, ok := cfg["webhook_url"].(string); ok && strings.TrimSpace(webhook) != "" {
+		if !isValidURL(webhook) {
+			// The webhook URL embeds the robot credential, so it is not echoed back.
+			return "", errors2.BadRequest("webhook_url must be a valid URL").
+				WithDetail("field", "webhook_url")
+		}
+		endpoint = webhook
+	} else {
+		token, ok := cfg["access_token"].(string)
+		if !ok || strings.TrimSpace(token) == "" {
+			return "", errors2.BadRequest("access token is invalid").
+				WithDetail("access_token", cfg["access_token"])
+		}
+		endpoint = DefaultWebhookURL + "?access_token=" + url.QueryEscape(strings.TrimSpace(token))
+	}
+
+	secret, ok := cfg["secret"].(string)
+	if !ok || strings.TrimSpace(secret) == "" {
+		return endpoint, nil
+	}
+
+	timestamp := strconv.FormatInt(p.now().UnixMilli(), 10)
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%stimestamp=%s&sign=%s",
+		endpoint, separator, timestamp, url.QueryEscape(sign(timestamp, strings.TrimSpace(secret)))), nil
+}
+
+func sign(timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (p *Provider) checkHTTPStatus(response *resty.Response) error {
+	if response.StatusCode() >= 200 && response.StatusCode() <= 299 {
+		return nil
+	}
+
+	body := response.String()
+	if body == "" {
+		body = "no response body"
+	}
+	p.logger.Error("Failed to send dingtalk notification",
+		zap.Int("status", response.StatusCode()),
+		zap.String("body", body),
+	)
+	return errors2.BadRequest("dingtalk returned non-success status").
+		WithDetails(map[string]any{
+			"status": response.StatusCode(),
+			"body":   body,
+		})
+}
+
+func parseRobotResult(response *resty.Response) error {
+	if res, ok := response.Result().(*robotResponse); ok && res != nil && res.ErrCode != 0 {
+		return errors2.BadRequest("dingtalk returned error").
+			WithDetails(map[string]any{
+				"code":    res.ErrCode,
+				"message": res.ErrMsg,
+			})
+	}
+	return nil
+}
+
+// toStringSlice accepts both JSON arrays and comma separated strings.
+func toStringSlice(v any) []string {
+	var raw []string
+	switch val := v.(type) {
+	case []string:
+		raw = val
+	case []any:
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	case string:
+		raw = strings.Split(val, ",")
+	}
+
+	var result []string
+	for _, item := range raw {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func isValidURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return false
+	}
+	return parsed.Host != ""
+}
@@ -0,0 +1,106 @@
+package dingtalk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendValidationErrors(t *testing.T) {
+	t.Parallel()
+	provider := NewProvider(zap.NewNop())
+
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+
+	err = provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"access_token": "abc",
+		"title_layout": "{{.Title",
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
+
+func TestSendRobotErrorCode(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errcode": 310000,
+			"errmsg":  "sign not match",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url": server.URL,
+	}}, &mockNotificationData)
+
+	require.Error(t, err)
+	appErr := errors2.GetAppError(err)
+	require.Equal(t, errors2.ErrCodeBadRequest, appErr.Code)
+	require.Equal(t, 310000, appErr.Details["code"])
+	require.Equal(t, "sign not match", appErr.Details["message"])
+}
+
+func TestSendSignedWithMentions(t *testing.T) {
+	t.Parallel()
+	var (
+		received  RobotRequest
+		timestamp string
+		signature string
+		token     string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		query := r.URL.Query()
+		timestamp = query.Get("timestamp")
+		signature = query.Get("sign")
+		token = query.Get("access_token")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	provider.now = func() time.Time { return time.UnixMilli(1700000000000) }
+
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url": server.URL + "?access_token=tk",
+		"secret":      "SEC123",
+		"at_mobiles":  []any{"13800000000", " "},
+	}}, &mockNotificationData)
+	require.NoError(t, err)
+
+	require.Equal(t, "tk", token)
+	require.Equal(t, "1700000000000", timestamp)
+	require.Equal(t, sign("1700000000000", "SEC123"), signature)
+
+	require.Equal(t, msgTypeMarkdown, received.MsgType)
+	require.Equal(t, "Test", received.Markdown.Title)
+	require.Contains(t, received.Markdown.Text, "test content")
+	require.Contains(t, received.Markdown.Text, "@13800000000")
+	require.NotNil(t, received.At)
+	require.Equal(t, []string{"13800000000"}, received.At.AtMobiles)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	// Reference value computed with the algorithm from the DingTalk documentation.
+	require.Equal(t, "lkcPI1uoxBY1gUnCnnPH1Kkru0Hqjo7rFpA3haIVhEQ=", sign("1700000000000", "SEC123"))
+}
+
+var mockNotificationData = external.NotificationData{
+	Title:   "Test",
+	Content: "test content",
+	URL:     "https://live.example/1001",
+}
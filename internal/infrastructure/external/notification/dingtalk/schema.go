@@ -0,0 +1,40 @@
+package dingtalk
+
+import (
+	"maps"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/render"
+)
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:  domain.SchemaTypeObject,
+	Title: "DingTalk robot",
+	AnyOf: []*domain.ChannelConfigSchema{
+		{Required: []string{"access_token"}},
+		{Required: []string{"webhook_url"}},
+	},
+	Properties: func() map[string]*domain.ChannelConfigSchema {
+		properties := map[string]*domain.ChannelConfigSchema{
+			"access_token": {Type: domain.SchemaTypeString, Title: "Access token", Secret: true},
+			"webhook_url":  {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Webhook URL", Description: "Full webhook URL, used instead of the access token", Secret: true},
+			"secret":       {Type: domain.SchemaTypeString, Title: "Signing secret", Description: "Required when the robot uses the signature security mode", Secret: true},
+			"at_mobiles": {
+				Title:       "Mention mobiles",
+				Description: "Array or comma separated list of mobile numbers to @",
+				AnyOf: []*domain.ChannelConfigSchema{
+					{Type: domain.SchemaTypeArray, Items: &domain.ChannelConfigSchema{Type: domain.SchemaTypeString}},
+					{Type: domain.SchemaTypeString},
+				},
+				Overridable: true,
+			},
+			"at_all": {Type: domain.SchemaTypeBoolean, Title: "Mention everyone", Overridable: true},
+		}
+		maps.Copy(properties, render.LayoutProperties())
+		return properties
+	}(),
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
@@ -0,0 +1,16 @@
+package gotify
+
+// MessageRequest is the payload for creating a message through the Gotify REST API.
+// refer: https://gotify.net/api-docs#/message/createMessage
+type MessageRequest struct {
+	Title    string         `json:"title,omitempty"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"` // 0 (lowest) - 10 (highest)
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorCode        int    `json:"errorCode"`
+	ErrorDescription string `json:"errorDescription"`
+}
@@ -0,0 +1,203 @@
+package gotify
+
+import (
+	"cmp"
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	"github.com/ryuyb/fusion/internal/infrastructure/client"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const (
+	tokenHeader = "X-Gotify-Key"
+
+	minPriority     = 0
+	maxPriority     = 10
+	defaultPriority = 5
+)
+
+type Provider struct {
+	logger *zap.Logger
+	client *resty.Client
+}
+
+func NewProvider(logger *zap.Logger) *Provider {
+	return &Provider{
+		logger: logger,
+		client: client.NewRestyClient(logger),
+	}
+}
+
+func (p *Provider) GetChannelType() domain.NotificationChannelType {
+	return domain.ChannelTypeGotify
+}
+
+func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel, data *external.NotificationData) error {
+	endpointURL, err := resolveEndpoint(channel.Config)
+	if err != nil {
+		return err
+	}
+
+	token, ok := channel.Config["app_token"].(string)
+	if !ok || strings.TrimSpace(token) == "" {
+		return errors2.BadRequest("app token is invalid").
+			WithDetail("app_token", channel.Config["app_token"])
+	}
+
+	req, err := buildRequest(channel, data)
+	if err != nil {
+		return err
+	}
+
+	request := p.client.R().
+		SetContext(ctx).
+		SetContentType(fiber.MIMEApplicationJSONCharsetUTF8).
+		SetHeader(tokenHeader, token).
+		SetBody(req).
+		SetError(&errorResponse{})
+	applyBasicAuth(request, channel.Config)
+
+	response, err := request.Post(endpointURL)
+	if err != nil {
+		err = client.RedactError(err)
+		p.logger.Error("Failed to send gotify notification", zap.Error(err))
+		return errors2.Internal(err)
+	}
+
+	return p.checkHTTPStatus(response, endpointURL)
+}
+
+func (p *Provider) TestConnection(ctx context.Context, config map[string]any) error {
+	data := &external.NotificationData{
+		Title:   "Test Notification",
+		Content: "This is a test notification from Fusion",
+	}
+	return p.Send(ctx, &domain.NotificationChannel{Config: config}, data)
+}
+
+func buildRequest(channel *domain.NotificationChannel, data *external.NotificationData) (MessageRequest, error) {
+	cfg := channel.Config
+	req := MessageRequest{
+		Title:    data.Title,
+		Message:  data.Content,
+		Priority: mapPriority(channel.Priority),
+	}
+
+	if priority, ok := toInt(cfg["priority"]); ok {
+		if priority < minPriority || priority > maxPriority {
+			return MessageRequest{}, errors2.BadRequest("priority must be between 0 and 10").
+				WithDetail("priority", priority)
+		}
+		req.Priority = priority
+	}
+
+	contentType := "text/plain"
+	if markdown, ok := cfg["markdown"].(bool); ok && markdown {
+		contentType = "text/markdown"
+	}
+	extras := map[string]any{
+		"client::display": map[string]any{"contentType": contentType},
+	}
+
+	clientNotification := map[string]any{}
+	if data.URL != "" {
+		clientNotification["click"] = map[string]any{"url": data.URL}
+	}
+	if image := cmp.Or(data.ImageURL, data.IconURL); image != "" {
+		clientNotification["bigImageUrl"] = image
+	}
+	if len(clientNotification) > 0 {
+		extras["client::notification"] = clientNotification
+	}
+	req.Extras = extras
+
+	return req, nil
+}
+
+// mapPriority translates the channel priority onto the Gotify 0-10 scale.
+// Channels without an explicit priority use a priority that still pops up on Android clients.
+func mapPriority(priority int) int {
+	if priority <= 0 {
+		return defaultPriority
+	}
+	return min(max(priority, minPriority), maxPriority)
+}
+
+func resolveEndpoint(cfg map[string]any) (string, error) {
+	serverURL, ok := cfg["server_url"].(string)
+	if !ok || !isValidURL(serverURL) {
+		return "", errors2.BadRequest("server_url must be a valid URL").
+			WithDetail("server_url", cfg["server_url"])
+	}
+	return strings.TrimRight(serverURL, "/") + "/message", nil
+}
+
+// applyBasicAuth supports Gotify instances sitting behind a reverse proxy with basic auth.
+func applyBasicAuth(request *resty.Request, cfg map[string]any) {
+	username, _ := cfg["username"].(string)
+	password, _ := cfg["password"].(string)
+	if strings.TrimSpace(username) != "" {
+		request.SetBasicAuth(username, password)
+	}
+}
+
+func (p *Provider) checkHTTPStatus(response *resty.Response, endpointURL string) error {
+	if response.StatusCode() >= 200 && response.StatusCode() <= 299 {
+		return nil
+	}
+
+	body := response.String()
+	if body == "" {
+		body = "no response body"
+	}
+	p.logger.Error("Failed to send gotify notification",
+		zap.String("url", endpointURL),
+		zap.Int("status", response.StatusCode()),
+		zap.String("body", body),
+	)
+
+	details := map[string]any{
+		"status": response.StatusCode(),
+		"body":   body,
+	}
+	if res, ok := response.Error().(*errorResponse); ok && res != nil && res.Error != "" {
+		details["code"] = res.ErrorCode
+		details["message"] = res.ErrorDescription
+	}
+	return errors2.BadRequest("gotify returned non-success status").WithDetails(details)
+}
+
+func toInt(v any) (int, bool) {
+	switch val := v.(type) {
+	case int:
+		return val, true
+	case int32:
+		return int(val), true
+	case int64:
+		return int(val), true
+	case float32:
+		return int(val), true
+	case float64:
+		return int(val), true
+	default:
+		return 0, false
+	}
+}
+
+func isValidURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return false
+	}
+	return parsed.Host != ""
+}
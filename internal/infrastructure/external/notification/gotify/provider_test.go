@@ -0,0 +1,123 @@
+package gotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendValidationErrors(t *testing.T) {
+	t.Parallel()
+	provider := NewProvider(zap.NewNop())
+	ctx := context.Background()
+
+	err := provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{
+		"app_token": "abc",
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+
+	err = provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{
+		"server_url": "https://gotify.example",
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+
+	err = provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{
+		"server_url": "https://gotify.example",
+		"app_token":  "abc",
+		"priority":   11,
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
+
+func TestSendHTTPFailure(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error":            "Unauthorized",
+			"errorCode":        401,
+			"errorDescription": "you need to provide a valid access token",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"server_url": server.URL,
+		"app_token":  "invalid",
+	}}, &mockNotificationData)
+
+	require.Error(t, err)
+	appErr := errors2.GetAppError(err)
+	require.Equal(t, errors2.ErrCodeBadRequest, appErr.Code)
+	require.Equal(t, 401, appErr.Details["status"])
+	require.Equal(t, "you need to provide a valid access token", appErr.Details["message"])
+}
+
+func TestSendSuccess(t *testing.T) {
+	t.Parallel()
+	var (
+		received           MessageRequest
+		path, token        string
+		username, password string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		path = r.URL.Path
+		token = r.Header.Get(tokenHeader)
+		username, password, _ = r.BasicAuth()
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	channel := &domain.NotificationChannel{
+		Priority: 3,
+		Config: map[string]any{
+			"server_url": server.URL + "/",
+			"app_token":  "AbCdEf",
+			"username":   "neo",
+			"password":   "secret",
+		},
+	}
+
+	err := provider.Send(context.Background(), channel, &mockNotificationData)
+	require.NoError(t, err)
+
+	require.Equal(t, "/message", path)
+	require.Equal(t, "AbCdEf", token)
+	require.Equal(t, "neo", username)
+	require.Equal(t, "secret", password)
+	require.Equal(t, mockNotificationData.Title, received.Title)
+	require.Equal(t, mockNotificationData.Content, received.Message)
+	require.Equal(t, 3, received.Priority)
+
+	notification, ok := received.Extras["client::notification"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, mockNotificationData.IconURL, notification["bigImageUrl"])
+	require.Equal(t, map[string]any{"url": mockNotificationData.URL}, notification["click"])
+}
+
+func TestMapPriority(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, defaultPriority, mapPriority(0))
+	require.Equal(t, 1, mapPriority(1))
+	require.Equal(t, 10, mapPriority(42))
+}
+
+var mockNotificationData = external.NotificationData{
+	Title:   "Test",
+	Content: "test content",
+	URL:     "https://live.example/1001",
+	IconURL: "https://live.example/avatar.png",
+}
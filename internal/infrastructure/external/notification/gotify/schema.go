@@ -0,0 +1,21 @@
+package gotify
+
+import "github.com/ryuyb/fusion/internal/core/domain"
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:     domain.SchemaTypeObject,
+	Title:    "Gotify",
+	Required: []string{"server_url", "app_token"},
+	Properties: map[string]*domain.ChannelConfigSchema{
+		"server_url": {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Server URL"},
+		"app_token":  {Type: domain.SchemaTypeString, Title: "Application token", Secret: true},
+		"priority":   {Type: domain.SchemaTypeInteger, Title: "Priority", Description: "Overrides the channel priority", Minimum: domain.SchemaBound(minPriority), Maximum: domain.SchemaBound(maxPriority), Overridable: true},
+		"markdown":   {Type: domain.SchemaTypeBoolean, Title: "Markdown", Overridable: true},
+		"username":   {Type: domain.SchemaTypeString, Title: "Username", Description: "Basic auth for instances behind a reverse proxy"},
+		"password":   {Type: domain.SchemaTypeString, Title: "Password", Secret: true},
+	},
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
@@ -0,0 +1,52 @@
+package inbox
+
+import (
+	"context"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"go.uber.org/zap"
+)
+
+// Provider keeps notifications in the channel owner's in-app inbox instead of sending them anywhere.
+type Provider struct {
+	logger *zap.Logger
+	repo   coreRepo.InboxMessageRepository
+}
+
+func NewProvider(repo coreRepo.InboxMessageRepository, logger *zap.Logger) *Provider {
+	return &Provider{
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+func (p *Provider) GetChannelType() domain.NotificationChannelType {
+	return domain.ChannelTypeInbox
+}
+
+func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel, data *external.NotificationData) error {
+	message := &domain.InboxMessage{
+		UserID:    channel.UserID,
+		ChannelID: channel.ID,
+		EventType: data.EventType,
+		Severity:  data.Severity,
+		Title:     data.Title,
+		Content:   data.Content,
+		URL:       data.URL,
+		ImageURL:  data.ImageURL,
+		IconURL:   data.IconURL,
+	}
+	if data.StreamerID > 0 {
+		streamerID := data.StreamerID
+		message.StreamerID = &streamerID
+	}
+	_, err := p.repo.Create(ctx, message)
+	return err
+}
+
+// TestConnection has nothing to reach: the inbox is part of Fusion.
+func (p *Provider) TestConnection(_ context.Context, _ map[string]any) error {
+	return nil
+}
@@ -0,0 +1,14 @@
+package inbox
+
+import "github.com/ryuyb/fusion/internal/core/domain"
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:        domain.SchemaTypeObject,
+	Title:       "Inbox",
+	Description: "Keeps notifications in the in-app inbox",
+	Properties:  map[string]*domain.ChannelConfigSchema{},
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
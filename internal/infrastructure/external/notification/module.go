@@ -3,12 +3,26 @@ package notification
 import (
 	"github.com/ryuyb/fusion/internal/core/port/external"
 	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/bark"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/dingtalk"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/gotify"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/inbox"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/ntfy"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/webpush"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/wecom"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Module("notification",
+	fx.Provide(webpush.NewKeyStore),
+
 	fx.Provide(
 		asProvider(bark.NewProvider),
+		asProvider(ntfy.NewProvider),
+		asProvider(gotify.NewProvider),
+		asProvider(wecom.NewProvider),
+		asProvider(dingtalk.NewProvider),
+		asProvider(webpush.NewProvider),
+		asProvider(inbox.NewProvider),
 	),
 
 	fx.Provide(
@@ -17,6 +31,8 @@ var Module = fx.Module("notification",
 			fx.ParamTags(`group:"notification_providers"`),
 		),
 	),
+
+	fx.Provide(NewChannelConfigCipher),
 )
 
 func asProvider(f any) any {
@@ -0,0 +1,32 @@
+package ntfy
+
+// PublishRequest is the JSON publish payload accepted by the ntfy server root endpoint.
+// refer: https://docs.ntfy.sh/publish/#publish-as-json
+type PublishRequest struct {
+	Topic    string   `json:"topic"`
+	Title    string   `json:"title,omitempty"`
+	Message  string   `json:"message,omitempty"`
+	Priority int      `json:"priority,omitempty"` // 1 (min) - 5 (max), default to 3
+	Tags     []string `json:"tags,omitempty"`
+	Click    string   `json:"click,omitempty"`
+	Icon     string   `json:"icon,omitempty"`
+	Attach   string   `json:"attach,omitempty"`
+	Markdown bool     `json:"markdown,omitempty"`
+	Actions  []Action `json:"actions,omitempty"`
+}
+
+// Action is a button under the notification; http actions call the URL in the background.
+// refer: https://docs.ntfy.sh/publish/#action-buttons
+type Action struct {
+	Action string `json:"action"`
+	Label  string `json:"label"`
+	URL    string `json:"url"`
+	Method string `json:"method,omitempty"`
+	Clear  bool   `json:"clear,omitempty"`
+}
+
+type errorResponse struct {
+	Code  int    `json:"code"`
+	HTTP  int    `json:"http"`
+	Error string `json:"error"`
+}
@@ -0,0 +1,236 @@
+package ntfy
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	"github.com/ryuyb/fusion/internal/infrastructure/client"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const (
+	DefaultServerURL = "https://ntfy.sh"
+
+	minPriority     = 1
+	maxPriority     = 5
+	defaultPriority = 3
+
+	// maxActions is how many action buttons ntfy shows at most.
+	maxActions = 3
+)
+
+type Provider struct {
+	logger *zap.Logger
+	client *resty.Client
+}
+
+func NewProvider(logger *zap.Logger) *Provider {
+	return &Provider{
+		logger: logger,
+		client: client.NewRestyClient(logger),
+	}
+}
+
+func (p *Provider) GetChannelType() domain.NotificationChannelType {
+	return domain.ChannelTypeNtfy
+}
+
+func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel, data *external.NotificationData) error {
+	serverURL, err := resolveServerURL(channel.Config)
+	if err != nil {
+		return err
+	}
+
+	req, err := buildRequest(channel, data)
+	if err != nil {
+		return err
+	}
+
+	request := p.client.R().
+		SetContext(ctx).
+		SetContentType(fiber.MIMEApplicationJSONCharsetUTF8).
+		SetBody(req).
+		SetError(&errorResponse{})
+	applyAuth(request, channel.Config)
+
+	response, err := request.Post(serverURL)
+	if err != nil {
+		err = client.RedactError(err)
+		p.logger.Error("Failed to send ntfy notification", zap.Error(err))
+		return errors2.Internal(err)
+	}
+
+	return p.checkHTTPStatus(response, serverURL)
+}
+
+func (p *Provider) TestConnection(ctx context.Context, config map[string]any) error {
+	data := &external.NotificationData{
+		Title:   "Test Notification",
+		Content: "This is a test notification from Fusion",
+	}
+	return p.Send(ctx, &domain.NotificationChannel{Config: config}, data)
+}
+
+func buildRequest(channel *domain.NotificationChannel, data *external.NotificationData) (PublishRequest, error) {
+	cfg := channel.Config
+	topic, ok := cfg["topic"].(string)
+	if !ok || strings.TrimSpace(topic) == "" {
+		return PublishRequest{}, errors2.BadRequest("topic is invalid").
+			WithDetail("topic", cfg["topic"])
+	}
+
+	req := PublishRequest{
+		Topic:    strings.TrimSpace(topic),
+		Title:    data.Title,
+		Message:  data.Content,
+		Priority: mapPriority(channel.Priority),
+		Click:    data.URL,
+		Icon:     data.IconURL,
+	}
+
+	if priority, ok := toInt(cfg["priority"]); ok {
+		if priority < minPriority || priority > maxPriority {
+			return PublishRequest{}, errors2.BadRequest("priority must be between 1 and 5").
+				WithDetail("priority", priority)
+		}
+		req.Priority = priority
+	}
+
+	if tags, ok := cfg["tags"].(string); ok && strings.TrimSpace(tags) != "" {
+		for _, tag := range strings.Split(tags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				req.Tags = append(req.Tags, tag)
+			}
+		}
+	}
+
+	if click, ok := cfg["click"].(string); ok && strings.TrimSpace(click) != "" {
+		if !isValidURL(click) {
+			return PublishRequest{}, errors2.BadRequest("click must be a valid URL").
+				WithDetail("click", click)
+		}
+		req.Click = click
+	}
+
+	if icon, ok := cfg["icon"].(string); ok && strings.TrimSpace(icon) != "" {
+		if !isValidURL(icon) {
+			return PublishRequest{}, errors2.BadRequest("icon must be a valid URL").
+				WithDetail("icon", icon)
+		}
+		req.Icon = icon
+	}
+
+	if attach, ok := cfg["attach_icon"].(bool); ok && attach {
+		req.Attach = req.Icon
+	}
+
+	if markdown, ok := cfg["markdown"].(bool); ok {
+		req.Markdown = markdown
+	}
+
+	for _, action := range data.Actions[:min(len(data.Actions), maxActions)] {
+		req.Actions = append(req.Actions, Action{
+			Action: "http",
+			Label:  action.Label,
+			URL:    action.URL,
+			Method: "POST",
+			Clear:  true,
+		})
+	}
+
+	return req, nil
+}
+
+// mapPriority translates the channel priority onto the ntfy 1-5 scale.
+// Channels without an explicit priority use the ntfy default.
+func mapPriority(priority int) int {
+	if priority <= 0 {
+		return defaultPriority
+	}
+	return min(max(priority, minPriority), maxPriority)
+}
+
+func resolveServerURL(cfg map[string]any) (string, error) {
+	serverURL, ok := cfg["server_url"].(string)
+	if !ok || strings.TrimSpace(serverURL) == "" {
+		return DefaultServerURL, nil
+	}
+	if !isValidURL(serverURL) {
+		return "", errors2.BadRequest("server_url must be a valid URL").
+			WithDetail("server_url", serverURL)
+	}
+	return strings.TrimRight(serverURL, "/"), nil
+}
+
+// applyAuth prefers an access token and falls back to basic auth when credentials are configured.
+func applyAuth(request *resty.Request, cfg map[string]any) {
+	if token, ok := cfg["access_token"].(string); ok && strings.TrimSpace(token) != "" {
+		request.SetAuthToken(token)
+		return
+	}
+	username, _ := cfg["username"].(string)
+	password, _ := cfg["password"].(string)
+	if strings.TrimSpace(username) != "" {
+		request.SetBasicAuth(username, password)
+	}
+}
+
+func (p *Provider) checkHTTPStatus(response *resty.Response, serverURL string) error {
+	if response.StatusCode() >= 200 && response.StatusCode() <= 299 {
+		return nil
+	}
+
+	body := response.String()
+	if body == "" {
+		body = "no response body"
+	}
+	p.logger.Error("Failed to send ntfy notification",
+		zap.String("url", serverURL),
+		zap.Int("status", response.StatusCode()),
+		zap.String("body", body),
+	)
+
+	details := map[string]any{
+		"status": response.StatusCode(),
+		"body":   body,
+	}
+	if res, ok := response.Error().(*errorResponse); ok && res != nil && res.Error != "" {
+		details["code"] = res.Code
+		details["message"] = res.Error
+	}
+	return errors2.BadRequest("ntfy returned non-success status").WithDetails(details)
+}
+
+func toInt(v any) (int, bool) {
+	switch val := v.(type) {
+	case int:
+		return val, true
+	case int32:
+		return int(val), true
+	case int64:
+		return int(val), true
+	case float32:
+		return int(val), true
+	case float64:
+		return int(val), true
+	default:
+		return 0, false
+	}
+}
+
+func isValidURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return false
+	}
+	return parsed.Host != ""
+}
@@ -0,0 +1,163 @@
+package ntfy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendValidationErrors(t *testing.T) {
+	t.Parallel()
+	provider := NewProvider(zap.NewNop())
+	ctx := context.Background()
+
+	err := provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+
+	err = provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{
+		"topic":    "fusion",
+		"priority": 6,
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+
+	err = provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{
+		"topic":      "fusion",
+		"server_url": "ftp://ntfy.example",
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
+
+func TestSendHTTPFailure(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"code":  40301,
+			"http":  403,
+			"error": "forbidden",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"topic":      "fusion",
+		"server_url": server.URL,
+	}}, &mockNotificationData)
+
+	require.Error(t, err)
+	appErr := errors2.GetAppError(err)
+	require.Equal(t, errors2.ErrCodeBadRequest, appErr.Code)
+	require.Equal(t, 403, appErr.Details["status"])
+	require.Equal(t, "forbidden", appErr.Details["message"])
+}
+
+func TestSendSuccess(t *testing.T) {
+	t.Parallel()
+	var (
+		received PublishRequest
+		authz    string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		authz = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"id":"abc"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	channel := &domain.NotificationChannel{
+		Priority: 9,
+		Config: map[string]any{
+			"topic":        "fusion",
+			"server_url":   server.URL + "/",
+			"access_token": "tk_123",
+			"tags":         "tv, live",
+			"attach_icon":  true,
+		},
+	}
+
+	err := provider.Send(context.Background(), channel, &mockNotificationData)
+	require.NoError(t, err)
+
+	require.Equal(t, "Bearer tk_123", authz)
+	require.Equal(t, "fusion", received.Topic)
+	require.Equal(t, mockNotificationData.Title, received.Title)
+	require.Equal(t, mockNotificationData.Content, received.Message)
+	require.Equal(t, 5, received.Priority)
+	require.Equal(t, []string{"tv", "live"}, received.Tags)
+	require.Equal(t, mockNotificationData.URL, received.Click)
+	require.Equal(t, mockNotificationData.IconURL, received.Icon)
+	require.Equal(t, mockNotificationData.IconURL, received.Attach)
+	require.Empty(t, received.Actions)
+}
+
+func TestBuildRequestActions(t *testing.T) {
+	t.Parallel()
+	data := mockNotificationData
+	data.Actions = []external.NotificationAction{
+		{Type: domain.NotificationActionAcknowledge, Label: "Got it", URL: "https://fusion.example/ack"},
+		{Type: domain.NotificationActionSnooze, Label: "Snooze 24h", URL: "https://fusion.example/snooze"},
+		{Type: domain.NotificationActionMute, Label: "Mute this stream", URL: "https://fusion.example/mute"},
+		{Type: domain.NotificationActionUnfollow, Label: "Unfollow", URL: "https://fusion.example/unfollow"},
+	}
+
+	req, err := buildRequest(&domain.NotificationChannel{Config: map[string]any{"topic": "fusion"}}, &data)
+	require.NoError(t, err)
+	require.Len(t, req.Actions, maxActions)
+	require.Equal(t, Action{Action: "http", Label: "Got it", URL: "https://fusion.example/ack", Method: "POST", Clear: true}, req.Actions[0])
+	require.Equal(t, "https://fusion.example/mute", req.Actions[2].URL)
+}
+
+func TestSendBasicAuth(t *testing.T) {
+	t.Parallel()
+	var (
+		username, password string
+		received           PublishRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		username, password, _ = r.BasicAuth()
+		_ = json.NewDecoder(r.Body).Decode(&received)
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"topic":      "fusion",
+		"server_url": server.URL,
+		"username":   "neo",
+		"password":   "secret",
+	}}, &mockNotificationData)
+	require.NoError(t, err)
+
+	require.Equal(t, "neo", username)
+	require.Equal(t, "secret", password)
+	require.Equal(t, defaultPriority, received.Priority)
+}
+
+func TestMapPriority(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, defaultPriority, mapPriority(0))
+	require.Equal(t, defaultPriority, mapPriority(-3))
+	require.Equal(t, 1, mapPriority(1))
+	require.Equal(t, 4, mapPriority(4))
+	require.Equal(t, 5, mapPriority(100))
+}
+
+var mockNotificationData = external.NotificationData{
+	Title:   "Test",
+	Content: "test content",
+	URL:     "https://live.example/1001",
+	IconURL: "https://live.example/avatar.png",
+}
@@ -0,0 +1,26 @@
+package ntfy
+
+import "github.com/ryuyb/fusion/internal/core/domain"
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:     domain.SchemaTypeObject,
+	Title:    "ntfy",
+	Required: []string{"topic"},
+	Properties: map[string]*domain.ChannelConfigSchema{
+		"topic":        {Type: domain.SchemaTypeString, Title: "Topic"},
+		"server_url":   {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Server URL", Default: DefaultServerURL},
+		"priority":     {Type: domain.SchemaTypeInteger, Title: "Priority", Description: "Overrides the channel priority", Minimum: domain.SchemaBound(minPriority), Maximum: domain.SchemaBound(maxPriority), Overridable: true},
+		"tags":         {Type: domain.SchemaTypeString, Title: "Tags", Description: "Comma separated tags or emoji short codes", Overridable: true},
+		"click":        {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Click URL", Description: "Opened on tap instead of the live room", Overridable: true},
+		"icon":         {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Icon URL", Overridable: true},
+		"attach_icon":  {Type: domain.SchemaTypeBoolean, Title: "Attach icon", Description: "Also send the icon as an attachment", Overridable: true},
+		"markdown":     {Type: domain.SchemaTypeBoolean, Title: "Markdown", Overridable: true},
+		"access_token": {Type: domain.SchemaTypeString, Title: "Access token", Secret: true},
+		"username":     {Type: domain.SchemaTypeString, Title: "Username"},
+		"password":     {Type: domain.SchemaTypeString, Title: "Password", Secret: true},
+	},
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
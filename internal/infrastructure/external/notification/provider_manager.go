@@ -61,3 +61,33 @@ func (pm *NotificationProviderManager) GetSupportedChannels() []domain.Notificat
 	}
 	return channelTypes
 }
+
+// ValidateConfig checks a channel config against the schema declared by the provider of channelType.
+func (pm *NotificationProviderManager) ValidateConfig(channelType domain.NotificationChannelType, config map[string]any) error {
+	provider, exists := pm.providers[channelType]
+	if !exists {
+		return errors2.BadRequest("notification channel type is not supported").
+			WithDetail("channel_type", channelType)
+	}
+	return provider.ConfigSchema().Validate(config)
+}
+
+// ValidateOverrides checks per-follow config overrides against the schema of the provider of channelType
+// and reports offending fields under the prefix field.
+func (pm *NotificationProviderManager) ValidateOverrides(channelType domain.NotificationChannelType, field string, overrides map[string]any) error {
+	provider, exists := pm.providers[channelType]
+	if !exists {
+		return errors2.BadRequest("notification channel type is not supported").
+			WithDetail("channel_type", channelType)
+	}
+	return provider.ConfigSchema().ValidateOverrides(field, overrides)
+}
+
+// SecretFields lists the config fields the provider of channelType marks as secret.
+func (pm *NotificationProviderManager) SecretFields(channelType domain.NotificationChannelType) []string {
+	provider, exists := pm.providers[channelType]
+	if !exists {
+		return nil
+	}
+	return provider.ConfigSchema().SecretFields()
+}
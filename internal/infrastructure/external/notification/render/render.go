@@ -0,0 +1,105 @@
+package render
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/tmpl"
+)
+
+// Config keys a channel can use to override the default layouts. A layout runs at send time, after the
+// channel and follow message templates have worded the notification, and arranges the result for the
+// target: it sees .Title and .Content as those templates rendered them, plus the links and metadata of
+// the notification.
+const (
+	TitleLayoutKey = "title_layout"
+	BodyLayoutKey  = "body_layout"
+)
+
+const (
+	DefaultTitleLayout = `{{.Title}}`
+
+	DefaultMarkdownLayout = `### {{.Title}}
+{{if .Content}}
+{{.Content}}
+{{end}}{{if .URL}}
+[{{.URL}}]({{.URL}})
+{{end}}`
+
+	DefaultTextLayout = `{{.Title}}{{if .Content}}
+{{.Content}}{{end}}{{if .URL}}
+{{.URL}}{{end}}`
+
+	DefaultContentLayout = `{{.Content}}`
+)
+
+// Title lays out the notification title with the channel layout, falling back to the plain title.
+func Title(cfg map[string]any, data *external.NotificationData) (string, error) {
+	return renderWithConfig(cfg, TitleLayoutKey, DefaultTitleLayout, data)
+}
+
+// Markdown renders the notification body as markdown for chat robots that support it.
+func Markdown(cfg map[string]any, data *external.NotificationData) (string, error) {
+	return renderWithConfig(cfg, BodyLayoutKey, DefaultMarkdownLayout, data)
+}
+
+// Text renders the notification body as plain text.
+func Text(cfg map[string]any, data *external.NotificationData) (string, error) {
+	return renderWithConfig(cfg, BodyLayoutKey, DefaultTextLayout, data)
+}
+
+// Content renders the notification body for targets that show the title and link on their own, e.g.
+// news cards. A custom body layout still sees the whole notification.
+func Content(cfg map[string]any, data *external.NotificationData) (string, error) {
+	return renderWithConfig(cfg, BodyLayoutKey, DefaultContentLayout, data)
+}
+
+var engine = tmpl.Engine{MissingKey: "zero"}
+
+// Render executes a text/template source against the notification data.
+func Render(source string, data *external.NotificationData) (string, error) {
+	tpl, err := engine.Parse("notification", source)
+	if err != nil {
+		return "", errors2.BadRequest("notification template is invalid").Wrap(err)
+	}
+	rendered, err := tpl.Execute(context.Background(), data)
+	if err != nil {
+		return "", errors2.BadRequest("failed to render notification template").Wrap(err)
+	}
+	return rendered, nil
+}
+
+func renderWithConfig(cfg map[string]any, key, fallback string, data *external.NotificationData) (string, error) {
+	source := fallback
+	if v, ok := cfg[key].(string); ok && strings.TrimSpace(v) != "" {
+		source = v
+	}
+	rendered, err := Render(source, data)
+	if err != nil {
+		if appErr := errors2.GetAppError(err); appErr != nil {
+			return "", appErr.WithDetail("template", key)
+		}
+		return "", err
+	}
+	return rendered, nil
+}
+
+// LayoutProperties describes the layout config keys for providers that render through this package.
+func LayoutProperties() map[string]*domain.ChannelConfigSchema {
+	return map[string]*domain.ChannelConfigSchema{
+		TitleLayoutKey: {
+			Type:        domain.SchemaTypeString,
+			Title:       "Title layout",
+			Description: "text/template source laying out the message title, executed against the notification data after the message templates",
+			Default:     DefaultTitleLayout,
+		},
+		BodyLayoutKey: {
+			Type:        domain.SchemaTypeString,
+			Title:       "Body layout",
+			Description: "text/template source laying out the message body, executed against the notification data after the message templates",
+		},
+	}
+}
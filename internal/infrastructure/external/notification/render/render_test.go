@@ -0,0 +1,57 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaults(t *testing.T) {
+	data := &external.NotificationData{
+		Title:   "Streamer is live now!",
+		Content: "Playing Game",
+		URL:     "https://live.example/1001",
+	}
+
+	title, err := Title(nil, data)
+	require.NoError(t, err)
+	require.Equal(t, "Streamer is live now!", title)
+
+	markdown, err := Markdown(nil, data)
+	require.NoError(t, err)
+	require.Equal(t, "### Streamer is live now!\n\nPlaying Game\n\n[https://live.example/1001](https://live.example/1001)", markdown)
+
+	text, err := Text(nil, data)
+	require.NoError(t, err)
+	require.Equal(t, "Streamer is live now!\nPlaying Game\nhttps://live.example/1001", text)
+}
+
+func TestConfigOverrides(t *testing.T) {
+	data := &external.NotificationData{Title: "Live", Content: "Body"}
+	cfg := map[string]any{
+		TitleLayoutKey: "[Fusion] {{.Title}}",
+		BodyLayoutKey:  "**{{.Content}}**",
+	}
+
+	title, err := Title(cfg, data)
+	require.NoError(t, err)
+	require.Equal(t, "[Fusion] Live", title)
+
+	body, err := Markdown(cfg, data)
+	require.NoError(t, err)
+	require.Equal(t, "**Body**", body)
+}
+
+func TestInvalidTemplate(t *testing.T) {
+	_, err := Title(map[string]any{TitleLayoutKey: "{{.Title"}, &external.NotificationData{})
+	appErr := errors2.GetAppError(err)
+	require.NotNil(t, appErr)
+	require.Equal(t, errors2.ErrCodeBadRequest, appErr.Code)
+	require.Equal(t, TitleLayoutKey, appErr.Details["template"])
+
+	// Templates come from channel config, so their execution is bounded.
+	_, err = Markdown(map[string]any{BodyLayoutKey: "{{range 100000000000}}{{end}}"}, &external.NotificationData{})
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
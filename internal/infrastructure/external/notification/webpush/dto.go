@@ -0,0 +1,19 @@
+package webpush
+
+// Payload is the JSON document delivered to the service worker, which shows it via showNotification().
+type Payload struct {
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Icon  string `json:"icon,omitempty"`
+	Image string `json:"image,omitempty"`
+	Tag   string `json:"tag,omitempty"`
+	// Actions are handed to showNotification(); the service worker opens the URL of the one clicked.
+	Actions []PayloadAction `json:"actions,omitempty"`
+}
+
+type PayloadAction struct {
+	Action string `json:"action"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+}
@@ -0,0 +1,75 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// decrypt is the user agent side of RFC 8291, used to verify what encrypt produces.
+func decrypt(t *testing.T, body []byte, uaPrivate *ecdh.PrivateKey, authSecret []byte) []byte {
+	t.Helper()
+	require.Greater(t, len(body), saltLength+5)
+
+	salt := body[:saltLength]
+	require.Equal(t, uint32(recordSize), binary.BigEndian.Uint32(body[saltLength:saltLength+4]))
+	idLength := int(body[saltLength+4])
+	asPublic := body[saltLength+5 : saltLength+5+idLength]
+	ciphertext := body[saltLength+5+idLength:]
+
+	asKey, err := ecdh.P256().NewPublicKey(asPublic)
+	require.NoError(t, err)
+	sharedSecret, err := uaPrivate.ECDH(asKey)
+	require.NoError(t, err)
+
+	prkKey, err := hkdf.Extract(sha256.New, sharedSecret, authSecret)
+	require.NoError(t, err)
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPrivate.PublicKey().Bytes()...), asPublic...)
+	ikm, err := hkdf.Expand(sha256.New, prkKey, string(keyInfo), 32)
+	require.NoError(t, err)
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	require.NoError(t, err)
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	require.NoError(t, err)
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(cek)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	record, err := gcm.Open(nil, nonce, ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), record[len(record)-1])
+	return record[:len(record)-1]
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	t.Parallel()
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+
+	plaintext := []byte(`{"title":"Streamer is live"}`)
+	body, err := encrypt(plaintext, uaPrivate.PublicKey().Bytes(), authSecret)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypt(t, body, uaPrivate, authSecret))
+}
+
+func TestEncryptRejectsOversizedPayload(t *testing.T) {
+	t.Parallel()
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = encrypt(make([]byte, MaxPayloadSize+1), uaPrivate.PublicKey().Bytes(), make([]byte, 16))
+	require.Error(t, err)
+}
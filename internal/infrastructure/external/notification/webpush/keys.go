@@ -0,0 +1,159 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const vapidSettingKey = "webpush.vapid_keys"
+
+// VAPIDKeys is the application server key pair used to sign push requests (RFC 8292).
+// Both keys are base64url encoded without padding, the format browsers expect for applicationServerKey.
+type VAPIDKeys struct {
+	PublicKey  string `json:"public_key"`
+	PrivateKey string `json:"private_key"`
+
+	signingKey *ecdsa.PrivateKey
+}
+
+// KeyStore resolves VAPID keys from configuration or, when absent, generates them once and persists them.
+type KeyStore struct {
+	cfg    config.WebPushConfig
+	repo   coreRepo.SystemSettingRepository
+	logger *zap.Logger
+
+	mu   sync.Mutex
+	keys *VAPIDKeys
+}
+
+func NewKeyStore(cfg *config.Config, repo coreRepo.SystemSettingRepository, logger *zap.Logger) *KeyStore {
+	return &KeyStore{
+		cfg:    cfg.Notification.WebPush,
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *KeyStore) Keys(ctx context.Context) (*VAPIDKeys, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keys != nil {
+		return s.keys, nil
+	}
+
+	keys, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.keys = keys
+	return keys, nil
+}
+
+func (s *KeyStore) load(ctx context.Context) (*VAPIDKeys, error) {
+	if s.cfg.VAPIDPublicKey != "" || s.cfg.VAPIDPrivateKey != "" {
+		keys, err := ParseVAPIDKeys(s.cfg.VAPIDPublicKey, s.cfg.VAPIDPrivateKey)
+		if err != nil {
+			return nil, errors2.Internal(fmt.Errorf("configured vapid keys are invalid: %w", err))
+		}
+		return keys, nil
+	}
+
+	keys, err := s.loadPersisted(ctx)
+	if err == nil || !errors2.IsNotFoundError(err) {
+		return keys, err
+	}
+
+	generated, err := GenerateVAPIDKeys()
+	if err != nil {
+		return nil, errors2.Internal(err)
+	}
+	value, err := json.Marshal(generated)
+	if err != nil {
+		return nil, errors2.Internal(err)
+	}
+	if _, err := s.repo.Create(ctx, &domain.SystemSetting{Key: vapidSettingKey, Value: string(value)}); err != nil {
+		// Another instance may have generated the keys concurrently; prefer the persisted pair.
+		if appErr := errors2.GetAppError(err); appErr != nil && appErr.Code == errors2.ErrCodeConflict {
+			return s.loadPersisted(ctx)
+		}
+		return nil, err
+	}
+	s.logger.Info("generated web push vapid keys")
+	return generated, nil
+}
+
+func (s *KeyStore) loadPersisted(ctx context.Context) (*VAPIDKeys, error) {
+	setting, err := s.repo.FindByKey(ctx, vapidSettingKey)
+	if err != nil {
+		return nil, err
+	}
+	var stored VAPIDKeys
+	if err := json.Unmarshal([]byte(setting.Value), &stored); err != nil {
+		return nil, errors2.Internal(fmt.Errorf("stored vapid keys are corrupted: %w", err))
+	}
+	keys, err := ParseVAPIDKeys(stored.PublicKey, stored.PrivateKey)
+	if err != nil {
+		return nil, errors2.Internal(fmt.Errorf("stored vapid keys are invalid: %w", err))
+	}
+	return keys, nil
+}
+
+// GenerateVAPIDKeys creates a new P-256 key pair.
+func GenerateVAPIDKeys() (*VAPIDKeys, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return newVAPIDKeys(privateKey)
+}
+
+// ParseVAPIDKeys validates a base64url encoded key pair and makes sure both halves belong together.
+func ParseVAPIDKeys(publicKey, privateKey string) (*VAPIDKeys, error) {
+	rawPrivate, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(privateKey, "="))
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+	signingKey, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), rawPrivate)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	keys, err := newVAPIDKeys(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(keys.PublicKey), []byte(strings.TrimRight(publicKey, "="))) != 1 {
+		return nil, fmt.Errorf("public key does not match private key")
+	}
+	return keys, nil
+}
+
+func newVAPIDKeys(signingKey *ecdsa.PrivateKey) (*VAPIDKeys, error) {
+	ecdhKey, err := signingKey.ECDH()
+	if err != nil {
+		return nil, err
+	}
+	rawPrivate, err := signingKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return &VAPIDKeys{
+		PublicKey:  base64.RawURLEncoding.EncodeToString(ecdhKey.PublicKey().Bytes()),
+		PrivateKey: base64.RawURLEncoding.EncodeToString(rawPrivate),
+		signingKey: signingKey,
+	}, nil
+}
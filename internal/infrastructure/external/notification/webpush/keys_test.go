@@ -0,0 +1,58 @@
+package webpush
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseVAPIDKeysRejectsMismatchedPair(t *testing.T) {
+	t.Parallel()
+	first, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	second, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+
+	parsed, err := ParseVAPIDKeys(first.PublicKey, first.PrivateKey)
+	require.NoError(t, err)
+	require.Equal(t, first.PublicKey, parsed.PublicKey)
+
+	_, err = ParseVAPIDKeys(second.PublicKey, first.PrivateKey)
+	require.Error(t, err)
+}
+
+func TestKeyStoreGeneratesAndPersistsKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := repoMocks.NewMockSystemSettingRepository(t)
+
+	var stored string
+	repo.EXPECT().FindByKey(ctx, vapidSettingKey).Return(nil, errors2.NotFound("system setting")).Once()
+	repo.EXPECT().Create(ctx, mock.MatchedBy(func(setting *domain.SystemSetting) bool {
+		stored = setting.Value
+		return setting.Key == vapidSettingKey
+	})).RunAndReturn(func(_ context.Context, setting *domain.SystemSetting) (*domain.SystemSetting, error) {
+		return setting, nil
+	}).Once()
+
+	store := NewKeyStore(&config.Config{}, repo, zap.NewNop())
+	keys, err := store.Keys(ctx)
+	require.NoError(t, err)
+
+	var persisted VAPIDKeys
+	require.NoError(t, json.Unmarshal([]byte(stored), &persisted))
+	require.Equal(t, keys.PublicKey, persisted.PublicKey)
+
+	// Cached after the first lookup.
+	again, err := store.Keys(ctx)
+	require.NoError(t, err)
+	require.Same(t, keys, again)
+}
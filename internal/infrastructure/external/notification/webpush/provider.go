@@ -0,0 +1,244 @@
+package webpush
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/client"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const (
+	DefaultTTL     = 24 * time.Hour
+	DefaultSubject = "mailto:admin@example.com"
+
+	subscriptionBatchSize = 100
+	vapidTokenLifetime    = 12 * time.Hour
+)
+
+var allowedUrgencies = map[string]struct{}{
+	"very-low": {},
+	"low":      {},
+	"normal":   {},
+	"high":     {},
+}
+
+type Provider struct {
+	logger  *zap.Logger
+	client  *resty.Client
+	keys    *KeyStore
+	repo    coreRepo.WebPushSubscriptionRepository
+	subject string
+	ttl     time.Duration
+}
+
+func NewProvider(cfg *config.Config, keys *KeyStore, repo coreRepo.WebPushSubscriptionRepository, logger *zap.Logger) *Provider {
+	subject := cfg.Notification.WebPush.Subject
+	if strings.TrimSpace(subject) == "" {
+		subject = DefaultSubject
+	}
+	ttl := cfg.Notification.WebPush.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Provider{
+		logger:  logger,
+		client:  client.NewRestyClient(logger),
+		keys:    keys,
+		repo:    repo,
+		subject: subject,
+		ttl:     ttl,
+	}
+}
+
+func (p *Provider) GetChannelType() domain.NotificationChannelType {
+	return domain.ChannelTypeWebPush
+}
+
+// Send delivers the notification to every browser the channel owner subscribed with.
+// Subscriptions rejected by the push service as expired are removed.
+func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel, data *external.NotificationData) error {
+	urgency := "normal"
+	if v, ok := channel.Config["urgency"].(string); ok && strings.TrimSpace(v) != "" {
+		if _, allowed := allowedUrgencies[v]; !allowed {
+			return errors2.BadRequest("urgency is invalid").WithDetail("urgency", v)
+		}
+		urgency = v
+	}
+
+	keys, err := p.keys.Keys(ctx)
+	if err != nil {
+		return err
+	}
+
+	actions := make([]PayloadAction, len(data.Actions))
+	for i, action := range data.Actions {
+		actions[i] = PayloadAction{Action: string(action.Type), Title: action.Label, URL: action.URL}
+	}
+	payload, err := json.Marshal(Payload{
+		Title:   data.Title,
+		Body:    data.Content,
+		URL:     data.URL,
+		Icon:    data.IconURL,
+		Image:   data.ImageURL,
+		Actions: actions,
+	})
+	if err != nil {
+		return errors2.Internal(err)
+	}
+
+	subscriptions, err := p.listSubscriptions(ctx, channel.UserID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sent    int
+		lastErr error
+	)
+	for _, subscription := range subscriptions {
+		gone, err := p.push(ctx, keys, subscription, payload, urgency)
+		if gone {
+			p.logger.Info("removing expired web push subscription",
+				zap.Int64("subscription_id", subscription.ID),
+				zap.Int64("user_id", subscription.UserID))
+			if err := p.repo.Delete(ctx, subscription.ID); err != nil && !errors2.IsNotFoundError(err) {
+				p.logger.Warn("failed to remove expired web push subscription",
+					zap.Int64("subscription_id", subscription.ID),
+					zap.Error(err))
+			}
+			continue
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		return nil
+	}
+	if lastErr != nil {
+		return lastErr
+	}
+	return errors2.BadRequest("no active web push subscriptions").WithDetail("user_id", channel.UserID)
+}
+
+// TestConnection cannot reach a browser without a saved subscription, so it only verifies the server keys.
+func (p *Provider) TestConnection(ctx context.Context, config map[string]any) error {
+	if _, err := p.keys.Keys(ctx); err != nil {
+		return err
+	}
+	return errors2.BadRequest("web push channels can only be tested after subscribing a browser")
+}
+
+func (p *Provider) listSubscriptions(ctx context.Context, userID int64) ([]*domain.WebPushSubscription, error) {
+	var (
+		results []*domain.WebPushSubscription
+		offset  int
+	)
+	for {
+		batch, total, err := p.repo.ListByUserId(ctx, userID, offset, subscriptionBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, batch...)
+		offset += len(batch)
+		if len(batch) == 0 || offset >= total {
+			break
+		}
+	}
+	return results, nil
+}
+
+// push sends one encrypted message and reports whether the subscription no longer exists.
+func (p *Provider) push(ctx context.Context, keys *VAPIDKeys, subscription *domain.WebPushSubscription, payload []byte, urgency string) (bool, error) {
+	uaPublic, err := domain.DecodeWebPushKey(subscription.P256dh)
+	if err != nil {
+		return false, errors2.BadRequest("push subscription p256dh key is invalid").Wrap(err)
+	}
+	authSecret, err := domain.DecodeWebPushKey(subscription.Auth)
+	if err != nil {
+		return false, errors2.BadRequest("push subscription auth secret is invalid").Wrap(err)
+	}
+	body, err := encrypt(payload, uaPublic, authSecret)
+	if err != nil {
+		return false, errors2.BadRequest("failed to encrypt web push payload").Wrap(err)
+	}
+
+	authorization, err := p.vapidAuthorization(keys, subscription.Endpoint)
+	if err != nil {
+		return false, err
+	}
+
+	response, err := p.client.R().
+		SetContext(ctx).
+		SetHeader("Authorization", authorization).
+		SetHeader("Content-Encoding", "aes128gcm").
+		SetHeader("Content-Type", "application/octet-stream").
+		SetHeader("TTL", strconv.Itoa(int(p.ttl.Seconds()))).
+		SetHeader("Urgency", urgency).
+		SetBody(body).
+		Post(subscription.Endpoint)
+	if err != nil {
+		err = client.RedactError(err)
+		p.logger.Error("Failed to send web push notification", zap.Error(err))
+		return false, errors2.Internal(err)
+	}
+
+	switch status := response.StatusCode(); {
+	case status >= 200 && status <= 299:
+		return false, nil
+	case status == http.StatusNotFound || status == http.StatusGone:
+		return true, nil
+	default:
+		body := response.String()
+		if body == "" {
+			body = "no response body"
+		}
+		p.logger.Error("Failed to send web push notification",
+			zap.Int64("subscription_id", subscription.ID),
+			zap.Int("status", status),
+			zap.String("body", body),
+		)
+		return false, errors2.BadRequest("push service returned non-success status").
+			WithDetails(map[string]any{
+				"status": status,
+				"body":   body,
+			})
+	}
+}
+
+// vapidAuthorization builds the "vapid" Authorization header defined by RFC 8292.
+func (p *Provider) vapidAuthorization(keys *VAPIDKeys, endpoint string) (string, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors2.BadRequest("push subscription endpoint is invalid").Wrap(err)
+	}
+	// RegisteredClaims would encode "aud" as an array, which push services reject; RFC 8292 wants the origin
+	// as a plain string.
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host),
+		"exp": time.Now().Add(vapidTokenLifetime).Unix(),
+		"sub": p.subject,
+	})
+	signed, err := token.SignedString(keys.signingKey)
+	if err != nil {
+		return "", errors2.Internal(err)
+	}
+	return fmt.Sprintf("vapid t=%s, k=%s", signed, keys.PublicKey), nil
+}
@@ -0,0 +1,171 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	repoMocks "github.com/ryuyb/fusion/internal/core/port/repository"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testSubscriber struct {
+	private *ecdh.PrivateKey
+	auth    []byte
+}
+
+func newTestSubscriber(t *testing.T) *testSubscriber {
+	t.Helper()
+	private, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+	return &testSubscriber{private: private, auth: auth}
+}
+
+func (s *testSubscriber) subscription(id int64, endpoint string) *domain.WebPushSubscription {
+	return &domain.WebPushSubscription{
+		ID:       id,
+		UserID:   7,
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(s.private.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(s.auth),
+	}
+}
+
+func newTestProvider(t *testing.T, repo *repoMocks.MockWebPushSubscriptionRepository) *Provider {
+	t.Helper()
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	cfg := &config.Config{Notification: config.NotificationConfig{WebPush: config.WebPushConfig{
+		VAPIDPublicKey:  keys.PublicKey,
+		VAPIDPrivateKey: keys.PrivateKey,
+	}}}
+	return NewProvider(cfg, NewKeyStore(cfg, nil, zap.NewNop()), repo, zap.NewNop())
+}
+
+var mockNotificationData = external.NotificationData{
+	Title:   "Streamer is live",
+	Content: "Playing games",
+	URL:     "https://live.example/room/1",
+	IconURL: "https://live.example/avatar.png",
+}
+
+func TestSendEncryptsPayload(t *testing.T) {
+	t.Parallel()
+	subscriber := newTestSubscriber(t)
+
+	var received Payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		require.Equal(t, "86400", r.Header.Get("TTL"))
+		require.Equal(t, "high", r.Header.Get("Urgency"))
+		require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "vapid t="))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(decrypt(t, body, subscriber.private, subscriber.auth), &received))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(server.Close)
+
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	repo.EXPECT().ListByUserId(context.Background(), int64(7), 0, subscriptionBatchSize).
+		Return([]*domain.WebPushSubscription{subscriber.subscription(1, server.URL)}, 1, nil)
+
+	provider := newTestProvider(t, repo)
+	err := provider.Send(context.Background(), &domain.NotificationChannel{
+		UserID: 7,
+		Config: map[string]any{"urgency": "high"},
+	}, &mockNotificationData)
+	require.NoError(t, err)
+	require.Equal(t, Payload{
+		Title: mockNotificationData.Title,
+		Body:  mockNotificationData.Content,
+		URL:   mockNotificationData.URL,
+		Icon:  mockNotificationData.IconURL,
+	}, received)
+}
+
+func TestVAPIDAuthorizationClaims(t *testing.T) {
+	t.Parallel()
+	provider := newTestProvider(t, nil)
+	keys, err := provider.keys.Keys(context.Background())
+	require.NoError(t, err)
+
+	authorization, err := provider.vapidAuthorization(keys, "https://push.example:8443/send/abc?x=1")
+	require.NoError(t, err)
+	token, ok := strings.CutPrefix(authorization, "vapid t=")
+	require.True(t, ok)
+	token, _, _ = strings.Cut(token, ",")
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	raw, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(raw, &claims))
+	require.Equal(t, "https://push.example:8443", claims["aud"])
+	require.Equal(t, DefaultSubject, claims["sub"])
+	require.IsType(t, float64(0), claims["exp"])
+}
+
+func TestSendRemovesExpiredSubscription(t *testing.T) {
+	t.Parallel()
+	subscriber := newTestSubscriber(t)
+
+	gone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	t.Cleanup(gone.Close)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(ok.Close)
+
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	repo.EXPECT().ListByUserId(context.Background(), int64(7), 0, subscriptionBatchSize).
+		Return([]*domain.WebPushSubscription{
+			subscriber.subscription(1, gone.URL),
+			subscriber.subscription(2, ok.URL),
+		}, 2, nil)
+	repo.EXPECT().Delete(context.Background(), int64(1)).Return(nil)
+
+	provider := newTestProvider(t, repo)
+	err := provider.Send(context.Background(), &domain.NotificationChannel{UserID: 7}, &mockNotificationData)
+	require.NoError(t, err)
+}
+
+func TestSendWithoutSubscriptions(t *testing.T) {
+	t.Parallel()
+	repo := repoMocks.NewMockWebPushSubscriptionRepository(t)
+	repo.EXPECT().ListByUserId(context.Background(), int64(7), 0, subscriptionBatchSize).
+		Return([]*domain.WebPushSubscription{}, 0, nil)
+
+	provider := newTestProvider(t, repo)
+	err := provider.Send(context.Background(), &domain.NotificationChannel{UserID: 7}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
+
+func TestSendInvalidUrgency(t *testing.T) {
+	t.Parallel()
+	provider := newTestProvider(t, repoMocks.NewMockWebPushSubscriptionRepository(t))
+	err := provider.Send(context.Background(), &domain.NotificationChannel{
+		UserID: 7,
+		Config: map[string]any{"urgency": "urgent"},
+	}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
@@ -0,0 +1,16 @@
+package webpush
+
+import "github.com/ryuyb/fusion/internal/core/domain"
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:        domain.SchemaTypeObject,
+	Title:       "Web Push",
+	Description: "Delivers to every browser the channel owner subscribed with",
+	Properties: map[string]*domain.ChannelConfigSchema{
+		"urgency": {Type: domain.SchemaTypeString, Title: "Urgency", Enum: []any{"very-low", "low", "normal", "high"}, Default: "normal", Overridable: true},
+	},
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
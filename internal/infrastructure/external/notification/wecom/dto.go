@@ -0,0 +1,29 @@
+package wecom
+
+// RobotRequest is the payload accepted by a WeCom group robot webhook.
+// refer: https://developer.work.weixin.qq.com/document/path/91770
+type RobotRequest struct {
+	MsgType  string           `json:"msgtype"`
+	Markdown *MarkdownMessage `json:"markdown,omitempty"`
+	News     *NewsMessage     `json:"news,omitempty"`
+}
+
+type MarkdownMessage struct {
+	Content string `json:"content"`
+}
+
+type NewsMessage struct {
+	Articles []NewsArticle `json:"articles"`
+}
+
+type NewsArticle struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+	PicURL      string `json:"picurl,omitempty"`
+}
+
+type robotResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
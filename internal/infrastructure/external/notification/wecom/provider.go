@@ -0,0 +1,192 @@
+package wecom
+
+import (
+	"cmp"
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	"github.com/ryuyb/fusion/internal/infrastructure/client"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/render"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+const (
+	DefaultWebhookURL = "https://qyapi.weixin.qq.com/cgi-bin/webhook/send"
+
+	MsgTypeMarkdown = "markdown"
+	MsgTypeNews     = "news"
+)
+
+type Provider struct {
+	logger *zap.Logger
+	client *resty.Client
+}
+
+func NewProvider(logger *zap.Logger) *Provider {
+	return &Provider{
+		logger: logger,
+		client: client.NewRestyClient(logger),
+	}
+}
+
+func (p *Provider) GetChannelType() domain.NotificationChannelType {
+	return domain.ChannelTypeWeCom
+}
+
+func (p *Provider) Send(ctx context.Context, channel *domain.NotificationChannel, data *external.NotificationData) error {
+	endpointURL, err := resolveEndpoint(channel.Config)
+	if err != nil {
+		return err
+	}
+
+	req, err := buildRequest(channel.Config, data)
+	if err != nil {
+		return err
+	}
+
+	response, err := p.client.R().
+		SetContext(ctx).
+		SetContentType(fiber.MIMEApplicationJSONCharsetUTF8).
+		SetBody(req).
+		SetResult(&robotResponse{}).
+		Post(endpointURL)
+	if err != nil {
+		err = client.RedactError(err)
+		p.logger.Error("Failed to send wecom notification", zap.Error(err))
+		return errors2.Internal(err)
+	}
+
+	if err := p.checkHTTPStatus(response); err != nil {
+		return err
+	}
+
+	return parseRobotResult(response)
+}
+
+func (p *Provider) TestConnection(ctx context.Context, config map[string]any) error {
+	data := &external.NotificationData{
+		Title:   "Test Notification",
+		Content: "This is a test notification from Fusion",
+	}
+	return p.Send(ctx, &domain.NotificationChannel{Config: config}, data)
+}
+
+func buildRequest(cfg map[string]any, data *external.NotificationData) (RobotRequest, error) {
+	msgType := MsgTypeMarkdown
+	if v, ok := cfg["msg_type"].(string); ok && strings.TrimSpace(v) != "" {
+		msgType = strings.TrimSpace(v)
+	}
+
+	title, err := render.Title(cfg, data)
+	if err != nil {
+		return RobotRequest{}, err
+	}
+
+	switch msgType {
+	case MsgTypeMarkdown:
+		titled := *data
+		titled.Title = title
+		content, err := render.Markdown(cfg, &titled)
+		if err != nil {
+			return RobotRequest{}, err
+		}
+		return RobotRequest{
+			MsgType:  MsgTypeMarkdown,
+			Markdown: &MarkdownMessage{Content: content},
+		}, nil
+	case MsgTypeNews:
+		if data.URL == "" {
+			// News cards require a link; fall back to markdown when there is nothing to open.
+			return buildRequest(withMsgType(cfg, MsgTypeMarkdown), data)
+		}
+		description, err := render.Content(cfg, data)
+		if err != nil {
+			return RobotRequest{}, err
+		}
+		return RobotRequest{
+			MsgType: MsgTypeNews,
+			News: &NewsMessage{Articles: []NewsArticle{{
+				Title:       title,
+				Description: description,
+				URL:         data.URL,
+				PicURL:      cmp.Or(data.ImageURL, data.IconURL),
+			}}},
+		}, nil
+	default:
+		return RobotRequest{}, errors2.BadRequest("msg_type is invalid").WithDetail("msg_type", msgType)
+	}
+}
+
+func withMsgType(cfg map[string]any, msgType string) map[string]any {
+	cloned := make(map[string]any, len(cfg))
+	for k, v := range cfg {
+		cloned[k] = v
+	}
+	cloned["msg_type"] = msgType
+	return cloned
+}
+
+// resolveEndpoint accepts either the full webhook URL copied from WeCom or just the robot key.
+func resolveEndpoint(cfg map[string]any) (string, error) {
+	if webhook, ok := cfg["webhook_url"].(string); ok && strings.TrimSpace(webhook) != "" {
+		if !isValidURL(webhook) {
+			// The webhook URL embeds the robot credential, so it is not echoed back.
+			return "", errors2.BadRequest("webhook_url must be a valid URL").
+				WithDetail("field", "webhook_url")
+		}
+		return webhook, nil
+	}
+	key, ok := cfg["key"].(string)
+	if !ok || strings.TrimSpace(key) == "" {
+		return "", errors2.BadRequest("robot key is invalid").WithDetail("key", cfg["key"])
+	}
+	return DefaultWebhookURL + "?key=" + url.QueryEscape(strings.TrimSpace(key)), nil
+}
+
+func (p *Provider) checkHTTPStatus(response *resty.Response) error {
+	if response.StatusCode() >= 200 && response.StatusCode() <= 299 {
+		return nil
+	}
+
+	body := response.String()
+	if body == "" {
+		body = "no response body"
+	}
+	p.logger.Error("Failed to send wecom notification",
+		zap.Int("status", response.StatusCode()),
+		zap.String("body", body),
+	)
+	return errors2.BadRequest("wecom returned non-success status").
+		WithDetails(map[string]any{
+			"status": response.StatusCode(),
+			"body":   body,
+		})
+}
+
+func parseRobotResult(response *resty.Response) error {
+	if res, ok := response.Result().(*robotResponse); ok && res != nil && res.ErrCode != 0 {
+		return errors2.BadRequest("wecom returned error").
+			WithDetails(map[string]any{
+				"code":    res.ErrCode,
+				"message": res.ErrMsg,
+			})
+	}
+	return nil
+}
+
+func isValidURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return false
+	}
+	return parsed.Host != ""
+}
@@ -0,0 +1,139 @@
+package wecom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/core/port/external"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendValidationErrors(t *testing.T) {
+	t.Parallel()
+	provider := NewProvider(zap.NewNop())
+	ctx := context.Background()
+
+	err := provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+
+	err = provider.Send(ctx, &domain.NotificationChannel{Config: map[string]any{
+		"key":      "abc",
+		"msg_type": "voice",
+	}}, &mockNotificationData)
+	require.Equal(t, errors2.ErrCodeBadRequest, errors2.GetAppError(err).Code)
+}
+
+func TestSendRobotErrorCode(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errcode": 93000,
+			"errmsg":  "invalid webhook url",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url": server.URL,
+	}}, &mockNotificationData)
+
+	require.Error(t, err)
+	appErr := errors2.GetAppError(err)
+	require.Equal(t, errors2.ErrCodeBadRequest, appErr.Code)
+	require.Equal(t, 93000, appErr.Details["code"])
+	require.Equal(t, "invalid webhook url", appErr.Details["message"])
+}
+
+func TestSendNetworkErrorHidesKey(t *testing.T) {
+	t.Parallel()
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url": "http://127.0.0.1:1/cgi-bin/webhook/send?key=SUPERSECRET",
+	}}, &mockNotificationData)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "key=REDACTED")
+	require.NotContains(t, err.Error(), "SUPERSECRET")
+}
+
+func TestSendMarkdown(t *testing.T) {
+	t.Parallel()
+	received := RobotRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url":  server.URL,
+		"title_layout": "<font color=\"warning\">{{.Title}}</font>",
+	}}, &mockNotificationData)
+	require.NoError(t, err)
+
+	require.Equal(t, MsgTypeMarkdown, received.MsgType)
+	require.NotNil(t, received.Markdown)
+	require.Contains(t, received.Markdown.Content, "### <font color=\"warning\">Test</font>")
+	require.Contains(t, received.Markdown.Content, "test content")
+	require.Contains(t, received.Markdown.Content, mockNotificationData.URL)
+}
+
+func TestSendNews(t *testing.T) {
+	t.Parallel()
+	received := RobotRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewProvider(zap.NewNop())
+	err := provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url": server.URL,
+		"msg_type":    MsgTypeNews,
+	}}, &mockNotificationData)
+	require.NoError(t, err)
+
+	require.Equal(t, MsgTypeNews, received.MsgType)
+	require.NotNil(t, received.News)
+	require.Len(t, received.News.Articles, 1)
+	article := received.News.Articles[0]
+	require.Equal(t, "Test", article.Title)
+	require.Equal(t, "test content", article.Description)
+	require.Equal(t, mockNotificationData.URL, article.URL)
+	require.Equal(t, mockNotificationData.IconURL, article.PicURL)
+
+	// A custom body layout renders against the whole notification, as it does for other message types.
+	err = provider.Send(context.Background(), &domain.NotificationChannel{Config: map[string]any{
+		"webhook_url": server.URL,
+		"msg_type":    MsgTypeNews,
+		"body_layout": "{{.Title}}: {{.Content}} {{.URL}}",
+	}}, &mockNotificationData)
+	require.NoError(t, err)
+	require.Equal(t, "Test: test content "+mockNotificationData.URL, received.News.Articles[0].Description)
+}
+
+func TestResolveEndpointFromKey(t *testing.T) {
+	t.Parallel()
+	endpoint, err := resolveEndpoint(map[string]any{"key": "a b"})
+	require.NoError(t, err)
+	require.Equal(t, DefaultWebhookURL+"?key=a+b", endpoint)
+}
+
+var mockNotificationData = external.NotificationData{
+	Title:   "Test",
+	Content: "test content",
+	URL:     "https://live.example/1001",
+	IconURL: "https://live.example/avatar.png",
+}
@@ -0,0 +1,30 @@
+package wecom
+
+import (
+	"maps"
+
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/infrastructure/external/notification/render"
+)
+
+var configSchema = &domain.ChannelConfigSchema{
+	Type:  domain.SchemaTypeObject,
+	Title: "WeCom robot",
+	AnyOf: []*domain.ChannelConfigSchema{
+		{Required: []string{"key"}},
+		{Required: []string{"webhook_url"}},
+	},
+	Properties: func() map[string]*domain.ChannelConfigSchema {
+		properties := map[string]*domain.ChannelConfigSchema{
+			"key":         {Type: domain.SchemaTypeString, Title: "Robot key", Secret: true},
+			"webhook_url": {Type: domain.SchemaTypeString, Format: domain.SchemaFormatURI, Title: "Webhook URL", Description: "Full webhook URL, used instead of the key", Secret: true},
+			"msg_type":    {Type: domain.SchemaTypeString, Title: "Message type", Enum: []any{MsgTypeMarkdown, MsgTypeNews}, Default: MsgTypeMarkdown, Overridable: true},
+		}
+		maps.Copy(properties, render.LayoutProperties())
+		return properties
+	}(),
+}
+
+func (p *Provider) ConfigSchema() *domain.ChannelConfigSchema {
+	return configSchema
+}
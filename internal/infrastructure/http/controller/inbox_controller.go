@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+)
+
+type InboxController struct {
+	service coreService.InboxService
+}
+
+func NewInboxController(service coreService.InboxService) *InboxController {
+	return &InboxController{service: service}
+}
+
+// ListByUser lists a user's inbox messages, newest first
+//
+//	@Summary	List Inbox Messages By User
+//	@Tags		Inbox
+//	@Produce	json
+//	@Param		user_id		path	int		true	"User ID"
+//	@Param		unread		query	bool	false	"Only unread messages"
+//	@Param		page		query	int		false	"Page"		default(1)
+//	@Param		page_size	query	int		false	"Page size"	default(10)
+//	@Security	Bearer
+//	@Success	200	{object}	dto.PaginationResponse[dto.InboxMessageResponse]
+//	@Router		/inbox/users/{user_id} [get]
+func (c *InboxController) ListByUser(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	query := new(dto.ListInboxMessagesQuery)
+	if err := ctx.Bind().Query(query); err != nil {
+		return errors.BadRequest("invalid query parameters").Wrap(err)
+	}
+	page, pageSize := util.ParsePagination(ctx)
+	messages, total, err := c.service.ListByUserId(ctx, userID, query.Unread, page, pageSize)
+	if err != nil {
+		return err
+	}
+	items := make([]*dto.InboxMessageResponse, len(messages))
+	for i, message := range messages {
+		items[i] = c.toResponse(message)
+	}
+	return ctx.JSON(dto.NewPaginationResponse(items, total, page, pageSize))
+}
+
+// UnreadCount counts a user's unread inbox messages
+//
+//	@Summary	Count Unread Inbox Messages
+//	@Tags		Inbox
+//	@Produce	json
+//	@Param		user_id	path	int	true	"User ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.InboxUnreadCountResponse
+//	@Router		/inbox/users/{user_id}/unread-count [get]
+func (c *InboxController) UnreadCount(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	unread, err := c.service.CountUnread(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(&dto.InboxUnreadCountResponse{Unread: unread})
+}
+
+// MarkRead marks an inbox message read
+//
+//	@Summary	Mark Inbox Message Read
+//	@Tags		Inbox
+//	@Produce	json
+//	@Param		id	path	int	true	"Message ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.InboxMessageResponse
+//	@Router		/inbox/{id}/read [post]
+func (c *InboxController) MarkRead(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid inbox message id").Wrap(err)
+	}
+	message, err := c.service.MarkRead(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(message))
+}
+
+// MarkAllRead marks every unread inbox message of a user read
+//
+//	@Summary	Mark All Inbox Messages Read
+//	@Tags		Inbox
+//	@Produce	json
+//	@Param		user_id	path	int	true	"User ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.InboxMarkAllReadResponse
+//	@Router		/inbox/users/{user_id}/read-all [post]
+func (c *InboxController) MarkAllRead(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	marked, err := c.service.MarkAllRead(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(&dto.InboxMarkAllReadResponse{Marked: marked})
+}
+
+func (c *InboxController) toResponse(message *domain.InboxMessage) *dto.InboxMessageResponse {
+	return &dto.InboxMessageResponse{
+		ID:         message.ID,
+		UserID:     message.UserID,
+		ChannelID:  message.ChannelID,
+		StreamerID: message.StreamerID,
+		EventType:  string(message.EventType),
+		Severity:   string(message.Severity),
+		Title:      message.Title,
+		Content:    message.Content,
+		URL:        message.URL,
+		ImageURL:   message.ImageURL,
+		IconURL:    message.IconURL,
+		Read:       message.IsRead(),
+		ReadAt:     message.ReadAt,
+		CreatedAt:  message.CreatedAt,
+	}
+}
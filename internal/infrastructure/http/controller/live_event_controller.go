@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/ryuyb/fusion/internal/pkg/auth"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultEventsHeartbeat = 25 * time.Second
+	// eventsWriteWait bounds a single write to a stream, so a client that stopped reading is dropped.
+	eventsWriteWait = 10 * time.Second
+)
+
+type LiveEventController struct {
+	service   coreService.LiveEventService
+	heartbeat time.Duration
+	upgrader  websocket.FastHTTPUpgrader
+	logger    *zap.Logger
+}
+
+func NewLiveEventController(cfg *config.Config, service coreService.LiveEventService, logger *zap.Logger) *LiveEventController {
+	heartbeat := cfg.Events.Heartbeat
+	if heartbeat <= 0 {
+		heartbeat = defaultEventsHeartbeat
+	}
+	return &LiveEventController{
+		service:   service,
+		heartbeat: heartbeat,
+		upgrader: websocket.FastHTTPUpgrader{
+			// The stream is authenticated by a bearer token rather than cookies, so any origin the API
+			// serves may connect, as with CORS.
+			CheckOrigin: func(*fasthttp.RequestCtx) bool { return true },
+		},
+		logger: logger,
+	}
+}
+
+// Stream pushes the current user's events as they happen
+//
+//	@Summary		Stream Events
+//	@Description	Server-Sent Events by default; requests asking for a WebSocket upgrade get one instead.
+//	@Description	Reconnecting with the Last-Event-ID header, or last_event_id for WebSockets, replays missed events that are still kept.
+//	@Tags			Events
+//	@Produce		text/event-stream
+//	@Param			Last-Event-ID	header	int		false	"Last event ID seen"
+//	@Param			last_event_id	query	int		false	"Last event ID seen, for clients that cannot set headers"
+//	@Param			access_token	query	string	false	"Access token, for clients that cannot set headers"
+//	@Security		Bearer
+//	@Success		200	{object}	dto.LiveEventResponse
+//	@Router			/events/stream [get]
+func (c *LiveEventController) Stream(ctx fiber.Ctx) error {
+	userID, ok := auth.GetCurrentUserId(ctx)
+	if !ok {
+		return errors.Unauthorized("Missing Authorization header")
+	}
+	lastEventID, err := c.lastEventID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if websocket.FastHTTPIsWebSocketUpgrade(ctx.RequestCtx()) {
+		// The upgrader answers a failed handshake itself.
+		if err := c.upgrader.Upgrade(ctx.RequestCtx(), func(conn *websocket.Conn) {
+			c.serveWebSocket(conn, userID, lastEventID)
+		}); err != nil {
+			c.logger.Debug("websocket upgrade failed", zap.Int64("user_id", userID), zap.Error(err))
+		}
+		return nil
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	// Keeps nginx from buffering the stream.
+	ctx.Set("X-Accel-Buffering", "no")
+	conn := ctx.RequestCtx().Conn()
+	return ctx.SendStreamWriter(func(w *bufio.Writer) {
+		c.serveSSE(conn, w, userID, lastEventID)
+	})
+}
+
+func (c *LiveEventController) lastEventID(ctx fiber.Ctx) (int64, error) {
+	value := ctx.Get("Last-Event-ID")
+	if value == "" {
+		value = ctx.Query("last_event_id")
+	}
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.BadRequest("invalid last event id").Wrap(err)
+	}
+	return id, nil
+}
+
+// serveSSE writes events until the client goes away or the subscription ends. The server's write
+// timeout is applied once per response, so each write pushes the connection deadline out again.
+func (c *LiveEventController) serveSSE(conn net.Conn, w *bufio.Writer, userID, lastEventID int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	replay, events := c.service.Subscribe(ctx, userID, lastEventID)
+
+	flush := func() bool {
+		if err := conn.SetWriteDeadline(time.Now().Add(eventsWriteWait)); err != nil {
+			return false
+		}
+		return w.Flush() == nil
+	}
+
+	// The retry field tells EventSource how long to wait before reconnecting.
+	if _, err := w.WriteString("retry: 3000\n\n"); err != nil || !flush() {
+		return
+	}
+	for _, event := range replay {
+		if err := writeSSEEvent(w, event); err != nil {
+			return
+		}
+	}
+	if !flush() {
+		return
+	}
+
+	ticker := time.NewTicker(c.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeSSEEvent(w, event); err != nil || !flush() {
+				return
+			}
+		case <-ticker.C:
+			if _, err := w.WriteString(": ping\n\n"); err != nil || !flush() {
+				return
+			}
+		}
+	}
+}
+
+func writeSSEEvent(w *bufio.Writer, event *domain.LiveEvent) error {
+	data, err := sonic.Marshal(toLiveEventResponse(event))
+	if err != nil {
+		return err
+	}
+	id := strconv.FormatInt(event.ID, 10)
+	_, err = w.WriteString("id: " + id + "\nevent: " + string(event.Type) + "\ndata: " + string(data) + "\n\n")
+	return err
+}
+
+// serveWebSocket writes events until the client goes away or the subscription ends. Clients only
+// listen; what they send is read to notice pongs and the connection closing.
+func (c *LiveEventController) serveWebSocket(conn *websocket.Conn, userID, lastEventID int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func() { _ = conn.Close() }()
+	replay, events := c.service.Subscribe(ctx, userID, lastEventID)
+
+	conn.SetReadLimit(512)
+	_ = conn.SetReadDeadline(time.Now().Add(2 * c.heartbeat))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * c.heartbeat))
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	write := func(event *domain.LiveEvent) bool {
+		if err := conn.SetWriteDeadline(time.Now().Add(eventsWriteWait)); err != nil {
+			return false
+		}
+		data, err := sonic.Marshal(toLiveEventResponse(event))
+		if err != nil {
+			return false
+		}
+		return conn.WriteMessage(websocket.TextMessage, data) == nil
+	}
+	for _, event := range replay {
+		if !write(event) {
+			return
+		}
+	}
+
+	ticker := time.NewTicker(c.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(eventsWriteWait))
+				return
+			}
+			if !write(event) {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventsWriteWait)); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func toLiveEventResponse(event *domain.LiveEvent) *dto.LiveEventResponse {
+	return &dto.LiveEventResponse{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		Data:      event.Data,
+		CreatedAt: event.CreatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/auth"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+)
+
+type NotificationActionController struct {
+	service coreService.NotificationActionService
+}
+
+func NewNotificationActionController(service coreService.NotificationActionService) *NotificationActionController {
+	return &NotificationActionController{service: service}
+}
+
+// Perform carries out the action of a signed link sent with a notification
+//
+//	@Summary		Perform Notification Action
+//	@Description	Target of the links in notifications to snooze, mute or unfollow a streamer, or to acknowledge the notification.
+//	@Description	The signed token authorizes the request and expires; acting on a notification also acknowledges it, which stops escalation.
+//	@Description	Opening the link with GET only acknowledges or snoozes; mute and unfollow have to be confirmed with POST.
+//	@Description	Browsers asking for HTML get a page instead, which asks to confirm mute and unfollow and posts back.
+//	@Tags			NotificationAction
+//	@Produce		json,html
+//	@Param			token	path		string	true	"Signed action token"
+//	@Success		200		{object}	dto.NotificationActionResponse
+//	@Router			/notification-actions/{token} [get]
+//	@Router			/notification-actions/{token} [post]
+func (c *NotificationActionController) Perform(ctx fiber.Ctx) error {
+	token := ctx.Params("token")
+	confirmed := ctx.Method() == fiber.MethodPost
+	if !wantsPage(ctx) {
+		result, err := c.service.Perform(ctx, token, confirmed)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(c.toResponse(result))
+	}
+
+	locale := auth.GetLocale(ctx)
+	if !confirmed {
+		parsed, err := c.service.Inspect(ctx, token)
+		if err != nil {
+			return c.renderError(ctx, err)
+		}
+		if parsed.Action.NeedsConfirmation() {
+			return renderNotificationActionPage(ctx, fiber.StatusOK, locale, notificationActionConfirmation(locale, parsed.Action))
+		}
+	}
+	result, err := c.service.Perform(ctx, token, confirmed)
+	if err != nil {
+		return c.renderError(ctx, err)
+	}
+	return renderNotificationActionPage(ctx, fiber.StatusOK, locale, notificationActionPageData{
+		Message: i18n.T(locale, "notification.action.done."+string(result.Action)),
+	})
+}
+
+// renderError shows a failed action on the page; errors that are not the user's to fix go to the
+// error handler as usual.
+func (c *NotificationActionController) renderError(ctx fiber.Ctx, err error) error {
+	appErr := errors.GetAppError(err)
+	if appErr == nil || appErr.HTTPStatus >= fiber.StatusInternalServerError {
+		return err
+	}
+	locale := auth.GetLocale(ctx)
+	return renderNotificationActionPage(ctx, appErr.HTTPStatus, locale, notificationActionPageData{
+		Message: appErr.Localize(locale),
+	})
+}
+
+func (c *NotificationActionController) toResponse(result *domain.NotificationActionResult) *dto.NotificationActionResponse {
+	resp := &dto.NotificationActionResponse{
+		Action:       string(result.Action),
+		FollowID:     result.FollowID,
+		Acknowledged: result.Acknowledged,
+		Unfollowed:   result.Follow == nil,
+	}
+	if follow := result.Follow; follow != nil {
+		resp.SnoozedUntil = follow.SnoozedUntil
+		resp.MutedSessionID = follow.MutedSessionID
+	}
+	return resp
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	serviceMocks "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const browserAccept = "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"
+
+func newNotificationActionApp(t *testing.T) (*fiber.App, *serviceMocks.MockNotificationActionService) {
+	app := fiber.New()
+	service := serviceMocks.NewMockNotificationActionService(t)
+	controller := NewNotificationActionController(service)
+	app.Get("/actions/:token", controller.Perform)
+	app.Post("/actions/:token", controller.Perform)
+	return app, service
+}
+
+func TestNotificationActionController_BrowserConfirmsUnfollow(t *testing.T) {
+	app, service := newNotificationActionApp(t)
+	service.EXPECT().Inspect(mock.Anything, "signed").
+		Return(&domain.NotificationActionToken{Action: domain.NotificationActionUnfollow, FollowID: 3}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/actions/signed", nil)
+	req.Header.Set(fiber.HeaderAccept, browserAccept)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	require.Contains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMETextHTML)
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `<form method="post">`)
+
+	service.EXPECT().Perform(mock.Anything, "signed", true).
+		Return(&domain.NotificationActionResult{Action: domain.NotificationActionUnfollow, FollowID: 3}, nil)
+	req = httptest.NewRequest(http.MethodPost, "/actions/signed", nil)
+	req.Header.Set(fiber.HeaderAccept, browserAccept)
+	resp, err = app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	defer resp.Body.Close()
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NotContains(t, string(body), "<form")
+}
+
+func TestNotificationActionController_BrowserAcknowledgesRightAway(t *testing.T) {
+	app, service := newNotificationActionApp(t)
+	service.EXPECT().Inspect(mock.Anything, "signed").
+		Return(&domain.NotificationActionToken{Action: domain.NotificationActionAcknowledge, FollowID: 3}, nil)
+	service.EXPECT().Perform(mock.Anything, "signed", false).
+		Return(&domain.NotificationActionResult{Action: domain.NotificationActionAcknowledge, FollowID: 3, Acknowledged: true}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/actions/signed", nil)
+	req.Header.Set(fiber.HeaderAccept, browserAccept)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	require.Contains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMETextHTML)
+}
+
+func TestNotificationActionController_BrowserSeesExpiredLink(t *testing.T) {
+	app, service := newNotificationActionApp(t)
+	service.EXPECT().Inspect(mock.Anything, "signed").Return(nil, errors.Forbidden("action link has expired"))
+
+	req := httptest.NewRequest(http.MethodGet, "/actions/signed", nil)
+	req.Header.Set(fiber.HeaderAccept, browserAccept)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	require.Contains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMETextHTML)
+}
+
+func TestNotificationActionController_BackgroundRequestGetsJSON(t *testing.T) {
+	app, service := newNotificationActionApp(t)
+	service.EXPECT().Perform(mock.Anything, "signed", true).
+		Return(&domain.NotificationActionResult{Action: domain.NotificationActionSnooze, FollowID: 3}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/actions/signed", nil)
+	req.Header.Set(fiber.HeaderAccept, "*/*")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	require.Contains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON)
+}
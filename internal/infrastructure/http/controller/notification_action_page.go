@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"html/template"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
+)
+
+// notificationActionPage is what a browser opening an action link is shown. Actions that need
+// confirming post the form back to the same link.
+var notificationActionPage = template.Must(template.New("notification-action").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Fusion</title>
+<style>body{font-family:system-ui,sans-serif;max-width:28rem;margin:4rem auto;padding:0 1rem;text-align:center}button{font-size:1rem;padding:.5rem 1.5rem}</style>
+</head>
+<body>
+<p>{{.Message}}</p>
+{{if .Confirm}}<form method="post"><button type="submit">{{.Confirm}}</button></form>{{end}}
+</body>
+</html>
+`))
+
+type notificationActionPageData struct {
+	Lang    string
+	Message string
+	// Confirm labels the button that confirms the action; empty once there is nothing left to do.
+	Confirm string
+}
+
+// wantsPage reports whether the request comes from a browser rather than a client reading JSON,
+// e.g. the background requests of ntfy.
+func wantsPage(ctx fiber.Ctx) bool {
+	return ctx.Accepts(fiber.MIMEApplicationJSON, fiber.MIMETextHTML) == fiber.MIMETextHTML
+}
+
+func renderNotificationActionPage(ctx fiber.Ctx, status int, locale i18n.Locale, data notificationActionPageData) error {
+	data.Lang = string(locale)
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	ctx.Type("html", "utf-8")
+	return notificationActionPage.Execute(ctx.Status(status).Response().BodyWriter(), data)
+}
+
+func notificationActionConfirmation(locale i18n.Locale, action domain.NotificationActionType) notificationActionPageData {
+	return notificationActionPageData{
+		Message: i18n.T(locale, "notification.action.confirm."+string(action)),
+		Confirm: i18n.T(locale, "notification.action."+string(action)),
+	}
+}
@@ -1,13 +1,16 @@
 package controller
 
 import (
+	"encoding/json"
 	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/ryuyb/fusion/internal/core/command"
 	"github.com/ryuyb/fusion/internal/core/domain"
 	coreService "github.com/ryuyb/fusion/internal/core/port/service"
 	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/auth"
 	"github.com/ryuyb/fusion/internal/pkg/errors"
 	"github.com/ryuyb/fusion/internal/pkg/util"
 )
@@ -42,6 +45,15 @@ func (c *NotificationChannelController) Create(ctx fiber.Ctx) error {
 		Config:      req.Config,
 		Enable:      req.Enable,
 		Priority:    req.Priority,
+
+		TitleTemplate: req.TitleTemplate,
+		BodyTemplate:  req.BodyTemplate,
+
+		DigestWindowSeconds:  req.DigestWindowSeconds,
+		DailySummaryAt:       req.DailySummaryAt,
+		DailySummaryTimezone: req.DailySummaryTimezone,
+
+		Locale: string(auth.GetLocale(ctx)),
 	}
 	created, err := c.service.Create(ctx, cmd)
 	if err != nil {
@@ -75,6 +87,15 @@ func (c *NotificationChannelController) Update(ctx fiber.Ctx) error {
 			Config:      req.Config,
 			Enable:      req.Enable,
 			Priority:    req.Priority,
+
+			TitleTemplate: req.TitleTemplate,
+			BodyTemplate:  req.BodyTemplate,
+
+			DigestWindowSeconds:  req.DigestWindowSeconds,
+			DailySummaryAt:       req.DailySummaryAt,
+			DailySummaryTimezone: req.DailySummaryTimezone,
+
+			Locale: string(auth.GetLocale(ctx)),
 		},
 	}
 	updated, err := c.service.Update(ctx, cmd)
@@ -153,8 +174,134 @@ func (c *NotificationChannelController) ListByUser(ctx fiber.Ctx) error {
 	return ctx.JSON(dto.NewPaginationResponse(items, total, page, pageSize))
 }
 
+// ListTypes lists the supported channel types with their config schemas
+//
+//	@Summary	List Notification Channel Types
+//	@Tags		NotificationChannel
+//	@Produce	json
+//	@Security	Bearer
+//	@Success	200	{array}	dto.NotificationChannelTypeResponse
+//	@Router		/notification-channels/types [get]
+func (c *NotificationChannelController) ListTypes(ctx fiber.Ctx) error {
+	channelTypes := c.service.ListChannelTypes(ctx)
+	items := make([]*dto.NotificationChannelTypeResponse, len(channelTypes))
+	for i, channelType := range channelTypes {
+		schema, err := json.Marshal(channelType.Schema)
+		if err != nil {
+			return errors.Internal(err)
+		}
+		items[i] = &dto.NotificationChannelTypeResponse{
+			ChannelType: string(channelType.ChannelType),
+			Schema:      schema,
+		}
+	}
+	return ctx.JSON(items)
+}
+
+// Test sends a test message through a saved notification channel. Provider failures are
+// reported in the response body and recorded as the channel's last test result.
+//
+//	@Summary	Test Notification Channel
+//	@Tags		NotificationChannel
+//	@Produce	json
+//	@Param		id	path	int	true	"Channel ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationChannelTestResponse
+//	@Router		/notification-channels/{id}/test [post]
+func (c *NotificationChannelController) Test(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid channel id").Wrap(err)
+	}
+	result, err := c.service.Test(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toTestResponse(result))
+}
+
+// Verify confirms a channel with the code sent through it and enables the channel
+//
+//	@Summary	Verify Notification Channel
+//	@Tags		NotificationChannel
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path	int									true	"Channel ID"
+//	@Param		request	body	dto.VerifyNotificationChannelRequest	true	"Verification code"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationChannelResponse
+//	@Router		/notification-channels/{id}/verify [post]
+func (c *NotificationChannelController) Verify(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid channel id").Wrap(err)
+	}
+	req := new(dto.VerifyNotificationChannelRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	channel, err := c.service.Verify(ctx, id, req.Code)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(channel))
+}
+
+// ResendVerification sends a new verification code through a channel that is not verified yet
+//
+//	@Summary	Resend Notification Channel Verification
+//	@Tags		NotificationChannel
+//	@Produce	json
+//	@Param		id	path	int	true	"Channel ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationChannelResponse
+//	@Router		/notification-channels/{id}/verification [post]
+func (c *NotificationChannelController) ResendVerification(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid channel id").Wrap(err)
+	}
+	channel, err := c.service.ResendVerification(ctx, id, auth.GetLocale(ctx))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(channel))
+}
+
+// TestConfig validates an unsaved channel config and sends a test message with it
+//
+//	@Summary	Test Notification Channel Config
+//	@Tags		NotificationChannel
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body	dto.TestNotificationChannelConfigRequest	true	"Channel type and config"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationChannelTestResponse
+//	@Router		/notification-channels/test [post]
+func (c *NotificationChannelController) TestConfig(ctx fiber.Ctx) error {
+	req := new(dto.TestNotificationChannelConfigRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	result, err := c.service.TestConfig(ctx, domain.NotificationChannelType(req.ChannelType), req.Config)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toTestResponse(result))
+}
+
+func (c *NotificationChannelController) toTestResponse(result *domain.NotificationChannelTestResult) *dto.NotificationChannelTestResponse {
+	return &dto.NotificationChannelTestResponse{
+		Success:   result.Success,
+		Error:     result.Error,
+		Response:  result.Response,
+		LatencyMs: result.Latency.Milliseconds(),
+		TestedAt:  result.TestedAt,
+	}
+}
+
 func (c *NotificationChannelController) toResponse(channel *domain.NotificationChannel) *dto.NotificationChannelResponse {
-	return &dto.NotificationChannelResponse{
+	resp := &dto.NotificationChannelResponse{
 		ID:          channel.ID,
 		UserID:      channel.UserID,
 		ChannelType: string(channel.ChannelType),
@@ -162,5 +309,32 @@ func (c *NotificationChannelController) toResponse(channel *domain.NotificationC
 		Config:      channel.Config,
 		Enable:      channel.Enable,
 		Priority:    channel.Priority,
+
+		TitleTemplate: channel.Template.Title,
+		BodyTemplate:  channel.Template.Body,
+
+		DigestWindowSeconds: int64(channel.DigestWindow / time.Second),
+	}
+	if summary := channel.DailySummary; summary != nil {
+		resp.DailySummaryAt = summary.At
+		resp.DailySummaryTimezone = summary.Timezone
+		resp.DailySummarySentAt = summary.LastSentAt
+	}
+	if channel.LastTest != nil {
+		resp.LastTestedAt = &channel.LastTest.TestedAt
+		resp.LastTestSuccess = &channel.LastTest.Success
+		resp.LastTestError = channel.LastTest.Error
+	}
+	resp.Verified = channel.IsVerified()
+	if v := channel.Verification; v != nil {
+		resp.VerificationExpiresAt = &v.ExpiresAt
+		resp.VerificationSentAt = v.SentAt
 	}
+	resp.Health = string(channel.Health.State())
+	resp.ConsecutiveFailures = channel.Health.ConsecutiveFailures
+	resp.LastError = channel.Health.LastError
+	resp.LastFailureAt = channel.Health.LastFailureAt
+	resp.LastSuccessAt = channel.Health.LastSuccessAt
+	resp.DisabledAt = channel.Health.DisabledAt
+	return resp
 }
@@ -0,0 +1,246 @@
+package controller
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+)
+
+type NotificationDeliveryController struct {
+	service coreService.NotificationDeliveryService
+}
+
+func NewNotificationDeliveryController(service coreService.NotificationDeliveryService) *NotificationDeliveryController {
+	return &NotificationDeliveryController{service: service}
+}
+
+// GetByID fetches a notification delivery
+//
+//	@Summary	Get Notification Delivery
+//	@Tags		NotificationDelivery
+//	@Produce	json
+//	@Param		id	path	int	true	"Delivery ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationDeliveryResponse
+//	@Router		/notification-deliveries/{id} [get]
+func (c *NotificationDeliveryController) GetByID(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid delivery id").Wrap(err)
+	}
+	delivery, err := c.service.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(delivery))
+}
+
+// Resend puts a recorded notification back in the outbox
+//
+//	@Summary	Resend Notification Delivery
+//	@Tags		NotificationDelivery
+//	@Produce	json
+//	@Param		id	path	int	true	"Delivery ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationDeliveryResponse
+//	@Router		/notification-deliveries/{id}/resend [post]
+func (c *NotificationDeliveryController) Resend(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid delivery id").Wrap(err)
+	}
+	delivery, err := c.service.Resend(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(delivery))
+}
+
+// Acknowledge marks a delivered notification as seen, which stops escalation to further channels
+//
+//	@Summary	Acknowledge Notification Delivery
+//	@Tags		NotificationDelivery
+//	@Produce	json
+//	@Param		id	path	int	true	"Delivery ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationDeliveryResponse
+//	@Router		/notification-deliveries/{id}/ack [post]
+func (c *NotificationDeliveryController) Acknowledge(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid delivery id").Wrap(err)
+	}
+	delivery, err := c.service.Acknowledge(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(delivery))
+}
+
+// ListByUser lists a user's notification delivery history
+//
+//	@Summary	List Notification Deliveries By User
+//	@Tags		NotificationDelivery
+//	@Produce	json
+//	@Param		user_id		path	int		true	"User ID"
+//	@Param		status		query	string	false	"Status"	Enums(pending, processing, retrying, sent, dead, held, skipped, batched, digested, throttled)
+//	@Param		channel_id	query	int		false	"Channel ID"
+//	@Param		streamer_id	query	int		false	"Streamer ID"
+//	@Param		follow_id	query	int		false	"Follow ID"
+//	@Param		event_type	query	string	false	"Event type"
+//	@Param		route_id	query	string	false	"Route ID"
+//	@Param		from		query	string	false	"Created at or after (RFC 3339)"
+//	@Param		to			query	string	false	"Created before (RFC 3339)"
+//	@Param		page		query	int		false	"Page"		default(1)
+//	@Param		page_size	query	int		false	"Page size"	default(10)
+//	@Security	Bearer
+//	@Success	200	{object}	dto.PaginationResponse[dto.NotificationDeliveryResponse]
+//	@Router		/notification-deliveries/users/{user_id} [get]
+func (c *NotificationDeliveryController) ListByUser(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	query := new(dto.ListNotificationDeliveriesQuery)
+	if err := ctx.Bind().Query(query); err != nil {
+		return errors.BadRequest("invalid query parameters").Wrap(err)
+	}
+	filter, err := c.toFilter(query)
+	if err != nil {
+		return err
+	}
+	page, pageSize := util.ParsePagination(ctx)
+	deliveries, total, err := c.service.ListByUserId(ctx, userID, filter, page, pageSize)
+	if err != nil {
+		return err
+	}
+	items := make([]*dto.NotificationDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		items[i] = c.toResponse(delivery)
+	}
+	return ctx.JSON(dto.NewPaginationResponse(items, total, page, pageSize))
+}
+
+// ListDeadLetters lists deliveries that exhausted their retries or failed permanently
+//
+//	@Summary	List Dead-Lettered Notification Deliveries
+//	@Tags		NotificationDelivery
+//	@Produce	json
+//	@Param		user_id		query	int		false	"User ID"
+//	@Param		channel_id	query	int		false	"Channel ID"
+//	@Param		event_type	query	string	false	"Event type"
+//	@Param		page		query	int		false	"Page"		default(1)
+//	@Param		page_size	query	int		false	"Page size"	default(10)
+//	@Security	Bearer
+//	@Success	200	{object}	dto.PaginationResponse[dto.NotificationDeliveryResponse]
+//	@Router		/notification-deliveries/dead-letters [get]
+func (c *NotificationDeliveryController) ListDeadLetters(ctx fiber.Ctx) error {
+	query := new(dto.ListDeadLettersQuery)
+	if err := ctx.Bind().Query(query); err != nil {
+		return errors.BadRequest("invalid query parameters").Wrap(err)
+	}
+	filter := &domain.NotificationDeliveryFilter{
+		UserID:    query.UserID,
+		ChannelID: query.ChannelID,
+		EventType: domain.NotificationEventType(query.EventType),
+	}
+	page, pageSize := util.ParsePagination(ctx)
+	deliveries, total, err := c.service.ListDeadLetters(ctx, filter, page, pageSize)
+	if err != nil {
+		return err
+	}
+	items := make([]*dto.NotificationDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		items[i] = c.toResponse(delivery)
+	}
+	return ctx.JSON(dto.NewPaginationResponse(items, total, page, pageSize))
+}
+
+// ReplayDeadLetters requeues dead-lettered deliveries
+//
+//	@Summary	Replay Dead-Lettered Notification Deliveries
+//	@Tags		NotificationDelivery
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body	dto.ReplayDeadLettersRequest	true	"Optional scope; empty replays everything"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.ReplayDeadLettersResponse
+//	@Router		/notification-deliveries/dead-letters/replay [post]
+func (c *NotificationDeliveryController) ReplayDeadLetters(ctx fiber.Ctx) error {
+	req := new(dto.ReplayDeadLettersRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	replayed, err := c.service.ReplayDeadLetters(ctx, &domain.NotificationDeliveryFilter{
+		UserID:     req.UserID,
+		ChannelID:  req.ChannelID,
+		StreamerID: req.StreamerID,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(&dto.ReplayDeadLettersResponse{Replayed: replayed})
+}
+
+func (c *NotificationDeliveryController) toFilter(query *dto.ListNotificationDeliveriesQuery) (*domain.NotificationDeliveryFilter, error) {
+	filter := &domain.NotificationDeliveryFilter{
+		Status:     domain.NotificationDeliveryStatus(query.Status),
+		ChannelID:  query.ChannelID,
+		StreamerID: query.StreamerID,
+		FollowID:   query.FollowID,
+		EventType:  domain.NotificationEventType(query.EventType),
+		RouteID:    query.RouteID,
+	}
+	if query.From != "" {
+		from, err := time.Parse(time.RFC3339, query.From)
+		if err != nil {
+			return nil, errors.BadRequest("from must be an RFC 3339 timestamp").WithDetail("from", query.From)
+		}
+		filter.From = from
+	}
+	if query.To != "" {
+		to, err := time.Parse(time.RFC3339, query.To)
+		if err != nil {
+			return nil, errors.BadRequest("to must be an RFC 3339 timestamp").WithDetail("to", query.To)
+		}
+		filter.To = to
+	}
+	return filter, nil
+}
+
+func (c *NotificationDeliveryController) toResponse(delivery *domain.NotificationDelivery) *dto.NotificationDeliveryResponse {
+	resp := &dto.NotificationDeliveryResponse{
+		ID:             delivery.ID,
+		UserID:         delivery.UserID,
+		FollowID:       delivery.FollowID,
+		ChannelID:      delivery.ChannelID,
+		ChannelType:    string(delivery.ChannelType),
+		StreamerID:     delivery.StreamerID,
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Response:       delivery.Response,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LatencyMs:      delivery.Latency.Milliseconds(),
+		Error:          delivery.Error,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		DigestID:       delivery.DigestID,
+		AcknowledgedAt: delivery.AcknowledgedAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+	if route := delivery.Route; route != nil {
+		resp.RouteID = route.ID
+		resp.RouteMode = string(route.Mode)
+		resp.RouteStep = route.Step
+		resp.EscalateAfterSeconds = int64(route.EscalateAfter / time.Second)
+	}
+	return resp
+}
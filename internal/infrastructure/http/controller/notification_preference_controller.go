@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+)
+
+type NotificationPreferenceController struct {
+	service coreService.NotificationPreferenceService
+}
+
+func NewNotificationPreferenceController(service coreService.NotificationPreferenceService) *NotificationPreferenceController {
+	return &NotificationPreferenceController{service: service}
+}
+
+// GetByUser fetches a user's notification preference, falling back to the defaults
+//
+//	@Summary	Get Notification Preference
+//	@Tags		NotificationPreference
+//	@Produce	json
+//	@Param		user_id	path	int	true	"User ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationPreferenceResponse
+//	@Router		/notification-preferences/users/{user_id} [get]
+func (c *NotificationPreferenceController) GetByUser(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	preference, err := c.service.FindByUserId(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(preference))
+}
+
+// UpdateByUser saves a user's notification preference
+//
+//	@Summary	Update Notification Preference
+//	@Tags		NotificationPreference
+//	@Accept		json
+//	@Produce	json
+//	@Param		user_id	path	int										true	"User ID"
+//	@Param		request	body	dto.UpdateNotificationPreferenceRequest	true	"Preference data"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationPreferenceResponse
+//	@Router		/notification-preferences/users/{user_id} [put]
+func (c *NotificationPreferenceController) UpdateByUser(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	req := new(dto.UpdateNotificationPreferenceRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	preference, err := c.service.Update(ctx, &command.UpdateNotificationPreferenceCommand{
+		UserID:               userID,
+		RoutingMode:          req.RoutingMode,
+		EscalateAfterSeconds: req.EscalateAfterSeconds,
+		QuietHours:           toQuietHoursCommand(req.QuietHours),
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(preference))
+}
+
+// SetDoNotDisturb silences a user's notifications for a while
+//
+//	@Summary	Enable Do Not Disturb
+//	@Tags		NotificationPreference
+//	@Accept		json
+//	@Produce	json
+//	@Param		user_id	path	int							true	"User ID"
+//	@Param		request	body	dto.SetDoNotDisturbRequest	true	"Duration"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationPreferenceResponse
+//	@Router		/notification-preferences/users/{user_id}/dnd [put]
+func (c *NotificationPreferenceController) SetDoNotDisturb(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	req := new(dto.SetDoNotDisturbRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	preference, err := c.service.SetDoNotDisturb(ctx, &command.SetDoNotDisturbCommand{UserID: userID, Minutes: req.Minutes})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(preference))
+}
+
+// ClearDoNotDisturb switches do-not-disturb off
+//
+//	@Summary	Disable Do Not Disturb
+//	@Tags		NotificationPreference
+//	@Produce	json
+//	@Param		user_id	path	int	true	"User ID"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationPreferenceResponse
+//	@Router		/notification-preferences/users/{user_id}/dnd [delete]
+func (c *NotificationPreferenceController) ClearDoNotDisturb(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	preference, err := c.service.SetDoNotDisturb(ctx, &command.SetDoNotDisturbCommand{UserID: userID})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c.toResponse(preference))
+}
+
+func toQuietHoursCommand(req *dto.QuietHoursDTO) *command.QuietHoursCommand {
+	if req == nil {
+		return nil
+	}
+	rules := make([]command.QuietHoursRuleCommand, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = command.QuietHoursRuleCommand{Weekdays: rule.Weekdays, Start: rule.Start, End: rule.End}
+	}
+	return &command.QuietHoursCommand{Timezone: req.Timezone, Action: req.Action, Rules: rules}
+}
+
+func (c *NotificationPreferenceController) toResponse(preference *domain.NotificationPreference) *dto.NotificationPreferenceResponse {
+	resp := &dto.NotificationPreferenceResponse{
+		UserID:               preference.UserID,
+		RoutingMode:          string(preference.Routing.Mode),
+		EscalateAfterSeconds: int64(preference.Routing.EscalateAfter / time.Second),
+	}
+	if quietHours := preference.QuietHours; quietHours != nil {
+		rules := make([]dto.QuietHoursRuleDTO, len(quietHours.Rules))
+		for i, rule := range quietHours.Rules {
+			weekdays := make([]int, len(rule.Weekdays))
+			for j, day := range rule.Weekdays {
+				weekdays[j] = int(day)
+			}
+			rules[i] = dto.QuietHoursRuleDTO{Weekdays: weekdays, Start: rule.Start, End: rule.End}
+		}
+		resp.QuietHours = &dto.QuietHoursDTO{Timezone: quietHours.Timezone, Action: string(quietHours.Action), Rules: rules}
+	}
+	if until := preference.DoNotDisturbUntil; until != nil && until.After(time.Now()) {
+		resp.DoNotDisturbUntil = until
+	}
+	if preference.ID > 0 {
+		resp.UpdatedAt = &preference.UpdatedAt
+	}
+	return resp
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/command"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/auth"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+)
+
+type NotificationTemplateController struct {
+	service coreService.NotificationTemplateService
+}
+
+func NewNotificationTemplateController(service coreService.NotificationTemplateService) *NotificationTemplateController {
+	return &NotificationTemplateController{service: service}
+}
+
+// Preview renders a notification template against a streamer or sample data
+//
+//	@Summary	Preview Notification Template
+//	@Tags		NotificationTemplate
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body	dto.PreviewNotificationTemplateRequest	true	"Template and optional streamer"
+//	@Security	Bearer
+//	@Success	200	{object}	dto.NotificationTemplatePreviewResponse
+//	@Router		/notification-templates/preview [post]
+func (c *NotificationTemplateController) Preview(ctx fiber.Ctx) error {
+	req := new(dto.PreviewNotificationTemplateRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	cmd := &command.PreviewNotificationTemplateCommand{
+		TitleTemplate: req.TitleTemplate,
+		BodyTemplate:  req.BodyTemplate,
+		StreamerID:    req.StreamerID,
+		Alias:         req.Alias,
+		Locale:        string(auth.GetLocale(ctx)),
+	}
+	rendered, err := c.service.Preview(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(&dto.NotificationTemplatePreviewResponse{
+		Title: rendered.Title,
+		Body:  rendered.Body,
+	})
+}
+
+// Variables lists the variables available in notification templates
+//
+//	@Summary	List Notification Template Variables
+//	@Tags		NotificationTemplate
+//	@Produce	json
+//	@Security	Bearer
+//	@Success	200	{array}	dto.NotificationTemplateVariableResponse
+//	@Router		/notification-templates/variables [get]
+func (c *NotificationTemplateController) Variables(ctx fiber.Ctx) error {
+	variables := c.service.Variables()
+	items := make([]*dto.NotificationTemplateVariableResponse, len(variables))
+	for i, variable := range variables {
+		items[i] = &dto.NotificationTemplateVariableResponse{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Example:     variable.Example,
+		}
+	}
+	return ctx.JSON(items)
+}
@@ -35,6 +35,7 @@ func (u *UserController) Create(ctx fiber.Ctx) error {
 		Username: req.Username,
 		Email:    req.Email,
 		Password: req.Password,
+		Locale:   req.Locale,
 	}
 	create, err := u.service.Create(ctx, cmd)
 	if err != nil {
@@ -65,6 +66,7 @@ func (u *UserController) Update(ctx fiber.Ctx) error {
 			Username: req.Username,
 			Email:    req.Email,
 			Password: req.Password,
+			Locale:   req.Locale,
 		},
 	}
 	update, err := u.service.Update(ctx, cmd)
@@ -159,6 +161,7 @@ func (u *UserController) toResponse(user *domain.User) *dto.UserResponse {
 		ID:        user.ID,
 		Username:  user.Username,
 		Email:     user.Email,
+		Locale:    user.Locale,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
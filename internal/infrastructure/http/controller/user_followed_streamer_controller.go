@@ -2,6 +2,7 @@ package controller
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/ryuyb/fusion/internal/core/command"
@@ -41,7 +42,20 @@ func (c *UserFollowedStreamerController) Create(ctx fiber.Ctx) error {
 		Alias:                  req.Alias,
 		Notes:                  req.Notes,
 		NotificationsEnabled:   req.NotificationsEnabled,
+		AlwaysNotify:           req.AlwaysNotify,
+		NotifyStreamEnd:        req.NotifyStreamEnd,
+		NotifyTitleChange:      req.NotifyTitleChange,
+		NotifyCategories:       req.NotifyCategories,
 		NotificationChannelIDs: req.NotificationChannelIDs,
+
+		TitleTemplate: req.TitleTemplate,
+		BodyTemplate:  req.BodyTemplate,
+		Filter:        toNotificationFilterCommand(req.Filter),
+
+		RoutingMode:          req.RoutingMode,
+		EscalateAfterSeconds: req.EscalateAfterSeconds,
+
+		ChannelOverrides: req.ChannelOverrides,
 	}
 
 	created, err := c.service.Create(ctx, cmd)
@@ -72,7 +86,20 @@ func (c *UserFollowedStreamerController) Update(ctx fiber.Ctx) error {
 		Alias:                  req.Alias,
 		Notes:                  req.Notes,
 		NotificationsEnabled:   req.NotificationsEnabled,
+		AlwaysNotify:           req.AlwaysNotify,
+		NotifyStreamEnd:        req.NotifyStreamEnd,
+		NotifyTitleChange:      req.NotifyTitleChange,
+		NotifyCategories:       req.NotifyCategories,
 		NotificationChannelIDs: req.NotificationChannelIDs,
+
+		TitleTemplate: req.TitleTemplate,
+		BodyTemplate:  req.BodyTemplate,
+		Filter:        toNotificationFilterCommand(req.Filter),
+
+		RoutingMode:          req.RoutingMode,
+		EscalateAfterSeconds: req.EscalateAfterSeconds,
+
+		ChannelOverrides: req.ChannelOverrides,
 	}
 	updated, err := c.service.Update(ctx, cmd)
 	if err != nil {
@@ -179,13 +206,54 @@ func (c *UserFollowedStreamerController) ListByStreamer(ctx fiber.Ctx) error {
 }
 
 func (c *UserFollowedStreamerController) toResponse(follow *domain.UserFollowedStreamer) *dto.UserFollowedStreamerResponse {
-	return &dto.UserFollowedStreamerResponse{
+	resp := &dto.UserFollowedStreamerResponse{
 		ID:                     follow.ID,
 		UserID:                 follow.UserID,
 		StreamerID:             follow.StreamerID,
 		Alias:                  follow.Alias,
 		Notes:                  follow.Notes,
 		NotificationsEnabled:   follow.NotificationsEnabled,
+		AlwaysNotify:           follow.AlwaysNotify,
+		NotifyStreamEnd:        follow.NotifyStreamEnd,
+		NotifyTitleChange:      follow.NotifyTitleChange,
+		NotifyCategories:       follow.NotifyCategories,
 		NotificationChannelIDs: follow.NotificationChannelIDs,
+
+		TitleTemplate: follow.Template.Title,
+		BodyTemplate:  follow.Template.Body,
+
+		ChannelOverrides: follow.ChannelOverrides,
+
+		SnoozedUntil:   follow.SnoozedUntil,
+		MutedSessionID: follow.MutedSessionID,
+	}
+	if filter := follow.Filter; filter != nil {
+		resp.Filter = &dto.NotificationFilterDTO{
+			TitleInclude:      filter.TitleInclude,
+			TitleExclude:      filter.TitleExclude,
+			TitlePattern:      filter.TitlePattern,
+			Categories:        filter.Categories,
+			BlockedCategories: filter.BlockedCategories,
+			MinViewers:        filter.MinViewers,
+		}
+	}
+	if follow.Routing != nil {
+		resp.RoutingMode = string(follow.Routing.Mode)
+		resp.EscalateAfterSeconds = int64(follow.Routing.EscalateAfter / time.Second)
+	}
+	return resp
+}
+
+func toNotificationFilterCommand(req *dto.NotificationFilterDTO) *command.NotificationFilterCommand {
+	if req == nil {
+		return nil
+	}
+	return &command.NotificationFilterCommand{
+		TitleInclude:      req.TitleInclude,
+		TitleExclude:      req.TitleExclude,
+		TitlePattern:      req.TitlePattern,
+		Categories:        req.Categories,
+		BlockedCategories: req.BlockedCategories,
+		MinViewers:        req.MinViewers,
 	}
 }
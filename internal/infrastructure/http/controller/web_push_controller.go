@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/core/command"
+	"github.com/ryuyb/fusion/internal/core/domain"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/util"
+)
+
+type WebPushController struct {
+	service coreService.WebPushService
+}
+
+func NewWebPushController(service coreService.WebPushService) *WebPushController {
+	return &WebPushController{service: service}
+}
+
+// GetVAPIDPublicKey returns the application server key used by PushManager.subscribe()
+//
+//	@Summary	Get VAPID Public Key
+//	@Tags		WebPush
+//	@Produce	json
+//	@Success	200	{object}	dto.VAPIDPublicKeyResponse
+//	@Router		/webpush/vapid-public-key [get]
+func (c *WebPushController) GetVAPIDPublicKey(ctx fiber.Ctx) error {
+	publicKey, err := c.service.GetVAPIDPublicKey(ctx)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(&dto.VAPIDPublicKeyResponse{PublicKey: publicKey})
+}
+
+// Subscribe registers a browser push subscription
+//
+//	@Summary	Subscribe Web Push
+//	@Tags		WebPush
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body	dto.SubscribeWebPushRequest	true	"Push subscription"
+//	@Security	Bearer
+//	@Success	201	{object}	dto.WebPushSubscriptionResponse
+//	@Router		/webpush/subscriptions [post]
+func (c *WebPushController) Subscribe(ctx fiber.Ctx) error {
+	req := new(dto.SubscribeWebPushRequest)
+	if err := util.ParseRequestJson(ctx, req); err != nil {
+		return err
+	}
+	cmd := &command.SubscribeWebPushCommand{
+		UserID:    req.UserID,
+		Endpoint:  req.Endpoint,
+		P256dh:    req.Keys.P256dh,
+		Auth:      req.Keys.Auth,
+		UserAgent: ctx.Get(fiber.HeaderUserAgent),
+	}
+	subscription, err := c.service.Subscribe(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusCreated).JSON(c.toResponse(subscription))
+}
+
+// Unsubscribe removes a browser push subscription
+//
+//	@Summary	Unsubscribe Web Push
+//	@Tags		WebPush
+//	@Produce	json
+//	@Param		id	path	int	true	"Subscription ID"
+//	@Security	Bearer
+//	@Success	200
+//	@Router		/webpush/subscriptions/{id} [delete]
+func (c *WebPushController) Unsubscribe(ctx fiber.Ctx) error {
+	id, err := strconv.ParseInt(ctx.Params("id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid subscription id").Wrap(err)
+	}
+	if err := c.service.Unsubscribe(ctx, id); err != nil {
+		return err
+	}
+	return ctx.SendStatus(fiber.StatusOK)
+}
+
+// ListByUser lists a user's browser push subscriptions
+//
+//	@Summary	List Web Push Subscriptions By User
+//	@Tags		WebPush
+//	@Produce	json
+//	@Param		user_id		path	int	true	"User ID"
+//	@Param		page		query	int	false	"Page"		default(1)
+//	@Param		page_size	query	int	false	"Page size"	default(10)
+//	@Security	Bearer
+//	@Success	200	{object}	dto.PaginationResponse[dto.WebPushSubscriptionResponse]
+//	@Router		/webpush/subscriptions/users/{user_id} [get]
+func (c *WebPushController) ListByUser(ctx fiber.Ctx) error {
+	userID, err := strconv.ParseInt(ctx.Params("user_id"), 10, 64)
+	if err != nil {
+		return errors.BadRequest("invalid user id").Wrap(err)
+	}
+	page, pageSize := util.ParsePagination(ctx)
+	subscriptions, total, err := c.service.ListByUserId(ctx, userID, page, pageSize)
+	if err != nil {
+		return err
+	}
+	items := make([]*dto.WebPushSubscriptionResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		items[i] = c.toResponse(subscription)
+	}
+	return ctx.JSON(dto.NewPaginationResponse(items, total, page, pageSize))
+}
+
+func (c *WebPushController) toResponse(subscription *domain.WebPushSubscription) *dto.WebPushSubscriptionResponse {
+	return &dto.WebPushSubscriptionResponse{
+		ID:        subscription.ID,
+		UserID:    subscription.UserID,
+		Endpoint:  subscription.Endpoint,
+		UserAgent: subscription.UserAgent,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}
+}
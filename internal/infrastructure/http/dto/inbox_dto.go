@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type ListInboxMessagesQuery struct {
+	Unread bool `query:"unread"`
+}
+
+type InboxMessageResponse struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	ChannelID  int64      `json:"channel_id"`
+	StreamerID *int64     `json:"streamer_id,omitempty"`
+	EventType  string     `json:"event_type"`
+	Severity   string     `json:"severity,omitempty"`
+	Title      string     `json:"title"`
+	Content    string     `json:"content"`
+	URL        string     `json:"url,omitempty"`
+	ImageURL   string     `json:"image_url,omitempty"`
+	IconURL    string     `json:"icon_url,omitempty"`
+	Read       bool       `json:"read"`
+	ReadAt     *time.Time `json:"read_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type InboxUnreadCountResponse struct {
+	Unread int `json:"unread"`
+}
+
+type InboxMarkAllReadResponse struct {
+	Marked int `json:"marked"`
+}
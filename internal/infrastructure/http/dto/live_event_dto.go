@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+// LiveEventResponse is one event on the event stream. SSE sends it as the data of a message whose id
+// and event fields repeat ID and Type; WebSocket sends it as a text message.
+type LiveEventResponse struct {
+	ID        int64          `json:"id" example:"1748808000000000"`
+	Type      string         `json:"type" enums:"stream.online,stream.offline,stream.title_changed,stream.category_changed,notification.delivered,notification.failed"`
+	Data      map[string]any `json:"data"`
+	CreatedAt time.Time      `json:"created_at"`
+}
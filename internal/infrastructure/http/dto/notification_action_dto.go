@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type NotificationActionResponse struct {
+	Action       string `json:"action" enums:"ack,snooze,mute,unfollow"`
+	FollowID     int64  `json:"follow_id"`
+	Acknowledged bool   `json:"acknowledged"`
+	Unfollowed   bool   `json:"unfollowed"`
+	// SnoozedUntil and MutedSessionID are the follow's state after the action.
+	SnoozedUntil   *time.Time `json:"snoozed_until,omitempty"`
+	MutedSessionID *int64     `json:"muted_session_id,omitempty"`
+}
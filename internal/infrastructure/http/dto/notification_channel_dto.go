@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type CreateNotificationChannelRequest struct {
 	UserID      int64          `json:"user_id"`
 	ChannelType string         `json:"channel_type"`
@@ -7,6 +12,17 @@ type CreateNotificationChannelRequest struct {
 	Config      map[string]any `json:"config"`
 	Enable      bool           `json:"enable"`
 	Priority    int            `json:"priority"`
+
+	// TitleTemplate and BodyTemplate word the notification from the stream variables, e.g. .Streamer.
+	// WeCom and DingTalk robots then arrange the result with config.title_layout and config.body_layout.
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+
+	// DigestWindowSeconds batches go-live notifications into one message per window; 0 sends each right away.
+	DigestWindowSeconds int64 `json:"digest_window_seconds,omitempty" validate:"omitempty,gte=60,lte=21600"`
+	// DailySummaryAt is the local HH:MM time of the daily summary of who streamed; empty turns it off.
+	DailySummaryAt       string `json:"daily_summary_at,omitempty"`
+	DailySummaryTimezone string `json:"daily_summary_timezone,omitempty"`
 }
 
 type UpdateNotificationChannelRequest struct {
@@ -17,6 +33,15 @@ type UpdateNotificationChannelRequest struct {
 	Config      map[string]any `json:"config"`
 	Enable      bool           `json:"enable"`
 	Priority    int            `json:"priority"`
+
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+
+	// DigestWindowSeconds batches go-live notifications into one message per window; 0 sends each right away.
+	DigestWindowSeconds int64 `json:"digest_window_seconds,omitempty" validate:"omitempty,gte=60,lte=21600"`
+	// DailySummaryAt is the local HH:MM time of the daily summary of who streamed; empty turns it off.
+	DailySummaryAt       string `json:"daily_summary_at,omitempty"`
+	DailySummaryTimezone string `json:"daily_summary_timezone,omitempty"`
 }
 
 type NotificationChannelResponse struct {
@@ -27,4 +52,54 @@ type NotificationChannelResponse struct {
 	Config      map[string]any `json:"config"`
 	Enable      bool           `json:"enable"`
 	Priority    int            `json:"priority"`
+
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+
+	DigestWindowSeconds  int64      `json:"digest_window_seconds"`
+	DailySummaryAt       string     `json:"daily_summary_at,omitempty"`
+	DailySummaryTimezone string     `json:"daily_summary_timezone,omitempty"`
+	DailySummarySentAt   *time.Time `json:"daily_summary_sent_at,omitempty"`
+
+	LastTestedAt    *time.Time `json:"last_tested_at,omitempty"`
+	LastTestSuccess *bool      `json:"last_test_success,omitempty"`
+	LastTestError   string     `json:"last_test_error,omitempty"`
+
+	// Verified is false while the channel waits for the code sent through it; it stays disabled until then.
+	Verified              bool       `json:"verified"`
+	VerificationExpiresAt *time.Time `json:"verification_expires_at,omitempty"`
+	// VerificationSentAt is empty when sending the code failed; request a new one.
+	VerificationSentAt *time.Time `json:"verification_sent_at,omitempty"`
+
+	// Health is healthy, failing while recent sends fail, or unhealthy once the channel was disabled
+	// for failing too often in a row; enabling it again resets it.
+	Health              string     `json:"health" enums:"healthy,failing,unhealthy"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+}
+
+type VerifyNotificationChannelRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+type TestNotificationChannelConfigRequest struct {
+	ChannelType string         `json:"channel_type" validate:"required"`
+	Config      map[string]any `json:"config"`
+}
+
+type NotificationChannelTestResponse struct {
+	Success   bool           `json:"success"`
+	Error     string         `json:"error,omitempty"`
+	Response  map[string]any `json:"response,omitempty"`
+	LatencyMs int64          `json:"latency_ms"`
+	TestedAt  time.Time      `json:"tested_at"`
+}
+
+type NotificationChannelTypeResponse struct {
+	ChannelType string `json:"channel_type"`
+	// Schema is a JSON Schema describing the config object of this channel type.
+	Schema json.RawMessage `json:"schema" swaggertype:"object"`
 }
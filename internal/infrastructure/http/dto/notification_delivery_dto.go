@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+type ListNotificationDeliveriesQuery struct {
+	Status     string `query:"status"`
+	ChannelID  int64  `query:"channel_id"`
+	StreamerID int64  `query:"streamer_id"`
+	FollowID   int64  `query:"follow_id"`
+	EventType  string `query:"event_type"`
+	RouteID    string `query:"route_id"`
+	From       string `query:"from"` // RFC 3339
+	To         string `query:"to"`   // RFC 3339
+}
+
+type ListDeadLettersQuery struct {
+	UserID    int64  `query:"user_id"`
+	ChannelID int64  `query:"channel_id"`
+	EventType string `query:"event_type"`
+}
+
+type ReplayDeadLettersRequest struct {
+	UserID     int64 `json:"user_id"`
+	ChannelID  int64 `json:"channel_id"`
+	StreamerID int64 `json:"streamer_id"`
+}
+
+type ReplayDeadLettersResponse struct {
+	Replayed int `json:"replayed"`
+}
+
+type NotificationDeliveryResponse struct {
+	ID            int64          `json:"id"`
+	UserID        int64          `json:"user_id"`
+	FollowID      *int64         `json:"follow_id,omitempty"`
+	ChannelID     int64          `json:"channel_id"`
+	ChannelType   string         `json:"channel_type"`
+	StreamerID    *int64         `json:"streamer_id,omitempty"`
+	EventType     string         `json:"event_type"`
+	Payload       map[string]any `json:"payload"`
+	Response      map[string]any `json:"response,omitempty"`
+	Status        string         `json:"status"`
+	Attempts      int            `json:"attempts"`
+	LatencyMs     int64          `json:"latency_ms"`
+	Error         string         `json:"error,omitempty"`
+	NextAttemptAt *time.Time     `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time     `json:"delivered_at,omitempty"`
+	// Route fields are set for notifications sent with a sequential routing mode.
+	RouteID              string `json:"route_id,omitempty"`
+	RouteMode            string `json:"route_mode,omitempty"`
+	RouteStep            int    `json:"route_step"`
+	EscalateAfterSeconds int64  `json:"escalate_after_seconds,omitempty"`
+	// DigestID is the digest delivery a batched or throttled notification went out with.
+	DigestID       *int64     `json:"digest_id,omitempty"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
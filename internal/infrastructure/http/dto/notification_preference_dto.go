@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// UpdateNotificationPreferenceRequest replaces the preference; do-not-disturb is set separately.
+type UpdateNotificationPreferenceRequest struct {
+	RoutingMode string `json:"routing_mode" enums:"broadcast,first_success,escalate"`
+	// EscalateAfterSeconds is used by the escalate mode; 0 means the default of 300.
+	EscalateAfterSeconds int64 `json:"escalate_after_seconds"`
+	// QuietHours null turns quiet hours off.
+	QuietHours *QuietHoursDTO `json:"quiet_hours"`
+}
+
+type QuietHoursDTO struct {
+	// Timezone is an IANA name such as "Asia/Shanghai"; empty means UTC.
+	Timezone string              `json:"timezone,omitempty"`
+	Action   string              `json:"action" enums:"drop,delay,passive"`
+	Rules    []QuietHoursRuleDTO `json:"rules"`
+}
+
+type QuietHoursRuleDTO struct {
+	// Weekdays the period starts on, 0 (Sunday) to 6; empty means every day.
+	Weekdays []int  `json:"weekdays,omitempty"`
+	Start    string `json:"start" example:"23:00"`
+	End      string `json:"end" example:"07:00"`
+}
+
+type SetDoNotDisturbRequest struct {
+	Minutes int `json:"minutes" validate:"required,gte=1"`
+}
+
+type NotificationPreferenceResponse struct {
+	UserID               int64          `json:"user_id"`
+	RoutingMode          string         `json:"routing_mode"`
+	EscalateAfterSeconds int64          `json:"escalate_after_seconds,omitempty"`
+	QuietHours           *QuietHoursDTO `json:"quiet_hours,omitempty"`
+	DoNotDisturbUntil    *time.Time     `json:"do_not_disturb_until,omitempty"`
+	UpdatedAt            *time.Time     `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+type PreviewNotificationTemplateRequest struct {
+	TitleTemplate string `json:"title_template"`
+	BodyTemplate  string `json:"body_template"`
+	StreamerID    int64  `json:"streamer_id,omitempty"`
+	Alias         string `json:"alias,omitempty"`
+}
+
+type NotificationTemplatePreviewResponse struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type NotificationTemplateVariableResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Example     string `json:"example"`
+}
@@ -7,6 +7,7 @@ type CreateUserRequest struct {
 	Email           string `json:"email" validate:"required,email"`
 	Password        string `json:"password" validate:"required,min=8,max=32"`
 	ConfirmPassword string `json:"confirm_password" validate:"required,eqfield=Password"`
+	Locale          string `json:"locale" validate:"omitempty,oneof=en zh-CN"`
 }
 
 type UpdateUserRequest struct {
@@ -19,6 +20,7 @@ type UserResponse struct {
 	ID        int64     `json:"id"`
 	Username  string    `json:"username"`
 	Email     string    `json:"email"`
+	Locale    string    `json:"locale,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
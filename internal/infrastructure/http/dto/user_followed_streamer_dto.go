@@ -1,28 +1,104 @@
 package dto
 
+import "time"
+
 type CreateUserFollowedStreamerRequest struct {
-	UserID                 int64   `json:"user_id"`
-	StreamerID             int64   `json:"streamer_id"`
-	Alias                  string  `json:"alias"`
-	Notes                  string  `json:"notes"`
-	NotificationsEnabled   bool    `json:"notifications_enabled"`
-	NotificationChannelIDs []int64 `json:"notification_channel_ids"`
+	UserID                 int64    `json:"user_id"`
+	StreamerID             int64    `json:"streamer_id"`
+	Alias                  string   `json:"alias"`
+	Notes                  string   `json:"notes"`
+	NotificationsEnabled   bool     `json:"notifications_enabled"`
+	AlwaysNotify           bool     `json:"always_notify"`
+	NotifyStreamEnd        bool     `json:"notify_stream_end"`
+	NotifyTitleChange      bool     `json:"notify_title_change"`
+	NotifyCategories       []string `json:"notify_categories,omitempty"`
+	NotificationChannelIDs []int64  `json:"notification_channel_ids"`
+
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+
+	// Filter limits which go-live events notify; null lets every broadcast through.
+	Filter *NotificationFilterDTO `json:"filter,omitempty"`
+
+	// RoutingMode overrides the user's routing for this follow; empty inherits it.
+	RoutingMode          string `json:"routing_mode,omitempty" enums:"broadcast,first_success,escalate"`
+	EscalateAfterSeconds int64  `json:"escalate_after_seconds,omitempty"`
+
+	// ChannelOverrides lays config fields over the config of the channel with the given ID for this
+	// follow's notifications. Only fields the channel type schema marks x-overridable are accepted.
+	ChannelOverrides map[int64]map[string]any `json:"channel_overrides,omitempty"`
 }
 
 type UpdateUserFollowedStreamerRequest struct {
-	ID                     int64   `json:"id"`
-	Alias                  string  `json:"alias"`
-	Notes                  string  `json:"notes"`
-	NotificationsEnabled   bool    `json:"notifications_enabled"`
-	NotificationChannelIDs []int64 `json:"notification_channel_ids"`
+	ID                     int64    `json:"id"`
+	Alias                  string   `json:"alias"`
+	Notes                  string   `json:"notes"`
+	NotificationsEnabled   bool     `json:"notifications_enabled"`
+	AlwaysNotify           bool     `json:"always_notify"`
+	NotifyStreamEnd        bool     `json:"notify_stream_end"`
+	NotifyTitleChange      bool     `json:"notify_title_change"`
+	NotifyCategories       []string `json:"notify_categories,omitempty"`
+	NotificationChannelIDs []int64  `json:"notification_channel_ids"`
+
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+
+	// Filter limits which go-live events notify; null lets every broadcast through.
+	Filter *NotificationFilterDTO `json:"filter,omitempty"`
+
+	// RoutingMode overrides the user's routing for this follow; empty inherits it.
+	RoutingMode          string `json:"routing_mode,omitempty" enums:"broadcast,first_success,escalate"`
+	EscalateAfterSeconds int64  `json:"escalate_after_seconds,omitempty"`
+
+	// ChannelOverrides lays config fields over the config of the channel with the given ID for this
+	// follow's notifications. Only fields the channel type schema marks x-overridable are accepted.
+	ChannelOverrides map[int64]map[string]any `json:"channel_overrides,omitempty"`
 }
 
 type UserFollowedStreamerResponse struct {
-	ID                     int64   `json:"id"`
-	UserID                 int64   `json:"user_id"`
-	StreamerID             int64   `json:"streamer_id"`
-	Alias                  string  `json:"alias"`
-	Notes                  string  `json:"notes"`
-	NotificationsEnabled   bool    `json:"notifications_enabled"`
-	NotificationChannelIDs []int64 `json:"notification_channel_ids"`
+	ID                     int64    `json:"id"`
+	UserID                 int64    `json:"user_id"`
+	StreamerID             int64    `json:"streamer_id"`
+	Alias                  string   `json:"alias"`
+	Notes                  string   `json:"notes"`
+	NotificationsEnabled   bool     `json:"notifications_enabled"`
+	AlwaysNotify           bool     `json:"always_notify"`
+	NotifyStreamEnd        bool     `json:"notify_stream_end"`
+	NotifyTitleChange      bool     `json:"notify_title_change"`
+	NotifyCategories       []string `json:"notify_categories,omitempty"`
+	NotificationChannelIDs []int64  `json:"notification_channel_ids"`
+
+	TitleTemplate string `json:"title_template,omitempty"`
+	BodyTemplate  string `json:"body_template,omitempty"`
+
+	// Filter limits which go-live events notify; null lets every broadcast through.
+	Filter *NotificationFilterDTO `json:"filter,omitempty"`
+
+	// RoutingMode overrides the user's routing for this follow; empty inherits it.
+	RoutingMode          string `json:"routing_mode,omitempty" enums:"broadcast,first_success,escalate"`
+	EscalateAfterSeconds int64  `json:"escalate_after_seconds,omitempty"`
+
+	// ChannelOverrides lays config fields over the config of the channel with the given ID for this
+	// follow's notifications. Only fields the channel type schema marks x-overridable are accepted.
+	ChannelOverrides map[int64]map[string]any `json:"channel_overrides,omitempty"`
+
+	// SnoozedUntil and MutedSessionID are set from the action links in notifications and hold back
+	// notifications until then, or about that one broadcast.
+	SnoozedUntil   *time.Time `json:"snoozed_until,omitempty"`
+	MutedSessionID *int64     `json:"muted_session_id,omitempty"`
+}
+
+// NotificationFilterDTO holds go-live filter rules; every rule that is set has to pass. Keywords and
+// categories match case-insensitively.
+type NotificationFilterDTO struct {
+	// TitleInclude requires the title to contain at least one of the keywords.
+	TitleInclude []string `json:"title_include,omitempty" validate:"max=20,dive,max=100"`
+	// TitleExclude rejects titles containing any of the keywords.
+	TitleExclude []string `json:"title_exclude,omitempty" validate:"max=20,dive,max=100"`
+	// TitlePattern is a regular expression the title has to match.
+	TitlePattern string `json:"title_pattern,omitempty" validate:"max=200" example:"(?i)elden ring"`
+	// Categories only lets these categories through; empty allows any.
+	Categories        []string `json:"categories,omitempty" validate:"max=20,dive,max=100"`
+	BlockedCategories []string `json:"blocked_categories,omitempty" validate:"max=20,dive,max=100"`
+	MinViewers        int      `json:"min_viewers,omitempty" validate:"gte=0"`
 }
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type VAPIDPublicKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
+
+type WebPushSubscriptionKeys struct {
+	P256dh string `json:"p256dh" validate:"required"`
+	Auth   string `json:"auth" validate:"required"`
+}
+
+// SubscribeWebPushRequest mirrors PushSubscription.toJSON() in the browser, plus the owning user.
+type SubscribeWebPushRequest struct {
+	UserID   int64                   `json:"user_id" validate:"required"`
+	Endpoint string                  `json:"endpoint" validate:"required,url"`
+	Keys     WebPushSubscriptionKeys `json:"keys"`
+}
+
+type WebPushSubscriptionResponse struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Endpoint  string    `json:"endpoint"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -4,9 +4,11 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
+	coreRepo "github.com/ryuyb/fusion/internal/core/port/repository"
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/jwt"
 	"github.com/ryuyb/fusion/internal/pkg/auth"
 	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 )
 
 const (
@@ -15,11 +17,13 @@ const (
 
 type Auth struct {
 	jwtManager *jwt.JWTManager
+	userRepo   coreRepo.UserRepository
 }
 
-func NewAuth(jwtManager *jwt.JWTManager) *Auth {
+func NewAuth(jwtManager *jwt.JWTManager, userRepo coreRepo.UserRepository) *Auth {
 	return &Auth{
 		jwtManager: jwtManager,
+		userRepo:   userRepo,
 	}
 }
 
@@ -40,8 +44,28 @@ func (a *Auth) Handler() fiber.Handler {
 			return err
 		}
 
-		ctx.Locals(auth.UserContextKey, claims)
-		ctx.Locals(auth.UserIdContextKey, claims.ID)
+		a.authenticate(ctx, claims)
+
+		return ctx.Next()
+	}
+}
+
+// Stream authenticates like Handler but also accepts the token in the access_token query parameter,
+// since browsers cannot set headers on EventSource and WebSocket requests.
+func (a *Auth) Stream() fiber.Handler {
+	handler := a.Handler()
+	return func(ctx fiber.Ctx) error {
+		token := ctx.Query("access_token")
+		if token == "" || ctx.Get(fiber.HeaderAuthorization) != "" {
+			return handler(ctx)
+		}
+
+		claims, err := a.jwtManager.ValidateToken(token)
+		if err != nil {
+			return err
+		}
+
+		a.authenticate(ctx, claims)
 
 		return ctx.Next()
 	}
@@ -58,10 +82,19 @@ func (a *Auth) Optional() fiber.Handler {
 		if len(authHeaderParts) == 2 && authHeaderParts[0] == Bearer {
 			tokenString := authHeaderParts[1]
 			if claims, err := a.jwtManager.ValidateToken(tokenString); err == nil {
-				ctx.Locals(auth.UserContextKey, claims)
-				ctx.Locals(auth.UserIdContextKey, claims.ID)
+				a.authenticate(ctx, claims)
 			}
 		}
 		return ctx.Next()
 	}
 }
+
+// authenticate stores the user of a valid token on the request, along with the locale saved in their
+// profile. Responses fall back to the Accept-Language header when the profile cannot be read.
+func (a *Auth) authenticate(ctx fiber.Ctx, claims *jwt.UserClaims) {
+	ctx.Locals(auth.UserContextKey, claims)
+	ctx.Locals(auth.UserIdContextKey, claims.ID)
+	if user, err := a.userRepo.FindById(ctx, claims.ID); err == nil && user.Locale != "" {
+		ctx.Locals(auth.LocaleContextKey, i18n.Resolve(user.Locale))
+	}
+}
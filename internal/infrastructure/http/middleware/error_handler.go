@@ -5,7 +5,9 @@ import (
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/ryuyb/fusion/internal/infrastructure/http/dto"
+	"github.com/ryuyb/fusion/internal/pkg/auth"
 	"github.com/ryuyb/fusion/internal/pkg/errors"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -30,7 +32,7 @@ func ErrorHandler(logger *zap.Logger) fiber.ErrorHandler {
 func handleAppError(c fiber.Ctx, err *errors.AppError, logger *zap.Logger) error {
 	logError(c, err, logger)
 
-	errResp := dto.NewErrorResponse(c, string(err.Code), err.Message)
+	errResp := dto.NewErrorResponse(c, string(err.Code), err.Localize(auth.GetLocale(c)))
 
 	if len(err.Details) > 0 {
 		errResp.WithDetails(err.Details)
@@ -70,7 +72,7 @@ func handleUnknownError(c fiber.Ctx, err error, logger *zap.Logger) error {
 	errResp := dto.NewErrorResponse(
 		c,
 		"INTERNAL_ERROR",
-		"An unexpected error occurred",
+		i18n.T(auth.GetLocale(c), "An unexpected error occurred"),
 	)
 
 	return c.Status(fiber.StatusInternalServerError).JSON(errResp)
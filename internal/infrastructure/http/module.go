@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v3/middleware/compress"
 	"github.com/gofiber/fiber/v3/middleware/requestid"
 	"github.com/gofiber/swagger/v2"
+	coreService "github.com/ryuyb/fusion/internal/core/port/service"
 	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
 	"github.com/ryuyb/fusion/internal/infrastructure/http/middleware"
 	"github.com/ryuyb/fusion/internal/infrastructure/http/router"
@@ -28,6 +29,13 @@ var Module = fx.Module("http",
 		controller.NewStreamerController,
 		controller.NewNotificationChannelController,
 		controller.NewUserFollowedStreamerController,
+		controller.NewWebPushController,
+		controller.NewNotificationTemplateController,
+		controller.NewNotificationDeliveryController,
+		controller.NewNotificationPreferenceController,
+		controller.NewLiveEventController,
+		controller.NewInboxController,
+		controller.NewNotificationActionController,
 	),
 
 	fx.Provide(
@@ -39,7 +47,7 @@ var Module = fx.Module("http",
 	router.Module,
 )
 
-func NewFiberApp(cfg *config.Config, logger *zap.Logger, routerRegistry *router.RouterRegistry, validate *validator.Validator) *fiber.App {
+func NewFiberApp(cfg *config.Config, logger *zap.Logger, routerRegistry *router.RouterRegistry, validate *validator.Validator, events coreService.LiveEventService) *fiber.App {
 	app := fiber.New(fiber.Config{
 		AppName:       cfg.App.Name,
 		ServerHeader:  fmt.Sprintf("%s Server", cfg.App.Name),
@@ -60,6 +68,7 @@ func NewFiberApp(cfg *config.Config, logger *zap.Logger, routerRegistry *router.
 	app.Use(middleware.Recovery(logger.Named("recovery")))
 	app.Use(compress.New(compress.Config{
 		Level: compress.LevelBestSpeed,
+		Next:  router.IsEventStream,
 	}))
 	app.Use(middleware.Logger(logger))
 
@@ -67,5 +76,11 @@ func NewFiberApp(cfg *config.Config, logger *zap.Logger, routerRegistry *router.
 
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
+	// Open event streams never finish on their own; ending them lets shutdown drain the connections.
+	app.Hooks().OnPreShutdown(func() error {
+		events.Close()
+		return nil
+	})
+
 	return app
 }
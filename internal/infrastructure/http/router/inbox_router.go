@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+)
+
+type InboxRouter struct {
+	controller *controller.InboxController
+}
+
+func NewInboxRouter(controller *controller.InboxController) Router {
+	return &InboxRouter{controller: controller}
+}
+
+func (r *InboxRouter) RegisterRouters(router fiber.Router) {
+	group := router.Group("/api/v1/inbox")
+	group.Post("/:id/read", r.controller.MarkRead)
+	group.Get("/users/:user_id", r.controller.ListByUser)
+	group.Get("/users/:user_id/unread-count", r.controller.UnreadCount)
+	group.Post("/users/:user_id/read-all", r.controller.MarkAllRead)
+}
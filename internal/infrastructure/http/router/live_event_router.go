@@ -0,0 +1,30 @@
+package router
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/middleware"
+)
+
+const eventStreamPath = "/api/v1/events/stream"
+
+type LiveEventRouter struct {
+	controller *controller.LiveEventController
+	auth       *middleware.Auth
+}
+
+func NewLiveEventRouter(controller *controller.LiveEventController, auth *middleware.Auth) Router {
+	return &LiveEventRouter{controller: controller, auth: auth}
+}
+
+func (r *LiveEventRouter) RegisterRouters(router fiber.Router) {
+	router.Get(eventStreamPath, r.auth.Stream(), r.controller.Stream)
+}
+
+// IsEventStream reports whether the request opens the event stream, whose body never ends and so must
+// not pass through middleware that buffers the response.
+func IsEventStream(c fiber.Ctx) bool {
+	return strings.EqualFold(strings.TrimSuffix(c.Path(), "/"), eventStreamPath)
+}
@@ -11,6 +11,13 @@ var Module = fx.Module("router",
 		asRouter(NewStreamerRouter),
 		asRouter(NewNotificationChannelRouter),
 		asRouter(NewUserFollowedStreamerRouter),
+		asRouter(NewWebPushRouter),
+		asRouter(NewNotificationTemplateRouter),
+		asRouter(NewNotificationDeliveryRouter),
+		asRouter(NewNotificationPreferenceRouter),
+		asRouter(NewLiveEventRouter),
+		asRouter(NewInboxRouter),
+		asRouter(NewNotificationActionRouter),
 	),
 
 	fx.Provide(NewRouterRegistry),
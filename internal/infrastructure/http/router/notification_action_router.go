@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+)
+
+type NotificationActionRouter struct {
+	controller *controller.NotificationActionController
+}
+
+func NewNotificationActionRouter(controller *controller.NotificationActionController) Router {
+	return &NotificationActionRouter{controller: controller}
+}
+
+// RegisterRouters serves the action links of notifications. Links are opened from a browser or
+// called in the background by ntfy, so both GET and POST act, but only POST mutes or unfollows. A
+// browser opening a mute or unfollow link gets a page that posts back once confirmed.
+func (r *NotificationActionRouter) RegisterRouters(router fiber.Router) {
+	group := router.Group("/api/v1/notification-actions")
+	group.Get("/:token", r.controller.Perform)
+	group.Post("/:token", r.controller.Perform)
+}
@@ -15,7 +15,12 @@ func NewNotificationChannelRouter(controller *controller.NotificationChannelCont
 
 func (r *NotificationChannelRouter) RegisterRouters(router fiber.Router) {
 	group := router.Group("/api/v1/notification-channels")
+	group.Get("/types", r.controller.ListTypes)
 	group.Post("/", r.controller.Create)
+	group.Post("/test", r.controller.TestConfig)
+	group.Post("/:id/test", r.controller.Test)
+	group.Post("/:id/verify", r.controller.Verify)
+	group.Post("/:id/verification", r.controller.ResendVerification)
 	group.Put("/:id", r.controller.Update)
 	group.Delete("/:id", r.controller.Delete)
 	group.Get("/:id", r.controller.GetByID)
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+)
+
+type NotificationDeliveryRouter struct {
+	controller *controller.NotificationDeliveryController
+}
+
+func NewNotificationDeliveryRouter(controller *controller.NotificationDeliveryController) Router {
+	return &NotificationDeliveryRouter{controller: controller}
+}
+
+func (r *NotificationDeliveryRouter) RegisterRouters(router fiber.Router) {
+	group := router.Group("/api/v1/notification-deliveries")
+	group.Get("/dead-letters", r.controller.ListDeadLetters)
+	group.Post("/dead-letters/replay", r.controller.ReplayDeadLetters)
+	group.Get("/:id", r.controller.GetByID)
+	group.Post("/:id/resend", r.controller.Resend)
+	group.Post("/:id/ack", r.controller.Acknowledge)
+	group.Get("/users/:user_id", r.controller.ListByUser)
+}
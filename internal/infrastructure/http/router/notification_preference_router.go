@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+)
+
+type NotificationPreferenceRouter struct {
+	controller *controller.NotificationPreferenceController
+}
+
+func NewNotificationPreferenceRouter(controller *controller.NotificationPreferenceController) Router {
+	return &NotificationPreferenceRouter{controller: controller}
+}
+
+func (r *NotificationPreferenceRouter) RegisterRouters(router fiber.Router) {
+	group := router.Group("/api/v1/notification-preferences")
+	group.Get("/users/:user_id", r.controller.GetByUser)
+	group.Put("/users/:user_id", r.controller.UpdateByUser)
+	group.Put("/users/:user_id/dnd", r.controller.SetDoNotDisturb)
+	group.Delete("/users/:user_id/dnd", r.controller.ClearDoNotDisturb)
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+)
+
+type NotificationTemplateRouter struct {
+	controller *controller.NotificationTemplateController
+}
+
+func NewNotificationTemplateRouter(controller *controller.NotificationTemplateController) Router {
+	return &NotificationTemplateRouter{controller: controller}
+}
+
+func (r *NotificationTemplateRouter) RegisterRouters(router fiber.Router) {
+	group := router.Group("/api/v1/notification-templates")
+	group.Post("/preview", r.controller.Preview)
+	group.Get("/variables", r.controller.Variables)
+}
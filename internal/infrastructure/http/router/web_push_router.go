@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/ryuyb/fusion/internal/infrastructure/http/controller"
+)
+
+type WebPushRouter struct {
+	controller *controller.WebPushController
+}
+
+func NewWebPushRouter(controller *controller.WebPushController) Router {
+	return &WebPushRouter{controller: controller}
+}
+
+func (r *WebPushRouter) RegisterRouters(router fiber.Router) {
+	group := router.Group("/api/v1/webpush")
+	group.Get("/vapid-public-key", r.controller.GetVAPIDPublicKey)
+	group.Post("/subscriptions", r.controller.Subscribe)
+	group.Delete("/subscriptions/:id", r.controller.Unsubscribe)
+	group.Get("/subscriptions/users/:user_id", r.controller.ListByUser)
+}
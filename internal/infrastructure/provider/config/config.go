@@ -9,6 +9,12 @@ type Config struct {
 	Log      LogConfig            `mapstructure:"logger"`
 	JWT      JWTConfig            `mapstructure:"jwt"`
 	Job      map[string]JobConfig `mapstructure:"job"`
+
+	Encryption EncryptionConfig `mapstructure:"encryption"`
+
+	Notification NotificationConfig `mapstructure:"notification"`
+
+	Events EventsConfig `mapstructure:"events"`
 }
 
 type AppConfig struct {
@@ -62,7 +68,95 @@ type JWTConfig struct {
 	Expiration time.Duration `mapstructure:"expiration"`
 }
 
+// EncryptionConfig holds the master keys used to encrypt secrets at rest.
+// Keys maps a key id to a base64 encoded 32 byte key; ActiveKey names the key new secrets are encrypted with.
+type EncryptionConfig struct {
+	ActiveKey string            `mapstructure:"active_key"`
+	Keys      map[string]string `mapstructure:"keys"`
+}
+
+// EventsConfig tunes the real-time event stream. The last ReplaySize events of each user, no older
+// than ReplayWindow, are kept for clients reconnecting with Last-Event-ID; zero keeps none or, for the
+// window, keeps them regardless of age. Idle streams are pinged every Heartbeat so proxies keep them
+// open and dropped clients are noticed.
+type EventsConfig struct {
+	ReplaySize   int           `mapstructure:"replay_size"`
+	ReplayWindow time.Duration `mapstructure:"replay_window"`
+	Heartbeat    time.Duration `mapstructure:"heartbeat"`
+}
+
 type JobConfig struct {
 	Enable   bool   `mapstructure:"enable"`
 	CronExpr string `mapstructure:"cron_expr"`
 }
+
+type NotificationConfig struct {
+	WebPush   WebPushConfig   `mapstructure:"webpush"`
+	Delivery  DeliveryConfig  `mapstructure:"delivery"`
+	Outbox    OutboxConfig    `mapstructure:"outbox"`
+	Reminder  ReminderConfig  `mapstructure:"reminder"`
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	Health    HealthConfig    `mapstructure:"health"`
+	Inbox     InboxConfig     `mapstructure:"inbox"`
+	Actions   ActionsConfig   `mapstructure:"actions"`
+}
+
+// ActionsConfig controls the signed links notifications carry to act on a follow without signing in,
+// e.g. to snooze the streamer for Snooze. Links point at BaseURL, the public address of this server,
+// and expire after TTL; without a BaseURL notifications carry none. Secret signs the links; without
+// one a key is derived from the JWT secret.
+type ActionsConfig struct {
+	BaseURL string        `mapstructure:"base_url"`
+	Secret  string        `mapstructure:"secret"`
+	TTL     time.Duration `mapstructure:"ttl"`
+	Snooze  time.Duration `mapstructure:"snooze"`
+}
+
+// InboxConfig decides how long in-app inbox messages are kept; zero keeps them forever.
+type InboxConfig struct {
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// HealthConfig decides when a failing channel is turned off. FailureThreshold consecutive dead-lettered
+// deliveries disable the channel and alert its owner through their other channels; zero keeps failing channels on.
+type HealthConfig struct {
+	FailureThreshold int `mapstructure:"failure_threshold"`
+}
+
+// RateLimitConfig caps how many notifications are sent within Window; go-live notifications over the
+// limit are collapsed into one message. A zero limit turns that check off.
+type RateLimitConfig struct {
+	Window     time.Duration `mapstructure:"window"`
+	PerChannel int           `mapstructure:"per_channel"`
+	PerUser    int           `mapstructure:"per_user"`
+}
+
+type ReminderConfig struct {
+	// ReconnectGrace treats a streamer going live again within this long of last being seen live as
+	// the same stream.
+	ReconnectGrace time.Duration `mapstructure:"reconnect_grace"`
+	// RenotifyCooldown is the minimum time between two go-live notifications of a follow.
+	RenotifyCooldown time.Duration `mapstructure:"renotify_cooldown"`
+}
+
+type DeliveryConfig struct {
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+type OutboxConfig struct {
+	Enable       bool          `mapstructure:"enable"`
+	Workers      int           `mapstructure:"workers"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	Lease        time.Duration `mapstructure:"lease"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	BaseBackoff  time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+}
+
+type WebPushConfig struct {
+	Subject         string        `mapstructure:"subject"`
+	TTL             time.Duration `mapstructure:"ttl"`
+	VAPIDPublicKey  string        `mapstructure:"vapid_public_key"`
+	VAPIDPrivateKey string        `mapstructure:"vapid_private_key"`
+}
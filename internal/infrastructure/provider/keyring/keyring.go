@@ -0,0 +1,177 @@
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	errors2 "github.com/ryuyb/fusion/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	envelopePrefix = "enc:v1:"
+	keySize        = 32
+)
+
+var encoding = base64.RawURLEncoding
+
+// Keyring seals small secrets with envelope encryption: every value gets a fresh
+// data key, and the data key is wrapped with a master key named by id.
+//
+// Sealed values look like "enc:v1:<key id>:<wrapped data key>:<ciphertext>". New values
+// are always wrapped with the active key; values wrapped with any configured key can be
+// opened, so master keys are rotated by adding a key, making it active and re-sealing.
+type Keyring struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// NewKeyring loads master keys from config. Without keys the keyring is disabled and
+// Seal is a no-op, so existing plaintext deployments keep working.
+func NewKeyring(cfg *config.Config, logger *zap.Logger) (*Keyring, error) {
+	encryption := cfg.Encryption
+	if len(encryption.Keys) == 0 {
+		logger.Warn("no encryption keys configured, secrets are stored in plaintext")
+		return &Keyring{}, nil
+	}
+
+	keys := make(map[string][]byte, len(encryption.Keys))
+	for id, encoded := range encryption.Keys {
+		if strings.Contains(id, ":") {
+			return nil, fmt.Errorf("encryption key id %q must not contain ':'", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q is not valid base64: %w", id, err)
+		}
+		if len(key) != keySize {
+			return nil, fmt.Errorf("encryption key %q must be %d bytes, got %d", id, keySize, len(key))
+		}
+		keys[id] = key
+	}
+	if _, ok := keys[encryption.ActiveKey]; !ok {
+		return nil, fmt.Errorf("active encryption key %q is not configured", encryption.ActiveKey)
+	}
+	return &Keyring{activeID: encryption.ActiveKey, keys: keys}, nil
+}
+
+// Enabled reports whether master keys are configured.
+func (k *Keyring) Enabled() bool {
+	return k.activeID != ""
+}
+
+// IsSealed reports whether value was produced by Seal.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// NeedsRotation reports whether value should be re-sealed: it is still plaintext or
+// its data key is wrapped with a key other than the active one.
+func (k *Keyring) NeedsRotation(value string) bool {
+	if !k.Enabled() {
+		return false
+	}
+	if !IsSealed(value) {
+		return true
+	}
+	id, _, _, err := parseEnvelope(value)
+	return err != nil || id != k.activeID
+}
+
+// Seal encrypts plaintext under the active key. aad binds the result to its context,
+// e.g. the config field name, so sealed values cannot be swapped between fields.
+func (k *Keyring) Seal(plaintext, aad []byte) (string, error) {
+	if !k.Enabled() {
+		return "", errors2.Internal(fmt.Errorf("keyring has no active key"))
+	}
+
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", errors2.Internal(err)
+	}
+	wrapped, err := seal(k.keys[k.activeID], dataKey, []byte(k.activeID))
+	if err != nil {
+		return "", errors2.Internal(err)
+	}
+	ciphertext, err := seal(dataKey, plaintext, aad)
+	if err != nil {
+		return "", errors2.Internal(err)
+	}
+	return envelopePrefix + k.activeID + ":" + encoding.EncodeToString(wrapped) + ":" + encoding.EncodeToString(ciphertext), nil
+}
+
+// Open decrypts a value produced by Seal with the same aad.
+func (k *Keyring) Open(value string, aad []byte) ([]byte, error) {
+	id, wrapped, ciphertext, err := parseEnvelope(value)
+	if err != nil {
+		return nil, errors2.Internal(err)
+	}
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, errors2.Internal(fmt.Errorf("encryption key %q is not configured", id))
+	}
+	dataKey, err := open(key, wrapped, []byte(id))
+	if err != nil {
+		return nil, errors2.Internal(fmt.Errorf("failed to unwrap data key: %w", err))
+	}
+	plaintext, err := open(dataKey, ciphertext, aad)
+	if err != nil {
+		return nil, errors2.Internal(fmt.Errorf("failed to decrypt value: %w", err))
+	}
+	return plaintext, nil
+}
+
+func parseEnvelope(value string) (id string, wrapped, ciphertext []byte, err error) {
+	if !IsSealed(value) {
+		return "", nil, nil, fmt.Errorf("value is not sealed")
+	}
+	parts := strings.Split(strings.TrimPrefix(value, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, fmt.Errorf("sealed value is malformed")
+	}
+	if wrapped, err = encoding.DecodeString(parts[1]); err != nil {
+		return "", nil, nil, fmt.Errorf("sealed data key is malformed: %w", err)
+	}
+	if ciphertext, err = encoding.DecodeString(parts[2]); err != nil {
+		return "", nil, nil, fmt.Errorf("sealed ciphertext is malformed: %w", err)
+	}
+	return parts[0], wrapped, ciphertext, nil
+}
+
+// seal encrypts with AES-256-GCM and prepends the random nonce.
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(key, sealed, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
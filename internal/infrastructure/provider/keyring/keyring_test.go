@@ -0,0 +1,81 @@
+package keyring
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/ryuyb/fusion/internal/infrastructure/provider/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestKeyring(t *testing.T, active string, ids ...string) *Keyring {
+	t.Helper()
+	keys := map[string]string{}
+	for i, id := range ids {
+		keys[id] = base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(rune('a'+i)), keySize)))
+	}
+	kr, err := NewKeyring(&config.Config{Encryption: config.EncryptionConfig{ActiveKey: active, Keys: keys}}, zap.NewNop())
+	require.NoError(t, err)
+	return kr
+}
+
+func TestKeyring_SealOpen(t *testing.T) {
+	kr := newTestKeyring(t, "k1", "k1")
+
+	sealed, err := kr.Seal([]byte(`"device-key"`), []byte("bark.device_key"))
+	require.NoError(t, err)
+	require.True(t, IsSealed(sealed))
+	require.NotContains(t, sealed, "device-key")
+
+	plaintext, err := kr.Open(sealed, []byte("bark.device_key"))
+	require.NoError(t, err)
+	require.Equal(t, `"device-key"`, string(plaintext))
+
+	_, err = kr.Open(sealed, []byte("bark.other"))
+	require.Error(t, err)
+
+	again, err := kr.Seal([]byte(`"device-key"`), []byte("bark.device_key"))
+	require.NoError(t, err)
+	require.NotEqual(t, sealed, again)
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	old := newTestKeyring(t, "k1", "k1")
+	sealed, err := old.Seal([]byte("secret"), nil)
+	require.NoError(t, err)
+	require.False(t, old.NeedsRotation(sealed))
+
+	rotated := newTestKeyring(t, "k2", "k1", "k2")
+	require.True(t, rotated.NeedsRotation(sealed))
+	require.True(t, rotated.NeedsRotation("plaintext"))
+
+	plaintext, err := rotated.Open(sealed, nil)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(plaintext))
+
+	resealed, err := rotated.Seal(plaintext, nil)
+	require.NoError(t, err)
+	require.False(t, rotated.NeedsRotation(resealed))
+
+	retired := newTestKeyring(t, "k2", "k2")
+	_, err = retired.Open(sealed, nil)
+	require.Error(t, err)
+}
+
+func TestNewKeyring(t *testing.T) {
+	disabled, err := NewKeyring(&config.Config{}, zap.NewNop())
+	require.NoError(t, err)
+	require.False(t, disabled.Enabled())
+	require.False(t, disabled.NeedsRotation("plaintext"))
+
+	for _, encryption := range []config.EncryptionConfig{
+		{ActiveKey: "k1", Keys: map[string]string{"k1": "not base64"}},
+		{ActiveKey: "k1", Keys: map[string]string{"k1": base64.StdEncoding.EncodeToString([]byte("short"))}},
+		{ActiveKey: "k2", Keys: map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, keySize))}},
+	} {
+		_, err := NewKeyring(&config.Config{Encryption: encryption}, zap.NewNop())
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,7 @@
+package keyring
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("keyring",
+	fx.Provide(NewKeyring),
+)
@@ -3,11 +3,15 @@ package auth
 import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/jwt"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 )
 
 const (
 	UserContextKey   = "auth_user"
 	UserIdContextKey = "user_id"
+	// LocaleContextKey holds the locale saved in the authenticated user's profile, looked up on every
+	// request so a change applies right away rather than with the next token.
+	LocaleContextKey = "locale"
 )
 
 func GetCurrentUser(c fiber.Ctx) (*jwt.UserClaims, bool) {
@@ -32,3 +36,12 @@ func IsAuthenticated(c fiber.Ctx) bool {
 	_, exists := GetCurrentUser(c)
 	return exists
 }
+
+// GetLocale returns the locale responses are written in: the one saved in the authenticated user's
+// profile, otherwise the best match for the Accept-Language header.
+func GetLocale(c fiber.Ctx) i18n.Locale {
+	if locale, ok := c.Locals(LocaleContextKey).(i18n.Locale); ok {
+		return locale
+	}
+	return i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage))
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/ryuyb/fusion/internal/infrastructure/provider/validator"
+	"github.com/ryuyb/fusion/internal/pkg/i18n"
 	"github.com/samber/lo"
 )
 
@@ -32,6 +33,11 @@ type AppError struct {
 	HTTPStatus int            `json:"-"`
 	Details    map[string]any `json:"details,omitempty"`
 	Err        error          `json:"-"`
+
+	// format and args keep the untranslated message of errors built from a format string, so Localize
+	// can look the format up in the catalog.
+	format string
+	args   []any
 }
 
 func (e *AppError) Error() string {
@@ -41,6 +47,15 @@ func (e *AppError) Error() string {
 	return e.Message
 }
 
+// Localize returns the message translated into locale. Messages missing from the catalog, e.g.
+// errors passed through from a provider, are returned as they are.
+func (e *AppError) Localize(locale i18n.Locale) string {
+	if e.format != "" {
+		return i18n.T(locale, e.format, e.args...)
+	}
+	return i18n.T(locale, e.Message)
+}
+
 func (e *AppError) Unwrap() error {
 	return e.Err
 }
@@ -84,6 +99,13 @@ func BadRequest(message string) *AppError {
 	}
 }
 
+// BadRequestf is BadRequest with a formatted message that stays translatable.
+func BadRequestf(format string, args ...any) *AppError {
+	err := BadRequest(fmt.Sprintf(format, args...))
+	err.format, err.args = format, args
+	return err
+}
+
 func Unauthorized(message string) *AppError {
 	return &AppError{
 		Code:       ErrCodeUnauthorized,
@@ -105,6 +127,8 @@ func NotFound(resource string) *AppError {
 		Code:       ErrCodeNotFound,
 		Message:    fmt.Sprintf("%s not found", resource),
 		HTTPStatus: http.StatusNotFound,
+		format:     "%s not found",
+		args:       []any{resource},
 	}
 }
 
@@ -49,6 +49,8 @@ func handleConstraintError(err error, resource string) *AppError {
 			Message:    fmt.Sprintf("%s already exists", resource),
 			HTTPStatus: http.StatusConflict,
 			Err:        err,
+			format:     "%s already exists",
+			args:       []any{resource},
 		}
 	}
 
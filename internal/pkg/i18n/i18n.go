@@ -0,0 +1,111 @@
+// Package i18n is the message catalog for notification texts and API error messages.
+//
+// Messages are looked up by key. Notification texts use IDs such as "notification.stream_ended.title"
+// and are shipped in every bundle; error messages use their English text as the key, so only the
+// non-English bundles list them.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Locale is a supported language tag.
+type Locale string
+
+const (
+	English           Locale = "en"
+	SimplifiedChinese Locale = "zh-CN"
+
+	// Default is used when neither the user nor the request asks for a supported locale.
+	Default = English
+)
+
+// Supported lists the locales a bundle is shipped for.
+var Supported = []Locale{English, SimplifiedChinese}
+
+//go:embed locales/*.json
+var bundles embed.FS
+
+var catalog = loadCatalog()
+
+func loadCatalog() map[Locale]map[string]string {
+	result := make(map[Locale]map[string]string, len(Supported))
+	for _, locale := range Supported {
+		raw, err := bundles.ReadFile("locales/" + string(locale) + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("i18n: missing bundle for %s: %v", locale, err))
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(raw, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: invalid bundle for %s: %v", locale, err))
+		}
+		result[locale] = messages
+	}
+	return result
+}
+
+// Language is the base language of the locale, e.g. "zh" for zh-CN.
+func (l Locale) Language() string {
+	language, _, _ := strings.Cut(string(l), "-")
+	return language
+}
+
+// Parse maps a language tag such as "zh", "zh-Hans-CN" or "en-US" to a supported locale. Every
+// Chinese variant maps to Simplified Chinese, the only Chinese bundle.
+func Parse(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	switch {
+	case tag == "zh" || strings.HasPrefix(tag, "zh-"):
+		return SimplifiedChinese, true
+	case tag == "en" || strings.HasPrefix(tag, "en-"):
+		return English, true
+	default:
+		return "", false
+	}
+}
+
+// Negotiate picks the supported locale an Accept-Language header prefers most, or Default.
+func Negotiate(acceptLanguage string) Locale {
+	best, bestQuality := Default, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				quality = parsed
+			}
+		}
+		if locale, ok := Parse(tag); ok && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best
+}
+
+// Resolve returns the locale a user saved in their profile, or Default when it is empty or unsupported.
+func Resolve(tag string) Locale {
+	if locale, ok := Parse(tag); ok {
+		return locale
+	}
+	return Default
+}
+
+// T translates key into locale and formats it with args. A key missing from the locale falls back to
+// the English bundle and then to the key itself.
+func T(locale Locale, key string, args ...any) string {
+	text, ok := catalog[locale][key]
+	if !ok {
+		text, ok = catalog[English][key]
+	}
+	if !ok {
+		text = key
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
@@ -0,0 +1,153 @@
+// Package tmpl executes user-supplied text/template sources with bounds on how long they run and how
+// much they print, so a template saved through the API cannot tie up a worker or exhaust memory.
+package tmpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+const (
+	DefaultMaxOutput = 8 << 10
+	DefaultMaxSteps  = 10000
+	DefaultTimeout   = 100 * time.Millisecond
+
+	// stepFunc is called at the start of every template and loop iteration to enforce the bounds.
+	stepFunc = "__step"
+)
+
+var (
+	ErrOutputTooLarge = errors.New("template output is too large")
+	ErrTooManySteps   = errors.New("template runs too many iterations")
+)
+
+// Engine parses and executes templates within fixed bounds. Zero fields take the defaults.
+type Engine struct {
+	// MissingKey is the text/template "missingkey" option, e.g. "zero" or "error".
+	MissingKey string
+	// MaxOutput is the most bytes an execution may print.
+	MaxOutput int
+	// MaxSteps is the most templates and loop iterations an execution may run.
+	MaxSteps int
+	// Timeout bounds an execution on top of the deadline of its context.
+	Timeout time.Duration
+}
+
+// Template is a parsed template ready to be executed any number of times, concurrently.
+type Template struct {
+	engine Engine
+	tpl    *template.Template
+}
+
+// Parse parses source, instrumenting every template and loop body so executions can be bounded.
+func (e Engine) Parse(name, source string) (*Template, error) {
+	funcs := template.FuncMap{stepFunc: func() string { return "" }}
+	tpl := template.New(name).Funcs(funcs)
+	if e.MissingKey != "" {
+		tpl = tpl.Option("missingkey=" + e.MissingKey)
+	}
+	tpl, err := tpl.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	step, err := template.New(stepFunc).Funcs(funcs).Parse("{{" + stepFunc + "}}")
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tpl.Templates() {
+		if t.Tree != nil && t.Tree.Root != nil {
+			instrument(t.Tree.Root, step.Tree.Root.Nodes[0])
+		}
+	}
+	return &Template{engine: e, tpl: tpl}, nil
+}
+
+// Execute runs the template against data and returns its output with surrounding whitespace trimmed.
+func (t *Template) Execute(ctx context.Context, data any) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, withDefault(t.engine.Timeout, DefaultTimeout))
+	defer cancel()
+
+	run := &execution{
+		ctx:       ctx,
+		maxSteps:  withDefault(t.engine.MaxSteps, DefaultMaxSteps),
+		maxOutput: withDefault(t.engine.MaxOutput, DefaultMaxOutput),
+	}
+	tpl, err := t.tpl.Clone()
+	if err != nil {
+		return "", err
+	}
+	tpl.Funcs(template.FuncMap{stepFunc: run.step})
+	if err := tpl.Execute(run, data); err != nil {
+		if run.err != nil {
+			return "", run.err
+		}
+		return "", err
+	}
+	return strings.TrimSpace(run.out.String()), nil
+}
+
+// instrument puts step at the start of list and of every loop body within it.
+func instrument(list *parse.ListNode, step parse.Node) {
+	var walk func(list *parse.ListNode)
+	walk = func(list *parse.ListNode) {
+		if list == nil {
+			return
+		}
+		for _, node := range list.Nodes {
+			switch n := node.(type) {
+			case *parse.IfNode:
+				walk(n.List)
+				walk(n.ElseList)
+			case *parse.WithNode:
+				walk(n.List)
+				walk(n.ElseList)
+			case *parse.RangeNode:
+				walk(n.List)
+				walk(n.ElseList)
+				n.List.Nodes = append([]parse.Node{step}, n.List.Nodes...)
+			}
+		}
+	}
+	walk(list)
+	list.Nodes = append([]parse.Node{step}, list.Nodes...)
+}
+
+// execution is the state of a single run; it is also where the output is written.
+type execution struct {
+	ctx       context.Context
+	steps     int
+	maxSteps  int
+	maxOutput int
+	out       strings.Builder
+	err       error
+}
+
+func (e *execution) step() (string, error) {
+	e.steps++
+	if e.steps > e.maxSteps {
+		e.err = ErrTooManySteps
+	} else if err := e.ctx.Err(); err != nil {
+		e.err = fmt.Errorf("template took too long: %w", err)
+	}
+	return "", e.err
+}
+
+func (e *execution) Write(p []byte) (int, error) {
+	if e.out.Len()+len(p) > e.maxOutput {
+		e.err = ErrOutputTooLarge
+		return 0, e.err
+	}
+	return e.out.Write(p)
+}
+
+func withDefault[T int | time.Duration](value, fallback T) T {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}